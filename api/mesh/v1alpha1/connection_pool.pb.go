@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.14.0
+// source: mesh/v1alpha1/connection_pool.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConnectionPool defines how dataplanes manage the upstream connections of
+// their outbounds.
+type ConnectionPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes that are sources of traffic.
+	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// List of selectors to match services that are destinations of traffic.
+	Destinations []*Selector          `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	Conf         *ConnectionPool_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *ConnectionPool) Reset() {
+	*x = ConnectionPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_connection_pool_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionPool) ProtoMessage() {}
+
+func (x *ConnectionPool) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_connection_pool_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionPool.ProtoReflect.Descriptor instead.
+func (*ConnectionPool) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_connection_pool_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConnectionPool) GetSources() []*Selector {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *ConnectionPool) GetDestinations() []*Selector {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *ConnectionPool) GetConf() *ConnectionPool_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+type ConnectionPool_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MaxRequestsPerConnection is the maximum number of requests sent over
+	// a single upstream connection. Once it is reached, the connection is
+	// drained and a new one is opened, so that long-lived HTTP/2
+	// connections get rebalanced across the endpoints. It only applies to
+	// HTTP, HTTP/2 and gRPC services.
+	MaxRequestsPerConnection *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=max_requests_per_connection,json=maxRequestsPerConnection,proto3" json:"max_requests_per_connection,omitempty"`
+	// IdleTimeout is the time after which an upstream connection without
+	// active requests is closed. It only applies to HTTP, HTTP/2 and gRPC
+	// services, and takes precedence over the HTTP idle timeout of the
+	// Timeout policy.
+	IdleTimeout  *durationpb.Duration              `protobuf:"bytes,2,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+	Http2        *ConnectionPool_Conf_Http2        `protobuf:"bytes,3,opt,name=http2,proto3" json:"http2,omitempty"`
+	TcpKeepalive *ConnectionPool_Conf_TcpKeepalive `protobuf:"bytes,4,opt,name=tcp_keepalive,json=tcpKeepalive,proto3" json:"tcp_keepalive,omitempty"`
+	// DnsLookupFamily is the IP family used to resolve the addresses of
+	// external services. It takes precedence over the default of the
+	// mesh.
+	DnsLookupFamily Networking_Outbound_DnsLookupFamily `protobuf:"varint,5,opt,name=dns_lookup_family,json=dnsLookupFamily,proto3,enum=kuma.mesh.v1alpha1.Networking_Outbound_DnsLookupFamily" json:"dns_lookup_family,omitempty"`
+}
+
+func (x *ConnectionPool_Conf) Reset() {
+	*x = ConnectionPool_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_connection_pool_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionPool_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionPool_Conf) ProtoMessage() {}
+
+func (x *ConnectionPool_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_connection_pool_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionPool_Conf.ProtoReflect.Descriptor instead.
+func (*ConnectionPool_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_connection_pool_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ConnectionPool_Conf) GetMaxRequestsPerConnection() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxRequestsPerConnection
+	}
+	return nil
+}
+
+func (x *ConnectionPool_Conf) GetIdleTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return nil
+}
+
+func (x *ConnectionPool_Conf) GetHttp2() *ConnectionPool_Conf_Http2 {
+	if x != nil {
+		return x.Http2
+	}
+	return nil
+}
+
+func (x *ConnectionPool_Conf) GetTcpKeepalive() *ConnectionPool_Conf_TcpKeepalive {
+	if x != nil {
+		return x.TcpKeepalive
+	}
+	return nil
+}
+
+func (x *ConnectionPool_Conf) GetDnsLookupFamily() Networking_Outbound_DnsLookupFamily {
+	if x != nil {
+		return x.DnsLookupFamily
+	}
+	return Networking_Outbound_UNSPECIFIED
+}
+
+// Http2 configures keepalive PING frames on the upstream connections
+// that use HTTP/2.
+type ConnectionPool_Conf_Http2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// KeepaliveInterval is the interval between the PING frames sent on
+	// idle connections.
+	KeepaliveInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=keepalive_interval,json=keepaliveInterval,proto3" json:"keepalive_interval,omitempty"`
+	// KeepaliveTimeout is how long to wait for the acknowledgement of a
+	// PING frame before closing the connection.
+	KeepaliveTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=keepalive_timeout,json=keepaliveTimeout,proto3" json:"keepalive_timeout,omitempty"`
+}
+
+func (x *ConnectionPool_Conf_Http2) Reset() {
+	*x = ConnectionPool_Conf_Http2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_connection_pool_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionPool_Conf_Http2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionPool_Conf_Http2) ProtoMessage() {}
+
+func (x *ConnectionPool_Conf_Http2) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_connection_pool_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionPool_Conf_Http2.ProtoReflect.Descriptor instead.
+func (*ConnectionPool_Conf_Http2) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_connection_pool_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *ConnectionPool_Conf_Http2) GetKeepaliveInterval() *durationpb.Duration {
+	if x != nil {
+		return x.KeepaliveInterval
+	}
+	return nil
+}
+
+func (x *ConnectionPool_Conf_Http2) GetKeepaliveTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.KeepaliveTimeout
+	}
+	return nil
+}
+
+// TcpKeepalive configures TCP keepalive probes on the upstream
+// connections.
+type ConnectionPool_Conf_TcpKeepalive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Probes is the number of unacknowledged probes after which the
+	// connection is considered dead.
+	Probes *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=probes,proto3" json:"probes,omitempty"`
+	// Time is how long a connection has to be idle before probes are
+	// sent.
+	Time *durationpb.Duration `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Interval is the time between probes.
+	Interval *durationpb.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *ConnectionPool_Conf_TcpKeepalive) Reset() {
+	*x = ConnectionPool_Conf_TcpKeepalive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_connection_pool_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionPool_Conf_TcpKeepalive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionPool_Conf_TcpKeepalive) ProtoMessage() {}
+
+func (x *ConnectionPool_Conf_TcpKeepalive) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_connection_pool_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionPool_Conf_TcpKeepalive.ProtoReflect.Descriptor instead.
+func (*ConnectionPool_Conf_TcpKeepalive) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_connection_pool_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *ConnectionPool_Conf_TcpKeepalive) GetProbes() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+func (x *ConnectionPool_Conf_TcpKeepalive) GetTime() *durationpb.Duration {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ConnectionPool_Conf_TcpKeepalive) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_connection_pool_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_connection_pool_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d,
+	0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x08, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x04,
+	0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a,
+	0xfb, 0x05, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x5b, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x18, 0x6d, 0x61, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x68, 0x74, 0x74, 0x70, 0x32, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x32, 0x52, 0x05, 0x68, 0x74, 0x74, 0x70, 0x32, 0x12, 0x59, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f,
+	0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x63, 0x70, 0x4b, 0x65, 0x65, 0x70,
+	0x61, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c,
+	0x69, 0x76, 0x65, 0x12, 0x63, 0x0a, 0x11, 0x64, 0x6e, 0x73, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x44, 0x6e, 0x73, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x0f, 0x64, 0x6e, 0x73, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x1a, 0xa5, 0x01, 0x0a, 0x05, 0x48, 0x74, 0x74,
+	0x70, 0x32, 0x12, 0x4e, 0x0a, 0x12, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
+	0x11, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x4c, 0x0a, 0x11, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x10,
+	0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x1a, 0xaa, 0x01, 0x0a, 0x0c, 0x54, 0x63, 0x70, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76,
+	0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x06, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x3a, 0x4b, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x45, 0x3a, 0x11, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x6f, 0x6f, 0x6c, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c,
+	0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x52, 0x02, 0x10, 0x01, 0x42, 0x53, 0x5a, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f,
+	0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x25, 0x50, 0x01, 0xa2, 0x01, 0x0e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0xf2, 0x01, 0x0f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x6f, 0x6f, 0x6c, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_connection_pool_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_connection_pool_proto_rawDescData = file_mesh_v1alpha1_connection_pool_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_connection_pool_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_connection_pool_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_connection_pool_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_connection_pool_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_connection_pool_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_connection_pool_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_mesh_v1alpha1_connection_pool_proto_goTypes = []interface{}{
+	(*ConnectionPool)(nil),                   // 0: kuma.mesh.v1alpha1.ConnectionPool
+	(*ConnectionPool_Conf)(nil),              // 1: kuma.mesh.v1alpha1.ConnectionPool.Conf
+	(*ConnectionPool_Conf_Http2)(nil),        // 2: kuma.mesh.v1alpha1.ConnectionPool.Conf.Http2
+	(*ConnectionPool_Conf_TcpKeepalive)(nil), // 3: kuma.mesh.v1alpha1.ConnectionPool.Conf.TcpKeepalive
+	(*Selector)(nil),                         // 4: kuma.mesh.v1alpha1.Selector
+	(*wrapperspb.UInt32Value)(nil),           // 5: google.protobuf.UInt32Value
+	(*durationpb.Duration)(nil),              // 6: google.protobuf.Duration
+	(Networking_Outbound_DnsLookupFamily)(0), // 7: kuma.mesh.v1alpha1.Networking.Outbound.DnsLookupFamily
+}
+var file_mesh_v1alpha1_connection_pool_proto_depIdxs = []int32{
+	4,  // 0: kuma.mesh.v1alpha1.ConnectionPool.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	4,  // 1: kuma.mesh.v1alpha1.ConnectionPool.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	1,  // 2: kuma.mesh.v1alpha1.ConnectionPool.conf:type_name -> kuma.mesh.v1alpha1.ConnectionPool.Conf
+	5,  // 3: kuma.mesh.v1alpha1.ConnectionPool.Conf.max_requests_per_connection:type_name -> google.protobuf.UInt32Value
+	6,  // 4: kuma.mesh.v1alpha1.ConnectionPool.Conf.idle_timeout:type_name -> google.protobuf.Duration
+	2,  // 5: kuma.mesh.v1alpha1.ConnectionPool.Conf.http2:type_name -> kuma.mesh.v1alpha1.ConnectionPool.Conf.Http2
+	3,  // 6: kuma.mesh.v1alpha1.ConnectionPool.Conf.tcp_keepalive:type_name -> kuma.mesh.v1alpha1.ConnectionPool.Conf.TcpKeepalive
+	7,  // 7: kuma.mesh.v1alpha1.ConnectionPool.Conf.dns_lookup_family:type_name -> kuma.mesh.v1alpha1.Networking.Outbound.DnsLookupFamily
+	6,  // 8: kuma.mesh.v1alpha1.ConnectionPool.Conf.Http2.keepalive_interval:type_name -> google.protobuf.Duration
+	6,  // 9: kuma.mesh.v1alpha1.ConnectionPool.Conf.Http2.keepalive_timeout:type_name -> google.protobuf.Duration
+	5,  // 10: kuma.mesh.v1alpha1.ConnectionPool.Conf.TcpKeepalive.probes:type_name -> google.protobuf.UInt32Value
+	6,  // 11: kuma.mesh.v1alpha1.ConnectionPool.Conf.TcpKeepalive.time:type_name -> google.protobuf.Duration
+	6,  // 12: kuma.mesh.v1alpha1.ConnectionPool.Conf.TcpKeepalive.interval:type_name -> google.protobuf.Duration
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_connection_pool_proto_init() }
+func file_mesh_v1alpha1_connection_pool_proto_init() {
+	if File_mesh_v1alpha1_connection_pool_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	file_mesh_v1alpha1_mesh_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_connection_pool_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionPool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_connection_pool_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionPool_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_connection_pool_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionPool_Conf_Http2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_connection_pool_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionPool_Conf_TcpKeepalive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_connection_pool_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_connection_pool_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_connection_pool_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_connection_pool_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_connection_pool_proto = out.File
+	file_mesh_v1alpha1_connection_pool_proto_rawDesc = nil
+	file_mesh_v1alpha1_connection_pool_proto_goTypes = nil
+	file_mesh_v1alpha1_connection_pool_proto_depIdxs = nil
+}
@@ -135,6 +135,14 @@ func (n *Dataplane_Networking) GetInboundInterfaces() ([]InboundInterface, error
 	return ifaces, nil
 }
 
+// InboundPortRangeAddress returns the address on which the ports of the inbound port range are exposed.
+func (n *Dataplane_Networking) InboundPortRangeAddress(portRange *Dataplane_Networking_InboundPortRange) string {
+	if portRange.GetAddress() != "" {
+		return portRange.GetAddress()
+	}
+	return n.GetAddress()
+}
+
 func (n *Dataplane_Networking) ToInboundInterface(inbound *Dataplane_Networking_Inbound) InboundInterface {
 	iface := InboundInterface{
 		DataplanePort: inbound.Port,
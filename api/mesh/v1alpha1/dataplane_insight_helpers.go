@@ -12,6 +12,18 @@ import (
 
 var _ generic.Insight = &DataplaneInsight{}
 
+// Reasons why a Dataplane disconnected from the Control Plane.
+const (
+	// DisconnectReasonStreamClosed means that the ADS stream was closed.
+	DisconnectReasonStreamClosed = "StreamClosed"
+	// DisconnectReasonIdleTimeout means that the Control Plane instance that
+	// handled the subscription stopped updating it, e.g. because it was killed.
+	DisconnectReasonIdleTimeout = "IdleTimeout"
+	// DisconnectReasonReplaced means that the subscription wasn't closed
+	// when the Dataplane opened a new one.
+	DisconnectReasonReplaced = "Replaced"
+)
+
 func NewSubscriptionStatus() *DiscoverySubscriptionStatus {
 	return &DiscoverySubscriptionStatus{
 		Total: &DiscoveryServiceStats{},
@@ -88,6 +100,7 @@ func (x *DataplaneInsight) UpdateSubscription(s generic.Subscription) error {
 		x.Subscriptions[i] = discoverySubscription
 	} else {
 		x.finalizeSubscriptions()
+		x.countSubscription(discoverySubscription)
 		x.Subscriptions = append(x.Subscriptions, discoverySubscription)
 	}
 	return nil
@@ -101,8 +114,119 @@ func (x *DataplaneInsight) finalizeSubscriptions() {
 	for _, subscription := range x.GetSubscriptions() {
 		if subscription.DisconnectTime == nil {
 			subscription.DisconnectTime = now
+			subscription.DisconnectReason = DisconnectReasonReplaced
+		}
+	}
+}
+
+// countSubscription accounts for a new subscription in the summary. Insights
+// created before the summary existed start it from their subscriptions.
+func (x *DataplaneInsight) countSubscription(s *DiscoverySubscription) {
+	if x.SubscriptionSummary == nil {
+		x.SubscriptionSummary = &SubscriptionSummary{
+			ConnectCount: uint32(len(x.GetSubscriptions())),
+		}
+		if len(x.GetSubscriptions()) > 0 {
+			x.SubscriptionSummary.FirstConnectTime = x.GetSubscriptions()[0].GetConnectTime()
 		}
 	}
+	x.SubscriptionSummary.ConnectCount++
+	if x.SubscriptionSummary.FirstConnectTime == nil {
+		x.SubscriptionSummary.FirstConnectTime = s.GetConnectTime()
+	}
+}
+
+// PruneSubscriptions keeps at most limit subscriptions, and drops the ones
+// that disconnected more than maxAge ago. The most recent subscription is
+// always kept. A limit or a maxAge of 0 means no limit. The pruned
+// subscriptions are accounted for in the summary.
+func (x *DataplaneInsight) PruneSubscriptions(limit int, maxAge time.Duration, now time.Time) {
+	subscriptions := x.GetSubscriptions()
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	keepFrom := 0
+	if limit > 0 && len(subscriptions) > limit {
+		keepFrom = len(subscriptions) - limit
+	}
+	if maxAge > 0 {
+		for keepFrom < len(subscriptions)-1 {
+			s := subscriptions[keepFrom]
+			if s.GetDisconnectTime() == nil || now.Sub(s.GetDisconnectTime().AsTime()) <= maxAge {
+				break
+			}
+			keepFrom++
+		}
+	}
+	if keepFrom == 0 {
+		return
+	}
+
+	if x.SubscriptionSummary == nil {
+		x.SubscriptionSummary = &SubscriptionSummary{
+			ConnectCount:     uint32(len(subscriptions)),
+			FirstConnectTime: subscriptions[0].GetConnectTime(),
+		}
+	}
+	summary := x.SubscriptionSummary
+	connected := summary.GetPrunedConnectedDuration().AsDuration()
+	for _, s := range subscriptions[:keepFrom] {
+		connected += s.connectedDuration(now)
+		if s.GetDisconnectTime() != nil {
+			summary.LastDisconnectTime = s.GetDisconnectTime()
+			summary.LastDisconnectReason = s.GetDisconnectReason()
+		}
+	}
+	summary.PrunedConnectedDuration = util_proto.Duration(connected)
+
+	x.Subscriptions = subscriptions[keepFrom:]
+}
+
+// Uptime returns the percentage of the time since the Dataplane first
+// connected that it was connected to the Control Plane.
+func (x *DataplaneInsight) Uptime(now time.Time) float64 {
+	first := x.GetSubscriptionSummary().GetFirstConnectTime()
+	if first == nil && len(x.GetSubscriptions()) > 0 {
+		first = x.GetSubscriptions()[0].GetConnectTime()
+	}
+	if first == nil {
+		return 0
+	}
+	total := now.Sub(first.AsTime())
+	if total <= 0 {
+		return 0
+	}
+
+	connected := x.GetSubscriptionSummary().GetPrunedConnectedDuration().AsDuration()
+	for _, s := range x.GetSubscriptions() {
+		connected += s.connectedDuration(now)
+	}
+	if connected > total {
+		connected = total
+	}
+	return 100 * float64(connected) / float64(total)
+}
+
+// GetConnectCount returns the number of times the Dataplane connected to
+// the Control Plane.
+func (x *DataplaneInsight) GetConnectCount() uint32 {
+	if x.GetSubscriptionSummary() == nil {
+		return uint32(len(x.GetSubscriptions()))
+	}
+	return x.GetSubscriptionSummary().GetConnectCount()
+}
+
+// GetLastDisconnectReason returns the reason of the last disconnection of
+// the Dataplane, or an empty string if it never disconnected.
+func (x *DataplaneInsight) GetLastDisconnectReason() string {
+	subscriptions := x.GetSubscriptions()
+	for i := len(subscriptions) - 1; i >= 0; i-- {
+		if subscriptions[i].GetDisconnectTime() != nil {
+			return subscriptions[i].GetDisconnectReason()
+		}
+	}
+	return x.GetSubscriptionSummary().GetLastDisconnectReason()
 }
 
 // todo(lobkovilya): delete GetLatestSubscription, use GetLastSubscription instead
@@ -132,10 +256,46 @@ func (x *DataplaneInsight) GetLastSubscription() generic.Subscription {
 	return x.GetSubscriptions()[len(x.GetSubscriptions())-1]
 }
 
+// MaxResourceUsageSamples is the number of the most recent resource usage samples kept in DataplaneInsight.
+const MaxResourceUsageSamples = 10
+
+// AddResourceUsage appends a sample of the resource usage and drops the oldest ones
+// so that at most MaxResourceUsageSamples are kept.
+func (x *DataplaneInsight) AddResourceUsage(u *ResourceUsage) {
+	x.ResourceUsage = append(x.ResourceUsage, u)
+	if excess := len(x.ResourceUsage) - MaxResourceUsageSamples; excess > 0 {
+		x.ResourceUsage = x.ResourceUsage[excess:]
+	}
+}
+
+// GetLatestResourceUsage returns the most recent sample of the resource usage or nil if there is none.
+func (x *DataplaneInsight) GetLatestResourceUsage() *ResourceUsage {
+	if len(x.GetResourceUsage()) == 0 {
+		return nil
+	}
+	return x.GetResourceUsage()[len(x.GetResourceUsage())-1]
+}
+
 func (x *DiscoverySubscription) SetDisconnectTime(t time.Time) {
 	x.DisconnectTime = util_proto.MustTimestampProto(t)
 }
 
+// connectedDuration returns how long the subscription was connected, up to
+// now if it is still connected.
+func (x *DiscoverySubscription) connectedDuration(now time.Time) time.Duration {
+	if x.GetConnectTime() == nil {
+		return 0
+	}
+	end := now
+	if x.GetDisconnectTime() != nil {
+		end = x.GetDisconnectTime().AsTime()
+	}
+	if d := end.Sub(x.GetConnectTime().AsTime()); d > 0 {
+		return d
+	}
+	return 0
+}
+
 func (x *DataplaneInsight) Sum(v func(*DiscoverySubscription) uint64) uint64 {
 	var result uint64 = 0
 	for _, s := range x.GetSubscriptions() {
@@ -174,3 +334,9 @@ func (s *DiscoverySubscriptionStatus) StatsOf(typeUrl string) *DiscoveryServiceS
 		return &DiscoveryServiceStats{}
 	}
 }
+
+// IsFIPS returns true if Envoy was built with the FIPS validated BoringSSL module,
+// e.g. "<sha>/1.18.3/Clean/RELEASE/BoringSSL-FIPS".
+func (x *EnvoyVersion) IsFIPS() bool {
+	return strings.HasSuffix(x.GetBuild(), "/BoringSSL-FIPS")
+}
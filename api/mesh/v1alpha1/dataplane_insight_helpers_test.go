@@ -41,6 +41,8 @@ var _ = Describe("DataplaneHelpers", func() {
 
 				// then
 				Expect(util_proto.ToYAML(status)).To(MatchYAML(`
+                subscriptionSummary:
+                  connectCount: 1
                 subscriptions:
                 - controlPlaneInstanceId: node-001
                   id: "1"
@@ -152,6 +154,110 @@ var _ = Describe("DataplaneHelpers", func() {
 			})
 		})
 
+		Describe("PruneSubscriptions()", func() {
+
+			var now time.Time
+
+			BeforeEach(func() {
+				now = t1.Add(10 * time.Hour)
+				status.Subscriptions = []*DiscoverySubscription{
+					{
+						Id:               "1",
+						ConnectTime:      util_proto.MustTimestampProto(t1),
+						DisconnectTime:   util_proto.MustTimestampProto(t1.Add(1 * time.Hour)),
+						DisconnectReason: DisconnectReasonIdleTimeout,
+					},
+					{
+						Id:               "2",
+						ConnectTime:      util_proto.MustTimestampProto(t1.Add(2 * time.Hour)),
+						DisconnectTime:   util_proto.MustTimestampProto(t1.Add(5 * time.Hour)),
+						DisconnectReason: DisconnectReasonStreamClosed,
+					},
+					{
+						Id:          "3",
+						ConnectTime: util_proto.MustTimestampProto(t1.Add(6 * time.Hour)),
+					},
+				}
+			})
+
+			It("should keep at most limit subscriptions", func() {
+				// when
+				status.PruneSubscriptions(1, 0, now)
+
+				// then
+				Expect(status.Subscriptions).To(HaveLen(1))
+				Expect(status.Subscriptions[0].Id).To(Equal("3"))
+				// and
+				Expect(status.GetConnectCount()).To(Equal(uint32(3)))
+				Expect(status.SubscriptionSummary.FirstConnectTime.AsTime()).To(Equal(t1))
+				Expect(status.SubscriptionSummary.PrunedConnectedDuration.AsDuration()).To(Equal(4 * time.Hour))
+				Expect(status.GetLastDisconnectReason()).To(Equal(DisconnectReasonStreamClosed))
+			})
+
+			It("should drop subscriptions that disconnected more than max age ago", func() {
+				// when
+				status.PruneSubscriptions(0, 6*time.Hour, now)
+
+				// then
+				Expect(status.Subscriptions).To(HaveLen(2))
+				Expect(status.Subscriptions[0].Id).To(Equal("2"))
+				Expect(status.SubscriptionSummary.PrunedConnectedDuration.AsDuration()).To(Equal(1 * time.Hour))
+				Expect(status.SubscriptionSummary.LastDisconnectReason).To(Equal(DisconnectReasonIdleTimeout))
+			})
+
+			It("should always keep the last subscription", func() {
+				// given
+				status.Subscriptions[2].DisconnectTime = util_proto.MustTimestampProto(t1.Add(7 * time.Hour))
+
+				// when
+				status.PruneSubscriptions(0, time.Minute, now)
+
+				// then
+				Expect(status.Subscriptions).To(HaveLen(1))
+				Expect(status.Subscriptions[0].Id).To(Equal("3"))
+			})
+
+			It("should not change the subscriptions within the limits", func() {
+				// when
+				status.PruneSubscriptions(3, 24*time.Hour, now)
+
+				// then
+				Expect(status.Subscriptions).To(HaveLen(3))
+				Expect(status.SubscriptionSummary).To(BeNil())
+			})
+		})
+
+		Describe("Uptime()", func() {
+
+			It("should return `0` when there are no subscriptions", func() {
+				Expect(status.Uptime(t1)).To(Equal(0.0))
+			})
+
+			It("should account for the pruned subscriptions", func() {
+				// given
+				status.Subscriptions = []*DiscoverySubscription{
+					{
+						Id:             "1",
+						ConnectTime:    util_proto.MustTimestampProto(t1),
+						DisconnectTime: util_proto.MustTimestampProto(t1.Add(3 * time.Hour)),
+					},
+					{
+						Id:          "2",
+						ConnectTime: util_proto.MustTimestampProto(t1.Add(5 * time.Hour)),
+					},
+				}
+				now := t1.Add(10 * time.Hour)
+				uptime := status.Uptime(now)
+
+				// when
+				status.PruneSubscriptions(1, 0, now)
+
+				// then
+				Expect(uptime).To(BeNumerically("~", 80.0, 0.001))
+				Expect(status.Uptime(now)).To(BeNumerically("~", 80.0, 0.001))
+			})
+		})
+
 		Describe("GetLatestSubscription()", func() {
 
 			It("should return `nil` when there are no subscriptions", func() {
@@ -192,6 +298,34 @@ var _ = Describe("DataplaneHelpers", func() {
 			})
 		})
 
+		Describe("AddResourceUsage()", func() {
+
+			It("should keep only the most recent samples", func() {
+				// given
+				status.ResourceUsage = nil
+
+				// when
+				for i := 0; i < MaxResourceUsageSamples+2; i++ {
+					status.AddResourceUsage(&ResourceUsage{
+						ActiveConnections: uint64(i),
+					})
+				}
+
+				// then
+				Expect(status.ResourceUsage).To(HaveLen(MaxResourceUsageSamples))
+				Expect(status.ResourceUsage[0].ActiveConnections).To(Equal(uint64(2)))
+				Expect(status.GetLatestResourceUsage().ActiveConnections).To(Equal(uint64(MaxResourceUsageSamples + 1)))
+			})
+
+			It("should return `nil` when there are no samples", func() {
+				// given
+				status.ResourceUsage = nil
+
+				// expect
+				Expect(status.GetLatestResourceUsage()).To(BeNil())
+			})
+		})
+
 		Describe("Sum()", func() {
 
 			It("should return `0` when there are no subscriptions", func() {
@@ -328,4 +462,40 @@ var _ = Describe("DataplaneHelpers", func() {
 			})
 		})
 	})
+
+	Describe("EnvoyVersion", func() {
+
+		Describe("IsFIPS()", func() {
+
+			It("should detect FIPS build", func() {
+				// given
+				version := &EnvoyVersion{
+					Version: "1.18.3",
+					Build:   "98c1c9e9a40804b93b074badad1cdf284b47d58b/1.18.3/Clean/RELEASE/BoringSSL-FIPS",
+				}
+
+				// expect
+				Expect(version.IsFIPS()).To(BeTrue())
+			})
+
+			It("should not detect FIPS in regular build", func() {
+				// given
+				version := &EnvoyVersion{
+					Version: "1.18.3",
+					Build:   "98c1c9e9a40804b93b074badad1cdf284b47d58b/1.18.3/Clean/RELEASE/BoringSSL",
+				}
+
+				// expect
+				Expect(version.IsFIPS()).To(BeFalse())
+			})
+
+			It("should not fail on nil version", func() {
+				// given
+				var version *EnvoyVersion
+
+				// expect
+				Expect(version.IsFIPS()).To(BeFalse())
+			})
+		})
+	})
 })
@@ -12,6 +12,8 @@ import (
 	v1alpha1 "github.com/kumahq/kuma/api/system/v1alpha1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -90,6 +92,10 @@ const (
 	Gateway_Listener_TLS   Gateway_Listener_Protocol = 3
 	Gateway_Listener_HTTP  Gateway_Listener_Protocol = 4
 	Gateway_Listener_HTTPS Gateway_Listener_Protocol = 5
+	// GRPC listeners accept gRPC requests over cleartext HTTP/2 and
+	// route them with HTTP routes. gRPC over TLS is served by HTTPS
+	// listeners.
+	Gateway_Listener_GRPC Gateway_Listener_Protocol = 6
 )
 
 // Enum value maps for Gateway_Listener_Protocol.
@@ -101,6 +107,7 @@ var (
 		3: "TLS",
 		4: "HTTP",
 		5: "HTTPS",
+		6: "GRPC",
 	}
 	Gateway_Listener_Protocol_value = map[string]int32{
 		"NONE":  0,
@@ -109,6 +116,7 @@ var (
 		"TLS":   3,
 		"HTTP":  4,
 		"HTTPS": 5,
+		"GRPC":  6,
 	}
 )
 
@@ -139,11 +147,66 @@ func (Gateway_Listener_Protocol) EnumDescriptor() ([]byte, []int) {
 	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 0}
 }
 
+type Gateway_Listener_Paths_PrefixMatch int32
+
+const (
+	// COMPONENTS prefixes match whole path components, like the
+	// Kubernetes Ingress and Gateway APIs, e.g. "/api" matches
+	// "/api" and "/api/users" but not "/apis".
+	Gateway_Listener_Paths_COMPONENTS Gateway_Listener_Paths_PrefixMatch = 0
+	// BYTES prefixes match the beginning of the path, e.g. "/api"
+	// also matches "/apis".
+	Gateway_Listener_Paths_BYTES Gateway_Listener_Paths_PrefixMatch = 1
+)
+
+// Enum value maps for Gateway_Listener_Paths_PrefixMatch.
+var (
+	Gateway_Listener_Paths_PrefixMatch_name = map[int32]string{
+		0: "COMPONENTS",
+		1: "BYTES",
+	}
+	Gateway_Listener_Paths_PrefixMatch_value = map[string]int32{
+		"COMPONENTS": 0,
+		"BYTES":      1,
+	}
+)
+
+func (x Gateway_Listener_Paths_PrefixMatch) Enum() *Gateway_Listener_Paths_PrefixMatch {
+	p := new(Gateway_Listener_Paths_PrefixMatch)
+	*p = x
+	return p
+}
+
+func (x Gateway_Listener_Paths_PrefixMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Gateway_Listener_Paths_PrefixMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_gateway_proto_enumTypes[2].Descriptor()
+}
+
+func (Gateway_Listener_Paths_PrefixMatch) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_gateway_proto_enumTypes[2]
+}
+
+func (x Gateway_Listener_Paths_PrefixMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Gateway_Listener_Paths_PrefixMatch.Descriptor instead.
+func (Gateway_Listener_Paths_PrefixMatch) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 9, 0}
+}
+
 // Gateway is a virtual proxy.
 //
 // Each Gateway is bound to a set of builtin gateway dataplanes.
-// Each builtin dataplane instance can host exactly one Gateway
-// proxy configuration.
+// A builtin dataplane instance hosts all the Gateways that select
+// it. Their listeners are merged in order of precedence: Gateways
+// with more specific selectors first, then Gateways created first.
+// Listeners that can't be merged with the listeners of a Gateway
+// that takes precedence on the same port are not configured, and
+// the conflict is reported in the GatewayInsight of the Gateway.
 //
 // Gateway aligns with the Kubernetes Gateway API v1alpha2. See that
 // spec for detailed documentation.
@@ -266,6 +329,13 @@ type Gateway_Listener struct {
 	// matched. This field can be omitted for protocols that don't require
 	// hostname based matching.
 	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Hostnames specifies several virtual hostnames to match, any of
+	// which can be a wildcard domain like "*.example.com". The
+	// listener is configured once for each hostname, as if it was
+	// repeated with each of them. Hostnames can't be given together
+	// with hostname, and no hostname can be matched by several
+	// listeners on the same port.
+	Hostnames []string `protobuf:"bytes,14,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
 	// Port is the network port. Multiple listeners may use the
 	// same port, subject to the Listener compatibility rules.
 	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
@@ -273,7 +343,8 @@ type Gateway_Listener struct {
 	Protocol Gateway_Listener_Protocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=kuma.mesh.v1alpha1.Gateway_Listener_Protocol" json:"protocol,omitempty"`
 	// TLS is the TLS configuration for the Listener. This field
 	// is required if the Protocol field is "HTTPS" or "TLS" and
-	// ignored otherwise.
+	// ignored otherwise. HTTPS listeners terminate TLS, while TLS
+	// listeners pass the TLS stream through to the backends.
 	Tls *Gateway_TLS_Conf `protobuf:"bytes,4,opt,name=tls,proto3" json:"tls,omitempty"`
 	// Tags specifies a unique combination of tags that routes can use
 	// to match themselves to this listener.
@@ -283,6 +354,44 @@ type Gateway_Listener struct {
 	// gateway tags and the listener tags. A route will be attached to the
 	// listener if all of the route's tags are preset in the matching tags
 	Tags map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// RateLimit is the rate limit of the requests to the listener.
+	RateLimit *Gateway_Listener_RateLimit `protobuf:"bytes,6,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// CrossMesh exposes the listener to the data plane proxies of all
+	// the meshes that have mutual TLS enabled. Clients connect over
+	// the mutual TLS of their own mesh and are verified by its CA.
+	// Cross-mesh listeners must use the HTTP protocol, and the mesh of
+	// the gateway must have mutual TLS enabled.
+	CrossMesh bool `protobuf:"varint,7,opt,name=cross_mesh,json=crossMesh,proto3" json:"cross_mesh,omitempty"`
+	// Security is the browser security configuration of the listener.
+	Security *Gateway_Listener_Security `protobuf:"bytes,8,opt,name=security,proto3" json:"security,omitempty"`
+	// Cache is the response cache configuration of the listener.
+	Cache *Gateway_Listener_Cache `protobuf:"bytes,9,opt,name=cache,proto3" json:"cache,omitempty"`
+	// AccessLog is the access log configuration of the listener.
+	AccessLog *Gateway_Listener_AccessLog `protobuf:"bytes,10,opt,name=access_log,json=accessLog,proto3" json:"access_log,omitempty"`
+	// Waf is the web application firewall configuration of the
+	// listener. Listeners on the same port must have the same
+	// configuration.
+	Waf *Gateway_Listener_Waf `protobuf:"bytes,11,opt,name=waf,proto3" json:"waf,omitempty"`
+	// Jwt is the JWT authentication configuration of the listener.
+	// Listeners on the same port must have the same configuration.
+	Jwt *Gateway_Listener_Jwt `protobuf:"bytes,12,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	// Limits are the connection limits and timeouts of the listener.
+	// Listeners on the same port must have the same configuration.
+	Limits *Gateway_Listener_Limits `protobuf:"bytes,13,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Oidc is the OpenID Connect authentication of the listener.
+	// Listeners on the same port must have the same configuration.
+	Oidc *Gateway_Listener_Oidc `protobuf:"bytes,15,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	// Paths configures the path handling of the listener. Listeners
+	// on the same port must have the same configuration.
+	Paths *Gateway_Listener_Paths `protobuf:"bytes,16,opt,name=paths,proto3" json:"paths,omitempty"`
+	// XffNumTrustedHops is the number of proxies in front of the
+	// gateway, such as load balancers, that append the address of their
+	// client to the X-Forwarded-For header. The client address of a
+	// request is then the address that the outermost of these proxies
+	// appended, rather than the source address of the connection. It
+	// can only be set on HTTP, HTTPS and GRPC listeners, and listeners
+	// on the same port must have the same value.
+	XffNumTrustedHops uint32 `protobuf:"varint,17,opt,name=xff_num_trusted_hops,json=xffNumTrustedHops,proto3" json:"xff_num_trusted_hops,omitempty"`
 }
 
 func (x *Gateway_Listener) Reset() {
@@ -324,6 +433,13 @@ func (x *Gateway_Listener) GetHostname() string {
 	return ""
 }
 
+func (x *Gateway_Listener) GetHostnames() []string {
+	if x != nil {
+		return x.Hostnames
+	}
+	return nil
+}
+
 func (x *Gateway_Listener) GetPort() uint32 {
 	if x != nil {
 		return x.Port
@@ -352,6 +468,138 @@ func (x *Gateway_Listener) GetTags() map[string]string {
 	return nil
 }
 
+func (x *Gateway_Listener) GetRateLimit() *Gateway_Listener_RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *Gateway_Listener) GetCrossMesh() bool {
+	if x != nil {
+		return x.CrossMesh
+	}
+	return false
+}
+
+func (x *Gateway_Listener) GetSecurity() *Gateway_Listener_Security {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+func (x *Gateway_Listener) GetCache() *Gateway_Listener_Cache {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *Gateway_Listener) GetAccessLog() *Gateway_Listener_AccessLog {
+	if x != nil {
+		return x.AccessLog
+	}
+	return nil
+}
+
+func (x *Gateway_Listener) GetWaf() *Gateway_Listener_Waf {
+	if x != nil {
+		return x.Waf
+	}
+	return nil
+}
+
+func (x *Gateway_Listener) GetJwt() *Gateway_Listener_Jwt {
+	if x != nil {
+		return x.Jwt
+	}
+	return nil
+}
+
+func (x *Gateway_Listener) GetLimits() *Gateway_Listener_Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Gateway_Listener) GetOidc() *Gateway_Listener_Oidc {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *Gateway_Listener) GetPaths() *Gateway_Listener_Paths {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *Gateway_Listener) GetXffNumTrustedHops() uint32 {
+	if x != nil {
+		return x.XffNumTrustedHops
+	}
+	return 0
+}
+
+// ExplicitRoutes requires each hostname to be listed in the hostnames
+// of a GatewayRoute before it is served by the Gateway. Routes
+// without hostnames, or with hostnames that only match by a wildcard,
+// are not applied. Requests that aren't matched by any route are
+// rejected.
+type Gateway_ExplicitRoutes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status is the HTTP status code of the response to requests
+	// that aren't matched by any route. It must be 403 or 404, the
+	// default is 404.
+	Status uint32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *Gateway_ExplicitRoutes) Reset() {
+	*x = Gateway_ExplicitRoutes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_ExplicitRoutes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_ExplicitRoutes) ProtoMessage() {}
+
+func (x *Gateway_ExplicitRoutes) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_ExplicitRoutes.ProtoReflect.Descriptor instead.
+func (*Gateway_ExplicitRoutes) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Gateway_ExplicitRoutes) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
 // Conf defines the desired state of Gateway.
 //
 // Aligns with GatewaySpec.
@@ -363,12 +611,15 @@ type Gateway_Conf struct {
 	// Listeners define logical endpoints that are bound on this Gateway's
 	// address(es).
 	Listeners []*Gateway_Listener `protobuf:"bytes,2,rep,name=listeners,proto3" json:"listeners,omitempty"`
+	// ExplicitRoutes, when it is set, denies requests for hostnames
+	// that aren't explicitly covered by GatewayRoutes.
+	ExplicitRoutes *Gateway_ExplicitRoutes `protobuf:"bytes,3,opt,name=explicit_routes,json=explicitRoutes,proto3" json:"explicit_routes,omitempty"`
 }
 
 func (x *Gateway_Conf) Reset() {
 	*x = Gateway_Conf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[3]
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -381,7 +632,7 @@ func (x *Gateway_Conf) String() string {
 func (*Gateway_Conf) ProtoMessage() {}
 
 func (x *Gateway_Conf) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[3]
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,7 +645,7 @@ func (x *Gateway_Conf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Gateway_Conf.ProtoReflect.Descriptor instead.
 func (*Gateway_Conf) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 2}
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 3}
 }
 
 func (x *Gateway_Conf) GetListeners() []*Gateway_Listener {
@@ -404,16 +655,28 @@ func (x *Gateway_Conf) GetListeners() []*Gateway_Listener {
 	return nil
 }
 
+func (x *Gateway_Conf) GetExplicitRoutes() *Gateway_ExplicitRoutes {
+	if x != nil {
+		return x.ExplicitRoutes
+	}
+	return nil
+}
+
 type Gateway_TLS_Options struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ClientCertificateAuthority is a reference to a object that
+	// contains PEM encoded CA certificates. When it is set, clients
+	// must present a certificate signed by one of these authorities.
+	ClientCertificateAuthority *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=client_certificate_authority,json=clientCertificateAuthority,proto3" json:"client_certificate_authority,omitempty"`
 }
 
 func (x *Gateway_TLS_Options) Reset() {
 	*x = Gateway_TLS_Options{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[5]
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -426,7 +689,7 @@ func (x *Gateway_TLS_Options) String() string {
 func (*Gateway_TLS_Options) ProtoMessage() {}
 
 func (x *Gateway_TLS_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[5]
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,6 +705,74 @@ func (*Gateway_TLS_Options) Descriptor() ([]byte, []int) {
 	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 0, 0}
 }
 
+func (x *Gateway_TLS_Options) GetClientCertificateAuthority() *v1alpha1.DataSource {
+	if x != nil {
+		return x.ClientCertificateAuthority
+	}
+	return nil
+}
+
+// Certificate is a TLS certificate and private key that is served
+// to the clients that request one of its hostnames with SNI.
+type Gateway_TLS_Certificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hostnames are the SNI names the certificate is served for. Any
+	// of them can be a wildcard domain like "*.example.com".
+	Hostnames []string `protobuf:"bytes,1,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
+	// Certificate is a reference to a object that contains a TLS
+	// certificate and private key.
+	Certificate *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+}
+
+func (x *Gateway_TLS_Certificate) Reset() {
+	*x = Gateway_TLS_Certificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_TLS_Certificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_TLS_Certificate) ProtoMessage() {}
+
+func (x *Gateway_TLS_Certificate) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_TLS_Certificate.ProtoReflect.Descriptor instead.
+func (*Gateway_TLS_Certificate) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *Gateway_TLS_Certificate) GetHostnames() []string {
+	if x != nil {
+		return x.Hostnames
+	}
+	return nil
+}
+
+func (x *Gateway_TLS_Certificate) GetCertificate() *v1alpha1.DataSource {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
 // Aligns with GatewayTLSConfig.
 type Gateway_TLS_Conf struct {
 	state         protoimpl.MessageState
@@ -455,7 +786,21 @@ type Gateway_TLS_Conf struct {
 	// certificate and private key. This certificate is used to establish
 	// a TLS session for requests that match the hostname of the associated
 	// listener.
+	//
+	// Certificates in secrets are delivered to the data plane proxies
+	// over SDS, so updating the secret rotates the certificate without
+	// draining the connections of the listener. On Universal, certificate
+	// files are read by the data plane proxy, which reloads them when
+	// they change, so the files must be on the host of the proxy.
 	Certificate *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// Certificates are the certificates of a listener that serves
+	// several hostnames with different certificates. The certificate
+	// that is served is selected by the SNI name the client requests,
+	// exact hostnames taking precedence over wildcard ones. Clients
+	// that request a name that none of the certificates is served for
+	// get the certificate above, which can then be omitted if all the
+	// clients are expected to request one of the names.
+	Certificates []*Gateway_TLS_Certificate `protobuf:"bytes,4,rep,name=certificates,proto3" json:"certificates,omitempty"`
 	// Options should eventually configure how TLS is configured. This
 	// is where cipher suite and version configuration can be specified,
 	// client certificates enforced, and so on.
@@ -465,7 +810,7 @@ type Gateway_TLS_Conf struct {
 func (x *Gateway_TLS_Conf) Reset() {
 	*x = Gateway_TLS_Conf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[6]
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -478,7 +823,7 @@ func (x *Gateway_TLS_Conf) String() string {
 func (*Gateway_TLS_Conf) ProtoMessage() {}
 
 func (x *Gateway_TLS_Conf) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[6]
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -491,7 +836,7 @@ func (x *Gateway_TLS_Conf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Gateway_TLS_Conf.ProtoReflect.Descriptor instead.
 func (*Gateway_TLS_Conf) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 0, 1}
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 0, 2}
 }
 
 func (x *Gateway_TLS_Conf) GetMode() Gateway_TLS_Mode {
@@ -508,6 +853,13 @@ func (x *Gateway_TLS_Conf) GetCertificate() *v1alpha1.DataSource {
 	return nil
 }
 
+func (x *Gateway_TLS_Conf) GetCertificates() []*Gateway_TLS_Certificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
 func (x *Gateway_TLS_Conf) GetOptions() *Gateway_TLS_Options {
 	if x != nil {
 		return x.Options
@@ -515,148 +867,1763 @@ func (x *Gateway_TLS_Conf) GetOptions() *Gateway_TLS_Options {
 	return nil
 }
 
-var File_mesh_v1alpha1_gateway_proto protoreflect.FileDescriptor
+// RateLimit limits the rate of the requests accepted by HTTP, HTTPS
+// and GRPC listeners.
+type Gateway_Listener_RateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_mesh_v1alpha1_gateway_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b,
-	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8,
-	0x08, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05,
-	0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
-	0x12, 0x43, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x61, 0x67, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0x8c, 0x02, 0x0a, 0x03,
-	0x54, 0x4c, 0x53, 0x1a, 0x09, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xc7,
-	0x01, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x12, 0x42, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
-	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x30, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45,
-	0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x41, 0x53,
-	0x53, 0x54, 0x48, 0x52, 0x4f, 0x55, 0x47, 0x48, 0x10, 0x02, 0x1a, 0x80, 0x03, 0x0a, 0x08, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x53,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x61,
-	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x54,
-	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x37,
-	0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a,
-	0x03, 0x54, 0x43, 0x50, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x02, 0x12,
-	0x07, 0x0a, 0x03, 0x54, 0x4c, 0x53, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50,
-	0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x05, 0x1a, 0x54, 0x0a,
-	0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x4b, 0xaa, 0x8c,
-	0x89, 0xa6, 0x01, 0x11, 0x0a, 0x0f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x09, 0x12, 0x07, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68,
-	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x30, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0b, 0x3a, 0x09,
-	0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b,
-	0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// Requests is the number of requests the listener accepts per
+	// interval.
+	Requests uint32 `protobuf:"varint,1,opt,name=requests,proto3" json:"requests,omitempty"`
+	// Interval is the interval for which the requests are accounted.
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Key is the value that selects the limits of a request. It is
+	// required when limits are given.
+	Key *Gateway_Listener_RateLimit_Key `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Limits are the separate limits of the requests that match
+	// a value of the key. Requests that don't match any of them are
+	// accounted by the limit of the listener.
+	Limits []*Gateway_Listener_RateLimit_Limit `protobuf:"bytes,4,rep,name=limits,proto3" json:"limits,omitempty"`
 }
 
-var (
-	file_mesh_v1alpha1_gateway_proto_rawDescOnce sync.Once
-	file_mesh_v1alpha1_gateway_proto_rawDescData = file_mesh_v1alpha1_gateway_proto_rawDesc
-)
+func (x *Gateway_Listener_RateLimit) Reset() {
+	*x = Gateway_Listener_RateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_mesh_v1alpha1_gateway_proto_rawDescGZIP() []byte {
-	file_mesh_v1alpha1_gateway_proto_rawDescOnce.Do(func() {
-		file_mesh_v1alpha1_gateway_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_gateway_proto_rawDescData)
-	})
-	return file_mesh_v1alpha1_gateway_proto_rawDescData
+func (x *Gateway_Listener_RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_mesh_v1alpha1_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_mesh_v1alpha1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_mesh_v1alpha1_gateway_proto_goTypes = []interface{}{
-	(Gateway_TLS_Mode)(0),          // 0: kuma.mesh.v1alpha1.Gateway.TLS.Mode
-	(Gateway_Listener_Protocol)(0), // 1: kuma.mesh.v1alpha1.Gateway.Listener.Protocol
-	(*Gateway)(nil),                // 2: kuma.mesh.v1alpha1.Gateway
-	(*Gateway_TLS)(nil),            // 3: kuma.mesh.v1alpha1.Gateway.TLS
-	(*Gateway_Listener)(nil),       // 4: kuma.mesh.v1alpha1.Gateway.Listener
-	(*Gateway_Conf)(nil),           // 5: kuma.mesh.v1alpha1.Gateway.Conf
-	nil,                            // 6: kuma.mesh.v1alpha1.Gateway.TagsEntry
-	(*Gateway_TLS_Options)(nil),    // 7: kuma.mesh.v1alpha1.Gateway.TLS.Options
-	(*Gateway_TLS_Conf)(nil),       // 8: kuma.mesh.v1alpha1.Gateway.TLS.Conf
-	nil,                            // 9: kuma.mesh.v1alpha1.Gateway.Listener.TagsEntry
-	(*Selector)(nil),               // 10: kuma.mesh.v1alpha1.Selector
-	(*v1alpha1.DataSource)(nil),    // 11: kuma.system.v1alpha1.DataSource
+func (*Gateway_Listener_RateLimit) ProtoMessage() {}
+
+func (x *Gateway_Listener_RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_mesh_v1alpha1_gateway_proto_depIdxs = []int32{
-	10, // 0: kuma.mesh.v1alpha1.Gateway.selectors:type_name -> kuma.mesh.v1alpha1.Selector
-	6,  // 1: kuma.mesh.v1alpha1.Gateway.tags:type_name -> kuma.mesh.v1alpha1.Gateway.TagsEntry
-	5,  // 2: kuma.mesh.v1alpha1.Gateway.conf:type_name -> kuma.mesh.v1alpha1.Gateway.Conf
-	1,  // 3: kuma.mesh.v1alpha1.Gateway.Listener.protocol:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Protocol
-	8,  // 4: kuma.mesh.v1alpha1.Gateway.Listener.tls:type_name -> kuma.mesh.v1alpha1.Gateway.TLS.Conf
-	9,  // 5: kuma.mesh.v1alpha1.Gateway.Listener.tags:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.TagsEntry
-	4,  // 6: kuma.mesh.v1alpha1.Gateway.Conf.listeners:type_name -> kuma.mesh.v1alpha1.Gateway.Listener
-	0,  // 7: kuma.mesh.v1alpha1.Gateway.TLS.Conf.mode:type_name -> kuma.mesh.v1alpha1.Gateway.TLS.Mode
-	11, // 8: kuma.mesh.v1alpha1.Gateway.TLS.Conf.certificate:type_name -> kuma.system.v1alpha1.DataSource
-	7,  // 9: kuma.mesh.v1alpha1.Gateway.TLS.Conf.options:type_name -> kuma.mesh.v1alpha1.Gateway.TLS.Options
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+
+// Deprecated: Use Gateway_Listener_RateLimit.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_RateLimit) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 1}
 }
 
-func init() { file_mesh_v1alpha1_gateway_proto_init() }
-func file_mesh_v1alpha1_gateway_proto_init() {
-	if File_mesh_v1alpha1_gateway_proto != nil {
-		return
+func (x *Gateway_Listener_RateLimit) GetRequests() uint32 {
+	if x != nil {
+		return x.Requests
 	}
-	file_mesh_v1alpha1_selector_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_mesh_v1alpha1_gateway_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Gateway); i {
-			case 0:
+	return 0
+}
+
+func (x *Gateway_Listener_RateLimit) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_RateLimit) GetKey() *Gateway_Listener_RateLimit_Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_RateLimit) GetLimits() []*Gateway_Listener_RateLimit_Limit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// Security enables browser security features on HTTP and HTTPS
+// listeners.
+type Gateway_Listener_Security struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Csrf enables the protection against cross-site request forgery.
+	Csrf *Gateway_Listener_Security_Csrf `protobuf:"bytes,1,opt,name=csrf,proto3" json:"csrf,omitempty"`
+	// Headers enables the security headers. X-Content-Type-Options is
+	// always set to "nosniff".
+	Headers *Gateway_Listener_Security_Headers `protobuf:"bytes,2,opt,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (x *Gateway_Listener_Security) Reset() {
+	*x = Gateway_Listener_Security{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Security) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Security) ProtoMessage() {}
+
+func (x *Gateway_Listener_Security) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Security.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Security) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 2}
+}
+
+func (x *Gateway_Listener_Security) GetCsrf() *Gateway_Listener_Security_Csrf {
+	if x != nil {
+		return x.Csrf
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Security) GetHeaders() *Gateway_Listener_Security_Headers {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// Cache enables the caching of responses on HTTP and HTTPS
+// listeners. Responses are only cached when their Cache-Control
+// header allows it. The cache filter of GatewayRoutes can set the
+// header for the responses of backends that don't.
+type Gateway_Listener_Cache struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MaxBodyBytes is the size of the largest response body that is
+	// cached. The default is 1048576 (1MiB).
+	MaxBodyBytes uint32                      `protobuf:"varint,1,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty"`
+	Key          *Gateway_Listener_Cache_Key `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *Gateway_Listener_Cache) Reset() {
+	*x = Gateway_Listener_Cache{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Cache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Cache) ProtoMessage() {}
+
+func (x *Gateway_Listener_Cache) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Cache.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Cache) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 3}
+}
+
+func (x *Gateway_Listener_Cache) GetMaxBodyBytes() uint32 {
+	if x != nil {
+		return x.MaxBodyBytes
+	}
+	return 0
+}
+
+func (x *Gateway_Listener_Cache) GetKey() *Gateway_Listener_Cache_Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// AccessLog logs the requests of HTTP, HTTPS and GRPC listeners.
+type Gateway_Listener_AccessLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Backend is the name of a logging backend of the mesh. When it
+	// is empty, the default logging backend of the mesh is used.
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Format is the format of the log lines. It replaces the format
+	// of the backend. Besides the placeholders of the backend format,
+	// %KUMA_GATEWAY_ROUTE% is the name of the GatewayRoute that
+	// matched the request, and %KUMA_GATEWAY_VIRTUAL_HOST% is the
+	// hostname of the virtual host that matched it.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *Gateway_Listener_AccessLog) Reset() {
+	*x = Gateway_Listener_AccessLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_AccessLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_AccessLog) ProtoMessage() {}
+
+func (x *Gateway_Listener_AccessLog) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_AccessLog.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_AccessLog) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 4}
+}
+
+func (x *Gateway_Listener_AccessLog) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_AccessLog) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Waf inspects the requests of HTTP, HTTPS and GRPC listeners with
+// the Coraza web application firewall that is bundled with the
+// gateway data plane proxies.
+type Gateway_Listener_Waf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RuleSet is the name of the WafRuleSet that the requests are
+	// inspected with. It must be in the mesh of the Gateway.
+	RuleSet string `protobuf:"bytes,1,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`
+	// DetectionOnly logs the requests that match the rules, but
+	// doesn't block them. It can be used to tune the rules before
+	// they are enforced.
+	DetectionOnly bool `protobuf:"varint,2,opt,name=detection_only,json=detectionOnly,proto3" json:"detection_only,omitempty"`
+}
+
+func (x *Gateway_Listener_Waf) Reset() {
+	*x = Gateway_Listener_Waf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Waf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Waf) ProtoMessage() {}
+
+func (x *Gateway_Listener_Waf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Waf.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Waf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 5}
+}
+
+func (x *Gateway_Listener_Waf) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_Waf) GetDetectionOnly() bool {
+	if x != nil {
+		return x.DetectionOnly
+	}
+	return false
+}
+
+// Jwt authenticates the requests of HTTP, HTTPS and GRPC listeners
+// with JSON Web Tokens. Requests must carry a valid token of any of
+// the providers, unless the rules of their GatewayRoute require
+// other providers or disable the authentication.
+type Gateway_Listener_Jwt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Providers are the issuers of the accepted tokens.
+	Providers []*Gateway_Listener_Jwt_Provider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *Gateway_Listener_Jwt) Reset() {
+	*x = Gateway_Listener_Jwt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Jwt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Jwt) ProtoMessage() {}
+
+func (x *Gateway_Listener_Jwt) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Jwt.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Jwt) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 6}
+}
+
+func (x *Gateway_Listener_Jwt) GetProviders() []*Gateway_Listener_Jwt_Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// Limits are the connection limits and timeouts of a listener.
+// Zero values keep the defaults.
+type Gateway_Listener_Limits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IdleTimeout closes the connections that have no active requests
+	// or, on TCP and TLS listeners, no traffic for this long. The
+	// default is 5 minutes on HTTP, HTTPS and GRPC listeners, and 1
+	// hour on TCP and TLS listeners.
+	IdleTimeout *durationpb.Duration `protobuf:"bytes,1,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+	// RequestTimeout limits the time to receive a whole request and
+	// to send its response, on HTTP, HTTPS and GRPC listeners. It
+	// must be disabled for streaming requests, and it is by default.
+	RequestTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	// MaxConcurrentStreams is the maximum number of concurrent
+	// requests on a HTTP/2 connection. The default is 100.
+	MaxConcurrentStreams uint32 `protobuf:"varint,3,opt,name=max_concurrent_streams,json=maxConcurrentStreams,proto3" json:"max_concurrent_streams,omitempty"`
+	// MaxConnections is the maximum number of connections that the
+	// listener accepts at the same time, on all of its hostnames. The
+	// connections above the limit are closed. By default, connections
+	// are not limited. The limit is part of the bootstrap configuration
+	// of the gateway data plane proxies, so they have to be restarted
+	// to pick up a change.
+	MaxConnections uint32 `protobuf:"varint,4,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+}
+
+func (x *Gateway_Listener_Limits) Reset() {
+	*x = Gateway_Listener_Limits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Limits) ProtoMessage() {}
+
+func (x *Gateway_Listener_Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Limits.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Limits) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 7}
+}
+
+func (x *Gateway_Listener_Limits) GetIdleTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Limits) GetRequestTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Limits) GetMaxConcurrentStreams() uint32 {
+	if x != nil {
+		return x.MaxConcurrentStreams
+	}
+	return 0
+}
+
+func (x *Gateway_Listener_Limits) GetMaxConnections() uint32 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+// Oidc authenticates the users of browsers with an OpenID Connect
+// provider. Requests without a valid session are redirected to the
+// provider to log in, and the access token of the session is
+// forwarded to the backends in the Authorization header.
+//
+// The session cookies have the Envoy default names BearerToken,
+// OauthHMAC and OauthExpires. Settings of the cookies are deferred
+// until the Envoy API version Kuma is built with supports them.
+type Gateway_Listener_Oidc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Provider is the OpenID Connect provider of the users.
+	Provider *Gateway_Listener_Oidc_Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// ClientId is the client identifier of the gateway at the
+	// provider.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// ClientSecret is the client secret of the gateway at the
+	// provider. It should be stored in a Secret.
+	ClientSecret *v1alpha1.DataSource `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// HmacSecret signs the session cookies. It should be stored in a
+	// Secret, and it must be the same on all the gateway data plane
+	// proxies so that the sessions are valid on any of them.
+	HmacSecret *v1alpha1.DataSource `protobuf:"bytes,4,opt,name=hmac_secret,json=hmacSecret,proto3" json:"hmac_secret,omitempty"`
+	// RedirectPath is the path that the provider redirects the users
+	// to after they logged in. The default is "/oauth2/callback".
+	RedirectPath string `protobuf:"bytes,5,opt,name=redirect_path,json=redirectPath,proto3" json:"redirect_path,omitempty"`
+	// SignoutPath is the path that ends the session of the users.
+	// The default is "/oauth2/signout".
+	SignoutPath string `protobuf:"bytes,6,opt,name=signout_path,json=signoutPath,proto3" json:"signout_path,omitempty"`
+	// Scopes are the scopes that are requested from the provider,
+	// "openid" is always requested.
+	Scopes []string `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
+}
+
+func (x *Gateway_Listener_Oidc) Reset() {
+	*x = Gateway_Listener_Oidc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Oidc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Oidc) ProtoMessage() {}
+
+func (x *Gateway_Listener_Oidc) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Oidc.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Oidc) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 8}
+}
+
+func (x *Gateway_Listener_Oidc) GetProvider() *Gateway_Listener_Oidc_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Oidc) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_Oidc) GetClientSecret() *v1alpha1.DataSource {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Oidc) GetHmacSecret() *v1alpha1.DataSource {
+	if x != nil {
+		return x.HmacSecret
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Oidc) GetRedirectPath() string {
+	if x != nil {
+		return x.RedirectPath
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_Oidc) GetSignoutPath() string {
+	if x != nil {
+		return x.SignoutPath
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_Oidc) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// Paths configures how the paths of the requests are normalized and
+// matched on HTTP, HTTPS and GRPC listeners.
+type Gateway_Listener_Paths struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PrefixMatch selects how the PREFIX path matches of the routes
+	// are matched, unless a match selects it itself. It also selects
+	// how the matched prefix is replaced by the rewrite filter.
+	PrefixMatch Gateway_Listener_Paths_PrefixMatch `protobuf:"varint,1,opt,name=prefix_match,json=prefixMatch,proto3,enum=kuma.mesh.v1alpha1.Gateway_Listener_Paths_PrefixMatch" json:"prefix_match,omitempty"`
+	// MergeSlashes merges the adjacent slashes of the path, e.g.
+	// "/api//users" is matched and forwarded as "/api/users". It is
+	// enabled by default.
+	MergeSlashes *wrapperspb.BoolValue `protobuf:"bytes,2,opt,name=merge_slashes,json=mergeSlashes,proto3" json:"merge_slashes,omitempty"`
+	// Normalize normalizes the path as specified by RFC 3986, e.g.
+	// "/api/./users/../groups" is matched and forwarded as
+	// "/api/groups". It is enabled by default, unless traversal
+	// sequences are rejected.
+	Normalize *wrapperspb.BoolValue `protobuf:"bytes,3,opt,name=normalize,proto3" json:"normalize,omitempty"`
+	// RejectTraversal rejects the requests whose path has "." or ".."
+	// segments, even percent-encoded, or percent-encoded slashes or
+	// backslashes, with a 403 response. The paths can't be normalized
+	// then, so that the segments reach the check.
+	RejectTraversal bool `protobuf:"varint,4,opt,name=reject_traversal,json=rejectTraversal,proto3" json:"reject_traversal,omitempty"`
+}
+
+func (x *Gateway_Listener_Paths) Reset() {
+	*x = Gateway_Listener_Paths{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Paths) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Paths) ProtoMessage() {}
+
+func (x *Gateway_Listener_Paths) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Paths.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Paths) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 9}
+}
+
+func (x *Gateway_Listener_Paths) GetPrefixMatch() Gateway_Listener_Paths_PrefixMatch {
+	if x != nil {
+		return x.PrefixMatch
+	}
+	return Gateway_Listener_Paths_COMPONENTS
+}
+
+func (x *Gateway_Listener_Paths) GetMergeSlashes() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.MergeSlashes
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Paths) GetNormalize() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Normalize
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Paths) GetRejectTraversal() bool {
+	if x != nil {
+		return x.RejectTraversal
+	}
+	return false
+}
+
+// Key selects the requests that get a separate limit.
+type Gateway_Listener_RateLimit_Key struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Header is the name of a request header. Requests are
+	// selected by the value of this header.
+	Header string `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// ClientAddress selects requests by the IP address of the
+	// client.
+	ClientAddress bool `protobuf:"varint,2,opt,name=client_address,json=clientAddress,proto3" json:"client_address,omitempty"`
+}
+
+func (x *Gateway_Listener_RateLimit_Key) Reset() {
+	*x = Gateway_Listener_RateLimit_Key{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_RateLimit_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_RateLimit_Key) ProtoMessage() {}
+
+func (x *Gateway_Listener_RateLimit_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_RateLimit_Key.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_RateLimit_Key) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 1, 0}
+}
+
+func (x *Gateway_Listener_RateLimit_Key) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_RateLimit_Key) GetClientAddress() bool {
+	if x != nil {
+		return x.ClientAddress
+	}
+	return false
+}
+
+// Limit is the limit of the requests with the given value of the
+// key.
+type Gateway_Listener_RateLimit_Limit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value of the header, or IP address of the client.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Requests is the number of requests accepted per interval.
+	Requests uint32 `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	// Interval is the interval for which the requests are accounted.
+	Interval *durationpb.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *Gateway_Listener_RateLimit_Limit) Reset() {
+	*x = Gateway_Listener_RateLimit_Limit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_RateLimit_Limit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_RateLimit_Limit) ProtoMessage() {}
+
+func (x *Gateway_Listener_RateLimit_Limit) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_RateLimit_Limit.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_RateLimit_Limit) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 1, 1}
+}
+
+func (x *Gateway_Listener_RateLimit_Limit) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_RateLimit_Limit) GetRequests() uint32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *Gateway_Listener_RateLimit_Limit) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+// Csrf rejects the requests that change state (any method other
+// than GET, HEAD and OPTIONS) when their Origin header doesn't
+// match the host of the request or one of the additional origins.
+type Gateway_Listener_Security_Csrf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AdditionalOrigins are the origins, other than the host of the
+	// request, whose requests are accepted, e.g. "app.example.com".
+	AdditionalOrigins []string `protobuf:"bytes,1,rep,name=additional_origins,json=additionalOrigins,proto3" json:"additional_origins,omitempty"`
+}
+
+func (x *Gateway_Listener_Security_Csrf) Reset() {
+	*x = Gateway_Listener_Security_Csrf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Security_Csrf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Security_Csrf) ProtoMessage() {}
+
+func (x *Gateway_Listener_Security_Csrf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Security_Csrf.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Security_Csrf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 2, 0}
+}
+
+func (x *Gateway_Listener_Security_Csrf) GetAdditionalOrigins() []string {
+	if x != nil {
+		return x.AdditionalOrigins
+	}
+	return nil
+}
+
+// Headers are the security headers added to all the responses.
+// Routes can override them with response header filters.
+type Gateway_Listener_Security_Headers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// StrictTransportSecurity is the value of the
+	// Strict-Transport-Security header. It is only sent by HTTPS
+	// listeners. The default is "max-age=31536000; includeSubDomains".
+	StrictTransportSecurity string `protobuf:"bytes,1,opt,name=strict_transport_security,json=strictTransportSecurity,proto3" json:"strict_transport_security,omitempty"`
+	// FrameOptions is the value of the X-Frame-Options header. It
+	// must be "DENY" or "SAMEORIGIN", the default is "DENY".
+	FrameOptions string `protobuf:"bytes,2,opt,name=frame_options,json=frameOptions,proto3" json:"frame_options,omitempty"`
+}
+
+func (x *Gateway_Listener_Security_Headers) Reset() {
+	*x = Gateway_Listener_Security_Headers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Security_Headers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Security_Headers) ProtoMessage() {}
+
+func (x *Gateway_Listener_Security_Headers) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Security_Headers.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Security_Headers) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 2, 1}
+}
+
+func (x *Gateway_Listener_Security_Headers) GetStrictTransportSecurity() string {
+	if x != nil {
+		return x.StrictTransportSecurity
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_Security_Headers) GetFrameOptions() string {
+	if x != nil {
+		return x.FrameOptions
+	}
+	return ""
+}
+
+// Key selects the properties of the requests that identify their
+// cached responses. The path is always part of the key.
+type Gateway_Listener_Cache_Key struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ExcludeHost removes the host from the key, so that all the
+	// hosts of the listener share their cached responses.
+	ExcludeHost bool `protobuf:"varint,1,opt,name=exclude_host,json=excludeHost,proto3" json:"exclude_host,omitempty"`
+	// QueryParameters are the names of the query parameters that
+	// are part of the key. By default, all the query parameters are.
+	QueryParameters []string `protobuf:"bytes,2,rep,name=query_parameters,json=queryParameters,proto3" json:"query_parameters,omitempty"`
+	// Headers are the names of the request headers that responses
+	// can vary on (see the Vary response header). A response is
+	// cached separately for each value of these headers, responses
+	// that vary on any other header are not cached.
+	Headers []string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (x *Gateway_Listener_Cache_Key) Reset() {
+	*x = Gateway_Listener_Cache_Key{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Cache_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Cache_Key) ProtoMessage() {}
+
+func (x *Gateway_Listener_Cache_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Cache_Key.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Cache_Key) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 3, 0}
+}
+
+func (x *Gateway_Listener_Cache_Key) GetExcludeHost() bool {
+	if x != nil {
+		return x.ExcludeHost
+	}
+	return false
+}
+
+func (x *Gateway_Listener_Cache_Key) GetQueryParameters() []string {
+	if x != nil {
+		return x.QueryParameters
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Cache_Key) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// Provider is an issuer of JSON Web Tokens.
+type Gateway_Listener_Jwt_Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name identifies the provider in the JWT requirements of
+	// GatewayRoutes.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Issuer is the value of the "iss" claim of the tokens. When it
+	// is empty, the issuer isn't verified.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Audiences are the accepted values of the "aud" claim of the
+	// tokens. When it is empty, the audience isn't verified.
+	Audiences []string `protobuf:"bytes,3,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	// RemoteJwks is the URI of the key set of the provider. Either
+	// RemoteJwks or LocalJwks must be given.
+	RemoteJwks *Gateway_Listener_Jwt_Provider_RemoteJwks `protobuf:"bytes,4,opt,name=remote_jwks,json=remoteJwks,proto3" json:"remote_jwks,omitempty"`
+	// LocalJwks is the key set of the provider, in the JSON Web Key
+	// Set format.
+	LocalJwks *v1alpha1.DataSource `protobuf:"bytes,5,opt,name=local_jwks,json=localJwks,proto3" json:"local_jwks,omitempty"`
+	// ClaimToHeaders are the claims that are forwarded to the
+	// backends as request headers.
+	ClaimToHeaders []*Gateway_Listener_Jwt_Provider_ClaimToHeader `protobuf:"bytes,6,rep,name=claim_to_headers,json=claimToHeaders,proto3" json:"claim_to_headers,omitempty"`
+	// Forward keeps the token in the requests that are forwarded to
+	// the backends. By default, it is removed.
+	Forward bool `protobuf:"varint,7,opt,name=forward,proto3" json:"forward,omitempty"`
+}
+
+func (x *Gateway_Listener_Jwt_Provider) Reset() {
+	*x = Gateway_Listener_Jwt_Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Jwt_Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Jwt_Provider) ProtoMessage() {}
+
+func (x *Gateway_Listener_Jwt_Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Jwt_Provider.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Jwt_Provider) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 6, 0}
+}
+
+func (x *Gateway_Listener_Jwt_Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_Jwt_Provider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_Jwt_Provider) GetAudiences() []string {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Jwt_Provider) GetRemoteJwks() *Gateway_Listener_Jwt_Provider_RemoteJwks {
+	if x != nil {
+		return x.RemoteJwks
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Jwt_Provider) GetLocalJwks() *v1alpha1.DataSource {
+	if x != nil {
+		return x.LocalJwks
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Jwt_Provider) GetClaimToHeaders() []*Gateway_Listener_Jwt_Provider_ClaimToHeader {
+	if x != nil {
+		return x.ClaimToHeaders
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Jwt_Provider) GetForward() bool {
+	if x != nil {
+		return x.Forward
+	}
+	return false
+}
+
+// RemoteJwks fetches the JSON Web Key Set of the provider.
+type Gateway_Listener_Jwt_Provider_RemoteJwks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Uri is the HTTP or HTTPS URI of the key set.
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// CacheDuration is how long the key set is cached before it is
+	// fetched again. The default is 5 minutes.
+	CacheDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=cache_duration,json=cacheDuration,proto3" json:"cache_duration,omitempty"`
+	// CertificateAuthority verifies the certificate of HTTPS URIs.
+	// By default, the certificate authorities of the image of the
+	// gateway data plane proxy are used.
+	CertificateAuthority *v1alpha1.DataSource `protobuf:"bytes,3,opt,name=certificate_authority,json=certificateAuthority,proto3" json:"certificate_authority,omitempty"`
+}
+
+func (x *Gateway_Listener_Jwt_Provider_RemoteJwks) Reset() {
+	*x = Gateway_Listener_Jwt_Provider_RemoteJwks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Jwt_Provider_RemoteJwks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Jwt_Provider_RemoteJwks) ProtoMessage() {}
+
+func (x *Gateway_Listener_Jwt_Provider_RemoteJwks) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Jwt_Provider_RemoteJwks.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Jwt_Provider_RemoteJwks) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 6, 0, 0}
+}
+
+func (x *Gateway_Listener_Jwt_Provider_RemoteJwks) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_Jwt_Provider_RemoteJwks) GetCacheDuration() *durationpb.Duration {
+	if x != nil {
+		return x.CacheDuration
+	}
+	return nil
+}
+
+func (x *Gateway_Listener_Jwt_Provider_RemoteJwks) GetCertificateAuthority() *v1alpha1.DataSource {
+	if x != nil {
+		return x.CertificateAuthority
+	}
+	return nil
+}
+
+// ClaimToHeader copies a claim of the token to a request header.
+type Gateway_Listener_Jwt_Provider_ClaimToHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Claim is the name of a top-level claim of the token.
+	Claim string `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	// Header is the name of the request header. Any value of the
+	// header that is sent by the client is removed.
+	Header string `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (x *Gateway_Listener_Jwt_Provider_ClaimToHeader) Reset() {
+	*x = Gateway_Listener_Jwt_Provider_ClaimToHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Jwt_Provider_ClaimToHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Jwt_Provider_ClaimToHeader) ProtoMessage() {}
+
+func (x *Gateway_Listener_Jwt_Provider_ClaimToHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Jwt_Provider_ClaimToHeader.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Jwt_Provider_ClaimToHeader) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 6, 0, 1}
+}
+
+func (x *Gateway_Listener_Jwt_Provider_ClaimToHeader) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_Jwt_Provider_ClaimToHeader) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+// Provider is the OpenID Connect provider of the users.
+type Gateway_Listener_Oidc_Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AuthorizationEndpoint is the HTTP or HTTPS URI that the
+	// users are redirected to, to log in.
+	AuthorizationEndpoint string `protobuf:"bytes,1,opt,name=authorization_endpoint,json=authorizationEndpoint,proto3" json:"authorization_endpoint,omitempty"`
+	// TokenEndpoint is the HTTP or HTTPS URI that the gateway
+	// exchanges the authorization codes for tokens at.
+	TokenEndpoint string `protobuf:"bytes,2,opt,name=token_endpoint,json=tokenEndpoint,proto3" json:"token_endpoint,omitempty"`
+	// CertificateAuthority verifies the certificate of a HTTPS
+	// token endpoint. By default, the certificate authorities of
+	// the image of the gateway data plane proxy are used.
+	CertificateAuthority *v1alpha1.DataSource `protobuf:"bytes,3,opt,name=certificate_authority,json=certificateAuthority,proto3" json:"certificate_authority,omitempty"`
+}
+
+func (x *Gateway_Listener_Oidc_Provider) Reset() {
+	*x = Gateway_Listener_Oidc_Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway_Listener_Oidc_Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway_Listener_Oidc_Provider) ProtoMessage() {}
+
+func (x *Gateway_Listener_Oidc_Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway_Listener_Oidc_Provider.ProtoReflect.Descriptor instead.
+func (*Gateway_Listener_Oidc_Provider) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_proto_rawDescGZIP(), []int{0, 1, 8, 0}
+}
+
+func (x *Gateway_Listener_Oidc_Provider) GetAuthorizationEndpoint() string {
+	if x != nil {
+		return x.AuthorizationEndpoint
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_Oidc_Provider) GetTokenEndpoint() string {
+	if x != nil {
+		return x.TokenEndpoint
+	}
+	return ""
+}
+
+func (x *Gateway_Listener_Oidc_Provider) GetCertificateAuthority() *v1alpha1.DataSource {
+	if x != nil {
+		return x.CertificateAuthority
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_gateway_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_gateway_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf,
+	0x28, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x43, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x61, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xb2, 0x04, 0x0a, 0x03,
+	0x54, 0x4c, 0x53, 0x1a, 0x6d, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x62,
+	0x0a, 0x1c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x1a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x1a, 0x6f, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x42, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x1a, 0x98, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x38, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c,
+	0x53, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x30,
+	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x50, 0x41, 0x53, 0x53, 0x54, 0x48, 0x52, 0x4f, 0x55, 0x47, 0x48, 0x10, 0x02,
+	0x1a, 0xd1, 0x1f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x49, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x42,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x4d, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x4d, 0x65, 0x73, 0x68,
+	0x12, 0x49, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x4d, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f,
+	0x67, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x12, 0x3a, 0x0a, 0x03,
+	0x77, 0x61, 0x66, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e,
+	0x57, 0x61, 0x66, 0x52, 0x03, 0x77, 0x61, 0x66, 0x12, 0x3a, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x4a, 0x77, 0x74, 0x52,
+	0x03, 0x6a, 0x77, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x6f, 0x69, 0x64,
+	0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x69,
+	0x64, 0x63, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x12, 0x40, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x78, 0x66,
+	0x66, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x6f,
+	0x70, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x78, 0x66, 0x66, 0x4e, 0x75, 0x6d,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x48, 0x6f, 0x70, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54,
+	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0xaa, 0x03, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x35,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x44, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4c, 0x0a, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x44, 0x0a, 0x03, 0x4b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a,
+	0x70, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x1a, 0xc6, 0x02, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x46,
+	0x0a, 0x04, 0x63, 0x73, 0x72, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x73, 0x72, 0x66,
+	0x52, 0x04, 0x63, 0x73, 0x72, 0x66, 0x12, 0x4f, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x35, 0x0a, 0x04, 0x43, 0x73, 0x72, 0x66, 0x12,
+	0x2d, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x1a, 0x6a,
+	0x0a, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xde, 0x01, 0x0a, 0x05, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x6d, 0x0a, 0x03,
+	0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68,
+	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x09, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x1a, 0x47, 0x0a, 0x03, 0x57, 0x61,
+	0x66, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x6e, 0x6c, 0x79, 0x1a, 0xcb, 0x05, 0x0a, 0x03, 0x4a, 0x77, 0x74, 0x12, 0x4f, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x2e, 0x4a, 0x77, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x1a, 0xf2, 0x04, 0x0a,
+	0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6a, 0x77,
+	0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x4a,
+	0x77, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x4a, 0x77, 0x6b, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4a, 0x77,
+	0x6b, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6a, 0x77, 0x6b, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4a,
+	0x77, 0x6b, 0x73, 0x12, 0x69, 0x0a, 0x10, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x6f, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x2e, 0x4a, 0x77, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0e,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x1a, 0xb7, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x4a, 0x77, 0x6b, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x15, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x14, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x1a, 0x3d, 0x0a, 0x0d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x1a, 0xe9, 0x01, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0c,
+	0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69,
+	0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x34,
+	0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14,
+	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d,
+	0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x9f, 0x04,
+	0x0a, 0x04, 0x4f, 0x69, 0x64, 0x63, 0x12, 0x4e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x4f,
+	0x69, 0x64, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0c, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x68, 0x6d,
+	0x61, 0x63, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x0a, 0x68, 0x6d, 0x61, 0x63, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x75,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x1a, 0xbf, 0x01,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x15, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a,
+	0xb2, 0x02, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x59, 0x0a, 0x0c, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x3f, 0x0a, 0x0d, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x22, 0x28, 0x0a, 0x0b, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4d,
+	0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x59, 0x54,
+	0x45, 0x53, 0x10, 0x01, 0x22, 0x4e, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43,
+	0x50, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
+	0x54, 0x4c, 0x53, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x10, 0x04, 0x12,
+	0x09, 0x0a, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x52,
+	0x50, 0x43, 0x10, 0x06, 0x1a, 0x28, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0xa9,
+	0x01, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x53, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x45, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x3a, 0x4b, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x11, 0x0a, 0x0f, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6,
+	0x01, 0x09, 0x12, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0xaa, 0x8c, 0x89, 0xa6, 0x01,
+	0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x30, 0x01, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x0b, 0x3a, 0x09, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b,
+	0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_gateway_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_gateway_proto_rawDescData = file_mesh_v1alpha1_gateway_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_gateway_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_gateway_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_gateway_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_gateway_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_gateway_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_mesh_v1alpha1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_mesh_v1alpha1_gateway_proto_goTypes = []interface{}{
+	(Gateway_TLS_Mode)(0),                               // 0: kuma.mesh.v1alpha1.Gateway.TLS.Mode
+	(Gateway_Listener_Protocol)(0),                      // 1: kuma.mesh.v1alpha1.Gateway.Listener.Protocol
+	(Gateway_Listener_Paths_PrefixMatch)(0),             // 2: kuma.mesh.v1alpha1.Gateway.Listener.Paths.PrefixMatch
+	(*Gateway)(nil),                                     // 3: kuma.mesh.v1alpha1.Gateway
+	(*Gateway_TLS)(nil),                                 // 4: kuma.mesh.v1alpha1.Gateway.TLS
+	(*Gateway_Listener)(nil),                            // 5: kuma.mesh.v1alpha1.Gateway.Listener
+	(*Gateway_ExplicitRoutes)(nil),                      // 6: kuma.mesh.v1alpha1.Gateway.ExplicitRoutes
+	(*Gateway_Conf)(nil),                                // 7: kuma.mesh.v1alpha1.Gateway.Conf
+	nil,                                                 // 8: kuma.mesh.v1alpha1.Gateway.TagsEntry
+	(*Gateway_TLS_Options)(nil),                         // 9: kuma.mesh.v1alpha1.Gateway.TLS.Options
+	(*Gateway_TLS_Certificate)(nil),                     // 10: kuma.mesh.v1alpha1.Gateway.TLS.Certificate
+	(*Gateway_TLS_Conf)(nil),                            // 11: kuma.mesh.v1alpha1.Gateway.TLS.Conf
+	nil,                                                 // 12: kuma.mesh.v1alpha1.Gateway.Listener.TagsEntry
+	(*Gateway_Listener_RateLimit)(nil),                  // 13: kuma.mesh.v1alpha1.Gateway.Listener.RateLimit
+	(*Gateway_Listener_Security)(nil),                   // 14: kuma.mesh.v1alpha1.Gateway.Listener.Security
+	(*Gateway_Listener_Cache)(nil),                      // 15: kuma.mesh.v1alpha1.Gateway.Listener.Cache
+	(*Gateway_Listener_AccessLog)(nil),                  // 16: kuma.mesh.v1alpha1.Gateway.Listener.AccessLog
+	(*Gateway_Listener_Waf)(nil),                        // 17: kuma.mesh.v1alpha1.Gateway.Listener.Waf
+	(*Gateway_Listener_Jwt)(nil),                        // 18: kuma.mesh.v1alpha1.Gateway.Listener.Jwt
+	(*Gateway_Listener_Limits)(nil),                     // 19: kuma.mesh.v1alpha1.Gateway.Listener.Limits
+	(*Gateway_Listener_Oidc)(nil),                       // 20: kuma.mesh.v1alpha1.Gateway.Listener.Oidc
+	(*Gateway_Listener_Paths)(nil),                      // 21: kuma.mesh.v1alpha1.Gateway.Listener.Paths
+	(*Gateway_Listener_RateLimit_Key)(nil),              // 22: kuma.mesh.v1alpha1.Gateway.Listener.RateLimit.Key
+	(*Gateway_Listener_RateLimit_Limit)(nil),            // 23: kuma.mesh.v1alpha1.Gateway.Listener.RateLimit.Limit
+	(*Gateway_Listener_Security_Csrf)(nil),              // 24: kuma.mesh.v1alpha1.Gateway.Listener.Security.Csrf
+	(*Gateway_Listener_Security_Headers)(nil),           // 25: kuma.mesh.v1alpha1.Gateway.Listener.Security.Headers
+	(*Gateway_Listener_Cache_Key)(nil),                  // 26: kuma.mesh.v1alpha1.Gateway.Listener.Cache.Key
+	(*Gateway_Listener_Jwt_Provider)(nil),               // 27: kuma.mesh.v1alpha1.Gateway.Listener.Jwt.Provider
+	(*Gateway_Listener_Jwt_Provider_RemoteJwks)(nil),    // 28: kuma.mesh.v1alpha1.Gateway.Listener.Jwt.Provider.RemoteJwks
+	(*Gateway_Listener_Jwt_Provider_ClaimToHeader)(nil), // 29: kuma.mesh.v1alpha1.Gateway.Listener.Jwt.Provider.ClaimToHeader
+	(*Gateway_Listener_Oidc_Provider)(nil),              // 30: kuma.mesh.v1alpha1.Gateway.Listener.Oidc.Provider
+	(*Selector)(nil),                                    // 31: kuma.mesh.v1alpha1.Selector
+	(*v1alpha1.DataSource)(nil),                         // 32: kuma.system.v1alpha1.DataSource
+	(*durationpb.Duration)(nil),                         // 33: google.protobuf.Duration
+	(*wrapperspb.BoolValue)(nil),                        // 34: google.protobuf.BoolValue
+}
+var file_mesh_v1alpha1_gateway_proto_depIdxs = []int32{
+	31, // 0: kuma.mesh.v1alpha1.Gateway.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	8,  // 1: kuma.mesh.v1alpha1.Gateway.tags:type_name -> kuma.mesh.v1alpha1.Gateway.TagsEntry
+	7,  // 2: kuma.mesh.v1alpha1.Gateway.conf:type_name -> kuma.mesh.v1alpha1.Gateway.Conf
+	1,  // 3: kuma.mesh.v1alpha1.Gateway.Listener.protocol:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Protocol
+	11, // 4: kuma.mesh.v1alpha1.Gateway.Listener.tls:type_name -> kuma.mesh.v1alpha1.Gateway.TLS.Conf
+	12, // 5: kuma.mesh.v1alpha1.Gateway.Listener.tags:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.TagsEntry
+	13, // 6: kuma.mesh.v1alpha1.Gateway.Listener.rate_limit:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.RateLimit
+	14, // 7: kuma.mesh.v1alpha1.Gateway.Listener.security:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Security
+	15, // 8: kuma.mesh.v1alpha1.Gateway.Listener.cache:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Cache
+	16, // 9: kuma.mesh.v1alpha1.Gateway.Listener.access_log:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.AccessLog
+	17, // 10: kuma.mesh.v1alpha1.Gateway.Listener.waf:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Waf
+	18, // 11: kuma.mesh.v1alpha1.Gateway.Listener.jwt:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Jwt
+	19, // 12: kuma.mesh.v1alpha1.Gateway.Listener.limits:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Limits
+	20, // 13: kuma.mesh.v1alpha1.Gateway.Listener.oidc:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Oidc
+	21, // 14: kuma.mesh.v1alpha1.Gateway.Listener.paths:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Paths
+	5,  // 15: kuma.mesh.v1alpha1.Gateway.Conf.listeners:type_name -> kuma.mesh.v1alpha1.Gateway.Listener
+	6,  // 16: kuma.mesh.v1alpha1.Gateway.Conf.explicit_routes:type_name -> kuma.mesh.v1alpha1.Gateway.ExplicitRoutes
+	32, // 17: kuma.mesh.v1alpha1.Gateway.TLS.Options.client_certificate_authority:type_name -> kuma.system.v1alpha1.DataSource
+	32, // 18: kuma.mesh.v1alpha1.Gateway.TLS.Certificate.certificate:type_name -> kuma.system.v1alpha1.DataSource
+	0,  // 19: kuma.mesh.v1alpha1.Gateway.TLS.Conf.mode:type_name -> kuma.mesh.v1alpha1.Gateway.TLS.Mode
+	32, // 20: kuma.mesh.v1alpha1.Gateway.TLS.Conf.certificate:type_name -> kuma.system.v1alpha1.DataSource
+	10, // 21: kuma.mesh.v1alpha1.Gateway.TLS.Conf.certificates:type_name -> kuma.mesh.v1alpha1.Gateway.TLS.Certificate
+	9,  // 22: kuma.mesh.v1alpha1.Gateway.TLS.Conf.options:type_name -> kuma.mesh.v1alpha1.Gateway.TLS.Options
+	33, // 23: kuma.mesh.v1alpha1.Gateway.Listener.RateLimit.interval:type_name -> google.protobuf.Duration
+	22, // 24: kuma.mesh.v1alpha1.Gateway.Listener.RateLimit.key:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.RateLimit.Key
+	23, // 25: kuma.mesh.v1alpha1.Gateway.Listener.RateLimit.limits:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.RateLimit.Limit
+	24, // 26: kuma.mesh.v1alpha1.Gateway.Listener.Security.csrf:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Security.Csrf
+	25, // 27: kuma.mesh.v1alpha1.Gateway.Listener.Security.headers:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Security.Headers
+	26, // 28: kuma.mesh.v1alpha1.Gateway.Listener.Cache.key:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Cache.Key
+	27, // 29: kuma.mesh.v1alpha1.Gateway.Listener.Jwt.providers:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Jwt.Provider
+	33, // 30: kuma.mesh.v1alpha1.Gateway.Listener.Limits.idle_timeout:type_name -> google.protobuf.Duration
+	33, // 31: kuma.mesh.v1alpha1.Gateway.Listener.Limits.request_timeout:type_name -> google.protobuf.Duration
+	30, // 32: kuma.mesh.v1alpha1.Gateway.Listener.Oidc.provider:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Oidc.Provider
+	32, // 33: kuma.mesh.v1alpha1.Gateway.Listener.Oidc.client_secret:type_name -> kuma.system.v1alpha1.DataSource
+	32, // 34: kuma.mesh.v1alpha1.Gateway.Listener.Oidc.hmac_secret:type_name -> kuma.system.v1alpha1.DataSource
+	2,  // 35: kuma.mesh.v1alpha1.Gateway.Listener.Paths.prefix_match:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Paths.PrefixMatch
+	34, // 36: kuma.mesh.v1alpha1.Gateway.Listener.Paths.merge_slashes:type_name -> google.protobuf.BoolValue
+	34, // 37: kuma.mesh.v1alpha1.Gateway.Listener.Paths.normalize:type_name -> google.protobuf.BoolValue
+	33, // 38: kuma.mesh.v1alpha1.Gateway.Listener.RateLimit.Limit.interval:type_name -> google.protobuf.Duration
+	28, // 39: kuma.mesh.v1alpha1.Gateway.Listener.Jwt.Provider.remote_jwks:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Jwt.Provider.RemoteJwks
+	32, // 40: kuma.mesh.v1alpha1.Gateway.Listener.Jwt.Provider.local_jwks:type_name -> kuma.system.v1alpha1.DataSource
+	29, // 41: kuma.mesh.v1alpha1.Gateway.Listener.Jwt.Provider.claim_to_headers:type_name -> kuma.mesh.v1alpha1.Gateway.Listener.Jwt.Provider.ClaimToHeader
+	33, // 42: kuma.mesh.v1alpha1.Gateway.Listener.Jwt.Provider.RemoteJwks.cache_duration:type_name -> google.protobuf.Duration
+	32, // 43: kuma.mesh.v1alpha1.Gateway.Listener.Jwt.Provider.RemoteJwks.certificate_authority:type_name -> kuma.system.v1alpha1.DataSource
+	32, // 44: kuma.mesh.v1alpha1.Gateway.Listener.Oidc.Provider.certificate_authority:type_name -> kuma.system.v1alpha1.DataSource
+	45, // [45:45] is the sub-list for method output_type
+	45, // [45:45] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_gateway_proto_init() }
+func file_mesh_v1alpha1_gateway_proto_init() {
+	if File_mesh_v1alpha1_gateway_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_gateway_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway); i {
+			case 0:
 				return &v.state
 			case 1:
 				return &v.sizeCache
@@ -691,6 +2658,18 @@ func file_mesh_v1alpha1_gateway_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_ExplicitRoutes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Gateway_Conf); i {
 			case 0:
 				return &v.state
@@ -702,7 +2681,7 @@ func file_mesh_v1alpha1_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_gateway_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_gateway_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Gateway_TLS_Options); i {
 			case 0:
 				return &v.state
@@ -714,7 +2693,19 @@ func file_mesh_v1alpha1_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_gateway_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_gateway_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_TLS_Certificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Gateway_TLS_Conf); i {
 			case 0:
 				return &v.state
@@ -726,14 +2717,230 @@ func file_mesh_v1alpha1_gateway_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_RateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Security); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Cache); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_AccessLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Waf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Jwt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Limits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Oidc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Paths); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_RateLimit_Key); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_RateLimit_Limit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Security_Csrf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Security_Headers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Cache_Key); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Jwt_Provider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Jwt_Provider_RemoteJwks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Jwt_Provider_ClaimToHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateway_Listener_Oidc_Provider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_gateway_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      3,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
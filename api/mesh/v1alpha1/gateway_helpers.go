@@ -0,0 +1,19 @@
+package v1alpha1
+
+// MatchedHostnames returns the hostnames that the listener matches. A
+// listener without any hostname matches all of them, i.e. "*".
+func (l *Gateway_Listener) MatchedHostnames() []string {
+	var hostnames []string
+
+	if l.GetHostname() != "" {
+		hostnames = append(hostnames, l.GetHostname())
+	}
+
+	hostnames = append(hostnames, l.GetHostnames()...)
+
+	if len(hostnames) == 0 {
+		return []string{"*"}
+	}
+
+	return hostnames
+}
@@ -12,6 +12,7 @@ import (
 	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -143,11 +144,70 @@ func (GatewayRoute_HttpRoute_Match_Path_MatchType) EnumDescriptor() ([]byte, []i
 	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 0, 0, 0}
 }
 
+// PrefixMatch selects how a PREFIX match is matched. The prefix
+// match of the listener is used by default.
+type GatewayRoute_HttpRoute_Match_Path_PrefixMatch int32
+
+const (
+	// LISTENER follows the prefix match of the listener.
+	GatewayRoute_HttpRoute_Match_Path_LISTENER GatewayRoute_HttpRoute_Match_Path_PrefixMatch = 0
+	// COMPONENTS prefixes match whole path components, e.g. "/api"
+	// matches "/api" and "/api/users" but not "/apis".
+	GatewayRoute_HttpRoute_Match_Path_COMPONENTS GatewayRoute_HttpRoute_Match_Path_PrefixMatch = 1
+	// BYTES prefixes match the beginning of the path, e.g. "/api"
+	// also matches "/apis".
+	GatewayRoute_HttpRoute_Match_Path_BYTES GatewayRoute_HttpRoute_Match_Path_PrefixMatch = 2
+)
+
+// Enum value maps for GatewayRoute_HttpRoute_Match_Path_PrefixMatch.
+var (
+	GatewayRoute_HttpRoute_Match_Path_PrefixMatch_name = map[int32]string{
+		0: "LISTENER",
+		1: "COMPONENTS",
+		2: "BYTES",
+	}
+	GatewayRoute_HttpRoute_Match_Path_PrefixMatch_value = map[string]int32{
+		"LISTENER":   0,
+		"COMPONENTS": 1,
+		"BYTES":      2,
+	}
+)
+
+func (x GatewayRoute_HttpRoute_Match_Path_PrefixMatch) Enum() *GatewayRoute_HttpRoute_Match_Path_PrefixMatch {
+	p := new(GatewayRoute_HttpRoute_Match_Path_PrefixMatch)
+	*p = x
+	return p
+}
+
+func (x GatewayRoute_HttpRoute_Match_Path_PrefixMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GatewayRoute_HttpRoute_Match_Path_PrefixMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_gateway_route_proto_enumTypes[2].Descriptor()
+}
+
+func (GatewayRoute_HttpRoute_Match_Path_PrefixMatch) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_gateway_route_proto_enumTypes[2]
+}
+
+func (x GatewayRoute_HttpRoute_Match_Path_PrefixMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Match_Path_PrefixMatch.Descriptor instead.
+func (GatewayRoute_HttpRoute_Match_Path_PrefixMatch) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 0, 0, 1}
+}
+
 type GatewayRoute_HttpRoute_Match_Header_MatchType int32
 
 const (
-	GatewayRoute_HttpRoute_Match_Header_EXACT GatewayRoute_HttpRoute_Match_Header_MatchType = 0
-	GatewayRoute_HttpRoute_Match_Header_REGEX GatewayRoute_HttpRoute_Match_Header_MatchType = 1
+	GatewayRoute_HttpRoute_Match_Header_EXACT   GatewayRoute_HttpRoute_Match_Header_MatchType = 0
+	GatewayRoute_HttpRoute_Match_Header_REGEX   GatewayRoute_HttpRoute_Match_Header_MatchType = 1
+	GatewayRoute_HttpRoute_Match_Header_PREFIX  GatewayRoute_HttpRoute_Match_Header_MatchType = 2
+	GatewayRoute_HttpRoute_Match_Header_PRESENT GatewayRoute_HttpRoute_Match_Header_MatchType = 3
+	GatewayRoute_HttpRoute_Match_Header_ABSENT  GatewayRoute_HttpRoute_Match_Header_MatchType = 4
 )
 
 // Enum value maps for GatewayRoute_HttpRoute_Match_Header_MatchType.
@@ -155,10 +215,16 @@ var (
 	GatewayRoute_HttpRoute_Match_Header_MatchType_name = map[int32]string{
 		0: "EXACT",
 		1: "REGEX",
+		2: "PREFIX",
+		3: "PRESENT",
+		4: "ABSENT",
 	}
 	GatewayRoute_HttpRoute_Match_Header_MatchType_value = map[string]int32{
-		"EXACT": 0,
-		"REGEX": 1,
+		"EXACT":   0,
+		"REGEX":   1,
+		"PREFIX":  2,
+		"PRESENT": 3,
+		"ABSENT":  4,
 	}
 )
 
@@ -173,11 +239,11 @@ func (x GatewayRoute_HttpRoute_Match_Header_MatchType) String() string {
 }
 
 func (GatewayRoute_HttpRoute_Match_Header_MatchType) Descriptor() protoreflect.EnumDescriptor {
-	return file_mesh_v1alpha1_gateway_route_proto_enumTypes[2].Descriptor()
+	return file_mesh_v1alpha1_gateway_route_proto_enumTypes[3].Descriptor()
 }
 
 func (GatewayRoute_HttpRoute_Match_Header_MatchType) Type() protoreflect.EnumType {
-	return &file_mesh_v1alpha1_gateway_route_proto_enumTypes[2]
+	return &file_mesh_v1alpha1_gateway_route_proto_enumTypes[3]
 }
 
 func (x GatewayRoute_HttpRoute_Match_Header_MatchType) Number() protoreflect.EnumNumber {
@@ -192,8 +258,10 @@ func (GatewayRoute_HttpRoute_Match_Header_MatchType) EnumDescriptor() ([]byte, [
 type GatewayRoute_HttpRoute_Match_Query_MatchType int32
 
 const (
-	GatewayRoute_HttpRoute_Match_Query_EXACT GatewayRoute_HttpRoute_Match_Query_MatchType = 0
-	GatewayRoute_HttpRoute_Match_Query_REGEX GatewayRoute_HttpRoute_Match_Query_MatchType = 1
+	GatewayRoute_HttpRoute_Match_Query_EXACT   GatewayRoute_HttpRoute_Match_Query_MatchType = 0
+	GatewayRoute_HttpRoute_Match_Query_REGEX   GatewayRoute_HttpRoute_Match_Query_MatchType = 1
+	GatewayRoute_HttpRoute_Match_Query_PRESENT GatewayRoute_HttpRoute_Match_Query_MatchType = 2
+	GatewayRoute_HttpRoute_Match_Query_ABSENT  GatewayRoute_HttpRoute_Match_Query_MatchType = 3
 )
 
 // Enum value maps for GatewayRoute_HttpRoute_Match_Query_MatchType.
@@ -201,10 +269,14 @@ var (
 	GatewayRoute_HttpRoute_Match_Query_MatchType_name = map[int32]string{
 		0: "EXACT",
 		1: "REGEX",
+		2: "PRESENT",
+		3: "ABSENT",
 	}
 	GatewayRoute_HttpRoute_Match_Query_MatchType_value = map[string]int32{
-		"EXACT": 0,
-		"REGEX": 1,
+		"EXACT":   0,
+		"REGEX":   1,
+		"PRESENT": 2,
+		"ABSENT":  3,
 	}
 )
 
@@ -219,11 +291,11 @@ func (x GatewayRoute_HttpRoute_Match_Query_MatchType) String() string {
 }
 
 func (GatewayRoute_HttpRoute_Match_Query_MatchType) Descriptor() protoreflect.EnumDescriptor {
-	return file_mesh_v1alpha1_gateway_route_proto_enumTypes[3].Descriptor()
+	return file_mesh_v1alpha1_gateway_route_proto_enumTypes[4].Descriptor()
 }
 
 func (GatewayRoute_HttpRoute_Match_Query_MatchType) Type() protoreflect.EnumType {
-	return &file_mesh_v1alpha1_gateway_route_proto_enumTypes[3]
+	return &file_mesh_v1alpha1_gateway_route_proto_enumTypes[4]
 }
 
 func (x GatewayRoute_HttpRoute_Match_Query_MatchType) Number() protoreflect.EnumNumber {
@@ -403,7 +475,8 @@ func (x *GatewayRoute_UdpRoute) GetRules() []*GatewayRoute_UdpRoute_Rule {
 	return nil
 }
 
-// TLS routes are valid for listeners that accept connections over TLS.
+// TCP routes are valid for TCP listeners. The gateway forwards each
+// connection it accepts to one of the backends of the route.
 type GatewayRoute_TcpRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -451,9 +524,10 @@ func (x *GatewayRoute_TcpRoute) GetRules() []*GatewayRoute_TcpRoute_Rule {
 	return nil
 }
 
-// TLS routes are valid for listeners that accept connections over TLS.
-// This can be a raw TLS connection, but can also be used to forward
-// HTTP and other protocols that layer on top of TLS.
+// TLS routes are valid for TLS listeners in passthrough mode. The
+// gateway matches the server name that the client sends in the TLS
+// Server Name Indication extension, and forwards the TLS stream to one
+// of the backends of the route without terminating it.
 type GatewayRoute_TlsRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -461,7 +535,8 @@ type GatewayRoute_TlsRoute struct {
 
 	// Hostnames lists the server names for which this route is valid. The
 	// hostnames are matched against the TLS Server Name Indication extension
-	// send by the client.
+	// send by the client. If there are no hostnames, the route matches
+	// all the server names of the listener.
 	Hostnames []string                      `protobuf:"bytes,1,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
 	Rules     []*GatewayRoute_TlsRoute_Rule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
 }
@@ -778,6 +853,8 @@ func (x *GatewayRoute_UdpRoute_Rule) GetBackends() []*GatewayRoute_Backend {
 	return nil
 }
 
+// Match is reserved for matching criteria of TCP connections.
+// There are no criteria yet, so every connection matches.
 type GatewayRoute_TcpRoute_Match struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -821,8 +898,13 @@ type GatewayRoute_TcpRoute_Rule struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Matches  []*GatewayRoute_TcpRoute_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
-	Backends []*GatewayRoute_Backend        `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
+	// Matches are reserved for future use, every connection matches
+	// the rule.
+	Matches []*GatewayRoute_TcpRoute_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Backends is the set of services to which the gateway will
+	// forward connections. Connections are balanced across the
+	// backends according to their weights.
+	Backends []*GatewayRoute_Backend `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
 }
 
 func (x *GatewayRoute_TcpRoute_Rule) Reset() {
@@ -871,6 +953,8 @@ func (x *GatewayRoute_TcpRoute_Rule) GetBackends() []*GatewayRoute_Backend {
 	return nil
 }
 
+// Match is reserved for matching criteria of TLS connections.
+// There are no criteria yet, so every connection matches.
 type GatewayRoute_TlsRoute_Match struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -914,8 +998,13 @@ type GatewayRoute_TlsRoute_Rule struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Matches  []*GatewayRoute_TlsRoute_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
-	Backends []*GatewayRoute_Backend        `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
+	// Matches are reserved for future use, every connection matches
+	// the rule.
+	Matches []*GatewayRoute_TlsRoute_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Backends is the set of services to which the gateway will
+	// forward connections. Connections are balanced across the
+	// backends according to their weights.
+	Backends []*GatewayRoute_Backend `protobuf:"bytes,2,rep,name=backends,proto3" json:"backends,omitempty"`
 }
 
 func (x *GatewayRoute_TlsRoute_Rule) Reset() {
@@ -977,6 +1066,8 @@ type GatewayRoute_HttpRoute_Match struct {
 	Method          GatewayRoute_HttpRoute_Match_Method    `protobuf:"varint,2,opt,name=method,proto3,enum=kuma.mesh.v1alpha1.GatewayRoute_HttpRoute_Match_Method" json:"method,omitempty"`
 	Headers         []*GatewayRoute_HttpRoute_Match_Header `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
 	QueryParameters []*GatewayRoute_HttpRoute_Match_Query  `protobuf:"bytes,4,rep,name=query_parameters,json=queryParameters,proto3" json:"query_parameters,omitempty"`
+	Client          *GatewayRoute_HttpRoute_Match_Client   `protobuf:"bytes,5,opt,name=client,proto3" json:"client,omitempty"`
+	Grpc            *GatewayRoute_HttpRoute_Match_Grpc     `protobuf:"bytes,6,opt,name=grpc,proto3" json:"grpc,omitempty"`
 }
 
 func (x *GatewayRoute_HttpRoute_Match) Reset() {
@@ -1039,6 +1130,20 @@ func (x *GatewayRoute_HttpRoute_Match) GetQueryParameters() []*GatewayRoute_Http
 	return nil
 }
 
+func (x *GatewayRoute_HttpRoute_Match) GetClient() *GatewayRoute_HttpRoute_Match_Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Match) GetGrpc() *GatewayRoute_HttpRoute_Match_Grpc {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
 type GatewayRoute_HttpRoute_Filter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1048,6 +1153,13 @@ type GatewayRoute_HttpRoute_Filter struct {
 	//	*GatewayRoute_HttpRoute_Filter_RequestHeader_
 	//	*GatewayRoute_HttpRoute_Filter_Mirror_
 	//	*GatewayRoute_HttpRoute_Filter_Redirect_
+	//	*GatewayRoute_HttpRoute_Filter_Rewrite_
+	//	*GatewayRoute_HttpRoute_Filter_ResponseHeader_
+	//	*GatewayRoute_HttpRoute_Filter_DirectResponse_
+	//	*GatewayRoute_HttpRoute_Filter_Cache_
+	//	*GatewayRoute_HttpRoute_Filter_Cors_
+	//	*GatewayRoute_HttpRoute_Filter_Buffer_
+	//	*GatewayRoute_HttpRoute_Filter_FaultInjection
 	Filter isGatewayRoute_HttpRoute_Filter_Filter `protobuf_oneof:"filter"`
 }
 
@@ -1111,6 +1223,55 @@ func (x *GatewayRoute_HttpRoute_Filter) GetRedirect() *GatewayRoute_HttpRoute_Fi
 	return nil
 }
 
+func (x *GatewayRoute_HttpRoute_Filter) GetRewrite() *GatewayRoute_HttpRoute_Filter_Rewrite {
+	if x, ok := x.GetFilter().(*GatewayRoute_HttpRoute_Filter_Rewrite_); ok {
+		return x.Rewrite
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter) GetResponseHeader() *GatewayRoute_HttpRoute_Filter_ResponseHeader {
+	if x, ok := x.GetFilter().(*GatewayRoute_HttpRoute_Filter_ResponseHeader_); ok {
+		return x.ResponseHeader
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter) GetDirectResponse() *GatewayRoute_HttpRoute_Filter_DirectResponse {
+	if x, ok := x.GetFilter().(*GatewayRoute_HttpRoute_Filter_DirectResponse_); ok {
+		return x.DirectResponse
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter) GetCache() *GatewayRoute_HttpRoute_Filter_Cache {
+	if x, ok := x.GetFilter().(*GatewayRoute_HttpRoute_Filter_Cache_); ok {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter) GetCors() *GatewayRoute_HttpRoute_Filter_Cors {
+	if x, ok := x.GetFilter().(*GatewayRoute_HttpRoute_Filter_Cors_); ok {
+		return x.Cors
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter) GetBuffer() *GatewayRoute_HttpRoute_Filter_Buffer {
+	if x, ok := x.GetFilter().(*GatewayRoute_HttpRoute_Filter_Buffer_); ok {
+		return x.Buffer
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter) GetFaultInjection() *FaultInjection_Conf {
+	if x, ok := x.GetFilter().(*GatewayRoute_HttpRoute_Filter_FaultInjection); ok {
+		return x.FaultInjection
+	}
+	return nil
+}
+
 type isGatewayRoute_HttpRoute_Filter_Filter interface {
 	isGatewayRoute_HttpRoute_Filter_Filter()
 }
@@ -1127,12 +1288,59 @@ type GatewayRoute_HttpRoute_Filter_Redirect_ struct {
 	Redirect *GatewayRoute_HttpRoute_Filter_Redirect `protobuf:"bytes,3,opt,name=redirect,proto3,oneof"`
 }
 
+type GatewayRoute_HttpRoute_Filter_Rewrite_ struct {
+	Rewrite *GatewayRoute_HttpRoute_Filter_Rewrite `protobuf:"bytes,4,opt,name=rewrite,proto3,oneof"`
+}
+
+type GatewayRoute_HttpRoute_Filter_ResponseHeader_ struct {
+	ResponseHeader *GatewayRoute_HttpRoute_Filter_ResponseHeader `protobuf:"bytes,5,opt,name=response_header,json=responseHeader,proto3,oneof"`
+}
+
+type GatewayRoute_HttpRoute_Filter_DirectResponse_ struct {
+	DirectResponse *GatewayRoute_HttpRoute_Filter_DirectResponse `protobuf:"bytes,6,opt,name=direct_response,json=directResponse,proto3,oneof"`
+}
+
+type GatewayRoute_HttpRoute_Filter_Cache_ struct {
+	Cache *GatewayRoute_HttpRoute_Filter_Cache `protobuf:"bytes,7,opt,name=cache,proto3,oneof"`
+}
+
+type GatewayRoute_HttpRoute_Filter_Cors_ struct {
+	Cors *GatewayRoute_HttpRoute_Filter_Cors `protobuf:"bytes,8,opt,name=cors,proto3,oneof"`
+}
+
+type GatewayRoute_HttpRoute_Filter_Buffer_ struct {
+	Buffer *GatewayRoute_HttpRoute_Filter_Buffer `protobuf:"bytes,9,opt,name=buffer,proto3,oneof"`
+}
+
+type GatewayRoute_HttpRoute_Filter_FaultInjection struct {
+	// The fault injection filter injects delays, aborts and response
+	// bandwidth limits into the requests of the rule, with the same
+	// semantics as the FaultInjection policy. It lets clients be
+	// tested against faults at the edge of the mesh. At least one
+	// fault must be given.
+	FaultInjection *FaultInjection_Conf `protobuf:"bytes,10,opt,name=fault_injection,json=faultInjection,proto3,oneof"`
+}
+
 func (*GatewayRoute_HttpRoute_Filter_RequestHeader_) isGatewayRoute_HttpRoute_Filter_Filter() {}
 
 func (*GatewayRoute_HttpRoute_Filter_Mirror_) isGatewayRoute_HttpRoute_Filter_Filter() {}
 
 func (*GatewayRoute_HttpRoute_Filter_Redirect_) isGatewayRoute_HttpRoute_Filter_Filter() {}
 
+func (*GatewayRoute_HttpRoute_Filter_Rewrite_) isGatewayRoute_HttpRoute_Filter_Filter() {}
+
+func (*GatewayRoute_HttpRoute_Filter_ResponseHeader_) isGatewayRoute_HttpRoute_Filter_Filter() {}
+
+func (*GatewayRoute_HttpRoute_Filter_DirectResponse_) isGatewayRoute_HttpRoute_Filter_Filter() {}
+
+func (*GatewayRoute_HttpRoute_Filter_Cache_) isGatewayRoute_HttpRoute_Filter_Filter() {}
+
+func (*GatewayRoute_HttpRoute_Filter_Cors_) isGatewayRoute_HttpRoute_Filter_Filter() {}
+
+func (*GatewayRoute_HttpRoute_Filter_Buffer_) isGatewayRoute_HttpRoute_Filter_Filter() {}
+
+func (*GatewayRoute_HttpRoute_Filter_FaultInjection) isGatewayRoute_HttpRoute_Filter_Filter() {}
+
 type GatewayRoute_HttpRoute_Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1145,13 +1353,31 @@ type GatewayRoute_HttpRoute_Rule struct {
 	// matched requests.
 	//
 	// If the redirect filter is specified, it must be the only
-	// filter given.
+	// filter given. If the direct response filter is specified, it
+	// can only be combined with response header filters.
 	Filters []*GatewayRoute_HttpRoute_Filter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
 	// Backends is the set of services to which the gateway will
-	// forward requests. If a redirect filter is specified, no
-	// backends are allowed. Otherwise, at least one backend
-	// must be given.
+	// forward requests. If a redirect or direct response filter is
+	// specified, no backends are allowed. Otherwise, at least one
+	// backend must be given.
 	Backends []*GatewayRoute_Backend `protobuf:"bytes,3,rep,name=backends,proto3" json:"backends,omitempty"`
+	// Priority orders the rules whose matches are equally specific.
+	// Requests are matched by the rules with a higher priority first.
+	// When several rules match the same path, only the rule with the
+	// highest priority is used.
+	Priority uint32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Upgrades can only be enabled on rules that forward requests to
+	// backends.
+	Upgrades *GatewayRoute_HttpRoute_Rule_Upgrades `protobuf:"bytes,5,opt,name=upgrades,proto3" json:"upgrades,omitempty"`
+	// Waf only has an effect on the listeners that enable a web
+	// application firewall.
+	Waf *GatewayRoute_HttpRoute_Rule_Waf `protobuf:"bytes,6,opt,name=waf,proto3" json:"waf,omitempty"`
+	// Sticky only has an effect on rules that forward to several
+	// backends.
+	Sticky *GatewayRoute_HttpRoute_Rule_Sticky `protobuf:"bytes,7,opt,name=sticky,proto3" json:"sticky,omitempty"`
+	// Jwt only has an effect on the listeners that enable JWT
+	// authentication.
+	Jwt *GatewayRoute_HttpRoute_Rule_Jwt `protobuf:"bytes,8,opt,name=jwt,proto3" json:"jwt,omitempty"`
 }
 
 func (x *GatewayRoute_HttpRoute_Rule) Reset() {
@@ -1207,6 +1433,41 @@ func (x *GatewayRoute_HttpRoute_Rule) GetBackends() []*GatewayRoute_Backend {
 	return nil
 }
 
+func (x *GatewayRoute_HttpRoute_Rule) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *GatewayRoute_HttpRoute_Rule) GetUpgrades() *GatewayRoute_HttpRoute_Rule_Upgrades {
+	if x != nil {
+		return x.Upgrades
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Rule) GetWaf() *GatewayRoute_HttpRoute_Rule_Waf {
+	if x != nil {
+		return x.Waf
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Rule) GetSticky() *GatewayRoute_HttpRoute_Rule_Sticky {
+	if x != nil {
+		return x.Sticky
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Rule) GetJwt() *GatewayRoute_HttpRoute_Rule_Jwt {
+	if x != nil {
+		return x.Jwt
+	}
+	return nil
+}
+
 // Path matches may be "EXACT", "PREFIX", or "REGEX" matches. If
 // the match type is not specified, "EXACT" is the default.
 type GatewayRoute_HttpRoute_Match_Path struct {
@@ -1218,7 +1479,8 @@ type GatewayRoute_HttpRoute_Match_Path struct {
 	// Value is the path to match against. For EXACT and PREFIX match
 	// types, it must be a HTTP URI path. For the REGEX match type,
 	// it must be a RE2 regular expression.
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Value       string                                        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	PrefixMatch GatewayRoute_HttpRoute_Match_Path_PrefixMatch `protobuf:"varint,3,opt,name=prefix_match,json=prefixMatch,proto3,enum=kuma.mesh.v1alpha1.GatewayRoute_HttpRoute_Match_Path_PrefixMatch" json:"prefix_match,omitempty"`
 }
 
 func (x *GatewayRoute_HttpRoute_Match_Path) Reset() {
@@ -1267,9 +1529,17 @@ func (x *GatewayRoute_HttpRoute_Match_Path) GetValue() string {
 	return ""
 }
 
+func (x *GatewayRoute_HttpRoute_Match_Path) GetPrefixMatch() GatewayRoute_HttpRoute_Match_Path_PrefixMatch {
+	if x != nil {
+		return x.PrefixMatch
+	}
+	return GatewayRoute_HttpRoute_Match_Path_LISTENER
+}
+
 // Header matches a value in a HTTP request header. Not that if
 // the header is defined to have multiple values, a REGEX match
-// must be used to match a specific value.
+// must be used to match a specific value. PRESENT and ABSENT
+// matches only check whether the request has the header.
 type GatewayRoute_HttpRoute_Match_Header struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1279,6 +1549,8 @@ type GatewayRoute_HttpRoute_Match_Header struct {
 	// Name of the HTTP header containing the value to match.
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Value that the HTTP header value should be matched against.
+	// It is required for EXACT, REGEX and PREFIX matches, and must
+	// be empty for PRESENT and ABSENT matches.
 	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
@@ -1335,7 +1607,9 @@ func (x *GatewayRoute_HttpRoute_Match_Header) GetValue() string {
 	return ""
 }
 
-// Query matches against HTTP request query parameters.
+// Query matches against HTTP request query parameters. PRESENT
+// and ABSENT matches only check whether the request has the
+// query parameter.
 type GatewayRoute_HttpRoute_Match_Query struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1345,6 +1619,8 @@ type GatewayRoute_HttpRoute_Match_Query struct {
 	// Name of the query parameter containing the value to match.
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Value that the query parameter value should be matched against.
+	// It is required for EXACT and REGEX matches, and must be empty
+	// for PRESENT and ABSENT matches.
 	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
@@ -1401,18 +1677,42 @@ func (x *GatewayRoute_HttpRoute_Match_Query) GetValue() string {
 	return ""
 }
 
-type GatewayRoute_HttpRoute_Filter_RequestHeader struct {
+// Client restricts the match to the clients that sent the request.
+// Requests from other clients are matched by the other rules.
+//
+// Matching the claims of JWTs is deferred. The claims are copied
+// to the request headers after the route is selected, and the
+// Envoy API version Kuma is built with can't match routes on the
+// metadata of the JWT authentication.
+type GatewayRoute_HttpRoute_Match_Client struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Set    []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header `protobuf:"bytes,1,rep,name=set,proto3" json:"set,omitempty"`
-	Add    []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
-	Remove []string                                              `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
-}
-
-func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) Reset() {
-	*x = GatewayRoute_HttpRoute_Filter_RequestHeader{}
+	// SourceCidrs matches the IP address of the client against a
+	// list of IPv4 or IPv6 CIDR blocks.
+	//
+	// The client address is the source address of the connection.
+	// The clients of the CIDR blocks of the rules of a listener are
+	// served by their own filter chain and routes. When the blocks
+	// of several rules overlap, a client gets the rules of all the
+	// blocks that contain its address.
+	//
+	// On listeners that trust X-Forwarded-For hops (see the
+	// xff_num_trusted_hops listener option), the client address is
+	// the one that the outermost trusted proxy appended to the
+	// header instead, and only IPv4 CIDR blocks are matched.
+	SourceCidrs []string `protobuf:"bytes,1,rep,name=source_cidrs,json=sourceCidrs,proto3" json:"source_cidrs,omitempty"`
+	// CertificateSans matches the URI or DNS subject alternative
+	// names of the client certificate. This requires the listener to
+	// validate client certificates. Gateway listeners replace the
+	// X-Forwarded-Client-Cert header of the requests with the names
+	// of the validated certificate, so clients can't set them.
+	CertificateSans []string `protobuf:"bytes,2,rep,name=certificate_sans,json=certificateSans,proto3" json:"certificate_sans,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Match_Client) Reset() {
+	*x = GatewayRoute_HttpRoute_Match_Client{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1420,13 +1720,13 @@ func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) Reset() {
 	}
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) String() string {
+func (x *GatewayRoute_HttpRoute_Match_Client) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayRoute_HttpRoute_Filter_RequestHeader) ProtoMessage() {}
+func (*GatewayRoute_HttpRoute_Match_Client) ProtoMessage() {}
 
-func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) ProtoReflect() protoreflect.Message {
+func (x *GatewayRoute_HttpRoute_Match_Client) ProtoReflect() protoreflect.Message {
 	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1438,49 +1738,46 @@ func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayRoute_HttpRoute_Filter_RequestHeader.ProtoReflect.Descriptor instead.
-func (*GatewayRoute_HttpRoute_Filter_RequestHeader) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 0}
-}
-
-func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) GetSet() []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header {
-	if x != nil {
-		return x.Set
-	}
-	return nil
+// Deprecated: Use GatewayRoute_HttpRoute_Match_Client.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Match_Client) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 0, 3}
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) GetAdd() []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header {
+func (x *GatewayRoute_HttpRoute_Match_Client) GetSourceCidrs() []string {
 	if x != nil {
-		return x.Add
+		return x.SourceCidrs
 	}
 	return nil
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) GetRemove() []string {
+func (x *GatewayRoute_HttpRoute_Match_Client) GetCertificateSans() []string {
 	if x != nil {
-		return x.Remove
+		return x.CertificateSans
 	}
 	return nil
 }
 
-// The mirror filter sends a percentage of HTTP requests to the
-// given backend. The gateway ignores any responses to these requests.
-type GatewayRoute_HttpRoute_Filter_Mirror struct {
+// Grpc matches gRPC requests. Requests are matched by their
+// gRPC content type and, if given, by the service and method
+// that they call.
+type GatewayRoute_HttpRoute_Match_Grpc struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Backend denotes the service to which requests will be mirrored. The
-	// "weight" field must not be given.
-	Backend *GatewayRoute_Backend `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
-	// Percentage specifies the percentage of requests to mirror to
-	// the backend (in the range 0.0 - 100.0, inclusive).
-	Percentage *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Service is the fully qualified name of the gRPC service,
+	// e.g. "helloworld.Greeter". All gRPC services are matched if
+	// it is empty.
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// Method is the name of the gRPC method of the service. All
+	// methods of the service are matched if it is empty.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Metadata matches values of the gRPC request metadata.
+	Metadata []*GatewayRoute_HttpRoute_Match_Header `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_Mirror) Reset() {
-	*x = GatewayRoute_HttpRoute_Filter_Mirror{}
+func (x *GatewayRoute_HttpRoute_Match_Grpc) Reset() {
+	*x = GatewayRoute_HttpRoute_Match_Grpc{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1488,13 +1785,13 @@ func (x *GatewayRoute_HttpRoute_Filter_Mirror) Reset() {
 	}
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_Mirror) String() string {
+func (x *GatewayRoute_HttpRoute_Match_Grpc) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayRoute_HttpRoute_Filter_Mirror) ProtoMessage() {}
+func (*GatewayRoute_HttpRoute_Match_Grpc) ProtoMessage() {}
 
-func (x *GatewayRoute_HttpRoute_Filter_Mirror) ProtoReflect() protoreflect.Message {
+func (x *GatewayRoute_HttpRoute_Match_Grpc) ProtoReflect() protoreflect.Message {
 	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1506,45 +1803,44 @@ func (x *GatewayRoute_HttpRoute_Filter_Mirror) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayRoute_HttpRoute_Filter_Mirror.ProtoReflect.Descriptor instead.
-func (*GatewayRoute_HttpRoute_Filter_Mirror) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 1}
+// Deprecated: Use GatewayRoute_HttpRoute_Match_Grpc.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Match_Grpc) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 0, 4}
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_Mirror) GetBackend() *GatewayRoute_Backend {
+func (x *GatewayRoute_HttpRoute_Match_Grpc) GetService() string {
 	if x != nil {
-		return x.Backend
+		return x.Service
 	}
-	return nil
+	return ""
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_Mirror) GetPercentage() *wrapperspb.DoubleValue {
+func (x *GatewayRoute_HttpRoute_Match_Grpc) GetMethod() string {
 	if x != nil {
-		return x.Percentage
+		return x.Method
+	}
+	return ""
+}
+
+func (x *GatewayRoute_HttpRoute_Match_Grpc) GetMetadata() []*GatewayRoute_HttpRoute_Match_Header {
+	if x != nil {
+		return x.Metadata
 	}
 	return nil
 }
 
-// The redirect filter responds to the HTTP request immediately,
-// without forwarding it to any backend. The response is a HTTP
-// redirect message.
-type GatewayRoute_HttpRoute_Filter_Redirect struct {
+type GatewayRoute_HttpRoute_Filter_RequestHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The scheme for the redirect URL. Usually "http" or "https".
-	Scheme string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
-	// The hostname to redirect to.
-	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	// The port to redirect to.
-	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
-	// The HTTP response status code. This must be in the range 300 - 308.
-	StatusCode uint32 `protobuf:"varint,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Set    []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header `protobuf:"bytes,1,rep,name=set,proto3" json:"set,omitempty"`
+	Add    []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	Remove []string                                              `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_Redirect) Reset() {
-	*x = GatewayRoute_HttpRoute_Filter_Redirect{}
+func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) Reset() {
+	*x = GatewayRoute_HttpRoute_Filter_RequestHeader{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1552,13 +1848,13 @@ func (x *GatewayRoute_HttpRoute_Filter_Redirect) Reset() {
 	}
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_Redirect) String() string {
+func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayRoute_HttpRoute_Filter_Redirect) ProtoMessage() {}
+func (*GatewayRoute_HttpRoute_Filter_RequestHeader) ProtoMessage() {}
 
-func (x *GatewayRoute_HttpRoute_Filter_Redirect) ProtoReflect() protoreflect.Message {
+func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) ProtoReflect() protoreflect.Message {
 	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1570,50 +1866,46 @@ func (x *GatewayRoute_HttpRoute_Filter_Redirect) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayRoute_HttpRoute_Filter_Redirect.ProtoReflect.Descriptor instead.
-func (*GatewayRoute_HttpRoute_Filter_Redirect) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 2}
-}
-
-func (x *GatewayRoute_HttpRoute_Filter_Redirect) GetScheme() string {
-	if x != nil {
-		return x.Scheme
-	}
-	return ""
+// Deprecated: Use GatewayRoute_HttpRoute_Filter_RequestHeader.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Filter_RequestHeader) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 0}
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_Redirect) GetHostname() string {
+func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) GetSet() []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header {
 	if x != nil {
-		return x.Hostname
+		return x.Set
 	}
-	return ""
+	return nil
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_Redirect) GetPort() uint32 {
+func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) GetAdd() []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header {
 	if x != nil {
-		return x.Port
+		return x.Add
 	}
-	return 0
+	return nil
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_Redirect) GetStatusCode() uint32 {
+func (x *GatewayRoute_HttpRoute_Filter_RequestHeader) GetRemove() []string {
 	if x != nil {
-		return x.StatusCode
+		return x.Remove
 	}
-	return 0
+	return nil
 }
 
-type GatewayRoute_HttpRoute_Filter_RequestHeader_Header struct {
+// The response header filter modifies the headers of the HTTP
+// response before it is returned to the client.
+type GatewayRoute_HttpRoute_Filter_ResponseHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Set    []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header `protobuf:"bytes,1,rep,name=set,proto3" json:"set,omitempty"`
+	Add    []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	Remove []string                                              `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) Reset() {
-	*x = GatewayRoute_HttpRoute_Filter_RequestHeader_Header{}
+func (x *GatewayRoute_HttpRoute_Filter_ResponseHeader) Reset() {
+	*x = GatewayRoute_HttpRoute_Filter_ResponseHeader{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1621,13 +1913,13 @@ func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) Reset() {
 	}
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) String() string {
+func (x *GatewayRoute_HttpRoute_Filter_ResponseHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayRoute_HttpRoute_Filter_RequestHeader_Header) ProtoMessage() {}
+func (*GatewayRoute_HttpRoute_Filter_ResponseHeader) ProtoMessage() {}
 
-func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) ProtoReflect() protoreflect.Message {
+func (x *GatewayRoute_HttpRoute_Filter_ResponseHeader) ProtoReflect() protoreflect.Message {
 	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1639,25 +1931,895 @@ func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayRoute_HttpRoute_Filter_RequestHeader_Header.ProtoReflect.Descriptor instead.
-func (*GatewayRoute_HttpRoute_Filter_RequestHeader_Header) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 0, 0}
+// Deprecated: Use GatewayRoute_HttpRoute_Filter_ResponseHeader.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Filter_ResponseHeader) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 1}
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) GetName() string {
+func (x *GatewayRoute_HttpRoute_Filter_ResponseHeader) GetSet() []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header {
 	if x != nil {
-		return x.Name
+		return x.Set
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_ResponseHeader) GetAdd() []*GatewayRoute_HttpRoute_Filter_RequestHeader_Header {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_ResponseHeader) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+// The mirror filter sends a percentage of HTTP requests to the
+// given backend. The gateway ignores any responses to these requests.
+// A rule can have several mirror filters to mirror requests to
+// several backends, each with its own percentage.
+type GatewayRoute_HttpRoute_Filter_Mirror struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Backend denotes the service to which requests will be mirrored. The
+	// "weight" field must not be given.
+	Backend *GatewayRoute_Backend `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Percentage specifies the percentage of requests to mirror to
+	// the backend (in the range 0.0 - 100.0, inclusive).
+	Percentage *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Mirror) Reset() {
+	*x = GatewayRoute_HttpRoute_Filter_Mirror{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Mirror) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Filter_Mirror) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Filter_Mirror) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Filter_Mirror.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Filter_Mirror) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 2}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Mirror) GetBackend() *GatewayRoute_Backend {
+	if x != nil {
+		return x.Backend
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Mirror) GetPercentage() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Percentage
+	}
+	return nil
+}
+
+// The redirect filter responds to the HTTP request immediately,
+// without forwarding it to any backend. The response is a HTTP
+// redirect message.
+type GatewayRoute_HttpRoute_Filter_Redirect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The scheme for the redirect URL. Usually "http" or "https".
+	Scheme string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// The hostname to redirect to.
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The port to redirect to.
+	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// The HTTP response status code. This must be in the range 300 - 308.
+	StatusCode uint32 `protobuf:"varint,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Redirect) Reset() {
+	*x = GatewayRoute_HttpRoute_Filter_Redirect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Redirect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Filter_Redirect) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Filter_Redirect) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Filter_Redirect.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Filter_Redirect) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 3}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Redirect) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Redirect) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Redirect) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Redirect) GetStatusCode() uint32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+// The rewrite filter modifies the path and the host of the HTTP
+// request before it is forwarded to the backends.
+type GatewayRoute_HttpRoute_Filter_Rewrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ReplaceFullPath replaces the whole path of the request.
+	ReplaceFullPath string `protobuf:"bytes,1,opt,name=replace_full_path,json=replaceFullPath,proto3" json:"replace_full_path,omitempty"`
+	// ReplacePrefixMatch replaces the prefix of the path that was
+	// matched by a PREFIX path match of the rule, e.g. replacing
+	// "/api" with "/v2" rewrites "/api/users" to "/v2/users". It can
+	// only be used when all the matches of the rule are PREFIX path
+	// matches.
+	ReplacePrefixMatch string `protobuf:"bytes,2,opt,name=replace_prefix_match,json=replacePrefixMatch,proto3" json:"replace_prefix_match,omitempty"`
+	// Hostname replaces the Host header of the request.
+	Hostname string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// ReplaceRegex rewrites the path with a regular expression
+	// substitution, e.g. the pattern "^/service/([^/]+)(/.*)$" with
+	// the substitution "\2/instance/\1" rewrites "/service/foo/v1/api"
+	// to "/v1/api/instance/foo". It cannot be used together with
+	// replace_full_path or replace_prefix_match.
+	ReplaceRegex *GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace `protobuf:"bytes,4,opt,name=replace_regex,json=replaceRegex,proto3" json:"replace_regex,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite) Reset() {
+	*x = GatewayRoute_HttpRoute_Filter_Rewrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Filter_Rewrite) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Filter_Rewrite.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Filter_Rewrite) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 4}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite) GetReplaceFullPath() string {
+	if x != nil {
+		return x.ReplaceFullPath
+	}
+	return ""
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite) GetReplacePrefixMatch() string {
+	if x != nil {
+		return x.ReplacePrefixMatch
+	}
+	return ""
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite) GetReplaceRegex() *GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace {
+	if x != nil {
+		return x.ReplaceRegex
+	}
+	return nil
+}
+
+// The direct response filter responds to the HTTP request
+// immediately with a fixed status code and body, without
+// forwarding it to any backend. It can be used to serve static
+// content, such as maintenance pages or "robots.txt" files.
+type GatewayRoute_HttpRoute_Filter_DirectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The HTTP response status code. This must be in the range 200 - 599.
+	StatusCode uint32 `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The body of the response. The body can be at most 4096 bytes.
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_DirectResponse) Reset() {
+	*x = GatewayRoute_HttpRoute_Filter_DirectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_DirectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Filter_DirectResponse) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Filter_DirectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Filter_DirectResponse.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Filter_DirectResponse) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 5}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_DirectResponse) GetStatusCode() uint32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_DirectResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// The cache filter sets how long the responses of the rule are
+// cached, by replacing their Cache-Control header. It only has an
+// effect on the gateway listeners that enable caching, but clients
+// and other caches also honor the header.
+type GatewayRoute_HttpRoute_Filter_Cache struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ttl is how long responses are cached. It must be at least one
+	// second.
+	Ttl *durationpb.Duration `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cache) Reset() {
+	*x = GatewayRoute_HttpRoute_Filter_Cache{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Filter_Cache) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cache) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Filter_Cache.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Filter_Cache) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 6}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cache) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// The CORS filter lets web pages of other origins access the
+// backends of the rule. The gateway answers the preflight requests
+// of browsers, and adds the CORS headers to the responses of the
+// allowed origins.
+type GatewayRoute_HttpRoute_Filter_Cors struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AllowOrigins are the origins, e.g. "https://app.example.com",
+	// that can access the rule. "*" allows any origin.
+	AllowOrigins []string `protobuf:"bytes,1,rep,name=allow_origins,json=allowOrigins,proto3" json:"allow_origins,omitempty"`
+	// AllowMethods are the methods that the requests of other
+	// origins can use.
+	AllowMethods []string `protobuf:"bytes,2,rep,name=allow_methods,json=allowMethods,proto3" json:"allow_methods,omitempty"`
+	// AllowHeaders are the headers that the requests of other
+	// origins can set.
+	AllowHeaders []string `protobuf:"bytes,3,rep,name=allow_headers,json=allowHeaders,proto3" json:"allow_headers,omitempty"`
+	// ExposeHeaders are the response headers that the pages of
+	// other origins can read.
+	ExposeHeaders []string `protobuf:"bytes,4,rep,name=expose_headers,json=exposeHeaders,proto3" json:"expose_headers,omitempty"`
+	// MaxAge is how long browsers cache the response of preflight
+	// requests.
+	MaxAge *durationpb.Duration `protobuf:"bytes,5,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// AllowCredentials lets the requests of other origins include
+	// credentials, like cookies. It can't be used when any origin
+	// is allowed.
+	AllowCredentials bool `protobuf:"varint,6,opt,name=allow_credentials,json=allowCredentials,proto3" json:"allow_credentials,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cors) Reset() {
+	*x = GatewayRoute_HttpRoute_Filter_Cors{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Filter_Cors) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cors) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Filter_Cors.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Filter_Cors) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 7}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cors) GetAllowOrigins() []string {
+	if x != nil {
+		return x.AllowOrigins
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cors) GetAllowMethods() []string {
+	if x != nil {
+		return x.AllowMethods
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cors) GetAllowHeaders() []string {
+	if x != nil {
+		return x.AllowHeaders
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cors) GetExposeHeaders() []string {
+	if x != nil {
+		return x.ExposeHeaders
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cors) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Cors) GetAllowCredentials() bool {
+	if x != nil {
+		return x.AllowCredentials
+	}
+	return false
+}
+
+// The buffer filter buffers the whole body of the requests of the
+// rule before forwarding them, so that slow clients don't hold
+// backend connections. Requests with larger bodies are rejected
+// with a 413 status code.
+type GatewayRoute_HttpRoute_Filter_Buffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MaxRequestBytes is the largest request body that is accepted.
+	MaxRequestBytes uint32 `protobuf:"varint,1,opt,name=max_request_bytes,json=maxRequestBytes,proto3" json:"max_request_bytes,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Buffer) Reset() {
+	*x = GatewayRoute_HttpRoute_Filter_Buffer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Buffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Filter_Buffer) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Filter_Buffer) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Filter_Buffer.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Filter_Buffer) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 8}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Buffer) GetMaxRequestBytes() uint32 {
+	if x != nil {
+		return x.MaxRequestBytes
+	}
+	return 0
+}
+
+type GatewayRoute_HttpRoute_Filter_RequestHeader_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) Reset() {
+	*x = GatewayRoute_HttpRoute_Filter_RequestHeader_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Filter_RequestHeader_Header) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Filter_RequestHeader_Header.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Filter_RequestHeader_Header) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 0, 0}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// RegexReplace replaces the parts of the path that match a
+// regular expression.
+type GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pattern is the RE2 regular expression that is matched against
+	// the path of the request. The query string is not matched and
+	// is kept as is.
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Substitution replaces each match of the pattern. It can refer
+	// to the capture groups of the pattern, e.g. "\1" for the first
+	// group.
+	Substitution string `protobuf:"bytes,2,opt,name=substitution,proto3" json:"substitution,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace) Reset() {
+	*x = GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 4, 0}
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace) GetSubstitution() string {
+	if x != nil {
+		return x.Substitution
+	}
+	return ""
+}
+
+// Upgrades enables the HTTP upgrades of the requests that are
+// matched by the rule. Upgrades are disabled by default.
+type GatewayRoute_HttpRoute_Rule_Upgrades struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Websocket enables WebSocket upgrades.
+	Websocket bool `protobuf:"varint,1,opt,name=websocket,proto3" json:"websocket,omitempty"`
+	// Connect enables CONNECT requests. The gateway terminates them
+	// and tunnels their payload to the backends. CONNECT requests
+	// don't have a path, so they are only matched by the header
+	// matches of the rule.
+	Connect bool `protobuf:"varint,2,opt,name=connect,proto3" json:"connect,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Upgrades) Reset() {
+	*x = GatewayRoute_HttpRoute_Rule_Upgrades{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Upgrades) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Rule_Upgrades) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Rule_Upgrades) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Rule_Upgrades.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Rule_Upgrades) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 2, 0}
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Upgrades) GetWebsocket() bool {
+	if x != nil {
+		return x.Websocket
+	}
+	return false
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Upgrades) GetConnect() bool {
+	if x != nil {
+		return x.Connect
+	}
+	return false
+}
+
+// Waf excludes the requests that are matched by the rule from the
+// web application firewall of the gateway listener. Exclusions
+// are matched on the hostnames of the route and on the paths of
+// the rule matches.
+type GatewayRoute_HttpRoute_Rule_Waf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled doesn't inspect the requests at all.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// ExcludedRules are the IDs of the firewall rules that don't
+	// inspect the requests.
+	ExcludedRules []uint32 `protobuf:"varint,2,rep,packed,name=excluded_rules,json=excludedRules,proto3" json:"excluded_rules,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Waf) Reset() {
+	*x = GatewayRoute_HttpRoute_Rule_Waf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Waf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Rule_Waf) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Rule_Waf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Rule_Waf.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Rule_Waf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 2, 1}
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Waf) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Waf) GetExcludedRules() []uint32 {
+	if x != nil {
+		return x.ExcludedRules
+	}
+	return nil
+}
+
+// Sticky keeps clients on the backend that served their first
+// request. The gateway picks a backend according to the weights
+// and records it in a cookie of the response. Later requests that
+// carry the cookie are forwarded to the same backend, so a client
+// assigned to a canary keeps hitting it for the whole session.
+type GatewayRoute_HttpRoute_Rule_Sticky struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cookie is the name of the cookie that records the backend.
+	// The default is "kuma-canary".
+	Cookie string `protobuf:"bytes,1,opt,name=cookie,proto3" json:"cookie,omitempty"`
+	// Ttl is how long clients stay on their backend. When it is
+	// not given, the cookie lasts until the browser session ends.
+	Ttl *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Sticky) Reset() {
+	*x = GatewayRoute_HttpRoute_Rule_Sticky{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Sticky) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Rule_Sticky) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Rule_Sticky) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GatewayRoute_HttpRoute_Filter_RequestHeader_Header) GetValue() string {
+// Deprecated: Use GatewayRoute_HttpRoute_Rule_Sticky.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Rule_Sticky) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 2, 2}
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Sticky) GetCookie() string {
 	if x != nil {
-		return x.Value
+		return x.Cookie
 	}
 	return ""
 }
 
+func (x *GatewayRoute_HttpRoute_Rule_Sticky) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// Jwt overrides the JWT authentication of the gateway listener
+// for the requests of the rule.
+type GatewayRoute_HttpRoute_Rule_Jwt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled accepts the requests without a token.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Providers are the names of the JWT providers of the listener
+	// whose tokens are accepted. By default, the tokens of all the
+	// providers of the listener are.
+	Providers []string `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Jwt) Reset() {
+	*x = GatewayRoute_HttpRoute_Rule_Jwt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Jwt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayRoute_HttpRoute_Rule_Jwt) ProtoMessage() {}
+
+func (x *GatewayRoute_HttpRoute_Rule_Jwt) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayRoute_HttpRoute_Rule_Jwt.ProtoReflect.Descriptor instead.
+func (*GatewayRoute_HttpRoute_Rule_Jwt) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 2, 3}
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Jwt) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *GatewayRoute_HttpRoute_Rule_Jwt) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
 var File_mesh_v1alpha1_gateway_route_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_gateway_route_proto_rawDesc = []byte{
@@ -1666,185 +2828,284 @@ var file_mesh_v1alpha1_gateway_route_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xc4, 0x1f, 0x0a, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x12, 0x48, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01,
-	0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x04, 0x63,
-	0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04,
-	0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xe6, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x12, 0x24, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x42, 0x0c, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x06,
-	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x75, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75,
-	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x18, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x11, 0x9a,
-	0x01, 0x0e, 0x08, 0x01, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x2a, 0x04, 0x72, 0x02, 0x10, 0x01,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3e, 0x0a,
-	0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x95, 0x02,
-	0x0a, 0x08, 0x55, 0x64, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x64, 0x70, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa,
-	0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x07,
-	0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0xa5, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65,
-	0x12, 0x49, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x55, 0x64, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x08, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05,
-	0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x3a,
-	0x04, 0x88, 0xb5, 0x18, 0x01, 0x1a, 0x95, 0x02, 0x0a, 0x08, 0x54, 0x63, 0x70, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x12, 0x52, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69,
+	0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x35, 0x0a, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05,
+	0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x47, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xe6, 0x01, 0x0a, 0x07, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x88,
+	0xb5, 0x18, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x75, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x18, 0x88, 0xb5, 0x18,
+	0x01, 0xfa, 0x42, 0x11, 0x9a, 0x01, 0x0e, 0x08, 0x01, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x2a,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x95, 0x02, 0x0a, 0x08, 0x55, 0x64, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x52, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x55, 0x64, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x0c,
+	0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0xa5, 0x01, 0x0a,
+	0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x64, 0x70, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0x52, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x0c, 0x88, 0xb5,
+	0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x73, 0x3a, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x1a, 0x95, 0x02, 0x0a, 0x08, 0x54,
+	0x63, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92,
+	0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x0d, 0x0a, 0x05, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x3a, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x1a, 0xa5, 0x01, 0x0a, 0x04, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x52,
+	0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01,
+	0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x73, 0x1a, 0xb3, 0x02, 0x0a, 0x08, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x52, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54,
+	0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x0c, 0x88, 0xb5,
+	0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x1a, 0x0d, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x1a, 0xa5, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x6c, 0x73,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x89, 0x29, 0x0a, 0x09, 0x48, 0x74, 0x74,
+	0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02,
+	0x08, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0xe5, 0x0c, 0x0a, 0x05, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x49, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c,
-	0x65, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52,
-	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x1a,
-	0xa5, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x63, 0x70, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42,
-	0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x3a, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x1a, 0xb3, 0x02,
-	0x0a, 0x08, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f,
-	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68,
-	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05,
-	0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x07, 0x0a, 0x05,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0xa5, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x49,
-	0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x08, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75,
-	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01,
-	0x02, 0x08, 0x01, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x3a, 0x04, 0x88,
-	0xb5, 0x18, 0x01, 0x1a, 0xe3, 0x12, 0x0a, 0x09, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12,
-	0x53, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x4f,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37,
 	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42,
-	0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x1a, 0xa1, 0x08, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x49,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x51, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x61, 0x0a, 0x10, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b,
 	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
 	0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48,
-	0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x50,
-	0x61, 0x74, 0x68, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x4f, 0x0a, 0x06, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x52, 0x04, 0x67, 0x72, 0x70,
+	0x63, 0x1a, 0xd5, 0x02, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x55, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
 	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
 	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x51, 0x0a, 0x07, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x75,
-	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74,
-	0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x61, 0x0a,
-	0x10, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x1a, 0xb7, 0x01, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x55, 0x0a, 0x05, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74, 0x68,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x13, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x64, 0x0a, 0x0c,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x41, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x22, 0x2d, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x52,
+	0x45, 0x46, 0x49, 0x58, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10,
+	0x02, 0x22, 0x36, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x02, 0x1a, 0xe1, 0x01, 0x0a, 0x06, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x41, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18,
+	0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x46, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x52, 0x45, 0x46,
+	0x49, 0x58, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x10,
+	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x1a, 0xd3, 0x01,
+	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x56, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88,
+	0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52,
+	0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x42, 0x53, 0x45, 0x4e,
+	0x54, 0x10, 0x03, 0x1a, 0x56, 0x0a, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x64, 0x72, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f,
+	0x73, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x61, 0x6e, 0x73, 0x1a, 0x8d, 0x01, 0x0a, 0x04,
+	0x47, 0x72, 0x70, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x53, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
 	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61,
 	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x2e,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x13, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2d, 0x0a, 0x09, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43,
-	0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x10, 0x01, 0x12,
-	0x09, 0x0a, 0x05, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x1a, 0xca, 0x01, 0x0a, 0x06, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x41, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x74, 0x0a, 0x06, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x54, 0x10,
+	0x03, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x45, 0x41, 0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x4f,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x54, 0x43,
+	0x48, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x07, 0x12, 0x07, 0x0a,
+	0x03, 0x50, 0x55, 0x54, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10,
+	0x09, 0x1a, 0xb6, 0x14, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
 	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4d, 0x61,
-	0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5,
-	0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x21, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x01, 0x1a, 0xc8, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x56, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x40, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05,
-	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01,
-	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x21, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05,
-	0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x47, 0x45, 0x58,
-	0x10, 0x01, 0x22, 0x74, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x08, 0x0a, 0x04,
-	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
-	0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12,
-	0x07, 0x0a, 0x03, 0x47, 0x45, 0x54, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x45, 0x41, 0x44,
-	0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x05, 0x12,
-	0x09, 0x0a, 0x05, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f,
-	0x53, 0x54, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x08, 0x12, 0x09, 0x0a,
-	0x05, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x09, 0x1a, 0xc2, 0x07, 0x0a, 0x06, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x58, 0x0a, 0x08, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x12, 0x55, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x6b, 0x0a, 0x0f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x0f, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x40, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x48, 0x00, 0x52,
+	0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x63, 0x6f, 0x72, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x72, 0x73, 0x48, 0x00, 0x52, 0x04,
+	0x63, 0x6f, 0x72, 0x73, 0x12, 0x52, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x0f, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xab, 0x02, 0x0a,
+	0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x58,
+	0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x6b, 0x75,
 	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
 	0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74,
 	0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x52, 0x0a,
-	0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e,
-	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x58, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x48,
-	0x00, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x1a, 0xab, 0x02, 0x0a, 0x0d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x58, 0x0a,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x58, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x03, 0x61,
+	0x64, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x4e, 0x0a, 0x06, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xdc, 0x01, 0x0a, 0x0e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x58, 0x0a,
 	0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x6b, 0x75, 0x6d,
 	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
 	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74,
@@ -1857,12 +3118,7 @@ var file_mesh_v1alpha1_gateway_route_proto_rawDesc = []byte{
 	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
 	0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x03, 0x61, 0x64,
 	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x4e, 0x0a, 0x06, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
-	0x10, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xac, 0x01, 0x0a, 0x06, 0x4d, 0x69,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0xac, 0x01, 0x0a, 0x06, 0x4d, 0x69,
 	0x72, 0x72, 0x6f, 0x72, 0x12, 0x50, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
 	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
@@ -1885,51 +3141,140 @@ var file_mesh_v1alpha1_gateway_route_proto_rawDesc = []byte{
 	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1c, 0x88, 0xb5, 0x18, 0x01, 0xfa,
 	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x28, 0xac, 0x02, 0xfa,
 	0x42, 0x05, 0x2a, 0x03, 0x18, 0xb4, 0x02, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
-	0x6f, 0x64, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0xf9, 0x01,
-	0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x58, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa,
-	0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x12, 0x4b, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a,
-	0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x00, 0x52,
-	0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x8e, 0x02, 0x0a, 0x04, 0x43, 0x6f,
-	0x6e, 0x66, 0x12, 0x3d, 0x0a, 0x03, 0x75, 0x64, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x55, 0x64, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x75, 0x64,
-	0x70, 0x12, 0x3d, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x6f, 0x64, 0x65, 0x1a, 0xc4, 0x02, 0x0a, 0x07, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1a, 0x0a,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x6b, 0x0a, 0x0d, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x46, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x65,
+	0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x67, 0x65, 0x78, 0x1a, 0x52, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x65, 0x78, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x56, 0x0a, 0x0e, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0f, 0xfa, 0x42, 0x08, 0x2a, 0x06, 0x18, 0xd7, 0x04, 0x28, 0xc8, 0x01, 0x88, 0xb5,
+	0x18, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x1a, 0x3a, 0x0a, 0x05, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x74,
+	0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x1a, 0x83,
+	0x02, 0x0a, 0x04, 0x43, 0x6f, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x04,
+	0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x1a, 0x3a, 0x0a, 0x06, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x30,
+	0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0xe7, 0x06, 0x0a, 0x04, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x58, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92,
+	0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x4b, 0x0a,
+	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
 	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x2e, 0x54, 0x63, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70,
-	0x12, 0x3d, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
-	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12,
-	0x40, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
-	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74,
-	0x70, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x3a, 0x5b, 0xaa, 0x8c, 0x89, 0xa6,
-	0x01, 0x16, 0x0a, 0x14, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0e, 0x12, 0x0c,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0xaa, 0x8c, 0x89, 0xa6,
-	0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x30, 0x01,
-	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x11, 0x3a, 0x0f, 0x0a, 0x0d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x2d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x4f, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d,
-	0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x21, 0x50, 0x01, 0xa2, 0x01, 0x0c, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0xf2, 0x01, 0x0d, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x2d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x00, 0x52, 0x08, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x54, 0x0a, 0x08, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x08,
+	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x03, 0x77, 0x61, 0x66, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x57, 0x61, 0x66, 0x52, 0x03, 0x77, 0x61, 0x66, 0x12,
+	0x4e, 0x0a, 0x06, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x2e, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x52, 0x06, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x12,
+	0x45, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4a, 0x77,
+	0x74, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x1a, 0x42, 0x0a, 0x08, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x1a, 0x48, 0x0a, 0x03, 0x57, 0x61,
+	0x66, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x06, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03,
+	0x74, 0x74, 0x6c, 0x1a, 0x3f, 0x0a, 0x03, 0x4a, 0x77, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x1a, 0x8e, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x3d, 0x0a,
+	0x03, 0x75, 0x64, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x64, 0x70,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x75, 0x64, 0x70, 0x12, 0x3d, 0x0a, 0x03,
+	0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x63, 0x70, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x3d, 0x0a, 0x03, 0x74,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x6c, 0x73, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x04, 0x68, 0x74,
+	0x74, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x42, 0x07, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x3a, 0x5b, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x16, 0x0a, 0x14, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0e, 0x12, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d,
+	0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x30, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01,
+	0x11, 0x3a, 0x0f, 0x0a, 0x0d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2d, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x42, 0x4f, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5,
+	0x18, 0x21, 0x50, 0x01, 0xa2, 0x01, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0xf2, 0x01, 0x0d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2d, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1944,80 +3289,117 @@ func file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_gateway_route_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_gateway_route_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_mesh_v1alpha1_gateway_route_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_mesh_v1alpha1_gateway_route_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_mesh_v1alpha1_gateway_route_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_mesh_v1alpha1_gateway_route_proto_goTypes = []interface{}{
 	(GatewayRoute_HttpRoute_Match_Method)(0),                   // 0: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Method
 	(GatewayRoute_HttpRoute_Match_Path_MatchType)(0),           // 1: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path.MatchType
-	(GatewayRoute_HttpRoute_Match_Header_MatchType)(0),         // 2: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Header.MatchType
-	(GatewayRoute_HttpRoute_Match_Query_MatchType)(0),          // 3: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Query.MatchType
-	(*GatewayRoute)(nil),                                       // 4: kuma.mesh.v1alpha1.GatewayRoute
-	(*GatewayRoute_Backend)(nil),                               // 5: kuma.mesh.v1alpha1.GatewayRoute.Backend
-	(*GatewayRoute_UdpRoute)(nil),                              // 6: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute
-	(*GatewayRoute_TcpRoute)(nil),                              // 7: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute
-	(*GatewayRoute_TlsRoute)(nil),                              // 8: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute
-	(*GatewayRoute_HttpRoute)(nil),                             // 9: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute
-	(*GatewayRoute_Conf)(nil),                                  // 10: kuma.mesh.v1alpha1.GatewayRoute.Conf
-	nil,                                                        // 11: kuma.mesh.v1alpha1.GatewayRoute.Backend.DestinationEntry
-	(*GatewayRoute_UdpRoute_Match)(nil),                        // 12: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Match
-	(*GatewayRoute_UdpRoute_Rule)(nil),                         // 13: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Rule
-	(*GatewayRoute_TcpRoute_Match)(nil),                        // 14: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Match
-	(*GatewayRoute_TcpRoute_Rule)(nil),                         // 15: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Rule
-	(*GatewayRoute_TlsRoute_Match)(nil),                        // 16: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Match
-	(*GatewayRoute_TlsRoute_Rule)(nil),                         // 17: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Rule
-	(*GatewayRoute_HttpRoute_Match)(nil),                       // 18: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match
-	(*GatewayRoute_HttpRoute_Filter)(nil),                      // 19: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter
-	(*GatewayRoute_HttpRoute_Rule)(nil),                        // 20: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule
-	(*GatewayRoute_HttpRoute_Match_Path)(nil),                  // 21: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path
-	(*GatewayRoute_HttpRoute_Match_Header)(nil),                // 22: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Header
-	(*GatewayRoute_HttpRoute_Match_Query)(nil),                 // 23: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Query
-	(*GatewayRoute_HttpRoute_Filter_RequestHeader)(nil),        // 24: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader
-	(*GatewayRoute_HttpRoute_Filter_Mirror)(nil),               // 25: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Mirror
-	(*GatewayRoute_HttpRoute_Filter_Redirect)(nil),             // 26: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Redirect
-	(*GatewayRoute_HttpRoute_Filter_RequestHeader_Header)(nil), // 27: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.Header
-	(*Selector)(nil),                                           // 28: kuma.mesh.v1alpha1.Selector
-	(*wrapperspb.DoubleValue)(nil),                             // 29: google.protobuf.DoubleValue
+	(GatewayRoute_HttpRoute_Match_Path_PrefixMatch)(0),         // 2: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path.PrefixMatch
+	(GatewayRoute_HttpRoute_Match_Header_MatchType)(0),         // 3: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Header.MatchType
+	(GatewayRoute_HttpRoute_Match_Query_MatchType)(0),          // 4: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Query.MatchType
+	(*GatewayRoute)(nil),                                       // 5: kuma.mesh.v1alpha1.GatewayRoute
+	(*GatewayRoute_Backend)(nil),                               // 6: kuma.mesh.v1alpha1.GatewayRoute.Backend
+	(*GatewayRoute_UdpRoute)(nil),                              // 7: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute
+	(*GatewayRoute_TcpRoute)(nil),                              // 8: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute
+	(*GatewayRoute_TlsRoute)(nil),                              // 9: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute
+	(*GatewayRoute_HttpRoute)(nil),                             // 10: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute
+	(*GatewayRoute_Conf)(nil),                                  // 11: kuma.mesh.v1alpha1.GatewayRoute.Conf
+	nil,                                                        // 12: kuma.mesh.v1alpha1.GatewayRoute.Backend.DestinationEntry
+	(*GatewayRoute_UdpRoute_Match)(nil),                        // 13: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Match
+	(*GatewayRoute_UdpRoute_Rule)(nil),                         // 14: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Rule
+	(*GatewayRoute_TcpRoute_Match)(nil),                        // 15: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Match
+	(*GatewayRoute_TcpRoute_Rule)(nil),                         // 16: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Rule
+	(*GatewayRoute_TlsRoute_Match)(nil),                        // 17: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Match
+	(*GatewayRoute_TlsRoute_Rule)(nil),                         // 18: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Rule
+	(*GatewayRoute_HttpRoute_Match)(nil),                       // 19: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match
+	(*GatewayRoute_HttpRoute_Filter)(nil),                      // 20: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter
+	(*GatewayRoute_HttpRoute_Rule)(nil),                        // 21: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule
+	(*GatewayRoute_HttpRoute_Match_Path)(nil),                  // 22: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path
+	(*GatewayRoute_HttpRoute_Match_Header)(nil),                // 23: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Header
+	(*GatewayRoute_HttpRoute_Match_Query)(nil),                 // 24: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Query
+	(*GatewayRoute_HttpRoute_Match_Client)(nil),                // 25: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Client
+	(*GatewayRoute_HttpRoute_Match_Grpc)(nil),                  // 26: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Grpc
+	(*GatewayRoute_HttpRoute_Filter_RequestHeader)(nil),        // 27: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader
+	(*GatewayRoute_HttpRoute_Filter_ResponseHeader)(nil),       // 28: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.ResponseHeader
+	(*GatewayRoute_HttpRoute_Filter_Mirror)(nil),               // 29: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Mirror
+	(*GatewayRoute_HttpRoute_Filter_Redirect)(nil),             // 30: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Redirect
+	(*GatewayRoute_HttpRoute_Filter_Rewrite)(nil),              // 31: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Rewrite
+	(*GatewayRoute_HttpRoute_Filter_DirectResponse)(nil),       // 32: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.DirectResponse
+	(*GatewayRoute_HttpRoute_Filter_Cache)(nil),                // 33: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Cache
+	(*GatewayRoute_HttpRoute_Filter_Cors)(nil),                 // 34: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Cors
+	(*GatewayRoute_HttpRoute_Filter_Buffer)(nil),               // 35: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Buffer
+	(*GatewayRoute_HttpRoute_Filter_RequestHeader_Header)(nil), // 36: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	(*GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace)(nil), // 37: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Rewrite.RegexReplace
+	(*GatewayRoute_HttpRoute_Rule_Upgrades)(nil),               // 38: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.Upgrades
+	(*GatewayRoute_HttpRoute_Rule_Waf)(nil),                    // 39: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.Waf
+	(*GatewayRoute_HttpRoute_Rule_Sticky)(nil),                 // 40: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.Sticky
+	(*GatewayRoute_HttpRoute_Rule_Jwt)(nil),                    // 41: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.Jwt
+	(*Selector)(nil),                                           // 42: kuma.mesh.v1alpha1.Selector
+	(*FaultInjection_Conf)(nil),                                // 43: kuma.mesh.v1alpha1.FaultInjection.Conf
+	(*wrapperspb.DoubleValue)(nil),                             // 44: google.protobuf.DoubleValue
+	(*durationpb.Duration)(nil),                                // 45: google.protobuf.Duration
 }
 var file_mesh_v1alpha1_gateway_route_proto_depIdxs = []int32{
-	28, // 0: kuma.mesh.v1alpha1.GatewayRoute.selectors:type_name -> kuma.mesh.v1alpha1.Selector
-	10, // 1: kuma.mesh.v1alpha1.GatewayRoute.conf:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Conf
-	11, // 2: kuma.mesh.v1alpha1.GatewayRoute.Backend.destination:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend.DestinationEntry
-	13, // 3: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.rules:type_name -> kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Rule
-	15, // 4: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.rules:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Rule
-	17, // 5: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.rules:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Rule
-	20, // 6: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.rules:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule
-	6,  // 7: kuma.mesh.v1alpha1.GatewayRoute.Conf.udp:type_name -> kuma.mesh.v1alpha1.GatewayRoute.UdpRoute
-	7,  // 8: kuma.mesh.v1alpha1.GatewayRoute.Conf.tcp:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TcpRoute
-	8,  // 9: kuma.mesh.v1alpha1.GatewayRoute.Conf.tls:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TlsRoute
-	9,  // 10: kuma.mesh.v1alpha1.GatewayRoute.Conf.http:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute
-	12, // 11: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Match
-	5,  // 12: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend
-	14, // 13: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Match
-	5,  // 14: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend
-	16, // 15: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Match
-	5,  // 16: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend
-	21, // 17: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.path:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path
+	42, // 0: kuma.mesh.v1alpha1.GatewayRoute.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	11, // 1: kuma.mesh.v1alpha1.GatewayRoute.conf:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Conf
+	12, // 2: kuma.mesh.v1alpha1.GatewayRoute.Backend.destination:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend.DestinationEntry
+	14, // 3: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.rules:type_name -> kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Rule
+	16, // 4: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.rules:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Rule
+	18, // 5: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.rules:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Rule
+	21, // 6: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.rules:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule
+	7,  // 7: kuma.mesh.v1alpha1.GatewayRoute.Conf.udp:type_name -> kuma.mesh.v1alpha1.GatewayRoute.UdpRoute
+	8,  // 8: kuma.mesh.v1alpha1.GatewayRoute.Conf.tcp:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TcpRoute
+	9,  // 9: kuma.mesh.v1alpha1.GatewayRoute.Conf.tls:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TlsRoute
+	10, // 10: kuma.mesh.v1alpha1.GatewayRoute.Conf.http:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute
+	13, // 11: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Match
+	6,  // 12: kuma.mesh.v1alpha1.GatewayRoute.UdpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend
+	15, // 13: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Match
+	6,  // 14: kuma.mesh.v1alpha1.GatewayRoute.TcpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend
+	17, // 15: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Match
+	6,  // 16: kuma.mesh.v1alpha1.GatewayRoute.TlsRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend
+	22, // 17: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.path:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path
 	0,  // 18: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.method:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Method
-	22, // 19: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.headers:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Header
-	23, // 20: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.query_parameters:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Query
-	24, // 21: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.request_header:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader
-	25, // 22: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.mirror:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Mirror
-	26, // 23: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.redirect:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Redirect
-	18, // 24: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match
-	19, // 25: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.filters:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter
-	5,  // 26: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend
-	1,  // 27: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path.match:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path.MatchType
-	2,  // 28: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Header.match:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Header.MatchType
-	3,  // 29: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Query.match:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Query.MatchType
-	27, // 30: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.set:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.Header
-	27, // 31: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.add:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.Header
-	5,  // 32: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Mirror.backend:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend
-	29, // 33: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Mirror.percentage:type_name -> google.protobuf.DoubleValue
-	34, // [34:34] is the sub-list for method output_type
-	34, // [34:34] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	23, // 19: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.headers:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Header
+	24, // 20: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.query_parameters:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Query
+	25, // 21: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.client:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Client
+	26, // 22: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.grpc:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Grpc
+	27, // 23: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.request_header:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader
+	29, // 24: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.mirror:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Mirror
+	30, // 25: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.redirect:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Redirect
+	31, // 26: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.rewrite:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Rewrite
+	28, // 27: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.response_header:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.ResponseHeader
+	32, // 28: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.direct_response:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.DirectResponse
+	33, // 29: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.cache:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Cache
+	34, // 30: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.cors:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Cors
+	35, // 31: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.buffer:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Buffer
+	43, // 32: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.fault_injection:type_name -> kuma.mesh.v1alpha1.FaultInjection.Conf
+	19, // 33: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match
+	20, // 34: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.filters:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter
+	6,  // 35: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend
+	38, // 36: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.upgrades:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.Upgrades
+	39, // 37: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.waf:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.Waf
+	40, // 38: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.sticky:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.Sticky
+	41, // 39: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.jwt:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.Jwt
+	1,  // 40: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path.match:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path.MatchType
+	2,  // 41: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path.prefix_match:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Path.PrefixMatch
+	3,  // 42: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Header.match:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Header.MatchType
+	4,  // 43: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Query.match:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Query.MatchType
+	23, // 44: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Grpc.metadata:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Match.Header
+	36, // 45: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.set:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	36, // 46: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.add:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	36, // 47: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.ResponseHeader.set:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	36, // 48: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.ResponseHeader.add:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	6,  // 49: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Mirror.backend:type_name -> kuma.mesh.v1alpha1.GatewayRoute.Backend
+	44, // 50: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Mirror.percentage:type_name -> google.protobuf.DoubleValue
+	37, // 51: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Rewrite.replace_regex:type_name -> kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Rewrite.RegexReplace
+	45, // 52: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Cache.ttl:type_name -> google.protobuf.Duration
+	45, // 53: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Filter.Cors.max_age:type_name -> google.protobuf.Duration
+	45, // 54: kuma.mesh.v1alpha1.GatewayRoute.HttpRoute.Rule.Sticky.ttl:type_name -> google.protobuf.Duration
+	55, // [55:55] is the sub-list for method output_type
+	55, // [55:55] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_gateway_route_proto_init() }
@@ -2025,6 +3407,7 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 	if File_mesh_v1alpha1_gateway_route_proto != nil {
 		return
 	}
+	file_mesh_v1alpha1_fault_injection_proto_init()
 	file_mesh_v1alpha1_selector_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_mesh_v1alpha1_gateway_route_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -2256,7 +3639,7 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_route_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayRoute_HttpRoute_Filter_RequestHeader); i {
+			switch v := v.(*GatewayRoute_HttpRoute_Match_Client); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2268,7 +3651,7 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_route_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayRoute_HttpRoute_Filter_Mirror); i {
+			switch v := v.(*GatewayRoute_HttpRoute_Match_Grpc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2280,7 +3663,7 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_route_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayRoute_HttpRoute_Filter_Redirect); i {
+			switch v := v.(*GatewayRoute_HttpRoute_Filter_RequestHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2292,6 +3675,102 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_route_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Filter_ResponseHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Filter_Mirror); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Filter_Redirect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Filter_Rewrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Filter_DirectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Filter_Cache); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Filter_Cors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Filter_Buffer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GatewayRoute_HttpRoute_Filter_RequestHeader_Header); i {
 			case 0:
 				return &v.state
@@ -2303,6 +3782,66 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Rule_Upgrades); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Rule_Waf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Rule_Sticky); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayRoute_HttpRoute_Rule_Jwt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_mesh_v1alpha1_gateway_route_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*GatewayRoute_Conf_Udp)(nil),
@@ -2314,14 +3853,21 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 		(*GatewayRoute_HttpRoute_Filter_RequestHeader_)(nil),
 		(*GatewayRoute_HttpRoute_Filter_Mirror_)(nil),
 		(*GatewayRoute_HttpRoute_Filter_Redirect_)(nil),
+		(*GatewayRoute_HttpRoute_Filter_Rewrite_)(nil),
+		(*GatewayRoute_HttpRoute_Filter_ResponseHeader_)(nil),
+		(*GatewayRoute_HttpRoute_Filter_DirectResponse_)(nil),
+		(*GatewayRoute_HttpRoute_Filter_Cache_)(nil),
+		(*GatewayRoute_HttpRoute_Filter_Cors_)(nil),
+		(*GatewayRoute_HttpRoute_Filter_Buffer_)(nil),
+		(*GatewayRoute_HttpRoute_Filter_FaultInjection)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_gateway_route_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   24,
+			NumEnums:      5,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,8 +1,10 @@
 package v1alpha1
 
 const (
-	LoggingTcpType  = "tcp"
-	LoggingFileType = "file"
+	LoggingTcpType    = "tcp"
+	LoggingFileType   = "file"
+	LoggingSplunkType = "splunk"
+	LoggingGrpcType   = "grpc"
 
 	TracingZipkinType  = "zipkin"
 	TracingDatadogType = "datadog"
@@ -1,9 +1,62 @@
 package v1alpha1
 
+const (
+	GuardrailProxyTemplateCustomization = "ProxyTemplateCustomization"
+	GuardrailProxyPatch                 = "ProxyPatch"
+	GuardrailPassthrough                = "Passthrough"
+	GuardrailPlaintextExternalService   = "PlaintextExternalService"
+)
+
+var AllGuardrailFeatures = []string{
+	GuardrailProxyTemplateCustomization,
+	GuardrailProxyPatch,
+	GuardrailPassthrough,
+	GuardrailPlaintextExternalService,
+}
+
+const (
+	RequestIdGeneratorUuid         = "Uuid"
+	RequestIdGeneratorTraceContext = "TraceContext"
+
+	DefaultRequestIdHeader = "x-request-id"
+)
+
+var RequestIdGenerators = []string{
+	RequestIdGeneratorUuid,
+	RequestIdGeneratorTraceContext,
+}
+
+// IsPassthrough returns true if the outbound traffic to unknown destinations is passed through.
+// Passthrough is disabled when it is denied by the guardrails of the mesh.
 func (m *Mesh) IsPassthrough() bool {
+	if m.IsDenied(GuardrailPassthrough) {
+		return false
+	}
 	passthrough := m.GetNetworking().GetOutbound().GetPassthrough()
 	if passthrough == nil {
 		return true
 	}
 	return passthrough.GetValue()
 }
+
+// IsDenied returns true if the feature is denied by the guardrails of the mesh.
+func (m *Mesh) IsDenied(feature string) bool {
+	for _, denied := range m.GetGuardrails().GetDeny() {
+		if denied == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// StrippedAtGateways returns names of the propagated headers that gateways remove from requests
+// coming from outside of the mesh.
+func (p *HeaderPropagation) StrippedAtGateways() []string {
+	var names []string
+	for _, header := range p.GetHeaders() {
+		if header.GetStripAtGateways() {
+			names = append(names, header.GetName())
+		}
+	}
+	return names
+}
@@ -0,0 +1,537 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.14.0
+// source: mesh/v1alpha1/proxy_patch.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProxyPatch applies targeted patches to the Envoy resources generated for
+// the selected data plane proxies.
+type ProxyPatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of Dataplane selectors.
+	Selectors []*Selector `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// Configuration for ProxyPatch
+	Conf *ProxyPatch_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *ProxyPatch) Reset() {
+	*x = ProxyPatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_proxy_patch_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyPatch) ProtoMessage() {}
+
+func (x *ProxyPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_proxy_patch_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyPatch.ProtoReflect.Descriptor instead.
+func (*ProxyPatch) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_proxy_patch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProxyPatch) GetSelectors() []*Selector {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *ProxyPatch) GetConf() *ProxyPatch_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+type ProxyPatch_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of patches applied in order.
+	Patches []*ProxyPatch_Patch `protobuf:"bytes,1,rep,name=patches,proto3" json:"patches,omitempty"`
+}
+
+func (x *ProxyPatch_Conf) Reset() {
+	*x = ProxyPatch_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_proxy_patch_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyPatch_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyPatch_Conf) ProtoMessage() {}
+
+func (x *ProxyPatch_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_proxy_patch_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyPatch_Conf.ProtoReflect.Descriptor instead.
+func (*ProxyPatch_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_proxy_patch_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ProxyPatch_Conf) GetPatches() []*ProxyPatch_Patch {
+	if x != nil {
+		return x.Patches
+	}
+	return nil
+}
+
+// Patch defines a modification of the generated resources that match.
+// Exactly one of jsonPatch and mergePatch has to be defined.
+type ProxyPatch_Patch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only resources that match will be patched.
+	Match *ProxyPatch_Patch_Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// List of JSON Patch (RFC 6902) operations applied to the resource.
+	JsonPatch []*ProxyPatch_Patch_JsonPatchOperation `protobuf:"bytes,2,rep,name=jsonPatch,proto3" json:"jsonPatch,omitempty"`
+	// JSON Merge Patch (RFC 7386) applied to the resource.
+	MergePatch *structpb.Struct `protobuf:"bytes,3,opt,name=mergePatch,proto3" json:"mergePatch,omitempty"`
+}
+
+func (x *ProxyPatch_Patch) Reset() {
+	*x = ProxyPatch_Patch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_proxy_patch_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyPatch_Patch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyPatch_Patch) ProtoMessage() {}
+
+func (x *ProxyPatch_Patch) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_proxy_patch_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyPatch_Patch.ProtoReflect.Descriptor instead.
+func (*ProxyPatch_Patch) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_proxy_patch_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *ProxyPatch_Patch) GetMatch() *ProxyPatch_Patch_Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *ProxyPatch_Patch) GetJsonPatch() []*ProxyPatch_Patch_JsonPatchOperation {
+	if x != nil {
+		return x.JsonPatch
+	}
+	return nil
+}
+
+func (x *ProxyPatch_Patch) GetMergePatch() *structpb.Struct {
+	if x != nil {
+		return x.MergePatch
+	}
+	return nil
+}
+
+// Match defines a match for a generated resource
+type ProxyPatch_Patch_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the resource (Listener, Cluster, RouteConfiguration,
+	// ClusterLoadAssignment)
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Name of the resource to match. All resources of the type are patched
+	// if empty.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Origin of the resource generation. (inbound, outbound, prometheus,
+	// transparent, ingress)
+	Origin string `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (x *ProxyPatch_Patch_Match) Reset() {
+	*x = ProxyPatch_Patch_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_proxy_patch_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyPatch_Patch_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyPatch_Patch_Match) ProtoMessage() {}
+
+func (x *ProxyPatch_Patch_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_proxy_patch_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyPatch_Patch_Match.ProtoReflect.Descriptor instead.
+func (*ProxyPatch_Patch_Match) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_proxy_patch_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+func (x *ProxyPatch_Patch_Match) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProxyPatch_Patch_Match) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProxyPatch_Patch_Match) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+// JsonPatchOperation defines a single JSON Patch operation
+type ProxyPatch_Patch_JsonPatchOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Operation (add, remove, replace, move, copy, test)
+	Op string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	// JSON Pointer of the modified value, e.g. /connectTimeout
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// JSON Pointer of the source value for move and copy operations
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Value for add, replace and test operations
+	Value *structpb.Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ProxyPatch_Patch_JsonPatchOperation) Reset() {
+	*x = ProxyPatch_Patch_JsonPatchOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_proxy_patch_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyPatch_Patch_JsonPatchOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyPatch_Patch_JsonPatchOperation) ProtoMessage() {}
+
+func (x *ProxyPatch_Patch_JsonPatchOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_proxy_patch_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyPatch_Patch_JsonPatchOperation.ProtoReflect.Descriptor instead.
+func (*ProxyPatch_Patch_JsonPatchOperation) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_proxy_patch_proto_rawDescGZIP(), []int{0, 1, 1}
+}
+
+func (x *ProxyPatch_Patch_JsonPatchOperation) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *ProxyPatch_Patch_JsonPatchOperation) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ProxyPatch_Patch_JsonPatchOperation) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ProxyPatch_Patch_JsonPatchOperation) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_proxy_patch_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_proxy_patch_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x05, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63,
+	0x6f, 0x6e, 0x66, 0x1a, 0x4c, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x44, 0x0a, 0x07, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x1a, 0xb7, 0x03, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x46, 0x0a, 0x05, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x55, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4a, 0x73, 0x6f,
+	0x6e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x37, 0x0a, 0x0a, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x1a, 0x4d, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x1a, 0x86, 0x01, 0x0a, 0x12, 0x4a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x02, 0x6f, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x02, 0x6f, 0x70, 0x12,
+	0x18, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88,
+	0xb5, 0x18, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2c, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x4c, 0xaa, 0x8c, 0x89,
+	0xa6, 0x01, 0x46, 0x12, 0x0a, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0x04, 0x6d, 0x65, 0x73, 0x68, 0x52, 0x02, 0x10, 0x01, 0x3a, 0x1a, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x4b, 0x5a, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b,
+	0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x1d, 0xa2, 0x01, 0x0a, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0xf2, 0x01, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2d, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_proxy_patch_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_proxy_patch_proto_rawDescData = file_mesh_v1alpha1_proxy_patch_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_proxy_patch_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_proxy_patch_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_proxy_patch_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_proxy_patch_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_proxy_patch_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_proxy_patch_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_mesh_v1alpha1_proxy_patch_proto_goTypes = []interface{}{
+	(*ProxyPatch)(nil),                          // 0: kuma.mesh.v1alpha1.ProxyPatch
+	(*ProxyPatch_Conf)(nil),                     // 1: kuma.mesh.v1alpha1.ProxyPatch.Conf
+	(*ProxyPatch_Patch)(nil),                    // 2: kuma.mesh.v1alpha1.ProxyPatch.Patch
+	(*ProxyPatch_Patch_Match)(nil),              // 3: kuma.mesh.v1alpha1.ProxyPatch.Patch.Match
+	(*ProxyPatch_Patch_JsonPatchOperation)(nil), // 4: kuma.mesh.v1alpha1.ProxyPatch.Patch.JsonPatchOperation
+	(*Selector)(nil),                            // 5: kuma.mesh.v1alpha1.Selector
+	(*structpb.Struct)(nil),                     // 6: google.protobuf.Struct
+	(*structpb.Value)(nil),                      // 7: google.protobuf.Value
+}
+var file_mesh_v1alpha1_proxy_patch_proto_depIdxs = []int32{
+	5, // 0: kuma.mesh.v1alpha1.ProxyPatch.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	1, // 1: kuma.mesh.v1alpha1.ProxyPatch.conf:type_name -> kuma.mesh.v1alpha1.ProxyPatch.Conf
+	2, // 2: kuma.mesh.v1alpha1.ProxyPatch.Conf.patches:type_name -> kuma.mesh.v1alpha1.ProxyPatch.Patch
+	3, // 3: kuma.mesh.v1alpha1.ProxyPatch.Patch.match:type_name -> kuma.mesh.v1alpha1.ProxyPatch.Patch.Match
+	4, // 4: kuma.mesh.v1alpha1.ProxyPatch.Patch.jsonPatch:type_name -> kuma.mesh.v1alpha1.ProxyPatch.Patch.JsonPatchOperation
+	6, // 5: kuma.mesh.v1alpha1.ProxyPatch.Patch.mergePatch:type_name -> google.protobuf.Struct
+	7, // 6: kuma.mesh.v1alpha1.ProxyPatch.Patch.JsonPatchOperation.value:type_name -> google.protobuf.Value
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_proxy_patch_proto_init() }
+func file_mesh_v1alpha1_proxy_patch_proto_init() {
+	if File_mesh_v1alpha1_proxy_patch_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_proxy_patch_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyPatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_proxy_patch_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyPatch_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_proxy_patch_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyPatch_Patch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_proxy_patch_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyPatch_Patch_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_proxy_patch_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyPatch_Patch_JsonPatchOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_proxy_patch_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_proxy_patch_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_proxy_patch_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_proxy_patch_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_proxy_patch_proto = out.File
+	file_mesh_v1alpha1_proxy_patch_proto_rawDesc = nil
+	file_mesh_v1alpha1_proxy_patch_proto_goTypes = nil
+	file_mesh_v1alpha1_proxy_patch_proto_depIdxs = nil
+}
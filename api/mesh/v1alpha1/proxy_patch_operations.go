@@ -0,0 +1,17 @@
+package v1alpha1
+
+const (
+	PatchTypeListener              = "Listener"
+	PatchTypeCluster               = "Cluster"
+	PatchTypeRouteConfiguration    = "RouteConfiguration"
+	PatchTypeClusterLoadAssignment = "ClusterLoadAssignment"
+)
+
+const (
+	JsonPatchOpAdd     = "add"
+	JsonPatchOpRemove  = "remove"
+	JsonPatchOpReplace = "replace"
+	JsonPatchOpMove    = "move"
+	JsonPatchOpCopy    = "copy"
+	JsonPatchOpTest    = "test"
+)
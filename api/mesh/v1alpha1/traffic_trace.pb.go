@@ -11,6 +11,7 @@ import (
 	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -88,6 +89,8 @@ type TrafficTrace_Conf struct {
 
 	// Backend defined in the Mesh entity.
 	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Sampling of specific services and routes.
+	Sampling *TrafficTrace_Conf_Sampling `protobuf:"bytes,2,opt,name=sampling,proto3" json:"sampling,omitempty"`
 }
 
 func (x *TrafficTrace_Conf) Reset() {
@@ -129,6 +132,203 @@ func (x *TrafficTrace_Conf) GetBackend() string {
 	return ""
 }
 
+func (x *TrafficTrace_Conf) GetSampling() *TrafficTrace_Conf_Sampling {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+// Sampling overrides the sampling of the backend for the traffic to
+// specific destination services and for specific gateway routes.
+type TrafficTrace_Conf_Sampling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sampling of the traffic to destination services. The traffic to
+	// other services is sampled according to the backend.
+	Services []*TrafficTrace_Conf_Sampling_Service `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	// Sampling of the traffic routed by gateway routes. The traffic of
+	// other routes is sampled according to the backend.
+	Routes []*TrafficTrace_Conf_Sampling_Route `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
+	// Maximum percentage of the sampled requests of selected
+	// dataplanes, regardless of the sampling of services and routes.
+	MaxPercentage *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=maxPercentage,proto3" json:"maxPercentage,omitempty"`
+}
+
+func (x *TrafficTrace_Conf_Sampling) Reset() {
+	*x = TrafficTrace_Conf_Sampling{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficTrace_Conf_Sampling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficTrace_Conf_Sampling) ProtoMessage() {}
+
+func (x *TrafficTrace_Conf_Sampling) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficTrace_Conf_Sampling.ProtoReflect.Descriptor instead.
+func (*TrafficTrace_Conf_Sampling) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_trace_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *TrafficTrace_Conf_Sampling) GetServices() []*TrafficTrace_Conf_Sampling_Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *TrafficTrace_Conf_Sampling) GetRoutes() []*TrafficTrace_Conf_Sampling_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *TrafficTrace_Conf_Sampling) GetMaxPercentage() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.MaxPercentage
+	}
+	return nil
+}
+
+// Service defines the sampling of the traffic to the service.
+type TrafficTrace_Conf_Sampling_Service struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value of the kuma.io/service tag of the destination service.
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// Percentage of the sampled requests (in the range 0.0 - 100.0,
+	// inclusive).
+	Percentage *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *TrafficTrace_Conf_Sampling_Service) Reset() {
+	*x = TrafficTrace_Conf_Sampling_Service{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficTrace_Conf_Sampling_Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficTrace_Conf_Sampling_Service) ProtoMessage() {}
+
+func (x *TrafficTrace_Conf_Sampling_Service) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficTrace_Conf_Sampling_Service.ProtoReflect.Descriptor instead.
+func (*TrafficTrace_Conf_Sampling_Service) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_trace_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (x *TrafficTrace_Conf_Sampling_Service) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *TrafficTrace_Conf_Sampling_Service) GetPercentage() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Percentage
+	}
+	return nil
+}
+
+// Route defines the sampling of the traffic routed by the gateway
+// route.
+type TrafficTrace_Conf_Sampling_Route struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the GatewayRoute.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Percentage of the sampled requests (in the range 0.0 - 100.0,
+	// inclusive).
+	Percentage *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *TrafficTrace_Conf_Sampling_Route) Reset() {
+	*x = TrafficTrace_Conf_Sampling_Route{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficTrace_Conf_Sampling_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficTrace_Conf_Sampling_Route) ProtoMessage() {}
+
+func (x *TrafficTrace_Conf_Sampling_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficTrace_Conf_Sampling_Route.ProtoReflect.Descriptor instead.
+func (*TrafficTrace_Conf_Sampling_Route) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_trace_proto_rawDescGZIP(), []int{0, 0, 0, 1}
+}
+
+func (x *TrafficTrace_Conf_Sampling_Route) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrafficTrace_Conf_Sampling_Route) GetPercentage() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Percentage
+	}
+	return nil
+}
+
 var File_mesh_v1alpha1_traffic_trace_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_traffic_trace_proto_rawDesc = []byte{
@@ -139,7 +339,9 @@ var file_mesh_v1alpha1_traffic_trace_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73,
 	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63,
 	0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x02, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x66,
+	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x06, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x66,
 	0x66, 0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65,
 	0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75,
 	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
@@ -148,20 +350,52 @@ var file_mesh_v1alpha1_traffic_trace_proto_rawDesc = []byte{
 	0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
 	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
 	0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52,
-	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0x20, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a,
-	0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x3a, 0x5d, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x16, 0x0a,
-	0x14, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0e, 0x12, 0x0c, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22,
-	0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa,
-	0x8c, 0x89, 0xa6, 0x01, 0x11, 0x3a, 0x0f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x2d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x42, 0x4f, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x8a, 0xb5, 0x18, 0x21, 0x50, 0x01, 0xa2, 0x01, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0xf2, 0x01, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0x2d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0x9d, 0x04, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x69, 0x6e, 0x67, 0x1a, 0xae, 0x03, 0x0a, 0x08, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e,
+	0x67, 0x12, 0x52, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x1a, 0x61, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x1a, 0x59, 0x0a, 0x05, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x3a, 0x5d, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x16, 0x0a, 0x14, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0e, 0x12, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d,
+	0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89,
+	0xa6, 0x01, 0x11, 0x3a, 0x0f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x42, 0x4f, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x8a, 0xb5, 0x18, 0x21, 0x50, 0x01, 0xa2, 0x01, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0xf2, 0x01, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -176,20 +410,30 @@ func file_mesh_v1alpha1_traffic_trace_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_traffic_trace_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_traffic_trace_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mesh_v1alpha1_traffic_trace_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_mesh_v1alpha1_traffic_trace_proto_goTypes = []interface{}{
-	(*TrafficTrace)(nil),      // 0: kuma.mesh.v1alpha1.TrafficTrace
-	(*TrafficTrace_Conf)(nil), // 1: kuma.mesh.v1alpha1.TrafficTrace.Conf
-	(*Selector)(nil),          // 2: kuma.mesh.v1alpha1.Selector
+	(*TrafficTrace)(nil),                       // 0: kuma.mesh.v1alpha1.TrafficTrace
+	(*TrafficTrace_Conf)(nil),                  // 1: kuma.mesh.v1alpha1.TrafficTrace.Conf
+	(*TrafficTrace_Conf_Sampling)(nil),         // 2: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling
+	(*TrafficTrace_Conf_Sampling_Service)(nil), // 3: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.Service
+	(*TrafficTrace_Conf_Sampling_Route)(nil),   // 4: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.Route
+	(*Selector)(nil),                           // 5: kuma.mesh.v1alpha1.Selector
+	(*wrapperspb.DoubleValue)(nil),             // 6: google.protobuf.DoubleValue
 }
 var file_mesh_v1alpha1_traffic_trace_proto_depIdxs = []int32{
-	2, // 0: kuma.mesh.v1alpha1.TrafficTrace.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	5, // 0: kuma.mesh.v1alpha1.TrafficTrace.selectors:type_name -> kuma.mesh.v1alpha1.Selector
 	1, // 1: kuma.mesh.v1alpha1.TrafficTrace.conf:type_name -> kuma.mesh.v1alpha1.TrafficTrace.Conf
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 2: kuma.mesh.v1alpha1.TrafficTrace.Conf.sampling:type_name -> kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling
+	3, // 3: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.services:type_name -> kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.Service
+	4, // 4: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.routes:type_name -> kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.Route
+	6, // 5: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.maxPercentage:type_name -> google.protobuf.DoubleValue
+	6, // 6: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.Service.percentage:type_name -> google.protobuf.DoubleValue
+	6, // 7: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.Route.percentage:type_name -> google.protobuf.DoubleValue
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_traffic_trace_proto_init() }
@@ -223,6 +467,42 @@ func file_mesh_v1alpha1_traffic_trace_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_traffic_trace_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficTrace_Conf_Sampling); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_trace_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficTrace_Conf_Sampling_Service); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_trace_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficTrace_Conf_Sampling_Route); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -230,7 +510,7 @@ func file_mesh_v1alpha1_traffic_trace_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_traffic_trace_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
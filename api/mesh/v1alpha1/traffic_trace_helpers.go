@@ -0,0 +1,27 @@
+package v1alpha1
+
+import (
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ServicePercentage returns the sampling of the traffic to the service or nil
+// if the traffic is sampled according to the backend.
+func (s *TrafficTrace_Conf_Sampling) ServicePercentage(service string) *wrapperspb.DoubleValue {
+	for _, svc := range s.GetServices() {
+		if svc.GetService() == service {
+			return svc.GetPercentage()
+		}
+	}
+	return nil
+}
+
+// RoutePercentage returns the sampling of the traffic routed by the gateway
+// route or nil if the traffic is sampled according to the backend.
+func (s *TrafficTrace_Conf_Sampling) RoutePercentage(name string) *wrapperspb.DoubleValue {
+	for _, route := range s.GetRoutes() {
+		if route.GetName() == name {
+			return route.GetPercentage()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	. "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+var _ = Describe("TrafficTraceHelper", func() {
+
+	sampling := &TrafficTrace_Conf_Sampling{
+		Services: []*TrafficTrace_Conf_Sampling_Service{
+			{
+				Service:    "backend",
+				Percentage: wrapperspb.Double(0.1),
+			},
+		},
+		Routes: []*TrafficTrace_Conf_Sampling_Route{
+			{
+				Name:       "checkout",
+				Percentage: wrapperspb.Double(100),
+			},
+		},
+	}
+
+	It("should return sampling of the service", func() {
+		Expect(sampling.ServicePercentage("backend").GetValue()).To(Equal(0.1))
+		Expect(sampling.ServicePercentage("web")).To(BeNil())
+	})
+
+	It("should return sampling of the route", func() {
+		Expect(sampling.RoutePercentage("checkout").GetValue()).To(Equal(100.0))
+		Expect(sampling.RoutePercentage("catalog")).To(BeNil())
+	})
+
+	It("should handle nil sampling", func() {
+		var sampling *TrafficTrace_Conf_Sampling
+		Expect(sampling.ServicePercentage("backend")).To(BeNil())
+		Expect(sampling.RoutePercentage("checkout")).To(BeNil())
+	})
+})
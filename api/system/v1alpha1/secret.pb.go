@@ -30,6 +30,10 @@ type Secret struct {
 
 	// Value of the secret
 	Data *wrapperspb.BytesValue `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// External references a value stored in an external secret manager,
+	// instead of holding the data. Only mesh scoped secrets can reference
+	// external values.
+	External *Secret_External `protobuf:"bytes,2,opt,name=external,proto3" json:"external,omitempty"`
 }
 
 func (x *Secret) Reset() {
@@ -71,6 +75,88 @@ func (x *Secret) GetData() *wrapperspb.BytesValue {
 	return nil
 }
 
+func (x *Secret) GetExternal() *Secret_External {
+	if x != nil {
+		return x.External
+	}
+	return nil
+}
+
+// External is a reference to a value stored in an external secret
+// manager. The value is fetched by the control plane, which caches it
+// and fetches it again periodically, so rotated values are picked up.
+type Secret_External struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Provider is the secret manager that stores the value. Available
+	// providers: "vault" (HashiCorp Vault KV version 2),
+	// "awsSecretsManager" (AWS Secrets Manager).
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Path of the secret. For Vault, it's the path of the secret in the
+	// KV engine. For AWS Secrets Manager, it's the name or the ARN of the
+	// secret.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Key of the value in the secret. Vault secrets are maps, so the key is
+	// required. The values of AWS Secrets Manager are used as they are,
+	// unless the key is set, in which case they are parsed as JSON objects.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *Secret_External) Reset() {
+	*x = Secret_External{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_system_v1alpha1_secret_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secret_External) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret_External) ProtoMessage() {}
+
+func (x *Secret_External) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1alpha1_secret_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret_External.ProtoReflect.Descriptor instead.
+func (*Secret_External) Descriptor() ([]byte, []int) {
+	return file_system_v1alpha1_secret_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Secret_External) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Secret_External) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Secret_External) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
 var File_system_v1alpha1_secret_proto protoreflect.FileDescriptor
 
 var file_system_v1alpha1_secret_proto_rawDesc = []byte{
@@ -80,20 +166,29 @@ var file_system_v1alpha1_secret_proto_rawDesc = []byte{
 	0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x63,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x02, 0x0a, 0x06, 0x53, 0x65, 0x63,
 	0x72, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x5e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x10, 0x0a, 0x0e, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6,
-	0x01, 0x08, 0x12, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x08,
-	0x22, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x28, 0x01,
-	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0a,
-	0x3a, 0x08, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04,
-	0x3a, 0x02, 0x20, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x08, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x1a, 0x4c, 0x0a, 0x08, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x3a, 0x5e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x10, 0x0a, 0x0e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89,
+	0xa6, 0x01, 0x08, 0x12, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0xaa, 0x8c, 0x89, 0xa6, 0x01,
+	0x08, 0x22, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x28,
+	0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01,
+	0x0a, 0x3a, 0x08, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0xaa, 0x8c, 0x89, 0xa6, 0x01,
+	0x04, 0x3a, 0x02, 0x20, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -108,18 +203,20 @@ func file_system_v1alpha1_secret_proto_rawDescGZIP() []byte {
 	return file_system_v1alpha1_secret_proto_rawDescData
 }
 
-var file_system_v1alpha1_secret_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_system_v1alpha1_secret_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_system_v1alpha1_secret_proto_goTypes = []interface{}{
 	(*Secret)(nil),                // 0: kuma.system.v1alpha1.Secret
-	(*wrapperspb.BytesValue)(nil), // 1: google.protobuf.BytesValue
+	(*Secret_External)(nil),       // 1: kuma.system.v1alpha1.Secret.External
+	(*wrapperspb.BytesValue)(nil), // 2: google.protobuf.BytesValue
 }
 var file_system_v1alpha1_secret_proto_depIdxs = []int32{
-	1, // 0: kuma.system.v1alpha1.Secret.data:type_name -> google.protobuf.BytesValue
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: kuma.system.v1alpha1.Secret.data:type_name -> google.protobuf.BytesValue
+	1, // 1: kuma.system.v1alpha1.Secret.external:type_name -> kuma.system.v1alpha1.Secret.External
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_system_v1alpha1_secret_proto_init() }
@@ -140,6 +237,18 @@ func file_system_v1alpha1_secret_proto_init() {
 				return nil
 			}
 		}
+		file_system_v1alpha1_secret_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secret_External); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -147,7 +256,7 @@ func file_system_v1alpha1_secret_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_system_v1alpha1_secret_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
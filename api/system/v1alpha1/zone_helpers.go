@@ -1,8 +1,41 @@
 package v1alpha1
 
+import (
+	"time"
+)
+
 func (x *Zone) IsEnabled() bool {
 	if x.Enabled == nil {
 		return true
 	}
 	return x.Enabled.GetValue()
 }
+
+// IsDraining returns true when the zone is being drained or is already drained.
+func (x *Zone) IsDraining() bool {
+	return x.GetDrain() != nil
+}
+
+// IsDrained returns true when the drain period of the zone is over.
+func (x *Zone) IsDrained(now time.Time) bool {
+	return x.IsDraining() && x.DrainRemaining(now) == 0
+}
+
+// DrainRemaining returns the fraction of endpoints of the zone that should still be available to other zones.
+// It's 1 when the zone is not draining and it decreases linearly to 0 over the drain period.
+func (x *Zone) DrainRemaining(now time.Time) float64 {
+	if !x.IsDraining() {
+		return 1
+	}
+	startTime := x.GetDrain().GetStartTime().AsTime()
+	period := x.GetDrain().GetPeriod().AsDuration()
+	elapsed := now.Sub(startTime)
+	switch {
+	case elapsed < 0:
+		return 1
+	case elapsed >= period:
+		return 0
+	default:
+		return 1 - float64(elapsed)/float64(period)
+	}
+}
@@ -0,0 +1,86 @@
+package v1alpha1_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Zone", func() {
+	Context("DrainRemaining", func() {
+		t1, _ := time.Parse(time.RFC3339, "2018-07-17T16:05:36.995+00:00")
+
+		It("should not drain zone without drain", func() {
+			// given
+			zone := &system_proto.Zone{}
+
+			// then
+			Expect(zone.IsDraining()).To(BeFalse())
+			Expect(zone.IsDrained(t1)).To(BeFalse())
+			Expect(zone.DrainRemaining(t1)).To(Equal(1.0))
+		})
+
+		type testCase struct {
+			now       time.Time
+			remaining float64
+			drained   bool
+		}
+
+		DescribeTable("should drain zone over the period",
+			func(given testCase) {
+				// given
+				zone := &system_proto.Zone{
+					Drain: &system_proto.Zone_Drain{
+						StartTime: util_proto.MustTimestampProto(t1),
+						Period:    durationpb.New(10 * time.Minute),
+					},
+				}
+
+				// then
+				Expect(zone.IsDraining()).To(BeTrue())
+				Expect(zone.DrainRemaining(given.now)).To(BeNumerically("~", given.remaining, 0.0001))
+				Expect(zone.IsDrained(given.now)).To(Equal(given.drained))
+			},
+			Entry("before the start", testCase{
+				now:       t1.Add(-time.Minute),
+				remaining: 1,
+			}),
+			Entry("at the start", testCase{
+				now:       t1,
+				remaining: 1,
+			}),
+			Entry("in the middle", testCase{
+				now:       t1.Add(4 * time.Minute),
+				remaining: 0.6,
+			}),
+			Entry("at the end", testCase{
+				now:       t1.Add(10 * time.Minute),
+				remaining: 0,
+				drained:   true,
+			}),
+			Entry("after the end", testCase{
+				now:       t1.Add(time.Hour),
+				remaining: 0,
+				drained:   true,
+			}),
+		)
+
+		It("should drain zone immediately without period", func() {
+			// given
+			zone := &system_proto.Zone{
+				Drain: &system_proto.Zone_Drain{
+					StartTime: util_proto.MustTimestampProto(t1),
+				},
+			}
+
+			// then
+			Expect(zone.IsDrained(t1)).To(BeTrue())
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
@@ -23,11 +24,13 @@ var (
 // newRootCmd represents the base command when called without any subcommands.
 func newRootCmd() *cobra.Command {
 	args := struct {
-		logLevel   string
-		outputPath string
-		maxSize    int
-		maxBackups int
-		maxAge     int
+		logLevel        string
+		logFormat       string
+		componentLevels map[string]string
+		outputPath      string
+		maxSize         int
+		maxBackups      int
+		maxAge          int
 	}{}
 	cmd := &cobra.Command{
 		Use:   "kuma-cp",
@@ -38,6 +41,20 @@ func newRootCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			format, err := kuma_log.ParseLogFormat(args.logFormat)
+			if err != nil {
+				return err
+			}
+
+			levels := kuma_log.DefaultComponentLevels
+			levels.SetDefault(level)
+			for component, text := range args.componentLevels {
+				componentLevel, err := kuma_log.ParseLogLevel(text)
+				if err != nil {
+					return errors.Wrapf(err, "invalid log level of component %q", component)
+				}
+				levels.Set(component, componentLevel)
+			}
 
 			if args.outputPath != "" {
 				output, err := filepath.Abs(args.outputPath)
@@ -46,9 +63,9 @@ func newRootCmd() *cobra.Command {
 				}
 
 				fmt.Printf("%s: logs will be stored in %q\n", "kuma-cp", output)
-				core.SetLogger(core.NewLoggerWithRotation(level, output, args.maxSize, args.maxBackups, args.maxAge))
+				core.SetLogger(core.NewComponentLoggerWithRotation(levels, format, output, args.maxSize, args.maxBackups, args.maxAge))
 			} else {
-				core.SetLogger(core.NewLogger(level))
+				core.SetLogger(core.NewComponentLogger(levels, format))
 			}
 
 			// once command line flags have been parsed,
@@ -63,6 +80,8 @@ func newRootCmd() *cobra.Command {
 
 	// root flags
 	cmd.PersistentFlags().StringVar(&args.logLevel, "log-level", kuma_log.InfoLevel.String(), kuma_cmd.UsageOptions("log level", kuma_log.OffLevel, kuma_log.InfoLevel, kuma_log.DebugLevel))
+	cmd.PersistentFlags().StringVar(&args.logFormat, "log-format", kuma_log.ConsoleFormat.String(), kuma_cmd.UsageOptions("log format", kuma_log.ConsoleFormat, kuma_log.JSONFormat))
+	cmd.PersistentFlags().StringToStringVar(&args.componentLevels, "log-component-levels", nil, "log levels of the components that override --log-level, e.g. xds-server=debug,kds=off. The level of a component also applies to its subcomponents. The levels can be changed at runtime on the /log-levels endpoint of the API server")
 	cmd.PersistentFlags().StringVar(&args.outputPath, "log-output-path", args.outputPath, "path to the file that will be filled with logs. Example: if we set it to /tmp/kuma.log then after the file is rotated we will have /tmp/kuma-2021-06-07T09-15-18.265.log")
 	cmd.PersistentFlags().IntVar(&args.maxBackups, "log-max-retained-files", 1000, "maximum number of the old log files to retain")
 	cmd.PersistentFlags().IntVar(&args.maxSize, "log-max-size", 100, "maximum size in megabytes of a log file before it gets rotated")
@@ -24,6 +24,8 @@ import (
 	kds_zone "github.com/kumahq/kuma/pkg/kds/zone"
 	mads_server "github.com/kumahq/kuma/pkg/mads/server"
 	metrics "github.com/kumahq/kuma/pkg/metrics/components"
+	"github.com/kumahq/kuma/pkg/recording"
+	"github.com/kumahq/kuma/pkg/servicediscovery"
 	"github.com/kumahq/kuma/pkg/util/os"
 	kuma_version "github.com/kumahq/kuma/pkg/version"
 	"github.com/kumahq/kuma/pkg/xds"
@@ -104,6 +106,10 @@ func newRunCmdWithOpts(opts kuma_cmd.RunCmdOpts) *cobra.Command {
 					runLog.Error(err, "unable to set up DP Server")
 					return err
 				}
+				if err := recording.Setup(rt); err != nil {
+					runLog.Error(err, "unable to set up Recording")
+					return err
+				}
 				if err := insights.Setup(rt); err != nil {
 					runLog.Error(err, "unable to set up Insights resyncer")
 					return err
@@ -112,6 +118,10 @@ func newRunCmdWithOpts(opts kuma_cmd.RunCmdOpts) *cobra.Command {
 					runLog.Error(err, "unable to set up Defaults")
 					return err
 				}
+				if err := servicediscovery.Setup(rt); err != nil {
+					runLog.Error(err, "unable to set up Service Discovery")
+					return err
+				}
 			case config_core.Zone:
 				if err := mads_server.SetupServer(rt); err != nil {
 					runLog.Error(err, "unable to set up Monitoring Assignment server")
@@ -150,6 +160,10 @@ func newRunCmdWithOpts(opts kuma_cmd.RunCmdOpts) *cobra.Command {
 					runLog.Error(err, "unable to set up Defaults")
 					return err
 				}
+				if err := servicediscovery.Setup(rt); err != nil {
+					runLog.Error(err, "unable to set up Service Discovery")
+					return err
+				}
 			}
 
 			if err := clusterid.Setup(rt); err != nil {
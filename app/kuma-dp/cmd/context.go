@@ -5,7 +5,11 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/accesslogs"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/drain"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/resourceusage"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/secrets"
 	kumadp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
 	"github.com/kumahq/kuma/pkg/log"
@@ -17,6 +21,10 @@ type RootContext struct {
 	ComponentManager         component.Manager
 	BootstrapGenerator       envoy.BootstrapConfigFactoryFunc
 	BootstrapDynamicMetadata map[string]string
+	SecretsFetcher           secrets.FetcherFunc
+	ResourceUsageSender      resourceusage.SenderFunc
+	DrainNotifier            drain.NotifierFunc
+	LoggingSecretFetcher     accesslogs.SecretFetcherFunc
 	Config                   *kumadp.Config
 	LogLevel                 log.LogLevel
 }
@@ -29,6 +37,22 @@ func DefaultRootContext() *RootContext {
 			Timeout:   10 * time.Second,
 			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
 		}),
+		SecretsFetcher: secrets.NewRemoteFetcher(&http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		}),
+		ResourceUsageSender: resourceusage.NewRemoteSender(&http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		}),
+		DrainNotifier: drain.NewRemoteNotifier(&http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		}),
+		LoggingSecretFetcher: accesslogs.NewRemoteSecretFetcher(&http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		}),
 		Config:                   &config,
 		BootstrapDynamicMetadata: map[string]string{},
 	}
@@ -12,8 +12,13 @@ import (
 	kumadp_config "github.com/kumahq/kuma/app/kuma-dp/pkg/config"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/accesslogs"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/dnsserver"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/drain"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/failmode"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/hooks"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/metrics"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/resourceusage"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/secrets"
 	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
 	"github.com/kumahq/kuma/pkg/config"
 	config_types "github.com/kumahq/kuma/pkg/config/types"
@@ -69,6 +74,11 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 				return errors.Errorf("invalid proxy type %q", cfg.Dataplane.ProxyType)
 			}
 
+			// only the dataplane proxy has the identity and trusts the Mesh CA
+			if cfg.DataplaneRuntime.SecretsDir != "" && cfg.Dataplane.ProxyType != string(mesh_proto.DataplaneProxyType) {
+				return errors.Errorf("secrets dir can only be used with proxy type %q", mesh_proto.DataplaneProxyType)
+			}
+
 			proxyResource, err = readResource(cmd, &cfg.DataplaneRuntime)
 			if err != nil {
 				runLog.Error(err, "failed to read policy", "proxyType", cfg.Dataplane.ProxyType)
@@ -153,26 +163,35 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 
 			shouldQuit := make(chan struct{})
 			ctx := opts.SetupSignalHandler()
-			go func() {
-				<-ctx.Done()
-				runLog.Info("Kuma DP caught an exit signal")
-				if shouldQuit != nil {
-					close(shouldQuit)
-				}
-			}()
+			// drainer is set only for the dataplane proxy, which is removed from the endpoints of other proxies before it stops
+			var drainer *drain.Drainer
 			components := []component.Component{
-				accesslogs.NewAccessLogServer(cfg.Dataplane),
+				accesslogs.NewAccessLogServer(*cfg, rootCtx.LoggingSecretFetcher),
+			}
+
+			// the fail mode is recorded in the metadata, so the Control Plane knows
+			// how the proxy behaves when its configuration becomes stale
+			dynamicMetadata := failmode.Metadata(cfg.Dataplane)
+			for key, value := range rootCtx.BootstrapDynamicMetadata {
+				dynamicMetadata[key] = value
 			}
 
+			hooksRunner := hooks.New(hooks.Opts{
+				Config: *cfg,
+				Stdout: cmd.OutOrStdout(),
+				Stderr: cmd.OutOrStderr(),
+			})
+
 			opts := envoy.Opts{
 				Config:          *cfg,
 				Generator:       rootCtx.BootstrapGenerator,
 				Dataplane:       rest.NewFromModel(proxyResource),
-				DynamicMetadata: rootCtx.BootstrapDynamicMetadata,
+				DynamicMetadata: dynamicMetadata,
 				Stdout:          cmd.OutOrStdout(),
 				Stderr:          cmd.OutOrStderr(),
 				Quit:            shouldQuit,
 				LogLevel:        rootCtx.LogLevel,
+				Hooks:           hooksRunner,
 			}
 
 			if cfg.DNS.Enabled {
@@ -194,6 +213,21 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 				components = append(components, dnsServer)
 			}
 
+			if cfg.DataplaneRuntime.SecretsDir != "" {
+				secretsAgent := secrets.New(secrets.Opts{
+					Config:    *cfg,
+					Fetcher:   rootCtx.SecretsFetcher,
+					Dataplane: rest.NewFromModel(proxyResource),
+					Hooks:     hooksRunner,
+				})
+				// Envoy has to find the files with the secrets on start
+				if err := secretsAgent.Init(); err != nil {
+					runLog.Error(err, "unable to deliver the secrets to the secrets dir")
+					return err
+				}
+				components = append(components, secretsAgent)
+			}
+
 			dataplane, err := envoy.New(opts)
 			if err != nil {
 				return err
@@ -201,6 +235,31 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 
 			components = append(components, dataplane)
 
+			if cfg.Dataplane.ProxyType == string(mesh_proto.DataplaneProxyType) {
+				resourceUsageReporter := resourceusage.New(resourceusage.Opts{
+					Config:    *cfg,
+					Sender:    rootCtx.ResourceUsageSender,
+					EnvoyPid:  dataplane.Pid,
+					AdminPort: adminPort,
+				})
+				components = append(components, resourceUsageReporter)
+
+				drainer = drain.New(drain.Opts{
+					Config:    *cfg,
+					Notifier:  rootCtx.DrainNotifier,
+					AdminPort: adminPort,
+				})
+
+				if adminPort != 0 && cfg.Dataplane.FailTimeout > 0 {
+					components = append(components, failmode.New(failmode.Opts{
+						Config:    *cfg,
+						AdminPort: adminPort,
+					}))
+				} else {
+					runLog.Info("Envoy Admin API is not exposed or the fail timeout is not set, the fail mode won't be applied")
+				}
+			}
+
 			metricsServer := metrics.New(cfg.Dataplane, adminPort)
 			components = append(components, metricsServer)
 
@@ -208,6 +267,21 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 				return err
 			}
 
+			go func() {
+				<-ctx.Done()
+				runLog.Info("Kuma DP caught an exit signal")
+				// the proxy is stopped whatever the result of the hooks
+				if err := hooksRunner.Run(hooks.PreShutdown, nil); err != nil {
+					runLog.Error(err, "pre-shutdown hooks failed, stopping anyway")
+				}
+				if drainer != nil {
+					drainer.Drain()
+				}
+				if shouldQuit != nil {
+					close(shouldQuit)
+				}
+			}()
+
 			runLog.Info("starting Kuma DP", "version", kuma_version.Build.Version)
 			if err := rootCtx.ComponentManager.Start(shouldQuit); err != nil {
 				runLog.Error(err, "error while running Kuma DP")
@@ -222,14 +296,21 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 	cmd.PersistentFlags().Var(&cfg.Dataplane.AdminPort, "admin-port", `Port (or range of ports to choose from) for Envoy Admin API to listen on. Empty value indicates that Envoy Admin API should not be exposed over TCP. Format: "9901 | 9901-9999 | 9901- | -9901"`)
 	cmd.PersistentFlags().StringVar(&cfg.Dataplane.Mesh, "mesh", cfg.Dataplane.Mesh, "Mesh that Dataplane belongs to")
 	cmd.PersistentFlags().StringVar(&cfg.Dataplane.ProxyType, "proxy-type", "dataplane", `type of the Dataplane ("dataplane", "ingress")`)
+	cmd.PersistentFlags().StringVar(&cfg.Dataplane.FailMode, "fail-mode", cfg.Dataplane.FailMode, `Behavior of the Dataplane when it is disconnected from the Control Plane for longer than the fail timeout ("open", "closed"). In the "open" mode traffic is served with the last known configuration and an alert is logged, in the "closed" mode the Dataplane is stopped`)
+	cmd.PersistentFlags().DurationVar(&cfg.Dataplane.FailTimeout, "fail-timeout", cfg.Dataplane.FailTimeout, "How long the Dataplane can be disconnected from the Control Plane before the fail mode is applied")
 	cmd.PersistentFlags().StringVar(&cfg.ControlPlane.URL, "cp-address", cfg.ControlPlane.URL, "URL of the Control Plane Dataplane Server. Example: https://localhost:5678")
 	cmd.PersistentFlags().StringVar(&cfg.ControlPlane.CaCertFile, "ca-cert-file", cfg.ControlPlane.CaCertFile, "Path to CA cert by which connection to the Control Plane will be verified if HTTPS is used")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.BinaryPath, "binary-path", cfg.DataplaneRuntime.BinaryPath, "Binary path of Envoy executable")
 	cmd.PersistentFlags().Uint32Var(&cfg.DataplaneRuntime.Concurrency, "concurrency", cfg.DataplaneRuntime.Concurrency, "Number of Envoy worker threads")
+	cmd.PersistentFlags().Uint64Var(&cfg.DataplaneRuntime.MemoryLimit, "memory-limit", cfg.DataplaneRuntime.MemoryLimit, "Memory limit of Envoy in bytes used to configure Envoy overload manager. If not set, the limit of the cgroup is used")
 	// todo(lobkovilya): delete deprecated bootstrap-version flag. Issue https://github.com/kumahq/kuma/issues/2986
 	cmd.PersistentFlags().StringVar(&bootstrapVersion, "bootstrap-version", "", "Bootstrap version (and API version) of xDS config. If empty, default version defined in Kuma CP will be used. (ex. '2', '3')")
 	_ = cmd.PersistentFlags().MarkDeprecated("bootstrap-version", "Envoy API v3 is used and can not be changed")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.ConfigDir, "config-dir", cfg.DataplaneRuntime.ConfigDir, "Directory in which Envoy config will be generated")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.SecretsDir, "secrets-dir", cfg.DataplaneRuntime.SecretsDir, "Directory (preferably tmpfs) in which identity and CA of the Dataplane are delivered to Envoy as files instead of over the xDS stream")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.Hooks.Dir, "hooks-dir", cfg.DataplaneRuntime.Hooks.Dir, `Directory with the executables that are run on the events of the Dataplane, in subdirectories named after the events ("post-bootstrap", "post-cert-rotation", "pre-shutdown")`)
+	cmd.PersistentFlags().DurationVar(&cfg.DataplaneRuntime.Hooks.Timeout, "hooks-timeout", cfg.DataplaneRuntime.Hooks.Timeout, "How long a hook can run before it is killed and considered failed")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.Hooks.FailurePolicy, "hooks-failure-policy", cfg.DataplaneRuntime.Hooks.FailurePolicy, `Behavior of the Dataplane when a hook fails ("ignore", "fail"). In the "ignore" mode the failure is logged, in the "fail" mode the Dataplane is stopped`)
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.TokenPath, "dataplane-token-file", cfg.DataplaneRuntime.TokenPath, "Path to a file with dataplane token (use 'kumactl generate dataplane-token' to get one)")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.Token, "dataplane-token", cfg.DataplaneRuntime.Token, "Dataplane Token")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.Resource, "dataplane", "", "Dataplane template to apply (YAML or JSON)")
@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -100,6 +101,9 @@ var _ = Describe("run", func() {
 				Expect(err).ToNot(HaveOccurred())
 				return respBytes, nil
 			}
+			rootCtx.DrainNotifier = func(_ string, _ kumadp.Config) (time.Duration, error) {
+				return 0, nil
+			}
 			_, writer := io.Pipe()
 			cmd := NewRootCmd(opts, rootCtx)
 			cmd.SetArgs(append([]string{"run"}, given.args...))
@@ -0,0 +1,80 @@
+package accesslogs
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	net_url "net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/token"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/xds/secrets/logging/types"
+)
+
+// SecretFetcherFunc fetches the value of a secret of the mesh referenced by a logging backend from the Control Plane.
+type SecretFetcherFunc func(url string, cfg kuma_dp.Config, secret string) ([]byte, error)
+
+type remoteSecretFetcher struct {
+	client *http.Client
+}
+
+func NewRemoteSecretFetcher(client *http.Client) SecretFetcherFunc {
+	rf := remoteSecretFetcher{client: client}
+	return rf.Fetch
+}
+
+func (r *remoteSecretFetcher) Fetch(url string, cfg kuma_dp.Config, secret string) ([]byte, error) {
+	secretUrl, err := net_url.Parse(url)
+	if err != nil {
+		return nil, err
+	}
+	if secretUrl.Scheme == "https" && cfg.ControlPlane.CaCert != "" {
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM([]byte(cfg.ControlPlane.CaCert)); !ok {
+			return nil, errors.New("could not add certificate")
+		}
+		r.client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		}
+	}
+	secretUrl.Path = "/logging-secrets"
+
+	dpToken, err := token.Read(cfg)
+	if err != nil {
+		return nil, err
+	}
+	request := types.LoggingSecretRequest{
+		Mesh:           cfg.Dataplane.Mesh,
+		Name:           cfg.Dataplane.Name,
+		DataplaneToken: dpToken,
+		Secret:         secret,
+	}
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal request to json")
+	}
+	resp, err := r.client.Post(secretUrl.String(), "application/json", bytes.NewReader(jsonBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "request to logging secrets server failed")
+	}
+	defer resp.Body.Close()
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to read the response with status code: %d", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+	response := types.LoggingSecretResponse{}
+	if err := json.Unmarshal(bodyBytes, &response); err != nil {
+		return nil, errors.Wrap(err, "could not parse the response")
+	}
+	return response.Value, nil
+}
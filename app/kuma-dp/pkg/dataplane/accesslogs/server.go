@@ -20,24 +20,28 @@ var logger = core.Log.WithName("accesslogs-server")
 var _ component.Component = &accessLogServer{}
 
 type accessLogServer struct {
-	server  *grpc.Server
-	address string
+	server      *grpc.Server
+	address     string
+	fetchSecret v3.SecretFetcherFunc
 }
 
 func (s *accessLogServer) NeedLeaderElection() bool {
 	return false
 }
 
-func NewAccessLogServer(dataplane kumadp.Dataplane) *accessLogServer {
-	address := envoy.AccessLogSocketName(dataplane.Name, dataplane.Mesh)
+func NewAccessLogServer(cfg kumadp.Config, fetcher SecretFetcherFunc) *accessLogServer {
+	address := envoy.AccessLogSocketName(cfg.Dataplane.Name, cfg.Dataplane.Mesh)
 	return &accessLogServer{
 		server:  grpc.NewServer(),
 		address: address,
+		fetchSecret: func(secret string) ([]byte, error) {
+			return fetcher(cfg.ControlPlane.URL, cfg, secret)
+		},
 	}
 }
 
 func (s *accessLogServer) Start(stop <-chan struct{}) error {
-	v3.RegisterAccessLogServer(s.server)
+	v3.RegisterAccessLogServer(s.server, s.fetchSecret)
 
 	_, err := os.Stat(s.address)
 	if err == nil {
@@ -0,0 +1,241 @@
+package v3
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+const (
+	defaultBatchMaxEntries    = 100
+	defaultBatchFlushInterval = 1 * time.Second
+	defaultBatchBufferSize    = 10000
+	defaultRetryMaxAttempts   = 5
+	defaultRetryBackoff       = 100 * time.Millisecond
+	defaultRetryMaxBackoff    = 10 * time.Second
+)
+
+// batchWriter represents a contract between a batch sender and a logging backend
+// that accepts log entries in batches, e.g. Splunk HEC or Kafka.
+type batchWriter interface {
+	Connect() error
+	Write(records []string) error
+	io.Closer
+}
+
+type batchConfig struct {
+	maxEntries    int
+	flushInterval time.Duration
+	bufferSize    int
+	maxAttempts   int
+	backoff       time.Duration
+	maxBackoff    time.Duration
+}
+
+func newBatchConfig(batching *mesh_proto.LoggingBackendBatching, retry *mesh_proto.LoggingBackendRetry) batchConfig {
+	cfg := batchConfig{
+		maxEntries:    defaultBatchMaxEntries,
+		flushInterval: defaultBatchFlushInterval,
+		bufferSize:    defaultBatchBufferSize,
+		maxAttempts:   defaultRetryMaxAttempts,
+		backoff:       defaultRetryBackoff,
+		maxBackoff:    defaultRetryMaxBackoff,
+	}
+	if batching.GetMaxEntries() != nil {
+		cfg.maxEntries = int(batching.GetMaxEntries().GetValue())
+	}
+	if batching.GetFlushInterval() != nil {
+		cfg.flushInterval = batching.GetFlushInterval().AsDuration()
+	}
+	if batching.GetBufferSize() != nil {
+		cfg.bufferSize = int(batching.GetBufferSize().GetValue())
+	}
+	if retry.GetMaxAttempts() != nil {
+		cfg.maxAttempts = int(retry.GetMaxAttempts().GetValue())
+	}
+	if retry.GetBackoff() != nil {
+		cfg.backoff = retry.GetBackoff().AsDuration()
+	}
+	if retry.GetMaxBackoff() != nil {
+		cfg.maxBackoff = retry.GetMaxBackoff().AsDuration()
+	}
+	return cfg
+}
+
+// batchSender buffers log entries in memory and hands them over to a batchWriter
+// either when a batch is full or when the flush interval elapses.
+//
+// The writer is connected lazily, before the first batch is written, and it is
+// reconnected after a batch is given up. While the writer cannot connect, log
+// entries stay in the buffer, so an unavailable logging backend never fails
+// the access log stream of Envoy.
+//
+// Log entries are never allowed to block Envoy: if the buffer is full because
+// the logging backend is slow or unavailable, new entries are dropped.
+type batchSender struct {
+	log    logr.Logger
+	cfg    batchConfig
+	writer batchWriter
+
+	mu      sync.Mutex
+	buffer  []string
+	dropped int
+
+	// fields below are used only by the goroutine that writes the batches
+	connected      bool
+	nextConnect    time.Time
+	connectBackoff time.Duration
+
+	flush   chan struct{}
+	stop    chan struct{}
+	stopped chan struct{}
+	sleep   func(time.Duration)
+	now     func() time.Time
+}
+
+var _ logSender = &batchSender{}
+
+func newBatchSender(log logr.Logger, cfg batchConfig, writer batchWriter) *batchSender {
+	return &batchSender{
+		log:            log,
+		cfg:            cfg,
+		writer:         writer,
+		connectBackoff: cfg.backoff,
+		flush:          make(chan struct{}, 1),
+		stop:           make(chan struct{}),
+		stopped:        make(chan struct{}),
+		sleep:          time.Sleep,
+		now:            time.Now,
+	}
+}
+
+// Connect starts sending the batches in the background. The writer itself is connected
+// only when there is a batch to write.
+func (s *batchSender) Connect() error {
+	go s.run()
+	return nil
+}
+
+func (s *batchSender) Send(record string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.buffer) >= s.cfg.bufferSize {
+		s.dropped++
+		return nil
+	}
+	s.buffer = append(s.buffer, record)
+	if len(s.buffer) >= s.cfg.maxEntries {
+		select {
+		case s.flush <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Close flushes buffered log entries and closes the underlying writer.
+func (s *batchSender) Close() error {
+	close(s.stop)
+	<-s.stopped
+	return s.writer.Close()
+}
+
+func (s *batchSender) run() {
+	defer close(s.stopped)
+	ticker := time.NewTicker(s.cfg.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			for s.flushBatch() {
+			}
+			return
+		case <-ticker.C:
+			for s.flushBatch() {
+			}
+		case <-s.flush:
+			for s.flushBatch() {
+			}
+		}
+	}
+}
+
+// connect connects the writer unless it is already connected. Failed attempts are spaced
+// with exponential backoff and log entries stay buffered in the meantime.
+func (s *batchSender) connect() bool {
+	if s.connected {
+		return true
+	}
+	if s.now().Before(s.nextConnect) {
+		return false
+	}
+	if err := s.writer.Connect(); err != nil {
+		s.log.Error(err, "failed to connect to the logging backend, log entries stay buffered", "retryIn", s.connectBackoff)
+		s.nextConnect = s.now().Add(s.connectBackoff)
+		s.connectBackoff *= 2
+		if s.connectBackoff > s.cfg.maxBackoff {
+			s.connectBackoff = s.cfg.maxBackoff
+		}
+		return false
+	}
+	s.connected = true
+	s.connectBackoff = s.cfg.backoff
+	return true
+}
+
+// flushBatch writes a single batch of log entries and reports whether there are more entries to write.
+func (s *batchSender) flushBatch() bool {
+	if !s.connect() {
+		return false
+	}
+
+	s.mu.Lock()
+	n := len(s.buffer)
+	if n > s.cfg.maxEntries {
+		n = s.cfg.maxEntries
+	}
+	batch := make([]string, n)
+	copy(batch, s.buffer)
+	s.buffer = s.buffer[n:]
+	more := len(s.buffer) > 0
+	dropped := s.dropped
+	s.dropped = 0
+	s.mu.Unlock()
+
+	if dropped > 0 {
+		s.log.Info("dropped log entries because the buffer is full", "dropped", dropped, "bufferSize", s.cfg.bufferSize)
+	}
+	if len(batch) == 0 {
+		return false
+	}
+	if err := s.write(batch); err != nil {
+		s.log.Error(err, "failed to send log entries, dropping the batch", "entries", len(batch))
+		// e.g. credentials of the backend might have been rotated
+		s.connected = false
+	}
+	return more
+}
+
+func (s *batchSender) write(batch []string) error {
+	backoff := s.cfg.backoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = s.writer.Write(batch); err == nil {
+			return nil
+		}
+		if attempt >= s.cfg.maxAttempts {
+			return errors.Wrapf(err, "giving up after %d attempts", attempt)
+		}
+		s.log.V(1).Info("failed to send log entries, retrying", "attempt", attempt, "backoff", backoff, "err", err)
+		s.sleep(backoff)
+		backoff *= 2
+		if backoff > s.cfg.maxBackoff {
+			backoff = s.cfg.maxBackoff
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package v3
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+type fakeWriter struct {
+	sync.Mutex
+	batches         [][]string
+	failures        int
+	connects        int
+	connectFailures int
+	closed          bool
+}
+
+func (w *fakeWriter) Connect() error {
+	w.Lock()
+	defer w.Unlock()
+	w.connects++
+	if w.connectFailures > 0 {
+		w.connectFailures--
+		return errors.New("backend is unreachable")
+	}
+	return nil
+}
+
+func (w *fakeWriter) Connects() int {
+	w.Lock()
+	defer w.Unlock()
+	return w.connects
+}
+
+func (w *fakeWriter) Write(records []string) error {
+	w.Lock()
+	defer w.Unlock()
+	if w.failures > 0 {
+		w.failures--
+		return errors.New("backend is unavailable")
+	}
+	w.batches = append(w.batches, records)
+	return nil
+}
+
+func (w *fakeWriter) Close() error {
+	w.Lock()
+	defer w.Unlock()
+	w.closed = true
+	return nil
+}
+
+func (w *fakeWriter) Batches() [][]string {
+	w.Lock()
+	defer w.Unlock()
+	return w.batches
+}
+
+var _ = Describe("batchSender", func() {
+
+	var writer *fakeWriter
+	var cfg batchConfig
+	var sleeps []time.Duration
+
+	BeforeEach(func() {
+		writer = &fakeWriter{}
+		cfg = newBatchConfig(nil, nil)
+		cfg.flushInterval = time.Hour
+		sleeps = nil
+	})
+
+	newSender := func() *batchSender {
+		sender := newBatchSender(core.Log.WithName("test"), cfg, writer)
+		sender.sleep = func(d time.Duration) {
+			sleeps = append(sleeps, d)
+		}
+		Expect(sender.Connect()).To(Succeed())
+		return sender
+	}
+
+	It("should send a batch once it is full", func() {
+		// given
+		cfg.maxEntries = 2
+		sender := newSender()
+
+		// when
+		Expect(sender.Send("1\n")).To(Succeed())
+		Expect(sender.Send("2\n")).To(Succeed())
+
+		// then
+		Eventually(writer.Batches).Should(Equal([][]string{{"1\n", "2\n"}}))
+
+		// when
+		Expect(sender.Send("3\n")).To(Succeed())
+		Expect(sender.Close()).To(Succeed())
+
+		// then
+		Expect(writer.Batches()).To(Equal([][]string{{"1\n", "2\n"}, {"3\n"}}))
+		Expect(writer.closed).To(BeTrue())
+	})
+
+	It("should send a batch once the flush interval elapses", func() {
+		// given
+		cfg.flushInterval = 10 * time.Millisecond
+		sender := newSender()
+		defer sender.Close()
+
+		// when
+		Expect(sender.Send("1\n")).To(Succeed())
+
+		// then
+		Eventually(writer.Batches).Should(Equal([][]string{{"1\n"}}))
+	})
+
+	It("should drop entries when the buffer is full", func() {
+		// given
+		cfg.bufferSize = 2
+		sender := newSender()
+
+		// when
+		for _, record := range []string{"1\n", "2\n", "3\n"} {
+			Expect(sender.Send(record)).To(Succeed())
+		}
+		Expect(sender.Close()).To(Succeed())
+
+		// then
+		Expect(writer.Batches()).To(Equal([][]string{{"1\n", "2\n"}}))
+	})
+
+	It("should retry with exponential backoff", func() {
+		// given
+		writer.failures = 3
+		cfg.backoff = 100 * time.Millisecond
+		cfg.maxBackoff = 300 * time.Millisecond
+		sender := newSender()
+
+		// when
+		Expect(sender.Send("1\n")).To(Succeed())
+		Expect(sender.Close()).To(Succeed())
+
+		// then
+		Expect(writer.Batches()).To(Equal([][]string{{"1\n"}}))
+		Expect(sleeps).To(Equal([]time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}))
+	})
+
+	It("should give up after max attempts", func() {
+		// given
+		writer.failures = 10
+		cfg.maxAttempts = 2
+		sender := newSender()
+
+		// when
+		Expect(sender.Send("1\n")).To(Succeed())
+		Expect(sender.Close()).To(Succeed())
+
+		// then
+		Expect(writer.Batches()).To(BeEmpty())
+		Expect(sleeps).To(HaveLen(1))
+	})
+
+	It("should connect lazily and keep entries buffered while the backend is unreachable", func() {
+		// given
+		writer.connectFailures = 2
+		cfg.flushInterval = 10 * time.Millisecond
+		cfg.backoff = time.Millisecond
+		sender := newSender()
+		defer sender.Close()
+
+		// then
+		Expect(writer.Connects()).To(Equal(0))
+
+		// when
+		Expect(sender.Send("1\n")).To(Succeed())
+		Expect(sender.Send("2\n")).To(Succeed())
+
+		// then
+		Eventually(writer.Batches).Should(Equal([][]string{{"1\n", "2\n"}}))
+		Expect(writer.Connects()).To(Equal(3))
+	})
+
+	It("should reconnect after giving up a batch", func() {
+		// given
+		writer.failures = 2
+		cfg.maxAttempts = 2
+		cfg.maxEntries = 1
+		sender := newSender()
+
+		// when
+		Expect(sender.Send("1\n")).To(Succeed())
+		Expect(sender.Send("2\n")).To(Succeed())
+		Expect(sender.Close()).To(Succeed())
+
+		// then
+		Expect(writer.Batches()).To(Equal([][]string{{"2\n"}}))
+		Expect(writer.Connects()).To(Equal(2))
+	})
+})
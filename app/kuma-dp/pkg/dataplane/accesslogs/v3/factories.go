@@ -7,22 +7,31 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
-func defaultHandler(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage) (logHandler, error) {
+// newDefaultHandler returns a factory of log handlers that load credentials of logging backends with the fetcher.
+func newDefaultHandler(fetchSecret SecretFetcherFunc) logHandlerFactoryFunc {
+	return func(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage) (logHandler, error) {
+		return defaultHandler(log, msg, fetchSecret)
+	}
+}
+
+func defaultHandler(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage, fetchSecret SecretFetcherFunc) (logHandler, error) {
 	parts := strings.SplitN(msg.GetIdentifier().GetLogName(), ";", 2)
 	if len(parts) != 2 {
 		return nil, errors.Errorf("log name %q has invalid format: expected %d components separated by ';', got %d", msg.GetIdentifier().GetLogName(), 2, len(parts))
 	}
-	address, formatString := parts[0], parts[1]
+	sink, formatString := parts[0], parts[1]
 
 	format, err := accesslog.ParseFormat(formatString)
 	if err != nil {
 		return nil, err
 	}
 
-	sender, err := defaultSender(log, address)
+	sender, err := defaultSender(log, sink, fetchSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -36,9 +45,28 @@ func defaultHandler(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessag
 	}, nil
 }
 
-func defaultSender(log logr.Logger, address string) (logSender, error) {
-	return &sender{
-		log:     log,
-		address: address,
-	}, nil
+func defaultSender(log logr.Logger, sink string, fetchSecret SecretFetcherFunc) (logSender, error) {
+	backendType, conf, err := accesslog.DecodeSink(sink)
+	if err != nil {
+		return nil, err
+	}
+	switch backendType {
+	case "":
+		return &sender{
+			log:     log,
+			address: sink,
+		}, nil
+	case mesh_proto.LoggingSplunkType:
+		cfg := &mesh_proto.SplunkLoggingBackendConfig{}
+		if err := util_proto.FromJSON(conf, cfg); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse configuration of %q logging backend", backendType)
+		}
+		writer, err := newSplunkWriter(log, cfg, fetchSecret)
+		if err != nil {
+			return nil, err
+		}
+		return newBatchSender(log, newBatchConfig(cfg.GetBatching(), cfg.GetRetry()), writer), nil
+	default:
+		return nil, errors.Errorf("unsupported type of logging backend: %q", backendType)
+	}
 }
@@ -17,7 +17,7 @@ var _ = Describe("defaultHandler", func() {
 		DescribeTable("should fail if configuration is not valid",
 			func(given testCase) {
 				// when
-				_, err := defaultHandler(nil, given.msg)
+				_, err := defaultHandler(nil, given.msg, nil)
 				// then
 				Expect(err).To(HaveOccurred())
 				// and
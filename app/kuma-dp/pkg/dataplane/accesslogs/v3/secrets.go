@@ -0,0 +1,38 @@
+package v3
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+)
+
+// SecretFetcherFunc fetches the value of a secret of the mesh from the Control Plane.
+type SecretFetcherFunc func(name string) ([]byte, error)
+
+// loadCredential returns the value of a credential of a logging backend.
+//
+// Credentials are references to secrets of the mesh or to files on the host of kuma-dp,
+// which keeps them out of the Envoy configuration. Inline values are rejected by
+// the validation of the Mesh.
+func loadCredential(fetchSecret SecretFetcherFunc, source *system_proto.DataSource) (string, error) {
+	var value []byte
+	var err error
+	switch source.GetType().(type) {
+	case *system_proto.DataSource_Secret:
+		if fetchSecret == nil {
+			return "", errors.Errorf("secret %q cannot be fetched from the Control Plane", source.GetSecret())
+		}
+		value, err = fetchSecret(source.GetSecret())
+	case *system_proto.DataSource_File:
+		value, err = ioutil.ReadFile(source.GetFile())
+	default:
+		return "", errors.Errorf("unsupported data source %T", source.GetType())
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(value)), nil
+}
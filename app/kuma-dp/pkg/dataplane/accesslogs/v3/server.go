@@ -22,9 +22,11 @@ type accessLogServer struct {
 
 var _ envoy_accesslog.AccessLogServiceServer = &accessLogServer{}
 
-func RegisterAccessLogServer(server *grpc.Server) {
+// RegisterAccessLogServer registers the Access Log Service. Credentials of logging backends
+// that reference secrets of the mesh are fetched with the fetcher.
+func RegisterAccessLogServer(server *grpc.Server, fetchSecret SecretFetcherFunc) {
 	srv := &accessLogServer{
-		newHandler: defaultHandler,
+		newHandler: newDefaultHandler(fetchSecret),
 	}
 	envoy_accesslog.RegisterAccessLogServiceServer(server, srv)
 }
@@ -0,0 +1,130 @@
+package v3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+const (
+	defaultSplunkSource     = "kuma-dp"
+	defaultSplunkSourcetype = "kuma:accesslog"
+	defaultSplunkTimeout    = 10 * time.Second
+)
+
+// splunkEvent is an event in format accepted by Splunk HTTP Event Collector.
+type splunkEvent struct {
+	Time       float64 `json:"time"`
+	Event      string  `json:"event"`
+	Source     string  `json:"source,omitempty"`
+	Sourcetype string  `json:"sourcetype,omitempty"`
+	Index      string  `json:"index,omitempty"`
+}
+
+// splunkWriter sends batches of log entries to Splunk HTTP Event Collector.
+type splunkWriter struct {
+	log         logr.Logger
+	cfg         *mesh_proto.SplunkLoggingBackendConfig
+	fetchSecret SecretFetcherFunc
+	client      *http.Client
+	now         func() time.Time
+
+	// token is loaded on every Connect, so a rotated token is picked up
+	// once the batch sender reconnects after failed deliveries.
+	token string
+}
+
+var _ batchWriter = &splunkWriter{}
+
+func newSplunkWriter(log logr.Logger, cfg *mesh_proto.SplunkLoggingBackendConfig, fetchSecret SecretFetcherFunc) (*splunkWriter, error) {
+	tlsConfig, err := newTlsConfig(cfg.GetTls())
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+	return &splunkWriter{
+		log:         log,
+		cfg:         cfg,
+		fetchSecret: fetchSecret,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   defaultSplunkTimeout,
+		},
+		now: time.Now,
+	}, nil
+}
+
+func (w *splunkWriter) Connect() error {
+	token, err := loadCredential(w.fetchSecret, w.cfg.GetToken())
+	if err != nil {
+		return errors.Wrap(err, "failed to load the token of Splunk HTTP Event Collector")
+	}
+	w.token = token
+	w.log.Info("sending access logs to Splunk HTTP Event Collector", "url", w.cfg.GetUrl())
+	return nil
+}
+
+func (w *splunkWriter) Write(records []string) error {
+	source := w.cfg.GetSource()
+	if source == "" {
+		source = defaultSplunkSource
+	}
+	sourcetype := w.cfg.GetSourcetype()
+	if sourcetype == "" {
+		sourcetype = defaultSplunkSourcetype
+	}
+	timestamp := float64(w.now().UnixNano()) / float64(time.Second)
+
+	// HTTP Event Collector accepts multiple events concatenated in a single request body.
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, record := range records {
+		event := splunkEvent{
+			Time:       timestamp,
+			Event:      strings.TrimSuffix(record, "\n"),
+			Source:     source,
+			Sourcetype: sourcetype,
+			Index:      w.cfg.GetIndex(),
+		}
+		if err := encoder.Encode(&event); err != nil {
+			return errors.Wrap(err, "failed to encode a Splunk event")
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.cfg.GetUrl(), &body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create a request to Splunk HTTP Event Collector: %s", w.cfg.GetUrl())
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Splunk %s", w.token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send log entries to Splunk HTTP Event Collector: %s", w.cfg.GetUrl())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("Splunk HTTP Event Collector %s responded with status %d: %s", w.cfg.GetUrl(), resp.StatusCode, msg)
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+func (w *splunkWriter) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
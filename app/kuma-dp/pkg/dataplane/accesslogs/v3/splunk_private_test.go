@@ -0,0 +1,122 @@
+package v3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+var _ = Describe("splunkWriter", func() {
+
+	var server *httptest.Server
+	var requests []*http.Request
+	var bodies []string
+	var status int
+
+	BeforeEach(func() {
+		requests = nil
+		bodies = nil
+		status = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			requests = append(requests, req)
+			bodies = append(bodies, string(body))
+			w.WriteHeader(status)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	secrets := map[string]string{
+		"splunk-token": "s3cr3t\n",
+	}
+	fetchSecret := func(name string) ([]byte, error) {
+		value, ok := secrets[name]
+		if !ok {
+			return nil, errors.Errorf("secret %q not found", name)
+		}
+		return []byte(value), nil
+	}
+
+	secretToken := func(name string) *system_proto.DataSource {
+		return &system_proto.DataSource{
+			Type: &system_proto.DataSource_Secret{Secret: name},
+		}
+	}
+
+	newWriter := func(cfg *mesh_proto.SplunkLoggingBackendConfig) *splunkWriter {
+		writer, err := newSplunkWriter(core.Log.WithName("test"), cfg, fetchSecret)
+		Expect(err).ToNot(HaveOccurred())
+		writer.now = func() time.Time {
+			return time.Unix(1600000000, 500000000)
+		}
+		return writer
+	}
+
+	It("should send log entries as HTTP Event Collector events", func() {
+		// given
+		writer := newWriter(&mesh_proto.SplunkLoggingBackendConfig{
+			Url:   server.URL + "/services/collector/event",
+			Token: secretToken("splunk-token"),
+			Index: "mesh",
+		})
+
+		// when
+		Expect(writer.Connect()).To(Succeed())
+		err := writer.Write([]string{"first entry\n", "second entry\n"})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Method).To(Equal(http.MethodPost))
+		Expect(requests[0].URL.Path).To(Equal("/services/collector/event"))
+		Expect(requests[0].Header.Get("Authorization")).To(Equal("Splunk s3cr3t"))
+		// and
+		Expect(bodies[0]).To(Equal(`{"time":1600000000.5,"event":"first entry","source":"kuma-dp","sourcetype":"kuma:accesslog","index":"mesh"}
+{"time":1600000000.5,"event":"second entry","source":"kuma-dp","sourcetype":"kuma:accesslog","index":"mesh"}
+`))
+	})
+
+	It("should fail when HTTP Event Collector rejects events", func() {
+		// given
+		status = http.StatusForbidden
+		writer := newWriter(&mesh_proto.SplunkLoggingBackendConfig{
+			Url:   server.URL,
+			Token: secretToken("splunk-token"),
+		})
+
+		// when
+		Expect(writer.Connect()).To(Succeed())
+		err := writer.Write([]string{"entry\n"})
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("responded with status 403")))
+	})
+
+	It("should fail to connect when the token cannot be loaded", func() {
+		// given
+		writer := newWriter(&mesh_proto.SplunkLoggingBackendConfig{
+			Url:   server.URL,
+			Token: secretToken("missing"),
+		})
+
+		// when
+		err := writer.Connect()
+
+		// then
+		Expect(err).To(MatchError(`failed to load the token of Splunk HTTP Event Collector: secret "missing" not found`))
+		Expect(requests).To(BeEmpty())
+	})
+})
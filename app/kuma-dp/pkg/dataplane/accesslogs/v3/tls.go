@@ -0,0 +1,31 @@
+package v3
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// newTlsConfig returns TLS configuration of the connection to a logging backend
+// or nil if TLS is not enabled.
+func newTlsConfig(cfg *mesh_proto.LoggingBackendTls) (*tls.Config, error) {
+	if !cfg.GetEnabled() {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         cfg.GetServerName(),
+		InsecureSkipVerify: cfg.GetInsecureSkipVerify(), // #nosec G402 -- explicitly requested by the user
+	}
+	if cfg.GetCaCert() != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cfg.GetCaCert())) {
+			return nil, errors.New("failed to parse CA certificate of a logging backend")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,102 @@
+package drain
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/resourceusage"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+var log = core.Log.WithName("kuma-dp").WithName("drain")
+
+// DefaultPollInterval defines how often the active connections are checked while the listeners are draining.
+const DefaultPollInterval = time.Second
+
+type Opts struct {
+	Config   kuma_dp.Config
+	Notifier NotifierFunc
+	// AdminPort is a port of Envoy Admin API, 0 when Envoy Admin API is not exposed
+	AdminPort    uint32
+	PollInterval time.Duration
+}
+
+// Drainer shuts down the data plane proxy in order. First the endpoints of the proxy are removed
+// from the configuration of other proxies, so they stop sending new requests. Then the listeners
+// of Envoy are drained, so the requests in flight are completed before Envoy is stopped.
+type Drainer struct {
+	opts        Opts
+	adminClient *http.Client
+}
+
+func New(opts Opts) *Drainer {
+	if opts.PollInterval == 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	return &Drainer{
+		opts:        opts,
+		adminClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Drain blocks until the data plane proxy is drained or the drain time passes.
+// Errors are only logged, so the proxy is stopped anyway.
+func (d *Drainer) Drain() {
+	log.Info("draining the data plane proxy")
+	delay, err := d.opts.Notifier(d.opts.Config.ControlPlane.URL, d.opts.Config)
+	if err != nil {
+		log.Error(err, "could not notify the Control Plane, draining the listeners without waiting for the endpoints to be removed")
+	} else {
+		log.Info("waiting for the endpoints to be removed from other data plane proxies", "delay", delay)
+		time.Sleep(delay)
+	}
+
+	if d.opts.AdminPort == 0 {
+		log.Info("Envoy Admin API is not exposed, skipping the draining of the listeners")
+		return
+	}
+	if err := d.drainListeners(); err != nil {
+		log.Error(err, "could not drain the listeners")
+		return
+	}
+	d.waitForConnections()
+}
+
+func (d *Drainer) drainListeners() error {
+	url := fmt.Sprintf("http://127.0.0.1:%d/drain_listeners?graceful", d.opts.AdminPort)
+	resp, err := d.adminClient.Post(url, "", nil)
+	if err != nil {
+		return errors.Wrap(err, "request to Envoy failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status code from Envoy: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (d *Drainer) waitForConnections() {
+	deadline := core.Now().Add(d.opts.Config.Dataplane.DrainTime)
+	ticker := time.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+	for {
+		connections, err := resourceusage.ActiveConnections(d.adminClient, d.opts.AdminPort)
+		if err != nil {
+			log.Error(err, "could not get the active connections, stopping the draining")
+			return
+		}
+		if connections == 0 {
+			log.Info("all connections are closed")
+			return
+		}
+		if !core.Now().Before(deadline) {
+			log.Info("drain time has passed, stopping with active connections", "connections", connections)
+			return
+		}
+		<-ticker.C
+	}
+}
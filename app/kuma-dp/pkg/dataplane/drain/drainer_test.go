@@ -0,0 +1,141 @@
+package drain_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/drain"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+)
+
+var _ = Describe("Drainer", func() {
+
+	var adminServer *httptest.Server
+	var adminPort uint32
+	var mutex sync.Mutex
+	var calls []string
+	var connections []uint64
+
+	BeforeEach(func() {
+		calls = nil
+		connections = nil
+		adminServer = httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			calls = append(calls, req.Method+" "+req.URL.Path)
+			if req.URL.Path == "/stats" {
+				active := uint64(0)
+				if len(connections) > 0 {
+					active = connections[0]
+					connections = connections[1:]
+				}
+				_, _ = fmt.Fprintf(resp, `{"stats":[{"name":"listener.0.0.0.0_8080.downstream_cx_active","value":%d}]}`, active)
+			}
+		}))
+		_, port, err := net.SplitHostPort(adminServer.Listener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		p, err := strconv.Atoi(port)
+		Expect(err).ToNot(HaveOccurred())
+		adminPort = uint32(p)
+	})
+
+	AfterEach(func() {
+		adminServer.Close()
+	})
+
+	config := func() kuma_dp.Config {
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Mesh = "default"
+		cfg.Dataplane.Name = "dp-1"
+		cfg.Dataplane.DrainTime = time.Second
+		return cfg
+	}
+
+	recordedCalls := func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]string{}, calls...)
+	}
+
+	It("should notify the Control Plane before draining the listeners", func() {
+		// given
+		connections = []uint64{2, 1, 0}
+		var notifiedAt time.Time
+		drainer := drain.New(drain.Opts{
+			Config: config(),
+			Notifier: func(url string, cfg kuma_dp.Config) (time.Duration, error) {
+				Expect(recordedCalls()).To(BeEmpty())
+				notifiedAt = time.Now()
+				return 50 * time.Millisecond, nil
+			},
+			AdminPort:    adminPort,
+			PollInterval: 10 * time.Millisecond,
+		})
+
+		// when
+		drainer.Drain()
+
+		// then
+		Expect(notifiedAt).ToNot(BeZero())
+		Expect(time.Since(notifiedAt)).To(BeNumerically(">=", 50*time.Millisecond))
+		Expect(recordedCalls()).To(Equal([]string{
+			"POST /drain_listeners",
+			"GET /stats",
+			"GET /stats",
+			"GET /stats",
+		}))
+	})
+
+	It("should stop waiting for the connections after the drain time", func() {
+		// given
+		connections = []uint64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+		cfg := config()
+		cfg.Dataplane.DrainTime = 50 * time.Millisecond
+		drainer := drain.New(drain.Opts{
+			Config: cfg,
+			Notifier: func(url string, cfg kuma_dp.Config) (time.Duration, error) {
+				return 0, nil
+			},
+			AdminPort:    adminPort,
+			PollInterval: 10 * time.Millisecond,
+		})
+
+		// when
+		start := time.Now()
+		drainer.Drain()
+
+		// then
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		Expect(recordedCalls()[0]).To(Equal("POST /drain_listeners"))
+	})
+
+	It("should drain the listeners when the Control Plane can't be notified", func() {
+		// given
+		drainer := drain.New(drain.Opts{
+			Config: config(),
+			Notifier: func(url string, cfg kuma_dp.Config) (time.Duration, error) {
+				return 0, errors.New("connection refused")
+			},
+			AdminPort:    adminPort,
+			PollInterval: 10 * time.Millisecond,
+		})
+
+		// when
+		drainer.Drain()
+
+		// then
+		Expect(recordedCalls()).To(Equal([]string{
+			"POST /drain_listeners",
+			"GET /stats",
+		}))
+	})
+})
@@ -0,0 +1,85 @@
+package drain
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	net_url "net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/token"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/xds/drain/types"
+)
+
+// NotifierFunc notifies the Control Plane that the data plane proxy is draining.
+// It returns the time it takes to remove the endpoints of the proxy from the configuration of other proxies.
+type NotifierFunc func(url string, cfg kuma_dp.Config) (time.Duration, error)
+
+type remoteNotifier struct {
+	client *http.Client
+}
+
+func NewRemoteNotifier(client *http.Client) NotifierFunc {
+	rn := remoteNotifier{client: client}
+	return rn.Notify
+}
+
+func (r *remoteNotifier) Notify(url string, cfg kuma_dp.Config) (time.Duration, error) {
+	drainUrl, err := net_url.Parse(url)
+	if err != nil {
+		return 0, err
+	}
+	if drainUrl.Scheme == "https" && cfg.ControlPlane.CaCert != "" {
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM([]byte(cfg.ControlPlane.CaCert)); !ok {
+			return 0, errors.New("could not add certificate")
+		}
+		r.client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		}
+	}
+	drainUrl.Path = "/drain"
+
+	dpToken, err := token.Read(cfg)
+	if err != nil {
+		return 0, err
+	}
+	request := types.DrainRequest{
+		Mesh:           cfg.Dataplane.Mesh,
+		Name:           cfg.Dataplane.Name,
+		DataplaneToken: dpToken,
+	}
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not marshal request to json")
+	}
+	resp, err := r.client.Post(drainUrl.String(), "application/json", bytes.NewReader(jsonBytes))
+	if err != nil {
+		return 0, errors.Wrap(err, "request to drain server failed")
+	}
+	defer resp.Body.Close()
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Unable to read the response with status code: %d", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+	response := types.DrainResponse{}
+	if err := json.Unmarshal(bodyBytes, &response); err != nil {
+		return 0, errors.Wrap(err, "could not parse the response")
+	}
+	delay, err := time.ParseDuration(response.PropagationDelay)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not parse the propagation delay")
+	}
+	return delay, nil
+}
@@ -11,11 +11,13 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
 
 	command_utils "github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/command"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/hooks"
 	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
@@ -48,6 +50,8 @@ type Opts struct {
 	Stderr          io.Writer
 	Quit            chan struct{}
 	LogLevel        pkg_log.LogLevel
+	// Hooks runs the post-bootstrap hooks once Envoy is started.
+	Hooks *hooks.Runner
 }
 
 func New(opts Opts) (*Envoy, error) {
@@ -62,6 +66,7 @@ var _ component.Component = &Envoy{}
 
 type Envoy struct {
 	opts Opts
+	pid  int64
 }
 
 type EnvoyVersion struct {
@@ -185,11 +190,23 @@ func (e *Envoy) Start(stop <-chan struct{}) error {
 		runLog.Error(err, "envoy executable failed", "path", resolvedPath, "arguments", args)
 		return err
 	}
+	atomic.StoreInt64(&e.pid, int64(command.Process.Pid))
+	defer atomic.StoreInt64(&e.pid, 0)
 	done := make(chan error, 1)
 	go func() {
 		done <- command.Wait()
 	}()
 
+	if err := e.opts.Hooks.Run(hooks.PostBootstrap, map[string]string{
+		hooks.BootstrapFileEnv: configFile,
+		hooks.EnvoyPidEnv:      strconv.Itoa(command.Process.Pid),
+	}); err != nil {
+		runLog.Error(err, "post-bootstrap hooks failed, stopping Envoy")
+		cancel()
+		<-done
+		return errors.Wrap(err, "post-bootstrap hooks failed")
+	}
+
 	select {
 	case <-stop:
 		runLog.Info("stopping Envoy")
@@ -209,6 +226,11 @@ func (e *Envoy) Start(stop <-chan struct{}) error {
 	}
 }
 
+// Pid returns the pid of the Envoy process or 0 when Envoy is not running.
+func (e *Envoy) Pid() int {
+	return int(atomic.LoadInt64(&e.pid))
+}
+
 func (e *Envoy) version() (*EnvoyVersion, error) {
 	binaryPathConfig := e.opts.Config.DataplaneRuntime.BinaryPath
 	resolvedPath, err := lookupEnvoyPath(binaryPathConfig)
@@ -16,6 +16,7 @@ import (
 
 	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
 	"github.com/kumahq/kuma/pkg/core"
+	util_os "github.com/kumahq/kuma/pkg/util/os"
 	kuma_version "github.com/kumahq/kuma/pkg/version"
 	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
 )
@@ -24,6 +25,9 @@ type remoteBootstrap struct {
 	client *http.Client
 }
 
+// detectMemoryLimit is replaced in tests, so they do not depend on the cgroup they are run in
+var detectMemoryLimit = util_os.MemoryLimit
+
 func NewRemoteBootstrapGenerator(client *http.Client) BootstrapConfigFactoryFunc {
 	rb := remoteBootstrap{client: client}
 	return rb.Generate
@@ -141,6 +145,8 @@ func (b *remoteBootstrap) requestForBootstrap(url *net_url.URL, cfg kuma_dp.Conf
 		DynamicMetadata: params.DynamicMetadata,
 		DNSPort:         params.DNSPort,
 		EmptyDNSPort:    params.EmptyDNSPort,
+		MemoryLimit:     memoryLimit(cfg),
+		SecretsDir:      cfg.DataplaneRuntime.SecretsDir,
 	}
 	jsonBytes, err := json.Marshal(request)
 	if err != nil {
@@ -173,3 +179,16 @@ func (b *remoteBootstrap) requestForBootstrap(url *net_url.URL, cfg kuma_dp.Conf
 	}
 	return respBytes, nil
 }
+
+// memoryLimit returns the memory limit of Envoy that is sent to the control plane to configure Envoy overload manager.
+func memoryLimit(cfg kuma_dp.Config) uint64 {
+	if cfg.DataplaneRuntime.MemoryLimit != 0 {
+		return cfg.DataplaneRuntime.MemoryLimit
+	}
+	limit, err := detectMemoryLimit()
+	if err != nil {
+		log.Info("[WARNING] could not detect the memory limit, heap based actions of Envoy overload manager will not be configured", "err", err.Error())
+		return 0
+	}
+	return limit
+}
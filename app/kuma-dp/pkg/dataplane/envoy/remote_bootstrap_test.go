@@ -36,6 +36,9 @@ var _ = Describe("Remote Bootstrap", func() {
 			GitCommit: "91ce236824a9d875601679aa80c63783fb0e8725",
 			BuildDate: "2019-08-07T11:26:06Z",
 		}
+		detectMemoryLimit = func() (uint64, error) {
+			return 0, nil
+		}
 	})
 
 	DescribeTable("should generate bootstrap configuration", func(given testCase) {
@@ -82,6 +85,7 @@ var _ = Describe("Remote Bootstrap", func() {
 				cfg.Dataplane.Name = "sample"
 				cfg.Dataplane.AdminPort = config_types.MustExactPort(4321) // exact port
 				cfg.DataplaneRuntime.Token = "token"
+				cfg.DataplaneRuntime.MemoryLimit = 536870912
 
 				return testCase{
 					config: cfg,
@@ -119,7 +123,8 @@ var _ = Describe("Remote Bootstrap", func() {
 					  "dynamicMetadata": {
 					    "test": "value"
 					  },
-                      "bootstrapVersion": "3"
+                      "bootstrapVersion": "3",
+					  "memoryLimit": 536870912
 					}`,
 				}
 			}()),
@@ -0,0 +1,11 @@
+package failmode_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestFailMode(t *testing.T) {
+	test.RunSpecs(t, "Fail Mode Suite")
+}
@@ -0,0 +1,158 @@
+package failmode
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	net_url "net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+var log = core.Log.WithName("kuma-dp").WithName("fail-mode")
+
+// Keys of the bootstrap dynamic metadata in which the fail mode of the dataplane is recorded.
+const (
+	MetadataKeyFailMode    = "kuma.io/fail-mode"
+	MetadataKeyFailTimeout = "kuma.io/fail-timeout"
+)
+
+// DefaultPollInterval defines how often the connection of Envoy to the Control Plane is checked.
+const DefaultPollInterval = 5 * time.Second
+
+const (
+	connectedStateStat   = "control_plane.connected_state"
+	connectedStateFilter = `^control_plane\.connected_state$`
+)
+
+// Metadata returns the bootstrap dynamic metadata that records the fail mode of the dataplane.
+func Metadata(cfg kuma_dp.Dataplane) map[string]string {
+	return map[string]string{
+		MetadataKeyFailMode:    failMode(cfg),
+		MetadataKeyFailTimeout: cfg.FailTimeout.String(),
+	}
+}
+
+type Opts struct {
+	Config kuma_dp.Config
+	// AdminPort is a port of Envoy Admin API
+	AdminPort    uint32
+	PollInterval time.Duration
+}
+
+// Watcher applies the fail mode of the dataplane when Envoy has been disconnected
+// from the Control Plane for longer than the fail timeout, so its configuration,
+// including the traffic permissions, may be stale.
+//
+// Envoy can't switch to a different set of RBAC rules on its own, so in the
+// closed mode the watcher returns an error, which stops Kuma DP and Envoy with it.
+// In the open mode Envoy keeps serving traffic with the last known configuration
+// and the watcher only raises an alert.
+type Watcher struct {
+	opts        Opts
+	adminClient *http.Client
+}
+
+var _ component.Component = &Watcher{}
+
+func New(opts Opts) *Watcher {
+	if opts.PollInterval == 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	return &Watcher{
+		opts:        opts,
+		adminClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func (w *Watcher) Start(stop <-chan struct{}) error {
+	mode := failMode(w.opts.Config.Dataplane)
+	timeout := w.opts.Config.Dataplane.FailTimeout
+	log.Info("starting watching the connection to the Control Plane", "failMode", mode, "failTimeout", timeout)
+
+	lastConnected := core.Now()
+	alerted := false
+	ticker := time.NewTicker(w.opts.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			connected, err := ControlPlaneConnected(w.adminClient, w.opts.AdminPort)
+			if err != nil {
+				// Envoy may not be started yet or be restarting, which is
+				// not a reason to consider the Control Plane unreachable.
+				log.V(1).Info("could not check the connection to the Control Plane", "err", err.Error())
+				continue
+			}
+			if connected {
+				if alerted {
+					log.Info("the connection to the Control Plane is restored", "disconnectedFor", core.Now().Sub(lastConnected))
+				}
+				lastConnected = core.Now()
+				alerted = false
+				continue
+			}
+			disconnectedFor := core.Now().Sub(lastConnected)
+			if disconnectedFor < timeout {
+				continue
+			}
+			if mode == kuma_dp.FailModeClosed {
+				return errors.Errorf("disconnected from the Control Plane for %s, which exceeds the fail timeout of %s, stopping the dataplane in %q fail mode", disconnectedFor, timeout, mode)
+			}
+			if !alerted {
+				log.Error(errors.New("configuration may be stale"), "disconnected from the Control Plane for longer than the fail timeout, serving traffic with the last known configuration",
+					"failMode", mode, "failTimeout", timeout, "disconnectedFor", disconnectedFor)
+				alerted = true
+			}
+		case <-stop:
+			log.Info("stopping watching the connection to the Control Plane")
+			return nil
+		}
+	}
+}
+
+func (w *Watcher) NeedLeaderElection() bool {
+	return false
+}
+
+type envoyStats struct {
+	Stats []struct {
+		Name  string `json:"name"`
+		Value uint64 `json:"value"`
+	} `json:"stats"`
+}
+
+// ControlPlaneConnected returns whether Envoy is connected to the Control Plane.
+func ControlPlaneConnected(client *http.Client, adminPort uint32) (bool, error) {
+	url := fmt.Sprintf("http://127.0.0.1:%d/stats?format=json&filter=%s", adminPort, net_url.QueryEscape(connectedStateFilter))
+	resp, err := client.Get(url)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get stats from Envoy")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.Errorf("unexpected status code from Envoy: %d", resp.StatusCode)
+	}
+	stats := envoyStats{}
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return false, errors.Wrap(err, "could not parse stats from Envoy")
+	}
+	for _, stat := range stats.Stats {
+		if stat.Name == connectedStateStat {
+			return stat.Value == 1, nil
+		}
+	}
+	return false, errors.Errorf("stat %q not found", connectedStateStat)
+}
+
+func failMode(cfg kuma_dp.Dataplane) string {
+	if cfg.FailMode == "" {
+		return kuma_dp.FailModeOpen
+	}
+	return cfg.FailMode
+}
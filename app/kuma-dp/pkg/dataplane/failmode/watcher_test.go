@@ -0,0 +1,137 @@
+package failmode_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/failmode"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+)
+
+var _ = Describe("Watcher", func() {
+
+	var adminServer *httptest.Server
+	var adminPort uint32
+	var connected int32
+
+	BeforeEach(func() {
+		atomic.StoreInt32(&connected, 1)
+		adminServer = httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			_, _ = fmt.Fprintf(resp, `{"stats":[{"name":"control_plane.connected_state","value":%d}]}`, atomic.LoadInt32(&connected))
+		}))
+		_, port, err := net.SplitHostPort(adminServer.Listener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		p, err := strconv.Atoi(port)
+		Expect(err).ToNot(HaveOccurred())
+		adminPort = uint32(p)
+	})
+
+	AfterEach(func() {
+		adminServer.Close()
+	})
+
+	config := func(mode string) kuma_dp.Config {
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.FailMode = mode
+		cfg.Dataplane.FailTimeout = 100 * time.Millisecond
+		return cfg
+	}
+
+	start := func(mode string) (chan struct{}, chan error) {
+		watcher := failmode.New(failmode.Opts{
+			Config:       config(mode),
+			AdminPort:    adminPort,
+			PollInterval: 10 * time.Millisecond,
+		})
+		stop := make(chan struct{})
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- watcher.Start(stop)
+		}()
+		return stop, errCh
+	}
+
+	It("should read the connection state from Envoy", func() {
+		// when
+		isConnected, err := failmode.ControlPlaneConnected(http.DefaultClient, adminPort)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isConnected).To(BeTrue())
+
+		// when
+		atomic.StoreInt32(&connected, 0)
+		isConnected, err = failmode.ControlPlaneConnected(http.DefaultClient, adminPort)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isConnected).To(BeFalse())
+	})
+
+	It("should stop the dataplane in the closed mode", func() {
+		// given
+		_, errCh := start(kuma_dp.FailModeClosed)
+
+		// when
+		atomic.StoreInt32(&connected, 0)
+
+		// then
+		var err error
+		Eventually(errCh).Should(Receive(&err))
+		Expect(err).To(MatchError(ContainSubstring(`stopping the dataplane in "closed" fail mode`)))
+	})
+
+	It("should keep the dataplane running in the open mode", func() {
+		// given
+		stop, errCh := start(kuma_dp.FailModeOpen)
+
+		// when
+		atomic.StoreInt32(&connected, 0)
+
+		// then
+		Consistently(errCh, "300ms").ShouldNot(Receive())
+
+		// when
+		close(stop)
+
+		// then
+		Eventually(errCh).Should(Receive(BeNil()))
+	})
+
+	It("should not stop the dataplane when the connection is restored in time", func() {
+		// given
+		stop, errCh := start(kuma_dp.FailModeClosed)
+
+		// when
+		for i := 0; i < 5; i++ {
+			atomic.StoreInt32(&connected, 0)
+			time.Sleep(50 * time.Millisecond)
+			atomic.StoreInt32(&connected, 1)
+			time.Sleep(20 * time.Millisecond)
+		}
+
+		// then
+		Consistently(errCh, "100ms").ShouldNot(Receive())
+		close(stop)
+		Eventually(errCh).Should(Receive(BeNil()))
+	})
+
+	It("should record the fail mode in the bootstrap metadata", func() {
+		// when
+		metadata := failmode.Metadata(config(kuma_dp.FailModeClosed).Dataplane)
+
+		// then
+		Expect(metadata).To(Equal(map[string]string{
+			"kuma.io/fail-mode":    "closed",
+			"kuma.io/fail-timeout": "100ms",
+		}))
+	})
+})
@@ -0,0 +1,147 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	command_utils "github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/command"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+var log = core.Log.WithName("kuma-dp").WithName("hooks")
+
+// Event is an event of the data plane proxy that hooks are run on.
+type Event string
+
+const (
+	// PostBootstrap is the event of Envoy started with the generated bootstrap configuration.
+	PostBootstrap Event = "post-bootstrap"
+	// PostCertRotation is the event of a new identity certificate delivered to the secrets dir.
+	PostCertRotation Event = "post-cert-rotation"
+	// PreShutdown is the event of kuma-dp about to drain and stop the data plane proxy.
+	PreShutdown Event = "pre-shutdown"
+)
+
+// Environment variables that are passed to the hooks, in addition to the environment of kuma-dp.
+const (
+	EventEnv         = "KUMA_DP_HOOK_EVENT"
+	MeshEnv          = "KUMA_DP_MESH"
+	NameEnv          = "KUMA_DP_NAME"
+	BootstrapFileEnv = "KUMA_DP_BOOTSTRAP_FILE"
+	EnvoyPidEnv      = "KUMA_DP_ENVOY_PID"
+	SecretsDirEnv    = "KUMA_DP_SECRETS_DIR"
+)
+
+type Opts struct {
+	Config kuma_dp.Config
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// Runner runs the hooks of the events. A nil Runner, or a Runner without a hooks dir,
+// doesn't run any hook.
+type Runner struct {
+	opts Opts
+}
+
+func New(opts Opts) *Runner {
+	return &Runner{opts: opts}
+}
+
+// Run runs the hooks of the event one after another, with the given additional environment
+// variables. With the 'fail' failure policy, the first hook that fails stops the run and its
+// error is returned. With the 'ignore' failure policy, failures are only logged.
+func (r *Runner) Run(event Event, env map[string]string) error {
+	if r == nil || r.opts.Config.DataplaneRuntime.Hooks.Dir == "" {
+		return nil
+	}
+
+	paths, err := r.hooks(event)
+	if err != nil {
+		return r.failed(event, err)
+	}
+
+	for _, path := range paths {
+		log.Info("running hook", "event", event, "path", path)
+		if err := r.run(event, path, env); err != nil {
+			if err := r.failed(event, errors.Wrapf(err, "hook %s failed", path)); err != nil {
+				return err
+			}
+			continue
+		}
+		log.V(1).Info("hook succeeded", "event", event, "path", path)
+	}
+
+	return nil
+}
+
+// hooks returns the executables in the directory of the event, in lexical order.
+func (r *Runner) hooks(event Event) ([]string, error) {
+	dir := filepath.Join(r.opts.Config.DataplaneRuntime.Hooks.Dir, string(event))
+
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not list the hooks in %s", dir)
+	}
+
+	var paths []string
+	for _, file := range files {
+		if file.IsDir() || file.Mode()&0111 == 0 {
+			log.V(1).Info("skipping a file that is not executable", "event", event, "path", filepath.Join(dir, file.Name()))
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, file.Name()))
+	}
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+func (r *Runner) run(event Event, path string, env map[string]string) error {
+	hooks := r.opts.Config.DataplaneRuntime.Hooks
+
+	ctx, cancel := context.WithTimeout(context.Background(), hooks.Timeout)
+	defer cancel()
+
+	command := command_utils.BuildCommand(ctx, r.opts.Stdout, r.opts.Stderr, path)
+	command.Env = append(os.Environ(),
+		fmt.Sprintf("%s=%s", EventEnv, event),
+		fmt.Sprintf("%s=%s", MeshEnv, r.opts.Config.Dataplane.Mesh),
+		fmt.Sprintf("%s=%s", NameEnv, r.opts.Config.Dataplane.Name),
+	)
+
+	var names []string
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		command.Env = append(command.Env, fmt.Sprintf("%s=%s", name, env[name]))
+	}
+
+	err := command.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return errors.Errorf("timed out after %s", hooks.Timeout)
+	}
+	return err
+}
+
+// failed applies the failure policy to the error of a hook.
+func (r *Runner) failed(event Event, err error) error {
+	if r.opts.Config.DataplaneRuntime.Hooks.FailurePolicy == kuma_dp.HookFailurePolicyFail {
+		return err
+	}
+	log.Error(err, "hook failed, ignoring the failure", "event", event)
+	return nil
+}
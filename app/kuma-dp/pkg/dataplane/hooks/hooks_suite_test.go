@@ -0,0 +1,11 @@
+package hooks_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestHooks(t *testing.T) {
+	test.RunSpecs(t, "Hooks Suite")
+}
@@ -0,0 +1,125 @@
+package hooks_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/hooks"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+)
+
+var _ = Describe("Runner", func() {
+
+	var dir string
+	var stdout *bytes.Buffer
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "kuma-dp-hooks-")
+		Expect(err).ToNot(HaveOccurred())
+		stdout = &bytes.Buffer{}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	writeHook := func(event hooks.Event, name string, script string, perm os.FileMode) {
+		path := filepath.Join(dir, string(event), name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte("#!/bin/sh\n"+script), perm)).To(Succeed())
+	}
+
+	runner := func(failurePolicy string) *hooks.Runner {
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Mesh = "default"
+		cfg.Dataplane.Name = "dp-1"
+		cfg.DataplaneRuntime.Hooks.Dir = dir
+		cfg.DataplaneRuntime.Hooks.Timeout = time.Second
+		cfg.DataplaneRuntime.Hooks.FailurePolicy = failurePolicy
+		return hooks.New(hooks.Opts{
+			Config: cfg,
+			Stdout: stdout,
+			Stderr: stdout,
+		})
+	}
+
+	It("should run the executable hooks of the event in order", func() {
+		// given
+		writeHook(hooks.PostBootstrap, "20-second", `echo "second $KUMA_DP_HOOK_EVENT $KUMA_DP_BOOTSTRAP_FILE"`, 0755)
+		writeHook(hooks.PostBootstrap, "10-first", `echo "first $KUMA_DP_MESH/$KUMA_DP_NAME"`, 0755)
+		writeHook(hooks.PostBootstrap, "README", `echo "not executable"`, 0644)
+		writeHook(hooks.PreShutdown, "10-other-event", `echo "other event"`, 0755)
+
+		// when
+		err := runner(kuma_dp.HookFailurePolicyFail).Run(hooks.PostBootstrap, map[string]string{
+			hooks.BootstrapFileEnv: "/tmp/bootstrap.yaml",
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout.String()).To(Equal("first default/dp-1\nsecond post-bootstrap /tmp/bootstrap.yaml\n"))
+	})
+
+	It("should not fail when the event has no hooks", func() {
+		// when
+		err := runner(kuma_dp.HookFailurePolicyFail).Run(hooks.PostCertRotation, nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should stop at the first failed hook with the fail policy", func() {
+		// given
+		writeHook(hooks.PostCertRotation, "10-fails", "exit 1", 0755)
+		writeHook(hooks.PostCertRotation, "20-not-run", `echo "not run"`, 0755)
+
+		// when
+		err := runner(kuma_dp.HookFailurePolicyFail).Run(hooks.PostCertRotation, nil)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("10-fails failed")))
+		Expect(stdout.String()).To(BeEmpty())
+	})
+
+	It("should run the next hooks with the ignore policy", func() {
+		// given
+		writeHook(hooks.PostCertRotation, "10-fails", "exit 1", 0755)
+		writeHook(hooks.PostCertRotation, "20-run", `echo "run"`, 0755)
+
+		// when
+		err := runner(kuma_dp.HookFailurePolicyIgnore).Run(hooks.PostCertRotation, nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout.String()).To(Equal("run\n"))
+	})
+
+	It("should kill the hooks that time out", func() {
+		// given
+		writeHook(hooks.PreShutdown, "10-sleeps", "exec sleep 10", 0755)
+
+		// when
+		err := runner(kuma_dp.HookFailurePolicyFail).Run(hooks.PreShutdown, nil)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("timed out after 1s")))
+	})
+
+	It("should not run hooks without a hooks dir", func() {
+		// given
+		var runner *hooks.Runner
+
+		// when
+		err := runner.Run(hooks.PostBootstrap, nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
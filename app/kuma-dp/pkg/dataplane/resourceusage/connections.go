@@ -0,0 +1,46 @@
+package resourceusage
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	net_url "net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const activeConnectionsFilter = `^listener\..*\.downstream_cx_active$`
+
+type envoyStats struct {
+	Stats []struct {
+		Name  string `json:"name"`
+		Value uint64 `json:"value"`
+	} `json:"stats"`
+}
+
+// ActiveConnections returns the number of downstream connections handled by the listeners of Envoy.
+// Connections to the admin listener and per worker stats, which would be counted twice, are skipped.
+func ActiveConnections(client *http.Client, adminPort uint32) (uint64, error) {
+	url := fmt.Sprintf("http://127.0.0.1:%d/stats?format=json&filter=%s", adminPort, net_url.QueryEscape(activeConnectionsFilter))
+	resp, err := client.Get(url)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get stats from Envoy")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.Errorf("unexpected status code from Envoy: %d", resp.StatusCode)
+	}
+	stats := envoyStats{}
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return 0, errors.Wrap(err, "could not parse stats from Envoy")
+	}
+	var total uint64
+	for _, stat := range stats.Stats {
+		if strings.HasPrefix(stat.Name, "listener.admin.") || strings.Contains(stat.Name, ".worker_") {
+			continue
+		}
+		total += stat.Value
+	}
+	return total, nil
+}
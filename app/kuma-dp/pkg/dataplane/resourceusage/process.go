@@ -0,0 +1,16 @@
+package resourceusage
+
+import (
+	"time"
+)
+
+// ProcessStats is a snapshot of the resources used by a process.
+type ProcessStats struct {
+	// CPUTime is the total time the process spent on CPU in user and kernel mode
+	CPUTime  time.Duration
+	RssBytes uint64
+	OpenFds  uint32
+}
+
+// ProcessStatsFunc reads the resources used by the process with the given pid.
+type ProcessStatsFunc func(pid int) (ProcessStats, error)
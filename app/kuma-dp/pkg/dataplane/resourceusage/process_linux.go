@@ -0,0 +1,75 @@
+package resourceusage
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// clockTicks is USER_HZ which is 100 on all the architectures supported by Linux.
+const clockTicks = 100
+
+// ReadProcessStats reads the resources used by the process from procfs.
+func ReadProcessStats(pid int) (ProcessStats, error) {
+	stat, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return ProcessStats{}, err
+	}
+	cpuTime, err := parseCPUTime(stat)
+	if err != nil {
+		return ProcessStats{}, err
+	}
+	statm, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/statm", pid))
+	if err != nil {
+		return ProcessStats{}, err
+	}
+	rssPages, err := parseRssPages(statm)
+	if err != nil {
+		return ProcessStats{}, err
+	}
+	fds, err := ioutil.ReadDir(fmt.Sprintf("/proc/%d/fd", pid))
+	if err != nil {
+		return ProcessStats{}, err
+	}
+	return ProcessStats{
+		CPUTime:  cpuTime,
+		RssBytes: rssPages * uint64(os.Getpagesize()),
+		OpenFds:  uint32(len(fds)),
+	}, nil
+}
+
+// parseCPUTime returns the sum of utime and stime from /proc/<pid>/stat.
+// The name of the executable in the second field may contain spaces, so the fields are counted from the closing parenthesis.
+func parseCPUTime(stat []byte) (time.Duration, error) {
+	idx := bytes.LastIndexByte(stat, ')')
+	if idx < 0 {
+		return 0, errors.New("invalid format of the stat file")
+	}
+	// fields start with the state of the process which is the 3rd field of the file
+	fields := bytes.Fields(stat[idx+1:])
+	if len(fields) < 13 {
+		return 0, errors.New("invalid format of the stat file")
+	}
+	utime, err := strconv.ParseUint(string(fields[11]), 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not parse utime")
+	}
+	stime, err := strconv.ParseUint(string(fields[12]), 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not parse stime")
+	}
+	return time.Duration(utime+stime) * time.Second / clockTicks, nil
+}
+
+func parseRssPages(statm []byte) (uint64, error) {
+	fields := bytes.Fields(statm)
+	if len(fields) < 2 {
+		return 0, errors.New("invalid format of the statm file")
+	}
+	return strconv.ParseUint(string(fields[1]), 10, 64)
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package resourceusage
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ReadProcessStats is supported only on Linux.
+func ReadProcessStats(_ int) (ProcessStats, error) {
+	return ProcessStats{}, errors.New("reading the resource usage of a process is supported only on Linux")
+}
@@ -0,0 +1,73 @@
+package resourceusage
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	net_url "net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/token"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/xds/resourceusage/types"
+)
+
+// SenderFunc sends the resource usage of the data plane proxy to the Control Plane.
+type SenderFunc func(url string, cfg kuma_dp.Config, request types.ResourceUsageRequest) error
+
+type remoteSender struct {
+	client *http.Client
+}
+
+func NewRemoteSender(client *http.Client) SenderFunc {
+	rs := remoteSender{client: client}
+	return rs.Send
+}
+
+func (r *remoteSender) Send(url string, cfg kuma_dp.Config, request types.ResourceUsageRequest) error {
+	usageUrl, err := net_url.Parse(url)
+	if err != nil {
+		return err
+	}
+	if usageUrl.Scheme == "https" && cfg.ControlPlane.CaCert != "" {
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM([]byte(cfg.ControlPlane.CaCert)); !ok {
+			return errors.New("could not add certificate")
+		}
+		r.client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		}
+	}
+	usageUrl.Path = "/resource-usage"
+
+	dpToken, err := token.Read(cfg)
+	if err != nil {
+		return err
+	}
+	request.Mesh = cfg.Dataplane.Mesh
+	request.Name = cfg.Dataplane.Name
+	request.DataplaneToken = dpToken
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal request to json")
+	}
+	resp, err := r.client.Post(usageUrl.String(), "application/json", bytes.NewReader(jsonBytes))
+	if err != nil {
+		return errors.Wrap(err, "request to resource usage server failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to read the response with status code: %d", resp.StatusCode)
+	}
+	return errors.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(bodyBytes))
+}
@@ -0,0 +1,128 @@
+package resourceusage
+
+import (
+	"net/http"
+	"os"
+	"time"
+
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/xds/resourceusage/types"
+)
+
+var log = core.Log.WithName("kuma-dp").WithName("resource-usage")
+
+// DefaultInterval defines how often the resource usage is reported to the Control Plane.
+const DefaultInterval = time.Minute
+
+type Opts struct {
+	Config kuma_dp.Config
+	Sender SenderFunc
+	// EnvoyPid returns the pid of the Envoy process or 0 when Envoy is not running
+	EnvoyPid  func() int
+	AdminPort uint32
+	Interval  time.Duration
+	// ProcessStats defaults to ReadProcessStats
+	ProcessStats ProcessStatsFunc
+}
+
+type sample struct {
+	pid     int
+	cpuTime time.Duration
+	time    time.Time
+}
+
+// Reporter periodically reports the resource usage of Kuma DP and Envoy to the Control Plane,
+// which stores the recent values in the DataplaneInsight.
+type Reporter struct {
+	opts        Opts
+	adminClient *http.Client
+	samples     map[string]sample
+}
+
+var _ component.Component = &Reporter{}
+
+func New(opts Opts) *Reporter {
+	if opts.Interval == 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.ProcessStats == nil {
+		opts.ProcessStats = ReadProcessStats
+	}
+	return &Reporter{
+		opts:        opts,
+		adminClient: &http.Client{Timeout: 5 * time.Second},
+		samples:     map[string]sample{},
+	}
+}
+
+func (r *Reporter) Start(stop <-chan struct{}) error {
+	if _, err := r.opts.ProcessStats(os.Getpid()); err != nil {
+		log.Info("resource usage won't be reported", "reason", err.Error())
+		return nil
+	}
+	log.Info("starting reporting of the resource usage", "interval", r.opts.Interval)
+	// take the baseline of CPU time, so the first report contains the usage since now
+	r.collect()
+	ticker := time.NewTicker(r.opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.report(); err != nil {
+				log.Error(err, "could not report the resource usage")
+			}
+		case <-stop:
+			log.Info("stopping reporting of the resource usage")
+			return nil
+		}
+	}
+}
+
+func (r *Reporter) NeedLeaderElection() bool {
+	return false
+}
+
+func (r *Reporter) report() error {
+	request := r.collect()
+	return r.opts.Sender(r.opts.Config.ControlPlane.URL, r.opts.Config, request)
+}
+
+func (r *Reporter) collect() types.ResourceUsageRequest {
+	request := types.ResourceUsageRequest{
+		KumaDp: r.processUsage("kuma-dp", os.Getpid()),
+	}
+	if pid := r.opts.EnvoyPid(); pid != 0 {
+		request.Envoy = r.processUsage("envoy", pid)
+		connections, err := ActiveConnections(r.adminClient, r.opts.AdminPort)
+		if err != nil {
+			log.V(1).Info("could not get the number of active connections", "err", err.Error())
+		}
+		request.ActiveConnections = connections
+	}
+	return request
+}
+
+// processUsage returns the current usage of the process. CPU usage is the average since the previous sample of the same process.
+func (r *Reporter) processUsage(name string, pid int) types.ProcessResourceUsage {
+	stats, err := r.opts.ProcessStats(pid)
+	if err != nil {
+		log.V(1).Info("could not read the resource usage", "process", name, "pid", pid, "err", err.Error())
+		return types.ProcessResourceUsage{}
+	}
+	now := core.Now()
+	usage := types.ProcessResourceUsage{
+		RssBytes: stats.RssBytes,
+		OpenFds:  stats.OpenFds,
+	}
+	if prev, ok := r.samples[name]; ok && prev.pid == pid && now.After(prev.time) && stats.CPUTime >= prev.cpuTime {
+		usage.CpuMillicores = uint64((stats.CPUTime - prev.cpuTime) * 1000 / now.Sub(prev.time))
+	}
+	r.samples[name] = sample{
+		pid:     pid,
+		cpuTime: stats.CPUTime,
+		time:    now,
+	}
+	return usage
+}
@@ -0,0 +1,170 @@
+package resourceusage_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/resourceusage"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/xds/resourceusage/types"
+)
+
+var _ = Describe("Reporter", func() {
+
+	const envoyPid = 1234
+
+	var adminServer *httptest.Server
+	var adminPort uint32
+
+	var lock sync.Mutex
+	var requests []types.ResourceUsageRequest
+	sender := func(_ string, _ kuma_dp.Config, request types.ResourceUsageRequest) error {
+		lock.Lock()
+		defer lock.Unlock()
+		requests = append(requests, request)
+		return nil
+	}
+	sentRequests := func() []types.ResourceUsageRequest {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]types.ResourceUsageRequest{}, requests...)
+	}
+
+	var cpuTime time.Duration
+	processStats := func(pid int) (resourceusage.ProcessStats, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if pid == envoyPid {
+			cpuTime += time.Second
+			return resourceusage.ProcessStats{
+				CPUTime:  cpuTime,
+				RssBytes: 4096,
+				OpenFds:  40,
+			}, nil
+		}
+		return resourceusage.ProcessStats{
+			RssBytes: 1024,
+			OpenFds:  10,
+		}, nil
+	}
+
+	BeforeEach(func() {
+		requests = nil
+		cpuTime = 0
+		adminServer = httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Path).To(Equal("/stats"))
+			Expect(req.URL.Query().Get("filter")).To(Equal(`^listener\..*\.downstream_cx_active$`))
+			_, err := fmt.Fprint(resp, `{"stats": [
+			  {"name": "listener.admin.downstream_cx_active", "value": 1},
+			  {"name": "listener.admin.main_thread.downstream_cx_active", "value": 1},
+			  {"name": "listener.0.0.0.0_15001.downstream_cx_active", "value": 3},
+			  {"name": "listener.0.0.0.0_15001.worker_0.downstream_cx_active", "value": 3},
+			  {"name": "listener.192.168.0.1_8080.downstream_cx_active", "value": 4}
+			]}`)
+			Expect(err).ToNot(HaveOccurred())
+		}))
+		_, port, err := net.SplitHostPort(adminServer.Listener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		p, err := strconv.ParseUint(port, 10, 32)
+		Expect(err).ToNot(HaveOccurred())
+		adminPort = uint32(p)
+	})
+
+	AfterEach(func() {
+		adminServer.Close()
+	})
+
+	It("should periodically report the resource usage", func() {
+		// given
+		reporter := resourceusage.New(resourceusage.Opts{
+			Config: kuma_dp.DefaultConfig(),
+			Sender: sender,
+			EnvoyPid: func() int {
+				return envoyPid
+			},
+			AdminPort:    adminPort,
+			Interval:     100 * time.Millisecond,
+			ProcessStats: processStats,
+		})
+		stop := make(chan struct{})
+		done := make(chan struct{})
+
+		// when
+		go func() {
+			defer GinkgoRecover()
+			Expect(reporter.Start(stop)).To(Succeed())
+			close(done)
+		}()
+
+		// then
+		Eventually(func() int {
+			return len(sentRequests())
+		}, "5s", "10ms").Should(BeNumerically(">=", 2))
+		close(stop)
+		Eventually(done).Should(BeClosed())
+
+		request := sentRequests()[1]
+		Expect(request.KumaDp).To(Equal(types.ProcessResourceUsage{
+			RssBytes: 1024,
+			OpenFds:  10,
+		}))
+		Expect(request.Envoy.RssBytes).To(Equal(uint64(4096)))
+		Expect(request.Envoy.OpenFds).To(Equal(uint32(40)))
+		// one second of CPU time every ~100ms
+		Expect(request.Envoy.CpuMillicores).To(BeNumerically(">", 1000))
+		Expect(request.ActiveConnections).To(Equal(uint64(7)))
+	})
+
+	It("should not report the usage of Envoy when it's not running", func() {
+		// given
+		reporter := resourceusage.New(resourceusage.Opts{
+			Config: kuma_dp.DefaultConfig(),
+			Sender: sender,
+			EnvoyPid: func() int {
+				return 0
+			},
+			AdminPort:    adminPort,
+			Interval:     100 * time.Millisecond,
+			ProcessStats: processStats,
+		})
+		stop := make(chan struct{})
+		defer close(stop)
+
+		// when
+		go func() {
+			defer GinkgoRecover()
+			Expect(reporter.Start(stop)).To(Succeed())
+		}()
+
+		// then
+		Eventually(func() int {
+			return len(sentRequests())
+		}, "5s", "10ms").Should(BeNumerically(">=", 1))
+		request := sentRequests()[0]
+		Expect(request.KumaDp.OpenFds).To(Equal(uint32(10)))
+		Expect(request.Envoy).To(Equal(types.ProcessResourceUsage{}))
+		Expect(request.ActiveConnections).To(BeZero())
+	})
+
+	It("should read the resource usage of the current process", func() {
+		// when
+		stats, err := resourceusage.ReadProcessStats(os.Getpid())
+
+		// then
+		if err != nil {
+			Skip(err.Error())
+		}
+		Expect(stats.RssBytes).ToNot(BeZero())
+		Expect(stats.OpenFds).ToNot(BeZero())
+	})
+})
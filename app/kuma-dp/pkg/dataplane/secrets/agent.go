@@ -0,0 +1,133 @@
+package secrets
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sethvargo/go-retry"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/hooks"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+var log = core.Log.WithName("kuma-dp").WithName("secrets")
+
+// DefaultRefreshInterval defines how often the secrets are fetched from the Control Plane.
+// The Control Plane regenerates the certificate when it's expiring soon, so the interval
+// has to be significantly shorter than the lifetime of the certificate.
+const DefaultRefreshInterval = 30 * time.Second
+
+type Opts struct {
+	Config          kuma_dp.Config
+	Fetcher         FetcherFunc
+	Dataplane       *rest.Resource
+	RefreshInterval time.Duration
+	// Hooks runs the post-cert-rotation hooks when a new identity certificate is delivered.
+	Hooks *hooks.Runner
+}
+
+// Agent delivers the secrets of the data plane proxy to Envoy as files in the secrets dir.
+// Envoy watches the files instead of receiving the secrets over the xDS stream, so the secrets
+// are rotated even when the xDS stream is interrupted, e.g. during the upgrade of the Control Plane.
+type Agent struct {
+	opts          Opts
+	identityCerts []string
+}
+
+var _ component.Component = &Agent{}
+
+func New(opts Opts) *Agent {
+	if opts.RefreshInterval == 0 {
+		opts.RefreshInterval = DefaultRefreshInterval
+	}
+	return &Agent{opts: opts}
+}
+
+// Init writes the initial secrets. It has to be called before Envoy is started.
+func (a *Agent) Init() error {
+	if err := os.MkdirAll(a.opts.Config.DataplaneRuntime.SecretsDir, 0700); err != nil {
+		return errors.Wrapf(err, "could not create the secrets dir %s", a.opts.Config.DataplaneRuntime.SecretsDir)
+	}
+
+	backoff, err := retry.NewConstant(a.opts.Config.ControlPlane.Retry.Backoff)
+	if err != nil {
+		return errors.Wrap(err, "could not create retry backoff")
+	}
+	backoff = retry.WithMaxDuration(a.opts.Config.ControlPlane.Retry.MaxDuration, backoff)
+	return retry.Do(context.Background(), backoff, func(ctx context.Context) error {
+		log.Info("trying to fetch the secrets from the Control Plane")
+		_, err := a.refresh()
+		if err == nil {
+			return nil
+		}
+		if IsInvalidRequestErr(err) { // there is no point in retrying invalid request
+			return err
+		}
+
+		switch err {
+		case DpNotFoundErr:
+			log.Info("Dataplane entity is not yet found in the Control Plane. Retrying.", "backoff", a.opts.Config.ControlPlane.Retry.Backoff)
+		default:
+			log.Info("could not fetch the secrets. Retrying.", "backoff", a.opts.Config.ControlPlane.Retry.Backoff, "err", err.Error())
+		}
+		return retry.RetryableError(err)
+	})
+}
+
+func (a *Agent) Start(stop <-chan struct{}) error {
+	log.Info("starting delivery of the secrets as files", "dir", a.opts.Config.DataplaneRuntime.SecretsDir, "refreshInterval", a.opts.RefreshInterval)
+	ticker := time.NewTicker(a.opts.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			rotated, err := a.refresh()
+			if err != nil {
+				// Envoy keeps using the secrets from the files, we will try again with the next tick
+				log.Error(err, "could not refresh the secrets")
+				continue
+			}
+			if rotated {
+				log.Info("a new identity certificate was delivered")
+				if err := a.opts.Hooks.Run(hooks.PostCertRotation, map[string]string{
+					hooks.SecretsDirEnv: a.opts.Config.DataplaneRuntime.SecretsDir,
+				}); err != nil {
+					return errors.Wrap(err, "post-cert-rotation hooks failed")
+				}
+			}
+		case <-stop:
+			log.Info("stopping delivery of the secrets as files")
+			return nil
+		}
+	}
+}
+
+func (a *Agent) NeedLeaderElection() bool {
+	return false
+}
+
+// refresh writes the secrets fetched from the Control Plane, and returns whether
+// a new identity certificate was delivered.
+func (a *Agent) refresh() (bool, error) {
+	secrets, err := a.opts.Fetcher(a.opts.Config.ControlPlane.URL, a.opts.Config, a.opts.Dataplane)
+	if err != nil {
+		return false, err
+	}
+	dir := a.opts.Config.DataplaneRuntime.SecretsDir
+	if secrets == nil { // mTLS is disabled
+		a.identityCerts = nil
+		return false, writeEmptySecrets(dir)
+	}
+	if err := writeSecrets(dir, secrets); err != nil {
+		return false, err
+	}
+	rotated := !reflect.DeepEqual(a.identityCerts, secrets.IdentityCerts)
+	a.identityCerts = secrets.IdentityCerts
+	return rotated, nil
+}
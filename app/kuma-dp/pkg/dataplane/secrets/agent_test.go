@@ -0,0 +1,201 @@
+package secrets_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_service_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/hooks"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/secrets"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/secrets/files/types"
+)
+
+var _ = Describe("Agent", func() {
+
+	var dir string
+	var cfg kuma_dp.Config
+
+	var lock sync.Mutex
+	var response *types.SecretsResponse
+	fetcher := func(_ string, _ kuma_dp.Config, _ *rest.Resource) (*types.SecretsResponse, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return response, nil
+	}
+	setResponse := func(resp *types.SecretsResponse) {
+		lock.Lock()
+		defer lock.Unlock()
+		response = resp
+	}
+
+	readSecret := func(name string) *envoy_tls.Secret {
+		content, err := ioutil.ReadFile(filepath.Join(dir, name+".yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		discoveryResponse := &envoy_service_discovery.DiscoveryResponse{}
+		Expect(util_proto.FromYAML(content, discoveryResponse)).To(Succeed())
+		if len(discoveryResponse.Resources) == 0 {
+			return nil
+		}
+		Expect(discoveryResponse.Resources).To(HaveLen(1))
+		secret := &envoy_tls.Secret{}
+		Expect(util_proto.UnmarshalAnyTo(discoveryResponse.Resources[0], secret)).To(Succeed())
+		return secret
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "secrets")
+		Expect(err).ToNot(HaveOccurred())
+		cfg = kuma_dp.DefaultConfig()
+		cfg.DataplaneRuntime.SecretsDir = filepath.Join(dir, "secrets")
+		dir = cfg.DataplaneRuntime.SecretsDir
+		setResponse(&types.SecretsResponse{
+			IdentityCerts: []string{"CERT"},
+			IdentityKey:   "KEY",
+			CaCerts:       []string{"CA-1", "CA-2"},
+		})
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(filepath.Dir(dir))).To(Succeed())
+	})
+
+	It("should write the secrets on init", func() {
+		// given
+		agent := secrets.New(secrets.Opts{
+			Config:  cfg,
+			Fetcher: fetcher,
+		})
+
+		// when
+		err := agent.Init()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		identity := readSecret("identity_cert")
+		Expect(identity.Name).To(Equal("identity_cert"))
+		Expect(identity.GetTlsCertificate().GetCertificateChain().GetInlineBytes()).To(Equal([]byte("CERT")))
+		Expect(identity.GetTlsCertificate().GetPrivateKey().GetInlineBytes()).To(Equal([]byte("KEY")))
+		ca := readSecret("mesh_ca")
+		Expect(ca.Name).To(Equal("mesh_ca"))
+		Expect(ca.GetValidationContext().GetTrustedCa().GetInlineBytes()).To(Equal([]byte("CA-1\nCA-2")))
+
+		// and the secrets are private
+		stat, err := os.Stat(filepath.Join(dir, "identity_cert.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stat.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("should write empty files when mTLS is disabled", func() {
+		// given
+		setResponse(nil)
+		agent := secrets.New(secrets.Opts{
+			Config:  cfg,
+			Fetcher: fetcher,
+		})
+
+		// when
+		err := agent.Init()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readSecret("identity_cert")).To(BeNil())
+		Expect(readSecret("mesh_ca")).To(BeNil())
+	})
+
+	It("should rotate the secrets", func() {
+		// given
+		agent := secrets.New(secrets.Opts{
+			Config:          cfg,
+			Fetcher:         fetcher,
+			RefreshInterval: 10 * time.Millisecond,
+		})
+		Expect(agent.Init()).To(Succeed())
+		caStat, err := os.Stat(filepath.Join(dir, "mesh_ca.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			defer GinkgoRecover()
+			Expect(agent.Start(stop)).To(Succeed())
+		}()
+
+		// when
+		setResponse(&types.SecretsResponse{
+			IdentityCerts: []string{"CERT-2"},
+			IdentityKey:   "KEY-2",
+			CaCerts:       []string{"CA-1", "CA-2"},
+		})
+
+		// then
+		Eventually(func() []byte {
+			return readSecret("identity_cert").GetTlsCertificate().GetCertificateChain().GetInlineBytes()
+		}, "5s", "10ms").Should(Equal([]byte("CERT-2")))
+
+		// and the file of unchanged CA is not replaced
+		newCaStat, err := os.Stat(filepath.Join(dir, "mesh_ca.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.SameFile(caStat, newCaStat)).To(BeTrue())
+
+		// and there are no leftovers of temporary files
+		files, err := ioutil.ReadDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(2))
+	})
+
+	It("should run the post-cert-rotation hooks", func() {
+		// given
+		hooksDir := filepath.Join(filepath.Dir(dir), "hooks")
+		output := filepath.Join(filepath.Dir(dir), "rotated")
+		Expect(os.MkdirAll(filepath.Join(hooksDir, "post-cert-rotation"), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(
+			filepath.Join(hooksDir, "post-cert-rotation", "10-record"),
+			[]byte("#!/bin/sh\necho \"$KUMA_DP_SECRETS_DIR\" >> "+output+"\n"),
+			0755,
+		)).To(Succeed())
+		cfg.DataplaneRuntime.Hooks.Dir = hooksDir
+
+		agent := secrets.New(secrets.Opts{
+			Config:          cfg,
+			Fetcher:         fetcher,
+			RefreshInterval: 10 * time.Millisecond,
+			Hooks:           hooks.New(hooks.Opts{Config: cfg, Stdout: GinkgoWriter, Stderr: GinkgoWriter}),
+		})
+		Expect(agent.Init()).To(Succeed())
+
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			defer GinkgoRecover()
+			Expect(agent.Start(stop)).To(Succeed())
+		}()
+
+		// when
+		setResponse(&types.SecretsResponse{
+			IdentityCerts: []string{"CERT-2"},
+			IdentityKey:   "KEY-2",
+			CaCerts:       []string{"CA-1", "CA-2"},
+		})
+
+		// then the hooks run once, for the new certificate only
+		Eventually(func() (string, error) {
+			content, err := ioutil.ReadFile(output)
+			return string(content), err
+		}, "5s", "10ms").Should(Equal(dir + "\n"))
+		Consistently(func() (string, error) {
+			content, err := ioutil.ReadFile(output)
+			return string(content), err
+		}, "100ms", "10ms").Should(Equal(dir + "\n"))
+	})
+})
@@ -0,0 +1,98 @@
+package secrets
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_service_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_secrets "github.com/kumahq/kuma/pkg/xds/envoy/secrets/v3"
+	xds_tls "github.com/kumahq/kuma/pkg/xds/envoy/tls"
+	"github.com/kumahq/kuma/pkg/xds/secrets/files/types"
+)
+
+// writeSecrets writes the secrets to the files that Envoy watches.
+// The CA is written first, so the peers of the data plane proxy are trusted
+// before the data plane proxy starts to use a certificate issued by a new CA.
+func writeSecrets(dir string, secrets *types.SecretsResponse) error {
+	identity := &core_xds.IdentitySecret{
+		PemKey: []byte(secrets.IdentityKey),
+	}
+	for _, cert := range secrets.IdentityCerts {
+		identity.PemCerts = append(identity.PemCerts, []byte(cert))
+	}
+	ca := &core_xds.CaSecret{}
+	for _, cert := range secrets.CaCerts {
+		ca.PemCerts = append(ca.PemCerts, []byte(cert))
+	}
+	if err := writeSecret(dir, xds_tls.MeshCaResource, envoy_secrets.CreateCaSecret(ca)); err != nil {
+		return err
+	}
+	return writeSecret(dir, xds_tls.IdentityCertResource, envoy_secrets.CreateIdentitySecret(identity))
+}
+
+// writeEmptySecrets makes sure the files that Envoy watches exist, even when there are no secrets yet.
+// Envoy rejects the path based config source of a secret if the file does not exist.
+func writeEmptySecrets(dir string) error {
+	for _, name := range []string{xds_tls.MeshCaResource, xds_tls.IdentityCertResource} {
+		path := xds_tls.SecretFilePath(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			continue
+		}
+		if err := writeSecret(dir, name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeSecret writes DiscoveryResponse with the secret (or without any resources if the secret is nil)
+// in the format of the path based config source of Envoy.
+func writeSecret(dir string, name string, secret *envoy_tls.Secret) error {
+	response := &envoy_service_discovery.DiscoveryResponse{}
+	if secret != nil {
+		resource, err := anypb.New(secret)
+		if err != nil {
+			return err
+		}
+		response.TypeUrl = resource.TypeUrl
+		response.Resources = append(response.Resources, resource)
+	}
+	content, err := util_proto.ToYAML(response)
+	if err != nil {
+		return errors.Wrapf(err, "could not marshal secret %q", name)
+	}
+	return writeFileAtomically(xds_tls.SecretFilePath(dir, name), content)
+}
+
+// writeFileAtomically replaces the file by renaming a temporary file, so Envoy never reads partially written file.
+// Envoy watches the directory for files moved into it, therefore the temporary file has to be created in the same directory.
+func writeFileAtomically(path string, content []byte) error {
+	if existing, err := ioutil.ReadFile(path); err == nil && bytes.Equal(existing, content) {
+		return nil // there is no need to trigger reload of the secret in Envoy
+	}
+	tmpFile, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return errors.Wrap(err, "could not create a temporary file")
+	}
+	defer os.Remove(tmpFile.Name()) // no-op once the file is renamed
+	if _, err := tmpFile.Write(content); err != nil {
+		_ = tmpFile.Close()
+		return errors.Wrapf(err, "could not write to a file %s", tmpFile.Name())
+	}
+	if err := tmpFile.Sync(); err != nil {
+		_ = tmpFile.Close()
+		return errors.Wrapf(err, "could not sync a file %s", tmpFile.Name())
+	}
+	if err := tmpFile.Close(); err != nil {
+		return errors.Wrapf(err, "could not close a file %s", tmpFile.Name())
+	}
+	return errors.Wrapf(os.Rename(tmpFile.Name(), path), "could not rename %s to %s", tmpFile.Name(), path)
+}
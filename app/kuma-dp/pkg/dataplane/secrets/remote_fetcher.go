@@ -0,0 +1,111 @@
+package secrets
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	net_url "net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/token"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/xds/secrets/files/types"
+)
+
+// FetcherFunc fetches the secrets of the data plane proxy from the Control Plane.
+// It returns nil when mTLS is disabled on the Mesh of the data plane proxy.
+type FetcherFunc func(url string, cfg kuma_dp.Config, dataplane *rest.Resource) (*types.SecretsResponse, error)
+
+var DpNotFoundErr = errors.New("Dataplane entity not found")
+
+func InvalidRequestErr(msg string) error {
+	return errors.Errorf("Invalid request: %s", msg)
+}
+
+func IsInvalidRequestErr(err error) bool {
+	return strings.HasPrefix(err.Error(), "Invalid request: ")
+}
+
+type remoteFetcher struct {
+	client *http.Client
+}
+
+func NewRemoteFetcher(client *http.Client) FetcherFunc {
+	rf := remoteFetcher{client: client}
+	return rf.Fetch
+}
+
+func (r *remoteFetcher) Fetch(url string, cfg kuma_dp.Config, dataplane *rest.Resource) (*types.SecretsResponse, error) {
+	secretsUrl, err := net_url.Parse(url)
+	if err != nil {
+		return nil, err
+	}
+	if secretsUrl.Scheme == "https" && cfg.ControlPlane.CaCert != "" {
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM([]byte(cfg.ControlPlane.CaCert)); !ok {
+			return nil, errors.New("could not add certificate")
+		}
+		r.client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		}
+	}
+	secretsUrl.Path = "/secrets"
+
+	var dataplaneResource string
+	if dataplane != nil {
+		dpJSON, err := json.Marshal(dataplane)
+		if err != nil {
+			return nil, err
+		}
+		dataplaneResource = string(dpJSON)
+	}
+	dpToken, err := token.Read(cfg)
+	if err != nil {
+		return nil, err
+	}
+	request := types.SecretsRequest{
+		Mesh:              cfg.Dataplane.Mesh,
+		Name:              cfg.Dataplane.Name,
+		DataplaneToken:    dpToken,
+		DataplaneResource: dataplaneResource,
+	}
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal request to json")
+	}
+	resp, err := r.client.Post(secretsUrl.String(), "application/json", bytes.NewReader(jsonBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "request to secrets server failed")
+	}
+	defer resp.Body.Close()
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to read the response with status code: %d. Make sure you are using https URL", resp.StatusCode)
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		secrets := &types.SecretsResponse{}
+		if err := json.Unmarshal(bodyBytes, secrets); err != nil {
+			return nil, errors.Wrap(err, "could not parse the response")
+		}
+		return secrets, nil
+	case resp.StatusCode == http.StatusNoContent:
+		return nil, nil
+	case resp.StatusCode == http.StatusNotFound && len(bodyBytes) == 0:
+		return nil, DpNotFoundErr
+	case resp.StatusCode == http.StatusNotFound && string(bodyBytes) == "404: Page Not Found": // response body of Go HTTP Server when hit for invalid endpoint
+		return nil, errors.New("There is no /secrets endpoint for provided CP address. Double check if the address passed to the CP has a DP Server port (5678 by default) and that the version of the CP supports delivering the secrets as files")
+	case resp.StatusCode/100 == 4:
+		return nil, InvalidRequestErr(string(bodyBytes))
+	default:
+		return nil, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,11 @@
+package secrets_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestSecrets(t *testing.T) {
+	test.RunSpecs(t, "Secrets Suite")
+}
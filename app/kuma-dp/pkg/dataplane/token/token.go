@@ -0,0 +1,24 @@
+package token
+
+import (
+	"io/ioutil"
+
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+)
+
+// Read returns the dataplane token that Kuma DP sends with its requests to the DP Server.
+// The token given directly takes precedence over the token file. It returns an empty token
+// when neither is configured.
+func Read(cfg kuma_dp.Config) (string, error) {
+	if cfg.DataplaneRuntime.Token != "" {
+		return cfg.DataplaneRuntime.Token, nil
+	}
+	if cfg.DataplaneRuntime.TokenPath != "" {
+		tokenData, err := ioutil.ReadFile(cfg.DataplaneRuntime.TokenPath)
+		if err != nil {
+			return "", err
+		}
+		return string(tokenData), nil
+	}
+	return "", nil
+}
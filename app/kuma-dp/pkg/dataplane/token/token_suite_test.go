@@ -0,0 +1,11 @@
+package token_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestToken(t *testing.T) {
+	test.RunSpecs(t, "Token Suite")
+}
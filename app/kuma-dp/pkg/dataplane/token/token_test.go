@@ -0,0 +1,77 @@
+package token_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/token"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+)
+
+var _ = Describe("Read", func() {
+
+	var tokenPath string
+
+	BeforeEach(func() {
+		dir, err := ioutil.TempDir("", "token")
+		Expect(err).ToNot(HaveOccurred())
+		tokenPath = filepath.Join(dir, "token")
+		Expect(ioutil.WriteFile(tokenPath, []byte("token-from-file"), 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(filepath.Dir(tokenPath))).To(Succeed())
+	})
+
+	It("should prefer the token given directly", func() {
+		// given
+		cfg := kuma_dp.DefaultConfig()
+		cfg.DataplaneRuntime.Token = "token"
+		cfg.DataplaneRuntime.TokenPath = tokenPath
+
+		// when
+		dpToken, err := token.Read(cfg)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dpToken).To(Equal("token"))
+	})
+
+	It("should read the token file", func() {
+		// given
+		cfg := kuma_dp.DefaultConfig()
+		cfg.DataplaneRuntime.TokenPath = tokenPath
+
+		// when
+		dpToken, err := token.Read(cfg)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dpToken).To(Equal("token-from-file"))
+	})
+
+	It("should return an empty token when none is configured", func() {
+		// when
+		dpToken, err := token.Read(kuma_dp.DefaultConfig())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dpToken).To(BeEmpty())
+	})
+
+	It("should fail when the token file cannot be read", func() {
+		// given
+		cfg := kuma_dp.DefaultConfig()
+		cfg.DataplaneRuntime.TokenPath = filepath.Join(filepath.Dir(tokenPath), "missing")
+
+		// when
+		_, err := token.Read(cfg)
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
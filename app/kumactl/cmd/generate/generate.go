@@ -25,8 +25,10 @@ func NewGenerateCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	// sub-commands
 	generateCmd.AddCommand(NewGenerateDataplaneTokenCmd(pctx))
 	generateCmd.AddCommand(NewGenerateZoneIngressTokenCmd(pctx))
+	generateCmd.AddCommand(NewGenerateZoneEnrollmentTokenCmd(pctx))
 	generateCmd.AddCommand(NewGenerateCertificateCmd(pctx))
 	generateCmd.AddCommand(NewGenerateSigningKeyCmd(pctx))
+	generateCmd.AddCommand(NewGenerateMeshCmd())
 	generateCmd.AddCommand(generate.NewGenerateUserTokenCmd(pctx))
 	return generateCmd
 }
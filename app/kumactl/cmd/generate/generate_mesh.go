@@ -0,0 +1,304 @@
+package generate
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	defaults_mesh "github.com/kumahq/kuma/pkg/defaults/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const (
+	meshBackendOff    = "off"
+	builtinCABackend  = "builtin"
+	universalFormat   = "universal"
+	kubernetesFormat  = "kubernetes"
+	kubernetesVersion = "kuma.io/v1alpha1"
+
+	defaultZipkinURL      = "http://jaeger-collector.kuma-tracing:9411/api/v2/spans"
+	defaultDatadogAddress = "trace-svc.default.svc.cluster.local"
+	defaultDatadogPort    = 8126
+	defaultLoggingPath    = "/dev/stdout"
+)
+
+type generateMeshContext struct {
+	args struct {
+		name            string
+		mtls            string
+		metrics         string
+		tracing         string
+		tracingAddress  string
+		tracingSampling float64
+		logging         string
+		loggingAddress  string
+		defaultPolicies bool
+		format          string
+	}
+}
+
+func NewGenerateMeshCmd() *cobra.Command {
+	ctx := &generateMeshContext{}
+	cmd := &cobra.Command{
+		Use:   "mesh",
+		Short: "Generate a Mesh with recommended policies",
+		Long: `Generate a Mesh with the selected backends and the policies recommended for it.
+
+The resources are validated before they are printed, so the output can be applied with "kumactl apply" or "kubectl apply".
+The default policies are the same ones that the control plane creates for a new Mesh.
+Policies that use the tracing and logging backends are added when those backends are enabled.`,
+		Example: `
+  # Generate a Mesh with mTLS, Prometheus metrics and Zipkin tracing
+  kumactl generate mesh --name demo --mtls builtin --metrics prometheus --tracing zipkin
+
+  # Generate a Mesh for Kubernetes that logs traffic to a TCP collector
+  kumactl generate mesh --name demo --logging tcp --logging-address logstash.logging:5000 --format kubernetes`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if ctx.args.format != universalFormat && ctx.args.format != kubernetesFormat {
+				return errors.Errorf(`--format has to be either %q or %q`, universalFormat, kubernetesFormat)
+			}
+			resources, err := ctx.resources()
+			if err != nil {
+				return err
+			}
+			for i, resource := range resources {
+				if err := resource.Validate(); err != nil {
+					return errors.Wrapf(err, "generated %s %q is not valid", resource.Descriptor().Name, resource.GetMeta().GetName())
+				}
+				bytes, err := marshalResource(resource, ctx.args.format)
+				if err != nil {
+					return errors.Wrapf(err, "could not marshal %s %q", resource.Descriptor().Name, resource.GetMeta().GetName())
+				}
+				if i > 0 {
+					if _, err := fmt.Fprint(cmd.OutOrStdout(), "---\n"); err != nil {
+						return err
+					}
+				}
+				if _, err := cmd.OutOrStdout().Write(bytes); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.name, "name", model.DefaultMesh, "name of the Mesh")
+	cmd.Flags().StringVar(&ctx.args.mtls, "mtls", meshBackendOff, kuma_cmd.UsageOptions("certificate authority used for mTLS", builtinCABackend, meshBackendOff))
+	cmd.Flags().StringVar(&ctx.args.metrics, "metrics", meshBackendOff, kuma_cmd.UsageOptions("metrics backend", mesh_proto.MetricsPrometheusType, meshBackendOff))
+	cmd.Flags().StringVar(&ctx.args.tracing, "tracing", meshBackendOff, kuma_cmd.UsageOptions("tracing backend", mesh_proto.TracingZipkinType, mesh_proto.TracingDatadogType, meshBackendOff))
+	cmd.Flags().StringVar(&ctx.args.tracingAddress, "tracing-address", "", fmt.Sprintf("URL of the Zipkin collector (default %q) or HOST:PORT of the Datadog agent (default \"%s:%d\")", defaultZipkinURL, defaultDatadogAddress, defaultDatadogPort))
+	cmd.Flags().Float64Var(&ctx.args.tracingSampling, "tracing-sampling", 100, "percentage of requests that are traced")
+	cmd.Flags().StringVar(&ctx.args.logging, "logging", meshBackendOff, kuma_cmd.UsageOptions("traffic logging backend", mesh_proto.LoggingFileType, mesh_proto.LoggingTcpType, meshBackendOff))
+	cmd.Flags().StringVar(&ctx.args.loggingAddress, "logging-address", "", fmt.Sprintf("path of the file (default %q) or HOST:PORT of the TCP collector", defaultLoggingPath))
+	cmd.Flags().BoolVar(&ctx.args.defaultPolicies, "default-policies", true, "generate the policies recommended for the Mesh")
+	cmd.Flags().StringVar(&ctx.args.format, "format", universalFormat, kuma_cmd.UsageOptions("format of the resources", universalFormat, kubernetesFormat))
+	return cmd
+}
+
+func (c *generateMeshContext) resources() ([]model.Resource, error) {
+	mesh := &core_mesh.MeshResource{
+		Meta: resourceMeta(core_mesh.MeshType, model.NoMesh, c.args.name),
+		Spec: &mesh_proto.Mesh{},
+	}
+
+	switch c.args.mtls {
+	case meshBackendOff:
+	case builtinCABackend:
+		mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+			EnabledBackend: "ca-1",
+			Backends: []*mesh_proto.CertificateAuthorityBackend{{
+				Name: "ca-1",
+				Type: builtinCABackend,
+			}},
+		}
+	default:
+		return nil, errors.Errorf("--mtls has to be one of %q, %q", builtinCABackend, meshBackendOff)
+	}
+
+	switch c.args.metrics {
+	case meshBackendOff:
+	case mesh_proto.MetricsPrometheusType:
+		mesh.Spec.Metrics = &mesh_proto.Metrics{
+			EnabledBackend: "prometheus-1",
+			Backends: []*mesh_proto.MetricsBackend{{
+				Name: "prometheus-1",
+				Type: mesh_proto.MetricsPrometheusType,
+			}},
+		}
+	default:
+		return nil, errors.Errorf("--metrics has to be one of %q, %q", mesh_proto.MetricsPrometheusType, meshBackendOff)
+	}
+
+	tracingBackend, err := c.tracingBackend()
+	if err != nil {
+		return nil, err
+	}
+	if tracingBackend != nil {
+		mesh.Spec.Tracing = &mesh_proto.Tracing{
+			DefaultBackend: tracingBackend.Name,
+			Backends:       []*mesh_proto.TracingBackend{tracingBackend},
+		}
+	}
+
+	loggingBackend, err := c.loggingBackend()
+	if err != nil {
+		return nil, err
+	}
+	if loggingBackend != nil {
+		mesh.Spec.Logging = &mesh_proto.Logging{
+			DefaultBackend: loggingBackend.Name,
+			Backends:       []*mesh_proto.LoggingBackend{loggingBackend},
+		}
+	}
+
+	resources := []model.Resource{mesh}
+	if !c.args.defaultPolicies {
+		return resources, nil
+	}
+	resources = append(resources, defaults_mesh.DefaultPolicies(c.args.name)...)
+	if tracingBackend != nil {
+		resources = append(resources, &core_mesh.TrafficTraceResource{
+			Meta: resourceMeta(core_mesh.TrafficTraceType, c.args.name, fmt.Sprintf("trace-all-%s", c.args.name)),
+			Spec: &mesh_proto.TrafficTrace{
+				Selectors: []*mesh_proto.Selector{{
+					Match: mesh_proto.MatchAnyService(),
+				}},
+				Conf: &mesh_proto.TrafficTrace_Conf{
+					Backend: tracingBackend.Name,
+				},
+			},
+		})
+	}
+	if loggingBackend != nil {
+		resources = append(resources, &core_mesh.TrafficLogResource{
+			Meta: resourceMeta(core_mesh.TrafficLogType, c.args.name, fmt.Sprintf("log-all-%s", c.args.name)),
+			Spec: &mesh_proto.TrafficLog{
+				Sources: []*mesh_proto.Selector{{
+					Match: mesh_proto.MatchAnyService(),
+				}},
+				Destinations: []*mesh_proto.Selector{{
+					Match: mesh_proto.MatchAnyService(),
+				}},
+				Conf: &mesh_proto.TrafficLog_Conf{
+					Backend: loggingBackend.Name,
+				},
+			},
+		})
+	}
+	return resources, nil
+}
+
+func (c *generateMeshContext) tracingBackend() (*mesh_proto.TracingBackend, error) {
+	backend := &mesh_proto.TracingBackend{
+		Name:     fmt.Sprintf("%s-1", c.args.tracing),
+		Type:     c.args.tracing,
+		Sampling: util_proto.Double(c.args.tracingSampling),
+	}
+	switch c.args.tracing {
+	case meshBackendOff:
+		return nil, nil
+	case mesh_proto.TracingZipkinType:
+		url := c.args.tracingAddress
+		if url == "" {
+			url = defaultZipkinURL
+		}
+		backend.Conf = util_proto.MustToStruct(&mesh_proto.ZipkinTracingBackendConfig{
+			Url: url,
+		})
+	case mesh_proto.TracingDatadogType:
+		address, port := defaultDatadogAddress, uint32(defaultDatadogPort)
+		if c.args.tracingAddress != "" {
+			host, p, err := net.SplitHostPort(c.args.tracingAddress)
+			if err != nil {
+				return nil, errors.Wrap(err, "--tracing-address has to be in format of HOST:PORT for datadog")
+			}
+			parsed, err := strconv.ParseUint(p, 10, 32)
+			if err != nil {
+				return nil, errors.Wrap(err, "--tracing-address has to be in format of HOST:PORT for datadog")
+			}
+			address, port = host, uint32(parsed)
+		}
+		backend.Conf = util_proto.MustToStruct(&mesh_proto.DatadogTracingBackendConfig{
+			Address: address,
+			Port:    port,
+		})
+	default:
+		return nil, errors.Errorf("--tracing has to be one of %q, %q, %q", mesh_proto.TracingZipkinType, mesh_proto.TracingDatadogType, meshBackendOff)
+	}
+	return backend, nil
+}
+
+func (c *generateMeshContext) loggingBackend() (*mesh_proto.LoggingBackend, error) {
+	backend := &mesh_proto.LoggingBackend{
+		Name: fmt.Sprintf("%s-1", c.args.logging),
+		Type: c.args.logging,
+	}
+	switch c.args.logging {
+	case meshBackendOff:
+		return nil, nil
+	case mesh_proto.LoggingFileType:
+		path := c.args.loggingAddress
+		if path == "" {
+			path = defaultLoggingPath
+		}
+		backend.Conf = util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+			Path: path,
+		})
+	case mesh_proto.LoggingTcpType:
+		if c.args.loggingAddress == "" {
+			return nil, errors.Errorf("--logging-address has to be set for %q logging", mesh_proto.LoggingTcpType)
+		}
+		backend.Conf = util_proto.MustToStruct(&mesh_proto.TcpLoggingBackendConfig{
+			Address: c.args.loggingAddress,
+		})
+	default:
+		return nil, errors.Errorf("--logging has to be one of %q, %q, %q", mesh_proto.LoggingFileType, mesh_proto.LoggingTcpType, meshBackendOff)
+	}
+	return backend, nil
+}
+
+func resourceMeta(resType model.ResourceType, mesh, name string) model.ResourceMeta {
+	return &rest.ResourceMeta{
+		Type: string(resType),
+		Mesh: mesh,
+		Name: name,
+	}
+}
+
+// marshalResource renders the resource without the creation and modification times which are set by the control plane.
+func marshalResource(resource model.Resource, format string) ([]byte, error) {
+	spec, err := util_proto.ToMap(resource.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+	meta := resource.GetMeta()
+	var obj map[string]interface{}
+	switch format {
+	case kubernetesFormat:
+		obj = map[string]interface{}{
+			"apiVersion": kubernetesVersion,
+			"kind":       string(resource.Descriptor().Name),
+			"metadata": map[string]interface{}{
+				"name": meta.GetName(),
+			},
+			"spec": spec,
+		}
+	default:
+		obj = spec
+		obj["type"] = string(resource.Descriptor().Name)
+		obj["name"] = meta.GetName()
+	}
+	if meta.GetMesh() != model.NoMesh {
+		obj["mesh"] = meta.GetMesh()
+	}
+	return yaml.Marshal(obj)
+}
@@ -0,0 +1,86 @@
+package generate_test
+
+import (
+	"bytes"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	"github.com/kumahq/kuma/pkg/util/test"
+)
+
+var _ = Describe("kumactl generate mesh", func() {
+
+	type testCase struct {
+		args       []string
+		goldenFile string
+	}
+
+	DescribeTable("should generate a valid Mesh",
+		func(given testCase) {
+			// given
+			rootCmd := test.DefaultTestingRootCmd()
+			stdout := &bytes.Buffer{}
+			rootCmd.SetOut(stdout)
+			rootCmd.SetArgs(append([]string{"generate", "mesh"}, given.args...))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", given.goldenFile)))
+		},
+		Entry("with default policies", testCase{
+			args:       []string{},
+			goldenFile: "generate-mesh.default.golden.yaml",
+		}),
+		Entry("with all backends", testCase{
+			args: []string{
+				"--name", "demo",
+				"--mtls", "builtin",
+				"--metrics", "prometheus",
+				"--tracing", "zipkin",
+				"--logging", "file",
+			},
+			goldenFile: "generate-mesh.all-backends.golden.yaml",
+		}),
+		Entry("in kubernetes format", testCase{
+			args: []string{
+				"--name", "demo",
+				"--mtls", "builtin",
+				"--tracing", "datadog",
+				"--tracing-address", "datadog-agent.monitoring:8126",
+				"--tracing-sampling", "10.5",
+				"--default-policies=false",
+				"--format", "kubernetes",
+			},
+			goldenFile: "generate-mesh.kubernetes.golden.yaml",
+		}),
+	)
+
+	DescribeTable("should reject invalid arguments",
+		func(args []string, expectedErr string) {
+			// given
+			rootCmd := test.DefaultTestingRootCmd()
+			rootCmd.SetOut(&bytes.Buffer{})
+			rootCmd.SetErr(&bytes.Buffer{})
+			rootCmd.SetArgs(append([]string{"generate", "mesh"}, args...))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedErr))
+		},
+		Entry("unknown CA", []string{"--mtls", "provided"}, `--mtls has to be one of "builtin", "off"`),
+		Entry("unknown format", []string{"--format", "json"}, `--format has to be either "universal" or "kubernetes"`),
+		Entry("tcp logging without address", []string{"--logging", "tcp"}, `--logging-address has to be set for "tcp" logging`),
+		Entry("datadog address without port", []string{"--tracing", "datadog", "--tracing-address", "datadog-agent"}, "--tracing-address has to be in format of HOST:PORT for datadog"),
+		Entry("zipkin url without port", []string{"--tracing", "zipkin", "--tracing-address", "http://zipkin.monitoring/api/v2/spans"}, "port has to be explicitly specified"),
+	)
+})
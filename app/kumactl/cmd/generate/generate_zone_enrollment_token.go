@@ -0,0 +1,56 @@
+package generate
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+type generateZoneEnrollmentTokenContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		zone     string
+		validFor time.Duration
+	}
+}
+
+func NewGenerateZoneEnrollmentTokenCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &generateZoneEnrollmentTokenContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "zone-enrollment-token",
+		Short: "Generate Zone Enrollment Token",
+		Long: `Generate Zone Enrollment Token that is used by a new Zone CP to register itself in Global CP.
+The token can be used only once. Global CP provisions the Zone, KDS credentials and the Zone Ingress Token of the enrolled zone.`,
+		Example: `
+Generate token for zone-1 valid for 24 hours
+$ kumactl generate zone-enrollment-token --zone zone-1 --valid-for 24h
+
+Start Zone CP with the token
+$ KUMA_MULTIZONE_ZONE_ENROLLMENT_TOKEN=<token> kuma-cp run
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if ctx.args.zone == "" {
+				return errors.New("--zone has to be specified")
+			}
+			client, err := pctx.CurrentZoneEnrollmentTokenClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create zone enrollment token client")
+			}
+
+			token, err := client.Generate(ctx.args.zone, ctx.args.validFor)
+			if err != nil {
+				return errors.Wrap(err, "failed to generate a zone enrollment token")
+			}
+			_, err = cmd.OutOrStdout().Write([]byte(token))
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.zone, "zone", "", "name of the zone to enroll")
+	cmd.Flags().DurationVar(&ctx.args.validFor, "valid-for", 24*time.Hour, "how long the token can be used to enroll the zone")
+	return cmd
+}
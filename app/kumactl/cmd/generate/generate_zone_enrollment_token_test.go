@@ -0,0 +1,114 @@
+package generate_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	"github.com/kumahq/kuma/pkg/util/test"
+)
+
+type staticZoneEnrollmentTokenGenerator struct {
+	err error
+}
+
+var _ tokens.ZoneEnrollmentTokenClient = &staticZoneEnrollmentTokenGenerator{}
+
+func (s *staticZoneEnrollmentTokenGenerator) Generate(zone string, validFor time.Duration) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	return fmt.Sprintf("token-for-%s-valid-for-%s", zone, validFor), nil
+}
+
+var _ = Describe("kumactl generate zone-enrollment-token", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var generator *staticZoneEnrollmentTokenGenerator
+	var ctx *kumactl_cmd.RootContext
+
+	BeforeEach(func() {
+		generator = &staticZoneEnrollmentTokenGenerator{}
+		ctx = &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				Registry: registry.NewTypeRegistry(),
+				NewBaseAPIServerClient: func(server *config_proto.ControlPlaneCoordinates_ApiServer) (util_http.Client, error) {
+					return nil, nil
+				},
+				NewZoneEnrollmentTokenClient: func(util_http.Client) tokens.ZoneEnrollmentTokenClient {
+					return generator
+				},
+				NewAPIServerClient: test.GetMockNewAPIServerClient(),
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(ctx)
+
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+		rootCmd.SetErr(buf)
+	})
+
+	type testCase struct {
+		args   []string
+		result string
+	}
+	DescribeTable("should generate token",
+		func(given testCase) {
+			// when
+			rootCmd.SetArgs(given.args)
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and
+			Expect(buf.String()).To(Equal(given.result))
+		},
+		Entry("for zone with default validity", testCase{
+			args:   []string{"generate", "zone-enrollment-token", "--zone=my-zone"},
+			result: "token-for-my-zone-valid-for-24h0m0s",
+		}),
+		Entry("for zone with custom validity", testCase{
+			args:   []string{"generate", "zone-enrollment-token", "--zone=my-zone", "--valid-for=1h"},
+			result: "token-for-my-zone-valid-for-1h0m0s",
+		}),
+	)
+
+	It("should require zone", func() {
+		// when
+		rootCmd.SetArgs([]string{"generate", "zone-enrollment-token"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("--zone has to be specified"))
+	})
+
+	It("should write error when generating token fails", func() {
+		// setup
+		generator.err = errors.New("could not connect to API")
+
+		// when
+		rootCmd.SetArgs([]string{"generate", "zone-enrollment-token", "--zone=example"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(HaveOccurred())
+
+		// and
+		Expect(buf.String()).To(Equal("Error: failed to generate a zone enrollment token: could not connect to API\n"))
+	})
+})
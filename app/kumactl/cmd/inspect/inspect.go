@@ -31,5 +31,10 @@ func NewInspectCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	inspectCmd.AddCommand(newInspectZonesCmd(pctx))
 	inspectCmd.AddCommand(newInspectMeshesCmd(pctx))
 	inspectCmd.AddCommand(newInspectServicesCmd(pctx))
+	inspectCmd.AddCommand(newInspectProxyTemplateCmd(pctx))
+	inspectCmd.AddCommand(newInspectEncryptionCmd(pctx))
+	inspectCmd.AddCommand(newInspectChangeCmd(pctx))
+	inspectCmd.AddCommand(newInspectVIPsCmd(pctx))
+	inspectCmd.AddCommand(newInspectLogLevelsCmd(pctx))
 	return inspectCmd
 }
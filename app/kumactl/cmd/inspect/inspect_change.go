@@ -0,0 +1,137 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+)
+
+func newInspectChangeCmd(pctx *cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change [ID]",
+		Short: "Inspect the propagation of a change of a policy to data plane proxies",
+		Long: `Inspect the propagation of a change of a policy to data plane proxies.
+
+Every change of a policy observed by the Control Plane is stamped with a change ID. Without the ID, recent changes are listed.
+With the ID, every data plane proxy affected by the change is listed with the time it took to generate
+and to acknowledge the new configuration. Only data plane proxies connected to the instance of the Control Plane
+that serves the request are reported.`,
+		Example: `
+List recent changes
+$ kumactl inspect change
+
+Inspect the propagation of a change
+$ kumactl inspect change 5f2a6b1c9d3e
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := pctx.CurrentConfigChangeClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a change client")
+			}
+			var result interface{}
+			var printTable func(io.Writer) error
+			if len(args) == 0 {
+				list, err := client.List(context.Background())
+				if err != nil {
+					return err
+				}
+				result = list
+				printTable = func(out io.Writer) error {
+					return printConfigChanges(pctx.Now(), list, out)
+				}
+			} else {
+				change, err := client.Get(context.Background(), args[0])
+				if err != nil {
+					return err
+				}
+				result = change
+				printTable = func(out io.Writer) error {
+					return printConfigChange(change, out)
+				}
+			}
+
+			switch format := output.Format(pctx.InspectContext.Args.OutputFormat); format {
+			case output.TableFormat:
+				return printTable(cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(result, cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printConfigChanges(now time.Time, list *types.ConfigChangeList, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"ID", "TYPE", "MESH", "NAME", "OPERATION", "AGE", "ACKNOWLEDGED"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(list.Items) <= i {
+					return nil
+				}
+				change := list.Items[i]
+				return []string{
+					change.ID,                         // ID
+					change.Type,                       // TYPE
+					change.Mesh,                       // MESH
+					change.Name,                       // NAME
+					change.Operation,                  // OPERATION
+					table.TimeSince(change.Time, now), // AGE
+					fmt.Sprintf("%d/%d", change.Acknowledged, change.Affected), // ACKNOWLEDGED
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+func printConfigChange(change *types.ConfigChange, out io.Writer) error {
+	if _, err := fmt.Fprintf(out, "CHANGE: %s\nRESOURCE: %s %s/%s\nOPERATION: %s\nACKNOWLEDGED: %d/%d\n\n",
+		change.ID, change.Type, change.Mesh, change.Name, change.Operation, change.Acknowledged, change.Affected); err != nil {
+		return err
+	}
+	data := printers.Table{
+		Headers: []string{"PROXY", "GENERATED AFTER", "ACKNOWLEDGED AFTER"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(change.Proxies) <= i {
+					return nil
+				}
+				proxy := change.Proxies[i]
+				acknowledged := "pending"
+				if proxy.Acknowledged != nil {
+					acknowledged = latency(change.Time, *proxy.Acknowledged)
+				}
+				return []string{
+					proxy.Name,                            // PROXY
+					latency(change.Time, proxy.Generated), // GENERATED AFTER
+					acknowledged,                          // ACKNOWLEDGED AFTER
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+func latency(from time.Time, to time.Time) string {
+	return to.Sub(from).Round(time.Millisecond).String()
+}
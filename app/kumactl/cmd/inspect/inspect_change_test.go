@@ -0,0 +1,143 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testConfigChangeClient struct {
+	receivedID string
+	list       *types.ConfigChangeList
+	change     *types.ConfigChange
+}
+
+func (c *testConfigChangeClient) List(_ context.Context) (*types.ConfigChangeList, error) {
+	return c.list, nil
+}
+
+func (c *testConfigChangeClient) Get(_ context.Context, id string) (*types.ConfigChange, error) {
+	c.receivedID = id
+	return c.change, nil
+}
+
+var _ resources.ConfigChangeClient = &testConfigChangeClient{}
+
+var _ = Describe("kumactl inspect change", func() {
+
+	var rootCtx *kumactl_cmd.RootContext
+	var changeClient *testConfigChangeClient
+	var stdout *bytes.Buffer
+
+	BeforeEach(func() {
+		var err error
+		rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+		rootCtx, err = test_kumactl.MakeRootContext(rootTime, memory_resources.NewStore())
+		Expect(err).ToNot(HaveOccurred())
+
+		changeTime := rootTime.Add(-2 * time.Minute)
+		acknowledged := changeTime.Add(1250 * time.Millisecond)
+		changeClient = &testConfigChangeClient{
+			list: &types.ConfigChangeList{
+				Items: []types.ConfigChange{
+					{
+						ID:           "5f2a6b1c9d3e",
+						Type:         "TrafficPermission",
+						Mesh:         "default",
+						Name:         "allow-all",
+						Operation:    "Update",
+						Time:         changeTime,
+						Affected:     2,
+						Acknowledged: 1,
+					},
+					{
+						ID:           "0b8e41d27a6c",
+						Type:         "Mesh",
+						Mesh:         "default",
+						Name:         "default",
+						Operation:    "Create",
+						Time:         rootTime.Add(-time.Hour),
+						Affected:     0,
+						Acknowledged: 0,
+					},
+				},
+			},
+			change: &types.ConfigChange{
+				ID:           "5f2a6b1c9d3e",
+				Type:         "TrafficPermission",
+				Mesh:         "default",
+				Name:         "allow-all",
+				Operation:    "Update",
+				Time:         changeTime,
+				Affected:     2,
+				Acknowledged: 1,
+				Proxies: []types.ConfigChangeProxy{
+					{
+						Name:         "default.backend-1",
+						Generated:    changeTime.Add(1100 * time.Millisecond),
+						Acknowledged: &acknowledged,
+					},
+					{
+						Name:      "default.web-1",
+						Generated: changeTime.Add(1200 * time.Millisecond),
+					},
+				},
+			},
+		}
+		rootCtx.Runtime.NewConfigChangeClient = func(util_http.Client) resources.ConfigChangeClient {
+			return changeClient
+		}
+		stdout = &bytes.Buffer{}
+	})
+
+	DescribeTable("should print the changes",
+		func(args []string, goldenFile string) {
+			// given
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(stdout)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "change"}, args...))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", goldenFile)))
+		},
+		Entry("list as a table", []string{}, "inspect-changes.golden.txt"),
+		Entry("single change as a table", []string{"5f2a6b1c9d3e"}, "inspect-change.golden.txt"),
+		Entry("single change as json", []string{"5f2a6b1c9d3e", "-ojson"}, "inspect-change.golden.json"),
+	)
+
+	It("should request the change by its ID", func() {
+		// given
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(stdout)
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "change", "5f2a6b1c9d3e"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changeClient.receivedID).To(Equal("5f2a6b1c9d3e"))
+	})
+})
@@ -0,0 +1,76 @@
+package inspect
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+)
+
+func newInspectEncryptionCmd(pctx *cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encryption",
+		Short: "Inspect encryption of the traffic between services",
+		Long: `Inspect encryption of the traffic between services.
+
+Lists every pair of services of the mesh that exchange traffic and whether the traffic is encrypted with mTLS,
+originated with TLS to an external service or sent in plaintext, for example when a destination falls back to plaintext
+in PERMISSIVE mode. Use -o csv or -o json to export the report.`,
+		Example: `kumactl inspect encryption --mesh default -o csv > encryption-report.csv`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := pctx.CurrentEncryptionReportClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create an encryption report client")
+			}
+			report, err := client.Report(context.Background(), pctx.CurrentMesh())
+			if err != nil {
+				return err
+			}
+
+			switch format := output.Format(pctx.InspectContext.Args.OutputFormat); format {
+			case output.TableFormat:
+				return printEncryptionReport(report, cmd.OutOrStdout())
+			case output.CSVFormat:
+				return report.WriteCSV(cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(report, cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printEncryptionReport(report *types.EncryptionReport, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"SOURCE", "DESTINATION", "EXTERNAL", "ENCRYPTION", "REASON"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(report.Paths) <= i {
+					return nil
+				}
+				path := report.Paths[i]
+				return []string{
+					path.Source,                       // SOURCE
+					path.Destination,                  // DESTINATION
+					strconv.FormatBool(path.External), // EXTERNAL
+					path.Encryption,                   // ENCRYPTION
+					path.Reason,                       // REASON
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -0,0 +1,97 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testEncryptionReportClient struct {
+	receivedMesh string
+	report       *types.EncryptionReport
+}
+
+func (c *testEncryptionReportClient) Report(_ context.Context, mesh string) (*types.EncryptionReport, error) {
+	c.receivedMesh = mesh
+	return c.report, nil
+}
+
+var _ resources.EncryptionReportClient = &testEncryptionReportClient{}
+
+var _ = Describe("kumactl inspect encryption", func() {
+
+	var rootCtx *kumactl_cmd.RootContext
+	var reportClient *testEncryptionReportClient
+	var stdout *bytes.Buffer
+
+	BeforeEach(func() {
+		var err error
+		rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+		rootCtx, err = test_kumactl.MakeRootContext(rootTime, memory_resources.NewStore())
+		Expect(err).ToNot(HaveOccurred())
+
+		reportClient = &testEncryptionReportClient{
+			report: &types.EncryptionReport{
+				Mesh: "default",
+				Paths: []types.EncryptionReportPath{
+					{
+						Source:      "backend",
+						Destination: "redis",
+						Encryption:  types.EncryptionPlaintext,
+						Reason:      "destination is not ready for mTLS, traffic falls back to plaintext in PERMISSIVE mode",
+					},
+					{
+						Source:      "web",
+						Destination: "backend",
+						Encryption:  types.EncryptionMTLS,
+					},
+					{
+						Source:      "web",
+						Destination: "httpbin",
+						External:    true,
+						Encryption:  types.EncryptionTLSOriginated,
+					},
+				},
+			},
+		}
+		rootCtx.Runtime.NewEncryptionReportClient = func(util_http.Client) resources.EncryptionReportClient {
+			return reportClient
+		}
+		stdout = &bytes.Buffer{}
+	})
+
+	DescribeTable("should print the encryption report",
+		func(outputFormat string, goldenFile string) {
+			// given
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(stdout)
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "encryption", "--mesh", "default", outputFormat})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reportClient.receivedMesh).To(Equal("default"))
+			Expect(stdout.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", goldenFile)))
+		},
+		Entry("as a table", "-otable", "inspect-encryption.golden.txt"),
+		Entry("as CSV", "-ocsv", "inspect-encryption.golden.csv"),
+	)
+})
@@ -0,0 +1,78 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+)
+
+func newInspectLogLevelsCmd(pctx *cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log-levels",
+		Short: "Inspect the log levels of the Control Plane",
+		Long: `Inspect the log levels of the Control Plane.
+
+Lists the default log level and the components of the Control Plane that log with a level of their own.
+A component logs with the level of its longest parent component that has a level, e.g. "xds-server.reconcile"
+logs with the level of "xds-server". Only the levels of the instance of the Control Plane that serves the request
+are reported. Run "kumactl log-level set" to change the levels.`,
+		Example: `
+List the log levels
+$ kumactl inspect log-levels
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := pctx.CurrentLogLevelsClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a log levels client")
+			}
+			levels, err := client.List(context.Background())
+			if err != nil {
+				return err
+			}
+
+			switch format := output.Format(pctx.InspectContext.Args.OutputFormat); format {
+			case output.TableFormat:
+				return printLogLevels(levels, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(levels, cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printLogLevels(levels *types.LogLevels, out io.Writer) error {
+	if _, err := fmt.Fprintf(out, "DEFAULT: %s\n\n", levels.Default); err != nil {
+		return err
+	}
+	data := printers.Table{
+		Headers: []string{"COMPONENT", "LEVEL"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(levels.Components) <= i {
+					return nil
+				}
+				component := levels.Components[i]
+				return []string{
+					component.Component, // COMPONENT
+					component.Level,     // LEVEL
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
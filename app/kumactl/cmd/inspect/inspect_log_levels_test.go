@@ -0,0 +1,85 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testLogLevelsClient struct {
+	levels *types.LogLevels
+}
+
+func (c *testLogLevelsClient) List(context.Context) (*types.LogLevels, error) {
+	return c.levels, nil
+}
+
+func (c *testLogLevelsClient) Set(context.Context, string, string) (*types.LogLevels, error) {
+	return c.levels, nil
+}
+
+func (c *testLogLevelsClient) Reset(context.Context, string) (*types.LogLevels, error) {
+	return c.levels, nil
+}
+
+var _ resources.LogLevelsClient = &testLogLevelsClient{}
+
+var _ = Describe("kumactl inspect log-levels", func() {
+
+	var rootCtx *kumactl_cmd.RootContext
+	var stdout *bytes.Buffer
+
+	BeforeEach(func() {
+		var err error
+		rootCtx, err = test_kumactl.MakeRootContext(time.Now(), memory_resources.NewStore())
+		Expect(err).ToNot(HaveOccurred())
+
+		client := &testLogLevelsClient{
+			levels: &types.LogLevels{
+				Default: "info",
+				Components: []types.ComponentLogLevel{
+					{Component: "kds", Level: "off"},
+					{Component: "xds-server", Level: "debug"},
+				},
+			},
+		}
+		rootCtx.Runtime.NewLogLevelsClient = func(util_http.Client) resources.LogLevelsClient {
+			return client
+		}
+		stdout = &bytes.Buffer{}
+	})
+
+	DescribeTable("should print the log levels",
+		func(args []string, goldenFile string) {
+			// given
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(stdout)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "log-levels"}, args...))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", goldenFile)))
+		},
+		Entry("as a table", []string{}, "inspect-log-levels.golden.txt"),
+		Entry("as json", []string{"-ojson"}, "inspect-log-levels.golden.json"),
+	)
+})
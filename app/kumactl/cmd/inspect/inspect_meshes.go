@@ -4,7 +4,13 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
@@ -12,15 +18,54 @@ import (
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
 func newInspectMeshesCmd(ctx *cmd.RootContext) *cobra.Command {
+	args := struct {
+		compare   string
+		compareTo string
+	}{}
 	cmd := &cobra.Command{
 		Use:   "meshes",
 		Short: "Inspect Meshes",
-		Long:  `Inspect Meshes.`,
+		Long: `Inspect Meshes.
+
+With --compare or --compare-to, the policies of the mesh are compared to the ones of another mesh, of the same
+Control Plane or of another Control Plane from the kumactl configuration, and only the resources that are missing
+in the compared mesh, extra in the compared mesh or different are listed. Dataplanes and secrets are not compared.`,
+		Example: `
+Inspect the meshes
+$ kumactl inspect meshes
+
+Compare the policies of the mesh "staging" with the ones of the mesh "prod"
+$ kumactl inspect meshes --mesh staging --compare prod
+
+Compare the policies of the mesh "default" with the ones of the same mesh on the Control Plane "prod-global"
+$ kumactl inspect meshes --mesh default --compare-to prod-global
+`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if args.compare != "" || args.compareTo != "" {
+				comparison, err := compareMeshes(ctx, args.compare, args.compareTo)
+				if err != nil {
+					return err
+				}
+				switch format := output.Format(ctx.InspectContext.Args.OutputFormat); format {
+				case output.TableFormat:
+					return printMeshComparison(comparison, cmd.OutOrStdout())
+				default:
+					printer, err := printers.NewGenericPrinter(format)
+					if err != nil {
+						return err
+					}
+					return printer.Print(comparison, cmd.OutOrStdout())
+				}
+			}
+
 			client, err := ctx.CurrentResourceStore()
 			if err != nil {
 				return err
@@ -42,6 +87,8 @@ func newInspectMeshesCmd(ctx *cmd.RootContext) *cobra.Command {
 			}
 		},
 	}
+	cmd.Flags().StringVar(&args.compare, "compare", "", "name of the mesh to compare the policies of the mesh with (defaults to the same mesh when --compare-to is set)")
+	cmd.Flags().StringVar(&args.compareTo, "compare-to", "", "name of a Control Plane from the kumactl configuration to compare the policies of the mesh with (defaults to the current Control Plane)")
 	return cmd
 }
 
@@ -140,3 +187,269 @@ func printMeshInsights(meshInsights *mesh.MeshInsightResourceList, out io.Writer
 	}
 	return printers.NewTablePrinter().Print(data, out)
 }
+
+const meshComparisonPageSize = 100
+
+// meshComparison lists the resources of a mesh that are not the same in the compared mesh.
+type meshComparison struct {
+	Meshes      []meshSummary    `json:"meshes"`
+	Same        int              `json:"same"`
+	Differences []meshDifference `json:"differences"`
+}
+
+type meshSummary struct {
+	Mesh         string `json:"mesh"`
+	ControlPlane string `json:"controlPlane"`
+	Resources    int    `json:"resources"`
+}
+
+// meshDifference is a resource that is missing in the compared mesh, extra in the compared mesh
+// or different in both meshes. Fields are the top level fields of the spec that are different.
+// The name is empty for the Mesh resource itself.
+type meshDifference struct {
+	Type       core_model.ResourceType `json:"type"`
+	Name       string                  `json:"name,omitempty"`
+	Difference string                  `json:"difference"`
+	Fields     []string                `json:"fields,omitempty"`
+}
+
+const (
+	meshDifferenceMissing   = "missing"
+	meshDifferenceExtra     = "extra"
+	meshDifferenceDifferent = "different"
+)
+
+// comparedTypes returns the types of the policies of a mesh. Dataplanes are specific to each
+// environment and secrets are accessible only to admins, so they are not compared.
+func comparedTypes(reg registry.TypeRegistry) []core_model.ResourceTypeDescriptor {
+	descriptors := reg.ObjectDescriptors(
+		core_model.HasScope(core_model.ScopeMesh),
+		core_model.TypeFilterFn(func(descriptor core_model.ResourceTypeDescriptor) bool {
+			return descriptor.WsPath != "" &&
+				!descriptor.ReadOnly &&
+				!descriptor.AdminOnly &&
+				descriptor.Name != mesh.DataplaneType
+		}),
+	)
+	sort.Slice(descriptors, func(i, j int) bool {
+		return descriptors[i].Name < descriptors[j].Name
+	})
+	return descriptors
+}
+
+func compareMeshes(pctx *cmd.RootContext, compare string, compareTo string) (*meshComparison, error) {
+	controlPlane, err := pctx.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	store, err := pctx.CurrentResourceStore()
+	if err != nil {
+		return nil, err
+	}
+	comparedStore := store
+	comparedControlPlane := controlPlane.Name
+	if compareTo != "" {
+		comparedStore, err = pctx.ControlPlaneResourceStore(compareTo)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create a client of Control Plane %q", compareTo)
+		}
+		comparedControlPlane = compareTo
+	}
+	meshName := pctx.CurrentMesh()
+	comparedMeshName := compare
+	if comparedMeshName == "" {
+		comparedMeshName = meshName
+	}
+	if comparedMeshName == meshName && comparedControlPlane == controlPlane.Name {
+		return nil, errors.Errorf("mesh %q cannot be compared with itself, set --compare to another mesh or --compare-to to another Control Plane", meshName)
+	}
+
+	ctx := context.Background()
+	comparison := &meshComparison{
+		Meshes: []meshSummary{
+			{Mesh: meshName, ControlPlane: controlPlane.Name},
+			{Mesh: comparedMeshName, ControlPlane: comparedControlPlane},
+		},
+		Differences: []meshDifference{},
+	}
+
+	meshRes := mesh.NewMeshResource()
+	if err := store.Get(ctx, meshRes, core_store.GetByKey(meshName, core_model.NoMesh)); err != nil {
+		return nil, errors.Wrapf(err, "failed to get mesh %q of Control Plane %q", meshName, controlPlane.Name)
+	}
+	comparedMeshRes := mesh.NewMeshResource()
+	if err := comparedStore.Get(ctx, comparedMeshRes, core_store.GetByKey(comparedMeshName, core_model.NoMesh)); err != nil {
+		return nil, errors.Wrapf(err, "failed to get mesh %q of Control Plane %q", comparedMeshName, comparedControlPlane)
+	}
+	if err := comparison.compare(mesh.MeshType, "", meshRes, comparedMeshRes); err != nil {
+		return nil, err
+	}
+	comparison.Meshes[0].Resources++
+	comparison.Meshes[1].Resources++
+
+	for _, descriptor := range comparedTypes(pctx.Runtime.Registry) {
+		items, err := listMeshResources(ctx, store, descriptor, meshName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list %s of mesh %q of Control Plane %q", descriptor.Name, meshName, controlPlane.Name)
+		}
+		comparedItems, err := listMeshResources(ctx, comparedStore, descriptor, comparedMeshName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list %s of mesh %q of Control Plane %q", descriptor.Name, comparedMeshName, comparedControlPlane)
+		}
+		comparison.Meshes[0].Resources += len(items)
+		comparison.Meshes[1].Resources += len(comparedItems)
+
+		compared := map[string]core_model.Resource{}
+		for _, res := range comparedItems {
+			compared[res.GetMeta().GetName()] = res
+		}
+		for _, res := range items {
+			name := res.GetMeta().GetName()
+			comparedRes, ok := compared[name]
+			delete(compared, name)
+			if !ok {
+				comparison.Differences = append(comparison.Differences, meshDifference{
+					Type:       descriptor.Name,
+					Name:       name,
+					Difference: meshDifferenceMissing,
+				})
+				continue
+			}
+			if err := comparison.compare(descriptor.Name, name, res, comparedRes); err != nil {
+				return nil, err
+			}
+		}
+		for _, res := range comparedItems {
+			name := res.GetMeta().GetName()
+			if _, extra := compared[name]; !extra {
+				continue
+			}
+			comparison.Differences = append(comparison.Differences, meshDifference{
+				Type:       descriptor.Name,
+				Name:       name,
+				Difference: meshDifferenceExtra,
+			})
+		}
+	}
+
+	sort.SliceStable(comparison.Differences, func(i, j int) bool {
+		if comparison.Differences[i].Type == comparison.Differences[j].Type {
+			return comparison.Differences[i].Name < comparison.Differences[j].Name
+		}
+		return comparison.Differences[i].Type < comparison.Differences[j].Type
+	})
+	return comparison, nil
+}
+
+// compare records the resource as a difference when its spec is not the same as the one of the compared resource.
+func (c *meshComparison) compare(resType core_model.ResourceType, name string, res core_model.Resource, compared core_model.Resource) error {
+	if proto.Equal(res.GetSpec(), compared.GetSpec()) {
+		c.Same++
+		return nil
+	}
+	fields, err := differentFields(res.GetSpec(), compared.GetSpec())
+	if err != nil {
+		return errors.Wrapf(err, "failed to compare %s %q", resType, name)
+	}
+	c.Differences = append(c.Differences, meshDifference{
+		Type:       resType,
+		Name:       name,
+		Difference: meshDifferenceDifferent,
+		Fields:     fields,
+	})
+	return nil
+}
+
+// differentFields returns the sorted top level fields that are not the same in both specs.
+func differentFields(spec core_model.ResourceSpec, compared core_model.ResourceSpec) ([]string, error) {
+	values, err := util_proto.ToMap(spec)
+	if err != nil {
+		return nil, err
+	}
+	comparedValues, err := util_proto.ToMap(compared)
+	if err != nil {
+		return nil, err
+	}
+	var fields []string
+	for field, value := range values {
+		if !reflect.DeepEqual(value, comparedValues[field]) {
+			fields = append(fields, field)
+		}
+	}
+	for field := range comparedValues {
+		if _, ok := values[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func listMeshResources(ctx context.Context, s core_store.ResourceStore, descriptor core_model.ResourceTypeDescriptor, meshName string) ([]core_model.Resource, error) {
+	var items []core_model.Resource
+	offset := ""
+	for {
+		list := descriptor.NewList()
+		if err := s.List(ctx, list, core_store.ListByMesh(meshName), core_store.ListByPage(meshComparisonPageSize, offset)); err != nil {
+			return nil, err
+		}
+		items = append(items, list.GetItems()...)
+		offset = list.GetPagination().NextOffset
+		if offset == "" {
+			return items, nil
+		}
+	}
+}
+
+func printMeshComparison(comparison *meshComparison, out io.Writer) error {
+	meshes := printers.Table{
+		Headers: []string{"MESH", "CONTROL PLANE", "RESOURCES"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(comparison.Meshes) <= i {
+					return nil
+				}
+				summary := comparison.Meshes[i]
+				return []string{
+					summary.Mesh,                    // MESH
+					summary.ControlPlane,            // CONTROL PLANE
+					strconv.Itoa(summary.Resources), // RESOURCES
+				}
+			}
+		}(),
+	}
+	if err := printers.NewTablePrinter().Print(meshes, out); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(out); err != nil {
+		return err
+	}
+
+	if len(comparison.Differences) == 0 {
+		_, err := fmt.Fprintf(out, "All %d resources are the same in both meshes\n", comparison.Same)
+		return err
+	}
+	differences := printers.Table{
+		Headers: []string{"TYPE", "NAME", "DIFFERENCE", "FIELDS"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(comparison.Differences) <= i {
+					return nil
+				}
+				difference := comparison.Differences[i]
+				return []string{
+					string(difference.Type),                           // TYPE
+					valueOrDash(difference.Name),                      // NAME
+					difference.Difference,                             // DIFFERENCE
+					valueOrDash(strings.Join(difference.Fields, ",")), // FIELDS
+				}
+			}
+		}(),
+		Footer: fmt.Sprintf("%d resources are the same, %d are different", comparison.Same, len(comparison.Differences)),
+	}
+	return printers.NewTablePrinter().Print(differences, out)
+}
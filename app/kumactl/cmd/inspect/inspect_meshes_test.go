@@ -15,6 +15,7 @@ import (
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/cmd"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
@@ -22,6 +23,7 @@ import (
 	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
 	"github.com/kumahq/kuma/pkg/test/matchers"
 	"github.com/kumahq/kuma/pkg/test/resources/model"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
 )
 
 var _ = Describe("kumactl inspect meshes", func() {
@@ -140,4 +142,156 @@ var _ = Describe("kumactl inspect meshes", func() {
 			}),
 		)
 	})
+
+	Describe("comparing meshes", func() {
+
+		var stagingStore core_store.ResourceStore
+		var prodStore core_store.ResourceStore
+		var rootCmd *cobra.Command
+		var buf *bytes.Buffer
+
+		create := func(s core_store.ResourceStore, res core_model.Resource, name, mesh string) {
+			err := s.Create(context.Background(), res, core_store.CreateByKey(name, mesh))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		mtls := func(backend string) *mesh_proto.Mesh {
+			return &mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{
+					EnabledBackend: backend,
+					Backends: []*mesh_proto.CertificateAuthorityBackend{
+						{Name: backend, Type: "builtin"},
+					},
+				},
+			}
+		}
+
+		permission := func(source, destination string) *mesh_proto.TrafficPermission {
+			return &mesh_proto.TrafficPermission{
+				Sources: []*mesh_proto.Selector{
+					{Match: map[string]string{mesh_proto.ServiceTag: source}},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{Match: map[string]string{mesh_proto.ServiceTag: destination}},
+				},
+			}
+		}
+
+		route := func(destination string) *mesh_proto.TrafficRoute {
+			return &mesh_proto.TrafficRoute{
+				Sources: []*mesh_proto.Selector{
+					{Match: map[string]string{mesh_proto.ServiceTag: "*"}},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{Match: map[string]string{mesh_proto.ServiceTag: "*"}},
+				},
+				Conf: &mesh_proto.TrafficRoute_Conf{
+					Destination: map[string]string{mesh_proto.ServiceTag: destination},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			stagingStore = memory_resources.NewStore()
+			prodStore = memory_resources.NewStore()
+
+			create(stagingStore, &mesh.MeshResource{Spec: mtls("ca-1")}, "staging", core_model.NoMesh)
+			create(stagingStore, &mesh.TrafficPermissionResource{Spec: permission("*", "*")}, "allow-all", "staging")
+			create(stagingStore, &mesh.TrafficPermissionResource{Spec: permission("web", "backend")}, "allow-web", "staging")
+			create(stagingStore, &mesh.TrafficRouteResource{Spec: route("*")}, "route-all", "staging")
+			create(stagingStore, &mesh.DataplaneResource{Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{Address: "192.168.0.1"},
+			}}, "web-01", "staging")
+
+			create(stagingStore, &mesh.MeshResource{Spec: mtls("ca-2")}, "prod", core_model.NoMesh)
+			create(stagingStore, &mesh.TrafficPermissionResource{Spec: permission("*", "*")}, "allow-all", "prod")
+			create(stagingStore, &mesh.TrafficRouteResource{Spec: route("backend")}, "route-all", "prod")
+			create(stagingStore, &mesh.TrafficRouteResource{Spec: route("canary")}, "canary", "prod")
+
+			create(stagingStore, &mesh.MeshResource{Spec: mtls("ca-1")}, "default", core_model.NoMesh)
+			create(stagingStore, &mesh.TrafficPermissionResource{Spec: permission("*", "*")}, "allow-all", "default")
+			create(prodStore, &mesh.MeshResource{Spec: mtls("ca-1")}, "default", core_model.NoMesh)
+			create(prodStore, &mesh.TrafficPermissionResource{Spec: permission("*", "*")}, "allow-all", "default")
+			create(prodStore, &mesh.DataplaneResource{Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{Address: "10.0.0.1"},
+			}}, "web-01", "default")
+
+			rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil,
+				mesh.MeshResourceTypeDescriptor,
+				mesh.TrafficPermissionResourceTypeDescriptor,
+				mesh.TrafficRouteResourceTypeDescriptor,
+				mesh.DataplaneResourceTypeDescriptor,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			rootCtx.Runtime.NewBaseAPIServerClient = func(server *config_proto.ControlPlaneCoordinates_ApiServer) (util_http.Client, error) {
+				return &controlPlaneClient{url: server.Url}, nil
+			}
+			rootCtx.Runtime.NewResourceStore = func(client util_http.Client) core_store.ResourceStore {
+				if client.(*controlPlaneClient).url == "http://prod-global.internal:5681" {
+					return prodStore
+				}
+				return stagingStore
+			}
+
+			rootCmd = cmd.NewRootCmd(rootCtx)
+			buf = &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+		})
+
+		It("should print the resources that differ from another mesh", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("testdata", "inspect-meshes-compare.config.yaml"),
+				"inspect", "meshes", "--mesh", "staging", "--compare", "prod"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "inspect-meshes-compare.golden.txt")))
+		})
+
+		It("should print the resources that differ from another mesh as JSON", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("testdata", "inspect-meshes-compare.config.yaml"),
+				"inspect", "meshes", "--mesh", "staging", "--compare", "prod", "-ojson"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(matchers.MatchGoldenJSON(filepath.Join("testdata", "inspect-meshes-compare.golden.json")))
+		})
+
+		It("should compare the mesh with the same mesh of another Control Plane", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("testdata", "inspect-meshes-compare.config.yaml"),
+				"inspect", "meshes", "--mesh", "default", "--compare-to", "prod-global"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "inspect-meshes-compare-to.golden.txt")))
+		})
+
+		It("should not compare the mesh with itself", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("testdata", "inspect-meshes-compare.config.yaml"),
+				"inspect", "meshes", "--mesh", "default", "--compare", "default"})
+			rootCmd.SetErr(&bytes.Buffer{})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError(`mesh "default" cannot be compared with itself, set --compare to another mesh or --compare-to to another Control Plane`))
+		})
+	})
 })
@@ -0,0 +1,169 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+type inspectProxyTemplateContext struct {
+	args struct {
+		render    bool
+		dataplane string
+	}
+}
+
+func newInspectProxyTemplateCmd(pctx *cmd.RootContext) *cobra.Command {
+	ctx := inspectProxyTemplateContext{}
+	cmd := &cobra.Command{
+		Use:   "proxytemplate NAME",
+		Short: "Inspect ProxyTemplate",
+		Long: `Inspect ProxyTemplate.
+
+With --render, the Envoy resources that the ProxyTemplate would produce for the given Dataplane are rendered
+by the control plane and validated against the Envoy API, without applying the ProxyTemplate.
+The ProxyPatches that select the Dataplane are applied on top of the rendered resources and listed
+together with the patches that were skipped because of a conflict with a more specific ProxyPatch.`,
+		Example: `kumactl inspect proxytemplate custom-template --render --dataplane backend-01`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if ctx.args.render && ctx.args.dataplane == "" {
+				return errors.New("--dataplane has to be set when --render is used")
+			}
+			if !ctx.args.render && ctx.args.dataplane != "" {
+				return errors.New("--dataplane can only be used with --render")
+			}
+
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			template := core_mesh.NewProxyTemplateResource()
+			if err := rs.Get(context.Background(), template, core_store.GetByKey(args[0], pctx.CurrentMesh())); err != nil {
+				if core_store.IsResourceNotFound(err) {
+					return errors.Errorf("ProxyTemplate %q not found in %s mesh", args[0], pctx.CurrentMesh())
+				}
+				return errors.Wrapf(err, "failed to get ProxyTemplate %s", args[0])
+			}
+
+			format := output.Format(pctx.InspectContext.Args.OutputFormat)
+			if !ctx.args.render {
+				if format == output.TableFormat { // ProxyTemplate has no tabular representation
+					format = output.YAMLFormat
+				}
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(rest_types.From.Resource(template), cmd.OutOrStdout())
+			}
+
+			client, err := pctx.CurrentProxyTemplatePreviewClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a proxy template preview client")
+			}
+			preview, err := client.Preview(context.Background(), ctx.args.dataplane, template)
+			if err != nil {
+				return err
+			}
+
+			switch format {
+			case output.TableFormat:
+				err = printProxyTemplatePreview(preview, cmd.OutOrStdout())
+			default:
+				printer, perr := printers.NewGenericPrinter(format)
+				if perr != nil {
+					return perr
+				}
+				err = printer.Print(preview, cmd.OutOrStdout())
+			}
+			if err != nil {
+				return err
+			}
+
+			invalid := 0
+			for _, resource := range preview.Resources {
+				if len(resource.Errors) > 0 {
+					invalid++
+				}
+			}
+			if invalid > 0 {
+				return errors.Errorf("%d rendered resources are rejected by the Envoy API", invalid)
+			}
+			return nil
+		},
+	}
+	cmd.PersistentFlags().BoolVar(&ctx.args.render, "render", false, "render Envoy resources that the ProxyTemplate would produce for the Dataplane and validate them")
+	cmd.PersistentFlags().StringVar(&ctx.args.dataplane, "dataplane", "", "name of the Dataplane to render the Envoy resources for")
+	return cmd
+}
+
+func printProxyTemplatePreview(preview *types.ProxyTemplatePreviewResponse, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"TYPE", "NAME", "ORIGIN", "ERRORS"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(preview.Resources) <= i {
+					return nil
+				}
+				resource := preview.Resources[i]
+				return []string{
+					resource.Type[strings.LastIndex(resource.Type, ".")+1:], // TYPE
+					resource.Name,                      // NAME
+					resource.Origin,                    // ORIGIN
+					strings.Join(resource.Errors, ";"), // ERRORS
+				}
+			}
+		}(),
+	}
+	if err := printers.NewTablePrinter().Print(data, out); err != nil {
+		return err
+	}
+	if len(preview.AppliedPatches) == 0 && len(preview.ConflictingPatches) == 0 {
+		return nil
+	}
+	patches := append(append([]types.ProxyPatchPreview{}, preview.AppliedPatches...), preview.ConflictingPatches...)
+	data = printers.Table{
+		Headers: []string{"PROXY PATCH", "INDEX", "TYPE", "NAME", "STATUS"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(patches) <= i {
+					return nil
+				}
+				patch := patches[i]
+				status := "applied"
+				if patch.ConflictsWith != "" {
+					status = fmt.Sprintf("conflicts with %s on %s", patch.ConflictsWith, patch.Path)
+				}
+				return []string{
+					patch.Policy,              // PROXY PATCH
+					strconv.Itoa(patch.Index), // INDEX
+					patch.Type,                // TYPE
+					patch.Resource,            // NAME
+					status,                    // STATUS
+				}
+			}
+		}(),
+	}
+	if _, err := fmt.Fprintln(out); err != nil {
+		return err
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -0,0 +1,148 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testProxyTemplatePreviewClient struct {
+	receivedDataplane string
+	receivedTemplate  *core_mesh.ProxyTemplateResource
+	preview           *types.ProxyTemplatePreviewResponse
+}
+
+func (c *testProxyTemplatePreviewClient) Preview(_ context.Context, dataplaneName string, template *core_mesh.ProxyTemplateResource) (*types.ProxyTemplatePreviewResponse, error) {
+	c.receivedDataplane = dataplaneName
+	c.receivedTemplate = template
+	return c.preview, nil
+}
+
+var _ resources.ProxyTemplatePreviewClient = &testProxyTemplatePreviewClient{}
+
+var _ = Describe("kumactl inspect proxytemplate", func() {
+
+	var rootCtx *kumactl_cmd.RootContext
+	var previewClient *testProxyTemplatePreviewClient
+	var stdout *bytes.Buffer
+
+	BeforeEach(func() {
+		store := memory_resources.NewStore()
+		err := store.Create(context.Background(), &core_mesh.ProxyTemplateResource{
+			Spec: &mesh_proto.ProxyTemplate{
+				Selectors: []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "backend"}}},
+				Conf: &mesh_proto.ProxyTemplate_Conf{
+					Imports: []string{"default-proxy"},
+					Modifications: []*mesh_proto.ProxyTemplate_Modifications{{
+						Type: &mesh_proto.ProxyTemplate_Modifications_Cluster_{
+							Cluster: &mesh_proto.ProxyTemplate_Modifications_Cluster{
+								Operation: "add",
+								Value:     "name: broken\nconnectTimeout: -1s",
+							},
+						},
+					}},
+				},
+			},
+		}, core_store.CreateByKey("custom-template", "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+		rootCtx, err = test_kumactl.MakeRootContext(rootTime, store, core_mesh.ProxyTemplateResourceTypeDescriptor)
+		Expect(err).ToNot(HaveOccurred())
+
+		previewClient = &testProxyTemplatePreviewClient{
+			preview: &types.ProxyTemplatePreviewResponse{
+				Resources: []types.ProxyTemplatePreviewResource{
+					{
+						Name:     "localhost:8080",
+						Type:     "type.googleapis.com/envoy.config.cluster.v3.Cluster",
+						Origin:   "inbound",
+						Resource: json.RawMessage(`{"name":"localhost:8080","connectTimeout":"10s"}`),
+					},
+					{
+						Name:     "broken",
+						Type:     "type.googleapis.com/envoy.config.cluster.v3.Cluster",
+						Origin:   "proxy-template-modifications",
+						Resource: json.RawMessage(`{"name":"broken","connectTimeout":"-1s"}`),
+						Errors:   []string{`invalid Cluster.ConnectTimeout: value must be greater than 0s`},
+					},
+					{
+						Name:   "identity_cert",
+						Type:   "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret",
+						Origin: "secrets",
+					},
+				},
+			},
+		}
+		rootCtx.Runtime.NewProxyTemplatePreviewClient = func(util_http.Client) resources.ProxyTemplatePreviewClient {
+			return previewClient
+		}
+		stdout = &bytes.Buffer{}
+	})
+
+	execute := func(args ...string) error {
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(stdout)
+		rootCmd.SetErr(&bytes.Buffer{})
+		rootCmd.SetArgs(append([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "proxytemplate"}, args...))
+		return rootCmd.Execute()
+	}
+
+	DescribeTable("should render the proxy template for a dataplane",
+		func(outputFormat string, goldenFile string) {
+			// when
+			err := execute("custom-template", "--render", "--dataplane", "backend-01", outputFormat)
+
+			// then
+			Expect(err).To(MatchError("1 rendered resources are rejected by the Envoy API"))
+			Expect(previewClient.receivedDataplane).To(Equal("backend-01"))
+			Expect(previewClient.receivedTemplate.GetMeta().GetName()).To(Equal("custom-template"))
+			Expect(stdout.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", goldenFile)))
+		},
+		Entry("as a table", "-otable", "inspect-proxytemplate-render.golden.txt"),
+		Entry("as YAML", "-oyaml", "inspect-proxytemplate-render.golden.yaml"),
+	)
+
+	It("should print the proxy template without --render", func() {
+		// when
+		err := execute("custom-template")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(previewClient.receivedTemplate).To(BeNil())
+		Expect(stdout.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "inspect-proxytemplate.golden.yaml")))
+	})
+
+	DescribeTable("should reject invalid arguments",
+		func(args []string, expectedErr string) {
+			// when
+			err := execute(args...)
+
+			// then
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("render without dataplane", []string{"custom-template", "--render"}, "--dataplane has to be set when --render is used"),
+		Entry("dataplane without render", []string{"custom-template", "--dataplane", "backend-01"}, "--dataplane can only be used with --render"),
+		Entry("unknown proxy template", []string{"unknown", "--render", "--dataplane", "backend-01"}, `ProxyTemplate "unknown" not found in default mesh`),
+	)
+})
@@ -0,0 +1,268 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+)
+
+// vipComparison lists the hostnames of a mesh whose VIPs are not the same on two Control Planes.
+type vipComparison struct {
+	Mesh        string            `json:"mesh"`
+	Tables      []vipTableSummary `json:"tables"`
+	Differences []vipDifference   `json:"differences"`
+}
+
+type vipTableSummary struct {
+	ControlPlane string `json:"controlPlane"`
+	*types.VIPTable
+}
+
+// vipDifference is a hostname with a different VIP on each Control Plane.
+// The VIP is empty when the hostname has no VIP on the Control Plane.
+type vipDifference struct {
+	Type        string `json:"type"`
+	Hostname    string `json:"hostname"`
+	VIP         string `json:"vip"`
+	ComparedVIP string `json:"comparedVip"`
+}
+
+func newInspectVIPsCmd(pctx *cmd.RootContext) *cobra.Command {
+	args := struct {
+		compareTo string
+	}{}
+	cmd := &cobra.Command{
+		Use:   "vips",
+		Short: "Inspect VIPs allocated to services and hostnames",
+		Long: `Inspect VIPs allocated to services and hostnames.
+
+Lists the virtual IPs allocated by the Control Plane to the services and hostnames of the mesh, along with the zone
+of the Control Plane and the last time the VIPs were allocated. With --compare-to, the VIPs are compared to the ones of
+another Control Plane from the kumactl configuration, and only the hostnames with different VIPs are listed.`,
+		Example: `
+List the VIPs of the mesh
+$ kumactl inspect vips --mesh default
+
+Compare the VIPs of the mesh with the ones of the Control Plane "zone-2"
+$ kumactl inspect vips --mesh default --compare-to zone-2
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := pctx.CurrentVIPsClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a VIPs client")
+			}
+			vips, err := client.Get(context.Background(), pctx.CurrentMesh())
+			if err != nil {
+				return err
+			}
+
+			var result interface{} = vips
+			if args.compareTo != "" {
+				comparison, err := compareVIPs(pctx, vips, args.compareTo)
+				if err != nil {
+					return err
+				}
+				result = comparison
+			}
+
+			switch format := output.Format(pctx.InspectContext.Args.OutputFormat); format {
+			case output.TableFormat:
+				if comparison, ok := result.(*vipComparison); ok {
+					return printVIPComparison(pctx, comparison, cmd.OutOrStdout())
+				}
+				return printVIPTable(pctx, vips, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(result, cmd.OutOrStdout())
+			}
+		},
+	}
+	cmd.Flags().StringVar(&args.compareTo, "compare-to", "", "name of a Control Plane from the kumactl configuration to compare the VIPs with")
+	return cmd
+}
+
+func compareVIPs(pctx *cmd.RootContext, vips *types.VIPTable, compareTo string) (*vipComparison, error) {
+	controlPlane, err := pctx.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	client, err := pctx.ControlPlaneVIPsClient(compareTo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a VIPs client")
+	}
+	compared, err := client.Get(context.Background(), vips.Mesh)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get VIPs of Control Plane %q", compareTo)
+	}
+
+	type key struct {
+		entryType string
+		hostname  string
+	}
+	differences := map[key]*vipDifference{}
+	for _, entry := range vips.Entries {
+		differences[key{entry.Type, entry.Hostname}] = &vipDifference{
+			Type:     entry.Type,
+			Hostname: entry.Hostname,
+			VIP:      entry.VIP,
+		}
+	}
+	for _, entry := range compared.Entries {
+		k := key{entry.Type, entry.Hostname}
+		if differences[k] == nil {
+			differences[k] = &vipDifference{
+				Type:     entry.Type,
+				Hostname: entry.Hostname,
+			}
+		}
+		differences[k].ComparedVIP = entry.VIP
+	}
+
+	comparison := &vipComparison{
+		Mesh: vips.Mesh,
+		Tables: []vipTableSummary{
+			{ControlPlane: controlPlane.Name, VIPTable: vips},
+			{ControlPlane: compareTo, VIPTable: compared},
+		},
+		Differences: []vipDifference{},
+	}
+	for _, difference := range differences {
+		if difference.VIP != difference.ComparedVIP {
+			comparison.Differences = append(comparison.Differences, *difference)
+		}
+	}
+	sort.Slice(comparison.Differences, func(i, j int) bool {
+		if comparison.Differences[i].Type == comparison.Differences[j].Type {
+			return comparison.Differences[i].Hostname < comparison.Differences[j].Hostname
+		}
+		return comparison.Differences[i].Type < comparison.Differences[j].Type
+	})
+	return comparison, nil
+}
+
+func printVIPTable(pctx *cmd.RootContext, vips *types.VIPTable, out io.Writer) error {
+	if _, err := fmt.Fprintf(out, "MESH: %s\nZONE: %s\nGENERATED: %s\n\n",
+		vips.Mesh, valueOrDash(vips.Zone), table.Ago(vips.GenerationTime, pctx.Now())); err != nil {
+		return err
+	}
+	data := printers.Table{
+		Headers: []string{"TYPE", "HOSTNAME", "VIP", "OUTBOUNDS"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(vips.Entries) <= i {
+					return nil
+				}
+				entry := vips.Entries[i]
+				return []string{
+					entry.Type,                    // TYPE
+					entry.Hostname,                // HOSTNAME
+					entry.VIP,                     // VIP
+					vipOutbounds(entry.Outbounds), // OUTBOUNDS
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+func printVIPComparison(pctx *cmd.RootContext, comparison *vipComparison, out io.Writer) error {
+	if _, err := fmt.Fprintf(out, "MESH: %s\n\n", comparison.Mesh); err != nil {
+		return err
+	}
+	tables := printers.Table{
+		Headers: []string{"CONTROL PLANE", "ZONE", "GENERATED", "ENTRIES"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(comparison.Tables) <= i {
+					return nil
+				}
+				summary := comparison.Tables[i]
+				return []string{
+					summary.ControlPlane,                          // CONTROL PLANE
+					valueOrDash(summary.Zone),                     // ZONE
+					table.Ago(summary.GenerationTime, pctx.Now()), // GENERATED
+					strconv.Itoa(len(summary.Entries)),            // ENTRIES
+				}
+			}
+		}(),
+	}
+	if err := printers.NewTablePrinter().Print(tables, out); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(out); err != nil {
+		return err
+	}
+
+	if len(comparison.Differences) == 0 {
+		_, err := fmt.Fprintln(out, "VIPs are the same on both Control Planes")
+		return err
+	}
+	differences := printers.Table{
+		Headers: []string{
+			"TYPE",
+			"HOSTNAME",
+			fmt.Sprintf("VIP (%s)", comparison.Tables[0].ControlPlane),
+			fmt.Sprintf("VIP (%s)", comparison.Tables[1].ControlPlane),
+		},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(comparison.Differences) <= i {
+					return nil
+				}
+				difference := comparison.Differences[i]
+				return []string{
+					difference.Type,                     // TYPE
+					difference.Hostname,                 // HOSTNAME
+					valueOrDash(difference.VIP),         // VIP
+					valueOrDash(difference.ComparedVIP), // COMPARED VIP
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(differences, out)
+}
+
+func vipOutbounds(outbounds []types.VIPOutbound) string {
+	var result []string
+	for _, outbound := range outbounds {
+		var tags []string
+		for name, value := range outbound.Tags {
+			tags = append(tags, name+"="+value)
+		}
+		sort.Strings(tags)
+		if outbound.Port == 0 {
+			result = append(result, fmt.Sprintf("{%s}", strings.Join(tags, ",")))
+		} else {
+			result = append(result, fmt.Sprintf("%d{%s}", outbound.Port, strings.Join(tags, ",")))
+		}
+	}
+	return strings.Join(result, " ")
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,163 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+// controlPlaneClient identifies a Control Plane by the URL of its API Server.
+type controlPlaneClient struct {
+	url string
+}
+
+func (c *controlPlaneClient) Do(*http.Request) (*http.Response, error) {
+	return nil, errors.New("not expected to be called")
+}
+
+type testVIPsClient struct {
+	receivedMesh string
+	table        *types.VIPTable
+}
+
+func (c *testVIPsClient) Get(_ context.Context, mesh string) (*types.VIPTable, error) {
+	c.receivedMesh = mesh
+	return c.table, nil
+}
+
+var _ resources.VIPsClient = &testVIPsClient{}
+
+var _ = Describe("kumactl inspect vips", func() {
+
+	var rootCtx *kumactl_cmd.RootContext
+	var clients map[string]*testVIPsClient
+	var stdout *bytes.Buffer
+
+	BeforeEach(func() {
+		var err error
+		rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+		rootCtx, err = test_kumactl.MakeRootContext(rootTime, memory_resources.NewStore())
+		Expect(err).ToNot(HaveOccurred())
+
+		generationTime := rootTime.Add(-5 * time.Minute)
+		clients = map[string]*testVIPsClient{
+			"http://zone-1.internal:5681": {
+				table: &types.VIPTable{
+					Mesh:           "default",
+					Zone:           "zone-1",
+					GenerationTime: &generationTime,
+					Entries: []types.VIPTableEntry{
+						{
+							Type:     "service",
+							Hostname: "backend",
+							VIP:      "240.0.0.1",
+							Outbounds: []types.VIPOutbound{
+								{Tags: map[string]string{mesh_proto.ServiceTag: "backend"}, Origin: "service"},
+							},
+						},
+						{
+							Type:     "service",
+							Hostname: "redis",
+							VIP:      "240.0.0.2",
+							Outbounds: []types.VIPOutbound{
+								{Tags: map[string]string{mesh_proto.ServiceTag: "redis"}, Origin: "service"},
+							},
+						},
+						{
+							Type:     "host",
+							Hostname: "httpbin.org",
+							VIP:      "240.0.0.0",
+							Outbounds: []types.VIPOutbound{
+								{Port: 443, Tags: map[string]string{mesh_proto.ServiceTag: "httpbin", "version": "v1"}, Origin: "host"},
+							},
+						},
+					},
+				},
+			},
+			"http://zone-2.internal:5681": {
+				table: &types.VIPTable{
+					Mesh: "default",
+					Zone: "zone-2",
+					Entries: []types.VIPTableEntry{
+						{Type: "service", Hostname: "backend", VIP: "240.0.0.1"},
+						{Type: "service", Hostname: "redis", VIP: "240.0.0.3"},
+						{Type: "service", Hostname: "web", VIP: "240.0.0.4"},
+					},
+				},
+			},
+		}
+		rootCtx.Runtime.NewBaseAPIServerClient = func(server *config_proto.ControlPlaneCoordinates_ApiServer) (util_http.Client, error) {
+			return &controlPlaneClient{url: server.Url}, nil
+		}
+		rootCtx.Runtime.NewVIPsClient = func(client util_http.Client) resources.VIPsClient {
+			return clients[client.(*controlPlaneClient).url]
+		}
+		stdout = &bytes.Buffer{}
+	})
+
+	It("should print the VIPs of the mesh", func() {
+		// given
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(stdout)
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("testdata", "inspect-vips.config.yaml"),
+			"inspect", "vips", "--mesh", "default"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clients["http://zone-1.internal:5681"].receivedMesh).To(Equal("default"))
+		Expect(stdout.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "inspect-vips.golden.txt")))
+	})
+
+	It("should print the VIPs that differ from another Control Plane", func() {
+		// given
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(stdout)
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("testdata", "inspect-vips.config.yaml"),
+			"inspect", "vips", "--mesh", "default", "--compare-to", "zone-2"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clients["http://zone-2.internal:5681"].receivedMesh).To(Equal("default"))
+		Expect(stdout.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "inspect-vips-compare.golden.txt")))
+	})
+
+	It("should fail for an unknown Control Plane", func() {
+		// given
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(stdout)
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("testdata", "inspect-vips.config.yaml"),
+			"inspect", "vips", "--compare-to", "unknown"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`there is no Control Plane with name "unknown"`)))
+	})
+})
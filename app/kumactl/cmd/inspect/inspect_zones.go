@@ -3,7 +3,10 @@ package inspect
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -14,17 +17,35 @@ import (
 	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	"github.com/kumahq/kuma/pkg/api-server/types"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
 func newInspectZonesCmd(ctx *cmd.RootContext) *cobra.Command {
+	args := struct {
+		upgradeReadiness bool
+		targetVersion    string
+	}{}
 	cmd := &cobra.Command{
 		Use:   "zones",
 		Short: "Inspect Zones",
-		Long:  `Inspect Zones.`,
+		Long: `Inspect Zones.
+
+With --upgrade-readiness, versions of Zone CPs, kuma-dp and Envoy in every zone are checked against
+the target version of the upgrade and the compatibility matrix. Blockers have to be resolved before the upgrade.`,
+		Example: `
+Check whether zones are ready to be upgraded to the version of the Global Control Plane
+$ kumactl inspect zones --upgrade-readiness
+
+Check whether zones are ready to be upgraded to the version 1.3.0
+$ kumactl inspect zones --upgrade-readiness --target-version 1.3.0
+`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if args.upgradeReadiness {
+				return inspectUpgradeReadiness(ctx, args.targetVersion, cmd.OutOrStdout())
+			}
 			client, err := ctx.CurrentZoneOverviewClient()
 			if err != nil {
 				return errors.Wrap(err, "failed to create a zone client")
@@ -46,9 +67,110 @@ func newInspectZonesCmd(ctx *cmd.RootContext) *cobra.Command {
 			}
 		},
 	}
+	cmd.Flags().BoolVar(&args.upgradeReadiness, "upgrade-readiness", false, "report versions of Zone CPs, kuma-dp and Envoy in every zone and issues that block the upgrade")
+	cmd.Flags().StringVar(&args.targetVersion, "target-version", "", "target version of the upgrade checked with --upgrade-readiness. If empty, the version of the Control Plane is used")
 	return cmd
 }
 
+func inspectUpgradeReadiness(ctx *cmd.RootContext, targetVersion string, out io.Writer) error {
+	client, err := ctx.CurrentUpgradeReadinessClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to create an upgrade readiness client")
+	}
+	report, err := client.Report(context.Background(), targetVersion)
+	if err != nil {
+		return err
+	}
+
+	switch format := output.Format(ctx.InspectContext.Args.OutputFormat); format {
+	case output.TableFormat:
+		return printUpgradeReadinessReport(report, out)
+	default:
+		printer, err := printers.NewGenericPrinter(format)
+		if err != nil {
+			return err
+		}
+		return printer.Print(report, out)
+	}
+}
+
+func printUpgradeReadinessReport(report *types.UpgradeReadinessReport, out io.Writer) error {
+	if _, err := fmt.Fprintf(out, "GLOBAL-CP VERSION: %s\nTARGET VERSION: %s\nREADY: %t\n\n", report.GlobalCPVersion, report.TargetVersion, report.Ready); err != nil {
+		return err
+	}
+	zones := printers.Table{
+		Headers: []string{"ZONE", "ZONE-CP VERSION", "KUMA-DP VERSIONS", "ENVOY VERSIONS", "BLOCKERS", "WARNINGS"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(report.Zones) <= i {
+					return nil
+				}
+				zone := report.Zones[i]
+				return []string{
+					zone.Zone,                                // ZONE
+					zone.ZoneCPVersion,                       // ZONE-CP VERSION
+					formatVersionCounts(zone.KumaDPVersions), // KUMA-DP VERSIONS
+					formatVersionCounts(zone.EnvoyVersions),  // ENVOY VERSIONS
+					strconv.Itoa(zone.Blockers()),            // BLOCKERS
+					strconv.Itoa(zone.Warnings()),            // WARNINGS
+				}
+			}
+		}(),
+	}
+	if err := printers.NewTablePrinter().Print(zones, out); err != nil {
+		return err
+	}
+
+	type zoneIssue struct {
+		zone  string
+		issue types.UpgradeIssue
+	}
+	var issues []zoneIssue
+	for _, zone := range report.Zones {
+		for _, issue := range zone.Issues {
+			issues = append(issues, zoneIssue{zone: zone.Zone, issue: issue})
+		}
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+	if _, err := fmt.Fprintln(out); err != nil {
+		return err
+	}
+	issuesTable := printers.Table{
+		Headers: []string{"ZONE", "SEVERITY", "COMPONENT", "VERSION", "COUNT", "REASON"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(issues) <= i {
+					return nil
+				}
+				issue := issues[i]
+				return []string{
+					issue.zone,                      // ZONE
+					issue.issue.Severity,            // SEVERITY
+					issue.issue.Component,           // COMPONENT
+					issue.issue.Version,             // VERSION
+					strconv.Itoa(issue.issue.Count), // COUNT
+					issue.issue.Reason,              // REASON
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(issuesTable, out)
+}
+
+func formatVersionCounts(counts []types.VersionCount) string {
+	var formatted []string
+	for _, count := range counts {
+		formatted = append(formatted, fmt.Sprintf("%s (%d)", count.Version, count.Count))
+	}
+	return strings.Join(formatted, ", ")
+}
+
 func printZoneOverviews(now time.Time, zoneOverviews *system.ZoneOverviewResourceList, out io.Writer) error {
 	var unmarshallErr error
 	data := printers.Table{
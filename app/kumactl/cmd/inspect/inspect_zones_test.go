@@ -16,6 +16,7 @@ import (
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/api-server/types"
 	system_core "github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
@@ -41,6 +42,18 @@ func (c *testZoneOverviewClient) List(_ context.Context) (*system_core.ZoneOverv
 
 var _ resources.ZoneOverviewClient = &testZoneOverviewClient{}
 
+type testUpgradeReadinessClient struct {
+	receivedTargetVersion string
+	report                *types.UpgradeReadinessReport
+}
+
+func (c *testUpgradeReadinessClient) Report(_ context.Context, targetVersion string) (*types.UpgradeReadinessReport, error) {
+	c.receivedTargetVersion = targetVersion
+	return c.report, nil
+}
+
+var _ resources.UpgradeReadinessClient = &testUpgradeReadinessClient{}
+
 var _ = Describe("kumactl inspect zones", func() {
 
 	var now, t1, t2 time.Time
@@ -281,4 +294,109 @@ var _ = Describe("kumactl inspect zones", func() {
 			}),
 		)
 	})
+
+	Describe("InspectZonesCmd with upgrade readiness", func() {
+
+		var rootCmd *cobra.Command
+		var buf *bytes.Buffer
+		var testClient *testUpgradeReadinessClient
+
+		BeforeEach(func() {
+			// setup
+			testClient = &testUpgradeReadinessClient{
+				report: &types.UpgradeReadinessReport{
+					GlobalCPVersion: "1.3.0",
+					TargetVersion:   "1.3.0",
+					Ready:           false,
+					Zones: []types.ZoneUpgradeReadiness{
+						{
+							Zone:           "zone-1",
+							ZoneCPVersion:  "1.2.0",
+							KumaDPVersions: []types.VersionCount{{Version: "1.2.0", Count: 2}, {Version: "1.2.1", Count: 1}},
+							EnvoyVersions:  []types.VersionCount{{Version: "1.17.0", Count: 1}, {Version: "1.18.0", Count: 2}},
+							Issues: []types.UpgradeIssue{
+								{
+									Severity:  types.UpgradeIssueBlocker,
+									Component: types.UpgradeComponentEnvoy,
+									Version:   "1.17.0",
+									Reason:    "version is not compatible with kuma-dp 1.2.1, compatible Envoy version: ~1.18.0",
+									Count:     1,
+								},
+								{
+									Severity:  types.UpgradeIssueBlocker,
+									Component: types.UpgradeComponentKumaDP,
+									Version:   "1.2.1",
+									Reason:    "version is newer than the version of the Zone CP",
+									Count:     1,
+								},
+								{
+									Severity:  types.UpgradeIssueWarning,
+									Component: types.UpgradeComponentKumaDP,
+									Reason:    "version is unknown, it has never been connected",
+									Count:     1,
+								},
+							},
+						},
+						{
+							Zone:           "zone-2",
+							ZoneCPVersion:  "1.1.0",
+							KumaDPVersions: []types.VersionCount{{Version: "1.1.0", Count: 1}},
+							EnvoyVersions:  []types.VersionCount{{Version: "1.17.0", Count: 1}},
+							Issues: []types.UpgradeIssue{
+								{
+									Severity:  types.UpgradeIssueBlocker,
+									Component: types.UpgradeComponentKumaDP,
+									Version:   "1.1.0",
+									Reason:    "version is more than one minor version behind the target version, it has to be upgraded first",
+									Count:     1,
+								},
+								{
+									Severity:  types.UpgradeIssueBlocker,
+									Component: types.UpgradeComponentZoneCP,
+									Version:   "1.1.0",
+									Reason:    "version is more than one minor version behind the target version, it has to be upgraded first",
+									Count:     1,
+								},
+							},
+						},
+						{
+							Zone: "zone-3",
+							Issues: []types.UpgradeIssue{
+								{
+									Severity:  types.UpgradeIssueWarning,
+									Component: types.UpgradeComponentZoneCP,
+									Reason:    "version is unknown, it has never been connected",
+									Count:     1,
+								},
+							},
+						},
+					},
+				},
+			}
+			rootCtx, err := test_kumactl.MakeRootContext(now, nil)
+			Expect(err).ToNot(HaveOccurred())
+			rootCtx.Runtime.NewUpgradeReadinessClient = func(util_http.Client) resources.UpgradeReadinessClient {
+				return testClient
+			}
+
+			rootCmd = cmd.NewRootCmd(rootCtx)
+			buf = &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+		})
+
+		It("should print the upgrade readiness report", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "zones", "--upgrade-readiness", "--target-version", "1.3.0"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testClient.receivedTargetVersion).To(Equal("1.3.0"))
+			Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "inspect-zones-upgrade-readiness.golden.txt")))
+		})
+	})
 })
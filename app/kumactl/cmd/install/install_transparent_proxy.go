@@ -30,6 +30,7 @@ type transparentProxyArgs struct {
 	RedirectPortInBoundV6  string
 	ExcludeInboundPorts    string
 	ExcludeOutboundPorts   string
+	ExcludeOutboundIPs     string
 	UID                    string
 	User                   string
 	RedirectDNS            bool
@@ -53,6 +54,7 @@ func newInstallTransparentProxy() *cobra.Command {
 		RedirectPortInBoundV6:  "15010",
 		ExcludeInboundPorts:    "",
 		ExcludeOutboundPorts:   "",
+		ExcludeOutboundIPs:     "",
 		UID:                    "",
 		User:                   "",
 		RedirectDNS:            false,
@@ -166,6 +168,7 @@ runuser -u kuma-dp -- \
 	cmd.Flags().StringVar(&args.RedirectPortInBoundV6, "redirect-inbound-port-v6", args.RedirectPortInBoundV6, "IPv6 inbound port redirected to Envoy, as specified in dataplane's `networking.transparentProxying.redirectPortInboundV6`")
 	cmd.Flags().StringVar(&args.ExcludeInboundPorts, "exclude-inbound-ports", args.ExcludeInboundPorts, "a comma separated list of inbound ports to exclude from redirect to Envoy")
 	cmd.Flags().StringVar(&args.ExcludeOutboundPorts, "exclude-outbound-ports", args.ExcludeOutboundPorts, "a comma separated list of outbound ports to exclude from redirect to Envoy")
+	cmd.Flags().StringVar(&args.ExcludeOutboundIPs, "exclude-outbound-ips", args.ExcludeOutboundIPs, "a comma separated list of outbound IP ranges in CIDR notation to exclude from redirect to Envoy")
 	cmd.Flags().StringVar(&args.User, "kuma-dp-user", args.UID, "the user that will run kuma-dp")
 	cmd.Flags().StringVar(&args.UID, "kuma-dp-uid", args.UID, "the UID of the user that will run kuma-dp")
 	cmd.Flags().BoolVar(&args.RedirectDNS, "redirect-dns", args.RedirectDNS, "redirect only DNS requests targeted to the servers listed in /etc/resolv.conf to a specified port")
@@ -225,6 +228,7 @@ func modifyIpTables(cmd *cobra.Command, args *transparentProxyArgs) error {
 		RedirectPortInBoundV6:  args.RedirectPortInBoundV6,
 		ExcludeInboundPorts:    args.ExcludeInboundPorts,
 		ExcludeOutboundPorts:   args.ExcludeOutboundPorts,
+		ExcludeOutboundIPs:     args.ExcludeOutboundIPs,
 		UID:                    uid,
 		GID:                    gid,
 		RedirectDNS:            args.RedirectDNS,
@@ -0,0 +1,91 @@
+package loglevel
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kuma_log "github.com/kumahq/kuma/pkg/log"
+)
+
+func NewLogLevelCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log-level",
+		Short: "Change the log levels of the Control Plane",
+		Long: `Change the log levels of the Control Plane at runtime.
+
+The levels are changed only on the instance of the Control Plane that serves the request and are not persisted,
+so they are lost when the Control Plane restarts. Run "kumactl inspect log-levels" to list the levels.`,
+	}
+	// sub-commands
+	cmd.AddCommand(newSetCmd(pctx))
+	cmd.AddCommand(newResetCmd(pctx))
+	return cmd
+}
+
+func newSetCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := struct {
+		component string
+	}{}
+	cmd := &cobra.Command{
+		Use:   "set LEVEL",
+		Short: "Set a log level of the Control Plane",
+		Long: `Set a log level of the Control Plane. LEVEL is one of off, info or debug.
+
+Without --component, the default log level of the Control Plane is set. With --component, the level is set
+for the component and its subcomponents, e.g. the level of "xds-server" also applies to "xds-server.reconcile".`,
+		Example: `
+Debug the generation of the configuration of data plane proxies
+$ kumactl log-level set debug --component xds-server
+
+Turn off the logs of the Control Plane except for the components with a level of their own
+$ kumactl log-level set off
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, cmdArgs []string) error {
+			level := cmdArgs[0]
+			if _, err := kuma_log.ParseLogLevel(level); err != nil {
+				return err
+			}
+			client, err := pctx.CurrentLogLevelsClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a log levels client")
+			}
+			if _, err := client.Set(context.Background(), args.component, level); err != nil {
+				return err
+			}
+			if args.component == "" {
+				cmd.Printf("default log level set to %q\n", level)
+			} else {
+				cmd.Printf("log level of %q set to %q\n", args.component, level)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&args.component, "component", "", "component of the Control Plane, e.g. xds-server or xds-server.reconcile")
+	return cmd
+}
+
+func newResetCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset COMPONENT",
+		Short: "Reset the log level of a component of the Control Plane",
+		Long:  `Reset the log level of a component of the Control Plane, so it logs with the level of its parent component again.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			component := args[0]
+			client, err := pctx.CurrentLogLevelsClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a log levels client")
+			}
+			if _, err := client.Reset(context.Background(), component); err != nil {
+				return err
+			}
+			cmd.Printf("log level of %q reset\n", component)
+			return nil
+		},
+	}
+	return cmd
+}
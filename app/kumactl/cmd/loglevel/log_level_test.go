@@ -0,0 +1,110 @@
+package loglevel_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	"github.com/kumahq/kuma/pkg/util/test"
+)
+
+type testLogLevelsClient struct {
+	levels map[string]string
+}
+
+func (c *testLogLevelsClient) List(context.Context) (*types.LogLevels, error) {
+	return &types.LogLevels{}, nil
+}
+
+func (c *testLogLevelsClient) Set(_ context.Context, component string, level string) (*types.LogLevels, error) {
+	c.levels[component] = level
+	return &types.LogLevels{}, nil
+}
+
+func (c *testLogLevelsClient) Reset(_ context.Context, component string) (*types.LogLevels, error) {
+	delete(c.levels, component)
+	return &types.LogLevels{}, nil
+}
+
+var _ resources.LogLevelsClient = &testLogLevelsClient{}
+
+var _ = Describe("kumactl log-level", func() {
+
+	var rootCmd *cobra.Command
+	var outbuf *bytes.Buffer
+	var client *testLogLevelsClient
+
+	BeforeEach(func() {
+		client = &testLogLevelsClient{
+			levels: map[string]string{},
+		}
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.NewAPIServerClient = test.GetMockNewAPIServerClient()
+		rootCtx.Runtime.NewLogLevelsClient = func(util_http.Client) resources.LogLevelsClient {
+			return client
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		outbuf = &bytes.Buffer{}
+		rootCmd.SetOut(outbuf)
+		rootCmd.SetErr(outbuf)
+	})
+
+	execute := func(args ...string) error {
+		rootCmd.SetArgs(append([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"log-level"}, args...))
+		return rootCmd.Execute()
+	}
+
+	It("should set the level of a component", func() {
+		// when
+		err := execute("set", "debug", "--component", "xds-server")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(Equal("log level of \"xds-server\" set to \"debug\"\n"))
+		Expect(client.levels).To(Equal(map[string]string{"xds-server": "debug"}))
+	})
+
+	It("should set the default level", func() {
+		// when
+		err := execute("set", "off")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(Equal("default log level set to \"off\"\n"))
+		Expect(client.levels).To(Equal(map[string]string{"": "off"}))
+	})
+
+	It("should reset the level of a component", func() {
+		// given
+		client.levels["xds-server"] = "debug"
+
+		// when
+		err := execute("reset", "xds-server")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(Equal("log level of \"xds-server\" reset\n"))
+		Expect(client.levels).To(BeEmpty())
+	})
+
+	It("should reject an unknown level", func() {
+		// when
+		err := execute("set", "trace", "--component", "xds-server")
+
+		// then
+		Expect(err).To(MatchError(`unknown log level "trace"`))
+		Expect(client.levels).To(BeEmpty())
+	})
+})
@@ -0,0 +1,11 @@
+package loglevel_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestLogLevelCmd(t *testing.T) {
+	test.RunSpecs(t, "Log Level Cmd Suite")
+}
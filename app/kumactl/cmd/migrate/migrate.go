@@ -0,0 +1,18 @@
+package migrate
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewMigrateCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate Kuma deployments",
+		Long:  `Migrate Kuma deployments.`,
+	}
+	// sub-commands
+	cmd.AddCommand(newMigrateToMultizoneCmd(pctx))
+	return cmd
+}
@@ -0,0 +1,11 @@
+package migrate_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestMigrateCmd(t *testing.T) {
+	test.RunSpecs(t, "Migrate Cmd Suite")
+}
@@ -0,0 +1,194 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+const defaultGlobalKdsPort = "5685"
+
+type migrateToMultizoneContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		globalCp         string
+		zone             string
+		globalKdsAddress string
+		dryRun           bool
+		verify           bool
+	}
+}
+
+func newMigrateToMultizoneCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &migrateToMultizoneContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "to-multizone",
+		Short: "Migrate a standalone Control Plane to a zone of a multizone deployment",
+		Long: `Migrate a standalone Control Plane to a zone of a multizone deployment.
+
+The standalone Control Plane is the active Control Plane of kumactl. The Global Control Plane has to be added
+with "kumactl config control-planes add" first.
+
+The migration copies meshes, policies and secrets of the standalone Control Plane to the Global Control Plane,
+so the zone receives exactly the same resources from the Global Control Plane once it is connected.
+Secrets include signing keys of dataplane tokens and CAs, so dataplanes keep their tokens and certificates.
+Resources created by the Global Control Plane in the migrated meshes, like default policies, are removed.
+
+Then the Control Plane has to be restarted as a Zone Control Plane with the printed configuration.
+Dataplanes reconnect to the same address once it is restarted.
+
+Finally, run the command with --verify to check that the zone is connected to the Global Control Plane
+and that all resources of the Global Control Plane are synced to the zone.`,
+		Example: `
+Copy resources of the active Control Plane to the Global Control Plane "global"
+$ kumactl migrate to-multizone --global-cp global --zone zone-1
+
+Verify the migration once the Control Plane runs as a Zone Control Plane
+$ kumactl migrate to-multizone --global-cp global --zone zone-1 --verify
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if ctx.args.zone == "" {
+				return errors.New("--zone has to be defined")
+			}
+			if ctx.args.verify {
+				return ctx.verify(cmd.OutOrStdout())
+			}
+			return ctx.migrate(cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.globalCp, "global-cp", "", "name of the Global Control Plane in the kumactl config (required)")
+	_ = cmd.MarkFlagRequired("global-cp")
+	cmd.Flags().StringVar(&ctx.args.zone, "zone", "", "name of the zone that the standalone Control Plane becomes (required)")
+	_ = cmd.MarkFlagRequired("zone")
+	cmd.Flags().StringVar(&ctx.args.globalKdsAddress, "global-kds-address", "", "address of KDS of the Global Control Plane. If empty, it's derived from the address of its API Server. Example: grpcs://global-cp:5685")
+	cmd.Flags().BoolVar(&ctx.args.dryRun, "dry-run", false, "print changes of the Global Control Plane without applying them")
+	cmd.Flags().BoolVar(&ctx.args.verify, "verify", false, "verify that the zone is connected and all resources are synced instead of copying resources")
+	return cmd
+}
+
+func (c *migrateToMultizoneContext) migrate(out io.Writer) error {
+	standaloneStore, err := c.CurrentResourceStore()
+	if err != nil {
+		return errors.Wrap(err, "failed to create a client of the standalone Control Plane")
+	}
+	globalStore, err := c.ControlPlaneResourceStore(c.args.globalCp)
+	if err != nil {
+		return errors.Wrap(err, "failed to create a client of the Global Control Plane")
+	}
+	kdsAddress, err := c.globalKdsAddress()
+	if err != nil {
+		return err
+	}
+
+	m := &migrator{
+		source:      standaloneStore,
+		target:      globalStore,
+		descriptors: migratedTypes(c.Runtime.Registry),
+		dryRun:      c.args.dryRun,
+		out:         out,
+	}
+	summary, err := m.copy(context.Background())
+	if err != nil {
+		return errors.Wrap(err, "failed to copy resources to the Global Control Plane")
+	}
+	if summary.conflicts > 0 {
+		if _, err := fmt.Fprintln(out, "\nResources marked as conflict exist on the Global Control Plane with a different content and are not changed.\n"+
+			"The zone receives them from the Global Control Plane, so the same content has to be used by the zone."); err != nil {
+			return err
+		}
+	}
+	if c.args.dryRun {
+		_, err := fmt.Fprintf(out, "\nDry run: %s. Nothing was changed.\n", summary)
+		return err
+	}
+	_, err = fmt.Fprintf(out, `
+Resources were copied to the Global Control Plane %q: %s.
+
+Next steps:
+1. Restart the Control Plane as a Zone Control Plane with the same store and the same address and the following configuration:
+     KUMA_MODE=zone
+     KUMA_MULTIZONE_ZONE_NAME=%s
+     KUMA_MULTIZONE_ZONE_GLOBAL_ADDRESS=%s
+   Don't change resources of the Control Plane until it is restarted, changes won't be synced to the Global Control Plane.
+2. Verify the migration:
+     kumactl migrate to-multizone --global-cp %s --zone %s --verify
+`, c.args.globalCp, summary, c.args.zone, kdsAddress, c.args.globalCp, c.args.zone)
+	return err
+}
+
+func (c *migrateToMultizoneContext) verify(out io.Writer) error {
+	zoneStore, err := c.CurrentResourceStore()
+	if err != nil {
+		return errors.Wrap(err, "failed to create a client of the Zone Control Plane")
+	}
+	globalStore, err := c.ControlPlaneResourceStore(c.args.globalCp)
+	if err != nil {
+		return errors.Wrap(err, "failed to create a client of the Global Control Plane")
+	}
+	zoneClient, err := c.ControlPlaneZoneOverviewClient(c.args.globalCp)
+	if err != nil {
+		return errors.Wrap(err, "failed to create a zone client of the Global Control Plane")
+	}
+
+	overviews, err := zoneClient.List(context.Background())
+	if err != nil {
+		return errors.Wrap(err, "failed to list zones of the Global Control Plane")
+	}
+	online := false
+	found := false
+	for _, overview := range overviews.Items {
+		if overview.GetMeta().GetName() == c.args.zone {
+			found = true
+			online = overview.Spec.GetZoneInsight().IsOnline() && overview.Spec.GetZone().IsEnabled()
+		}
+	}
+	if !found {
+		return errors.Errorf("zone %q is not connected to the Global Control Plane. Check that the Control Plane runs as a Zone Control Plane with the name %q", c.args.zone, c.args.zone)
+	}
+	if !online {
+		return errors.Errorf("zone %q is offline", c.args.zone)
+	}
+	if _, err := fmt.Fprintf(out, "Zone %q is online.\n", c.args.zone); err != nil {
+		return err
+	}
+
+	m := &migrator{
+		source:      globalStore,
+		target:      zoneStore,
+		descriptors: migratedTypes(c.Runtime.Registry),
+		out:         out,
+	}
+	synced, differences, err := m.compare(context.Background(), c.args.zone)
+	if err != nil {
+		return errors.Wrap(err, "failed to compare resources of the Global Control Plane and the zone")
+	}
+	if differences > 0 {
+		return errors.Errorf("%d resources of the Global Control Plane are not synced to the zone yet. Retry in a moment, if the problem persists check the logs of the Zone Control Plane", differences)
+	}
+	_, err = fmt.Fprintf(out, "All %d resources of the Global Control Plane are synced to the zone. The migration is complete.\n", synced)
+	return err
+}
+
+func (c *migrateToMultizoneContext) globalKdsAddress() (string, error) {
+	if c.args.globalKdsAddress != "" {
+		return c.args.globalKdsAddress, nil
+	}
+	_, controlPlane := c.Config().GetControlPlane(c.args.globalCp)
+	if controlPlane == nil {
+		return "", errors.Errorf("there is no Control Plane with name %q", c.args.globalCp)
+	}
+	apiServerURL, err := url.Parse(controlPlane.GetCoordinates().GetApiServer().GetUrl())
+	if err != nil {
+		return "", errors.Wrap(err, "could not parse the address of the Global Control Plane, define --global-kds-address")
+	}
+	return "grpcs://" + net.JoinHostPort(apiServerURL.Hostname(), defaultGlobalKdsPort), nil
+}
@@ -0,0 +1,314 @@
+package migrate_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// controlPlaneClient identifies a Control Plane by the URL of its API Server, so every Control Plane gets its own store
+type controlPlaneClient struct {
+	url string
+}
+
+func (c *controlPlaneClient) Do(*http.Request) (*http.Response, error) {
+	panic("not expected to be called")
+}
+
+type testZoneOverviewClient struct {
+	overviews []*system.ZoneOverviewResource
+}
+
+func (c *testZoneOverviewClient) List(_ context.Context) (*system.ZoneOverviewResourceList, error) {
+	return &system.ZoneOverviewResourceList{
+		Items: c.overviews,
+	}, nil
+}
+
+var _ resources.ZoneOverviewClient = &testZoneOverviewClient{}
+
+var _ = Describe("kumactl migrate to-multizone", func() {
+
+	var standaloneStore core_store.ResourceStore
+	var globalStore core_store.ResourceStore
+	var zoneClient *testZoneOverviewClient
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+
+	create := func(s core_store.ResourceStore, res core_model.Resource, name, mesh string) {
+		err := s.Create(context.Background(), res, core_store.CreateByKey(name, mesh))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	route := func(service string) *core_mesh.TrafficRouteResource {
+		return &core_mesh.TrafficRouteResource{
+			Spec: &mesh_proto.TrafficRoute{
+				Sources: []*mesh_proto.Selector{{
+					Match: map[string]string{mesh_proto.ServiceTag: "*"},
+				}},
+				Destinations: []*mesh_proto.Selector{{
+					Match: map[string]string{mesh_proto.ServiceTag: "*"},
+				}},
+				Conf: &mesh_proto.TrafficRoute_Conf{
+					Destination: map[string]string{mesh_proto.ServiceTag: service},
+				},
+			},
+		}
+	}
+
+	secret := func(data string) *system.SecretResource {
+		return &system.SecretResource{
+			Spec: &system_proto.Secret{
+				Data: util_proto.Bytes([]byte(data)),
+			},
+		}
+	}
+
+	globalSecret := func(data string) *system.GlobalSecretResource {
+		return &system.GlobalSecretResource{
+			Spec: &system_proto.Secret{
+				Data: util_proto.Bytes([]byte(data)),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		standaloneStore = memory_resources.NewStore()
+		globalStore = memory_resources.NewStore()
+		zoneClient = &testZoneOverviewClient{}
+
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil,
+			core_mesh.MeshResourceTypeDescriptor,
+			core_mesh.TrafficRouteResourceTypeDescriptor,
+			core_mesh.DataplaneResourceTypeDescriptor,
+			system.SecretResourceTypeDescriptor,
+			system.GlobalSecretResourceTypeDescriptor,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewBaseAPIServerClient = func(server *config_proto.ControlPlaneCoordinates_ApiServer) (util_http.Client, error) {
+			return &controlPlaneClient{url: server.Url}, nil
+		}
+		rootCtx.Runtime.NewResourceStore = func(client util_http.Client) core_store.ResourceStore {
+			if client.(*controlPlaneClient).url == "http://global.internal:5681" {
+				return globalStore
+			}
+			return standaloneStore
+		}
+		rootCtx.Runtime.NewZoneOverviewClient = func(client util_http.Client) resources.ZoneOverviewClient {
+			Expect(client.(*controlPlaneClient).url).To(Equal("http://global.internal:5681"))
+			return zoneClient
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	Describe("copying resources", func() {
+
+		BeforeEach(func() {
+			// standalone Control Plane
+			create(standaloneStore, &core_mesh.MeshResource{
+				Spec: &mesh_proto.Mesh{
+					Mtls: &mesh_proto.Mesh_Mtls{
+						EnabledBackend: "ca-1",
+						Backends: []*mesh_proto.CertificateAuthorityBackend{
+							{
+								Name: "ca-1",
+								Type: "builtin",
+							},
+						},
+					},
+				},
+			}, "default", core_model.NoMesh)
+			create(standaloneStore, route("backend"), "route-1", "default")
+			create(standaloneStore, secret("standalone-ca"), "default.ca-builtin-cert-ca-1", "default")
+			create(standaloneStore, globalSecret("standalone-signing-key"), "user-token-signing-key", core_model.NoMesh)
+			create(standaloneStore, globalSecret("zone-ingress-signing-key"), "zone-ingress-token-signing-key", core_model.NoMesh)
+			create(standaloneStore, &core_mesh.DataplaneResource{
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+							Port: 8080,
+							Tags: map[string]string{mesh_proto.ServiceTag: "backend"},
+						}},
+					},
+				},
+			}, "backend-1", "default")
+
+			// resources created by the Global Control Plane on its own
+			create(globalStore, &core_mesh.MeshResource{Spec: &mesh_proto.Mesh{}}, "default", core_model.NoMesh)
+			create(globalStore, route("*"), "route-all-default", "default")
+			create(globalStore, secret("global-ca"), "default.ca-builtin-cert-ca-1", "default")
+			create(globalStore, globalSecret("global-signing-key"), "user-token-signing-key", core_model.NoMesh)
+		})
+
+		It("should make resources of meshes of the Global Control Plane the same as in the standalone Control Plane", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("testdata", "migrate.config.yaml"),
+				"migrate", "to-multizone", "--global-cp", "global", "--zone", "zone-1"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "migrate-to-multizone.golden.txt")))
+
+			// and mesh is updated
+			mesh := core_mesh.NewMeshResource()
+			Expect(globalStore.Get(context.Background(), mesh, core_store.GetByKey("default", core_model.NoMesh))).To(Succeed())
+			Expect(mesh.Spec.GetMtls().GetEnabledBackend()).To(Equal("ca-1"))
+
+			// and policies are the same as in the standalone Control Plane
+			routes := core_mesh.TrafficRouteResourceList{}
+			Expect(globalStore.List(context.Background(), &routes, core_store.ListByMesh("default"))).To(Succeed())
+			Expect(routes.Items).To(HaveLen(1))
+			Expect(routes.Items[0].GetMeta().GetName()).To(Equal("route-1"))
+
+			// and CA is taken from the standalone Control Plane so certificates of dataplanes stay valid
+			ca := system.NewSecretResource()
+			Expect(globalStore.Get(context.Background(), ca, core_store.GetByKey("default.ca-builtin-cert-ca-1", "default"))).To(Succeed())
+			Expect(ca.Spec.GetData().GetValue()).To(Equal([]byte("standalone-ca")))
+
+			// and conflicting global secret of the Global Control Plane is not overridden
+			signingKey := system.NewGlobalSecretResource()
+			Expect(globalStore.Get(context.Background(), signingKey, core_store.GetByKey("user-token-signing-key", core_model.NoMesh))).To(Succeed())
+			Expect(signingKey.Spec.GetData().GetValue()).To(Equal([]byte("global-signing-key")))
+
+			// and dataplanes stay in the zone
+			dataplanes := core_mesh.DataplaneResourceList{}
+			Expect(globalStore.List(context.Background(), &dataplanes)).To(Succeed())
+			Expect(dataplanes.Items).To(BeEmpty())
+		})
+
+		It("should not change the Global Control Plane on dry run", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("testdata", "migrate.config.yaml"),
+				"migrate", "to-multizone", "--global-cp", "global", "--zone", "zone-1", "--dry-run"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "migrate-to-multizone.dry-run.golden.txt")))
+
+			// and
+			routes := core_mesh.TrafficRouteResourceList{}
+			Expect(globalStore.List(context.Background(), &routes, core_store.ListByMesh("default"))).To(Succeed())
+			Expect(routes.Items).To(HaveLen(1))
+			Expect(routes.Items[0].GetMeta().GetName()).To(Equal("route-all-default"))
+		})
+
+		It("should fail when the Global Control Plane is not configured", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("testdata", "migrate.config.yaml"),
+				"migrate", "to-multizone", "--global-cp", "other", "--zone", "zone-1"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError(`failed to create a client of the Global Control Plane: there is no Control Plane with name "other". Use ` + "`kumactl config control-planes add`" + ` to add it`))
+		})
+	})
+
+	Describe("verifying the migration", func() {
+
+		BeforeEach(func() {
+			for _, s := range []core_store.ResourceStore{globalStore, standaloneStore} {
+				create(s, &core_mesh.MeshResource{Spec: &mesh_proto.Mesh{}}, "default", core_model.NoMesh)
+				create(s, route("backend"), "route-1", "default")
+				create(s, secret("ca"), "default.ca-builtin-cert-ca-1", "default")
+			}
+		})
+
+		onlineZone := func(name string) *system.ZoneOverviewResource {
+			return &system.ZoneOverviewResource{
+				Meta: &test_model.ResourceMeta{Name: name},
+				Spec: &system_proto.ZoneOverview{
+					Zone: &system_proto.Zone{Enabled: util_proto.Bool(true)},
+					ZoneInsight: &system_proto.ZoneInsight{
+						Subscriptions: []*system_proto.KDSSubscription{{
+							Id:          "1",
+							ConnectTime: util_proto.MustTimestampProto(time.Now()),
+						}},
+					},
+				},
+			}
+		}
+
+		It("should succeed when the zone is online and all resources are synced", func() {
+			// given
+			zoneClient.overviews = []*system.ZoneOverviewResource{onlineZone("zone-1")}
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("testdata", "migrate.config.yaml"),
+				"migrate", "to-multizone", "--global-cp", "global", "--zone", "zone-1", "--verify"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(Equal(`Zone "zone-1" is online.
+All 3 resources of the Global Control Plane are synced to the zone. The migration is complete.
+`))
+		})
+
+		It("should fail when resources are not synced yet", func() {
+			// given
+			zoneClient.overviews = []*system.ZoneOverviewResource{onlineZone("zone-1")}
+			create(globalStore, route("web"), "route-2", "default")
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("testdata", "migrate.config.yaml"),
+				"migrate", "to-multizone", "--global-cp", "global", "--zone", "zone-1", "--verify"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError("1 resources of the Global Control Plane are not synced to the zone yet. Retry in a moment, if the problem persists check the logs of the Zone Control Plane"))
+			Expect(buf.String()).To(ContainSubstring(`missing    TrafficRoute "route-2" in mesh "default"`))
+		})
+
+		It("should fail when the zone is not connected", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("testdata", "migrate.config.yaml"),
+				"migrate", "to-multizone", "--global-cp", "global", "--zone", "zone-1", "--verify"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError(`zone "zone-1" is not connected to the Global Control Plane. Check that the Control Plane runs as a Zone Control Plane with the name "zone-1"`))
+		})
+	})
+})
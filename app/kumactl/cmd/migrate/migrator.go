@@ -0,0 +1,291 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	builtin_zone "github.com/kumahq/kuma/pkg/plugins/ca/builtin/zone"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
+)
+
+const listPageSize = 100
+
+// migratedTypes returns types of resources that are owned by the Global Control Plane in a multizone deployment
+// except Zones, which describe zones of the multizone deployment itself.
+// Meshes go first because the rest of resources belong to them, then global scoped and mesh scoped resources follow.
+func migratedTypes(reg registry.TypeRegistry) []core_model.ResourceTypeDescriptor {
+	globalOwned := core_model.TypeFilterFn(func(descriptor core_model.ResourceTypeDescriptor) bool {
+		return descriptor.KDSFlags.Has(core_model.ProvidedByGlobal) &&
+			!descriptor.KDSFlags.Has(core_model.ProvidedByZone) &&
+			descriptor.Name != system.ZoneType &&
+			descriptor.WsPath != ""
+	})
+	var descriptors []core_model.ResourceTypeDescriptor
+	descriptors = append(descriptors, sorted(reg.ObjectDescriptors(globalOwned, core_model.Named(core_mesh.MeshType)))...)
+	descriptors = append(descriptors, sorted(reg.ObjectDescriptors(globalOwned, core_model.HasScope(core_model.ScopeGlobal), core_model.Not(core_model.Named(core_mesh.MeshType))))...)
+	descriptors = append(descriptors, sorted(reg.ObjectDescriptors(globalOwned, core_model.HasScope(core_model.ScopeMesh)))...)
+	return descriptors
+}
+
+func sorted(descriptors []core_model.ResourceTypeDescriptor) []core_model.ResourceTypeDescriptor {
+	sort.Slice(descriptors, func(i, j int) bool {
+		return descriptors[i].Name < descriptors[j].Name
+	})
+	return descriptors
+}
+
+type copySummary struct {
+	created   int
+	updated   int
+	deleted   int
+	unchanged int
+	conflicts int
+}
+
+func (s copySummary) String() string {
+	return fmt.Sprintf("%d created, %d updated, %d deleted, %d unchanged, %d conflicts", s.created, s.updated, s.deleted, s.unchanged, s.conflicts)
+}
+
+// migrator copies resources owned by the Global Control Plane from the source to the target
+// and compares them after they are synced.
+type migrator struct {
+	source      core_store.ResourceStore
+	target      core_store.ResourceStore
+	descriptors []core_model.ResourceTypeDescriptor
+	dryRun      bool
+	out         io.Writer
+}
+
+// copy makes resources of the target the same as resources of the source in every mesh of the source.
+// Global scoped resources other than meshes that already exist in the target with a different spec are not overridden,
+// because they may be used by the target itself (ex. signing keys of user tokens), they are reported as conflicts.
+func (m *migrator) copy(ctx context.Context) (copySummary, error) {
+	summary := copySummary{}
+	meshes, err := m.meshes(ctx, m.source)
+	if err != nil {
+		return summary, err
+	}
+	for _, descriptor := range m.descriptors {
+		if descriptor.Scope == core_model.ScopeGlobal {
+			overwrite := descriptor.Name == core_mesh.MeshType
+			if err := m.copyResources(ctx, descriptor, "", overwrite, false, &summary); err != nil {
+				return summary, err
+			}
+			continue
+		}
+		for _, mesh := range meshes {
+			if err := m.copyResources(ctx, descriptor, mesh, true, true, &summary); err != nil {
+				return summary, err
+			}
+		}
+	}
+	return summary, nil
+}
+
+func (m *migrator) copyResources(
+	ctx context.Context,
+	descriptor core_model.ResourceTypeDescriptor,
+	mesh string,
+	overwrite bool,
+	deleteAbsent bool,
+	summary *copySummary,
+) error {
+	sourceItems, err := listAll(ctx, m.source, descriptor, mesh)
+	if err != nil {
+		return err
+	}
+	targetItems, err := listAll(ctx, m.target, descriptor, mesh)
+	if err != nil {
+		return err
+	}
+	existing := index(targetItems)
+	for _, res := range sourceItems {
+		key := core_model.MetaToResourceKey(res.GetMeta())
+		current, ok := existing[key]
+		delete(existing, key)
+		switch {
+		case !ok:
+			m.report("create", descriptor, key)
+			summary.created++
+			if m.dryRun {
+				continue
+			}
+			newRes := descriptor.NewObject()
+			if err := newRes.SetSpec(res.GetSpec()); err != nil {
+				return err
+			}
+			if err := m.target.Create(ctx, newRes, core_store.CreateBy(key)); err != nil {
+				return err
+			}
+		case proto.Equal(current.GetSpec(), res.GetSpec()):
+			summary.unchanged++
+		case !overwrite:
+			m.report("conflict", descriptor, key)
+			summary.conflicts++
+		default:
+			m.report("update", descriptor, key)
+			summary.updated++
+			if m.dryRun {
+				continue
+			}
+			if err := current.SetSpec(res.GetSpec()); err != nil {
+				return err
+			}
+			if err := m.target.Update(ctx, current); err != nil {
+				return err
+			}
+		}
+	}
+	if !deleteAbsent {
+		return nil
+	}
+	for _, res := range targetItems {
+		key := core_model.MetaToResourceKey(res.GetMeta())
+		if _, absent := existing[key]; !absent {
+			continue
+		}
+		m.report("delete", descriptor, key)
+		summary.deleted++
+		if m.dryRun {
+			continue
+		}
+		if err := m.target.Delete(ctx, res, core_store.DeleteBy(key)); err != nil && !core_store.IsResourceNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// compare reports resources of the source that are synced to the zone but are missing or different in the target
+// and resources of the target that are absent in the source. It returns the number of resources that are the same
+// and the number of differences.
+func (m *migrator) compare(ctx context.Context, zone string) (int, int, error) {
+	same, differences := 0, 0
+	meshes, err := m.meshes(ctx, m.source)
+	if err != nil {
+		return 0, 0, err
+	}
+	compareResources := func(descriptor core_model.ResourceTypeDescriptor, mesh string) error {
+		sourceItems, err := listAll(ctx, m.source, descriptor, mesh)
+		if err != nil {
+			return err
+		}
+		targetItems, err := listAll(ctx, m.target, descriptor, mesh)
+		if err != nil {
+			return err
+		}
+		existing := index(targetItems)
+		for _, res := range sourceItems {
+			key := core_model.MetaToResourceKey(res.GetMeta())
+			current, ok := existing[key]
+			delete(existing, key)
+			switch {
+			case !syncedToZone(zone, descriptor, key):
+				continue
+			case !ok:
+				m.report("missing", descriptor, key)
+				differences++
+			case !proto.Equal(current.GetSpec(), res.GetSpec()):
+				m.report("outdated", descriptor, key)
+				differences++
+			default:
+				same++
+			}
+		}
+		if descriptor.Name == system.GlobalSecretType {
+			// only some global secrets are synced, the zone keeps the rest of its own global secrets
+			return nil
+		}
+		for _, res := range targetItems {
+			key := core_model.MetaToResourceKey(res.GetMeta())
+			if _, unexpected := existing[key]; unexpected {
+				m.report("unexpected", descriptor, key)
+				differences++
+			}
+		}
+		return nil
+	}
+	for _, descriptor := range m.descriptors {
+		if descriptor.Scope == core_model.ScopeGlobal {
+			if err := compareResources(descriptor, ""); err != nil {
+				return 0, 0, err
+			}
+			continue
+		}
+		for _, mesh := range meshes {
+			if err := compareResources(descriptor, mesh); err != nil {
+				return 0, 0, err
+			}
+		}
+	}
+	return same, differences, nil
+}
+
+// syncedToZone returns false for resources that the Global Control Plane doesn't sync to the zone.
+func syncedToZone(zone string, descriptor core_model.ResourceTypeDescriptor, key core_model.ResourceKey) bool {
+	switch descriptor.Name {
+	case system.GlobalSecretType:
+		return zoneingress.IsSigningKeyResource(key)
+	case system.SecretType:
+		// private keys of zone CAs are synced only to their own zones
+		if secretZone, isKey, ok := builtin_zone.ZoneOfSecret(key.Name); ok && isKey {
+			return secretZone == zone
+		}
+	}
+	return true
+}
+
+func (m *migrator) meshes(ctx context.Context, s core_store.ResourceStore) ([]string, error) {
+	meshes, err := listAll(ctx, s, core_mesh.MeshResourceTypeDescriptor, "")
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, mesh := range meshes {
+		names = append(names, mesh.GetMeta().GetName())
+	}
+	return names, nil
+}
+
+func (m *migrator) report(action string, descriptor core_model.ResourceTypeDescriptor, key core_model.ResourceKey) {
+	if key.Mesh == "" {
+		_, _ = fmt.Fprintf(m.out, "%-10s %s %q\n", action, descriptor.Name, key.Name)
+		return
+	}
+	_, _ = fmt.Fprintf(m.out, "%-10s %s %q in mesh %q\n", action, descriptor.Name, key.Name, key.Mesh)
+}
+
+func listAll(ctx context.Context, s core_store.ResourceStore, descriptor core_model.ResourceTypeDescriptor, mesh string) ([]core_model.Resource, error) {
+	var items []core_model.Resource
+	offset := ""
+	for {
+		list := descriptor.NewList()
+		if err := s.List(ctx, list, core_store.ListByMesh(mesh), core_store.ListByPage(listPageSize, offset)); err != nil {
+			return nil, err
+		}
+		items = append(items, list.GetItems()...)
+		offset = list.GetPagination().NextOffset
+		if offset == "" {
+			break
+		}
+	}
+	sort.Sort(core_model.ByMeta(items))
+	return items, nil
+}
+
+func index(items []core_model.Resource) map[core_model.ResourceKey]core_model.Resource {
+	indexed := map[core_model.ResourceKey]core_model.Resource{}
+	for _, res := range items {
+		indexed[core_model.MetaToResourceKey(res.GetMeta())] = res
+	}
+	return indexed
+}
@@ -0,0 +1,53 @@
+package record
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/recording"
+)
+
+func NewRecordCmd(pctx *cmd.RootContext) *cobra.Command {
+	var duration time.Duration
+	cmd := &cobra.Command{
+		Use:   "record DATAPLANE",
+		Short: "Record requests handled by a dataplane",
+		Long: `Record a sample of requests handled by HTTP inbounds or listeners of a gateway of a dataplane.
+
+The recording has to be enabled in the mesh. Requests are recorded with their headers and bodies up to the limits of
+the mesh and stored in the Control Plane, encrypted with a key that is generated by kumactl and never stored. Keep the
+printed key to replay the recording with kumactl replay.`,
+		Example: `kumactl record backend-01 --mesh default --duration 20s`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := pctx.CurrentRecordingClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a recording client")
+			}
+			key, err := recording.NewKey()
+			if err != nil {
+				return errors.Wrap(err, "failed to generate a key")
+			}
+			summary, err := client.Record(context.Background(), pctx.CurrentMesh(), args[0], types.RecordingRequest{
+				Key:      key,
+				Duration: duration.String(),
+			})
+			if err != nil {
+				return err
+			}
+			encodedKey := base64.StdEncoding.EncodeToString(key)
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "Recorded %d requests in %q\nKey: %s\nReplay the recording with:\n  kumactl replay %s --mesh %s --dataplane %s --key %s --target URL\n",
+				summary.Requests, summary.Name, encodedKey, summary.Name, summary.Mesh, summary.Dataplane, encodedKey)
+			return err
+		},
+	}
+	cmd.Flags().DurationVar(&duration, "duration", recording.DefaultDuration, fmt.Sprintf("duration of the recording, at most %s", recording.MaxDuration))
+	return cmd
+}
@@ -0,0 +1,11 @@
+package record_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestRecordCmd(t *testing.T) {
+	test.RunSpecs(t, "Record Cmd Suite")
+}
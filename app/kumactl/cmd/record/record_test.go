@@ -0,0 +1,91 @@
+package record_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/recording"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testRecordingClient struct {
+	receivedMesh      string
+	receivedDataplane string
+	receivedRequest   types.RecordingRequest
+}
+
+func (c *testRecordingClient) Record(_ context.Context, mesh string, dataplane string, request types.RecordingRequest) (*types.RecordingSummary, error) {
+	c.receivedMesh = mesh
+	c.receivedDataplane = dataplane
+	c.receivedRequest = request
+	return &types.RecordingSummary{
+		Name:      "recording-backend-01-1631000000",
+		Mesh:      mesh,
+		Dataplane: dataplane,
+		Requests:  12,
+	}, nil
+}
+
+func (c *testRecordingClient) Get(context.Context, string, string, string) (*types.EncryptedRecording, error) {
+	return nil, nil
+}
+
+var _ resources.RecordingClient = &testRecordingClient{}
+
+var _ = Describe("kumactl record", func() {
+
+	var rootCtx *kumactl_cmd.RootContext
+	var client *testRecordingClient
+
+	BeforeEach(func() {
+		var err error
+		rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+		rootCtx, err = test_kumactl.MakeRootContext(rootTime, memory_resources.NewStore())
+		Expect(err).ToNot(HaveOccurred())
+
+		client = &testRecordingClient{}
+		rootCtx.Runtime.NewRecordingClient = func(util_http.Client) resources.RecordingClient {
+			return client
+		}
+	})
+
+	It("should record requests with a new key", func() {
+		// given
+		stdout := &bytes.Buffer{}
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(stdout)
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"record", "backend-01", "--mesh", "demo", "--duration", "20s",
+		})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.receivedMesh).To(Equal("demo"))
+		Expect(client.receivedDataplane).To(Equal("backend-01"))
+		Expect(client.receivedRequest.Duration).To(Equal("20s"))
+		Expect(client.receivedRequest.Key).To(HaveLen(recording.KeySize))
+		// and
+		key := base64.StdEncoding.EncodeToString(client.receivedRequest.Key)
+		Expect(stdout.String()).To(Equal(`Recorded 12 requests in "recording-backend-01-1631000000"
+Key: ` + key + `
+Replay the recording with:
+  kumactl replay recording-backend-01-1631000000 --mesh demo --dataplane backend-01 --key ` + key + ` --target URL
+`))
+	})
+})
@@ -0,0 +1,154 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/recording"
+)
+
+// skippedHeaders are set by the HTTP client for every replayed request.
+var skippedHeaders = map[string]bool{
+	"connection":        true,
+	"content-length":    true,
+	"host":              true,
+	"keep-alive":        true,
+	"proxy-connection":  true,
+	"te":                true,
+	"transfer-encoding": true,
+	"upgrade":           true,
+}
+
+type replayArgs struct {
+	dataplane string
+	key       string
+	target    string
+	host      string
+	timeout   time.Duration
+}
+
+func NewReplayCmd(pctx *cmd.RootContext) *cobra.Command {
+	args := replayArgs{}
+	cmd := &cobra.Command{
+		Use:   "replay RECORDING",
+		Short: "Replay recorded requests",
+		Long: `Replay requests recorded with kumactl record against a service, for example a new version of the service
+that runs outside of production.
+
+Requests are sent one by one in the recorded order and the statuses of the responses are compared with the recorded
+ones. Requests whose body was truncated during the recording are skipped.`,
+		Example: `kumactl replay recording-backend-01-1631000000 --dataplane backend-01 --key KEY --target http://localhost:8080`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, cmdArgs []string) error {
+			key, err := base64.StdEncoding.DecodeString(args.key)
+			if err != nil {
+				return errors.Wrap(err, "key has to be base64 encoded")
+			}
+			target, err := url.Parse(args.target)
+			if err != nil || target.Scheme == "" || target.Host == "" {
+				return errors.Errorf("target has to be a URL like http://localhost:8080")
+			}
+
+			client, err := pctx.CurrentRecordingClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a recording client")
+			}
+			encrypted, err := client.Get(context.Background(), pctx.CurrentMesh(), args.dataplane, cmdArgs[0])
+			if err != nil {
+				return err
+			}
+			rec, err := recording.Open(key, encrypted.Data)
+			if err != nil {
+				return err
+			}
+
+			httpClient := &http.Client{
+				Timeout: args.timeout,
+				// redirects are a part of the replayed responses
+				CheckRedirect: func(*http.Request, []*http.Request) error {
+					return http.ErrUseLastResponse
+				},
+			}
+			results := make([]string, len(rec.Requests))
+			for i, request := range rec.Requests {
+				results[i] = replay(httpClient, target, args.host, request)
+			}
+			return printResults(rec, results, cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringVar(&args.dataplane, "dataplane", "", "name of the dataplane whose requests were recorded")
+	cmd.Flags().StringVar(&args.key, "key", "", "key printed by kumactl record")
+	cmd.Flags().StringVar(&args.target, "target", "", "URL of the service to replay the requests against")
+	cmd.Flags().StringVar(&args.host, "host", "", "Host header of the replayed requests, by default the recorded one")
+	cmd.Flags().DurationVar(&args.timeout, "timeout", 10*time.Second, "timeout of a replayed request")
+	_ = cmd.MarkFlagRequired("dataplane")
+	_ = cmd.MarkFlagRequired("key")
+	_ = cmd.MarkFlagRequired("target")
+	return cmd
+}
+
+// replay sends the request to the target and returns the status of the
+// response or the reason why it could not be replayed.
+func replay(client *http.Client, target *url.URL, host string, request types.RecordedRequest) string {
+	if request.BodyTruncated {
+		return "skipped: truncated body"
+	}
+	req, err := http.NewRequest(request.Method, strings.TrimSuffix(target.String(), "/")+request.Path, bytes.NewReader(request.Body))
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	for _, header := range request.Headers {
+		if skippedHeaders[strings.ToLower(header.Name)] {
+			continue
+		}
+		req.Header.Add(header.Name, header.Value)
+	}
+	req.Host = request.Authority
+	if host != "" {
+		req.Host = host
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return strconv.Itoa(resp.StatusCode)
+}
+
+func printResults(rec *types.Recording, results []string, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"METHOD", "PATH", "RECORDED", "REPLAYED"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(rec.Requests) <= i {
+					return nil
+				}
+				request := rec.Requests[i]
+				return []string{
+					request.Method,                        // METHOD
+					request.Path,                          // PATH
+					strconv.Itoa(request.Response.Status), // RECORDED
+					results[i],                            // REPLAYED
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
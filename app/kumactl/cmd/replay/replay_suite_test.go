@@ -0,0 +1,11 @@
+package replay_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestReplayCmd(t *testing.T) {
+	test.RunSpecs(t, "Replay Cmd Suite")
+}
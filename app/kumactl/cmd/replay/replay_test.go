@@ -0,0 +1,177 @@
+package replay_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/recording"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testRecordingClient struct {
+	recordings map[string]*types.EncryptedRecording
+}
+
+func (c *testRecordingClient) Record(context.Context, string, string, types.RecordingRequest) (*types.RecordingSummary, error) {
+	return nil, nil
+}
+
+func (c *testRecordingClient) Get(_ context.Context, _ string, dataplane string, name string) (*types.EncryptedRecording, error) {
+	return c.recordings[dataplane+"/"+name], nil
+}
+
+var _ resources.RecordingClient = &testRecordingClient{}
+
+type receivedRequest struct {
+	method string
+	path   string
+	host   string
+	header string
+	body   string
+}
+
+var _ = Describe("kumactl replay", func() {
+
+	var rootCtx *kumactl_cmd.RootContext
+	var server *httptest.Server
+	var received []receivedRequest
+	var key []byte
+
+	BeforeEach(func() {
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			body, _ := ioutil.ReadAll(req.Body)
+			received = append(received, receivedRequest{
+				method: req.Method,
+				path:   req.URL.RequestURI(),
+				host:   req.Host,
+				header: req.Header.Get("x-user"),
+				body:   string(body),
+			})
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		var err error
+		key, err = recording.NewKey()
+		Expect(err).ToNot(HaveOccurred())
+		data, err := recording.Seal(key, &types.Recording{
+			Name:      "recording-backend-01-1631000000",
+			Mesh:      "default",
+			Dataplane: "backend-01",
+			Requests: []types.RecordedRequest{
+				{
+					Method:    "GET",
+					Path:      "/orders?id=1",
+					Authority: "backend.mesh",
+					Headers: []types.RecordedHeader{
+						{Name: "x-user", Value: "alice"},
+						{Name: "content-length", Value: "0"},
+					},
+					Response: types.RecordedResponse{Status: 200},
+				},
+				{
+					Method:    "POST",
+					Path:      "/orders",
+					Authority: "backend.mesh",
+					Body:      []byte(`{"item": "book"}`),
+					Response:  types.RecordedResponse{Status: 500},
+				},
+				{
+					Method:        "POST",
+					Path:          "/uploads",
+					Authority:     "backend.mesh",
+					Body:          []byte("abc"),
+					BodyTruncated: true,
+					Response:      types.RecordedResponse{Status: 201},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+		rootCtx, err = test_kumactl.MakeRootContext(rootTime, memory_resources.NewStore())
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewRecordingClient = func(util_http.Client) resources.RecordingClient {
+			return &testRecordingClient{
+				recordings: map[string]*types.EncryptedRecording{
+					"backend-01/recording-backend-01-1631000000": {
+						Name: "recording-backend-01-1631000000",
+						Data: data,
+					},
+				},
+			}
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should replay recorded requests against the target", func() {
+		// given
+		stdout := &bytes.Buffer{}
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(stdout)
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"replay", "recording-backend-01-1631000000",
+			"--dataplane", "backend-01",
+			"--key", base64.StdEncoding.EncodeToString(key),
+			"--target", server.URL,
+		})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(received).To(Equal([]receivedRequest{
+			{method: "GET", path: "/orders?id=1", host: "backend.mesh", header: "alice"},
+			{method: "POST", path: "/orders", host: "backend.mesh", body: `{"item": "book"}`},
+		}))
+		// and
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		Expect(lines).To(HaveLen(4))
+		Expect(strings.Fields(lines[0])).To(Equal([]string{"METHOD", "PATH", "RECORDED", "REPLAYED"}))
+		Expect(strings.Fields(lines[1])).To(Equal([]string{"GET", "/orders?id=1", "200", "201"}))
+		Expect(strings.Fields(lines[2])).To(Equal([]string{"POST", "/orders", "500", "201"}))
+		Expect(lines[3]).To(ContainSubstring("skipped: truncated body"))
+	})
+
+	It("should fail with a wrong key", func() {
+		// given
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(&bytes.Buffer{})
+		rootCmd.SetErr(&bytes.Buffer{})
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"replay", "recording-backend-01-1631000000",
+			"--dataplane", "backend-01",
+			"--key", base64.StdEncoding.EncodeToString(make([]byte, recording.KeySize)),
+			"--target", server.URL,
+		})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not decrypt the recording")))
+		Expect(received).To(BeEmpty())
+	})
+})
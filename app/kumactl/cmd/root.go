@@ -13,8 +13,15 @@ import (
 	"github.com/kumahq/kuma/app/kumactl/cmd/get"
 	"github.com/kumahq/kuma/app/kumactl/cmd/inspect"
 	"github.com/kumahq/kuma/app/kumactl/cmd/install"
+	"github.com/kumahq/kuma/app/kumactl/cmd/loglevel"
+	"github.com/kumahq/kuma/app/kumactl/cmd/migrate"
+	"github.com/kumahq/kuma/app/kumactl/cmd/record"
+	"github.com/kumahq/kuma/app/kumactl/cmd/replay"
+	"github.com/kumahq/kuma/app/kumactl/cmd/tui"
 	"github.com/kumahq/kuma/app/kumactl/cmd/uninstall"
+	"github.com/kumahq/kuma/app/kumactl/cmd/validate"
 	"github.com/kumahq/kuma/app/kumactl/cmd/version"
+	"github.com/kumahq/kuma/app/kumactl/cmd/zone"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	kumactl_config "github.com/kumahq/kuma/app/kumactl/pkg/config"
 	kumactl_errors "github.com/kumahq/kuma/app/kumactl/pkg/errors"
@@ -87,8 +94,15 @@ func NewRootCmd(root *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.AddCommand(get.NewGetCmd(root))
 	cmd.AddCommand(inspect.NewInspectCmd(root))
 	cmd.AddCommand(install.NewInstallCmd(root))
+	cmd.AddCommand(loglevel.NewLogLevelCmd(root))
+	cmd.AddCommand(migrate.NewMigrateCmd(root))
+	cmd.AddCommand(record.NewRecordCmd(root))
+	cmd.AddCommand(replay.NewReplayCmd(root))
+	cmd.AddCommand(tui.NewTUICmd(root))
 	cmd.AddCommand(uninstall.NewUninstallCmd())
+	cmd.AddCommand(validate.NewValidateCmd(root))
 	cmd.AddCommand(version.NewCmd(root))
+	cmd.AddCommand(zone.NewZoneCmd(root))
 
 	kumactl_cmd.WrapRunnables(cmd, kumactl_errors.FormatErrorWrapper)
 	return cmd
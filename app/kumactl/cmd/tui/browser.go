@@ -0,0 +1,379 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_system "github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+const (
+	clearScreen    = "\033[H\033[2J"
+	enterAltScreen = "\033[?1049h\033[?25l"
+	leaveAltScreen = "\033[?25h\033[?1049l"
+)
+
+type view int
+
+const (
+	meshesView view = iota
+	dataplanesView
+	policiesView
+	gatewayRoutesView
+)
+
+var views = []struct {
+	title   string
+	headers []string
+}{
+	meshesView:        {title: "Meshes", headers: []string{"NAME", "MTLS", "AGE"}},
+	dataplanesView:    {title: "Dataplanes", headers: []string{"NAME", "STATUS", "KUMA-DP VERSION", "ENVOY VERSION", "LAST CONNECTED AGO"}},
+	policiesView:      {title: "Policies", headers: []string{"TYPE", "NAME", "AGE"}},
+	gatewayRoutesView: {title: "Gateway Routes", headers: []string{"NAME", "HOSTNAMES", "AGE"}},
+}
+
+type row struct {
+	cells    []string
+	resource model.Resource
+}
+
+// browser keeps the state of the terminal UI. Only the current view is fetched on every refresh.
+type browser struct {
+	store          core_store.ResourceStore
+	overviewClient kumactl_resources.DataplaneOverviewClient
+	registry       registry.TypeRegistry
+	now            func() time.Time
+
+	mesh     string
+	view     view
+	selected int
+	details  bool
+
+	rows        []row
+	err         error
+	refreshedAt time.Time
+}
+
+func newBrowser(
+	store core_store.ResourceStore,
+	overviewClient kumactl_resources.DataplaneOverviewClient,
+	registry registry.TypeRegistry,
+	now func() time.Time,
+	mesh string,
+) *browser {
+	return &browser{
+		store:          store,
+		overviewClient: overviewClient,
+		registry:       registry,
+		now:            now,
+		mesh:           mesh,
+	}
+}
+
+func (b *browser) run(ctx context.Context, in io.Reader, out io.Writer, refreshInterval time.Duration, screen bool) error {
+	done := make(chan struct{})
+	defer close(done)
+	keys := make(chan key)
+	go readKeys(in, keys, done)
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	if screen {
+		if _, err := fmt.Fprint(out, enterAltScreen); err != nil {
+			return err
+		}
+		defer fmt.Fprint(out, leaveAltScreen)
+	}
+
+	b.refresh(ctx)
+	for {
+		if _, err := fmt.Fprint(out, clearScreen); err != nil {
+			return err
+		}
+		if err := b.render(out); err != nil {
+			return err
+		}
+		select {
+		case k, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			quit, refresh := b.handle(k)
+			if quit {
+				return nil
+			}
+			if refresh {
+				b.refresh(ctx)
+			}
+		case <-ticker.C:
+			b.refresh(ctx)
+		}
+	}
+}
+
+// handle applies the key to the state and reports whether the UI should quit or fetch the resources again.
+func (b *browser) handle(k key) (quit bool, refresh bool) {
+	switch k {
+	case keyQuit:
+		return true, false
+	case keyNextView:
+		return false, b.switchView((b.view + 1) % view(len(views)))
+	case keyPrevView:
+		return false, b.switchView((b.view + view(len(views)) - 1) % view(len(views)))
+	case keyView1, keyView2, keyView3, keyView4:
+		return false, b.switchView(view(k - keyView1))
+	case keyUp:
+		if !b.details && b.selected > 0 {
+			b.selected--
+		}
+	case keyDown:
+		if !b.details && b.selected < len(b.rows)-1 {
+			b.selected++
+		}
+	case keyEnter:
+		if b.err != nil || len(b.rows) == 0 {
+			return false, false
+		}
+		if b.view == meshesView {
+			b.mesh = b.rows[b.selected].resource.GetMeta().GetName()
+			return false, b.switchView(dataplanesView)
+		}
+		b.details = !b.details
+	case keyBack:
+		b.details = false
+	case keyRefresh:
+		return false, true
+	}
+	return false, false
+}
+
+func (b *browser) switchView(v view) bool {
+	b.view = v
+	b.selected = 0
+	b.details = false
+	b.rows = nil
+	return true
+}
+
+func (b *browser) refresh(ctx context.Context) {
+	b.rows, b.err = b.fetch(ctx)
+	b.refreshedAt = b.now()
+	if b.selected >= len(b.rows) {
+		b.selected = 0
+		b.details = false
+	}
+}
+
+func (b *browser) fetch(ctx context.Context) ([]row, error) {
+	switch b.view {
+	case meshesView:
+		return b.fetchMeshes(ctx)
+	case dataplanesView:
+		return b.fetchDataplanes(ctx)
+	case policiesView:
+		return b.fetchPolicies(ctx)
+	case gatewayRoutesView:
+		return b.fetchGatewayRoutes(ctx)
+	}
+	return nil, errors.Errorf("unknown view %d", b.view)
+}
+
+func (b *browser) fetchMeshes(ctx context.Context) ([]row, error) {
+	meshes := core_mesh.MeshResourceList{}
+	if err := b.store.List(ctx, &meshes); err != nil {
+		return nil, errors.Wrap(err, "failed to list Meshes")
+	}
+	var rows []row
+	for _, mesh := range meshes.Items {
+		mtls := "off"
+		if mesh.MTLSEnabled() {
+			mtls = mesh.Spec.GetMtls().GetEnabledBackend()
+		}
+		rows = append(rows, row{
+			cells: []string{
+				mesh.GetMeta().GetName(), // NAME
+				mtls,                     // MTLS
+				table.TimeSince(mesh.GetMeta().GetCreationTime(), b.now()), // AGE
+			},
+			resource: mesh,
+		})
+	}
+	return rows, nil
+}
+
+func (b *browser) fetchDataplanes(ctx context.Context) ([]row, error) {
+	overviews, err := b.overviewClient.List(ctx, b.mesh, nil, false, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list Dataplanes")
+	}
+	var rows []row
+	for _, overview := range overviews.Items {
+		lastSubscription, lastConnected := overview.Spec.GetDataplaneInsight().GetLatestSubscription()
+		status, _ := overview.GetStatus()
+		rows = append(rows, row{
+			cells: []string{
+				overview.GetMeta().GetName(), // NAME
+				status.String(),              // STATUS
+				lastSubscription.GetVersion().GetKumaDp().GetVersion(), // KUMA-DP VERSION
+				lastSubscription.GetVersion().GetEnvoy().GetVersion(),  // ENVOY VERSION
+				table.Ago(lastConnected, b.now()),                      // LAST CONNECTED AGO
+			},
+			resource: overview,
+		})
+	}
+	return rows, nil
+}
+
+func (b *browser) fetchPolicies(ctx context.Context) ([]row, error) {
+	descriptors := b.registry.ObjectDescriptors(
+		model.HasKumactlEnabled(),
+		model.HasScope(model.ScopeMesh),
+		model.Not(model.Named(
+			core_mesh.DataplaneType,
+			core_mesh.DataplaneInsightType,
+			core_mesh.DataplaneOverviewType,
+			core_mesh.GatewayRouteType,
+			core_system.SecretType,
+		)),
+		model.TypeFilterFn(func(descriptor model.ResourceTypeDescriptor) bool {
+			return !descriptor.ReadOnly
+		}),
+	)
+	sort.Slice(descriptors, func(i, j int) bool {
+		return descriptors[i].Name < descriptors[j].Name
+	})
+	var rows []row
+	for _, descriptor := range descriptors {
+		list := descriptor.NewList()
+		if err := b.store.List(ctx, list, core_store.ListByMesh(b.mesh)); err != nil {
+			return nil, errors.Wrapf(err, "failed to list %s", descriptor.Name)
+		}
+		for _, policy := range list.GetItems() {
+			rows = append(rows, row{
+				cells: []string{
+					string(descriptor.Name),                                      // TYPE
+					policy.GetMeta().GetName(),                                   // NAME
+					table.TimeSince(policy.GetMeta().GetCreationTime(), b.now()), // AGE
+				},
+				resource: policy,
+			})
+		}
+	}
+	return rows, nil
+}
+
+func (b *browser) fetchGatewayRoutes(ctx context.Context) ([]row, error) {
+	descriptor, err := b.registry.DescriptorFor(core_mesh.GatewayRouteType)
+	if err != nil {
+		return nil, errors.New("GatewayRoutes are not supported by this build of kumactl")
+	}
+	list := descriptor.NewList()
+	if err := b.store.List(ctx, list, core_store.ListByMesh(b.mesh)); err != nil {
+		return nil, errors.Wrap(err, "failed to list GatewayRoutes")
+	}
+	var rows []row
+	for _, route := range list.GetItems() {
+		rows = append(rows, row{
+			cells: []string{
+				route.GetMeta().GetName(),                                   // NAME
+				strings.Join(gatewayRouteHostnames(route), ","),             // HOSTNAMES
+				table.TimeSince(route.GetMeta().GetCreationTime(), b.now()), // AGE
+			},
+			resource: route,
+		})
+	}
+	return rows, nil
+}
+
+func gatewayRouteHostnames(route model.Resource) []string {
+	conf := route.(*core_mesh.GatewayRouteResource).Spec.GetConf()
+	if http := conf.GetHttp(); http != nil {
+		return http.GetHostnames()
+	}
+	return conf.GetTls().GetHostnames()
+}
+
+func (b *browser) render(out io.Writer) error {
+	refreshedAt := b.refreshedAt
+	if _, err := fmt.Fprintf(out, "Mesh: %s    Refreshed: %s\n\n", b.mesh, table.Date(&refreshedAt)); err != nil {
+		return err
+	}
+
+	var tabs []string
+	for i, v := range views {
+		if view(i) == b.view {
+			tabs = append(tabs, fmt.Sprintf("[%d %s]", i+1, v.title))
+		} else {
+			tabs = append(tabs, fmt.Sprintf("%d %s", i+1, v.title))
+		}
+	}
+	if _, err := fmt.Fprintf(out, "%s\n\n", strings.Join(tabs, "   ")); err != nil {
+		return err
+	}
+
+	switch {
+	case b.err != nil:
+		if _, err := fmt.Fprintf(out, "Error: %s\n", b.err); err != nil {
+			return err
+		}
+	case b.details:
+		printer, err := printers.NewGenericPrinter(output.YAMLFormat)
+		if err != nil {
+			return err
+		}
+		if err := printer.Print(rest_types.From.Resource(b.rows[b.selected].resource), out); err != nil {
+			return err
+		}
+	default:
+		if err := b.renderTable(out); err != nil {
+			return err
+		}
+	}
+
+	help := "q: quit  1-4/tab: switch view  j/k: move  enter: select  r: refresh"
+	if b.details {
+		help = "q: quit  esc: close details  r: refresh"
+	}
+	_, err := fmt.Fprintf(out, "\n%s\n", help)
+	return err
+}
+
+func (b *browser) renderTable(out io.Writer) error {
+	data := printers.Table{
+		Headers: append([]string{""}, views[b.view].headers...),
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(b.rows) <= i {
+					return nil
+				}
+				marker := ""
+				if i == b.selected {
+					marker = ">"
+				}
+				return append([]string{marker}, b.rows[i].cells...)
+			}
+		}(),
+	}
+	if len(b.rows) == 0 {
+		data.Footer = "No resources found"
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -0,0 +1,100 @@
+package tui
+
+import (
+	"io"
+)
+
+type key int
+
+const (
+	keyUnknown key = iota
+	keyQuit
+	keyNextView
+	keyPrevView
+	keyUp
+	keyDown
+	keyEnter
+	keyBack
+	keyRefresh
+	keyView1
+	keyView2
+	keyView3
+	keyView4
+)
+
+const (
+	esc   = 0x1b
+	ctrlC = 0x03
+)
+
+// decodeKeys translates a chunk of terminal input into keys.
+// Escape sequences of arrows are expected to arrive in a single chunk, which is how terminals deliver them.
+func decodeKeys(input []byte) []key {
+	var keys []key
+	for i := 0; i < len(input); i++ {
+		switch c := input[i]; c {
+		case esc:
+			if i+2 < len(input) && input[i+1] == '[' {
+				switch input[i+2] {
+				case 'A':
+					keys = append(keys, keyUp)
+				case 'B':
+					keys = append(keys, keyDown)
+				case 'C':
+					keys = append(keys, keyNextView)
+				case 'D':
+					keys = append(keys, keyPrevView)
+				case 'Z':
+					keys = append(keys, keyPrevView)
+				default:
+					keys = append(keys, keyUnknown)
+				}
+				i += 2
+			} else {
+				keys = append(keys, keyBack)
+			}
+		case 'q', ctrlC:
+			keys = append(keys, keyQuit)
+		case '\t', 'l':
+			keys = append(keys, keyNextView)
+		case 'h':
+			keys = append(keys, keyPrevView)
+		case 'k':
+			keys = append(keys, keyUp)
+		case 'j':
+			keys = append(keys, keyDown)
+		case '\r', '\n':
+			keys = append(keys, keyEnter)
+		case 'r':
+			keys = append(keys, keyRefresh)
+		case '1':
+			keys = append(keys, keyView1)
+		case '2':
+			keys = append(keys, keyView2)
+		case '3':
+			keys = append(keys, keyView3)
+		case '4':
+			keys = append(keys, keyView4)
+		}
+	}
+	return keys
+}
+
+// readKeys delivers keys read from the input until the input is closed or done is closed.
+func readKeys(in io.Reader, keys chan<- key, done <-chan struct{}) {
+	defer close(keys)
+	buf := make([]byte, 64)
+	for {
+		n, err := in.Read(buf)
+		for _, k := range decodeKeys(buf[:n]) {
+			select {
+			case keys <- k:
+			case <-done:
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
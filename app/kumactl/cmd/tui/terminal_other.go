@@ -0,0 +1,12 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package tui
+
+import (
+	"github.com/pkg/errors"
+)
+
+func makeRaw(int) (func(), error) {
+	return nil, errors.New("raw terminal mode is not supported on this platform")
+}
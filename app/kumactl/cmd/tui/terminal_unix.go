@@ -0,0 +1,29 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package tui
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// makeRaw switches the terminal to a mode in which every key press is delivered immediately and is not echoed.
+// Output processing stays enabled so new lines are still rendered as expected.
+func makeRaw(fd int) (func(), error) {
+	termios, err := unix.IoctlGetTermios(fd, ioctlGetTermios)
+	if err != nil {
+		return nil, err
+	}
+	previous := *termios
+
+	termios.Lflag &^= unix.ECHO | unix.ICANON | unix.ISIG | unix.IEXTEN
+	termios.Iflag &^= unix.ICRNL | unix.IXON
+	termios.Cc[unix.VMIN] = 1
+	termios.Cc[unix.VTIME] = 0
+	if err := unix.IoctlSetTermios(fd, ioctlSetTermios, termios); err != nil {
+		return nil, err
+	}
+	return func() {
+		_ = unix.IoctlSetTermios(fd, ioctlSetTermios, &previous)
+	}, nil
+}
@@ -0,0 +1,64 @@
+package tui
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+type tuiContext struct {
+	args struct {
+		refreshInterval time.Duration
+	}
+}
+
+func NewTUICmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := tuiContext{}
+	cmd := &cobra.Command{
+		Use:   "tui",
+		Short: "Browse Kuma resources in an interactive terminal UI",
+		Long: `Browse Meshes, Dataplanes, policies and GatewayRoutes in an interactive terminal UI.
+
+The resources are refreshed periodically. Keys:
+
+  1-4, tab, shift+tab, h, l   switch the view
+  j, k, up, down              move the selection
+  enter                       select the Mesh or show the details of the resource
+  esc                         close the details
+  r                           refresh now
+  q, ctrl+c                   quit`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if ctx.args.refreshInterval <= 0 {
+				return errors.New("--refresh-interval must be positive")
+			}
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			overviewClient, err := pctx.CurrentDataplaneOverviewClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a dataplane client")
+			}
+
+			b := newBrowser(rs, overviewClient, pctx.Runtime.Registry, pctx.Now, pctx.CurrentMesh())
+
+			in := cmd.InOrStdin()
+			screen := false
+			if f, ok := in.(*os.File); ok {
+				// when the terminal can't be switched to raw mode keys are delivered after enter is pressed
+				if restore, err := makeRaw(int(f.Fd())); err == nil {
+					defer restore()
+					screen = true
+				}
+			}
+			return b.run(context.Background(), in, cmd.OutOrStdout(), ctx.args.refreshInterval, screen)
+		},
+	}
+	cmd.PersistentFlags().DurationVar(&ctx.args.refreshInterval, "refresh-interval", 5*time.Second, "how often the resources are refreshed")
+	return cmd
+}
@@ -0,0 +1,11 @@
+package tui_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestTUICmd(t *testing.T) {
+	test.RunSpecs(t, "TUI Cmd Suite")
+}
@@ -0,0 +1,226 @@
+package tui_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testDataplaneOverviewClient struct {
+	receivedMesh string
+	overviews    []*core_mesh.DataplaneOverviewResource
+}
+
+func (c *testDataplaneOverviewClient) List(_ context.Context, meshName string, _ map[string]string, _ bool, _ bool) (*core_mesh.DataplaneOverviewResourceList, error) {
+	c.receivedMesh = meshName
+	return &core_mesh.DataplaneOverviewResourceList{
+		Items: c.overviews,
+	}, nil
+}
+
+var _ resources.DataplaneOverviewClient = &testDataplaneOverviewClient{}
+
+var _ = Describe("kumactl tui", func() {
+
+	now, _ := time.Parse(time.RFC3339, "2019-07-17T18:08:41Z")
+
+	var store core_store.ResourceStore
+	var overviewClient *testDataplaneOverviewClient
+	var buf *bytes.Buffer
+
+	create := func(resource model.Resource, mesh, name string, creationTime time.Time) {
+		err := store.Create(context.Background(), resource, core_store.CreateByKey(name, mesh), core_store.CreatedAt(creationTime))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		time.Local = time.UTC
+		store = memory_resources.NewStore()
+
+		create(&core_mesh.MeshResource{Spec: &mesh_proto.Mesh{}}, model.NoMesh, "default", now.Add(-2*time.Hour))
+		create(&core_mesh.MeshResource{
+			Spec: &mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{
+					EnabledBackend: "ca-1",
+					Backends: []*mesh_proto.CertificateAuthorityBackend{
+						{Name: "ca-1", Type: "builtin"},
+					},
+				},
+			},
+		}, model.NoMesh, "mesh-1", now.Add(-7*24*time.Hour))
+
+		selectors := []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "*"}}}
+		create(&core_mesh.TrafficPermissionResource{
+			Spec: &mesh_proto.TrafficPermission{
+				Sources:      []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "web"}}},
+				Destinations: []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "backend"}}},
+			},
+		}, "default", "web-to-backend", now.Add(-2*time.Hour))
+		create(&core_mesh.TrafficRouteResource{
+			Spec: &mesh_proto.TrafficRoute{
+				Sources:      selectors,
+				Destinations: selectors,
+				Conf: &mesh_proto.TrafficRoute_Conf{
+					Destination: map[string]string{mesh_proto.ServiceTag: "*"},
+				},
+			},
+		}, "default", "route-all-default", now.Add(-5*time.Minute))
+		create(&core_mesh.TrafficRouteResource{
+			Spec: &mesh_proto.TrafficRoute{Sources: selectors, Destinations: selectors},
+		}, "mesh-1", "route-all-mesh-1", now.Add(-5*time.Minute))
+		create(&core_mesh.GatewayRouteResource{
+			Spec: &mesh_proto.GatewayRoute{
+				Selectors: []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "edge-gateway"}}},
+				Conf: &mesh_proto.GatewayRoute_Conf{
+					Route: &mesh_proto.GatewayRoute_Conf_Http{
+						Http: &mesh_proto.GatewayRoute_HttpRoute{
+							Hostnames: []string{"example.com", "*.example.com"},
+						},
+					},
+				},
+			},
+		}, "default", "edge-route", now.Add(-90*time.Second))
+
+		inbound := &mesh_proto.Dataplane_Networking{
+			Address: "127.0.0.1",
+			Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+				Port: 8080,
+				Tags: map[string]string{mesh_proto.ServiceTag: "backend"},
+			}},
+		}
+		overviewClient = &testDataplaneOverviewClient{
+			overviews: []*core_mesh.DataplaneOverviewResource{
+				{
+					Meta: &test_model.ResourceMeta{Mesh: "mesh-1", Name: "backend-1"},
+					Spec: &mesh_proto.DataplaneOverview{
+						Dataplane: &mesh_proto.Dataplane{Networking: inbound},
+						DataplaneInsight: &mesh_proto.DataplaneInsight{
+							Subscriptions: []*mesh_proto.DiscoverySubscription{{
+								Id:          "1",
+								ConnectTime: timestamppb.New(now.Add(-30 * time.Second)),
+								Version: &mesh_proto.Version{
+									KumaDp: &mesh_proto.KumaDpVersion{Version: "1.3.1"},
+									Envoy:  &mesh_proto.EnvoyVersion{Version: "1.19.1"},
+								},
+							}},
+						},
+					},
+				},
+				{
+					Meta: &test_model.ResourceMeta{Mesh: "mesh-1", Name: "backend-2"},
+					Spec: &mesh_proto.DataplaneOverview{
+						Dataplane:        &mesh_proto.Dataplane{Networking: inbound},
+						DataplaneInsight: &mesh_proto.DataplaneInsight{},
+					},
+				},
+			},
+		}
+		buf = &bytes.Buffer{}
+	})
+
+	run := func(rootCtx *kumactl_cmd.RootContext, keys string) []string {
+		rootCtx.Runtime.NewDataplaneOverviewClient = func(util_http.Client) resources.DataplaneOverviewClient {
+			return overviewClient
+		}
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetIn(strings.NewReader(keys))
+		rootCmd.SetOut(buf)
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"tui",
+		})
+
+		err := rootCmd.Execute()
+
+		Expect(err).ToNot(HaveOccurred())
+		return strings.Split(buf.String(), "\033[H\033[2J")
+	}
+
+	type testCase struct {
+		keys       string
+		goldenFile string
+	}
+
+	DescribeTable("should render the view selected with the keys",
+		func(given testCase) {
+			// given
+			rootCtx, err := test_kumactl.MakeRootContext(now, store,
+				core_mesh.MeshResourceTypeDescriptor,
+				core_mesh.TrafficPermissionResourceTypeDescriptor,
+				core_mesh.TrafficRouteResourceTypeDescriptor,
+				core_mesh.GatewayRouteResourceTypeDescriptor,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			frames := run(rootCtx, given.keys)
+
+			// then
+			Expect(frames[len(frames)-1]).To(matchers.MatchGoldenEqual(filepath.Join("testdata", given.goldenFile)))
+		},
+		Entry("meshes", testCase{
+			keys:       "q",
+			goldenFile: "tui-meshes.golden.txt",
+		}),
+		Entry("dataplanes of the mesh selected with arrows", testCase{
+			keys:       "\033[B\rq",
+			goldenFile: "tui-dataplanes.golden.txt",
+		}),
+		Entry("policies", testCase{
+			keys:       "3jq",
+			goldenFile: "tui-policies.golden.txt",
+		}),
+		Entry("gateway routes", testCase{
+			keys:       "\t\t\tq",
+			goldenFile: "tui-gateway-routes.golden.txt",
+		}),
+		Entry("details of the selected policy", testCase{
+			keys:       "3\rq",
+			goldenFile: "tui-policy-details.golden.txt",
+		}),
+	)
+
+	It("should select the mesh of the dataplanes", func() {
+		// given
+		rootCtx, err := test_kumactl.MakeRootContext(now, store, core_mesh.MeshResourceTypeDescriptor)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		run(rootCtx, "j\rq")
+
+		// then
+		Expect(overviewClient.receivedMesh).To(Equal("mesh-1"))
+	})
+
+	It("should report that gateway routes are not supported", func() {
+		// given
+		rootCtx, err := test_kumactl.MakeRootContext(now, store, core_mesh.MeshResourceTypeDescriptor)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		frames := run(rootCtx, "4q")
+
+		// then
+		Expect(frames[len(frames)-1]).To(ContainSubstring("Error: GatewayRoutes are not supported by this build of kumactl"))
+	})
+})
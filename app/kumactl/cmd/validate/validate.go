@@ -0,0 +1,173 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// metaFields are the fields of a resource that are not a part of its spec.
+var metaFields = []string{"type", "mesh", "name", "creationTime", "modificationTime"}
+
+func NewValidateCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	var file string
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate Kuma resources offline",
+		Long: `Validate Kuma resources without connecting to the Control Plane.
+
+Every resource is checked against the schema of its type, which is compiled into kumactl, so unknown fields and values
+of a wrong type are rejected. Then the same semantic checks as in the Control Plane are applied, ex. a rule of
+a GatewayRoute that both redirects and forwards requests to backends.
+When a directory is given, all .yaml, .yml and .json files in it are validated recursively.`,
+		Example: `
+Validate resources in a file
+$ kumactl validate -f resource.yaml
+
+Validate all resources in a directory, for example in a pre-commit hook
+$ kumactl validate --no-config -f ./kuma/
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			sources, err := readSources(file, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			total, invalid := 0, 0
+			for _, src := range sources {
+				for i, doc := range splitDocuments(src.content) {
+					total++
+					if err := validateResource([]byte(doc)); err != nil {
+						invalid++
+						location := src.name
+						if len(src.content) != len(doc) {
+							location = fmt.Sprintf("%s#%d", src.name, i+1)
+						}
+						fmt.Fprintf(cmd.OutOrStdout(), "%s: %s\n", location, formatError(err))
+					}
+				}
+			}
+			if total == 0 {
+				return errors.New("no resource(s) passed to validate")
+			}
+			if invalid > 0 {
+				return errors.Errorf("%d of %d resource(s) are invalid", invalid, total)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%d resource(s) are valid\n", total)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Path to a file or a directory to validate. Pass `-` to read from stdin")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+type source struct {
+	name    string
+	content string
+}
+
+func readSources(path string, stdin io.Reader) ([]source, error) {
+	if path == "-" {
+		b, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			return nil, err
+		}
+		return []source{{name: "stdin", content: string(b)}}, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while reading provided path")
+	}
+	if !info.IsDir() {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "error while reading provided file")
+		}
+		return []source{{name: path, content: string(b)}}, nil
+	}
+	var sources []source
+	err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		switch filepath.Ext(p) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			return errors.Wrapf(err, "error while reading file %s", p)
+		}
+		sources = append(sources, source{name: p, content: string(b)})
+		return nil
+	})
+	return sources, err
+}
+
+// splitDocuments splits the content into resources the same way kumactl apply does.
+func splitDocuments(content string) []string {
+	var docs []string
+	for _, doc := range strings.Split(content, "---") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+func validateResource(bytes []byte) error {
+	res, err := rest_types.UnmarshallToCore(bytes)
+	if err != nil {
+		return errors.Wrap(err, "YAML contains invalid resource")
+	}
+	if err := validateSchema(bytes, res); err != nil {
+		return errors.Wrapf(err, "invalid %s object %q", res.Descriptor().Name, res.GetMeta().GetName())
+	}
+	if err := mesh.ValidateMeta(res.GetMeta().GetName(), res.GetMeta().GetMesh(), res.Descriptor().Scope); err.HasViolations() {
+		return errors.Wrapf(err.OrNil(), "invalid %s object %q", res.Descriptor().Name, res.GetMeta().GetName())
+	}
+	if err := res.Validate(); err != nil {
+		return errors.Wrapf(err, "invalid %s object %q", res.Descriptor().Name, res.GetMeta().GetName())
+	}
+	return nil
+}
+
+// validateSchema checks the spec of the resource strictly, unlike the Control Plane which ignores unknown fields.
+func validateSchema(bytes []byte, res model.Resource) error {
+	raw := map[string]interface{}{}
+	if err := yaml.Unmarshal(bytes, &raw); err != nil {
+		return err
+	}
+	for _, field := range metaFields {
+		delete(raw, field)
+	}
+	spec, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return util_proto.FromYAMLStrict(spec, res.Descriptor().NewObject().GetSpec())
+}
+
+func formatError(err error) string {
+	return strings.ReplaceAll(err.Error(), "\n", "\n  ")
+}
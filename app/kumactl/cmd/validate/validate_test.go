@@ -0,0 +1,86 @@
+package validate_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+)
+
+var _ = Describe("kumactl validate", func() {
+
+	var rootCmd *cobra.Command
+	var stdout *bytes.Buffer
+
+	BeforeEach(func() {
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				Registry: registry.Global(),
+			},
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		stdout = &bytes.Buffer{}
+		rootCmd.SetOut(stdout)
+	})
+
+	It("should accept valid resources in a directory", func() {
+		// given
+		rootCmd.SetArgs([]string{"--no-config", "validate", "-f", filepath.Join("testdata", "valid")})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout.String()).To(Equal("3 resource(s) are valid\n"))
+	})
+
+	It("should accept resources from stdin", func() {
+		// given
+		rootCmd.SetIn(strings.NewReader("type: Mesh\nname: default\n"))
+		rootCmd.SetArgs([]string{"--no-config", "validate", "-f", "-"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout.String()).To(Equal("1 resource(s) are valid\n"))
+	})
+
+	It("should report every invalid resource", func() {
+		// given
+		rootCmd.SetArgs([]string{"--no-config", "validate", "-f", filepath.Join("testdata", "invalid")})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("4 of 4 resource(s) are invalid"))
+		Expect(stdout.String()).To(ContainSubstring(`invalid-name.yaml: invalid Mesh object "Not_A_Valid_Name"`))
+		Expect(stdout.String()).To(ContainSubstring(`redirect-and-backends.yaml: invalid GatewayRoute object "redirect"`))
+		Expect(stdout.String()).To(ContainSubstring("must be empty when using redirect filters"))
+		Expect(stdout.String()).To(ContainSubstring(`unknown-field.yaml: invalid Mesh object "default"`))
+		Expect(stdout.String()).To(ContainSubstring(`unknown field "enabledBackends"`))
+		Expect(stdout.String()).To(ContainSubstring("unknown-type.yaml: YAML contains invalid resource"))
+	})
+
+	It("should fail when there are no resources", func() {
+		// given
+		rootCmd.SetIn(strings.NewReader(""))
+		rootCmd.SetArgs([]string{"--no-config", "validate", "-f", "-"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("no resource(s) passed to validate"))
+	})
+})
@@ -0,0 +1,45 @@
+package zone
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+func NewZoneCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "zone",
+		Short: "Manage zones of a multizone deployment",
+		Long:  `Manage zones of a multizone deployment on the Global Control Plane.`,
+	}
+	// sub-commands
+	cmd.AddCommand(newDisableCmd(pctx))
+	cmd.AddCommand(newEnableCmd(pctx))
+	return cmd
+}
+
+// updateZone applies the change to the Zone of the given name on the active Control Plane.
+func updateZone(pctx *kumactl_cmd.RootContext, name string, change func(zone *system.ZoneResource)) error {
+	rs, err := pctx.CurrentResourceStore()
+	if err != nil {
+		return err
+	}
+	zone := system.NewZoneResource()
+	if err := rs.Get(context.Background(), zone, store.GetByKey(name, model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return errors.Errorf("there is no %s with name %q", system.ZoneType, name)
+		}
+		return errors.Wrapf(err, "failed to get %s with the name %q", system.ZoneType, name)
+	}
+	change(zone)
+	if err := rs.Update(context.Background(), zone); err != nil {
+		return errors.Wrapf(err, "failed to update %s with the name %q", system.ZoneType, name)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package zone
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+)
+
+func newDisableCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := struct {
+		drain       bool
+		drainPeriod time.Duration
+	}{}
+	cmd := &cobra.Command{
+		Use:   "disable NAME",
+		Short: "Disable a zone",
+		Long: `Disable a zone.
+
+Without --drain, endpoints of the zone are immediately removed from other zones.
+
+With --drain, the zone is drained over the drain period, so it can be evacuated for maintenance.
+Zone Ingresses of the zone advertise progressively fewer instances of services, so other zones
+send less and less traffic to the zone. Once the drain period is over, Zone Ingresses of the zone
+advertise no services and are no longer synced to other zones.
+
+Run "kumactl zone enable" to bring the zone back.`,
+		Example: `
+Immediately remove endpoints of the zone "zone-1" from other zones
+$ kumactl zone disable zone-1
+
+Drain the zone "zone-1" over 10 minutes
+$ kumactl zone disable zone-1 --drain --drain-period 10m
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, cmdArgs []string) error {
+			if err := pctx.CheckServerVersionCompatibility(); err != nil {
+				cmd.PrintErrln(err)
+			}
+			name := cmdArgs[0]
+			alreadyDraining := false
+			err := updateZone(pctx, name, func(zone *system.ZoneResource) {
+				if !args.drain {
+					zone.Spec.Enabled = wrapperspb.Bool(false)
+					return
+				}
+				if zone.Spec.IsDraining() {
+					alreadyDraining = true
+					return
+				}
+				zone.Spec.Drain = &system_proto.Zone_Drain{
+					StartTime: timestamppb.New(core.Now()),
+					Period:    durationpb.New(args.drainPeriod),
+				}
+			})
+			if err != nil {
+				return err
+			}
+			switch {
+			case !args.drain:
+				cmd.Printf("disabled %s %q\n", system.ZoneType, name)
+			case alreadyDraining:
+				cmd.Printf("%s %q is already draining\n", system.ZoneType, name)
+			default:
+				cmd.Printf("draining %s %q over %s\n", system.ZoneType, name, args.drainPeriod)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&args.drain, "drain", false, "progressively remove endpoints of the zone from other zones over the drain period instead of disabling the zone immediately")
+	cmd.Flags().DurationVar(&args.drainPeriod, "drain-period", 5*time.Minute, "period over which endpoints of the zone are removed from other zones")
+	return cmd
+}
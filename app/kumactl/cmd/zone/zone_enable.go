@@ -0,0 +1,34 @@
+package zone
+
+import (
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+)
+
+func newEnableCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable NAME",
+		Short: "Enable a zone",
+		Long:  `Enable a zone that was disabled or drained, so its endpoints are available to other zones again.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := pctx.CheckServerVersionCompatibility(); err != nil {
+				cmd.PrintErrln(err)
+			}
+			name := args[0]
+			err := updateZone(pctx, name, func(zone *system.ZoneResource) {
+				zone.Spec.Enabled = wrapperspb.Bool(true)
+				zone.Spec.Drain = nil
+			})
+			if err != nil {
+				return err
+			}
+			cmd.Printf("enabled %s %q\n", system.ZoneType, name)
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,11 @@
+package zone_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestZoneCmd(t *testing.T) {
+	test.RunSpecs(t, "Zone Cmd Suite")
+}
@@ -0,0 +1,145 @@
+package zone_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/util/test"
+)
+
+var _ = Describe("kumactl zone", func() {
+
+	now, _ := time.Parse(time.RFC3339, "2021-07-13T08:40:00Z")
+
+	var rootCmd *cobra.Command
+	var outbuf *bytes.Buffer
+	var store core_store.ResourceStore
+
+	BeforeEach(func() {
+		core.Now = func() time.Time {
+			return now
+		}
+
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.NewAPIServerClient = test.GetMockNewAPIServerClient()
+		rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+			return store
+		}
+		store = memory_resources.NewStore()
+
+		err := store.Create(context.Background(), &system.ZoneResource{Spec: &system_proto.Zone{}}, core_store.CreateByKey("zone-1", core_model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		outbuf = &bytes.Buffer{}
+		rootCmd.SetOut(outbuf)
+		rootCmd.SetErr(outbuf)
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	execute := func(args ...string) error {
+		rootCmd.SetArgs(append([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"zone"}, args...))
+		return rootCmd.Execute()
+	}
+
+	getZone := func() *system_proto.Zone {
+		zone := system.NewZoneResource()
+		err := store.Get(context.Background(), zone, core_store.GetByKey("zone-1", core_model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		return zone.Spec
+	}
+
+	It("should disable zone", func() {
+		// when
+		err := execute("disable", "zone-1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(Equal("disabled Zone \"zone-1\"\n"))
+		Expect(getZone().IsEnabled()).To(BeFalse())
+		Expect(getZone().IsDraining()).To(BeFalse())
+	})
+
+	It("should drain zone", func() {
+		// when
+		err := execute("disable", "zone-1", "--drain", "--drain-period", "10m")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(Equal("draining Zone \"zone-1\" over 10m0s\n"))
+		Expect(getZone().IsEnabled()).To(BeTrue())
+		Expect(getZone().GetDrain()).To(matchers.MatchProto(&system_proto.Zone_Drain{
+			StartTime: util_proto.MustTimestampProto(now),
+			Period:    durationpb.New(10 * time.Minute),
+		}))
+	})
+
+	It("should not restart draining of a draining zone", func() {
+		// given
+		Expect(execute("disable", "zone-1", "--drain")).To(Succeed())
+		core.Now = func() time.Time {
+			return now.Add(time.Minute)
+		}
+		outbuf.Reset()
+
+		// when
+		err := execute("disable", "zone-1", "--drain")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(Equal("Zone \"zone-1\" is already draining\n"))
+		Expect(getZone().GetDrain().GetStartTime().AsTime()).To(Equal(now))
+	})
+
+	It("should enable zone", func() {
+		// given
+		zone := system.NewZoneResource()
+		Expect(store.Get(context.Background(), zone, core_store.GetByKey("zone-1", core_model.NoMesh))).To(Succeed())
+		zone.Spec.Enabled = wrapperspb.Bool(false)
+		zone.Spec.Drain = &system_proto.Zone_Drain{
+			StartTime: util_proto.MustTimestampProto(now),
+		}
+		Expect(store.Update(context.Background(), zone)).To(Succeed())
+
+		// when
+		err := execute("enable", "zone-1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outbuf.String()).To(Equal("enabled Zone \"zone-1\"\n"))
+		Expect(getZone().IsEnabled()).To(BeTrue())
+		Expect(getZone().IsDraining()).To(BeFalse())
+	})
+
+	It("should fail for unknown zone", func() {
+		// when
+		err := execute("disable", "zone-2")
+
+		// then
+		Expect(err).To(MatchError(`there is no Zone with name "zone-2"`))
+	})
+})
@@ -33,19 +33,27 @@ type RootArgs struct {
 }
 
 type RootRuntime struct {
-	Config                       config_proto.Configuration
-	Now                          func() time.Time
-	AuthnPlugins                 map[string]plugins.AuthnPlugin
-	NewBaseAPIServerClient       func(*config_proto.ControlPlaneCoordinates_ApiServer) (util_http.Client, error)
-	NewResourceStore             func(util_http.Client) core_store.ResourceStore
-	NewDataplaneOverviewClient   func(util_http.Client) kumactl_resources.DataplaneOverviewClient
-	NewZoneIngressOverviewClient func(util_http.Client) kumactl_resources.ZoneIngressOverviewClient
-	NewZoneOverviewClient        func(util_http.Client) kumactl_resources.ZoneOverviewClient
-	NewServiceOverviewClient     func(util_http.Client) kumactl_resources.ServiceOverviewClient
-	NewDataplaneTokenClient      func(util_http.Client) tokens.DataplaneTokenClient
-	NewZoneIngressTokenClient    func(util_http.Client) tokens.ZoneIngressTokenClient
-	NewAPIServerClient           func(util_http.Client) kumactl_resources.ApiServerClient
-	Registry                     registry.TypeRegistry
+	Config                        config_proto.Configuration
+	Now                           func() time.Time
+	AuthnPlugins                  map[string]plugins.AuthnPlugin
+	NewBaseAPIServerClient        func(*config_proto.ControlPlaneCoordinates_ApiServer) (util_http.Client, error)
+	NewResourceStore              func(util_http.Client) core_store.ResourceStore
+	NewDataplaneOverviewClient    func(util_http.Client) kumactl_resources.DataplaneOverviewClient
+	NewZoneIngressOverviewClient  func(util_http.Client) kumactl_resources.ZoneIngressOverviewClient
+	NewZoneOverviewClient         func(util_http.Client) kumactl_resources.ZoneOverviewClient
+	NewServiceOverviewClient      func(util_http.Client) kumactl_resources.ServiceOverviewClient
+	NewProxyTemplatePreviewClient func(util_http.Client) kumactl_resources.ProxyTemplatePreviewClient
+	NewEncryptionReportClient     func(util_http.Client) kumactl_resources.EncryptionReportClient
+	NewUpgradeReadinessClient     func(util_http.Client) kumactl_resources.UpgradeReadinessClient
+	NewVIPsClient                 func(util_http.Client) kumactl_resources.VIPsClient
+	NewRecordingClient            func(util_http.Client) kumactl_resources.RecordingClient
+	NewConfigChangeClient         func(util_http.Client) kumactl_resources.ConfigChangeClient
+	NewLogLevelsClient            func(util_http.Client) kumactl_resources.LogLevelsClient
+	NewDataplaneTokenClient       func(util_http.Client) tokens.DataplaneTokenClient
+	NewZoneIngressTokenClient     func(util_http.Client) tokens.ZoneIngressTokenClient
+	NewZoneEnrollmentTokenClient  func(util_http.Client) tokens.ZoneEnrollmentTokenClient
+	NewAPIServerClient            func(util_http.Client) kumactl_resources.ApiServerClient
+	Registry                      registry.TypeRegistry
 }
 
 // RootContext contains variables, functions and components that can be overridden when extending kumactl or running the test.
@@ -84,13 +92,21 @@ func DefaultRootContext() *RootContext {
 			NewResourceStore: func(client util_http.Client) core_store.ResourceStore {
 				return kumactl_resources.NewResourceStore(client, registry.Global().ObjectDescriptors())
 			},
-			NewDataplaneOverviewClient:   kumactl_resources.NewDataplaneOverviewClient,
-			NewZoneIngressOverviewClient: kumactl_resources.NewZoneIngressOverviewClient,
-			NewZoneOverviewClient:        kumactl_resources.NewZoneOverviewClient,
-			NewServiceOverviewClient:     kumactl_resources.NewServiceOverviewClient,
-			NewDataplaneTokenClient:      tokens.NewDataplaneTokenClient,
-			NewZoneIngressTokenClient:    tokens.NewZoneIngressTokenClient,
-			NewAPIServerClient:           kumactl_resources.NewAPIServerClient,
+			NewDataplaneOverviewClient:    kumactl_resources.NewDataplaneOverviewClient,
+			NewZoneIngressOverviewClient:  kumactl_resources.NewZoneIngressOverviewClient,
+			NewZoneOverviewClient:         kumactl_resources.NewZoneOverviewClient,
+			NewServiceOverviewClient:      kumactl_resources.NewServiceOverviewClient,
+			NewProxyTemplatePreviewClient: kumactl_resources.NewProxyTemplatePreviewClient,
+			NewEncryptionReportClient:     kumactl_resources.NewEncryptionReportClient,
+			NewUpgradeReadinessClient:     kumactl_resources.NewUpgradeReadinessClient,
+			NewVIPsClient:                 kumactl_resources.NewVIPsClient,
+			NewRecordingClient:            kumactl_resources.NewRecordingClient,
+			NewConfigChangeClient:         kumactl_resources.NewConfigChangeClient,
+			NewLogLevelsClient:            kumactl_resources.NewLogLevelsClient,
+			NewDataplaneTokenClient:       tokens.NewDataplaneTokenClient,
+			NewZoneIngressTokenClient:     tokens.NewZoneIngressTokenClient,
+			NewZoneEnrollmentTokenClient:  tokens.NewZoneEnrollmentTokenClient,
+			NewAPIServerClient:            kumactl_resources.NewAPIServerClient,
 		},
 		InstallCpContext:                    install_context.DefaultInstallCpContext(),
 		InstallCRDContext:                   install_context.DefaultInstallCrdsContext(),
@@ -155,6 +171,20 @@ func (rc *RootContext) BaseAPIServerClient() (util_http.Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	return rc.apiServerClient(controlPlane)
+}
+
+// ControlPlaneAPIServerClient returns a client of the API Server of the Control Plane with a given name.
+// It's used by commands that operate on more than one Control Plane at once.
+func (rc *RootContext) ControlPlaneAPIServerClient(name string) (util_http.Client, error) {
+	_, controlPlane := rc.Config().GetControlPlane(name)
+	if controlPlane == nil {
+		return nil, errors.Errorf("there is no Control Plane with name %q. Use `kumactl config control-planes add` to add it", name)
+	}
+	return rc.apiServerClient(controlPlane)
+}
+
+func (rc *RootContext) apiServerClient(controlPlane *config_proto.ControlPlane) (util_http.Client, error) {
 	client, err := rc.Runtime.NewBaseAPIServerClient(controlPlane.Coordinates.ApiServer)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create a client for Control Plane %q", controlPlane.Name)
@@ -182,6 +212,14 @@ func (rc *RootContext) CurrentResourceStore() (core_store.ResourceStore, error)
 	return rc.Runtime.NewResourceStore(client), nil
 }
 
+func (rc *RootContext) ControlPlaneResourceStore(name string) (core_store.ResourceStore, error) {
+	client, err := rc.ControlPlaneAPIServerClient(name)
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewResourceStore(client), nil
+}
+
 func (rc *RootContext) CurrentDataplaneOverviewClient() (kumactl_resources.DataplaneOverviewClient, error) {
 	client, err := rc.BaseAPIServerClient()
 	if err != nil {
@@ -198,6 +236,14 @@ func (rc *RootContext) CurrentZoneOverviewClient() (kumactl_resources.ZoneOvervi
 	return rc.Runtime.NewZoneOverviewClient(client), nil
 }
 
+func (rc *RootContext) ControlPlaneZoneOverviewClient(name string) (kumactl_resources.ZoneOverviewClient, error) {
+	client, err := rc.ControlPlaneAPIServerClient(name)
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewZoneOverviewClient(client), nil
+}
+
 func (rc *RootContext) CurrentZoneIngressOverviewClient() (kumactl_resources.ZoneIngressOverviewClient, error) {
 	client, err := rc.BaseAPIServerClient()
 	if err != nil {
@@ -214,6 +260,70 @@ func (rc *RootContext) CurrentServiceOverviewClient() (kumactl_resources.Service
 	return rc.Runtime.NewServiceOverviewClient(client), nil
 }
 
+func (rc *RootContext) CurrentProxyTemplatePreviewClient() (kumactl_resources.ProxyTemplatePreviewClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewProxyTemplatePreviewClient(client), nil
+}
+
+func (rc *RootContext) CurrentEncryptionReportClient() (kumactl_resources.EncryptionReportClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewEncryptionReportClient(client), nil
+}
+
+func (rc *RootContext) CurrentUpgradeReadinessClient() (kumactl_resources.UpgradeReadinessClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewUpgradeReadinessClient(client), nil
+}
+
+func (rc *RootContext) CurrentVIPsClient() (kumactl_resources.VIPsClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewVIPsClient(client), nil
+}
+
+func (rc *RootContext) ControlPlaneVIPsClient(name string) (kumactl_resources.VIPsClient, error) {
+	client, err := rc.ControlPlaneAPIServerClient(name)
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewVIPsClient(client), nil
+}
+
+func (rc *RootContext) CurrentRecordingClient() (kumactl_resources.RecordingClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewRecordingClient(client), nil
+}
+
+func (rc *RootContext) CurrentConfigChangeClient() (kumactl_resources.ConfigChangeClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewConfigChangeClient(client), nil
+}
+
+func (rc *RootContext) CurrentLogLevelsClient() (kumactl_resources.LogLevelsClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewLogLevelsClient(client), nil
+}
+
 func (rc *RootContext) CurrentDataplaneTokenClient() (tokens.DataplaneTokenClient, error) {
 	client, err := rc.BaseAPIServerClient()
 	if err != nil {
@@ -230,6 +340,14 @@ func (rc *RootContext) CurrentZoneIngressTokenClient() (tokens.ZoneIngressTokenC
 	return rc.Runtime.NewZoneIngressTokenClient(client), nil
 }
 
+func (rc *RootContext) CurrentZoneEnrollmentTokenClient() (tokens.ZoneEnrollmentTokenClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewZoneEnrollmentTokenClient(client), nil
+}
+
 func (rc *RootContext) IsFirstTimeUsage() bool {
 	if rc.Args.ConfigFile != "" {
 		return !util_files.FileExists(rc.Args.ConfigFile)
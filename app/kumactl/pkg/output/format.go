@@ -6,4 +6,5 @@ const (
 	TableFormat Format = "table"
 	YAMLFormat  Format = "yaml"
 	JSONFormat  Format = "json"
+	CSVFormat   Format = "csv"
 )
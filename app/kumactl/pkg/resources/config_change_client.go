@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type ConfigChangeClient interface {
+	List(ctx context.Context) (*types.ConfigChangeList, error)
+	Get(ctx context.Context, id string) (*types.ConfigChange, error)
+}
+
+func NewConfigChangeClient(client util_http.Client) ConfigChangeClient {
+	return &httpConfigChangeClient{
+		Client: client,
+	}
+}
+
+type httpConfigChangeClient struct {
+	Client util_http.Client
+}
+
+func (c *httpConfigChangeClient) List(ctx context.Context) (*types.ConfigChangeList, error) {
+	req, err := http.NewRequest("GET", "/changes", nil)
+	if err != nil {
+		return nil, err
+	}
+	statusCode, b, err := doRequest(c.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	list := types.ConfigChangeList{}
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+func (c *httpConfigChangeClient) Get(ctx context.Context, id string) (*types.ConfigChange, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("/changes/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	statusCode, b, err := doRequest(c.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	change := types.ConfigChange{}
+	if err := json.Unmarshal(b, &change); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type EncryptionReportClient interface {
+	Report(ctx context.Context, mesh string) (*types.EncryptionReport, error)
+}
+
+func NewEncryptionReportClient(client util_http.Client) EncryptionReportClient {
+	return &httpEncryptionReportClient{
+		Client: client,
+	}
+}
+
+type httpEncryptionReportClient struct {
+	Client util_http.Client
+}
+
+func (e *httpEncryptionReportClient) Report(ctx context.Context, mesh string) (*types.EncryptionReport, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("/meshes/%s/encryption-report", mesh), nil)
+	if err != nil {
+		return nil, err
+	}
+	statusCode, b, err := doRequest(e.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	report := types.EncryptionReport{}
+	if err := json.Unmarshal(b, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+// LogLevelsClient changes the log levels of the components of the Control Plane. An empty component
+// is the default level of the Control Plane.
+type LogLevelsClient interface {
+	List(ctx context.Context) (*types.LogLevels, error)
+	Set(ctx context.Context, component string, level string) (*types.LogLevels, error)
+	Reset(ctx context.Context, component string) (*types.LogLevels, error)
+}
+
+func NewLogLevelsClient(client util_http.Client) LogLevelsClient {
+	return &httpLogLevelsClient{
+		Client: client,
+	}
+}
+
+type httpLogLevelsClient struct {
+	Client util_http.Client
+}
+
+func (l *httpLogLevelsClient) List(ctx context.Context) (*types.LogLevels, error) {
+	return l.do(ctx, "GET", "", nil)
+}
+
+func (l *httpLogLevelsClient) Set(ctx context.Context, component string, level string) (*types.LogLevels, error) {
+	body, err := json.Marshal(types.LogLevel{Level: level})
+	if err != nil {
+		return nil, err
+	}
+	return l.do(ctx, "PUT", component, bytes.NewReader(body))
+}
+
+func (l *httpLogLevelsClient) Reset(ctx context.Context, component string) (*types.LogLevels, error) {
+	return l.do(ctx, "DELETE", component, nil)
+}
+
+func (l *httpLogLevelsClient) do(ctx context.Context, method string, component string, body io.Reader) (*types.LogLevels, error) {
+	path := "/log-levels"
+	if component != "" {
+		path += "/" + url.PathEscape(component)
+	}
+	req, err := http.NewRequest(method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("content-type", "application/json")
+	}
+	statusCode, b, err := doRequest(l.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	levels := types.LogLevels{}
+	if err := json.Unmarshal(b, &levels); err != nil {
+		return nil, err
+	}
+	return &levels, nil
+}
@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type ProxyTemplatePreviewClient interface {
+	Preview(ctx context.Context, dataplaneName string, template *mesh.ProxyTemplateResource) (*types.ProxyTemplatePreviewResponse, error)
+}
+
+func NewProxyTemplatePreviewClient(client util_http.Client) ProxyTemplatePreviewClient {
+	return &httpProxyTemplatePreviewClient{
+		Client: client,
+	}
+}
+
+type httpProxyTemplatePreviewClient struct {
+	Client util_http.Client
+}
+
+func (p *httpProxyTemplatePreviewClient) Preview(ctx context.Context, dataplaneName string, template *mesh.ProxyTemplateResource) (*types.ProxyTemplatePreviewResponse, error) {
+	body, err := json.Marshal(rest.From.Resource(template))
+	if err != nil {
+		return nil, err
+	}
+	resUrl := fmt.Sprintf("/meshes/%s/dataplanes/%s/xds-preview", template.GetMeta().GetMesh(), dataplaneName)
+	req, err := http.NewRequest("POST", resUrl, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("content-type", "application/json")
+	statusCode, b, err := doRequest(p.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	preview := types.ProxyTemplatePreviewResponse{}
+	if err := json.Unmarshal(b, &preview); err != nil {
+		return nil, err
+	}
+	return &preview, nil
+}
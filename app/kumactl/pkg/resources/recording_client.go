@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type RecordingClient interface {
+	Record(ctx context.Context, mesh string, dataplane string, request types.RecordingRequest) (*types.RecordingSummary, error)
+	Get(ctx context.Context, mesh string, dataplane string, name string) (*types.EncryptedRecording, error)
+}
+
+func NewRecordingClient(client util_http.Client) RecordingClient {
+	return &httpRecordingClient{
+		Client: client,
+	}
+}
+
+type httpRecordingClient struct {
+	Client util_http.Client
+}
+
+func (r *httpRecordingClient) Record(ctx context.Context, mesh string, dataplane string, request types.RecordingRequest) (*types.RecordingSummary, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", fmt.Sprintf("/meshes/%s/dataplanes/%s/recordings", url.PathEscape(mesh), url.PathEscape(dataplane)), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("content-type", "application/json")
+	statusCode, b, err := doRequest(r.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	summary := types.RecordingSummary{}
+	if err := json.Unmarshal(b, &summary); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+func (r *httpRecordingClient) Get(ctx context.Context, mesh string, dataplane string, name string) (*types.EncryptedRecording, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("/meshes/%s/dataplanes/%s/recordings/%s", url.PathEscape(mesh), url.PathEscape(dataplane), url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+	statusCode, b, err := doRequest(r.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	recording := types.EncryptedRecording{}
+	if err := json.Unmarshal(b, &recording); err != nil {
+		return nil, err
+	}
+	return &recording, nil
+}
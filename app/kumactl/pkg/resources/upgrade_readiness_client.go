@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type UpgradeReadinessClient interface {
+	// Report returns the upgrade readiness report for the target version.
+	// When the target version is empty, the version of the Control Plane is used.
+	Report(ctx context.Context, targetVersion string) (*types.UpgradeReadinessReport, error)
+}
+
+func NewUpgradeReadinessClient(client util_http.Client) UpgradeReadinessClient {
+	return &httpUpgradeReadinessClient{
+		Client: client,
+	}
+}
+
+type httpUpgradeReadinessClient struct {
+	Client util_http.Client
+}
+
+func (u *httpUpgradeReadinessClient) Report(ctx context.Context, targetVersion string) (*types.UpgradeReadinessReport, error) {
+	req, err := http.NewRequest("GET", "/upgrade-readiness", nil)
+	if err != nil {
+		return nil, err
+	}
+	if targetVersion != "" {
+		req.URL.RawQuery = url.Values{"version": []string{targetVersion}}.Encode()
+	}
+	statusCode, b, err := doRequest(u.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	report := types.UpgradeReadinessReport{}
+	if err := json.Unmarshal(b, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type VIPsClient interface {
+	Get(ctx context.Context, mesh string) (*types.VIPTable, error)
+}
+
+func NewVIPsClient(client util_http.Client) VIPsClient {
+	return &httpVIPsClient{
+		Client: client,
+	}
+}
+
+type httpVIPsClient struct {
+	Client util_http.Client
+}
+
+func (v *httpVIPsClient) Get(ctx context.Context, mesh string) (*types.VIPTable, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("/meshes/%s/vips", mesh), nil)
+	if err != nil {
+		return nil, err
+	}
+	statusCode, b, err := doRequest(v.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	table := types.VIPTable{}
+	if err := json.Unmarshal(b, &table); err != nil {
+		return nil, err
+	}
+	return &table, nil
+}
@@ -0,0 +1,66 @@
+package tokens
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	error_types "github.com/kumahq/kuma/pkg/core/rest/errors/types"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+func NewZoneEnrollmentTokenClient(client util_http.Client) ZoneEnrollmentTokenClient {
+	return &httpZoneEnrollmentTokenClient{
+		client: client,
+	}
+}
+
+type ZoneEnrollmentTokenClient interface {
+	Generate(zone string, validFor time.Duration) (string, error)
+}
+
+type httpZoneEnrollmentTokenClient struct {
+	client util_http.Client
+}
+
+var _ ZoneEnrollmentTokenClient = &httpZoneEnrollmentTokenClient{}
+
+func (h *httpZoneEnrollmentTokenClient) Generate(zone string, validFor time.Duration) (string, error) {
+	tokenReq := &types.ZoneEnrollmentTokenRequest{
+		Zone:     zone,
+		ValidFor: validFor.String(),
+	}
+	reqBytes, err := json.Marshal(tokenReq)
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal token request to json")
+	}
+	req, err := http.NewRequest("POST", "/tokens/zone-enrollment", bytes.NewReader(reqBytes))
+	if err != nil {
+		return "", errors.Wrap(err, "could not construct the request")
+	}
+	req.Header.Set("content-type", "application/json")
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "could not execute the request")
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read a body of the request")
+	}
+	if resp.StatusCode != 200 {
+		kumaErr := error_types.Error{}
+		if err := json.Unmarshal(body, &kumaErr); err == nil {
+			if kumaErr.Title != "" && kumaErr.Details != "" {
+				return "", &kumaErr
+			}
+		}
+		return "", errors.Errorf("(%d): %s", resp.StatusCode, body)
+	}
+	return string(body), nil
+}
@@ -0,0 +1,94 @@
+package tokens_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	kumactl_client "github.com/kumahq/kuma/app/kumactl/pkg/client"
+	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	config_kumactl "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/access"
+	tokens_server "github.com/kumahq/kuma/pkg/tokens/builtin/server"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneenrollment"
+)
+
+type zoneEnrollmentStaticTokenIssuer struct {
+}
+
+var _ zoneenrollment.TokenIssuer = &zoneEnrollmentStaticTokenIssuer{}
+
+func (z *zoneEnrollmentStaticTokenIssuer) Generate(zone string, validFor time.Duration) (zoneenrollment.Token, error) {
+	return fmt.Sprintf("enrollment-token-for-%s-valid-for-%s", zone, validFor), nil
+}
+
+func (z *zoneEnrollmentStaticTokenIssuer) Validate(token zoneenrollment.Token) (zoneenrollment.Identity, error) {
+	return zoneenrollment.Identity{}, errors.New("not implemented")
+}
+
+var _ = Describe("Zone Enrollment Tokens Client", func() {
+
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		container := restful.NewContainer()
+		container.Add(tokens_server.NewWebservice(&staticTokenIssuer{}, &zoneIngressStaticTokenIssuer{}, &zoneEnrollmentStaticTokenIssuer{}, access.NoopGenerateDpTokenAccess{}))
+		server = httptest.NewServer(container.ServeMux)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should return a token", func() {
+		// given
+		baseClient, err := kumactl_client.ApiServerClient(&config_kumactl.ControlPlaneCoordinates_ApiServer{
+			Url: server.URL,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		client := tokens.NewZoneEnrollmentTokenClient(baseClient)
+
+		// wait for server
+		Eventually(func() error {
+			_, err := client.Generate("my-zone-1", time.Hour)
+			return err
+		}, "5s", "100ms").ShouldNot(HaveOccurred())
+
+		// when
+		token, err := client.Generate("my-zone-1", 24*time.Hour)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token).To(Equal("enrollment-token-for-my-zone-1-valid-for-24h0m0s"))
+	})
+
+	It("should return an error when status code is different than 200", func() {
+		// given
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+		mux.HandleFunc("/tokens/zone-enrollment", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			writer.WriteHeader(500)
+			_, err := writer.Write([]byte("Internal Server Error"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+		baseClient, err := kumactl_client.ApiServerClient(&config_kumactl.ControlPlaneCoordinates_ApiServer{
+			Url: server.URL,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		client := tokens.NewZoneEnrollmentTokenClient(baseClient)
+
+		// when
+		_, err = client.Generate("my-zone-2", time.Hour)
+
+		// then
+		Expect(err).To(MatchError("(500): Internal Server Error"))
+	})
+})
@@ -37,7 +37,7 @@ var _ = Describe("Zone Ingress Tokens Client", func() {
 
 	BeforeEach(func() {
 		container := restful.NewContainer()
-		container.Add(tokens_server.NewWebservice(&staticTokenIssuer{}, &zoneIngressStaticTokenIssuer{}, access.NoopGenerateDpTokenAccess{}))
+		container.Add(tokens_server.NewWebservice(&staticTokenIssuer{}, &zoneIngressStaticTokenIssuer{}, &zoneEnrollmentStaticTokenIssuer{}, access.NoopGenerateDpTokenAccess{}))
 		server = httptest.NewServer(container.ServeMux)
 	})
 
@@ -34,7 +34,7 @@ COREDNS_PLUGIN_CFG_PATH ?= $(TOP)/tools/builds/coredns/templates/plugin.cfg
 BUILD_RELEASE_BINARIES := kuma-cp kuma-dp kumactl kuma-prometheus-sd coredns
 
 # List of binaries that we have test build roles for.
-BUILD_TEST_BINARIES := test-server
+BUILD_TEST_BINARIES := test-server kuma-cp-loadtest
 
 # Setting this variable to any value other than 'N', enables the experimental Kuma
 # gateway plugin. Experimental means "for experiments", NOT "for production".
@@ -99,6 +99,10 @@ build/kuma-prometheus-sd: ## Dev: Build `kuma-prometheus-sd` binary
 build/test-server: ## Dev: Build `test-server` binary
 	$(Build_Go_Application) ./test/server
 
+.PHONY: build/kuma-cp-loadtest
+build/kuma-cp-loadtest: ## Dev: Build `kuma-cp-loadtest` binary
+	$(Build_Go_Application) ./test/loadtest
+
 .PHONY: build/kuma-cp/linux-amd64
 build/kuma-cp/linux-amd64:
 	GOOS=linux GOARCH=amd64 $(MAKE) build/kuma-cp
@@ -123,6 +127,10 @@ build/coredns/linux-amd64:
 build/test-server/linux-amd64:
 	GOOS=linux GOARCH=amd64 $(MAKE) build/test-server
 
+.PHONY: build/kuma-cp-loadtest/linux-amd64
+build/kuma-cp-loadtest/linux-amd64:
+	GOOS=linux GOARCH=amd64 $(MAKE) build/kuma-cp-loadtest
+
 .PHONY: clean
 clean: clean/build ## Dev: Clean
 
@@ -136,6 +136,15 @@ test/e2e/debug-universal: build/kumactl images/test
 	GINKGO_EDITOR_INTEGRATION=true \
 		ginkgo --failFast $(GOFLAGS) $(LD_FLAGS) $(E2E_PKG_LIST)
 
+# test/e2e/gateway/conformance runs the conformance specs of the builtin gateway on universal.
+# Kuma is built with the gateway, and the specs fail rather than being skipped without it.
+.PHONY: test/e2e/gateway/conformance
+test/e2e/gateway/conformance:
+	$(MAKE) BUILD_WITH_EXPERIMENTAL_GATEWAY=Y build/kumactl images/test
+	$(MAKE) test/e2e/test \
+		E2E_PKG_LIST=./test/e2e/gateway/conformance/... \
+		ENV_VARS='$(ENV_VARS) KUMA_E2E_GATEWAY_CONFORMANCE=true'
+
 .PHONY: test/e2e
 test/e2e: build/kumactl images test/e2e/k8s/start
 	$(MAKE) test/e2e/test || (ret=$$?; $(MAKE) test/e2e/k8s/stop && exit $$ret)
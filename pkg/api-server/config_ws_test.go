@@ -76,6 +76,30 @@ var _ = Describe("Config WS", func() {
 			  "tlsCertFile": "../../test/certs/server-cert.pem",
 			  "tlsKeyFile": "../../test/certs/server-key.pem"
 			},
+			"rateLimit": {
+			  "enabled": false,
+			  "clientRequestsPerSecond": 50,
+			  "clientBurst": 100,
+			  "endpointRequestsPerSecond": 0,
+			  "endpointBurst": 0,
+			  "endpoints": [
+				{
+				  "path": "/tokens",
+				  "requestsPerSecond": 10,
+				  "burst": 20
+				},
+				{
+				  "path": "/tokens/dataplane",
+				  "requestsPerSecond": 10,
+				  "burst": 20
+				},
+				{
+				  "path": "/tokens/zone-ingress",
+				  "requestsPerSecond": 10,
+				  "burst": 20
+				}
+			  ]
+			},
 			"readOnly": false
 		  },
 		  "bootstrapServer": {
@@ -117,6 +141,13 @@ var _ = Describe("Config WS", func() {
 			  "refreshInterval": "10s"
 			},
 			"port": 5678,
+			"rateLimit": {
+			  "enabled": false,
+			  "clientRequestsPerSecond": 5,
+			  "clientBurst": 10,
+			  "endpointRequestsPerSecond": 0,
+			  "endpointBurst": 0
+			},
 			"tlsCertFile": "",
 			"tlsKeyFile": ""
 		  },
@@ -134,6 +165,7 @@ var _ = Describe("Config WS", func() {
 			"dataplane": {
 			  "enabled": true,
 			  "subscriptionLimit": 2,
+			  "subscriptionMaxAge": "0s",
 			  "idleTimeout": "5m0s"
 			},
 			"mesh": {
@@ -274,6 +306,13 @@ var _ = Describe("Config WS", func() {
               "enabled": true,
               "interval": "5s",
               "refreshInterval": "10s"
+            },
+            "rateLimit": {
+              "enabled": false,
+              "clientRequestsPerSecond": 5,
+              "clientBurst": 10,
+              "endpointRequestsPerSecond": 0,
+              "endpointBurst": 0
             }
           },
           "store": {
@@ -313,7 +352,36 @@ var _ = Describe("Config WS", func() {
           "xdsServer": {
             "dataplaneConfigurationRefreshInterval": "1s",
             "dataplaneStatusFlushInterval": "10s",
-            "nackBackoff": "5s"
+            "nackBackoff": "5s",
+            "dataplaneDrainPropagationDelay": "5s",
+            "shadow": {
+              "enabled": false,
+              "activeControlPlaneUrl": "",
+              "activeControlPlaneAuthToken": "*****",
+              "interval": "30s"
+            }
+          },
+          "serviceDiscovery": {
+            "consul": {
+              "enabled": false,
+              "address": "http://127.0.0.1:8500",
+              "token": "*****",
+              "datacenter": "",
+              "services": null,
+              "mesh": "default",
+              "syncInterval": "30s"
+            },
+            "cloudMap": {
+              "enabled": false,
+              "region": "",
+              "namespaceId": "",
+              "mesh": "default",
+              "accessKeyId": "",
+              "secretAccessKey": "*****",
+              "sessionToken": "*****",
+              "endpoint": "",
+              "syncInterval": "30s"
+            }
           },
           "diagnostics": {
             "serverPort": 5680,
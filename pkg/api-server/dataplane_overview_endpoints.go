@@ -2,12 +2,16 @@ package api_server
 
 import (
 	"context"
+	"encoding/json"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/emicklei/go-restful"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/resources/access"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -17,6 +21,7 @@ import (
 	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
 	"github.com/kumahq/kuma/pkg/core/user"
 	"github.com/kumahq/kuma/pkg/core/validators"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
 type dataplaneOverviewEndpoints struct {
@@ -33,6 +38,17 @@ func (r *dataplaneOverviewEndpoints) addFindEndpoint(ws *restful.WebService, pat
 		Returns(404, "Not found", nil))
 }
 
+func (r *dataplaneOverviewEndpoints) addSubscriptionsEndpoint(ws *restful.WebService, pathPrefix string) {
+	ws.Route(ws.GET(pathPrefix+"/dataplanes+insights/{name}/subscriptions").To(r.inspectSubscriptions).
+		Doc("Inspect the subscriptions of a dataplane").
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.QueryParameter("size", "Number of subscriptions in a page").DataType("integer")).
+		Param(ws.QueryParameter("offset", "Offset of the page").DataType("integer")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
 func (r *dataplaneOverviewEndpoints) addListEndpoint(ws *restful.WebService, pathPrefix string) {
 	ws.Route(ws.GET(pathPrefix+"/dataplanes+insights").To(r.inspectDataplanes).
 		Doc("Inspect all dataplanes").
@@ -68,6 +84,77 @@ func (r *dataplaneOverviewEndpoints) inspectDataplane(request *restful.Request,
 	}
 }
 
+func (r *dataplaneOverviewEndpoints) inspectSubscriptions(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	meshName := request.PathParameter("mesh")
+
+	if err := r.resourceAccess.ValidateGet(
+		core_model.ResourceKey{Mesh: meshName, Name: name},
+		mesh.NewDataplaneInsightResource().Descriptor(),
+		user.FromCtx(request.Request.Context()),
+	); err != nil {
+		rest_errors.HandleError(response, err, "Access Denied")
+		return
+	}
+
+	page, err := pagination(request)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve dataplane subscriptions")
+		return
+	}
+	offset := 0
+	if page.offset != "" {
+		o, err := strconv.Atoi(page.offset)
+		if err != nil || o < 0 {
+			rest_errors.HandleError(response, store.ErrorInvalidOffset, "Could not retrieve dataplane subscriptions")
+			return
+		}
+		offset = o
+	}
+
+	insight := mesh.NewDataplaneInsightResource()
+	if err := r.resManager.Get(request.Request.Context(), insight, store.GetByKey(name, meshName)); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve dataplane subscriptions")
+		return
+	}
+
+	subscriptions := insight.Spec.GetSubscriptions()
+	total := len(subscriptions)
+	start := offset
+	if start > total {
+		start = total
+	}
+	end := start + page.size
+	if end > total {
+		end = total
+	}
+
+	result := types.DataplaneSubscriptions{
+		Summary: types.DataplaneSubscriptionSummary{
+			ConnectCount:         insight.Spec.GetConnectCount(),
+			Uptime:               insight.Spec.Uptime(core.Now()),
+			LastDisconnectReason: insight.Spec.GetLastDisconnectReason(),
+		},
+		Total: uint32(total),
+		Items: []json.RawMessage{},
+	}
+	for _, subscription := range subscriptions[start:end] {
+		item, err := util_proto.ToJSON(subscription)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not retrieve dataplane subscriptions")
+			return
+		}
+		result.Items = append(result.Items, item)
+	}
+	if end < total {
+		result.Next = nextLink(request, strconv.Itoa(end))
+	}
+
+	if err := response.WriteAsJson(result); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve dataplane subscriptions")
+	}
+}
+
 func (r *dataplaneOverviewEndpoints) fetchOverview(ctx context.Context, name string, meshName string) (*mesh.DataplaneOverviewResource, error) {
 	dataplane := mesh.NewDataplaneResource()
 	if err := r.resManager.Get(ctx, dataplane, store.GetByKey(name, meshName)); err != nil {
@@ -242,6 +242,49 @@ var _ = Describe("Dataplane Overview Endpoints", func() {
 			Expect(body).To(MatchJSON(dp1Json))
 		})
 
+		It("should return a page of the subscriptions of an existing resource", func() {
+			// when
+			response, err := http.Get("http://" + apiServer.Address() + "/meshes/mesh1/dataplanes+insights/dp-1/subscriptions?size=1")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+{
+	"summary": {
+		"connectCount": 1,
+		"uptime": 100
+	},
+	"total": 1,
+	"items": [
+		{
+			"id": "stream-id-1",
+			"controlPlaneInstanceId": "cp-1",
+			"connectTime": "2019-07-01T00:00:00Z",
+			"status": {
+				"total": {},
+				"cds": {},
+				"eds": {},
+				"lds": {},
+				"rds": {}
+			}
+		}
+	],
+	"next": null
+}`))
+		})
+
+		It("should return 404 for the subscriptions of a missing resource", func() {
+			// when
+			response, err := http.Get("http://" + apiServer.Address() + "/meshes/mesh1/dataplanes+insights/dp-4/subscriptions")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(404))
+		})
+
 		type testCase struct {
 			url          string
 			expectedJson string
@@ -0,0 +1,225 @@
+package api_server
+
+import (
+	"context"
+	"sort"
+
+	"github.com/emicklei/go-restful"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/policy"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/insights"
+)
+
+const (
+	reasonMTLSDisabled       = "mTLS is disabled in the mesh"
+	reasonPermissiveFallback = "destination is not ready for mTLS, traffic falls back to plaintext in PERMISSIVE mode"
+	reasonExternalNoTLS      = "TLS is not enabled for the external service"
+)
+
+type encryptionReportEndpoints struct {
+	resManager manager.ResourceManager
+}
+
+func (r *encryptionReportEndpoints) addEndpoint(ws *restful.WebService) {
+	ws.Route(ws.GET("/meshes/{mesh}/encryption-report").To(r.report).
+		Doc("Report how the traffic between services of a mesh is encrypted").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.QueryParameter("format", "Format of the report: json or csv").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (r *encryptionReportEndpoints) report(request *restful.Request, response *restful.Response) {
+	meshName := request.PathParameter("mesh")
+
+	report, err := r.buildReport(request.Request.Context(), meshName)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not build an encryption report")
+		return
+	}
+
+	switch request.QueryParameter("format") {
+	case "", "json":
+		err = response.WriteAsJson(report)
+	case "csv":
+		response.AddHeader("content-type", "text/csv")
+		response.AddHeader("content-disposition", "attachment; filename="+meshName+"-encryption-report.csv")
+		err = report.WriteCSV(response)
+	default:
+		verr := validators.ValidationError{}
+		verr.AddViolation("format", "has to be either json or csv")
+		rest_errors.HandleError(response, &verr, "Could not build an encryption report")
+		return
+	}
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not write an encryption report")
+	}
+}
+
+func (r *encryptionReportEndpoints) buildReport(ctx context.Context, meshName string) (*types.EncryptionReport, error) {
+	meshRes := mesh.NewMeshResource()
+	if err := r.resManager.Get(ctx, meshRes, store.GetByKey(meshName, model.NoMesh)); err != nil {
+		return nil, err
+	}
+	dataplanes := &mesh.DataplaneResourceList{}
+	if err := r.resManager.List(ctx, dataplanes, store.ListByMesh(meshName)); err != nil {
+		return nil, err
+	}
+	externalServices := &mesh.ExternalServiceResourceList{}
+	if err := r.resManager.List(ctx, externalServices, store.ListByMesh(meshName)); err != nil {
+		return nil, err
+	}
+	permissions := &mesh.TrafficPermissionResourceList{}
+	if err := r.resManager.List(ctx, permissions, store.ListByMesh(meshName)); err != nil {
+		return nil, err
+	}
+	serviceInsight := mesh.NewServiceInsightResource()
+	err := r.resManager.Get(ctx, serviceInsight, store.GetByKey(insights.ServiceInsightName(meshName), meshName))
+	if err != nil && !store.IsResourceNotFound(err) { // It's fine to have mesh without insight
+		return nil, err
+	}
+	return buildEncryptionReport(meshRes, dataplanes.Items, externalServices.Items, permissions.Items, serviceInsight), nil
+}
+
+// buildEncryptionReport lists pairs of services that exchange traffic according to the configuration of the mesh.
+// A data plane proxy sends traffic to the services of its outbounds or to every service of the mesh when transparent
+// proxying is enabled. When mTLS is enabled, only pairs allowed by TrafficPermissions exchange traffic.
+// Traffic to ExternalServices always has to be allowed by TrafficPermissions.
+func buildEncryptionReport(
+	meshRes *mesh.MeshResource,
+	dataplanes []*mesh.DataplaneResource,
+	externalServices []*mesh.ExternalServiceResource,
+	permissions []*mesh.TrafficPermissionResource,
+	serviceInsight *mesh.ServiceInsightResource,
+) *types.EncryptionReport {
+	policies := make([]policy.ConnectionPolicy, len(permissions))
+	for i, permission := range permissions {
+		policies[i] = permission
+	}
+
+	destinations := map[string][]*mesh_proto.Dataplane_Networking_Inbound{}
+	for _, dataplane := range dataplanes {
+		for _, inbound := range dataplane.Spec.GetNetworking().GetInbound() {
+			destinations[inbound.GetService()] = append(destinations[inbound.GetService()], inbound)
+		}
+	}
+	externals := map[string]*mesh.ExternalServiceResource{}
+	for _, externalService := range externalServices {
+		externals[externalService.Spec.GetService()] = externalService
+	}
+
+	permitted := func(source *mesh_proto.Dataplane, destinationTags map[string]string) bool {
+		permission := policy.SelectInboundConnectionPolicy(destinationTags, policies)
+		if permission == nil {
+			return false
+		}
+		for _, selector := range permission.Sources() {
+			if source.MatchTags(selector.Match) {
+				return true
+			}
+		}
+		return false
+	}
+
+	paths := map[string]types.EncryptionReportPath{}
+	addPath := func(source *mesh_proto.Dataplane, path types.EncryptionReportPath) {
+		for _, service := range source.TagSet().UniqueValues(mesh_proto.ServiceTag) {
+			path.Source = service
+			paths[path.Source+"/"+path.Destination] = path
+		}
+	}
+
+	for _, dataplane := range dataplanes {
+		source := dataplane.Spec
+		if source.IsIngress() {
+			continue
+		}
+		reachable := map[string]bool{}
+		for _, outbound := range source.GetNetworking().GetOutbound() {
+			reachable[outbound.GetTagsIncludingLegacy()[mesh_proto.ServiceTag]] = true
+		}
+		transparentProxying := source.GetNetworking().GetTransparentProxying().GetRedirectPortOutbound() != 0
+
+		for service, inbounds := range destinations {
+			if !transparentProxying && !reachable[service] {
+				continue
+			}
+			if meshRes.MTLSEnabled() {
+				allowed := false
+				for _, inbound := range inbounds {
+					if permitted(source, inbound.GetTags()) {
+						allowed = true
+						break
+					}
+				}
+				if !allowed {
+					continue
+				}
+			}
+			addPath(source, meshServiceEncryption(meshRes, serviceInsight, service))
+		}
+
+		for service, externalService := range externals {
+			if !transparentProxying && !reachable[service] {
+				continue
+			}
+			if !permitted(source, externalService.Spec.GetTags()) {
+				continue
+			}
+			path := types.EncryptionReportPath{
+				Destination: service,
+				External:    true,
+				Encryption:  types.EncryptionTLSOriginated,
+			}
+			if !externalService.Spec.GetNetworking().GetTls().GetEnabled() {
+				path.Encryption = types.EncryptionPlaintext
+				path.Reason = reasonExternalNoTLS
+			}
+			addPath(source, path)
+		}
+	}
+
+	report := &types.EncryptionReport{
+		Mesh:  meshRes.GetMeta().GetName(),
+		Paths: []types.EncryptionReportPath{},
+	}
+	for _, path := range paths {
+		report.Paths = append(report.Paths, path)
+	}
+	sort.Slice(report.Paths, func(i, j int) bool {
+		if report.Paths[i].Source != report.Paths[j].Source {
+			return report.Paths[i].Source < report.Paths[j].Source
+		}
+		return report.Paths[i].Destination < report.Paths[j].Destination
+	})
+	return report
+}
+
+func meshServiceEncryption(meshRes *mesh.MeshResource, serviceInsight *mesh.ServiceInsightResource, service string) types.EncryptionReportPath {
+	path := types.EncryptionReportPath{
+		Destination: service,
+		Encryption:  types.EncryptionMTLS,
+	}
+	backend := meshRes.GetEnabledCertificateAuthorityBackend()
+	switch {
+	case backend == nil:
+		path.Encryption = types.EncryptionPlaintext
+		path.Reason = reasonMTLSDisabled
+	case backend.Mode == mesh_proto.CertificateAuthorityBackend_PERMISSIVE:
+		// the same readiness check is done when client side mTLS is configured for the outbound
+		insight := serviceInsight.Spec.GetServices()[service]
+		if insight == nil || insight.IssuedBackends[backend.Name] != insight.GetDataplanes().GetTotal() {
+			path.Encryption = types.EncryptionPlaintext
+			path.Reason = reasonPermissiveFallback
+		}
+	}
+	return path
+}
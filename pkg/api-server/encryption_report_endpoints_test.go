@@ -0,0 +1,239 @@
+package api_server_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/insights"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Encryption Report Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig(), true, metrics)
+
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+
+		stop = make(chan struct{})
+
+		go func() {
+			defer GinkgoRecover()
+			Expect(apiServer.Start(stop)).To(Succeed())
+		}()
+
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	create := func(resource core_model.Resource, name, mesh, spec string) {
+		Expect(util_proto.FromYAML([]byte(spec), resource.GetSpec())).To(Succeed())
+		Expect(resourceStore.Create(context.Background(), resource, store.CreateByKey(name, mesh))).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		create(core_mesh.NewMeshResource(), "mesh-1", core_model.NoMesh, `
+        mtls:
+          enabledBackend: ca-1
+          backends:
+          - name: ca-1
+            type: builtin
+            mode: PERMISSIVE`)
+		create(core_mesh.NewDataplaneResource(), "web-01", "mesh-1", `
+        networking:
+          address: 192.168.0.1
+          inbound:
+          - port: 8080
+            tags:
+              kuma.io/service: web
+          transparentProxying:
+            redirectPortInbound: 15006
+            redirectPortOutbound: 15001`)
+		create(core_mesh.NewDataplaneResource(), "backend-01", "mesh-1", `
+        networking:
+          address: 192.168.0.2
+          inbound:
+          - port: 8080
+            tags:
+              kuma.io/service: backend
+          outbound:
+          - port: 10001
+            tags:
+              kuma.io/service: redis`)
+		create(core_mesh.NewDataplaneResource(), "redis-01", "mesh-1", `
+        networking:
+          address: 192.168.0.3
+          inbound:
+          - port: 6379
+            tags:
+              kuma.io/service: redis`)
+		create(core_mesh.NewExternalServiceResource(), "httpbin", "mesh-1", `
+        networking:
+          address: httpbin.org:443
+          tls:
+            enabled: true
+        tags:
+          kuma.io/service: httpbin`)
+		create(core_mesh.NewExternalServiceResource(), "legacy", "mesh-1", `
+        networking:
+          address: legacy.internal:80
+        tags:
+          kuma.io/service: legacy`)
+		create(core_mesh.NewTrafficPermissionResource(), "web-to-all", "mesh-1", `
+        sources:
+        - match:
+            kuma.io/service: web
+        destinations:
+        - match:
+            kuma.io/service: '*'`)
+		create(core_mesh.NewTrafficPermissionResource(), "backend-to-redis", "mesh-1", `
+        sources:
+        - match:
+            kuma.io/service: backend
+        destinations:
+        - match:
+            kuma.io/service: redis`)
+		create(core_mesh.NewServiceInsightResource(), insights.ServiceInsightName("mesh-1"), "mesh-1", `
+        services:
+          web:
+            dataplanes:
+              total: 1
+            issuedBackends:
+              ca-1: 1
+          backend:
+            dataplanes:
+              total: 1
+            issuedBackends:
+              ca-1: 1
+          redis:
+            dataplanes:
+              total: 1`)
+
+		create(core_mesh.NewMeshResource(), "mesh-2", core_model.NoMesh, `{}`)
+		create(core_mesh.NewDataplaneResource(), "web-01", "mesh-2", `
+        networking:
+          address: 192.168.0.1
+          inbound:
+          - port: 8080
+            tags:
+              kuma.io/service: web
+          outbound:
+          - port: 10001
+            tags:
+              kuma.io/service: backend`)
+		create(core_mesh.NewDataplaneResource(), "backend-01", "mesh-2", `
+        networking:
+          address: 192.168.0.2
+          inbound:
+          - port: 8080
+            tags:
+              kuma.io/service: backend`)
+	})
+
+	get := func(path string) (int, string) {
+		response, err := http.Get("http://" + apiServer.Address() + path)
+		Expect(err).ToNot(HaveOccurred())
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return response.StatusCode, string(body)
+	}
+
+	It("should report encryption of paths between services allowed by traffic permissions", func() {
+		// when
+		status, body := get("/meshes/mesh-1/encryption-report")
+
+		// then
+		Expect(status).To(Equal(200))
+		Expect(body).To(MatchJSON(`
+        {
+          "mesh": "mesh-1",
+          "paths": [
+            {
+              "source": "backend",
+              "destination": "redis",
+              "external": false,
+              "encryption": "Plaintext",
+              "reason": "destination is not ready for mTLS, traffic falls back to plaintext in PERMISSIVE mode"
+            },
+            {
+              "source": "web",
+              "destination": "backend",
+              "external": false,
+              "encryption": "mTLS"
+            },
+            {
+              "source": "web",
+              "destination": "httpbin",
+              "external": true,
+              "encryption": "TLSOriginated"
+            },
+            {
+              "source": "web",
+              "destination": "legacy",
+              "external": true,
+              "encryption": "Plaintext",
+              "reason": "TLS is not enabled for the external service"
+            },
+            {
+              "source": "web",
+              "destination": "web",
+              "external": false,
+              "encryption": "mTLS"
+            }
+          ]
+        }`))
+	})
+
+	It("should report plaintext paths of outbounds when mTLS is disabled", func() {
+		// when
+		status, body := get("/meshes/mesh-2/encryption-report?format=csv")
+
+		// then
+		Expect(status).To(Equal(200))
+		Expect(body).To(Equal(`mesh,source,destination,external,encryption,reason
+mesh-2,web,backend,false,Plaintext,mTLS is disabled in the mesh
+`))
+	})
+
+	It("should reject unknown format", func() {
+		// when
+		status, body := get("/meshes/mesh-1/encryption-report?format=xml")
+
+		// then
+		Expect(status).To(Equal(400))
+		Expect(body).To(ContainSubstring("has to be either json or csv"))
+	})
+
+	It("should return 404 for unknown mesh", func() {
+		// when
+		status, _ := get("/meshes/unknown/encryption-report")
+
+		// then
+		Expect(status).To(Equal(404))
+	})
+})
@@ -0,0 +1,145 @@
+package api_server
+
+import (
+	"fmt"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	config_access "github.com/kumahq/kuma/pkg/config/access"
+	"github.com/kumahq/kuma/pkg/core/access"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	kuma_log "github.com/kumahq/kuma/pkg/log"
+)
+
+// logLevelsEndpoints change the log levels of the components of the Control Plane at runtime,
+// so a single subsystem can be debugged without restarting the Control Plane with the debug level.
+// The levels are changed only on the instance of the Control Plane that serves the request.
+type logLevelsEndpoints struct {
+	levels *kuma_log.ComponentLevels
+	// admins are the only users that can change the levels
+	usernames map[string]bool
+	groups    map[string]bool
+}
+
+func logLevelsWs(levels *kuma_log.ComponentLevels, admins config_access.AdminResourcesStaticAccessConfig) *restful.WebService {
+	e := &logLevelsEndpoints{
+		levels:    levels,
+		usernames: map[string]bool{},
+		groups:    map[string]bool{},
+	}
+	for _, u := range admins.Users {
+		e.usernames[u] = true
+	}
+	for _, group := range admins.Groups {
+		e.groups[group] = true
+	}
+
+	ws := new(restful.WebService).
+		Path("/log-levels").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.GET("").To(e.list).
+		Doc("List the log levels of the components of the Control Plane").
+		Returns(200, "OK", nil))
+	ws.Route(ws.PUT("").To(e.setDefault).
+		Doc("Set the default log level of the Control Plane").
+		Returns(200, "OK", nil))
+	ws.Route(ws.PUT("/{component}").To(e.set).
+		Doc("Set the log level of a component of the Control Plane and its subcomponents").
+		Param(ws.PathParameter("component", "Name of a component, e.g. xds-server or xds-server.reconcile").DataType("string")).
+		Returns(200, "OK", nil))
+	ws.Route(ws.DELETE("/{component}").To(e.reset).
+		Doc("Reset the log level of a component of the Control Plane to the level of its parent").
+		Param(ws.PathParameter("component", "Name of a component").DataType("string")).
+		Returns(200, "OK", nil))
+	return ws
+}
+
+func (e *logLevelsEndpoints) list(_ *restful.Request, response *restful.Response) {
+	e.writeLevels(response)
+}
+
+func (e *logLevelsEndpoints) setDefault(request *restful.Request, response *restful.Response) {
+	level, err := e.parseRequest(request)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not set the default log level")
+		return
+	}
+	e.levels.SetDefault(level)
+	log.Info("default log level changed", "level", level)
+	e.writeLevels(response)
+}
+
+func (e *logLevelsEndpoints) set(request *restful.Request, response *restful.Response) {
+	component := request.PathParameter("component")
+	level, err := e.parseRequest(request)
+	if err != nil {
+		rest_errors.HandleError(response, err, fmt.Sprintf("Could not set the log level of %q", component))
+		return
+	}
+	e.levels.Set(component, level)
+	log.Info("log level of the component changed", "component", component, "level", level)
+	e.writeLevels(response)
+}
+
+func (e *logLevelsEndpoints) reset(request *restful.Request, response *restful.Response) {
+	component := request.PathParameter("component")
+	if err := e.validateAccess(user.FromCtx(request.Request.Context())); err != nil {
+		rest_errors.HandleError(response, err, fmt.Sprintf("Could not reset the log level of %q", component))
+		return
+	}
+	e.levels.Reset(component)
+	log.Info("log level of the component reset", "component", component)
+	e.writeLevels(response)
+}
+
+func (e *logLevelsEndpoints) parseRequest(request *restful.Request) (kuma_log.LogLevel, error) {
+	if err := e.validateAccess(user.FromCtx(request.Request.Context())); err != nil {
+		return kuma_log.OffLevel, err
+	}
+	body := types.LogLevel{}
+	if err := request.ReadEntity(&body); err != nil {
+		return kuma_log.OffLevel, err
+	}
+	level, err := kuma_log.ParseLogLevel(body.Level)
+	if err != nil {
+		verr := validators.ValidationError{}
+		verr.AddViolation("level", fmt.Sprintf("must be one of %s, %s or %s", kuma_log.OffLevel, kuma_log.InfoLevel, kuma_log.DebugLevel))
+		return kuma_log.OffLevel, &verr
+	}
+	return level, nil
+}
+
+func (e *logLevelsEndpoints) validateAccess(u user.User) error {
+	allowed := e.usernames[u.Name]
+	for _, group := range u.Groups {
+		if e.groups[group] {
+			allowed = true
+		}
+	}
+	if !allowed {
+		return &access.AccessDeniedError{
+			Reason: fmt.Sprintf("user %q cannot change the log levels", u.String()),
+		}
+	}
+	return nil
+}
+
+func (e *logLevelsEndpoints) writeLevels(response *restful.Response) {
+	levels := types.LogLevels{
+		Default:    e.levels.Default().String(),
+		Components: []types.ComponentLogLevel{},
+	}
+	for _, c := range e.levels.Components() {
+		levels.Components = append(levels.Components, types.ComponentLogLevel{
+			Component: c.Component,
+			Level:     c.Level.String(),
+		})
+	}
+	if err := response.WriteAsJson(levels); err != nil {
+		rest_errors.HandleError(response, err, "Could not write the log levels")
+	}
+}
@@ -0,0 +1,133 @@
+package api_server_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	kuma_log "github.com/kumahq/kuma/pkg/log"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Log Levels WS", func() {
+
+	var apiServer *api_server.ApiServer
+	var stop chan struct{}
+
+	startApiServer := func(cfg *config.ApiServerConfig) {
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		apiServer = createTestApiServer(memory.NewStore(), cfg, true, metrics)
+
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Eventually(func() error {
+			_, err := http.Get(fmt.Sprintf("http://%s/log-levels", apiServer.Address()))
+			return err
+		}, "3s").ShouldNot(HaveOccurred())
+	}
+
+	AfterEach(func() {
+		close(stop)
+		kuma_log.DefaultComponentLevels.SetDefault(kuma_log.InfoLevel)
+		for _, c := range kuma_log.DefaultComponentLevels.Components() {
+			kuma_log.DefaultComponentLevels.Reset(c.Component)
+		}
+	})
+
+	request := func(method string, path string, body string) (int, types.LogLevels) {
+		req, err := http.NewRequest(method, fmt.Sprintf("http://%s/log-levels%s", apiServer.Address(), path), strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("content-type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		bytes, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		levels := types.LogLevels{}
+		if resp.StatusCode == http.StatusOK {
+			Expect(json.Unmarshal(bytes, &levels)).To(Succeed())
+		}
+		return resp.StatusCode, levels
+	}
+
+	It("should change the log levels of the components", func() {
+		// given
+		startApiServer(config.DefaultApiServerConfig())
+
+		// when
+		status, levels := request("PUT", "/xds-server", `{"level": "debug"}`)
+
+		// then
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(levels).To(Equal(types.LogLevels{
+			Default: "info",
+			Components: []types.ComponentLogLevel{
+				{Component: "xds-server", Level: "debug"},
+			},
+		}))
+		Expect(kuma_log.DefaultComponentLevels.Level("xds-server.reconcile")).To(Equal(kuma_log.DebugLevel))
+
+		// when
+		status, levels = request("PUT", "", `{"level": "off"}`)
+
+		// then
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(levels.Default).To(Equal("off"))
+
+		// when
+		status, levels = request("DELETE", "/xds-server", "")
+
+		// then
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(levels).To(Equal(types.LogLevels{
+			Default:    "off",
+			Components: []types.ComponentLogLevel{},
+		}))
+
+		// when
+		status, levels = request("GET", "", "")
+
+		// then
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(levels.Default).To(Equal("off"))
+	})
+
+	It("should reject an unknown log level", func() {
+		// given
+		startApiServer(config.DefaultApiServerConfig())
+
+		// when
+		status, _ := request("PUT", "/xds-server", `{"level": "trace"}`)
+
+		// then
+		Expect(status).To(Equal(http.StatusBadRequest))
+		Expect(kuma_log.DefaultComponentLevels.Components()).To(BeEmpty())
+	})
+
+	It("should not let users other than admins change the log levels", func() {
+		// given
+		cfg := config.DefaultApiServerConfig()
+		cfg.Authn.LocalhostIsAdmin = false
+		startApiServer(cfg)
+
+		// when
+		status, _ := request("PUT", "/xds-server", `{"level": "debug"}`)
+
+		// then
+		Expect(status).To(Equal(http.StatusForbidden))
+		Expect(kuma_log.DefaultComponentLevels.Components()).To(BeEmpty())
+	})
+})
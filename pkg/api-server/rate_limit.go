@@ -0,0 +1,97 @@
+package api_server
+
+import (
+	"net"
+
+	"github.com/emicklei/go-restful"
+	"github.com/prometheus/client_golang/prometheus"
+
+	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/util/ratelimit"
+)
+
+const (
+	clientRateLimit   = "client"
+	endpointRateLimit = "endpoint"
+)
+
+// rateLimiter limits requests of every client and requests of every endpoint with token buckets.
+type rateLimiter struct {
+	// clients is nil when requests of clients are not limited
+	clients *ratelimit.KeyedLimiter
+	// endpoints is nil when requests of endpoints are not limited by default
+	endpoints *ratelimit.KeyedLimiter
+	// endpointOverrides are limits of specific endpoints, nil value means no limit
+	endpointOverrides map[string]*ratelimit.KeyedLimiter
+	rejected          *prometheus.CounterVec
+}
+
+func newRateLimiter(cfg api_server.ApiServerRateLimit, metrics metrics.Metrics) (*rateLimiter, error) {
+	rejected := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_server_rate_limited_requests",
+		Help: "Number of requests rejected by the API Server because of exceeded rate limit",
+	}, []string{"limit", "handler"})
+	if err := metrics.Register(rejected); err != nil {
+		return nil, err
+	}
+	limiter := &rateLimiter{
+		endpointOverrides: map[string]*ratelimit.KeyedLimiter{},
+		rejected:          rejected,
+	}
+	if cfg.ClientRequestsPerSecond > 0 {
+		limiter.clients = ratelimit.NewKeyedLimiter(cfg.ClientRequestsPerSecond, cfg.ClientBurst)
+	}
+	if cfg.EndpointRequestsPerSecond > 0 {
+		limiter.endpoints = ratelimit.NewKeyedLimiter(cfg.EndpointRequestsPerSecond, cfg.EndpointBurst)
+	}
+	for _, endpoint := range cfg.Endpoints {
+		var endpointLimiter *ratelimit.KeyedLimiter
+		if endpoint.RequestsPerSecond > 0 {
+			endpointLimiter = ratelimit.NewKeyedLimiter(endpoint.RequestsPerSecond, endpoint.Burst)
+		}
+		limiter.endpointOverrides[endpoint.Path] = endpointLimiter
+	}
+	return limiter, nil
+}
+
+// Filter has to be executed after authentication, so requests of authenticated users are limited per user.
+func (r *rateLimiter) Filter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	endpoint := request.SelectedRoutePath()
+	if !r.allowEndpoint(endpoint) {
+		r.reject(endpointRateLimit, endpoint, response)
+		return
+	}
+	if r.clients != nil && !r.clients.Allow(clientKey(request)) {
+		r.reject(clientRateLimit, endpoint, response)
+		return
+	}
+	chain.ProcessFilter(request, response)
+}
+
+func (r *rateLimiter) allowEndpoint(endpoint string) bool {
+	limiter, ok := r.endpointOverrides[endpoint]
+	if !ok {
+		limiter = r.endpoints
+	}
+	return limiter == nil || limiter.Allow(endpoint)
+}
+
+func (r *rateLimiter) reject(limit string, endpoint string, response *restful.Response) {
+	r.rejected.WithLabelValues(limit, endpoint).Inc()
+	rest_errors.HandleError(response, &rest_errors.RateLimited{Limit: limit}, "Too many requests")
+}
+
+// clientKey identifies a client by the authenticated user or by the remote address for anonymous requests.
+func clientKey(request *restful.Request) string {
+	if u := user.FromCtx(request.Request.Context()); u.Name != user.Anonymous.Name {
+		return "user:" + u.Name
+	}
+	host, _, err := net.SplitHostPort(request.Request.RemoteAddr)
+	if err != nil {
+		host = request.Request.RemoteAddr
+	}
+	return "address:" + host
+}
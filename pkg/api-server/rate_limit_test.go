@@ -0,0 +1,77 @@
+package api_server_test
+
+import (
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Rate limit", func() {
+
+	var apiServerAddress string
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		cfg := config.DefaultApiServerConfig()
+		cfg.RateLimit.Enabled = true
+		cfg.RateLimit.ClientRequestsPerSecond = 0
+		cfg.RateLimit.Endpoints = []config.ApiServerEndpointRateLimit{
+			{
+				Path:              "/versions",
+				RequestsPerSecond: 0.001,
+				Burst:             2,
+			},
+		}
+		resourceStore := memory.NewStore()
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		apiServer := createTestApiServer(resourceStore, cfg, true, metrics)
+		apiServerAddress = apiServer.Address()
+
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// wait for the server, the index endpoint is not limited
+		Eventually(func() error {
+			_, err := http.Get(fmt.Sprintf("http://%s/", apiServerAddress))
+			return err
+		}, "3s").ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	It("should reject requests above the burst of the endpoint with 429", func() {
+		// when
+		var statuses []int
+		for i := 0; i < 3; i++ {
+			resp, err := http.Get(fmt.Sprintf("http://%s/versions", apiServerAddress))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			statuses = append(statuses, resp.StatusCode)
+			if resp.StatusCode == http.StatusTooManyRequests {
+				Expect(resp.Header.Get("Retry-After")).To(Equal("1"))
+			}
+		}
+
+		// then
+		Expect(statuses).To(Equal([]int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}))
+
+		// and other endpoints are not limited
+		resp, err := http.Get(fmt.Sprintf("http://%s/", apiServerAddress))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	})
+})
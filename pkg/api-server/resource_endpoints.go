@@ -140,7 +140,7 @@ func (r *resourceEndpoints) createOrUpdateResource(request *restful.Request, res
 	}
 
 	resource := r.descriptor.NewObject()
-	if err := r.resManager.Get(request.Request.Context(), resource, store.GetByKey(name, meshName)); err != nil {
+	if err := r.resManager.Get(request.Request.Context(), resource, store.GetByKey(name, meshName), store.GetConsistent()); err != nil {
 		if store.IsResourceNotFound(err) {
 			r.createResource(request.Request.Context(), name, meshName, resourceRes.Spec, response)
 		} else {
@@ -215,7 +215,7 @@ func (r *resourceEndpoints) deleteResource(request *restful.Request, response *r
 	meshName := r.meshFromRequest(request)
 	resource := r.descriptor.NewObject()
 
-	if err := r.resManager.Get(request.Request.Context(), resource, store.GetByKey(name, meshName)); err != nil {
+	if err := r.resManager.Get(request.Request.Context(), resource, store.GetByKey(name, meshName), store.GetConsistent()); err != nil {
 		rest_errors.HandleError(response, err, "Could not delete a resource")
 		return
 	}
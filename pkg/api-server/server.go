@@ -32,6 +32,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/pkg/core/runtime"
+	kuma_log "github.com/kumahq/kuma/pkg/log"
 	"github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/certs"
 	"github.com/kumahq/kuma/pkg/tokens/builtin"
@@ -100,6 +101,13 @@ func NewApiServer(
 		container.Filter(authn.LocalhostAuthenticator)
 	}
 	container.Filter(authenticator)
+	if serverConfig.RateLimit.Enabled {
+		limiter, err := newRateLimiter(serverConfig.RateLimit, metrics)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create a rate limiter")
+		}
+		container.Filter(limiter.Filter)
+	}
 
 	cors := restful.CrossOriginResourceSharing{
 		ExposeHeaders:  []string{restful.HEADER_AccessControlAllowOrigin},
@@ -130,6 +138,8 @@ func NewApiServer(
 
 	container.Add(versionsWs())
 
+	container.Add(logLevelsWs(kuma_log.DefaultComponentLevels, cfg.Access.Static.AdminResources))
+
 	zonesWs := zonesWs(resManager)
 	container.Add(zonesWs)
 
@@ -167,6 +177,7 @@ func addResourcesEndpoints(ws *restful.WebService, defs []model.ResourceTypeDesc
 	}
 	dpOverviewEndpoints.addListEndpoint(ws, "/meshes/{mesh}")
 	dpOverviewEndpoints.addFindEndpoint(ws, "/meshes/{mesh}")
+	dpOverviewEndpoints.addSubscriptionsEndpoint(ws, "/meshes/{mesh}")
 	dpOverviewEndpoints.addListEndpoint(ws, "") // listing all resources in all meshes
 
 	zoneOverviewEndpoints := zoneOverviewEndpoints{
@@ -189,6 +200,24 @@ func addResourcesEndpoints(ws *restful.WebService, defs []model.ResourceTypeDesc
 	}
 	globalInsightsEndpoints.addEndpoint(ws)
 
+	encryptionReportEndpoints := encryptionReportEndpoints{
+		resManager: resManager,
+	}
+	encryptionReportEndpoints.addEndpoint(ws)
+
+	upgradeReadinessEndpoints := upgradeReadinessEndpoints{
+		resManager: resManager,
+	}
+	upgradeReadinessEndpoints.addEndpoint(ws)
+
+	vipsEndpoints := vipsEndpoints{
+		resManager: resManager,
+	}
+	if cfg.Mode == config_core.Zone {
+		vipsEndpoints.zone = cfg.Multizone.Zone.Name
+	}
+	vipsEndpoints.addEndpoint(ws)
+
 	for _, definition := range defs {
 		defType := definition.Name
 		if cfg.ApiServer.ReadOnly || (defType == mesh.DataplaneType && cfg.Mode == config_core.Global) || (defType != mesh.DataplaneType && cfg.Mode == config_core.Zone) {
@@ -236,7 +265,11 @@ func dataplaneTokenWs(resManager manager.ResourceManager, access tokens_access.G
 	if err != nil {
 		return nil, err
 	}
-	return tokens_server.NewWebservice(dpIssuer, zoneIngressIssuer, access), nil
+	zoneEnrollmentIssuer, err := builtin.NewZoneEnrollmentTokenIssuer(resManager)
+	if err != nil {
+		return nil, err
+	}
+	return tokens_server.NewWebservice(dpIssuer, zoneIngressIssuer, zoneEnrollmentIssuer, access), nil
 }
 
 func (a *ApiServer) Start(stop <-chan struct{}) error {
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"time"
+)
+
+// ConfigChange is a change of a policy observed by the Control Plane together with its propagation
+// to the data plane proxies connected to the instance of the Control Plane.
+type ConfigChange struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Mesh      string    `json:"mesh"`
+	Name      string    `json:"name"`
+	Operation string    `json:"operation"`
+	Time      time.Time `json:"time"`
+	// Affected is a number of proxies whose configuration was changed by the change
+	Affected int `json:"affected"`
+	// Acknowledged is a number of affected proxies that acknowledged the new configuration
+	Acknowledged int `json:"acknowledged"`
+	// Proxies are reported only when a single change is requested
+	Proxies []ConfigChangeProxy `json:"proxies,omitempty"`
+}
+
+// ConfigChangeProxy is the propagation of a change to a single data plane proxy.
+type ConfigChangeProxy struct {
+	Name string `json:"name"`
+	// Generated is the time when the configuration that includes the change was generated
+	Generated time.Time `json:"generated"`
+	// Acknowledged is the time when the proxy acknowledged the configuration or nil if it hasn't yet
+	Acknowledged *time.Time `json:"acknowledged,omitempty"`
+}
+
+type ConfigChangeList struct {
+	Items []ConfigChange `json:"items"`
+}
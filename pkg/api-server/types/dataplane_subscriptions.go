@@ -0,0 +1,22 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// DataplaneSubscriptions is a page of the subscriptions of a Dataplane to the Control Plane,
+// the oldest first, together with the summary of its whole connection history.
+type DataplaneSubscriptions struct {
+	Summary DataplaneSubscriptionSummary `json:"summary"`
+	Total   uint32                       `json:"total"`
+	Items   []json.RawMessage            `json:"items"`
+	Next    *string                      `json:"next"`
+}
+
+// DataplaneSubscriptionSummary includes the subscriptions that were pruned from the DataplaneInsight.
+// Uptime is the percentage of the time since the first connection that the Dataplane was connected.
+type DataplaneSubscriptionSummary struct {
+	ConnectCount         uint32  `json:"connectCount"`
+	Uptime               float64 `json:"uptime"`
+	LastDisconnectReason string  `json:"lastDisconnectReason,omitempty"`
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+const (
+	EncryptionMTLS          = "mTLS"
+	EncryptionTLSOriginated = "TLSOriginated"
+	EncryptionPlaintext     = "Plaintext"
+)
+
+// EncryptionReport lists how the traffic between each pair of services of the mesh is encrypted.
+type EncryptionReport struct {
+	Mesh  string                 `json:"mesh"`
+	Paths []EncryptionReportPath `json:"paths"`
+}
+
+// EncryptionReportPath is a pair of services that exchange traffic.
+// Reason explains why the traffic is not encrypted with mTLS.
+type EncryptionReportPath struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	External    bool   `json:"external"`
+	Encryption  string `json:"encryption"`
+	Reason      string `json:"reason,omitempty"`
+}
+
+var encryptionReportCSVHeader = []string{"mesh", "source", "destination", "external", "encryption", "reason"}
+
+// WriteCSV writes the report as CSV with a header row.
+func (r *EncryptionReport) WriteCSV(out io.Writer) error {
+	w := csv.NewWriter(out)
+	if err := w.Write(encryptionReportCSVHeader); err != nil {
+		return err
+	}
+	for _, path := range r.Paths {
+		external := "false"
+		if path.External {
+			external = "true"
+		}
+		if err := w.Write([]string{r.Mesh, path.Source, path.Destination, external, path.Encryption, path.Reason}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,19 @@
+package types
+
+// LogLevels are the log levels of the Control Plane. The components log with the default level
+// unless they or one of their parent components have a level of their own.
+type LogLevels struct {
+	Default    string              `json:"default"`
+	Components []ComponentLogLevel `json:"components"`
+}
+
+// ComponentLogLevel is the level of a component, e.g. "xds-server" or "xds-server.reconcile".
+type ComponentLogLevel struct {
+	Component string `json:"component"`
+	Level     string `json:"level"`
+}
+
+// LogLevel is the body of a request that changes a log level.
+type LogLevel struct {
+	Level string `json:"level"`
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+type ProxyTemplatePreviewResponse struct {
+	Resources          []ProxyTemplatePreviewResource `json:"resources"`
+	AppliedPatches     []ProxyPatchPreview            `json:"appliedPatches,omitempty"`
+	ConflictingPatches []ProxyPatchPreview            `json:"conflictingPatches,omitempty"`
+}
+
+// ProxyTemplatePreviewResource is an Envoy resource rendered for a data plane proxy.
+// Errors contains violations of the Envoy API, the resource would be rejected by Envoy if it is not empty.
+type ProxyTemplatePreviewResource struct {
+	Name     string          `json:"name"`
+	Type     string          `json:"type"`
+	Origin   string          `json:"origin"`
+	Resource json.RawMessage `json:"resource,omitempty"`
+	Errors   []string        `json:"errors,omitempty"`
+}
+
+// ProxyPatchPreview is a patch of a ProxyPatch matched for the rendered resource.
+// Conflicting patches were skipped, because the Path was already modified by the more specific ConflictsWith ProxyPatch.
+type ProxyPatchPreview struct {
+	Policy        string `json:"policy"`
+	Index         int    `json:"index"`
+	Type          string `json:"type"`
+	Resource      string `json:"resource"`
+	Path          string `json:"path,omitempty"`
+	ConflictsWith string `json:"conflictsWith,omitempty"`
+}
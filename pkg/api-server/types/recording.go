@@ -0,0 +1,60 @@
+package types
+
+import (
+	"time"
+)
+
+// Recording is a sample of the requests handled by a data plane proxy, recorded to be replayed later.
+type Recording struct {
+	Name         string            `json:"name"`
+	Mesh         string            `json:"mesh"`
+	Dataplane    string            `json:"dataplane"`
+	CreationTime time.Time         `json:"creationTime"`
+	Requests     []RecordedRequest `json:"requests"`
+}
+
+// RecordedRequest is a request handled by a data plane proxy together with the response it received.
+// Pseudo-headers are not a part of Headers.
+type RecordedRequest struct {
+	Method        string           `json:"method"`
+	Path          string           `json:"path"`
+	Authority     string           `json:"authority,omitempty"`
+	Headers       []RecordedHeader `json:"headers,omitempty"`
+	Body          []byte           `json:"body,omitempty"`
+	BodyTruncated bool             `json:"bodyTruncated,omitempty"`
+	Response      RecordedResponse `json:"response"`
+}
+
+// RecordedResponse is the response to a RecordedRequest.
+type RecordedResponse struct {
+	Status        int              `json:"status"`
+	Headers       []RecordedHeader `json:"headers,omitempty"`
+	Body          []byte           `json:"body,omitempty"`
+	BodyTruncated bool             `json:"bodyTruncated,omitempty"`
+}
+
+type RecordedHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// RecordingRequest starts a recording. Key is the AES-256 key that the recording is encrypted with,
+// the Control Plane does not store it.
+type RecordingRequest struct {
+	Key      []byte `json:"key"`
+	Duration string `json:"duration,omitempty"`
+}
+
+// RecordingSummary describes a stored recording without exposing the recorded requests.
+type RecordingSummary struct {
+	Name      string `json:"name"`
+	Mesh      string `json:"mesh"`
+	Dataplane string `json:"dataplane"`
+	Requests  int    `json:"requests"`
+}
+
+// EncryptedRecording is a stored recording, Data can be decrypted only with the key of the RecordingRequest.
+type EncryptedRecording struct {
+	Name string `json:"name"`
+	Data []byte `json:"data"`
+}
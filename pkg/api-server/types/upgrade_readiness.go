@@ -0,0 +1,64 @@
+package types
+
+const (
+	UpgradeIssueBlocker = "Blocker"
+	UpgradeIssueWarning = "Warning"
+
+	UpgradeComponentZoneCP = "ZoneCP"
+	UpgradeComponentKumaDP = "KumaDP"
+	UpgradeComponentEnvoy  = "Envoy"
+)
+
+// UpgradeReadinessReport aggregates versions of Zone CPs, kuma-dp and Envoy in every zone
+// and lists issues that have to be resolved before upgrading to the target version.
+type UpgradeReadinessReport struct {
+	GlobalCPVersion string                 `json:"globalCpVersion"`
+	TargetVersion   string                 `json:"targetVersion"`
+	Ready           bool                   `json:"ready"`
+	Zones           []ZoneUpgradeReadiness `json:"zones"`
+}
+
+// ZoneUpgradeReadiness is the part of the report of a single zone.
+type ZoneUpgradeReadiness struct {
+	Zone           string         `json:"zone"`
+	ZoneCPVersion  string         `json:"zoneCpVersion"`
+	KumaDPVersions []VersionCount `json:"kumaDpVersions"`
+	EnvoyVersions  []VersionCount `json:"envoyVersions"`
+	Issues         []UpgradeIssue `json:"issues"`
+}
+
+// VersionCount is the number of data plane proxies and zone ingresses running the version.
+type VersionCount struct {
+	Version string `json:"version"`
+	Count   int    `json:"count"`
+}
+
+// UpgradeIssue describes a problem of a component with the version.
+// Count is the number of data plane proxies and zone ingresses with the problem.
+type UpgradeIssue struct {
+	Severity  string `json:"severity"`
+	Component string `json:"component"`
+	Version   string `json:"version"`
+	Reason    string `json:"reason"`
+	Count     int    `json:"count"`
+}
+
+// Blockers returns the number of issues that block the upgrade.
+func (z *ZoneUpgradeReadiness) Blockers() int {
+	return z.count(UpgradeIssueBlocker)
+}
+
+// Warnings returns the number of issues that don't block the upgrade.
+func (z *ZoneUpgradeReadiness) Warnings() int {
+	return z.count(UpgradeIssueWarning)
+}
+
+func (z *ZoneUpgradeReadiness) count(severity string) int {
+	count := 0
+	for _, issue := range z.Issues {
+		if issue.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
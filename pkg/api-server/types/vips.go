@@ -0,0 +1,32 @@
+package types
+
+import (
+	"time"
+)
+
+// VIPTable lists the virtual IPs allocated to the services and hostnames of a mesh
+// by the Control Plane that serves the table.
+type VIPTable struct {
+	Mesh string `json:"mesh"`
+	// Zone is the zone of the Control Plane, it is empty on standalone and global Control Planes.
+	Zone string `json:"zone,omitempty"`
+	// GenerationTime is the last time the VIPs were allocated, it is empty when there are none.
+	GenerationTime *time.Time      `json:"generationTime,omitempty"`
+	Entries        []VIPTableEntry `json:"entries"`
+}
+
+// VIPTableEntry is the virtual IP of a hostname.
+type VIPTableEntry struct {
+	Type      string        `json:"type"`
+	Hostname  string        `json:"hostname"`
+	VIP       string        `json:"vip"`
+	Outbounds []VIPOutbound `json:"outbounds"`
+}
+
+// VIPOutbound is a port of a hostname and the tags that select its destination.
+// Origin tells what the outbound comes from, e.g. a service or a VirtualOutbound policy.
+type VIPOutbound struct {
+	Port   uint32            `json:"port,omitempty"`
+	Tags   map[string]string `json:"tags"`
+	Origin string            `json:"origin"`
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// XdsDivergenceMissing is a resource served by the active Control Plane that is not generated in the shadow mode
+	XdsDivergenceMissing = "Missing"
+	// XdsDivergenceUnexpected is a resource generated in the shadow mode that is not served by the active Control Plane
+	XdsDivergenceUnexpected = "Unexpected"
+	// XdsDivergenceDifferent is a resource generated in the shadow mode that differs from the one served by the active Control Plane
+	XdsDivergenceDifferent = "Different"
+)
+
+// DataplaneXdsSnapshot is the configuration that the Control Plane serves to a data plane proxy
+// together with the environment-specific metadata of the proxy that are needed to generate the same configuration.
+type DataplaneXdsSnapshot struct {
+	Metadata  DataplaneXdsMetadata  `json:"metadata"`
+	Resources []XdsSnapshotResource `json:"resources"`
+}
+
+// DataplaneXdsMetadata is the metadata that a data plane proxy sends to the Control Plane except its token.
+type DataplaneXdsMetadata struct {
+	AdminPort       uint32            `json:"adminPort,omitempty"`
+	DNSPort         uint32            `json:"dnsPort,omitempty"`
+	EmptyDNSPort    uint32            `json:"emptyDnsPort,omitempty"`
+	ProxyType       string            `json:"proxyType,omitempty"`
+	SecretsDir      string            `json:"secretsDir,omitempty"`
+	DynamicMetadata map[string]string `json:"dynamicMetadata,omitempty"`
+	Version         json.RawMessage   `json:"version,omitempty"`
+}
+
+// XdsSnapshotResource is an Envoy resource served to a data plane proxy.
+// Resource is empty for secrets, private keys are never exposed.
+type XdsSnapshotResource struct {
+	Name     string          `json:"name"`
+	Type     string          `json:"type"`
+	Resource json.RawMessage `json:"resource,omitempty"`
+}
+
+// ShadowReport is a result of the comparison of configuration generated in the shadow mode
+// with the configuration served by the active Control Plane.
+type ShadowReport struct {
+	ActiveControlPlaneURL string                  `json:"activeControlPlaneUrl"`
+	Dataplanes            []ShadowDataplaneReport `json:"dataplanes"`
+}
+
+type ShadowDataplaneReport struct {
+	Mesh        string          `json:"mesh"`
+	Name        string          `json:"name"`
+	ComparedAt  time.Time       `json:"comparedAt"`
+	Error       string          `json:"error,omitempty"`
+	Divergences []XdsDivergence `json:"divergences"`
+}
+
+type XdsDivergence struct {
+	Kind string `json:"kind"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
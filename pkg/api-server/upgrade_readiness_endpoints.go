@@ -0,0 +1,310 @@
+package api_server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/emicklei/go-restful"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/kds/util"
+	kuma_version "github.com/kumahq/kuma/pkg/version"
+)
+
+const (
+	reasonUnknownVersion       = "version is unknown, it has never been connected"
+	reasonInvalidVersion       = "version is not a valid semantic version"
+	reasonNewerThanTarget      = "version is newer than the target version"
+	reasonTooOld               = "version is more than one minor version behind the target version, it has to be upgraded first"
+	reasonNewerThanZoneCP      = "version is newer than the version of the Zone CP"
+	reasonUnknownCompatibility = "compatible Envoy version of kuma-dp %s is unknown"
+	reasonIncompatibleEnvoy    = "version is not compatible with kuma-dp %s, compatible Envoy version: %s"
+)
+
+type upgradeReadinessEndpoints struct {
+	resManager manager.ResourceManager
+}
+
+func (r *upgradeReadinessEndpoints) addEndpoint(ws *restful.WebService) {
+	ws.Route(ws.GET("/upgrade-readiness").To(r.report).
+		Doc("Report versions of Zone CPs, kuma-dp and Envoy in every zone and issues that block the upgrade").
+		Param(ws.QueryParameter("version", "Target version of the upgrade, the version of the Control Plane by default").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(400, "Bad request", nil))
+}
+
+func (r *upgradeReadinessEndpoints) report(request *restful.Request, response *restful.Response) {
+	target := request.QueryParameter("version")
+	if target == "" {
+		target = kuma_version.Build.Version
+	} else if _, err := semver.NewVersion(target); err != nil {
+		verr := validators.ValidationError{}
+		verr.AddViolation("version", "has to be a valid semantic version")
+		rest_errors.HandleError(response, &verr, "Could not build an upgrade readiness report")
+		return
+	}
+
+	report, err := r.buildReport(request.Request.Context(), target)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not build an upgrade readiness report")
+		return
+	}
+	if err := response.WriteAsJson(report); err != nil {
+		rest_errors.HandleError(response, err, "Could not write an upgrade readiness report")
+	}
+}
+
+func (r *upgradeReadinessEndpoints) buildReport(ctx context.Context, target string) (*types.UpgradeReadinessReport, error) {
+	zones := &system.ZoneResourceList{}
+	if err := r.resManager.List(ctx, zones); err != nil {
+		return nil, err
+	}
+	zoneInsights := &system.ZoneInsightResourceList{}
+	if err := r.resManager.List(ctx, zoneInsights); err != nil {
+		return nil, err
+	}
+	dataplanes := &mesh.DataplaneResourceList{}
+	if err := r.resManager.List(ctx, dataplanes); err != nil {
+		return nil, err
+	}
+	dataplaneInsights := &mesh.DataplaneInsightResourceList{}
+	if err := r.resManager.List(ctx, dataplaneInsights); err != nil {
+		return nil, err
+	}
+	zoneIngresses := &mesh.ZoneIngressResourceList{}
+	if err := r.resManager.List(ctx, zoneIngresses); err != nil {
+		return nil, err
+	}
+	zoneIngressInsights := &mesh.ZoneIngressInsightResourceList{}
+	if err := r.resManager.List(ctx, zoneIngressInsights); err != nil {
+		return nil, err
+	}
+
+	builder := newUpgradeReadinessBuilder(kuma_version.Build.Version, target)
+	for _, zone := range zones.Items {
+		builder.zone(zone.GetMeta().GetName())
+	}
+	for _, insight := range zoneInsights.Items {
+		subscription, _ := insight.Spec.GetLatestSubscription()
+		builder.zoneCP(insight.GetMeta().GetName(), subscription.GetVersion().GetKumaCp().GetVersion())
+	}
+
+	dpVersions := map[model.ResourceKey]*mesh_proto.Version{}
+	for _, insight := range dataplaneInsights.Items {
+		subscription, _ := insight.Spec.GetLatestSubscription()
+		dpVersions[model.MetaToResourceKey(insight.GetMeta())] = subscription.GetVersion()
+	}
+	for _, dataplane := range dataplanes.Items {
+		builder.proxy(util.ZoneTag(dataplane), dpVersions[model.MetaToResourceKey(dataplane.GetMeta())])
+	}
+
+	ingressVersions := map[model.ResourceKey]*mesh_proto.Version{}
+	for _, insight := range zoneIngressInsights.Items {
+		subscription, _ := insight.Spec.GetLatestSubscription()
+		ingressVersions[model.MetaToResourceKey(insight.GetMeta())] = subscription.GetVersion()
+	}
+	for _, zoneIngress := range zoneIngresses.Items {
+		builder.proxy(zoneIngress.Spec.GetZone(), ingressVersions[model.MetaToResourceKey(zoneIngress.GetMeta())])
+	}
+
+	return builder.build(), nil
+}
+
+type issueKey struct {
+	severity  string
+	component string
+	version   string
+	reason    string
+}
+
+type zoneUpgradeReadiness struct {
+	zoneCPVersion  string
+	kumaDPVersions map[string]int
+	envoyVersions  map[string]int
+	issues         map[issueKey]int
+}
+
+// upgradeReadinessBuilder aggregates versions of components of every zone and checks them against the target version.
+// Zone CPs and data plane proxies can be at most one minor version behind the target version, data plane proxies cannot be
+// newer than their Zone CP and Envoy has to be compatible with kuma-dp according to the compatibility matrix.
+type upgradeReadinessBuilder struct {
+	globalCPVersion string
+	target          string
+	// targetVersion is nil when the target version is not a semantic version (ex. a development build),
+	// then versions are not compared with the target version
+	targetVersion *semver.Version
+	zones         map[string]*zoneUpgradeReadiness
+}
+
+func newUpgradeReadinessBuilder(globalCPVersion string, target string) *upgradeReadinessBuilder {
+	targetVersion, _ := semver.NewVersion(target)
+	return &upgradeReadinessBuilder{
+		globalCPVersion: globalCPVersion,
+		target:          target,
+		targetVersion:   targetVersion,
+		zones:           map[string]*zoneUpgradeReadiness{},
+	}
+}
+
+func (b *upgradeReadinessBuilder) zone(name string) *zoneUpgradeReadiness {
+	zone, ok := b.zones[name]
+	if !ok {
+		zone = &zoneUpgradeReadiness{
+			kumaDPVersions: map[string]int{},
+			envoyVersions:  map[string]int{},
+			issues:         map[issueKey]int{},
+		}
+		b.zones[name] = zone
+	}
+	return zone
+}
+
+func (b *upgradeReadinessBuilder) zoneCP(name string, version string) {
+	zone := b.zone(name)
+	zone.zoneCPVersion = version
+}
+
+func (b *upgradeReadinessBuilder) proxy(zoneName string, version *mesh_proto.Version) {
+	zone := b.zone(zoneName)
+	kumaDPVersion := version.GetKumaDp().GetVersion()
+	envoyVersion := version.GetEnvoy().GetVersion()
+	if kumaDPVersion != "" {
+		zone.kumaDPVersions[kumaDPVersion]++
+	}
+	if envoyVersion != "" {
+		zone.envoyVersions[envoyVersion]++
+	}
+
+	dpVersion, ok := b.checkVersion(zone, types.UpgradeComponentKumaDP, kumaDPVersion)
+	if !ok {
+		return
+	}
+	if cpVersion, err := semver.NewVersion(zone.zoneCPVersion); err == nil && dpVersion.GreaterThan(cpVersion) {
+		zone.issues[issueKey{types.UpgradeIssueBlocker, types.UpgradeComponentKumaDP, kumaDPVersion, reasonNewerThanZoneCP}]++
+	}
+	if envoyVersion == "" {
+		return
+	}
+	compatibility, err := kuma_version.CompatibilityMatrix.DataplaneConstraints(kumaDPVersion)
+	if err != nil {
+		zone.issues[issueKey{types.UpgradeIssueWarning, types.UpgradeComponentEnvoy, envoyVersion, fmt.Sprintf(reasonUnknownCompatibility, kumaDPVersion)}]++
+		return
+	}
+	if !envoyCompatible(compatibility.Envoy, envoyVersion) {
+		zone.issues[issueKey{types.UpgradeIssueBlocker, types.UpgradeComponentEnvoy, envoyVersion, fmt.Sprintf(reasonIncompatibleEnvoy, kumaDPVersion, compatibility.Envoy)}]++
+	}
+}
+
+// checkVersion checks the version of a component against the target version and returns the parsed version
+// unless the version is unknown.
+func (b *upgradeReadinessBuilder) checkVersion(zone *zoneUpgradeReadiness, component string, version string) (*semver.Version, bool) {
+	if version == "" {
+		zone.issues[issueKey{types.UpgradeIssueWarning, component, version, reasonUnknownVersion}]++
+		return nil, false
+	}
+	parsed, err := semver.NewVersion(version)
+	if err != nil {
+		zone.issues[issueKey{types.UpgradeIssueWarning, component, version, reasonInvalidVersion}]++
+		return nil, false
+	}
+	if b.targetVersion == nil {
+		return parsed, true
+	}
+	switch {
+	case parsed.GreaterThan(b.targetVersion):
+		zone.issues[issueKey{types.UpgradeIssueBlocker, component, version, reasonNewerThanTarget}]++
+	case parsed.Major() < b.targetVersion.Major() || (parsed.Major() == b.targetVersion.Major() && parsed.Minor()+1 < b.targetVersion.Minor()):
+		zone.issues[issueKey{types.UpgradeIssueBlocker, component, version, reasonTooOld}]++
+	}
+	return parsed, true
+}
+
+func envoyCompatible(constraint string, version string) bool {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return c.Check(v)
+}
+
+func (b *upgradeReadinessBuilder) build() *types.UpgradeReadinessReport {
+	report := &types.UpgradeReadinessReport{
+		GlobalCPVersion: b.globalCPVersion,
+		TargetVersion:   b.target,
+		Ready:           true,
+		Zones:           []types.ZoneUpgradeReadiness{},
+	}
+	var names []string
+	for name := range b.zones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		zone := b.zones[name]
+		// proxies without a zone (ex. in standalone mode) are not managed by a Zone CP
+		if name != "" {
+			b.checkVersion(zone, types.UpgradeComponentZoneCP, zone.zoneCPVersion)
+		}
+		zoneReport := types.ZoneUpgradeReadiness{
+			Zone:           name,
+			ZoneCPVersion:  zone.zoneCPVersion,
+			KumaDPVersions: versionCounts(zone.kumaDPVersions),
+			EnvoyVersions:  versionCounts(zone.envoyVersions),
+			Issues:         []types.UpgradeIssue{},
+		}
+		for key, count := range zone.issues {
+			zoneReport.Issues = append(zoneReport.Issues, types.UpgradeIssue{
+				Severity:  key.severity,
+				Component: key.component,
+				Version:   key.version,
+				Reason:    key.reason,
+				Count:     count,
+			})
+		}
+		sort.Slice(zoneReport.Issues, func(i, j int) bool {
+			a, b := zoneReport.Issues[i], zoneReport.Issues[j]
+			if a.Severity != b.Severity {
+				return a.Severity == types.UpgradeIssueBlocker
+			}
+			if a.Component != b.Component {
+				return a.Component < b.Component
+			}
+			if a.Version != b.Version {
+				return a.Version < b.Version
+			}
+			return a.Reason < b.Reason
+		})
+		if zoneReport.Blockers() > 0 {
+			report.Ready = false
+		}
+		report.Zones = append(report.Zones, zoneReport)
+	}
+	return report
+}
+
+func versionCounts(counts map[string]int) []types.VersionCount {
+	result := []types.VersionCount{}
+	for version, count := range counts {
+		result = append(result, types.VersionCount{
+			Version: version,
+			Count:   count,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+	return result
+}
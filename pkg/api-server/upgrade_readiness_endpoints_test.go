@@ -0,0 +1,250 @@
+package api_server_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	kuma_version "github.com/kumahq/kuma/pkg/version"
+)
+
+var _ = Describe("Upgrade Readiness Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop chan struct{}
+	var backupBuildInfo kuma_version.BuildInfo
+
+	BeforeEach(func() {
+		backupBuildInfo = kuma_version.Build
+		kuma_version.Build = kuma_version.BuildInfo{
+			Version: "1.3.0",
+		}
+
+		resourceStore = memory.NewStore()
+
+		metrics, err := metrics.NewMetrics("Global")
+		Expect(err).ToNot(HaveOccurred())
+
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig(), true, metrics)
+
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+
+		stop = make(chan struct{})
+
+		go func() {
+			defer GinkgoRecover()
+			Expect(apiServer.Start(stop)).To(Succeed())
+		}()
+
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+		kuma_version.Build = backupBuildInfo
+	})
+
+	create := func(resource core_model.Resource, name, mesh, spec string) {
+		Expect(util_proto.FromYAML([]byte(spec), resource.GetSpec())).To(Succeed())
+		Expect(resourceStore.Create(context.Background(), resource, store.CreateByKey(name, mesh))).To(Succeed())
+	}
+
+	dataplane := func(name, zone string) {
+		create(core_mesh.NewDataplaneResource(), name, "mesh-1", `
+        networking:
+          address: 192.168.0.1
+          inbound:
+          - port: 8080
+            tags:
+              kuma.io/service: web
+              kuma.io/zone: `+zone)
+	}
+
+	dataplaneInsight := func(name, kumaDpVersion, envoyVersion string) {
+		create(core_mesh.NewDataplaneInsightResource(), name, "mesh-1", `
+        subscriptions:
+        - id: "1"
+          version:
+            kumaDp:
+              version: `+kumaDpVersion+`
+            envoy:
+              version: `+envoyVersion)
+	}
+
+	zone := func(name, kumaCpVersion string) {
+		create(system.NewZoneResource(), name, core_model.NoMesh, `{}`)
+		if kumaCpVersion == "" {
+			return
+		}
+		create(system.NewZoneInsightResource(), name, core_model.NoMesh, `
+        subscriptions:
+        - id: "1"
+          version:
+            kumaCp:
+              version: `+kumaCpVersion)
+	}
+
+	BeforeEach(func() {
+		create(core_mesh.NewMeshResource(), "mesh-1", core_model.NoMesh, `{}`)
+
+		zone("zone-1", "1.2.0")
+		dataplane("web-01", "zone-1")
+		dataplaneInsight("web-01", "1.2.0", "1.18.0")
+		dataplane("web-02", "zone-1")
+		dataplaneInsight("web-02", "1.2.1", "1.17.0")
+		dataplane("web-03", "zone-1")
+		create(core_mesh.NewZoneIngressResource(), "ingress-01", core_model.NoMesh, `
+        zone: zone-1
+        networking:
+          address: 192.168.0.2
+          port: 10001`)
+		create(core_mesh.NewZoneIngressInsightResource(), "ingress-01", core_model.NoMesh, `
+        subscriptions:
+        - id: "1"
+          version:
+            kumaDp:
+              version: 1.2.0
+            envoy:
+              version: 1.18.0`)
+
+		zone("zone-2", "1.1.0")
+		dataplane("web-04", "zone-2")
+		dataplaneInsight("web-04", "1.1.0", "1.17.0")
+
+		zone("zone-3", "")
+	})
+
+	get := func(path string) (int, string) {
+		response, err := http.Get("http://" + apiServer.Address() + path)
+		Expect(err).ToNot(HaveOccurred())
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return response.StatusCode, string(body)
+	}
+
+	It("should report versions and blockers of the upgrade to the version of the Control Plane", func() {
+		// when
+		status, body := get("/upgrade-readiness")
+
+		// then
+		Expect(status).To(Equal(200))
+		Expect(body).To(MatchJSON(`
+        {
+          "globalCpVersion": "1.3.0",
+          "targetVersion": "1.3.0",
+          "ready": false,
+          "zones": [
+            {
+              "zone": "zone-1",
+              "zoneCpVersion": "1.2.0",
+              "kumaDpVersions": [
+                {"version": "1.2.0", "count": 2},
+                {"version": "1.2.1", "count": 1}
+              ],
+              "envoyVersions": [
+                {"version": "1.17.0", "count": 1},
+                {"version": "1.18.0", "count": 2}
+              ],
+              "issues": [
+                {
+                  "severity": "Blocker",
+                  "component": "Envoy",
+                  "version": "1.17.0",
+                  "reason": "version is not compatible with kuma-dp 1.2.1, compatible Envoy version: ~1.18.0",
+                  "count": 1
+                },
+                {
+                  "severity": "Blocker",
+                  "component": "KumaDP",
+                  "version": "1.2.1",
+                  "reason": "version is newer than the version of the Zone CP",
+                  "count": 1
+                },
+                {
+                  "severity": "Warning",
+                  "component": "KumaDP",
+                  "version": "",
+                  "reason": "version is unknown, it has never been connected",
+                  "count": 1
+                }
+              ]
+            },
+            {
+              "zone": "zone-2",
+              "zoneCpVersion": "1.1.0",
+              "kumaDpVersions": [
+                {"version": "1.1.0", "count": 1}
+              ],
+              "envoyVersions": [
+                {"version": "1.17.0", "count": 1}
+              ],
+              "issues": [
+                {
+                  "severity": "Blocker",
+                  "component": "KumaDP",
+                  "version": "1.1.0",
+                  "reason": "version is more than one minor version behind the target version, it has to be upgraded first",
+                  "count": 1
+                },
+                {
+                  "severity": "Blocker",
+                  "component": "ZoneCP",
+                  "version": "1.1.0",
+                  "reason": "version is more than one minor version behind the target version, it has to be upgraded first",
+                  "count": 1
+                }
+              ]
+            },
+            {
+              "zone": "zone-3",
+              "zoneCpVersion": "",
+              "kumaDpVersions": [],
+              "envoyVersions": [],
+              "issues": [
+                {
+                  "severity": "Warning",
+                  "component": "ZoneCP",
+                  "version": "",
+                  "reason": "version is unknown, it has never been connected",
+                  "count": 1
+                }
+              ]
+            }
+          ]
+        }`))
+	})
+
+	It("should report blockers of the upgrade to the given version", func() {
+		// when
+		status, body := get("/upgrade-readiness?version=1.2.0")
+
+		// then
+		Expect(status).To(Equal(200))
+		Expect(body).To(MatchRegexp(`"targetVersion":\s*"1.2.0"`))
+		Expect(body).To(MatchRegexp(`"reason":\s*"version is newer than the target version"`))
+	})
+
+	It("should reject invalid version", func() {
+		// when
+		status, body := get("/upgrade-readiness?version=latest")
+
+		// then
+		Expect(status).To(Equal(400))
+		Expect(body).To(ContainSubstring("has to be a valid semantic version"))
+	})
+})
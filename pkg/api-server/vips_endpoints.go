@@ -0,0 +1,83 @@
+package api_server
+
+import (
+	"context"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/dns/vips"
+)
+
+type vipsEndpoints struct {
+	resManager manager.ResourceManager
+	zone       string
+}
+
+func (v *vipsEndpoints) addEndpoint(ws *restful.WebService) {
+	ws.Route(ws.GET("/meshes/{mesh}/vips").To(v.inspect).
+		Doc("Inspect the virtual IPs allocated to the services and hostnames of a mesh").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (v *vipsEndpoints) inspect(request *restful.Request, response *restful.Response) {
+	table, err := v.buildTable(request.Request.Context(), request.PathParameter("mesh"))
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not inspect VIPs")
+		return
+	}
+
+	if err := response.WriteAsJson(table); err != nil {
+		rest_errors.HandleError(response, err, "Could not write VIPs")
+	}
+}
+
+func (v *vipsEndpoints) buildTable(ctx context.Context, meshName string) (*types.VIPTable, error) {
+	meshRes := mesh.NewMeshResource()
+	if err := v.resManager.Get(ctx, meshRes, store.GetByKey(meshName, model.NoMesh)); err != nil {
+		return nil, err
+	}
+
+	persistence := vips.NewPersistence(v.resManager, config_manager.NewConfigManager(v.resManager))
+	view, generationTime, err := persistence.GetByMeshWithTime(meshName)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &types.VIPTable{
+		Mesh:    meshName,
+		Zone:    v.zone,
+		Entries: []types.VIPTableEntry{},
+	}
+	if !generationTime.IsZero() {
+		table.GenerationTime = &generationTime
+	}
+
+	for _, entry := range view.HostnameEntries() {
+		outbound := view.Get(entry)
+		tableEntry := types.VIPTableEntry{
+			Type:      entry.Type.String(),
+			Hostname:  entry.Name,
+			VIP:       outbound.Address,
+			Outbounds: []types.VIPOutbound{},
+		}
+		for _, ob := range outbound.Outbounds {
+			tableEntry.Outbounds = append(tableEntry.Outbounds, types.VIPOutbound{
+				Port:   ob.Port,
+				Tags:   ob.TagSet,
+				Origin: ob.Origin,
+			})
+		}
+		table.Entries = append(table.Entries, tableEntry)
+	}
+
+	return table, nil
+}
@@ -0,0 +1,139 @@
+package api_server_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/dns/vips"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("VIPs Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig(), true, metrics)
+
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+
+		stop = make(chan struct{})
+
+		go func() {
+			defer GinkgoRecover()
+			Expect(apiServer.Start(stop)).To(Succeed())
+		}()
+
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	BeforeEach(func() {
+		for _, name := range []string{"mesh-1", "mesh-2"} {
+			err := resourceStore.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey(name, core_model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		view, err := vips.NewVirtualOutboundView(map[vips.HostnameEntry]vips.VirtualOutbound{
+			vips.NewServiceEntry("backend"): {
+				Address: "240.0.0.1",
+				Outbounds: []vips.OutboundEntry{
+					{TagSet: map[string]string{mesh_proto.ServiceTag: "backend"}, Origin: vips.OriginService},
+				},
+			},
+			vips.NewHostEntry("httpbin.org"): {
+				Address: "240.0.0.0",
+				Outbounds: []vips.OutboundEntry{
+					{Port: 443, TagSet: map[string]string{mesh_proto.ServiceTag: "httpbin"}, Origin: vips.OriginHost},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		persistence := vips.NewPersistence(manager.NewResourceManager(resourceStore), config_manager.NewConfigManager(resourceStore))
+		Expect(persistence.Set("mesh-1", view)).To(Succeed())
+	})
+
+	get := func(path string) (int, []byte) {
+		response, err := http.Get("http://" + apiServer.Address() + path)
+		Expect(err).ToNot(HaveOccurred())
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return response.StatusCode, body
+	}
+
+	It("should return the VIPs allocated in the mesh", func() {
+		// when
+		status, body := get("/meshes/mesh-1/vips")
+
+		// then
+		Expect(status).To(Equal(200))
+		table := types.VIPTable{}
+		Expect(json.Unmarshal(body, &table)).To(Succeed())
+		Expect(table.GenerationTime).ToNot(BeNil())
+		Expect(table.Mesh).To(Equal("mesh-1"))
+		Expect(table.Zone).To(BeEmpty())
+		Expect(table.Entries).To(Equal([]types.VIPTableEntry{
+			{
+				Type:     "service",
+				Hostname: "backend",
+				VIP:      "240.0.0.1",
+				Outbounds: []types.VIPOutbound{
+					{Tags: map[string]string{mesh_proto.ServiceTag: "backend"}, Origin: "service"},
+				},
+			},
+			{
+				Type:     "host",
+				Hostname: "httpbin.org",
+				VIP:      "240.0.0.0",
+				Outbounds: []types.VIPOutbound{
+					{Port: 443, Tags: map[string]string{mesh_proto.ServiceTag: "httpbin"}, Origin: "host"},
+				},
+			},
+		}))
+	})
+
+	It("should return an empty table when no VIP is allocated", func() {
+		// when
+		status, body := get("/meshes/mesh-2/vips")
+
+		// then
+		Expect(status).To(Equal(200))
+		Expect(body).To(MatchJSON(`{"mesh": "mesh-2", "entries": []}`))
+	})
+
+	It("should return 404 for unknown mesh", func() {
+		// when
+		status, _ := get("/meshes/unknown/vips")
+
+		// then
+		Expect(status).To(Equal(404))
+	})
+})
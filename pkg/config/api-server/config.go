@@ -22,6 +22,8 @@ type ApiServerConfig struct {
 	Auth ApiServerAuth `yaml:"auth"`
 	// Authentication configuration for API Server
 	Authn ApiServerAuthn `yaml:"authn"`
+	// Rate limiting configuration of the API Server
+	RateLimit ApiServerRateLimit `yaml:"rateLimit"`
 }
 
 // API Server HTTP configuration
@@ -92,6 +94,66 @@ type ApiServerAuthnTokens struct {
 	BootstrapAdminToken bool `yaml:"bootstrapAdminToken" envconfig:"kuma_api_server_authn_tokens_bootstrap_admin_token"`
 }
 
+// API Server rate limiting configuration. Requests that exceed a limit are rejected with 429 Too Many Requests.
+type ApiServerRateLimit struct {
+	// If true then requests to the API Server are rate limited
+	Enabled bool `yaml:"enabled" envconfig:"kuma_api_server_rate_limit_enabled"`
+	// Number of requests per second of a single client. A client is identified by the authenticated user
+	// or by the remote address for anonymous requests. 0 means no limit
+	ClientRequestsPerSecond float64 `yaml:"clientRequestsPerSecond" envconfig:"kuma_api_server_rate_limit_client_requests_per_second"`
+	// Maximum number of requests of a single client that can be made at once
+	ClientBurst int `yaml:"clientBurst" envconfig:"kuma_api_server_rate_limit_client_burst"`
+	// Number of requests per second of a single endpoint across all clients. 0 means no limit
+	EndpointRequestsPerSecond float64 `yaml:"endpointRequestsPerSecond" envconfig:"kuma_api_server_rate_limit_endpoint_requests_per_second"`
+	// Maximum number of requests of a single endpoint that can be made at once
+	EndpointBurst int `yaml:"endpointBurst" envconfig:"kuma_api_server_rate_limit_endpoint_burst"`
+	// Limits of specific endpoints that override EndpointRequestsPerSecond and EndpointBurst.
+	// Endpoints can only be defined in the configuration file.
+	Endpoints []ApiServerEndpointRateLimit `yaml:"endpoints" ignored:"true"`
+}
+
+// Rate limit of a single endpoint of the API Server
+type ApiServerEndpointRateLimit struct {
+	// Path of the endpoint as it is registered in the API Server, ex. /tokens/dataplane or /meshes/{mesh}/dataplanes
+	Path string `yaml:"path"`
+	// Number of requests per second of the endpoint across all clients. 0 means no limit
+	RequestsPerSecond float64 `yaml:"requestsPerSecond"`
+	// Maximum number of requests of the endpoint that can be made at once
+	Burst int `yaml:"burst"`
+}
+
+func (a *ApiServerRateLimit) Validate() error {
+	if a.ClientRequestsPerSecond < 0 {
+		return errors.New("ClientRequestsPerSecond cannot be negative")
+	}
+	if a.ClientRequestsPerSecond > 0 && a.ClientBurst < 1 {
+		return errors.New("ClientBurst has to be at least 1")
+	}
+	if a.EndpointRequestsPerSecond < 0 {
+		return errors.New("EndpointRequestsPerSecond cannot be negative")
+	}
+	if a.EndpointRequestsPerSecond > 0 && a.EndpointBurst < 1 {
+		return errors.New("EndpointBurst has to be at least 1")
+	}
+	paths := map[string]bool{}
+	for i, endpoint := range a.Endpoints {
+		if endpoint.Path == "" {
+			return errors.Errorf("Endpoints[%d].Path cannot be empty", i)
+		}
+		if paths[endpoint.Path] {
+			return errors.Errorf("Endpoints[%d] has a duplicated path %q", i, endpoint.Path)
+		}
+		paths[endpoint.Path] = true
+		if endpoint.RequestsPerSecond < 0 {
+			return errors.Errorf("Endpoints[%d].RequestsPerSecond cannot be negative", i)
+		}
+		if endpoint.RequestsPerSecond > 0 && endpoint.Burst < 1 {
+			return errors.Errorf("Endpoints[%d].Burst has to be at least 1", i)
+		}
+	}
+	return nil
+}
+
 func (a *ApiServerConfig) Sanitize() {
 }
 
@@ -102,6 +164,9 @@ func (a *ApiServerConfig) Validate() error {
 	if err := a.HTTPS.Validate(); err != nil {
 		return errors.Wrap(err, ".HTTP not valid")
 	}
+	if err := a.RateLimit.Validate(); err != nil {
+		return errors.Wrap(err, ".RateLimit not valid")
+	}
 	return nil
 }
 
@@ -131,5 +196,29 @@ func DefaultApiServerConfig() *ApiServerConfig {
 				BootstrapAdminToken: true,
 			},
 		},
+		RateLimit: ApiServerRateLimit{
+			Enabled:                   false,
+			ClientRequestsPerSecond:   50,
+			ClientBurst:               100,
+			EndpointRequestsPerSecond: 0, // no limit
+			EndpointBurst:             0,
+			Endpoints: []ApiServerEndpointRateLimit{
+				{
+					Path:              "/tokens",
+					RequestsPerSecond: 10,
+					Burst:             20,
+				},
+				{
+					Path:              "/tokens/dataplane",
+					RequestsPerSecond: 10,
+					Burst:             20,
+				},
+				{
+					Path:              "/tokens/zone-ingress",
+					RequestsPerSecond: 10,
+					Burst:             20,
+				},
+			},
+		},
 	}
 }
@@ -13,10 +13,12 @@ import (
 	"github.com/kumahq/kuma/pkg/config/diagnostics"
 	dns_server "github.com/kumahq/kuma/pkg/config/dns-server"
 	dp_server "github.com/kumahq/kuma/pkg/config/dp-server"
+	external_secrets "github.com/kumahq/kuma/pkg/config/external-secrets"
 	gui_server "github.com/kumahq/kuma/pkg/config/gui-server"
 	"github.com/kumahq/kuma/pkg/config/mads"
 	"github.com/kumahq/kuma/pkg/config/multizone"
 	"github.com/kumahq/kuma/pkg/config/plugins/runtime"
+	service_discovery "github.com/kumahq/kuma/pkg/config/service-discovery"
 	"github.com/kumahq/kuma/pkg/config/xds"
 	"github.com/kumahq/kuma/pkg/config/xds/bootstrap"
 )
@@ -27,12 +29,20 @@ var _ config.Config = &Defaults{}
 
 type Defaults struct {
 	SkipMeshCreation bool `yaml:"skipMeshCreation" envconfig:"kuma_defaults_skip_mesh_creation"`
+	// Paths of the templates of the policies that are created for every new Mesh in addition to the default ones.
+	// A template is rendered with the name of the Mesh as {{ .Mesh }}.
+	MeshPolicyTemplates []string `yaml:"meshPolicyTemplates" envconfig:"kuma_defaults_mesh_policy_templates"`
 }
 
 func (d *Defaults) Sanitize() {
 }
 
 func (d *Defaults) Validate() error {
+	for i, path := range d.MeshPolicyTemplates {
+		if path == "" {
+			return errors.Errorf(".MeshPolicyTemplates[%d] must not be empty", i)
+		}
+	}
 	return nil
 }
 
@@ -53,9 +63,10 @@ func (m *Metrics) Validate() error {
 }
 
 type DataplaneMetrics struct {
-	Enabled           bool          `yaml:"enabled" envconfig:"kuma_metrics_dataplane_enabled"`
-	SubscriptionLimit int           `yaml:"subscriptionLimit" envconfig:"kuma_metrics_dataplane_subscription_limit"`
-	IdleTimeout       time.Duration `yaml:"idleTimeout" envconfig:"kuma_metrics_dataplane_idle_timeout"`
+	Enabled            bool          `yaml:"enabled" envconfig:"kuma_metrics_dataplane_enabled"`
+	SubscriptionLimit  int           `yaml:"subscriptionLimit" envconfig:"kuma_metrics_dataplane_subscription_limit"`
+	SubscriptionMaxAge time.Duration `yaml:"subscriptionMaxAge" envconfig:"kuma_metrics_dataplane_subscription_max_age"`
+	IdleTimeout        time.Duration `yaml:"idleTimeout" envconfig:"kuma_metrics_dataplane_idle_timeout"`
 }
 
 func (d *DataplaneMetrics) Sanitize() {
@@ -65,6 +76,9 @@ func (d *DataplaneMetrics) Validate() error {
 	if d.SubscriptionLimit < 0 {
 		return errors.New("SubscriptionLimit should be positive or equal 0")
 	}
+	if d.SubscriptionMaxAge < 0 {
+		return errors.New("SubscriptionMaxAge should be positive or equal 0")
+	}
 	return nil
 }
 
@@ -89,6 +103,11 @@ type MeshMetrics struct {
 	MinResyncTimeout time.Duration `yaml:"minResyncTimeout" envconfig:"kuma_metrics_mesh_min_resync_timeout"`
 	// MaxResyncTimeout is a maximum time that MeshInsight could spend without resync
 	MaxResyncTimeout time.Duration `yaml:"maxResyncTimeout" envconfig:"kuma_metrics_mesh_max_resync_timeout"`
+	// ResyncJitter is a fraction of MaxResyncTimeout - MinResyncTimeout over which periodic resyncs of meshes are spread
+	ResyncJitter float64 `yaml:"resyncJitter" envconfig:"kuma_metrics_mesh_resync_jitter"`
+	// MaxLabeledMeshes is a maximum number of meshes with their own value of the mesh label of the per-mesh metrics.
+	// The meshes with the most data plane proxies get their own value, the others are aggregated as "_other".
+	MaxLabeledMeshes int `yaml:"maxLabeledMeshes" envconfig:"kuma_metrics_mesh_max_labeled_meshes"`
 }
 
 func (d *MeshMetrics) Sanitize() {
@@ -98,6 +117,12 @@ func (d *MeshMetrics) Validate() error {
 	if d.MaxResyncTimeout <= d.MinResyncTimeout {
 		return errors.New("MaxResyncTimeout should be greater than MinResyncTimeout")
 	}
+	if d.ResyncJitter < 0 || d.ResyncJitter >= 1 {
+		return errors.New("ResyncJitter must be in the range [0, 1)")
+	}
+	if d.MaxLabeledMeshes < 0 {
+		return errors.New("MaxLabeledMeshes should be positive or equal 0")
+	}
 	return nil
 }
 
@@ -143,6 +168,10 @@ type Config struct {
 	DpServer *dp_server.DpServerConfig `yaml:"dpServer"`
 	// Access Control configuration
 	Access access.AccessConfig `yaml:"access"`
+	// Service Discovery configuration
+	ServiceDiscovery *service_discovery.ServiceDiscoveryConfig `yaml:"serviceDiscovery"`
+	// External Secrets configuration
+	ExternalSecrets *external_secrets.ExternalSecretsConfig `yaml:"externalSecrets"`
 }
 
 func (c *Config) Sanitize() {
@@ -159,6 +188,8 @@ func (c *Config) Sanitize() {
 	c.DNSServer.Sanitize()
 	c.Multizone.Sanitize()
 	c.Diagnostics.Sanitize()
+	c.ServiceDiscovery.Sanitize()
+	c.ExternalSecrets.Sanitize()
 }
 
 func DefaultConfig() Config {
@@ -172,7 +203,8 @@ func DefaultConfig() Config {
 		BootstrapServer:            bootstrap.DefaultBootstrapServerConfig(),
 		Runtime:                    runtime.DefaultRuntimeConfig(),
 		Defaults: &Defaults{
-			SkipMeshCreation: false,
+			SkipMeshCreation:    false,
+			MeshPolicyTemplates: []string{},
 		},
 		Metrics: &Metrics{
 			Dataplane: &DataplaneMetrics{
@@ -188,18 +220,22 @@ func DefaultConfig() Config {
 			Mesh: &MeshMetrics{
 				MinResyncTimeout: 1 * time.Second,
 				MaxResyncTimeout: 20 * time.Second,
+				ResyncJitter:     0.1,
+				MaxLabeledMeshes: 20,
 			},
 		},
 		Reports: &Reports{
 			Enabled: true,
 		},
-		General:     DefaultGeneralConfig(),
-		GuiServer:   gui_server.DefaultGuiServerConfig(),
-		DNSServer:   dns_server.DefaultDNSServerConfig(),
-		Multizone:   multizone.DefaultMultizoneConfig(),
-		Diagnostics: diagnostics.DefaultDiagnosticsConfig(),
-		DpServer:    dp_server.DefaultDpServerConfig(),
-		Access:      access.DefaultAccessConfig(),
+		General:          DefaultGeneralConfig(),
+		GuiServer:        gui_server.DefaultGuiServerConfig(),
+		DNSServer:        dns_server.DefaultDNSServerConfig(),
+		Multizone:        multizone.DefaultMultizoneConfig(),
+		Diagnostics:      diagnostics.DefaultDiagnosticsConfig(),
+		DpServer:         dp_server.DefaultDpServerConfig(),
+		Access:           access.DefaultAccessConfig(),
+		ServiceDiscovery: service_discovery.DefaultServiceDiscoveryConfig(),
+		ExternalSecrets:  external_secrets.DefaultExternalSecretsConfig(),
 	}
 }
 
@@ -272,9 +308,18 @@ func (c *Config) Validate() error {
 	if err := c.DNSServer.Validate(); err != nil {
 		return errors.Wrap(err, "DNSServer validation failed")
 	}
+	if c.DNSServer.ExternalDNS.Type == dns_server.ExternalDNSTypeCRD && c.Environment != core.KubernetesEnvironment {
+		return errors.Errorf("DNSServer.ExternalDNS.Type %q is only available on %s", dns_server.ExternalDNSTypeCRD, core.KubernetesEnvironment)
+	}
 	if err := c.Diagnostics.Validate(); err != nil {
 		return errors.Wrap(err, "Diagnostics validation failed")
 	}
+	if err := c.ServiceDiscovery.Validate(); err != nil {
+		return errors.Wrap(err, "ServiceDiscovery validation failed")
+	}
+	if err := c.ExternalSecrets.Validate(); err != nil {
+		return errors.Wrap(err, "ExternalSecrets validation failed")
+	}
 	return nil
 }
 
@@ -22,15 +22,21 @@ func DefaultConfig() Config {
 			},
 		},
 		Dataplane: Dataplane{
-			Mesh:      "",
-			Name:      "",                                                      // Dataplane name must be set explicitly
-			AdminPort: config_types.MustPortRange(30001, config_types.MaxPort), // by default, automatically choose a free port for Envoy Admin interface
-			DrainTime: 30 * time.Second,
-			ProxyType: "dataplane",
+			Mesh:        "",
+			Name:        "",                                                      // Dataplane name must be set explicitly
+			AdminPort:   config_types.MustPortRange(30001, config_types.MaxPort), // by default, automatically choose a free port for Envoy Admin interface
+			DrainTime:   30 * time.Second,
+			ProxyType:   "dataplane",
+			FailMode:    FailModeOpen,
+			FailTimeout: 5 * time.Minute,
 		},
 		DataplaneRuntime: DataplaneRuntime{
 			BinaryPath: "envoy",
 			ConfigDir:  "", // if left empty, a temporary directory will be generated automatically
+			Hooks: Hooks{
+				Timeout:       30 * time.Second,
+				FailurePolicy: HookFailurePolicyIgnore,
+			},
 		},
 		DNS: DNS{
 			Enabled:                   true,
@@ -119,8 +125,22 @@ type Dataplane struct {
 	AdminPort config_types.PortRange `yaml:"adminPort,omitempty" envconfig:"kuma_dataplane_admin_port"`
 	// Drain time for listeners.
 	DrainTime time.Duration `yaml:"drainTime,omitempty" envconfig:"kuma_dataplane_drain_time"`
+	// FailMode defines how the dataplane behaves when it has been disconnected
+	// from the Control Plane for longer than FailTimeout, supported values: 'open', 'closed'.
+	// In the 'open' mode the dataplane keeps serving traffic with the last known
+	// configuration and raises an alert, in the 'closed' mode the dataplane is stopped.
+	FailMode string `yaml:"failMode,omitempty" envconfig:"kuma_dataplane_fail_mode"`
+	// FailTimeout is how long the dataplane can be disconnected from the Control Plane
+	// before its configuration is considered stale.
+	FailTimeout time.Duration `yaml:"failTimeout,omitempty" envconfig:"kuma_dataplane_fail_timeout"`
 }
 
+// Supported values of Dataplane.FailMode.
+const (
+	FailModeOpen   = "open"
+	FailModeClosed = "closed"
+)
+
 // DataplaneRuntime defines the context in which dataplane (Envoy) runs.
 type DataplaneRuntime struct {
 	// Path to Envoy binary.
@@ -129,6 +149,12 @@ type DataplaneRuntime struct {
 	ConfigDir string `yaml:"configDir,omitempty" envconfig:"kuma_dataplane_runtime_config_dir"`
 	// Concurrency specifies how to generate the Envoy concurrency flag.
 	Concurrency uint32 `yaml:"concurrency,omitempty" envconfig:"kuma_dataplane_runtime_concurrency"`
+	// MemoryLimit is the memory limit of Envoy in bytes, it is used by the control plane to configure Envoy overload manager.
+	// If not set, the limit of the cgroup kuma-dp runs in is used.
+	MemoryLimit uint64 `yaml:"memoryLimit,omitempty" envconfig:"kuma_dataplane_runtime_memory_limit"`
+	// SecretsDir is a directory (preferably tmpfs) to which kuma-dp writes identity and CA of the dataplane.
+	// When set, Envoy watches the files in this directory instead of receiving the secrets over the xDS stream.
+	SecretsDir string `yaml:"secretsDir,omitempty" envconfig:"kuma_dataplane_runtime_secrets_dir"`
 	// Path to a file with dataplane token (use 'kumactl generate dataplane-token' to get one)
 	TokenPath string `yaml:"dataplaneTokenPath,omitempty" envconfig:"kuma_dataplane_runtime_token_path"`
 	// Token is dataplane token's value provided directly, will be stored to a temporary file before applying
@@ -139,8 +165,31 @@ type DataplaneRuntime struct {
 	ResourcePath string `yaml:"resourcePath,omitempty" envconfig:"kuma_dataplane_runtime_resource_path"`
 	// ResourceVars are the StringToString values that can fill the Resource template
 	ResourceVars map[string]string `yaml:"resourceVars,omitempty"`
+	// Hooks are the local executables that kuma-dp runs on the events of the dataplane.
+	Hooks Hooks `yaml:"hooks,omitempty"`
 }
 
+// Hooks defines the local executables that kuma-dp runs on the events of the dataplane,
+// e.g. to sync kernel settings or to notify local agents. The executables of an event are
+// in the subdirectory of Dir named after the event ('post-bootstrap', 'post-cert-rotation',
+// 'pre-shutdown'), and they are run one after another in the lexical order of their names.
+type Hooks struct {
+	// Dir is the directory with the subdirectories of the events. Hooks are disabled when it is empty.
+	Dir string `yaml:"dir,omitempty" envconfig:"kuma_dataplane_runtime_hooks_dir"`
+	// Timeout is how long a hook can run before it is killed and considered failed.
+	Timeout time.Duration `yaml:"timeout,omitempty" envconfig:"kuma_dataplane_runtime_hooks_timeout"`
+	// FailurePolicy defines what happens when a hook fails, supported values: 'ignore', 'fail'.
+	// In the 'ignore' mode the failure is logged, in the 'fail' mode the dataplane is stopped.
+	// The dataplane is stopped anyway after the 'pre-shutdown' hooks, whatever their result.
+	FailurePolicy string `yaml:"failurePolicy,omitempty" envconfig:"kuma_dataplane_runtime_hooks_failure_policy"`
+}
+
+// Supported values of Hooks.FailurePolicy.
+const (
+	HookFailurePolicyIgnore = "ignore"
+	HookFailurePolicyFail   = "fail"
+)
+
 var _ config.Config = &Config{}
 
 func (c *Config) Validate() (errs error) {
@@ -210,6 +259,20 @@ func (d *Dataplane) Validate() (errs error) {
 		errs = multierr.Append(errs, errors.Errorf(".DrainTime must be positive"))
 	}
 
+	errs = multierr.Append(errs, d.validateFailMode())
+
+	return
+}
+
+func (d *Dataplane) validateFailMode() (errs error) {
+	switch d.FailMode {
+	case "", FailModeOpen, FailModeClosed:
+	default:
+		errs = multierr.Append(errs, errors.Errorf(".FailMode must be either %q or %q", FailModeOpen, FailModeClosed))
+	}
+	if d.FailTimeout < 0 {
+		errs = multierr.Append(errs, errors.Errorf(".FailTimeout must not be negative"))
+	}
 	return
 }
 
@@ -218,6 +281,7 @@ func (d *Dataplane) ValidateForTemplate() (errs error) {
 	if d.DrainTime <= 0 {
 		errs = multierr.Append(errs, errors.Errorf(".DrainTime must be positive"))
 	}
+	errs = multierr.Append(errs, d.validateFailMode())
 	return
 }
 
@@ -230,6 +294,26 @@ func (d *DataplaneRuntime) Validate() (errs error) {
 	if d.BinaryPath == "" {
 		errs = multierr.Append(errs, errors.Errorf(".BinaryPath must be non-empty"))
 	}
+	if err := d.Hooks.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrap(err, ".Hooks is not valid"))
+	}
+	return
+}
+
+var _ config.Config = &Hooks{}
+
+func (h *Hooks) Sanitize() {
+}
+
+func (h *Hooks) Validate() (errs error) {
+	switch h.FailurePolicy {
+	case "", HookFailurePolicyIgnore, HookFailurePolicyFail:
+	default:
+		errs = multierr.Append(errs, errors.Errorf(".FailurePolicy must be either %q or %q", HookFailurePolicyIgnore, HookFailurePolicyFail))
+	}
+	if h.Dir != "" && h.Timeout <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".Timeout must be positive"))
+	}
 	return
 }
 
@@ -31,6 +31,8 @@ var _ = Describe("Config", func() {
 		Expect(cfg.ControlPlane.URL).To(Equal("https://kuma-control-plane.internal:5682"))
 		Expect(cfg.Dataplane.AdminPort).To(Equal(config_types.MustExactPort(2345)))
 		Expect(cfg.Dataplane.DrainTime).To(Equal(60 * time.Second))
+		Expect(cfg.Dataplane.FailMode).To(Equal(kuma_dp.FailModeClosed))
+		Expect(cfg.Dataplane.FailTimeout).To(Equal(2 * time.Minute))
 	})
 
 	Context("with modified environment variables", func() {
@@ -62,9 +64,16 @@ var _ = Describe("Config", func() {
 				"KUMA_DATAPLANE_ADMIN_PORT":                              "2345",
 				"KUMA_DATAPLANE_DRAIN_TIME":                              "60s",
 				"KUMA_DATAPLANE_PROXY_TYPE":                              "ingress",
+				"KUMA_DATAPLANE_FAIL_MODE":                               "closed",
+				"KUMA_DATAPLANE_FAIL_TIMEOUT":                            "2m",
 				"KUMA_DATAPLANE_RUNTIME_BINARY_PATH":                     "envoy.sh",
 				"KUMA_DATAPLANE_RUNTIME_CONFIG_DIR":                      "/var/run/envoy",
 				"KUMA_DATAPLANE_RUNTIME_TOKEN_PATH":                      "/tmp/token",
+				"KUMA_DATAPLANE_RUNTIME_MEMORY_LIMIT":                    "536870912",
+				"KUMA_DATAPLANE_RUNTIME_SECRETS_DIR":                     "/var/run/kuma-dp/secrets",
+				"KUMA_DATAPLANE_RUNTIME_HOOKS_DIR":                       "/etc/kuma-dp/hooks",
+				"KUMA_DATAPLANE_RUNTIME_HOOKS_TIMEOUT":                   "10s",
+				"KUMA_DATAPLANE_RUNTIME_HOOKS_FAILURE_POLICY":            "fail",
 				"KUMA_DNS_ENABLED":                                       "true",
 				"KUMA_DNS_CORE_DNS_PORT":                                 "5300",
 				"KUMA_DNS_CORE_DNS_EMPTY_PORT":                           "5301",
@@ -95,9 +104,16 @@ var _ = Describe("Config", func() {
 			Expect(cfg.Dataplane.Name).To(Equal("example"))
 			Expect(cfg.Dataplane.AdminPort).To(Equal(config_types.MustExactPort(2345)))
 			Expect(cfg.Dataplane.DrainTime).To(Equal(60 * time.Second))
+			Expect(cfg.Dataplane.FailMode).To(Equal(kuma_dp.FailModeClosed))
+			Expect(cfg.Dataplane.FailTimeout).To(Equal(2 * time.Minute))
 			Expect(cfg.DataplaneRuntime.BinaryPath).To(Equal("envoy.sh"))
 			Expect(cfg.DataplaneRuntime.ConfigDir).To(Equal("/var/run/envoy"))
 			Expect(cfg.DataplaneRuntime.TokenPath).To(Equal("/tmp/token"))
+			Expect(cfg.DataplaneRuntime.MemoryLimit).To(Equal(uint64(536870912)))
+			Expect(cfg.DataplaneRuntime.SecretsDir).To(Equal("/var/run/kuma-dp/secrets"))
+			Expect(cfg.DataplaneRuntime.Hooks.Dir).To(Equal("/etc/kuma-dp/hooks"))
+			Expect(cfg.DataplaneRuntime.Hooks.Timeout).To(Equal(10 * time.Second))
+			Expect(cfg.DataplaneRuntime.Hooks.FailurePolicy).To(Equal(kuma_dp.HookFailurePolicyFail))
 			Expect(cfg.DNS.Enabled).To(BeTrue())
 			Expect(cfg.DNS.CoreDNSPort).To(Equal(uint32(5300)))
 			Expect(cfg.DNS.CoreDNSEmptyPort).To(Equal(uint32(5301)))
@@ -135,7 +151,7 @@ var _ = Describe("Config", func() {
 
 		// then
 		fmt.Println(err.Error())
-		Expect(err.Error()).To(Equal(`Invalid configuration: .ControlPlane is not valid: .Retry is not valid: .Backoff must be a positive duration; .Dataplane is not valid: .ProxyType is not valid: not-a-proxy is not a valid proxy type; .Mesh must be non-empty; .Name must be non-empty; .DrainTime must be positive; .DataplaneRuntime is not valid: .BinaryPath must be non-empty`))
+		Expect(err.Error()).To(Equal(`Invalid configuration: .ControlPlane is not valid: .Retry is not valid: .Backoff must be a positive duration; .Dataplane is not valid: .ProxyType is not valid: not-a-proxy is not a valid proxy type; .Mesh must be non-empty; .Name must be non-empty; .DrainTime must be positive; .FailMode must be either "open" or "closed"; .FailTimeout must not be negative; .DataplaneRuntime is not valid: .BinaryPath must be non-empty; .Hooks is not valid: .FailurePolicy must be either "ignore" or "fail"`))
 	})
 
 	It("should ensure the proxy type is supported", func() {
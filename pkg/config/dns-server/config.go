@@ -1,23 +1,49 @@
 package dns_server
 
 import (
-	"errors"
 	"net"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/config"
 )
 
+const (
+	ExternalDNSTypeCRD     = "crd"
+	ExternalDNSTypeRFC2136 = "rfc2136"
+)
+
 // DNS Server configuration
 type DNSServerConfig struct {
 	// The domain that the server will resolve the services for
 	Domain string `yaml:"domain" envconfig:"kuma_dns_server_domain"`
+	// Additional domains that the server will resolve the services for, e.g. `internal.corp`
+	AdditionalDomains []string `yaml:"additionalDomains" envconfig:"kuma_dns_server_additional_domains"`
 	// Port on which the server is exposed
 	Port uint32 `yaml:"port" envconfig:"kuma_dns_server_port"`
 	// CIDR used to allocate virtual IPs from
 	CIDR string `yaml:"CIDR" envconfig:"kuma_dns_server_cidr"`
+	// ExternalDNS configures publishing DNS records of mesh services to an external DNS
+	ExternalDNS *ExternalDNSConfig `yaml:"externalDNS"`
+}
+
+// Domains returns the domain and the additional domains that the server will resolve the services for.
+func (g *DNSServerConfig) Domains() []string {
+	return append([]string{g.Domain}, g.AdditionalDomains...)
+}
+
+// ExternalDNSDomains returns the domains for which the records are published to an external DNS.
+func (g *DNSServerConfig) ExternalDNSDomains() []string {
+	if len(g.ExternalDNS.Domains) > 0 {
+		return g.ExternalDNS.Domains
+	}
+	return g.Domains()
 }
 
 func (g *DNSServerConfig) Sanitize() {
+	g.ExternalDNS.Sanitize()
 }
 
 func (g *DNSServerConfig) Validate() error {
@@ -28,15 +54,120 @@ func (g *DNSServerConfig) Validate() error {
 	if err != nil {
 		return errors.New("Must provide a valid CIDR")
 	}
+	seen := map[string]bool{g.Domain: true}
+	for _, domain := range g.AdditionalDomains {
+		if !govalidator.IsDNSName(domain) {
+			return errors.Errorf("AdditionalDomains must contain valid domain names, got %q", domain)
+		}
+		if seen[domain] {
+			return errors.Errorf("AdditionalDomains must not contain duplicates, got %q", domain)
+		}
+		seen[domain] = true
+	}
+	if err := g.ExternalDNS.Validate(); err != nil {
+		return errors.Wrap(err, "ExternalDNS validation failed")
+	}
+	for _, domain := range g.ExternalDNS.Domains {
+		if !seen[domain] {
+			return errors.Errorf("ExternalDNS.Domains must contain only Domain or AdditionalDomains, got %q", domain)
+		}
+	}
 	return nil
 }
 
 var _ config.Config = &DNSServerConfig{}
 
+// ExternalDNS configuration
+type ExternalDNSConfig struct {
+	// Type of the external DNS integration. Available values: "crd" (DNSEndpoint resources
+	// of external-dns, Kubernetes only), "rfc2136" (dynamic DNS updates).
+	// If empty, DNS records are not published.
+	Type string `yaml:"type" envconfig:"kuma_dns_server_external_dns_type"`
+	// Domains for which the records are published. If empty, records are published for all domains.
+	Domains []string `yaml:"domains" envconfig:"kuma_dns_server_external_dns_domains"`
+	// TTL of the published records
+	TTL uint32 `yaml:"ttl" envconfig:"kuma_dns_server_external_dns_ttl"`
+	// Interval between synchronizations of the records
+	SyncInterval time.Duration `yaml:"syncInterval" envconfig:"kuma_dns_server_external_dns_sync_interval"`
+	// RFC2136 configuration
+	RFC2136 *RFC2136Config `yaml:"rfc2136"`
+}
+
+func (e *ExternalDNSConfig) Sanitize() {
+	e.RFC2136.Sanitize()
+}
+
+func (e *ExternalDNSConfig) Validate() error {
+	switch e.Type {
+	case "":
+		return nil
+	case ExternalDNSTypeCRD:
+	case ExternalDNSTypeRFC2136:
+		if err := e.RFC2136.Validate(); err != nil {
+			return errors.Wrap(err, "RFC2136 validation failed")
+		}
+	default:
+		return errors.Errorf("Type must be one of %q, %q, got %q", ExternalDNSTypeCRD, ExternalDNSTypeRFC2136, e.Type)
+	}
+	if e.SyncInterval <= 0 {
+		return errors.New("SyncInterval must be positive")
+	}
+	return nil
+}
+
+var _ config.Config = &ExternalDNSConfig{}
+
+// RFC2136 configuration
+type RFC2136Config struct {
+	// Address of the DNS server that accepts dynamic updates in format of HOST:PORT
+	Server string `yaml:"server" envconfig:"kuma_dns_server_external_dns_rfc2136_server"`
+	// Zone that is updated, e.g. `internal.corp`
+	Zone string `yaml:"zone" envconfig:"kuma_dns_server_external_dns_rfc2136_zone"`
+	// Name of the TSIG key. If empty, updates are not signed.
+	TSIGKeyName string `yaml:"tsigKeyName" envconfig:"kuma_dns_server_external_dns_rfc2136_tsig_key_name"`
+	// Base64 encoded TSIG secret
+	TSIGSecret string `yaml:"tsigSecret" envconfig:"kuma_dns_server_external_dns_rfc2136_tsig_secret"`
+	// TSIG algorithm, e.g. `hmac-sha256`
+	TSIGAlgorithm string `yaml:"tsigAlgorithm" envconfig:"kuma_dns_server_external_dns_rfc2136_tsig_algorithm"`
+	// Owner of the records. Every published name gets a TXT record with the owner, so the records can be
+	// recognized in the zone after a restart. Records of other owners are never modified.
+	OwnerID string `yaml:"ownerId" envconfig:"kuma_dns_server_external_dns_rfc2136_owner_id"`
+}
+
+func (r *RFC2136Config) Sanitize() {
+	r.TSIGSecret = config.SanitizedValue
+}
+
+func (r *RFC2136Config) Validate() error {
+	if host, port, err := net.SplitHostPort(r.Server); err != nil || host == "" || port == "" {
+		return errors.Errorf("Server must be in format of HOST:PORT, got %q", r.Server)
+	}
+	if !govalidator.IsDNSName(r.Zone) {
+		return errors.Errorf("Zone must be a valid domain name, got %q", r.Zone)
+	}
+	if r.TSIGKeyName != "" && r.TSIGSecret == "" {
+		return errors.New("TSIGSecret must be provided when TSIGKeyName is set")
+	}
+	if r.OwnerID == "" {
+		return errors.New("OwnerID must not be empty")
+	}
+	return nil
+}
+
+var _ config.Config = &RFC2136Config{}
+
 func DefaultDNSServerConfig() *DNSServerConfig {
 	return &DNSServerConfig{
 		Domain: "mesh",
 		Port:   5653,
 		CIDR:   "240.0.0.0/4",
+		ExternalDNS: &ExternalDNSConfig{
+			TTL:          60,
+			SyncInterval: 10 * time.Second,
+			RFC2136: &RFC2136Config{
+				TSIGAlgorithm: "hmac-sha256",
+				OwnerID:       "kuma",
+			},
+		},
 	}
 }
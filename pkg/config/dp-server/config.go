@@ -22,6 +22,38 @@ type DpServerConfig struct {
 	Auth DpServerAuthConfig `yaml:"auth"`
 	// Hds defines a Health Discovery Service configuration
 	Hds *HdsConfig `yaml:"hds"`
+	// RateLimit defines limits of HTTP requests like Bootstrap. xDS streams are not limited.
+	RateLimit DpServerRateLimit `yaml:"rateLimit"`
+}
+
+// DpServerRateLimit defines token bucket limits of HTTP requests to the DP Server.
+type DpServerRateLimit struct {
+	// Enabled if true then HTTP requests are limited
+	Enabled bool `yaml:"enabled" envconfig:"kuma_dp_server_rate_limit_enabled"`
+	// ClientRequestsPerSecond is a number of requests per second allowed for every client identified by its address. 0 means no limit.
+	ClientRequestsPerSecond float64 `yaml:"clientRequestsPerSecond" envconfig:"kuma_dp_server_rate_limit_client_requests_per_second"`
+	// ClientBurst is a maximum number of requests of a client that can be made at once.
+	ClientBurst int `yaml:"clientBurst" envconfig:"kuma_dp_server_rate_limit_client_burst"`
+	// EndpointRequestsPerSecond is a number of requests per second allowed for every endpoint, ex. /bootstrap. 0 means no limit.
+	EndpointRequestsPerSecond float64 `yaml:"endpointRequestsPerSecond" envconfig:"kuma_dp_server_rate_limit_endpoint_requests_per_second"`
+	// EndpointBurst is a maximum number of requests of an endpoint that can be made at once.
+	EndpointBurst int `yaml:"endpointBurst" envconfig:"kuma_dp_server_rate_limit_endpoint_burst"`
+}
+
+func (r *DpServerRateLimit) Validate() error {
+	if r.ClientRequestsPerSecond < 0 {
+		return errors.New("ClientRequestsPerSecond cannot be negative")
+	}
+	if r.ClientRequestsPerSecond > 0 && r.ClientBurst < 1 {
+		return errors.New("ClientBurst has to be at least 1")
+	}
+	if r.EndpointRequestsPerSecond < 0 {
+		return errors.New("EndpointRequestsPerSecond cannot be negative")
+	}
+	if r.EndpointRequestsPerSecond > 0 && r.EndpointBurst < 1 {
+		return errors.New("EndpointBurst has to be at least 1")
+	}
+	return nil
 }
 
 type DpServerAuthType string
@@ -56,6 +88,9 @@ func (a *DpServerConfig) Validate() error {
 	if err := a.Auth.Validate(); err != nil {
 		return errors.Wrap(err, "Auth is invalid")
 	}
+	if err := a.RateLimit.Validate(); err != nil {
+		return errors.Wrap(err, "RateLimit is invalid")
+	}
 	return nil
 }
 
@@ -66,6 +101,13 @@ func DefaultDpServerConfig() *DpServerConfig {
 			Type: "", // autoconfigured from the environment
 		},
 		Hds: DefaultHdsConfig(),
+		RateLimit: DpServerRateLimit{
+			Enabled:                   false,
+			ClientRequestsPerSecond:   5,
+			ClientBurst:               10,
+			EndpointRequestsPerSecond: 0,
+			EndpointBurst:             0,
+		},
 	}
 }
 
@@ -0,0 +1,135 @@
+package external_secrets
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config"
+)
+
+// External Secrets configuration.
+// Secrets can reference values stored in external secret managers instead of holding the data,
+// so certificates and credentials never need to be copied into the store of Kuma.
+// The values are fetched by the Control Plane, which caches them and fetches them again periodically.
+type ExternalSecretsConfig struct {
+	// Interval after which the values of external secrets are fetched again, so rotated values are picked up
+	RefreshInterval time.Duration `yaml:"refreshInterval" envconfig:"kuma_external_secrets_refresh_interval"`
+	// HashiCorp Vault configuration
+	Vault *VaultConfig `yaml:"vault"`
+	// AWS Secrets Manager configuration
+	AwsSecretsManager *AwsSecretsManagerConfig `yaml:"awsSecretsManager"`
+}
+
+func (e *ExternalSecretsConfig) Sanitize() {
+	e.Vault.Sanitize()
+	e.AwsSecretsManager.Sanitize()
+}
+
+func (e *ExternalSecretsConfig) Validate() error {
+	if e.RefreshInterval <= 0 {
+		return errors.New("RefreshInterval must be positive")
+	}
+	if err := e.Vault.Validate(); err != nil {
+		return errors.Wrap(err, "Vault validation failed")
+	}
+	if err := e.AwsSecretsManager.Validate(); err != nil {
+		return errors.Wrap(err, "AwsSecretsManager validation failed")
+	}
+	return nil
+}
+
+var _ config.Config = &ExternalSecretsConfig{}
+
+// HashiCorp Vault configuration
+type VaultConfig struct {
+	// If true then secrets can reference values of the KV version 2 secrets engine of Vault
+	Enabled bool `yaml:"enabled" envconfig:"kuma_external_secrets_vault_enabled"`
+	// URL of the Vault HTTP API, e.g. `https://vault.internal.corp:8200`
+	Address string `yaml:"address" envconfig:"kuma_external_secrets_vault_address"`
+	// Token used to read the secrets
+	Token string `yaml:"token" envconfig:"kuma_external_secrets_vault_token"`
+	// Path to a file with the token. The file is read again when the secrets are fetched, so the token can be rotated.
+	// If set, it takes precedence over Token.
+	TokenFile string `yaml:"tokenFile" envconfig:"kuma_external_secrets_vault_token_file"`
+	// Vault Enterprise namespace of the secrets engine
+	Namespace string `yaml:"namespace" envconfig:"kuma_external_secrets_vault_namespace"`
+	// Path on which the KV version 2 secrets engine is mounted
+	Mount string `yaml:"mount" envconfig:"kuma_external_secrets_vault_mount"`
+}
+
+func (v *VaultConfig) Sanitize() {
+	v.Token = config.SanitizedValue
+}
+
+func (v *VaultConfig) Validate() error {
+	if !v.Enabled {
+		return nil
+	}
+	if u, err := url.Parse(v.Address); err != nil || u.Scheme == "" || u.Host == "" {
+		return errors.Errorf("Address must be a valid URL, got %q", v.Address)
+	}
+	if v.Token == "" && v.TokenFile == "" {
+		return errors.New("Token or TokenFile must be provided")
+	}
+	if v.Mount == "" {
+		return errors.New("Mount must not be empty")
+	}
+	return nil
+}
+
+var _ config.Config = &VaultConfig{}
+
+// AWS Secrets Manager configuration
+type AwsSecretsManagerConfig struct {
+	// If true then secrets can reference values of AWS Secrets Manager
+	Enabled bool `yaml:"enabled" envconfig:"kuma_external_secrets_aws_secrets_manager_enabled"`
+	// AWS region of the secrets
+	Region string `yaml:"region" envconfig:"kuma_external_secrets_aws_secrets_manager_region"`
+	// AWS access key ID. If empty, the default credential chain of the AWS SDK is used, which supports the environment variables,
+	// the shared credentials file, IAM roles for service accounts, ECS task roles and EC2 instance profiles.
+	AccessKeyID string `yaml:"accessKeyId" envconfig:"kuma_external_secrets_aws_secrets_manager_access_key_id"`
+	// AWS secret access key. Used together with AccessKeyID.
+	SecretAccessKey string `yaml:"secretAccessKey" envconfig:"kuma_external_secrets_aws_secrets_manager_secret_access_key"`
+	// AWS session token of temporary credentials. Used together with AccessKeyID.
+	SessionToken string `yaml:"sessionToken" envconfig:"kuma_external_secrets_aws_secrets_manager_session_token"`
+	// URL of the AWS Secrets Manager API. If empty, `https://secretsmanager.<region>.amazonaws.com` is used.
+	Endpoint string `yaml:"endpoint" envconfig:"kuma_external_secrets_aws_secrets_manager_endpoint"`
+}
+
+func (a *AwsSecretsManagerConfig) Sanitize() {
+	a.SecretAccessKey = config.SanitizedValue
+	a.SessionToken = config.SanitizedValue
+}
+
+func (a *AwsSecretsManagerConfig) Validate() error {
+	if !a.Enabled {
+		return nil
+	}
+	if a.Region == "" {
+		return errors.New("Region must not be empty")
+	}
+	if a.Endpoint != "" {
+		if u, err := url.Parse(a.Endpoint); err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.Errorf("Endpoint must be a valid URL, got %q", a.Endpoint)
+		}
+	}
+	return nil
+}
+
+var _ config.Config = &AwsSecretsManagerConfig{}
+
+func DefaultExternalSecretsConfig() *ExternalSecretsConfig {
+	return &ExternalSecretsConfig{
+		RefreshInterval: 5 * time.Minute,
+		Vault: &VaultConfig{
+			Enabled: false,
+			Address: "http://127.0.0.1:8200",
+			Mount:   "secret",
+		},
+		AwsSecretsManager: &AwsSecretsManagerConfig{
+			Enabled: false,
+		},
+	}
+}
@@ -88,6 +88,11 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.BootstrapServer.Params.XdsConnectTimeout).To(Equal(13 * time.Second))
 			Expect(cfg.BootstrapServer.Params.AdminAccessLogPath).To(Equal("/access/log/test"))
 			Expect(cfg.BootstrapServer.Params.AdminAddress).To(Equal("1.1.1.1"))
+			Expect(cfg.BootstrapServer.OverloadManager.Enabled).To(BeFalse())
+			Expect(cfg.BootstrapServer.OverloadManager.ShrinkHeapThreshold).To(Equal(0.9))
+			Expect(cfg.BootstrapServer.OverloadManager.StopAcceptingRequestsThreshold).To(Equal(0.95))
+			Expect(cfg.BootstrapServer.OverloadManager.MaxActiveDownstreamConnections).To(Equal(uint32(10000)))
+			Expect(cfg.BootstrapServer.StatsPruning.Enabled).To(BeTrue())
 
 			Expect(cfg.Environment).To(Equal(config_core.KubernetesEnvironment))
 
@@ -102,6 +107,10 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Store.Postgres.MaxIdleConnections).To(Equal(300))
 			Expect(cfg.Store.Postgres.MinReconnectInterval).To(Equal(44 * time.Second))
 			Expect(cfg.Store.Postgres.MaxReconnectInterval).To(Equal(55 * time.Second))
+			Expect(cfg.Store.Postgres.ReadReplica.Host).To(Equal("postgres.replica"))
+			Expect(cfg.Store.Postgres.ReadReplica.Port).To(Equal(5433))
+			Expect(cfg.Store.Postgres.ReadReplica.MaxStaleness).To(Equal(10 * time.Second))
+			Expect(cfg.Store.Postgres.ReadReplica.LagCheckInterval).To(Equal(2 * time.Second))
 
 			Expect(cfg.Store.Kubernetes.SystemNamespace).To(Equal("test-namespace"))
 
@@ -130,6 +139,11 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.ApiServer.Authn.Type).To(Equal("custom-authn"))
 			Expect(cfg.ApiServer.Authn.Tokens.BootstrapAdminToken).To(BeFalse())
 			Expect(cfg.ApiServer.CorsAllowedDomains).To(Equal([]string{"https://kuma", "https://someapi"}))
+			Expect(cfg.ApiServer.RateLimit.Enabled).To(BeTrue())
+			Expect(cfg.ApiServer.RateLimit.ClientRequestsPerSecond).To(Equal(20.0))
+			Expect(cfg.ApiServer.RateLimit.ClientBurst).To(Equal(40))
+			Expect(cfg.ApiServer.RateLimit.EndpointRequestsPerSecond).To(Equal(100.0))
+			Expect(cfg.ApiServer.RateLimit.EndpointBurst).To(Equal(200))
 
 			// nolint: staticcheck
 			Expect(cfg.MonitoringAssignmentServer.GrpcPort).To(Equal(uint32(3333)))
@@ -198,13 +212,20 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Multizone.Global.KDS.TlsCertFile).To(Equal("/cert"))
 			Expect(cfg.Multizone.Global.KDS.TlsKeyFile).To(Equal("/key"))
 			Expect(cfg.Multizone.Global.KDS.MaxMsgSize).To(Equal(uint32(1)))
+			Expect(cfg.Multizone.Global.KDS.RequireZoneEnrollment).To(BeTrue())
+			Expect(cfg.Multizone.Global.KDS.MTLS.Enabled).To(BeTrue())
+			Expect(cfg.Multizone.Global.KDS.MTLS.CertValidityPeriod).To(Equal(48 * time.Hour))
+			Expect(cfg.Multizone.Global.KDS.MTLS.RotationThreshold).To(Equal(12 * time.Hour))
+			Expect(cfg.Multizone.Global.KDS.MTLS.ReconcileInterval).To(Equal(3 * time.Second))
 			Expect(cfg.Multizone.Zone.GlobalAddress).To(Equal("grpc://1.1.1.1:5685"))
 			Expect(cfg.Multizone.Zone.Name).To(Equal("zone-1"))
+			Expect(cfg.Multizone.Zone.EnrollmentToken).To(Equal("enrollment-token"))
 			Expect(cfg.Multizone.Zone.KDS.RootCAFile).To(Equal("/rootCa"))
 			Expect(cfg.Multizone.Zone.KDS.RefreshInterval).To(Equal(9 * time.Second))
 			Expect(cfg.Multizone.Zone.KDS.MaxMsgSize).To(Equal(uint32(2)))
 
 			Expect(cfg.Defaults.SkipMeshCreation).To(BeTrue())
+			Expect(cfg.Defaults.MeshPolicyTemplates).To(Equal([]string{"/etc/kuma/defaults/permissions.yaml", "/etc/kuma/defaults/timeouts.yaml"}))
 
 			Expect(cfg.Diagnostics.ServerPort).To(Equal(uint32(5003)))
 			Expect(cfg.Diagnostics.DebugEndpoints).To(BeTrue())
@@ -212,18 +233,37 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.DNSServer.Domain).To(Equal("test-domain"))
 			Expect(cfg.DNSServer.Port).To(Equal(uint32(15653)))
 			Expect(cfg.DNSServer.CIDR).To(Equal("127.1.0.0/16"))
+			Expect(cfg.DNSServer.AdditionalDomains).To(Equal([]string{"internal.corp", "legacy.corp"}))
+			Expect(cfg.DNSServer.ExternalDNS.Type).To(Equal("rfc2136"))
+			Expect(cfg.DNSServer.ExternalDNS.Domains).To(Equal([]string{"internal.corp"}))
+			Expect(cfg.DNSServer.ExternalDNS.TTL).To(Equal(uint32(30)))
+			Expect(cfg.DNSServer.ExternalDNS.SyncInterval).To(Equal(5 * time.Second))
+			Expect(cfg.DNSServer.ExternalDNS.RFC2136.Server).To(Equal("10.0.0.53:53"))
+			Expect(cfg.DNSServer.ExternalDNS.RFC2136.Zone).To(Equal("internal.corp"))
+			Expect(cfg.DNSServer.ExternalDNS.RFC2136.TSIGKeyName).To(Equal("kuma."))
+			Expect(cfg.DNSServer.ExternalDNS.RFC2136.TSIGSecret).To(Equal("c2VjcmV0"))
+			Expect(cfg.DNSServer.ExternalDNS.RFC2136.TSIGAlgorithm).To(Equal("hmac-sha512"))
+			Expect(cfg.DNSServer.ExternalDNS.RFC2136.OwnerID).To(Equal("kuma-zone-1"))
 
 			Expect(cfg.XdsServer.DataplaneStatusFlushInterval).To(Equal(7 * time.Second))
 			Expect(cfg.XdsServer.DataplaneConfigurationRefreshInterval).To(Equal(21 * time.Second))
 			Expect(cfg.XdsServer.NACKBackoff).To(Equal(10 * time.Second))
+			Expect(cfg.XdsServer.DataplaneDrainPropagationDelay).To(Equal(12 * time.Second))
+			Expect(cfg.XdsServer.Shadow.Enabled).To(BeTrue())
+			Expect(cfg.XdsServer.Shadow.ActiveControlPlaneURL).To(Equal("https://kuma-control-plane:5682"))
+			Expect(cfg.XdsServer.Shadow.ActiveControlPlaneAuthToken).To(Equal("token"))
+			Expect(cfg.XdsServer.Shadow.Interval).To(Equal(15 * time.Second))
 
 			Expect(cfg.Metrics.Zone.Enabled).To(BeFalse())
 			Expect(cfg.Metrics.Zone.SubscriptionLimit).To(Equal(23))
 			Expect(cfg.Metrics.Zone.IdleTimeout).To(Equal(2 * time.Minute))
 			Expect(cfg.Metrics.Mesh.MinResyncTimeout).To(Equal(35 * time.Second))
 			Expect(cfg.Metrics.Mesh.MaxResyncTimeout).To(Equal(27 * time.Second))
+			Expect(cfg.Metrics.Mesh.ResyncJitter).To(Equal(0.3))
+			Expect(cfg.Metrics.Mesh.MaxLabeledMeshes).To(Equal(50))
 			Expect(cfg.Metrics.Dataplane.Enabled).To(BeFalse())
 			Expect(cfg.Metrics.Dataplane.SubscriptionLimit).To(Equal(47))
+			Expect(cfg.Metrics.Dataplane.SubscriptionMaxAge).To(Equal(12 * time.Hour))
 			Expect(cfg.Metrics.Dataplane.IdleTimeout).To(Equal(1 * time.Minute))
 
 			Expect(cfg.DpServer.TlsCertFile).To(Equal("/test/path"))
@@ -238,6 +278,11 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.DpServer.Hds.CheckDefaults.NoTrafficInterval).To(Equal(7 * time.Second))
 			Expect(cfg.DpServer.Hds.CheckDefaults.HealthyThreshold).To(Equal(uint32(8)))
 			Expect(cfg.DpServer.Hds.CheckDefaults.UnhealthyThreshold).To(Equal(uint32(9)))
+			Expect(cfg.DpServer.RateLimit.Enabled).To(BeTrue())
+			Expect(cfg.DpServer.RateLimit.ClientRequestsPerSecond).To(Equal(3.0))
+			Expect(cfg.DpServer.RateLimit.ClientBurst).To(Equal(6))
+			Expect(cfg.DpServer.RateLimit.EndpointRequestsPerSecond).To(Equal(50.0))
+			Expect(cfg.DpServer.RateLimit.EndpointBurst).To(Equal(70))
 
 			Expect(cfg.Access.Type).To(Equal("custom-rbac"))
 			Expect(cfg.Access.Static.AdminResources.Users).To(Equal([]string{"ar-admin1", "ar-admin2"}))
@@ -246,6 +291,37 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Access.Static.GenerateDPToken.Groups).To(Equal([]string{"dp-group1", "dp-group2"}))
 			Expect(cfg.Access.Static.GenerateUserToken.Users).To(Equal([]string{"ut-admin1", "ut-admin2"}))
 			Expect(cfg.Access.Static.GenerateUserToken.Groups).To(Equal([]string{"ut-group1", "ut-group2"}))
+
+			Expect(cfg.ServiceDiscovery.Consul.Enabled).To(BeTrue())
+			Expect(cfg.ServiceDiscovery.Consul.Address).To(Equal("https://consul.internal.corp:8501"))
+			Expect(cfg.ServiceDiscovery.Consul.Token).To(Equal("consul-token"))
+			Expect(cfg.ServiceDiscovery.Consul.Datacenter).To(Equal("dc2"))
+			Expect(cfg.ServiceDiscovery.Consul.Services).To(Equal([]string{"billing", "inventory"}))
+			Expect(cfg.ServiceDiscovery.Consul.Mesh).To(Equal("legacy"))
+			Expect(cfg.ServiceDiscovery.Consul.SyncInterval).To(Equal(12 * time.Second))
+			Expect(cfg.ServiceDiscovery.CloudMap.Enabled).To(BeTrue())
+			Expect(cfg.ServiceDiscovery.CloudMap.Region).To(Equal("eu-west-1"))
+			Expect(cfg.ServiceDiscovery.CloudMap.NamespaceID).To(Equal("ns-abcdefgh12345678"))
+			Expect(cfg.ServiceDiscovery.CloudMap.Mesh).To(Equal("aws"))
+			Expect(cfg.ServiceDiscovery.CloudMap.AccessKeyID).To(Equal("AKIAEXAMPLE"))
+			Expect(cfg.ServiceDiscovery.CloudMap.SecretAccessKey).To(Equal("secret-access-key"))
+			Expect(cfg.ServiceDiscovery.CloudMap.SessionToken).To(Equal("session-token"))
+			Expect(cfg.ServiceDiscovery.CloudMap.Endpoint).To(Equal("https://servicediscovery.eu-west-1.amazonaws.com"))
+			Expect(cfg.ServiceDiscovery.CloudMap.SyncInterval).To(Equal(14 * time.Second))
+
+			Expect(cfg.ExternalSecrets.RefreshInterval).To(Equal(3 * time.Minute))
+			Expect(cfg.ExternalSecrets.Vault.Enabled).To(BeTrue())
+			Expect(cfg.ExternalSecrets.Vault.Address).To(Equal("https://vault.internal.corp:8200"))
+			Expect(cfg.ExternalSecrets.Vault.Token).To(Equal("vault-token"))
+			Expect(cfg.ExternalSecrets.Vault.TokenFile).To(Equal("/var/run/secrets/vault/token"))
+			Expect(cfg.ExternalSecrets.Vault.Namespace).To(Equal("mesh"))
+			Expect(cfg.ExternalSecrets.Vault.Mount).To(Equal("kv"))
+			Expect(cfg.ExternalSecrets.AwsSecretsManager.Enabled).To(BeTrue())
+			Expect(cfg.ExternalSecrets.AwsSecretsManager.Region).To(Equal("eu-central-1"))
+			Expect(cfg.ExternalSecrets.AwsSecretsManager.AccessKeyID).To(Equal("AKIASECRETS"))
+			Expect(cfg.ExternalSecrets.AwsSecretsManager.SecretAccessKey).To(Equal("secrets-access-key"))
+			Expect(cfg.ExternalSecrets.AwsSecretsManager.SessionToken).To(Equal("secrets-session-token"))
+			Expect(cfg.ExternalSecrets.AwsSecretsManager.Endpoint).To(Equal("https://secretsmanager.eu-central-1.amazonaws.com"))
 		},
 		Entry("from config file", testCase{
 			envVars: map[string]string{},
@@ -269,6 +345,11 @@ store:
       certPath: /path/to/cert
       keyPath: /path/to/key
       caPath: /path/to/rootCert
+    readReplica:
+      host: postgres.replica
+      port: 5433
+      maxStaleness: 10s
+      lagCheckInterval: 2s
   kubernetes:
     systemNamespace: test-namespace
   cache:
@@ -285,6 +366,13 @@ bootstrapServer:
     xdsHost: kuma-control-plane
     xdsPort: 4321
     xdsConnectTimeout: 13s
+  overloadManager:
+    enabled: false
+    shrinkHeapThreshold: 0.9
+    stopAcceptingRequestsThreshold: 0.95
+    maxActiveDownstreamConnections: 10000
+  statsPruning:
+    enabled: true
 apiServer:
   http:
     enabled: false # ENV: KUMA_API_SERVER_HTTP_ENABLED
@@ -307,6 +395,12 @@ apiServer:
   corsAllowedDomains:
     - https://kuma
     - https://someapi
+  rateLimit:
+    enabled: true
+    clientRequestsPerSecond: 20
+    clientBurst: 40
+    endpointRequestsPerSecond: 100
+    endpointBurst: 200
 monitoringAssignmentServer:
   grpcPort: 3333
   port: 2222
@@ -393,19 +487,45 @@ multizone:
       tlsCertFile: /cert
       tlsKeyFile: /key
       maxMsgSize: 1
+      requireZoneEnrollment: true
+      mtls:
+        enabled: true
+        certValidityPeriod: 48h
+        rotationThreshold: 12h
+        reconcileInterval: 3s
   zone:
     globalAddress: "grpc://1.1.1.1:5685"
     name: "zone-1"
+    enrollmentToken: enrollment-token
     kds:
       refreshInterval: 9s
       rootCaFile: /rootCa
       maxMsgSize: 2
 dnsServer:
   domain: test-domain
+  additionalDomains:
+  - internal.corp
+  - legacy.corp
   port: 15653
   CIDR: 127.1.0.0/16
+  externalDNS:
+    type: rfc2136
+    domains:
+    - internal.corp
+    ttl: 30
+    syncInterval: 5s
+    rfc2136:
+      server: 10.0.0.53:53
+      zone: internal.corp
+      tsigKeyName: kuma.
+      tsigSecret: c2VjcmV0
+      tsigAlgorithm: hmac-sha512
+      ownerId: kuma-zone-1
 defaults:
   skipMeshCreation: true
+  meshPolicyTemplates:
+  - /etc/kuma/defaults/permissions.yaml
+  - /etc/kuma/defaults/timeouts.yaml
 diagnostics:
   serverPort: 5003
   debugEndpoints: true
@@ -413,6 +533,12 @@ xdsServer:
   dataplaneConfigurationRefreshInterval: 21s
   dataplaneStatusFlushInterval: 7s
   nackBackoff: 10s
+  dataplaneDrainPropagationDelay: 12s
+  shadow:
+    enabled: true
+    activeControlPlaneUrl: https://kuma-control-plane:5682
+    activeControlPlaneAuthToken: token
+    interval: 15s
 metrics:
   zone:
     enabled: false
@@ -421,8 +547,11 @@ metrics:
   mesh:
     minResyncTimeout: 35s
     maxResyncTimeout: 27s
+    resyncJitter: 0.3
+    maxLabeledMeshes: 50
   dataplane:
     subscriptionLimit: 47
+    subscriptionMaxAge: 12h
     enabled: false
     idleTimeout: 1m
 dpServer:
@@ -441,6 +570,12 @@ dpServer:
       noTrafficInterval: 7s
       healthyThreshold: 8
       unhealthyThreshold: 9
+  rateLimit:
+    enabled: true
+    clientRequestsPerSecond: 3
+    clientBurst: 6
+    endpointRequestsPerSecond: 50
+    endpointBurst: 70
 access:
   type: custom-rbac
   static:
@@ -453,16 +588,56 @@ access:
     generateUserToken:
       users: ["ut-admin1", "ut-admin2"]
       groups: ["ut-group1", "ut-group2"]
+serviceDiscovery:
+  consul:
+    enabled: true
+    address: https://consul.internal.corp:8501
+    token: consul-token
+    datacenter: dc2
+    services: ["billing", "inventory"]
+    mesh: legacy
+    syncInterval: 12s
+  cloudMap:
+    enabled: true
+    region: eu-west-1
+    namespaceId: ns-abcdefgh12345678
+    mesh: aws
+    accessKeyId: AKIAEXAMPLE
+    secretAccessKey: secret-access-key
+    sessionToken: session-token
+    endpoint: https://servicediscovery.eu-west-1.amazonaws.com
+    syncInterval: 14s
+externalSecrets:
+  refreshInterval: 3m
+  vault:
+    enabled: true
+    address: https://vault.internal.corp:8200
+    token: vault-token
+    tokenFile: /var/run/secrets/vault/token
+    namespace: mesh
+    mount: kv
+  awsSecretsManager:
+    enabled: true
+    region: eu-central-1
+    accessKeyId: AKIASECRETS
+    secretAccessKey: secrets-access-key
+    sessionToken: secrets-session-token
+    endpoint: https://secretsmanager.eu-central-1.amazonaws.com
 `,
 		}),
 		Entry("from env variables", testCase{
 			envVars: map[string]string{
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_PORT":                                                  "1234",
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_HOST":                                                    "kuma-control-plane",
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_PORT":                                                    "4321",
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_CONNECT_TIMEOUT":                                         "13s",
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_ACCESS_LOG_PATH":                                       "/access/log/test",
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_ADDRESS":                                               "1.1.1.1",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_PORT":                                  "1234",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_HOST":                                    "kuma-control-plane",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_PORT":                                    "4321",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_CONNECT_TIMEOUT":                         "13s",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_ACCESS_LOG_PATH":                       "/access/log/test",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_ADDRESS":                               "1.1.1.1",
+				"KUMA_BOOTSTRAP_SERVER_OVERLOAD_MANAGER_ENABLED":                           "false",
+				"KUMA_BOOTSTRAP_SERVER_OVERLOAD_MANAGER_SHRINK_HEAP_THRESHOLD":             "0.9",
+				"KUMA_BOOTSTRAP_SERVER_OVERLOAD_MANAGER_STOP_ACCEPTING_REQUESTS_THRESHOLD": "0.95",
+				"KUMA_BOOTSTRAP_SERVER_OVERLOAD_MANAGER_MAX_ACTIVE_DOWNSTREAM_CONNECTIONS": "10000",
+				"KUMA_BOOTSTRAP_SERVER_STATS_PRUNING_ENABLED":                              "true",
 				"KUMA_ENVIRONMENT":                                                                         "kubernetes",
 				"KUMA_STORE_TYPE":                                                                          "postgres",
 				"KUMA_STORE_POSTGRES_HOST":                                                                 "postgres.host",
@@ -479,6 +654,10 @@ access:
 				"KUMA_STORE_POSTGRES_TLS_CA_PATH":                                                          "/path/to/rootCert",
 				"KUMA_STORE_POSTGRES_MIN_RECONNECT_INTERVAL":                                               "44s",
 				"KUMA_STORE_POSTGRES_MAX_RECONNECT_INTERVAL":                                               "55s",
+				"KUMA_STORE_POSTGRES_READ_REPLICA_HOST":                                                    "postgres.replica",
+				"KUMA_STORE_POSTGRES_READ_REPLICA_PORT":                                                    "5433",
+				"KUMA_STORE_POSTGRES_READ_REPLICA_MAX_STALENESS":                                           "10s",
+				"KUMA_STORE_POSTGRES_READ_REPLICA_LAG_CHECK_INTERVAL":                                      "2s",
 				"KUMA_STORE_KUBERNETES_SYSTEM_NAMESPACE":                                                   "test-namespace",
 				"KUMA_STORE_CACHE_ENABLED":                                                                 "false",
 				"KUMA_STORE_CACHE_EXPIRATION_TIME":                                                         "3s",
@@ -497,6 +676,11 @@ access:
 				"KUMA_API_SERVER_AUTHN_TYPE":                                                               "custom-authn",
 				"KUMA_API_SERVER_AUTHN_LOCALHOST_IS_ADMIN":                                                 "false",
 				"KUMA_API_SERVER_AUTHN_TOKENS_BOOTSTRAP_ADMIN_TOKEN":                                       "false",
+				"KUMA_API_SERVER_RATE_LIMIT_ENABLED":                                                       "true",
+				"KUMA_API_SERVER_RATE_LIMIT_CLIENT_REQUESTS_PER_SECOND":                                    "20",
+				"KUMA_API_SERVER_RATE_LIMIT_CLIENT_BURST":                                                  "40",
+				"KUMA_API_SERVER_RATE_LIMIT_ENDPOINT_REQUESTS_PER_SECOND":                                  "100",
+				"KUMA_API_SERVER_RATE_LIMIT_ENDPOINT_BURST":                                                "200",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_GRPC_PORT":                                              "3333",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_PORT":                                                   "2222",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_DEFAULT_FETCH_TIMEOUT":                                  "45s",
@@ -549,6 +733,17 @@ access:
 				"KUMA_GUI_SERVER_API_SERVER_URL":                                                           "http://localhost:1234",
 				"KUMA_DNS_SERVER_DOMAIN":                                                                   "test-domain",
 				"KUMA_DNS_SERVER_PORT":                                                                     "15653",
+				"KUMA_DNS_SERVER_ADDITIONAL_DOMAINS":                                                       "internal.corp,legacy.corp",
+				"KUMA_DNS_SERVER_EXTERNAL_DNS_TYPE":                                                        "rfc2136",
+				"KUMA_DNS_SERVER_EXTERNAL_DNS_DOMAINS":                                                     "internal.corp",
+				"KUMA_DNS_SERVER_EXTERNAL_DNS_TTL":                                                         "30",
+				"KUMA_DNS_SERVER_EXTERNAL_DNS_SYNC_INTERVAL":                                               "5s",
+				"KUMA_DNS_SERVER_EXTERNAL_DNS_RFC2136_SERVER":                                              "10.0.0.53:53",
+				"KUMA_DNS_SERVER_EXTERNAL_DNS_RFC2136_ZONE":                                                "internal.corp",
+				"KUMA_DNS_SERVER_EXTERNAL_DNS_RFC2136_TSIG_KEY_NAME":                                       "kuma.",
+				"KUMA_DNS_SERVER_EXTERNAL_DNS_RFC2136_TSIG_SECRET":                                         "c2VjcmV0",
+				"KUMA_DNS_SERVER_EXTERNAL_DNS_RFC2136_TSIG_ALGORITHM":                                      "hmac-sha512",
+				"KUMA_DNS_SERVER_EXTERNAL_DNS_RFC2136_OWNER_ID":                                            "kuma-zone-1",
 				"KUMA_DNS_SERVER_CIDR":                                                                     "127.1.0.0/16",
 				"KUMA_MODE":                                                                                "zone",
 				"KUMA_MULTIZONE_GLOBAL_KDS_GRPC_PORT":                                                      "1234",
@@ -556,6 +751,12 @@ access:
 				"KUMA_MULTIZONE_GLOBAL_KDS_TLS_CERT_FILE":                                                  "/cert",
 				"KUMA_MULTIZONE_GLOBAL_KDS_TLS_KEY_FILE":                                                   "/key",
 				"KUMA_MULTIZONE_GLOBAL_KDS_MAX_MSG_SIZE":                                                   "1",
+				"KUMA_MULTIZONE_GLOBAL_KDS_REQUIRE_ZONE_ENROLLMENT":                                        "true",
+				"KUMA_MULTIZONE_ZONE_ENROLLMENT_TOKEN":                                                     "enrollment-token",
+				"KUMA_MULTIZONE_GLOBAL_KDS_MTLS_ENABLED":                                                   "true",
+				"KUMA_MULTIZONE_GLOBAL_KDS_MTLS_CERT_VALIDITY_PERIOD":                                      "48h",
+				"KUMA_MULTIZONE_GLOBAL_KDS_MTLS_ROTATION_THRESHOLD":                                        "12h",
+				"KUMA_MULTIZONE_GLOBAL_KDS_MTLS_RECONCILE_INTERVAL":                                        "3s",
 				"KUMA_MULTIZONE_ZONE_GLOBAL_ADDRESS":                                                       "grpc://1.1.1.1:5685",
 				"KUMA_MULTIZONE_ZONE_NAME":                                                                 "zone-1",
 				"KUMA_MULTIZONE_ZONE_KDS_ROOT_CA_FILE":                                                     "/rootCa",
@@ -563,18 +764,27 @@ access:
 				"KUMA_MULTIZONE_ZONE_KDS_MAX_MSG_SIZE":                                                     "2",
 				"KUMA_MULTIZONE_GLOBAL_KDS_ZONE_INSIGHT_FLUSH_INTERVAL":                                    "5s",
 				"KUMA_DEFAULTS_SKIP_MESH_CREATION":                                                         "true",
+				"KUMA_DEFAULTS_MESH_POLICY_TEMPLATES":                                                      "/etc/kuma/defaults/permissions.yaml,/etc/kuma/defaults/timeouts.yaml",
 				"KUMA_DIAGNOSTICS_SERVER_PORT":                                                             "5003",
 				"KUMA_DIAGNOSTICS_DEBUG_ENDPOINTS":                                                         "true",
 				"KUMA_XDS_SERVER_DATAPLANE_STATUS_FLUSH_INTERVAL":                                          "7s",
 				"KUMA_XDS_SERVER_DATAPLANE_CONFIGURATION_REFRESH_INTERVAL":                                 "21s",
 				"KUMA_XDS_SERVER_NACK_BACKOFF":                                                             "10s",
+				"KUMA_XDS_SERVER_DATAPLANE_DRAIN_PROPAGATION_DELAY":                                        "12s",
+				"KUMA_XDS_SERVER_SHADOW_ENABLED":                                                           "true",
+				"KUMA_XDS_SERVER_SHADOW_ACTIVE_CONTROL_PLANE_URL":                                          "https://kuma-control-plane:5682",
+				"KUMA_XDS_SERVER_SHADOW_ACTIVE_CONTROL_PLANE_AUTH_TOKEN":                                   "token",
+				"KUMA_XDS_SERVER_SHADOW_INTERVAL":                                                          "15s",
 				"KUMA_METRICS_ZONE_ENABLED":                                                                "false",
 				"KUMA_METRICS_ZONE_SUBSCRIPTION_LIMIT":                                                     "23",
 				"KUMA_METRICS_ZONE_IDLE_TIMEOUT":                                                           "2m",
 				"KUMA_METRICS_MESH_MAX_RESYNC_TIMEOUT":                                                     "27s",
+				"KUMA_METRICS_MESH_RESYNC_JITTER":                                                          "0.3",
+				"KUMA_METRICS_MESH_MAX_LABELED_MESHES":                                                     "50",
 				"KUMA_METRICS_DATAPLANE_ENABLED":                                                           "false",
 				"KUMA_METRICS_MESH_MIN_RESYNC_TIMEOUT":                                                     "35s",
 				"KUMA_METRICS_DATAPLANE_SUBSCRIPTION_LIMIT":                                                "47",
+				"KUMA_METRICS_DATAPLANE_SUBSCRIPTION_MAX_AGE":                                              "12h",
 				"KUMA_METRICS_DATAPLANE_IDLE_TIMEOUT":                                                      "1m",
 				"KUMA_DP_SERVER_TLS_CERT_FILE":                                                             "/test/path",
 				"KUMA_DP_SERVER_TLS_KEY_FILE":                                                              "/test/path/key",
@@ -588,6 +798,11 @@ access:
 				"KUMA_DP_SERVER_HDS_CHECK_NO_TRAFFIC_INTERVAL":                                             "7s",
 				"KUMA_DP_SERVER_HDS_CHECK_HEALTHY_THRESHOLD":                                               "8",
 				"KUMA_DP_SERVER_HDS_CHECK_UNHEALTHY_THRESHOLD":                                             "9",
+				"KUMA_DP_SERVER_RATE_LIMIT_ENABLED":                                                        "true",
+				"KUMA_DP_SERVER_RATE_LIMIT_CLIENT_REQUESTS_PER_SECOND":                                     "3",
+				"KUMA_DP_SERVER_RATE_LIMIT_CLIENT_BURST":                                                   "6",
+				"KUMA_DP_SERVER_RATE_LIMIT_ENDPOINT_REQUESTS_PER_SECOND":                                   "50",
+				"KUMA_DP_SERVER_RATE_LIMIT_ENDPOINT_BURST":                                                 "70",
 				"KUMA_ACCESS_TYPE":                                                                         "custom-rbac",
 				"KUMA_ACCESS_STATIC_ADMIN_RESOURCES_USERS":                                                 "ar-admin1,ar-admin2",
 				"KUMA_ACCESS_STATIC_ADMIN_RESOURCES_GROUPS":                                                "ar-group1,ar-group2",
@@ -595,6 +810,35 @@ access:
 				"KUMA_ACCESS_STATIC_GENERATE_DP_TOKEN_GROUPS":                                              "dp-group1,dp-group2",
 				"KUMA_ACCESS_STATIC_GENERATE_USER_TOKEN_USERS":                                             "ut-admin1,ut-admin2",
 				"KUMA_ACCESS_STATIC_GENERATE_USER_TOKEN_GROUPS":                                            "ut-group1,ut-group2",
+				"KUMA_SERVICE_DISCOVERY_CONSUL_ENABLED":                                                    "true",
+				"KUMA_SERVICE_DISCOVERY_CONSUL_ADDRESS":                                                    "https://consul.internal.corp:8501",
+				"KUMA_SERVICE_DISCOVERY_CONSUL_TOKEN":                                                      "consul-token",
+				"KUMA_SERVICE_DISCOVERY_CONSUL_DATACENTER":                                                 "dc2",
+				"KUMA_SERVICE_DISCOVERY_CONSUL_SERVICES":                                                   "billing,inventory",
+				"KUMA_SERVICE_DISCOVERY_CONSUL_MESH":                                                       "legacy",
+				"KUMA_SERVICE_DISCOVERY_CONSUL_SYNC_INTERVAL":                                              "12s",
+				"KUMA_SERVICE_DISCOVERY_CLOUD_MAP_ENABLED":                                                 "true",
+				"KUMA_SERVICE_DISCOVERY_CLOUD_MAP_REGION":                                                  "eu-west-1",
+				"KUMA_SERVICE_DISCOVERY_CLOUD_MAP_NAMESPACE_ID":                                            "ns-abcdefgh12345678",
+				"KUMA_SERVICE_DISCOVERY_CLOUD_MAP_MESH":                                                    "aws",
+				"KUMA_SERVICE_DISCOVERY_CLOUD_MAP_ACCESS_KEY_ID":                                           "AKIAEXAMPLE",
+				"KUMA_SERVICE_DISCOVERY_CLOUD_MAP_SECRET_ACCESS_KEY":                                       "secret-access-key",
+				"KUMA_SERVICE_DISCOVERY_CLOUD_MAP_SESSION_TOKEN":                                           "session-token",
+				"KUMA_SERVICE_DISCOVERY_CLOUD_MAP_ENDPOINT":                                                "https://servicediscovery.eu-west-1.amazonaws.com",
+				"KUMA_SERVICE_DISCOVERY_CLOUD_MAP_SYNC_INTERVAL":                                           "14s",
+				"KUMA_EXTERNAL_SECRETS_REFRESH_INTERVAL":                                                   "3m",
+				"KUMA_EXTERNAL_SECRETS_VAULT_ENABLED":                                                      "true",
+				"KUMA_EXTERNAL_SECRETS_VAULT_ADDRESS":                                                      "https://vault.internal.corp:8200",
+				"KUMA_EXTERNAL_SECRETS_VAULT_TOKEN":                                                        "vault-token",
+				"KUMA_EXTERNAL_SECRETS_VAULT_TOKEN_FILE":                                                   "/var/run/secrets/vault/token",
+				"KUMA_EXTERNAL_SECRETS_VAULT_NAMESPACE":                                                    "mesh",
+				"KUMA_EXTERNAL_SECRETS_VAULT_MOUNT":                                                        "kv",
+				"KUMA_EXTERNAL_SECRETS_AWS_SECRETS_MANAGER_ENABLED":                                        "true",
+				"KUMA_EXTERNAL_SECRETS_AWS_SECRETS_MANAGER_REGION":                                         "eu-central-1",
+				"KUMA_EXTERNAL_SECRETS_AWS_SECRETS_MANAGER_ACCESS_KEY_ID":                                  "AKIASECRETS",
+				"KUMA_EXTERNAL_SECRETS_AWS_SECRETS_MANAGER_SECRET_ACCESS_KEY":                              "secrets-access-key",
+				"KUMA_EXTERNAL_SECRETS_AWS_SECRETS_MANAGER_SESSION_TOKEN":                                  "secrets-session-token",
+				"KUMA_EXTERNAL_SECRETS_AWS_SECRETS_MANAGER_ENDPOINT":                                       "https://secretsmanager.eu-central-1.amazonaws.com",
 			},
 			yamlFileConfig: "",
 		}),
@@ -23,6 +23,36 @@ type KdsServerConfig struct {
 	// MaxMsgSize defines a maximum size of the message that is exchanged using KDS.
 	// In practice this means a limit on full list of one resource type.
 	MaxMsgSize uint32 `yaml:"maxMsgSize" envconfig:"kuma_multizone_global_kds_max_msg_size"`
+	// RequireZoneEnrollment if true then a zone that is not registered yet has to present a Zone Enrollment Token to connect.
+	RequireZoneEnrollment bool `yaml:"requireZoneEnrollment" envconfig:"kuma_multizone_global_kds_require_zone_enrollment"`
+	// MTLS defines the automatic mutual TLS between Global and Zone control planes.
+	MTLS KdsMTLSConfig `yaml:"mtls"`
+}
+
+// KdsMTLSConfig defines the automatic mutual TLS of KDS connections.
+// Global CP manages an internal CA and issues a client certificate to every Zone CP.
+type KdsMTLSConfig struct {
+	// Enabled if true then Global CP issues certificates to Zone CPs and verifies them on KDS connections.
+	Enabled bool `yaml:"enabled" envconfig:"kuma_multizone_global_kds_mtls_enabled"`
+	// CertValidityPeriod defines how long a certificate issued to a Zone CP is valid.
+	CertValidityPeriod time.Duration `yaml:"certValidityPeriod" envconfig:"kuma_multizone_global_kds_mtls_cert_validity_period"`
+	// RotationThreshold defines how long before the expiration a certificate of a Zone CP is reissued.
+	RotationThreshold time.Duration `yaml:"rotationThreshold" envconfig:"kuma_multizone_global_kds_mtls_rotation_threshold"`
+	// ReconcileInterval defines how often Global CP issues, rotates and revokes certificates of Zone CPs.
+	ReconcileInterval time.Duration `yaml:"reconcileInterval" envconfig:"kuma_multizone_global_kds_mtls_reconcile_interval"`
+}
+
+func (c *KdsMTLSConfig) Validate() error {
+	if c.CertValidityPeriod <= 0 {
+		return errors.New(".CertValidityPeriod must be positive")
+	}
+	if c.RotationThreshold <= 0 || c.RotationThreshold >= c.CertValidityPeriod {
+		return errors.New(".RotationThreshold must be positive and lower than .CertValidityPeriod")
+	}
+	if c.ReconcileInterval <= 0 {
+		return errors.New(".ReconcileInterval must be positive")
+	}
+	return nil
 }
 
 var _ config.Config = &KdsServerConfig{}
@@ -46,6 +76,9 @@ func (c *KdsServerConfig) Validate() (errs error) {
 	if c.TlsKeyFile == "" && c.TlsCertFile != "" {
 		return errors.New("TlsKeyFile cannot be empty if TlsCertFile has been set")
 	}
+	if err := c.MTLS.Validate(); err != nil {
+		return errors.Wrap(err, ".MTLS is not valid")
+	}
 	return
 }
 
@@ -35,6 +35,12 @@ func DefaultGlobalConfig() *GlobalConfig {
 			RefreshInterval:          1 * time.Second,
 			ZoneInsightFlushInterval: 10 * time.Second,
 			MaxMsgSize:               10 * 1024 * 1024,
+			MTLS: KdsMTLSConfig{
+				Enabled:            false,
+				CertValidityPeriod: 30 * 24 * time.Hour,
+				RotationThreshold:  10 * 24 * time.Hour,
+				ReconcileInterval:  10 * time.Second,
+			},
 		},
 	}
 }
@@ -45,11 +51,14 @@ type ZoneConfig struct {
 	Name string `yaml:"name,omitempty" envconfig:"kuma_multizone_zone_name"`
 	// GlobalAddress URL of Global Kuma CP
 	GlobalAddress string `yaml:"globalAddress,omitempty" envconfig:"kuma_multizone_zone_global_address"`
+	// EnrollmentToken is a Zone Enrollment Token generated on Global CP. Zone CP uses it to register itself in Global CP.
+	EnrollmentToken string `yaml:"enrollmentToken,omitempty" envconfig:"kuma_multizone_zone_enrollment_token"`
 	// KDS Configuration
 	KDS *KdsClientConfig `yaml:"kds,omitempty"`
 }
 
 func (r *ZoneConfig) Sanitize() {
+	r.EnrollmentToken = config.SanitizedValue
 	r.KDS.Sanitize()
 }
 
@@ -41,6 +41,48 @@ type PostgresStoreConfig struct {
 	// MaxReconnectInterval controls the maximum possible duration to wait before trying
 	// to re-establish the database connection after connection loss.
 	MaxReconnectInterval time.Duration `yaml:"maxReconnectInterval" envconfig:"kuma_store_postgres_max_reconnect_interval"`
+	// Read replica that serves reads which tolerate bounded staleness
+	ReadReplica ReadReplicaPostgresStoreConfig `yaml:"readReplica"`
+}
+
+// ReadReplicaPostgresStoreConfig defines a read replica of the Postgres DB. The replica is accessed with the same
+// credentials, database name and TLS settings as the primary.
+type ReadReplicaPostgresStoreConfig struct {
+	// Host of the read replica. Empty value means that all reads are served by the primary
+	Host string `yaml:"host" envconfig:"kuma_store_postgres_read_replica_host"`
+	// Port of the read replica
+	Port int `yaml:"port" envconfig:"kuma_store_postgres_read_replica_port"`
+	// Maximum replication lag of the read replica. When the lag is higher, reads are served by the primary
+	MaxStaleness time.Duration `yaml:"maxStaleness" envconfig:"kuma_store_postgres_read_replica_max_staleness"`
+	// Interval of checking the replication lag of the read replica
+	LagCheckInterval time.Duration `yaml:"lagCheckInterval" envconfig:"kuma_store_postgres_read_replica_lag_check_interval"`
+}
+
+func (r ReadReplicaPostgresStoreConfig) Sanitize() {
+}
+
+func (r ReadReplicaPostgresStoreConfig) Validate() error {
+	if r.Host == "" {
+		return nil
+	}
+	if r.Port < 0 {
+		return errors.New("Port cannot be negative")
+	}
+	if r.MaxStaleness <= 0 {
+		return errors.New("MaxStaleness must be positive")
+	}
+	if r.LagCheckInterval <= 0 {
+		return errors.New("LagCheckInterval must be positive")
+	}
+	if r.LagCheckInterval > r.MaxStaleness {
+		return errors.New("LagCheckInterval cannot be greater than MaxStaleness")
+	}
+	return nil
+}
+
+// Enabled returns true when reads can be served by the read replica.
+func (r ReadReplicaPostgresStoreConfig) Enabled() bool {
+	return r.Host != ""
 }
 
 // Modes available here https://godoc.org/github.com/lib/pq
@@ -118,6 +160,9 @@ func (p *PostgresStoreConfig) Validate() error {
 	if p.MinReconnectInterval >= p.MaxReconnectInterval {
 		return errors.New("MinReconnectInterval should be less than MaxReconnectInterval")
 	}
+	if err := p.ReadReplica.Validate(); err != nil {
+		return errors.Wrap(err, "ReadReplica validation failed")
+	}
 	return nil
 }
 
@@ -134,6 +179,18 @@ func DefaultPostgresStoreConfig() *PostgresStoreConfig {
 		TLS:                  DefaultTLSPostgresStoreConfig(),
 		MinReconnectInterval: 10 * time.Second,
 		MaxReconnectInterval: 60 * time.Second,
+		ReadReplica:          DefaultReadReplicaPostgresStoreConfig(),
+	}
+}
+
+var _ config.Config = &ReadReplicaPostgresStoreConfig{}
+
+func DefaultReadReplicaPostgresStoreConfig() ReadReplicaPostgresStoreConfig {
+	return ReadReplicaPostgresStoreConfig{
+		Host:             "",
+		Port:             5432,
+		MaxStaleness:     5 * time.Second,
+		LagCheckInterval: 1 * time.Second,
 	}
 }
 
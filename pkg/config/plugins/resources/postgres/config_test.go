@@ -134,3 +134,50 @@ var _ = Describe("PostgresStoreConfig", func() {
 		}),
 	)
 })
+
+var _ = Describe("ReadReplicaPostgresStoreConfig", func() {
+	type testCase struct {
+		config postgres.ReadReplicaPostgresStoreConfig
+		error  string
+	}
+	DescribeTable("should validate invalid config",
+		func(given testCase) {
+			// when
+			err := given.config.Validate()
+
+			// then
+			Expect(err).To(MatchError(given.error))
+		},
+		Entry("MaxStaleness is not positive", testCase{
+			config: postgres.ReadReplicaPostgresStoreConfig{
+				Host:             "replica",
+				Port:             5432,
+				LagCheckInterval: 1 * time.Second,
+			},
+			error: "MaxStaleness must be positive",
+		}),
+		Entry("LagCheckInterval is greater than MaxStaleness", testCase{
+			config: postgres.ReadReplicaPostgresStoreConfig{
+				Host:             "replica",
+				Port:             5432,
+				MaxStaleness:     1 * time.Second,
+				LagCheckInterval: 2 * time.Second,
+			},
+			error: "LagCheckInterval cannot be greater than MaxStaleness",
+		}),
+	)
+
+	DescribeTable("should validate valid config",
+		func(cfg postgres.ReadReplicaPostgresStoreConfig) {
+			Expect(cfg.Validate()).To(Succeed())
+		},
+		Entry("default", postgres.DefaultReadReplicaPostgresStoreConfig()),
+		Entry("without host", postgres.ReadReplicaPostgresStoreConfig{}),
+		Entry("with host", postgres.ReadReplicaPostgresStoreConfig{
+			Host:             "replica",
+			Port:             5432,
+			MaxStaleness:     5 * time.Second,
+			LagCheckInterval: 1 * time.Second,
+		}),
+	)
+})
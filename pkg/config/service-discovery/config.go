@@ -0,0 +1,153 @@
+package service_discovery
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config"
+)
+
+// Service Discovery configuration.
+// Services registered in external service discovery systems are imported as ExternalServices,
+// so they can be reached through the mesh with policy control, e.g. during a migration to the mesh.
+// Services of the mesh can be exported to external service discovery systems, so they can be discovered by workloads outside of the mesh.
+// Services are imported and exported only by a Standalone or a Global Control Plane, because ExternalServices are managed
+// and all Dataplanes and Zone Ingresses are known by the Global Control Plane in multizone.
+type ServiceDiscoveryConfig struct {
+	// Consul catalog configuration
+	Consul *ConsulConfig `yaml:"consul"`
+	// AWS Cloud Map configuration
+	CloudMap *CloudMapConfig `yaml:"cloudMap"`
+}
+
+func (s *ServiceDiscoveryConfig) Sanitize() {
+	s.Consul.Sanitize()
+	s.CloudMap.Sanitize()
+}
+
+func (s *ServiceDiscoveryConfig) Validate() error {
+	if err := s.Consul.Validate(); err != nil {
+		return errors.Wrap(err, "Consul validation failed")
+	}
+	if err := s.CloudMap.Validate(); err != nil {
+		return errors.Wrap(err, "CloudMap validation failed")
+	}
+	return nil
+}
+
+var _ config.Config = &ServiceDiscoveryConfig{}
+
+// Consul catalog configuration
+type ConsulConfig struct {
+	// If true then healthy instances of services from the Consul catalog are imported as ExternalServices
+	Enabled bool `yaml:"enabled" envconfig:"kuma_service_discovery_consul_enabled"`
+	// URL of the Consul HTTP API, e.g. `http://127.0.0.1:8500`
+	Address string `yaml:"address" envconfig:"kuma_service_discovery_consul_address"`
+	// ACL token used to read the Consul catalog
+	Token string `yaml:"token" envconfig:"kuma_service_discovery_consul_token"`
+	// Datacenter from which the services are imported. If empty, the datacenter of the Consul agent is used.
+	Datacenter string `yaml:"datacenter" envconfig:"kuma_service_discovery_consul_datacenter"`
+	// Names of the services that are imported. If empty, all services except `consul` are imported.
+	Services []string `yaml:"services" envconfig:"kuma_service_discovery_consul_services"`
+	// Mesh in which ExternalServices are created
+	Mesh string `yaml:"mesh" envconfig:"kuma_service_discovery_consul_mesh"`
+	// Interval between synchronizations of the services
+	SyncInterval time.Duration `yaml:"syncInterval" envconfig:"kuma_service_discovery_consul_sync_interval"`
+}
+
+func (c *ConsulConfig) Sanitize() {
+	c.Token = config.SanitizedValue
+}
+
+func (c *ConsulConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if u, err := url.Parse(c.Address); err != nil || u.Scheme == "" || u.Host == "" {
+		return errors.Errorf("Address must be a valid URL, got %q", c.Address)
+	}
+	if c.Mesh == "" {
+		return errors.New("Mesh must not be empty")
+	}
+	if c.SyncInterval <= 0 {
+		return errors.New("SyncInterval must be positive")
+	}
+	return nil
+}
+
+var _ config.Config = &ConsulConfig{}
+
+// AWS Cloud Map configuration
+type CloudMapConfig struct {
+	// If true then services of the mesh are exported to the AWS Cloud Map namespace.
+	// Every healthy inbound of a Dataplane and every Zone Ingress that exposes the service is registered as an instance of the service.
+	// Only instances with IPv4 addresses are registered.
+	Enabled bool `yaml:"enabled" envconfig:"kuma_service_discovery_cloud_map_enabled"`
+	// AWS region of the namespace
+	Region string `yaml:"region" envconfig:"kuma_service_discovery_cloud_map_region"`
+	// ID of the namespace to which the services are exported, e.g. `ns-abcdefgh12345678`.
+	// In DNS namespaces services are created with A and SRV records, so they can also be discovered through Route 53.
+	NamespaceID string `yaml:"namespaceId" envconfig:"kuma_service_discovery_cloud_map_namespace_id"`
+	// Mesh from which services are exported
+	Mesh string `yaml:"mesh" envconfig:"kuma_service_discovery_cloud_map_mesh"`
+	// AWS access key ID. If empty, the default credential chain of the AWS SDK is used, which supports the environment variables,
+	// the shared credentials file, IAM roles for service accounts, ECS task roles and EC2 instance profiles.
+	AccessKeyID string `yaml:"accessKeyId" envconfig:"kuma_service_discovery_cloud_map_access_key_id"`
+	// AWS secret access key. Used together with AccessKeyID.
+	SecretAccessKey string `yaml:"secretAccessKey" envconfig:"kuma_service_discovery_cloud_map_secret_access_key"`
+	// AWS session token of temporary credentials. Used together with AccessKeyID.
+	SessionToken string `yaml:"sessionToken" envconfig:"kuma_service_discovery_cloud_map_session_token"`
+	// URL of the AWS Cloud Map API. If empty, `https://servicediscovery.<region>.amazonaws.com` is used.
+	Endpoint string `yaml:"endpoint" envconfig:"kuma_service_discovery_cloud_map_endpoint"`
+	// Interval between exports of the services
+	SyncInterval time.Duration `yaml:"syncInterval" envconfig:"kuma_service_discovery_cloud_map_sync_interval"`
+}
+
+func (c *CloudMapConfig) Sanitize() {
+	c.SecretAccessKey = config.SanitizedValue
+	c.SessionToken = config.SanitizedValue
+}
+
+func (c *CloudMapConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Region == "" {
+		return errors.New("Region must not be empty")
+	}
+	if c.NamespaceID == "" {
+		return errors.New("NamespaceID must not be empty")
+	}
+	if c.Mesh == "" {
+		return errors.New("Mesh must not be empty")
+	}
+	if c.Endpoint != "" {
+		if u, err := url.Parse(c.Endpoint); err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.Errorf("Endpoint must be a valid URL, got %q", c.Endpoint)
+		}
+	}
+	if c.SyncInterval <= 0 {
+		return errors.New("SyncInterval must be positive")
+	}
+	return nil
+}
+
+var _ config.Config = &CloudMapConfig{}
+
+func DefaultServiceDiscoveryConfig() *ServiceDiscoveryConfig {
+	return &ServiceDiscoveryConfig{
+		Consul: &ConsulConfig{
+			Enabled:      false,
+			Address:      "http://127.0.0.1:8500",
+			Mesh:         "default",
+			SyncInterval: 30 * time.Second,
+		},
+		CloudMap: &CloudMapConfig{
+			Enabled:      false,
+			Mesh:         "default",
+			SyncInterval: 30 * time.Second,
+		},
+	}
+}
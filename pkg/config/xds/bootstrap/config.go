@@ -15,22 +15,53 @@ var _ config.Config = &BootstrapServerConfig{}
 type BootstrapServerConfig struct {
 	// Parameters of bootstrap configuration
 	Params *BootstrapParamsConfig `yaml:"params"`
+	// Default configuration of Envoy overload manager, it can be overridden for a mesh in the Mesh resource
+	OverloadManager *OverloadManagerConfig `yaml:"overloadManager"`
+	// Pruning of Envoy stats that are not used by the features enabled in the mesh of a proxy
+	StatsPruning *StatsPruningConfig `yaml:"statsPruning"`
+	// Fragments of Envoy bootstrap configuration that are merged into the generated bootstrap configuration.
+	// Fragments can only be defined in the configuration file.
+	Fragments []*BootstrapFragmentConfig `yaml:"fragments" ignored:"true"`
 }
 
 func (b *BootstrapServerConfig) Sanitize() {
 	b.Params.Sanitize()
+	b.OverloadManager.Sanitize()
+	b.StatsPruning.Sanitize()
+	for _, fragment := range b.Fragments {
+		fragment.Sanitize()
+	}
 }
 
 func (b *BootstrapServerConfig) Validate() error {
 	if err := b.Params.Validate(); err != nil {
 		return errors.Wrap(err, "Params validation failed")
 	}
+	if err := b.OverloadManager.Validate(); err != nil {
+		return errors.Wrap(err, "OverloadManager validation failed")
+	}
+	if err := b.StatsPruning.Validate(); err != nil {
+		return errors.Wrap(err, "StatsPruning validation failed")
+	}
+	names := map[string]bool{}
+	for i, fragment := range b.Fragments {
+		if err := fragment.Validate(); err != nil {
+			return errors.Wrapf(err, "Fragments[%d] validation failed", i)
+		}
+		if names[fragment.Name] {
+			return errors.Errorf("Fragments[%d] has a duplicated name %q", i, fragment.Name)
+		}
+		names[fragment.Name] = true
+	}
 	return nil
 }
 
 func DefaultBootstrapServerConfig() *BootstrapServerConfig {
 	return &BootstrapServerConfig{
-		Params: DefaultBootstrapParamsConfig(),
+		Params:          DefaultBootstrapParamsConfig(),
+		OverloadManager: DefaultOverloadManagerConfig(),
+		StatsPruning:    DefaultStatsPruningConfig(),
+		Fragments:       []*BootstrapFragmentConfig{},
 	}
 }
 
@@ -86,3 +117,109 @@ func DefaultBootstrapParamsConfig() *BootstrapParamsConfig {
 		XdsConnectTimeout:  1 * time.Second,
 	}
 }
+
+var _ config.Config = &OverloadManagerConfig{}
+
+// OverloadManagerConfig defines how proxies degrade under resource pressure instead of being killed.
+// Heap based actions are configured only when the memory limit of a proxy is known, either detected by kuma-dp
+// or defined in the Mesh resource.
+type OverloadManagerConfig struct {
+	// If true then Envoy overload manager is configured
+	Enabled bool `yaml:"enabled" envconfig:"kuma_bootstrap_server_overload_manager_enabled"`
+	// Fraction of the memory limit at which a proxy starts to release free memory to the system
+	ShrinkHeapThreshold float64 `yaml:"shrinkHeapThreshold" envconfig:"kuma_bootstrap_server_overload_manager_shrink_heap_threshold"`
+	// Fraction of the memory limit at which a proxy stops accepting new requests
+	StopAcceptingRequestsThreshold float64 `yaml:"stopAcceptingRequestsThreshold" envconfig:"kuma_bootstrap_server_overload_manager_stop_accepting_requests_threshold"`
+	// Maximum number of active downstream connections of a proxy across all listeners. 0 means no limit
+	MaxActiveDownstreamConnections uint32 `yaml:"maxActiveDownstreamConnections" envconfig:"kuma_bootstrap_server_overload_manager_max_active_downstream_connections"`
+}
+
+func (o *OverloadManagerConfig) Sanitize() {
+}
+
+func (o *OverloadManagerConfig) Validate() error {
+	if o.ShrinkHeapThreshold <= 0 || o.ShrinkHeapThreshold > 1 {
+		return errors.New("ShrinkHeapThreshold must be in the range (0, 1]")
+	}
+	if o.StopAcceptingRequestsThreshold <= 0 || o.StopAcceptingRequestsThreshold > 1 {
+		return errors.New("StopAcceptingRequestsThreshold must be in the range (0, 1]")
+	}
+	if o.ShrinkHeapThreshold > o.StopAcceptingRequestsThreshold {
+		return errors.New("StopAcceptingRequestsThreshold cannot be lower than ShrinkHeapThreshold")
+	}
+	return nil
+}
+
+func DefaultOverloadManagerConfig() *OverloadManagerConfig {
+	return &OverloadManagerConfig{
+		Enabled:                        true,
+		ShrinkHeapThreshold:            0.95,
+		StopAcceptingRequestsThreshold: 0.98,
+		MaxActiveDownstreamConnections: 50000,
+	}
+}
+
+var _ config.Config = &StatsPruningConfig{}
+
+// StatsPruningConfig defines whether proxies instantiate only the Envoy stats used by the features of their mesh.
+// Stats are pruned when a proxy starts, so a proxy has to be restarted to expose stats of a feature enabled later.
+type StatsPruningConfig struct {
+	// If true then Envoy stats that are not used by the features enabled in the mesh are not instantiated
+	Enabled bool `yaml:"enabled" envconfig:"kuma_bootstrap_server_stats_pruning_enabled"`
+}
+
+func (s *StatsPruningConfig) Sanitize() {
+}
+
+func (s *StatsPruningConfig) Validate() error {
+	return nil
+}
+
+func DefaultStatsPruningConfig() *StatsPruningConfig {
+	return &StatsPruningConfig{
+		Enabled: false,
+	}
+}
+
+var _ config.Config = &BootstrapFragmentConfig{}
+
+// BootstrapFragmentConfig defines a fragment of Envoy bootstrap configuration (ex. stats sinks, overload manager,
+// bootstrap extensions) that is merged into the bootstrap configuration of the selected proxies.
+// Repeated fields of the fragment are appended to the generated configuration, other fields override it.
+type BootstrapFragmentConfig struct {
+	// Name of the fragment
+	Name string `yaml:"name"`
+	// Zones in which the fragment is applied. The fragment is applied in every zone when empty.
+	Zones []string `yaml:"zones,omitempty"`
+	// Tags that have to be matched by one of the inbounds of a dataplane, "*" matches any value.
+	// The fragment is applied to every proxy, including Zone Ingresses, when empty.
+	Tags map[string]string `yaml:"tags,omitempty"`
+	// Path to a file with the fragment in YAML format
+	Path string `yaml:"path,omitempty"`
+	// Inline fragment in YAML format
+	Config string `yaml:"config,omitempty"`
+}
+
+func (f *BootstrapFragmentConfig) Sanitize() {
+	if f.Config != "" {
+		f.Config = config.SanitizedValue
+	}
+}
+
+func (f *BootstrapFragmentConfig) Validate() error {
+	if f.Name == "" {
+		return errors.New("Name cannot be empty")
+	}
+	if f.Path == "" && f.Config == "" {
+		return errors.New("either Path or Config has to be defined")
+	}
+	if f.Path != "" && f.Config != "" {
+		return errors.New("Path and Config cannot be defined at the same time")
+	}
+	for key := range f.Tags {
+		if key == "" {
+			return errors.New("Tags cannot contain an empty key")
+		}
+	}
+	return nil
+}
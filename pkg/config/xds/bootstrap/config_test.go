@@ -8,6 +8,7 @@ import (
 	"time"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
 	"github.com/kumahq/kuma/pkg/config"
@@ -32,6 +33,16 @@ var _ = Describe("BootstrappServerConfig", func() {
 		Expect(cfg.Params.XdsHost).To(Equal("kuma-control-plane.internal"))
 		Expect(cfg.Params.XdsPort).To(Equal(uint32(10101)))
 		Expect(cfg.Params.XdsConnectTimeout).To(Equal(2 * time.Second))
+		Expect(cfg.OverloadManager.Enabled).To(BeFalse())
+		Expect(cfg.OverloadManager.ShrinkHeapThreshold).To(Equal(0.9))
+		Expect(cfg.OverloadManager.StopAcceptingRequestsThreshold).To(Equal(0.95))
+		Expect(cfg.OverloadManager.MaxActiveDownstreamConnections).To(Equal(uint32(10000)))
+		Expect(cfg.StatsPruning.Enabled).To(BeTrue())
+		Expect(cfg.Fragments).To(HaveLen(1))
+		Expect(cfg.Fragments[0].Name).To(Equal("statsd"))
+		Expect(cfg.Fragments[0].Zones).To(Equal([]string{"zone-1"}))
+		Expect(cfg.Fragments[0].Tags).To(Equal(map[string]string{"kuma.io/service": "backend"}))
+		Expect(cfg.Fragments[0].Path).To(Equal("/etc/kuma/bootstrap/statsd.yaml"))
 	})
 
 	Context("with modified environment variables", func() {
@@ -53,13 +64,18 @@ var _ = Describe("BootstrappServerConfig", func() {
 		It("should be loadable from environment variables", func() {
 			// setup
 			env := map[string]string{
-				"KUMA_BOOTSTRAP_SERVER_API_VERSION":                  "v3",
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_ADDRESS":         "192.168.0.1",
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_PORT":            "4321",
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_ACCESS_LOG_PATH": "/var/log",
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_HOST":              "kuma-control-plane.internal",
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_PORT":              "10101",
-				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_CONNECT_TIMEOUT":   "2s",
+				"KUMA_BOOTSTRAP_SERVER_API_VERSION":                                        "v3",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_ADDRESS":                               "192.168.0.1",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_PORT":                                  "4321",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_ACCESS_LOG_PATH":                       "/var/log",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_HOST":                                    "kuma-control-plane.internal",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_PORT":                                    "10101",
+				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_CONNECT_TIMEOUT":                         "2s",
+				"KUMA_BOOTSTRAP_SERVER_OVERLOAD_MANAGER_ENABLED":                           "false",
+				"KUMA_BOOTSTRAP_SERVER_OVERLOAD_MANAGER_SHRINK_HEAP_THRESHOLD":             "0.9",
+				"KUMA_BOOTSTRAP_SERVER_OVERLOAD_MANAGER_STOP_ACCEPTING_REQUESTS_THRESHOLD": "0.95",
+				"KUMA_BOOTSTRAP_SERVER_OVERLOAD_MANAGER_MAX_ACTIVE_DOWNSTREAM_CONNECTIONS": "10000",
+				"KUMA_BOOTSTRAP_SERVER_STATS_PRUNING_ENABLED":                              "true",
 			}
 			for key, value := range env {
 				os.Setenv(key, value)
@@ -81,6 +97,11 @@ var _ = Describe("BootstrappServerConfig", func() {
 			Expect(cfg.Params.XdsHost).To(Equal("kuma-control-plane.internal"))
 			Expect(cfg.Params.XdsPort).To(Equal(uint32(10101)))
 			Expect(cfg.Params.XdsConnectTimeout).To(Equal(2 * time.Second))
+			Expect(cfg.OverloadManager.Enabled).To(BeFalse())
+			Expect(cfg.OverloadManager.ShrinkHeapThreshold).To(Equal(0.9))
+			Expect(cfg.OverloadManager.StopAcceptingRequestsThreshold).To(Equal(0.95))
+			Expect(cfg.OverloadManager.MaxActiveDownstreamConnections).To(Equal(uint32(10000)))
+			Expect(cfg.StatsPruning.Enabled).To(BeTrue())
 		})
 	})
 
@@ -100,4 +121,29 @@ var _ = Describe("BootstrappServerConfig", func() {
 		// and
 		Expect(actual).To(MatchYAML(expected))
 	})
+
+	DescribeTable("should validate fragments",
+		func(fragment BootstrapFragmentConfig, expected string) {
+			// given
+			cfg := DefaultBootstrapServerConfig()
+			cfg.Fragments = []*BootstrapFragmentConfig{&fragment}
+
+			// when
+			err := cfg.Validate()
+
+			// then
+			Expect(err).To(MatchError(expected))
+		},
+		Entry("without name", BootstrapFragmentConfig{
+			Config: "stats_flush_interval: 10s",
+		}, "Fragments[0] validation failed: Name cannot be empty"),
+		Entry("without path and config", BootstrapFragmentConfig{
+			Name: "statsd",
+		}, "Fragments[0] validation failed: either Path or Config has to be defined"),
+		Entry("with both path and config", BootstrapFragmentConfig{
+			Name:   "statsd",
+			Path:   "/etc/kuma/bootstrap/statsd.yaml",
+			Config: "stats_flush_interval: 10s",
+		}, "Fragments[0] validation failed: Path and Config cannot be defined at the same time"),
+	)
 })
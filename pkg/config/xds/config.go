@@ -18,9 +18,46 @@ type XdsServerConfig struct {
 	DataplaneStatusFlushInterval time.Duration `yaml:"dataplaneStatusFlushInterval" envconfig:"kuma_xds_server_dataplane_status_flush_interval"`
 	// Backoff that is executed when Control Plane is sending the response that was previously rejected by Dataplane
 	NACKBackoff time.Duration `yaml:"nackBackoff" envconfig:"kuma_xds_server_nack_backoff"`
+	// Time that a draining Dataplane waits for its endpoints to be removed from the configuration of other Dataplanes
+	// before it drains its own listeners
+	DataplaneDrainPropagationDelay time.Duration `yaml:"dataplaneDrainPropagationDelay" envconfig:"kuma_xds_server_dataplane_drain_propagation_delay"`
+	// Shadow mode configuration
+	Shadow XdsServerShadowConfig `yaml:"shadow"`
+}
+
+// XdsServerShadowConfig defines the shadow mode, in which the Control Plane generates configuration for Dataplanes
+// without serving it and compares it with the configuration served by the active Control Plane.
+// It is used to verify a new version of the Control Plane before it replaces the active one.
+type XdsServerShadowConfig struct {
+	// If true then the Control Plane does not serve XDS, it only compares generated configuration with the active Control Plane
+	Enabled bool `yaml:"enabled" envconfig:"kuma_xds_server_shadow_enabled"`
+	// URL of the API Server of the active Control Plane
+	ActiveControlPlaneURL string `yaml:"activeControlPlaneUrl" envconfig:"kuma_xds_server_shadow_active_control_plane_url"`
+	// Token of a user that is authorized to fetch configuration of Dataplanes from the API Server of the active Control Plane
+	ActiveControlPlaneAuthToken string `yaml:"activeControlPlaneAuthToken" envconfig:"kuma_xds_server_shadow_active_control_plane_auth_token"`
+	// Interval for comparing configuration of Dataplanes with the active Control Plane
+	Interval time.Duration `yaml:"interval" envconfig:"kuma_xds_server_shadow_interval"`
+}
+
+func (s *XdsServerShadowConfig) Sanitize() {
+	s.ActiveControlPlaneAuthToken = config.SanitizedValue
+}
+
+func (s *XdsServerShadowConfig) Validate() error {
+	if !s.Enabled {
+		return nil
+	}
+	if s.ActiveControlPlaneURL == "" {
+		return errors.New("ActiveControlPlaneURL has to be defined when the shadow mode is enabled")
+	}
+	if s.Interval <= 0 {
+		return errors.New("Interval must be positive")
+	}
+	return nil
 }
 
 func (x *XdsServerConfig) Sanitize() {
+	x.Shadow.Sanitize()
 }
 
 func (x *XdsServerConfig) Validate() error {
@@ -30,6 +67,12 @@ func (x *XdsServerConfig) Validate() error {
 	if x.DataplaneStatusFlushInterval <= 0 {
 		return errors.New("DataplaneStatusFlushInterval must be positive")
 	}
+	if x.DataplaneDrainPropagationDelay < 0 {
+		return errors.New("DataplaneDrainPropagationDelay must not be negative")
+	}
+	if err := x.Shadow.Validate(); err != nil {
+		return errors.Wrap(err, ".Shadow is not valid")
+	}
 	return nil
 }
 
@@ -38,5 +81,10 @@ func DefaultXdsServerConfig() *XdsServerConfig {
 		DataplaneConfigurationRefreshInterval: 1 * time.Second,
 		DataplaneStatusFlushInterval:          10 * time.Second,
 		NACKBackoff:                           5 * time.Second,
+		DataplaneDrainPropagationDelay:        5 * time.Second,
+		Shadow: XdsServerShadowConfig{
+			Enabled:  false,
+			Interval: 30 * time.Second,
+		},
 	}
 }
@@ -28,6 +28,7 @@ var _ = Describe("XdsServerConfig", func() {
 		// and
 		Expect(cfg.DataplaneConfigurationRefreshInterval).To(Equal(3 * time.Second))
 		Expect(cfg.DataplaneStatusFlushInterval).To(Equal(5 * time.Second))
+		Expect(cfg.DataplaneDrainPropagationDelay).To(Equal(8 * time.Second))
 	})
 
 	Context("with modified environment variables", func() {
@@ -51,6 +52,7 @@ var _ = Describe("XdsServerConfig", func() {
 			env := map[string]string{
 				"KUMA_XDS_SERVER_DATAPLANE_CONFIGURATION_REFRESH_INTERVAL": "3s",
 				"KUMA_XDS_SERVER_DATAPLANE_STATUS_FLUSH_INTERVAL":          "5s",
+				"KUMA_XDS_SERVER_DATAPLANE_DRAIN_PROPAGATION_DELAY":        "8s",
 			}
 			for key, value := range env {
 				os.Setenv(key, value)
@@ -68,6 +70,7 @@ var _ = Describe("XdsServerConfig", func() {
 			// and
 			Expect(cfg.DataplaneConfigurationRefreshInterval).To(Equal(3 * time.Second))
 			Expect(cfg.DataplaneStatusFlushInterval).To(Equal(5 * time.Second))
+			Expect(cfg.DataplaneDrainPropagationDelay).To(Equal(8 * time.Second))
 		})
 	})
 
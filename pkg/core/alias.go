@@ -15,11 +15,13 @@ import (
 
 var (
 	// TODO remove dependency on kubernetes see: https://github.com/kumahq/kuma/issues/2798
-	Log                   = kube_log.Log
-	NewLogger             = kuma_log.NewLogger
-	NewLoggerWithRotation = kuma_log.NewLoggerWithRotation
-	SetLogger             = kube_log.SetLogger
-	Now                   = time.Now
+	Log                            = kube_log.Log
+	NewLogger                      = kuma_log.NewLogger
+	NewLoggerWithRotation          = kuma_log.NewLoggerWithRotation
+	NewComponentLogger             = kuma_log.NewComponentLogger
+	NewComponentLoggerWithRotation = kuma_log.NewComponentLoggerWithRotation
+	SetLogger                      = kube_log.SetLogger
+	Now                            = time.Now
 
 	SetupSignalHandler = func() context.Context {
 		ctx, cancel := context.WithCancel(context.Background())
@@ -17,6 +17,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/managers/apis/dataplane"
 	"github.com/kumahq/kuma/pkg/core/managers/apis/dataplaneinsight"
 	externalservice_managers "github.com/kumahq/kuma/pkg/core/managers/apis/external_service"
+	guardrails_managers "github.com/kumahq/kuma/pkg/core/managers/apis/guardrails"
 	mesh_managers "github.com/kumahq/kuma/pkg/core/managers/apis/mesh"
 	ratelimit_managers "github.com/kumahq/kuma/pkg/core/managers/apis/ratelimit"
 	"github.com/kumahq/kuma/pkg/core/managers/apis/zone"
@@ -34,7 +35,9 @@ import (
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
 	runtime_reports "github.com/kumahq/kuma/pkg/core/runtime/reports"
 	secret_cipher "github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	external_secrets "github.com/kumahq/kuma/pkg/core/secrets/external"
 	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
+	defaults_mesh "github.com/kumahq/kuma/pkg/defaults/mesh"
 	"github.com/kumahq/kuma/pkg/dns/resolver"
 	"github.com/kumahq/kuma/pkg/dp-server/server"
 	"github.com/kumahq/kuma/pkg/envoy/admin"
@@ -90,7 +93,11 @@ func buildRuntime(appCtx context.Context, cfg kuma_cp.Config) (core_runtime.Runt
 		return nil, err
 	}
 
-	builder.WithDataSourceLoader(datasource.NewDataSourceLoader(builder.ReadOnlyResourceManager()))
+	externalSecrets, err := external_secrets.NewResolver(cfg.ExternalSecrets)
+	if err != nil {
+		return nil, err
+	}
+	builder.WithDataSourceLoader(datasource.NewDataSourceLoader(builder.ReadOnlyResourceManager(), externalSecrets))
 
 	if err := initializeCaManagers(builder); err != nil {
 		return nil, err
@@ -125,7 +132,7 @@ func buildRuntime(appCtx context.Context, cfg kuma_cp.Config) (core_runtime.Runt
 		return nil, err
 	}
 
-	if err := rt.Add(leaderInfoComponent); err != nil {
+	if err := rt.Add(leaderInfoComponent, externalSecrets); err != nil {
 		return nil, err
 	}
 
@@ -326,9 +333,13 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 	defaultManager := core_manager.NewResourceManager(builder.ResourceStore())
 	customizableManager := core_manager.NewCustomizableResourceManager(defaultManager, nil)
 
+	policyTemplates, err := defaults_mesh.LoadPolicyTemplates(cfg.Defaults.MeshPolicyTemplates)
+	if err != nil {
+		return errors.Wrap(err, "could not load templates of the default policies of meshes")
+	}
 	customizableManager.Customize(
 		mesh.MeshType,
-		mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), builder.MeshValidator()),
+		mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), builder.MeshValidator(), policyTemplates),
 	)
 
 	rateLimitValidator := ratelimit_managers.RateLimitValidator{
@@ -339,12 +350,28 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 		ratelimit_managers.NewRateLimitManager(builder.ResourceStore(), rateLimitValidator),
 	)
 
+	guardrailsValidator := guardrails_managers.Validator{
+		Store: builder.ResourceStore(),
+	}
 	externalServiceValidator := externalservice_managers.ExternalServiceValidator{
 		Store: builder.ResourceStore(),
 	}
 	customizableManager.Customize(
 		mesh.ExternalServiceType,
-		externalservice_managers.NewExternalServiceManager(builder.ResourceStore(), externalServiceValidator),
+		guardrails_managers.NewGuardrailsManager(
+			externalservice_managers.NewExternalServiceManager(builder.ResourceStore(), externalServiceValidator),
+			guardrailsValidator,
+		),
+	)
+
+	customizableManager.Customize(
+		mesh.ProxyTemplateType,
+		guardrails_managers.NewGuardrailsManager(defaultManager, guardrailsValidator),
+	)
+
+	customizableManager.Customize(
+		mesh.ProxyPatchType,
+		guardrails_managers.NewGuardrailsManager(defaultManager, guardrailsValidator),
 	)
 
 	customizableManager.Customize(
@@ -414,7 +441,7 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 }
 
 func initializeDNSResolver(cfg kuma_cp.Config, builder *core_runtime.Builder) error {
-	builder.WithDNSResolver(resolver.NewDNSResolver(cfg.DNSServer.Domain))
+	builder.WithDNSResolver(resolver.NewDNSResolver(cfg.DNSServer.Domain, cfg.DNSServer.AdditionalDomains...))
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package issuer
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
@@ -52,7 +53,20 @@ func NewWorkloadCert(ca util_tls.KeyPair, mesh string, tags mesh_proto.MultiValu
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate X509 certificate")
 	}
-	return util_tls.ToKeyPair(workloadKey, workloadCert)
+	keyPair, err := util_tls.ToKeyPair(workloadKey, workloadCert)
+	if err != nil {
+		return nil, err
+	}
+	if !isSelfSigned(caCert) {
+		// the workload cert is issued by an intermediate CA, so we distribute the whole chain
+		// for the peers to be able to build the path to the root CA they trust.
+		keyPair.CertPEM = append(keyPair.CertPEM, ca.CertPEM...)
+	}
+	return keyPair, nil
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil
 }
 
 func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, publicKey crypto.PublicKey, certOpts ...CertOptsFn) (*x509.Certificate, error) {
@@ -64,6 +78,18 @@ func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, p
 		}
 		uris = append(uris, uri)
 	}
+	// Dataplanes with an instance tag (e.g. pods of StatefulSets) also get
+	// an instance-scoped identity for each service, so that policies can
+	// authorize a specific instance.
+	for _, service := range tags.Values(mesh_proto.ServiceTag) {
+		for _, instance := range tags.UniqueValues(mesh_proto.InstanceTag) {
+			uri, err := spiffe.ParseID(fmt.Sprintf("spiffe://%s/%s/instance/%s", trustDomain, service, instance), spiffe.AllowTrustDomainWorkload(trustDomain))
+			if err != nil {
+				return nil, err
+			}
+			uris = append(uris, uri)
+		}
+	}
 	for _, tag := range tags.Keys() {
 		for _, value := range tags.UniqueValues(tag) {
 			uri := fmt.Sprintf("kuma://%s/%s", tag, value)
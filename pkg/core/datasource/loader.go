@@ -10,6 +10,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/secrets/external"
 )
 
 type Loader interface {
@@ -17,14 +18,18 @@ type Loader interface {
 }
 
 type loader struct {
-	secretManager manager.ReadOnlyResourceManager
+	secretManager   manager.ReadOnlyResourceManager
+	externalSecrets external.Resolver
 }
 
 var _ Loader = &loader{}
 
-func NewDataSourceLoader(secretManager manager.ReadOnlyResourceManager) Loader {
+// NewDataSourceLoader creates a Loader of data sources. Secrets that reference values of external secret managers
+// are resolved by externalSecrets, which can be nil if no external secret manager is available.
+func NewDataSourceLoader(secretManager manager.ReadOnlyResourceManager, externalSecrets external.Resolver) Loader {
 	return &loader{
-		secretManager: secretManager,
+		secretManager:   secretManager,
+		externalSecrets: externalSecrets,
 	}
 }
 
@@ -57,5 +62,11 @@ func (l *loader) loadSecret(ctx context.Context, mesh string, secret string) ([]
 	if err := l.secretManager.Get(ctx, resource, core_store.GetByKey(secret, mesh)); err != nil {
 		return nil, err
 	}
+	if ref := resource.Spec.GetExternal(); ref != nil {
+		if l.externalSecrets == nil {
+			return nil, errors.New("no external secret managers")
+		}
+		return l.externalSecrets.Resolve(ctx, ref)
+	}
 	return resource.Spec.GetData().GetValue(), nil
 }
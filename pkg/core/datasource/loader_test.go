@@ -4,6 +4,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -14,12 +15,19 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	"github.com/kumahq/kuma/pkg/core/secrets/external"
 	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
+type staticProvider map[string]string
+
+func (s staticProvider) Fetch(_ context.Context, path string, key string) ([]byte, error) {
+	return []byte(s[path+"#"+key]), nil
+}
+
 var _ = Describe("DataSource Loader", func() {
 
 	var secretManager manager.ResourceManager
@@ -27,7 +35,7 @@ var _ = Describe("DataSource Loader", func() {
 
 	BeforeEach(func() {
 		secretManager = secret_manager.NewSecretManager(secret_store.NewSecretStore(memory.NewStore()), cipher.None(), nil)
-		dataSourceLoader = datasource.NewDataSourceLoader(secretManager)
+		dataSourceLoader = datasource.NewDataSourceLoader(secretManager, nil)
 	})
 
 	Context("Secret", func() {
@@ -64,6 +72,35 @@ var _ = Describe("DataSource Loader", func() {
 			// then
 			Expect(err).To(MatchError(`could not load data: Resource not found: type="Secret" name="test-secret" mesh="default"`))
 		})
+
+		It("should load secret that references an external value", func() {
+			// given
+			dataSourceLoader = datasource.NewDataSourceLoader(secretManager, external.NewCachingResolver(map[string]external.Provider{
+				external.ProviderVault: staticProvider{"kuma/gateway#tls.pem": "abc"},
+			}, time.Minute))
+			secretResource := system.SecretResource{
+				Spec: &system_proto.Secret{
+					External: &system_proto.Secret_External{
+						Provider: external.ProviderVault,
+						Path:     "kuma/gateway",
+						Key:      "tls.pem",
+					},
+				},
+			}
+			err := secretManager.Create(context.Background(), &secretResource, store.CreateByKey("test-secret", "default"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			data, err := dataSourceLoader.Load(context.Background(), "default", &system_proto.DataSource{
+				Type: &system_proto.DataSource_Secret{
+					Secret: "test-secret",
+				},
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([]byte("abc")))
+		})
 	})
 
 	Context("File", func() {
@@ -34,7 +34,7 @@ func (m *dataplaneInsightManager) Create(ctx context.Context, resource core_mode
 	m.limitSubscription(resource.(*core_mesh.DataplaneInsightResource))
 
 	dp := core_mesh.NewDataplaneResource()
-	if err := m.store.Get(ctx, dp, core_store.GetByKey(opts.Name, opts.Mesh)); err != nil {
+	if err := m.store.Get(ctx, dp, core_store.GetByKey(opts.Name, opts.Mesh), core_store.GetConsistent()); err != nil {
 		return err
 	}
 	return m.store.Create(ctx, resource, append(fs, core_store.CreatedAt(core.Now()), core_store.CreateWithOwner(dp))...)
@@ -50,12 +50,5 @@ func (m *dataplaneInsightManager) limitSubscription(dpInsight *core_mesh.Datapla
 		dpInsight.Spec.Subscriptions = nil
 		return
 	}
-	if m.config.SubscriptionLimit == 0 {
-		return
-	}
-	if len(dpInsight.Spec.Subscriptions) <= m.config.SubscriptionLimit {
-		return
-	}
-	s := dpInsight.Spec.Subscriptions
-	dpInsight.Spec.Subscriptions = s[len(s)-m.config.SubscriptionLimit:]
+	dpInsight.Spec.PruneSubscriptions(m.config.SubscriptionLimit, m.config.SubscriptionMaxAge, core.Now())
 }
@@ -3,6 +3,7 @@ package dataplaneinsight_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -13,6 +14,7 @@ import (
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
 var _ = Describe("DataplaneInsight Manager", func() {
@@ -49,6 +51,54 @@ var _ = Describe("DataplaneInsight Manager", func() {
 		Expect(actual.Spec.Subscriptions[0].Id).To(Equal("7"))
 		Expect(actual.Spec.Subscriptions[1].Id).To(Equal("8"))
 		Expect(actual.Spec.Subscriptions[2].Id).To(Equal("9"))
+		Expect(actual.Spec.GetConnectCount()).To(Equal(uint32(10)))
+	})
+
+	It("should drop subscriptions older than the max age", func() {
+		// setup
+		s := memory.NewStore()
+		cfg := &kuma_cp.DataplaneMetrics{
+			Enabled:            true,
+			SubscriptionLimit:  10,
+			SubscriptionMaxAge: time.Hour,
+		}
+		manager := dataplaneinsight.NewDataplaneInsightManager(s, cfg)
+
+		err := s.Create(context.Background(), core_mesh.NewDataplaneResource(), store.CreateByKey("di1", "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		now := time.Now()
+		input := core_mesh.NewDataplaneInsightResource()
+		input.Spec.Subscriptions = []*mesh_proto.DiscoverySubscription{
+			{
+				Id:             "1",
+				ConnectTime:    util_proto.MustTimestampProto(now.Add(-3 * time.Hour)),
+				DisconnectTime: util_proto.MustTimestampProto(now.Add(-2 * time.Hour)),
+			},
+			{
+				Id:             "2",
+				ConnectTime:    util_proto.MustTimestampProto(now.Add(-2 * time.Hour)),
+				DisconnectTime: util_proto.MustTimestampProto(now.Add(-30 * time.Minute)),
+			},
+			{
+				Id:          "3",
+				ConnectTime: util_proto.MustTimestampProto(now.Add(-30 * time.Minute)),
+			},
+		}
+
+		// when
+		err = manager.Create(context.Background(), input, store.CreateByKey("di1", "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		actual := core_mesh.NewDataplaneInsightResource()
+		err = s.Get(context.Background(), actual, store.GetByKey("di1", "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(actual.Spec.Subscriptions).To(HaveLen(2))
+		Expect(actual.Spec.Subscriptions[0].Id).To(Equal("2"))
+		Expect(actual.Spec.GetConnectCount()).To(Equal(uint32(3)))
+		Expect(actual.Spec.GetSubscriptionSummary().GetPrunedConnectedDuration().AsDuration()).To(Equal(time.Hour))
 	})
 
 	It("should cleanup subscriptions if disabled", func() {
@@ -0,0 +1,44 @@
+package guardrails
+
+import (
+	"context"
+
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+type guardrailsManager struct {
+	core_manager.ResourceManager
+	validator Validator
+}
+
+// NewGuardrailsManager returns a manager that validates created and updated resources against the guardrails
+// of their mesh before passing them to the delegate.
+func NewGuardrailsManager(delegate core_manager.ResourceManager, validator Validator) core_manager.ResourceManager {
+	return &guardrailsManager{
+		ResourceManager: delegate,
+		validator:       validator,
+	}
+}
+
+func (m *guardrailsManager) Create(ctx context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
+	if err := resource.Validate(); err != nil {
+		return err
+	}
+	opts := store.NewCreateOptions(fs...)
+	if err := m.validator.ValidateCreate(ctx, opts.Mesh, resource); err != nil {
+		return err
+	}
+	return m.ResourceManager.Create(ctx, resource, fs...)
+}
+
+func (m *guardrailsManager) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+	if err := resource.Validate(); err != nil {
+		return err
+	}
+	if err := m.validator.ValidateUpdate(ctx, resource); err != nil {
+		return err
+	}
+	return m.ResourceManager.Update(ctx, resource, fs...)
+}
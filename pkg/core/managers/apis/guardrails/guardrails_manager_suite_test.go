@@ -0,0 +1,11 @@
+package guardrails_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestGuardrailsManager(t *testing.T) {
+	test.RunSpecs(t, "Guardrails Manager Suite")
+}
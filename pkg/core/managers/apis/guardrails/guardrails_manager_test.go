@@ -0,0 +1,85 @@
+package guardrails_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/managers/apis/guardrails"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Guardrails Manager", func() {
+
+	var resStore store.ResourceStore
+	var resManager manager.ResourceManager
+
+	BeforeEach(func() {
+		resStore = memory.NewStore()
+		resManager = guardrails.NewGuardrailsManager(manager.NewResourceManager(resStore), guardrails.Validator{Store: resStore})
+
+		mesh := core_mesh.NewMeshResource()
+		mesh.Spec.Guardrails = &mesh_proto.Guardrails{
+			Deny: []string{mesh_proto.GuardrailPlaintextExternalService},
+		}
+		err := resStore.Create(context.Background(), mesh, store.CreateByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	externalService := func(tls bool) *core_mesh.ExternalServiceResource {
+		es := core_mesh.NewExternalServiceResource()
+		es.Spec = &mesh_proto.ExternalService{
+			Networking: &mesh_proto.ExternalService_Networking{
+				Address: "httpbin.org:443",
+				Tls: &mesh_proto.ExternalService_Networking_TLS{
+					Enabled: tls,
+				},
+			},
+			Tags: map[string]string{
+				mesh_proto.ServiceTag: "httpbin",
+			},
+		}
+		return es
+	}
+
+	It("should create a resource that does not use denied features", func() {
+		// when
+		err := resManager.Create(context.Background(), externalService(true), store.CreateByKey("httpbin", "mesh-1"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject a resource that uses denied features", func() {
+		// when
+		err := resManager.Create(context.Background(), externalService(false), store.CreateByKey("httpbin", "mesh-1"))
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(validators.IsValidationError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("plaintext external services are denied by the guardrails of the mesh"))
+	})
+
+	It("should reject an update that starts to use denied features", func() {
+		// given
+		err := resManager.Create(context.Background(), externalService(true), store.CreateByKey("httpbin", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		es := core_mesh.NewExternalServiceResource()
+		Expect(resManager.Get(context.Background(), es, store.GetByKey("httpbin", "mesh-1"))).To(Succeed())
+		es.Spec.Networking.Tls.Enabled = false
+		err = resManager.Update(context.Background(), es)
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(validators.IsValidationError(err)).To(BeTrue())
+	})
+})
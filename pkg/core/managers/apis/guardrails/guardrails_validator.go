@@ -0,0 +1,35 @@
+package guardrails
+
+import (
+	"context"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+// Validator rejects resources that use features denied by the guardrails of their mesh.
+type Validator struct {
+	Store store.ResourceStore
+}
+
+func (v *Validator) ValidateCreate(ctx context.Context, mesh string, resource model.Resource) error {
+	return v.validate(ctx, mesh, resource)
+}
+
+func (v *Validator) ValidateUpdate(ctx context.Context, resource model.Resource) error {
+	return v.validate(ctx, resource.GetMeta().GetMesh(), resource)
+}
+
+func (v *Validator) validate(ctx context.Context, mesh string, resource model.Resource) error {
+	meshRes := core_mesh.NewMeshResource()
+	if err := v.Store.Get(ctx, meshRes, store.GetByKey(mesh, model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return core_manager.MeshNotFound(mesh)
+		}
+		return err
+	}
+	verr := core_mesh.ValidateGuardrails(meshRes.Spec, resource)
+	return verr.OrNil()
+}
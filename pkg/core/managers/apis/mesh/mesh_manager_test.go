@@ -38,8 +38,8 @@ var _ = Describe("Mesh Manager", func() {
 	BeforeEach(func() {
 		resStore = memory.NewStore()
 		secretManager = secrets_manager.NewSecretManager(secrets_store.NewSecretStore(resStore), cipher.None(), nil)
-		builtinCaManager = ca_builtin.NewBuiltinCaManager(secretManager)
-		providedCaManager := provided.NewProvidedCaManager(datasource.NewDataSourceLoader(secretManager))
+		builtinCaManager = ca_builtin.NewBuiltinCaManager(secretManager, datasource.NewDataSourceLoader(secretManager, nil), "")
+		providedCaManager := provided.NewProvidedCaManager(datasource.NewDataSourceLoader(secretManager, nil))
 		caManagers := core_ca.Managers{
 			"builtin":  builtinCaManager,
 			"provided": providedCaManager,
@@ -47,7 +47,7 @@ var _ = Describe("Mesh Manager", func() {
 
 		manager := manager.NewResourceManager(resStore)
 		validator := mesh.NewMeshValidator(caManagers, resStore)
-		resManager = mesh.NewMeshManager(resStore, manager, caManagers, test_resources.Global(), validator)
+		resManager = mesh.NewMeshManager(resStore, manager, caManagers, test_resources.Global(), validator, nil)
 	})
 
 	Describe("Create()", func() {
@@ -113,6 +113,30 @@ var _ = Describe("Mesh Manager", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should skip creating the default policies of the mesh", func() {
+			// given
+			meshName := "mesh-1"
+			resKey := model.ResourceKey{
+				Name: meshName,
+			}
+
+			// when
+			mesh := core_mesh.NewMeshResource()
+			mesh.Spec.SkipCreatingInitialPolicies = []string{"TrafficPermission"}
+			err := resManager.Create(context.Background(), mesh, store.CreateBy(resKey))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and default TrafficPermission for the mesh doesn't exist
+			err = resStore.Get(context.Background(), core_mesh.NewTrafficPermissionResource(), store.GetByKey("allow-all-mesh-1", meshName))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+
+			// and default TrafficRoute for the mesh exists
+			err = resStore.Get(context.Background(), core_mesh.NewTrafficRouteResource(), store.GetByKey("route-all-mesh-1", meshName))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		Describe("should set default values for Prometheus settings", func() {
 
 			type testCase struct {
@@ -308,6 +332,34 @@ var _ = Describe("Mesh Manager", func() {
 			}))
 		})
 
+		It("should not allow to deny features that are used by resources of the mesh", func() {
+			// given
+			meshName := "mesh-1"
+			mesh := core_mesh.NewMeshResource()
+			err := resManager.Create(context.Background(), mesh, store.CreateByKey(meshName, model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+
+			patch := core_mesh.NewProxyPatchResource()
+			err = resStore.Create(context.Background(), patch, store.CreateByKey("patch-1", meshName))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			mesh.Spec.Guardrails = &mesh_proto.Guardrails{
+				Deny: []string{mesh_proto.GuardrailProxyPatch},
+			}
+			err = resManager.Update(context.Background(), mesh)
+
+			// then
+			Expect(err).To(Equal(&validators.ValidationError{
+				Violations: []validators.Violation{
+					{
+						Field:   "guardrails.deny",
+						Message: `ProxyPatch "patch-1" uses a denied feature`,
+					},
+				},
+			}))
+		})
+
 		It("should allow to change CA when mTLS is disabled", func() {
 			// given
 			meshName := "mesh-1"
@@ -2,11 +2,13 @@ package mesh
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/validators"
 )
@@ -64,6 +66,9 @@ func (m *meshValidator) ValidateUpdate(ctx context.Context, previousMesh *core_m
 	if err := ValidateMTLSBackends(ctx, m.CaManagers, newMesh.Meta.GetName(), newMesh); err != nil {
 		return err
 	}
+	if err := ValidateGuardrailsOfResources(ctx, newMesh, m.Store); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -87,6 +92,32 @@ func ValidateNoActiveDP(ctx context.Context, name string, store core_store.Resou
 	return nil
 }
 
+// ValidateGuardrailsOfResources validates that existing resources of the mesh do not use features denied by the guardrails,
+// so the guardrails cannot be enabled while they are violated.
+func ValidateGuardrailsOfResources(ctx context.Context, mesh *core_mesh.MeshResource, store core_store.ResourceStore) error {
+	if len(mesh.Spec.GetGuardrails().GetDeny()) == 0 {
+		return nil
+	}
+	verr := validators.ValidationError{}
+	lists := []core_model.ResourceList{
+		&core_mesh.ProxyTemplateResourceList{},
+		&core_mesh.ProxyPatchResourceList{},
+		&core_mesh.ExternalServiceResourceList{},
+	}
+	for _, list := range lists {
+		if err := store.List(ctx, list, core_store.ListByMesh(mesh.GetMeta().GetName())); err != nil {
+			return errors.Wrapf(err, "unable to list %s", list.GetItemType())
+		}
+		for _, resource := range list.GetItems() {
+			denied := core_mesh.ValidateGuardrails(mesh.Spec, resource)
+			if denied.HasViolations() {
+				verr.AddViolation("guardrails.deny", fmt.Sprintf("%s %q uses a denied feature", resource.Descriptor().Name, resource.GetMeta().GetName()))
+			}
+		}
+	}
+	return verr.OrNil()
+}
+
 func (m *meshValidator) validateMTLSBackendChange(previousMesh *core_mesh.MeshResource, newMesh *core_mesh.MeshResource) error {
 	verr := validators.ValidationError{}
 	if previousMesh.MTLSEnabled() && newMesh.MTLSEnabled() && previousMesh.Spec.GetMtls().GetEnabledBackend() != newMesh.Spec.GetMtls().GetEnabledBackend() {
@@ -34,7 +34,7 @@ func (m *zoneIngressInsightManager) Create(ctx context.Context, resource core_mo
 	m.limitSubscription(resource.(*mesh.ZoneIngressInsightResource))
 
 	zoneIngress := mesh.NewZoneIngressResource()
-	if err := m.store.Get(ctx, zoneIngress, core_store.GetByKey(opts.Name, core_model.NoMesh)); err != nil {
+	if err := m.store.Get(ctx, zoneIngress, core_store.GetByKey(opts.Name, core_model.NoMesh), core_store.GetConsistent()); err != nil {
 		return err
 	}
 	return m.store.Create(ctx, resource, append(fs, core_store.CreatedAt(core.Now()), core_store.CreateWithOwner(zoneIngress))...)
@@ -99,6 +99,48 @@ func SelectInboundDataplanePolicies(dataplane *mesh.DataplaneResource, policies
 	return match
 }
 
+// SelectDataplanePolicies given a Dataplane definition and a list of DataplanePolicy returns all matching DataplanePolicies.
+// Unlike SelectDataplanePolicy, policies are not exclusive, so every match is returned, ordered from the most specific one:
+// by rank (score) defined the same way as in SelectDataplanePolicy and, in case of the same rank, the policy created last first.
+func SelectDataplanePolicies(dataplane *mesh.DataplaneResource, policies []DataplanePolicy) []DataplanePolicy {
+	sort.Stable(DataplanePolicyByName(policies)) // sort to avoid flakiness
+
+	var matched []DataplanePolicy
+	ranks := map[DataplanePolicy]mesh_proto.TagSelectorRank{}
+	for _, policy := range policies {
+		isMatch := false
+		var bestRank mesh_proto.TagSelectorRank
+		if 0 == len(policy.Selectors()) { // match everything
+			isMatch = true
+		}
+		for _, selector := range policy.Selectors() {
+			if 0 == len(selector.Match) { // match everything
+				isMatch = true
+				continue
+			}
+			tagSelector := mesh_proto.TagSelector(selector.Match)
+			if dataplane.Spec.Matches(tagSelector) {
+				isMatch = true
+				if rank := tagSelector.Rank(); rank.CompareTo(bestRank) > 0 {
+					bestRank = rank
+				}
+			}
+		}
+		if isMatch {
+			matched = append(matched, policy)
+			ranks[policy] = bestRank
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		if cmp := ranks[matched[i]].CompareTo(ranks[matched[j]]); cmp != 0 {
+			return cmp > 0
+		}
+		return matched[i].GetMeta().GetCreationTime().After(matched[j].GetMeta().GetCreationTime())
+	})
+	return matched
+}
+
 type DataplanePolicyByName []DataplanePolicy
 
 func (a DataplanePolicyByName) Len() int      { return len(a) }
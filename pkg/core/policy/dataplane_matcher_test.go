@@ -381,4 +381,51 @@ var _ = Describe("Dataplane matcher", func() {
 			}),
 		)
 	})
+
+	Describe("SelectDataplanePolicies()", func() {
+
+		proxyPatch := func(name string, creationTime time.Time, selectors ...map[string]string) *core_mesh.ProxyPatchResource {
+			patch := &core_mesh.ProxyPatchResource{
+				Meta: &test_model.ResourceMeta{
+					Mesh:         "demo",
+					Name:         name,
+					CreationTime: creationTime,
+				},
+				Spec: &mesh_proto.ProxyPatch{},
+			}
+			for _, selector := range selectors {
+				patch.Spec.Selectors = append(patch.Spec.Selectors, &mesh_proto.Selector{Match: selector})
+			}
+			return patch
+		}
+
+		It("should return all matching policies starting from the most specific one", func() {
+			// given
+			dataplane := &core_mesh.DataplaneResource{
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Tags: map[string]string{
+									"app":     "example",
+									"version": "1.0",
+								},
+							},
+						},
+					},
+				},
+			}
+			everything := proxyPatch("everything", time.Unix(0, 0))
+			app := proxyPatch("app", time.Unix(0, 0), map[string]string{"app": "example"})
+			appLater := proxyPatch("app-later", time.Unix(1, 0), map[string]string{"app": "*"})
+			version := proxyPatch("version", time.Unix(0, 0), map[string]string{"app": "example", "version": "1.0"})
+			other := proxyPatch("other", time.Unix(0, 0), map[string]string{"app": "other"})
+
+			// when
+			actual := policy.SelectDataplanePolicies(dataplane, []policy.DataplanePolicy{everything, app, appLater, version, other})
+
+			// then
+			Expect(actual).To(Equal([]policy.DataplanePolicy{version, app, appLater, everything}))
+		})
+	})
 })
@@ -0,0 +1,99 @@
+package mesh
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (c *ConnectionPoolResource) Validate() error {
+	var err validators.ValidationError
+
+	err.Add(c.validateSources())
+	err.Add(c.validateDestinations())
+	err.Add(c.validateConf())
+
+	return err.OrNil()
+}
+
+func (c *ConnectionPoolResource) validateSources() validators.ValidationError {
+	return ValidateSelectors(
+		validators.RootedAt("sources"),
+		c.Spec.Sources,
+		ValidateSelectorsOpts{
+			ValidateSelectorOpts: ValidateSelectorOpts{
+				RequireAtLeastOneTag: true,
+				RequireService:       true,
+			},
+			RequireAtLeastOneSelector: true,
+		},
+	)
+}
+
+func (c *ConnectionPoolResource) validateDestinations() validators.ValidationError {
+	return ValidateSelectors(
+		validators.RootedAt("destinations"),
+		c.Spec.Destinations,
+		OnlyServiceTagAllowed,
+	)
+}
+
+func (c *ConnectionPoolResource) validateConf() (err validators.ValidationError) {
+	path := validators.RootedAt("conf")
+	conf := c.Spec.GetConf()
+
+	if conf == nil {
+		err.AddViolationAt(path, HasToBeDefinedViolation)
+		return
+	}
+
+	if conf.MaxRequestsPerConnection == nil && conf.IdleTimeout == nil &&
+		conf.Http2 == nil && conf.TcpKeepalive == nil &&
+		conf.DnsLookupFamily == mesh_proto.Networking_Outbound_UNSPECIFIED {
+		err.AddViolationAt(path, "at least one setting has to be defined")
+		return
+	}
+
+	if conf.MaxRequestsPerConnection != nil && conf.MaxRequestsPerConnection.GetValue() == 0 {
+		err.AddViolationAt(path.Field("maxRequestsPerConnection"), WhenDefinedHasToBeGreaterThan0Violation)
+	}
+	err.Add(validateDuration_GreaterThan0OrNil(path.Field("idleTimeout"), conf.IdleTimeout))
+	err.Add(c.validateConfHttp2(path.Field("http2"), conf.GetHttp2()))
+	err.Add(c.validateConfTcpKeepalive(path.Field("tcpKeepalive"), conf.GetTcpKeepalive()))
+
+	return
+}
+
+func (c *ConnectionPoolResource) validateConfHttp2(path validators.PathBuilder, conf *mesh_proto.ConnectionPool_Conf_Http2) (err validators.ValidationError) {
+	if conf == nil {
+		return
+	}
+	err.Add(validateRequiredDuration(path.Field("keepaliveInterval"), conf.KeepaliveInterval))
+	err.Add(validateRequiredDuration(path.Field("keepaliveTimeout"), conf.KeepaliveTimeout))
+	return
+}
+
+func (c *ConnectionPoolResource) validateConfTcpKeepalive(path validators.PathBuilder, conf *mesh_proto.ConnectionPool_Conf_TcpKeepalive) (err validators.ValidationError) {
+	if conf == nil {
+		return
+	}
+	if conf.Probes == nil && conf.Time == nil && conf.Interval == nil {
+		err.AddViolationAt(path, "at least one setting in section has to be defined")
+		return
+	}
+	if conf.Probes != nil && conf.Probes.GetValue() == 0 {
+		err.AddViolationAt(path.Field("probes"), WhenDefinedHasToBeGreaterThan0Violation)
+	}
+	err.Add(validateDuration_GreaterThan0OrNil(path.Field("time"), conf.Time))
+	err.Add(validateDuration_GreaterThan0OrNil(path.Field("interval"), conf.Interval))
+	return
+}
+
+func validateRequiredDuration(path validators.PathBuilder, duration *durationpb.Duration) (err validators.ValidationError) {
+	if duration == nil {
+		err.AddViolationAt(path, HasToBeDefinedViolation)
+		return
+	}
+	return validateDuration_GreaterThan0(path, duration)
+}
@@ -0,0 +1,142 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("ConnectionPool", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(connectionPoolYAML string) {
+				// setup
+				connectionPool := NewConnectionPoolResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(connectionPoolYAML), connectionPool.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := connectionPool.Validate()
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full policy", `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                conf:
+                  maxRequestsPerConnection: 100
+                  idleTimeout: 30s
+                  http2:
+                    keepaliveInterval: 10s
+                    keepaliveTimeout: 5s
+                  tcpKeepalive:
+                    probes: 3
+                    time: 60s
+                    interval: 10s`),
+			Entry("only max requests per connection", `
+                sources:
+                - match:
+                   kuma.io/service: '*'
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                conf:
+                  maxRequestsPerConnection: 1000`),
+			Entry("only dns lookup family", `
+                sources:
+                - match:
+                   kuma.io/service: '*'
+                destinations:
+                - match:
+                   kuma.io/service: external-api
+                conf:
+                  dnsLookupFamily: AUTO`),
+		)
+
+		type testCase struct {
+			connectionPool string
+			expected       string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				connectionPool := NewConnectionPoolResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.connectionPool), connectionPool.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := connectionPool.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("spec: empty", testCase{
+				connectionPool: ``,
+				expected: `
+               violations:
+               - field: sources
+                 message: must have at least one element
+               - field: destinations
+                 message: must have at least one element
+               - field: conf
+                 message: has to be defined`}),
+			Entry("conf: empty", testCase{
+				connectionPool: `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                conf: {}`,
+				expected: `
+               violations:
+               - field: conf
+                 message: at least one setting has to be defined`}),
+			Entry("conf.*: invalid", testCase{
+				connectionPool: `
+                sources:
+                - match:
+                   kuma.io/service: frontend
+                destinations:
+                - match:
+                   kuma.io/service: backend
+                conf:
+                  maxRequestsPerConnection: 0
+                  idleTimeout: 0s
+                  http2:
+                    keepaliveTimeout: 0s
+                  tcpKeepalive: {}`,
+				expected: `
+               violations:
+               - field: conf.maxRequestsPerConnection
+                 message: has to be greater than 0 when defined
+               - field: conf.idleTimeout
+                 message: has to be greater than 0 when defined
+               - field: conf.http2.keepaliveInterval
+                 message: has to be defined
+               - field: conf.http2.keepaliveTimeout
+                 message: has to be greater than 0
+               - field: conf.tcpKeepalive
+                 message: at least one setting in section has to be defined`}),
+		)
+	})
+})
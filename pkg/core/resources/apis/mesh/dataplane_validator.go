@@ -93,6 +93,48 @@ func validateNetworking(networking *mesh_proto.Dataplane_Networking) validators.
 		result := validateOutbound(outbound)
 		err.AddErrorAt(path.Field("outbound").Index(i), result)
 	}
+	err.Add(validatePortRanges(path, networking))
+	return err
+}
+
+func validatePortRanges(path validators.PathBuilder, networking *mesh_proto.Dataplane_Networking) validators.ValidationError {
+	var err validators.ValidationError
+	if len(networking.GetInboundPortRanges()) == 0 && len(networking.GetOutboundPortRanges()) == 0 {
+		return err
+	}
+	transparentProxying := networking.GetTransparentProxying()
+	if transparentProxying.GetRedirectPortInbound() == 0 || transparentProxying.GetRedirectPortOutbound() == 0 {
+		err.AddViolationAt(path.Field("transparentProxying"), "has to be enabled when port ranges are defined")
+	}
+	for i, portRange := range networking.GetInboundPortRanges() {
+		field := path.Field("inboundPortRanges").Index(i)
+		if portRange.Address != "" && net.ParseIP(portRange.Address) == nil {
+			err.AddViolationAt(field.Field("address"), "address has to be valid IP address")
+		}
+		err.Add(validatePortRange(field.Field("ports"), portRange.GetPorts()))
+	}
+	for i, portRange := range networking.GetOutboundPortRanges() {
+		field := path.Field("outboundPortRanges").Index(i)
+		err.Add(validatePortRange(field.Field("ports"), portRange.GetPorts()))
+		if _, exist := portRange.Tags[mesh_proto.ServiceTag]; !exist {
+			err.AddViolationAt(field.Field("tags").Key(mesh_proto.ServiceTag), `tag has to exist`)
+		}
+		err.AddErrorAt(field, validateTags(portRange.Tags))
+	}
+	return err
+}
+
+func validatePortRange(path validators.PathBuilder, portRange *mesh_proto.Dataplane_Networking_PortRange) validators.ValidationError {
+	var err validators.ValidationError
+	if portRange == nil {
+		err.AddViolationAt(path, "must be defined")
+		return err
+	}
+	err.Add(ValidatePort(path.Field("from"), portRange.GetFrom()))
+	err.Add(ValidatePort(path.Field("to"), portRange.GetTo()))
+	if portRange.GetFrom() > portRange.GetTo() {
+		err.AddViolationAt(path.Field("to"), "must be greater than or equal to from")
+	}
 	return err
 }
 
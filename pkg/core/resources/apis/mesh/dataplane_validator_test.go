@@ -258,6 +258,30 @@ var _ = Describe("Dataplane", func() {
                   tags:
                     kuma.io/service: redis`,
 		),
+		Entry("dataplane with port ranges", `
+            type: Dataplane
+            name: dp-1
+            mesh: default
+            networking:
+              address: 192.168.0.1
+              inbound:
+                - port: 21
+                  tags:
+                    kuma.io/service: ftp
+              inboundPortRanges:
+                - ports:
+                    from: 30000
+                    to: 30100
+              outboundPortRanges:
+                - ports:
+                    from: 10000
+                    to: 20000
+                  tags:
+                    kuma.io/service: media
+              transparentProxying:
+                redirectPortInbound: 15006
+                redirectPortOutbound: 15001`,
+		),
 	)
 
 	type testCase struct {
@@ -1135,6 +1159,46 @@ var _ = Describe("Dataplane", func() {
                 - field: networking.inbound[0].serviceProbe.unhealthyThreshold
                   message: must have a positive value`,
 		}),
+		Entry("dataplane with invalid port ranges", testCase{
+			dataplane: `
+            type: Dataplane
+            name: dp-1
+            mesh: default
+            networking:
+              address: 192.168.0.1
+              inbound:
+                - port: 21
+                  tags:
+                    kuma.io/service: ftp
+              inboundPortRanges:
+                - address: invalid
+                  ports:
+                    from: 30100
+                    to: 30000
+                - {}
+              outboundPortRanges:
+                - ports:
+                    from: 0
+                    to: 70000
+                  tags:
+                    version: "1"`,
+			expected: `
+                violations:
+                - field: networking.transparentProxying
+                  message: has to be enabled when port ranges are defined
+                - field: networking.inboundPortRanges[0].address
+                  message: address has to be valid IP address
+                - field: networking.inboundPortRanges[0].ports.to
+                  message: must be greater than or equal to from
+                - field: networking.inboundPortRanges[1].ports
+                  message: must be defined
+                - field: networking.outboundPortRanges[0].ports.from
+                  message: port must be in the range [1, 65535]
+                - field: networking.outboundPortRanges[0].ports.to
+                  message: port must be in the range [1, 65535]
+                - field: networking.outboundPortRanges[0].tags["kuma.io/service"]
+                  message: tag has to exist`,
+		}),
 	)
 
 })
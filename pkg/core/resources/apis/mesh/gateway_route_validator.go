@@ -1,12 +1,37 @@
 package mesh
 
 import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
 	"strings"
+	"time"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
 )
 
+// maxDirectResponseBodySize is the default limit Envoy applies to the
+// body of direct responses in a route configuration.
+const maxDirectResponseBodySize = 4096
+
+// minCacheTtl is the shortest time a response can be cached for, since
+// the max-age directive of the Cache-Control header is in seconds.
+const minCacheTtl = time.Second
+
+// minStickyTtl is the shortest time a client stays on the backend of a
+// sticky rule, since the Max-Age attribute of cookies is in seconds.
+const minStickyTtl = time.Second
+
+// minCorsMaxAge is the shortest time preflight responses can be cached
+// for, since the Access-Control-Max-Age header is in seconds.
+const minCorsMaxAge = time.Second
+
+// httpTokenRegex matches the tokens of RFC 7230, like cookie names,
+// methods and header names.
+var httpTokenRegex = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
 // Validate checks GatewayRouteResource semantic constraints.
 func (g *GatewayRouteResource) Validate() error {
 	var err validators.ValidationError
@@ -50,22 +75,63 @@ func validateGatewayRouteTLS(
 	path validators.PathBuilder,
 	conf *mesh_proto.GatewayRoute_TlsRoute,
 ) validators.ValidationError {
-	if conf != nil {
-		return validators.MakeUnimplementedFieldErr(path)
+	if conf == nil {
+		return validators.OK()
 	}
 
-	return validators.OK()
+	var err validators.ValidationError
+
+	for i, h := range conf.GetHostnames() {
+		err.Add(ValidateHostname(path.Field("hostnames").Index(i), h))
+	}
+
+	if len(conf.GetRules()) < 1 {
+		err.Add(validators.MakeRequiredFieldErr(path.Field("rules")))
+		return err
+	}
+
+	for i, rule := range conf.GetRules() {
+		path := path.Field("rules").Index(i)
+
+		if len(rule.GetBackends()) < 1 {
+			err.AddViolationAt(path.Field("backends"), "cannot be empty")
+		}
+
+		for j, b := range rule.GetBackends() {
+			err.Add(validateGatewayRouteBackend(path.Field("backends").Index(j), b))
+		}
+	}
+
+	return err
 }
 
 func validateGatewayRouteTCP(
 	path validators.PathBuilder,
 	conf *mesh_proto.GatewayRoute_TcpRoute,
 ) validators.ValidationError {
-	if conf != nil {
-		return validators.MakeUnimplementedFieldErr(path)
+	if conf == nil {
+		return validators.OK()
 	}
 
-	return validators.OK()
+	if len(conf.GetRules()) < 1 {
+		return validators.MakeRequiredFieldErr(path.Field("rules"))
+	}
+
+	var err validators.ValidationError
+
+	for i, rule := range conf.GetRules() {
+		path := path.Field("rules").Index(i)
+
+		if len(rule.GetBackends()) < 1 {
+			err.AddViolationAt(path.Field("backends"), "cannot be empty")
+		}
+
+		for j, b := range rule.GetBackends() {
+			err.Add(validateGatewayRouteBackend(path.Field("backends").Index(j), b))
+		}
+	}
+
+	return err
 }
 
 func validateGatewayRouteUDP(
@@ -116,11 +182,71 @@ func validateGatewayRouteHTTPRule(
 		err.Add(validateGatewayRouteHTTPMatch(path.Field("matches").Index(i), m))
 	}
 
+	var hasRewrite bool
+	var hasDirectResponse bool
+	var hasCache bool
+	var hasCors bool
+	var hasBuffer bool
+	var hasFaultInjection bool
 	for i, f := range conf.GetFilters() {
 		if f.GetRedirect() != nil {
 			hasRedirect = true
 		}
 
+		if f.GetDirectResponse() != nil {
+			if hasDirectResponse {
+				err.AddViolationAt(path.Field("filters").Index(i), "cannot have more than one direct response filter")
+			}
+			hasDirectResponse = true
+		}
+
+		if f.GetCache() != nil {
+			if hasCache {
+				err.AddViolationAt(path.Field("filters").Index(i), "cannot have more than one cache filter")
+			}
+			hasCache = true
+		}
+
+		if f.GetCors() != nil {
+			if hasCors {
+				err.AddViolationAt(path.Field("filters").Index(i), "cannot have more than one CORS filter")
+			}
+			hasCors = true
+		}
+
+		if f.GetBuffer() != nil {
+			if hasBuffer {
+				err.AddViolationAt(path.Field("filters").Index(i), "cannot have more than one buffer filter")
+			}
+			hasBuffer = true
+		}
+
+		if f.GetFaultInjection() != nil {
+			if hasFaultInjection {
+				err.AddViolationAt(path.Field("filters").Index(i), "cannot have more than one fault injection filter")
+			}
+			hasFaultInjection = true
+		}
+
+		if r := f.GetRewrite(); r != nil {
+			if hasRewrite {
+				err.AddViolationAt(path.Field("filters").Index(i), "cannot have more than one rewrite filter")
+			}
+			hasRewrite = true
+
+			// The matched prefix can only be replaced if there is one.
+			if r.GetReplacePrefixMatch() != "" {
+				for _, m := range conf.GetMatches() {
+					if m.GetPath().GetMatch() != mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX ||
+						m.GetPath().GetValue() == "" {
+						err.AddViolationAt(path.Field("filters").Index(i).Field("rewrite").Field("replace_prefix_match"),
+							"can only be used when all the matches are prefix path matches")
+						break
+					}
+				}
+			}
+		}
+
 		err.Add(validateGatewayRouteHTTPFilter(path.Field("filters").Index(i), f))
 	}
 
@@ -132,16 +258,47 @@ func validateGatewayRouteHTTPRule(
 		return err
 	}
 
+	// A direct response can still have its response headers modified,
+	// but there is no request to modify or mirror.
+	if hasDirectResponse {
+		for i, f := range conf.GetFilters() {
+			if f.GetDirectResponse() == nil && f.GetResponseHeader() == nil {
+				err.AddViolationAt(path.Field("filters").Index(i), "direct responses can only be used with response header filters")
+			}
+		}
+	}
+
 	switch len(conf.GetBackends()) {
 	case 0:
-		// Redirection doesn't forward, so there must not be any backend.
-		if !hasRedirect {
+		// Redirection and direct responses don't forward, so there
+		// must not be any backend.
+		if !hasRedirect && !hasDirectResponse {
 			err.AddViolationAt(path.Field("backends"), "cannot be empty")
 		}
 	default:
 		if hasRedirect {
 			err.AddViolationAt(path.Field("backends"), "must be empty when using redirect filters")
 		}
+		if hasDirectResponse {
+			err.AddViolationAt(path.Field("backends"), "must be empty when using direct response filters")
+		}
+	}
+
+	// Upgraded connections are tunneled to the backends.
+	if conf.GetUpgrades() != nil && len(conf.GetBackends()) == 0 {
+		err.AddViolationAt(path.Field("upgrades"), "can only be used when forwarding to backends")
+	}
+
+	if waf := conf.GetWaf(); waf != nil {
+		err.Add(validateGatewayRouteHTTPWaf(path.Field("waf"), waf))
+	}
+
+	if sticky := conf.GetSticky(); sticky != nil {
+		err.Add(validateGatewayRouteHTTPSticky(path.Field("sticky"), sticky))
+	}
+
+	if jwt := conf.GetJwt(); jwt != nil {
+		err.Add(validateGatewayRouteHTTPJwt(path.Field("jwt"), jwt))
 	}
 
 	for i, b := range conf.GetBackends() {
@@ -151,6 +308,57 @@ func validateGatewayRouteHTTPRule(
 	return err
 }
 
+func validateGatewayRouteHTTPWaf(
+	path validators.PathBuilder,
+	conf *mesh_proto.GatewayRoute_HttpRoute_Rule_Waf,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	if conf.GetDisabled() && len(conf.GetExcludedRules()) > 0 {
+		err.AddViolationAt(path.Field("excluded_rules"), "must be empty when the WAF is disabled")
+	}
+
+	err.Add(validateWafRuleIDs(path.Field("excluded_rules"), conf.GetExcludedRules()))
+
+	return err
+}
+
+func validateGatewayRouteHTTPSticky(
+	path validators.PathBuilder,
+	conf *mesh_proto.GatewayRoute_HttpRoute_Rule_Sticky,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	if c := conf.GetCookie(); c != "" && !httpTokenRegex.MatchString(c) {
+		err.AddViolationAt(path.Field("cookie"), "must be a valid cookie name")
+	}
+
+	if ttl := conf.GetTtl(); ttl != nil && ttl.AsDuration() < minStickyTtl {
+		err.AddViolationAt(path.Field("ttl"), "must be at least 1s")
+	}
+
+	return err
+}
+
+func validateGatewayRouteHTTPJwt(
+	path validators.PathBuilder,
+	conf *mesh_proto.GatewayRoute_HttpRoute_Rule_Jwt,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	if conf.GetDisabled() && len(conf.GetProviders()) > 0 {
+		err.AddViolationAt(path.Field("providers"), "must be empty when the JWT authentication is disabled")
+	}
+
+	for i, p := range conf.GetProviders() {
+		if !jwtProviderNameRegex.MatchString(p) {
+			err.AddViolationAt(path.Field("providers").Index(i), "must be a valid provider name")
+		}
+	}
+
+	return err
+}
+
 func validateGatewayRouteHTTPMatch(
 	path validators.PathBuilder,
 	conf *mesh_proto.GatewayRoute_HttpRoute_Match,
@@ -160,7 +368,9 @@ func validateGatewayRouteHTTPMatch(
 	if conf.GetPath() == nil &&
 		conf.GetMethod() == mesh_proto.GatewayRoute_HttpRoute_Match_NONE &&
 		len(conf.GetHeaders()) < 1 &&
-		len(conf.GetQueryParameters()) < 1 {
+		len(conf.GetQueryParameters()) < 1 &&
+		conf.GetClient() == nil &&
+		conf.GetGrpc() == nil {
 		err.AddViolationAt(path, "cannot be empty")
 	}
 
@@ -175,16 +385,15 @@ func validateGatewayRouteHTTPMatch(
 				err.AddViolationAt(path.Field("value"), "must be an absolute path")
 			}
 		}
+
+		if p.GetPrefixMatch() != mesh_proto.GatewayRoute_HttpRoute_Match_Path_LISTENER &&
+			p.GetMatch() != mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX {
+			err.AddViolationAt(path.Field("path").Field("prefix_match"), "can only be set on PREFIX matches")
+		}
 	}
 
 	for i, h := range conf.GetHeaders() {
-		path := path.Field("headers").Index(i)
-		if h.GetName() == "" {
-			err.AddViolationAt(path.Field("name"), "cannot be empty")
-		}
-		if h.GetValue() == "" {
-			err.AddViolationAt(path.Field("value"), "cannot be empty")
-		}
+		err.Add(validateGatewayRouteHeaderMatch(path.Field("headers").Index(i), h))
 	}
 
 	for i, q := range conf.GetQueryParameters() {
@@ -192,53 +401,108 @@ func validateGatewayRouteHTTPMatch(
 		if q.GetName() == "" {
 			err.AddViolationAt(path.Field("name"), "cannot be empty")
 		}
-		if q.GetValue() == "" {
-			err.AddViolationAt(path.Field("value"), "cannot be empty")
+		switch q.GetMatch() {
+		case mesh_proto.GatewayRoute_HttpRoute_Match_Query_PRESENT,
+			mesh_proto.GatewayRoute_HttpRoute_Match_Query_ABSENT:
+			if q.GetValue() != "" {
+				err.AddViolationAt(path.Field("value"), "must be empty for PRESENT and ABSENT matches")
+			}
+		default:
+			if q.GetValue() == "" {
+				err.AddViolationAt(path.Field("value"), "cannot be empty")
+			}
+		}
+	}
+
+	if c := conf.GetClient(); c != nil {
+		path := path.Field("client")
+		if len(c.GetSourceCidrs()) < 1 && len(c.GetCertificateSans()) < 1 {
+			err.AddViolationAt(path, "cannot be empty")
+		}
+
+		for i, cidr := range c.GetSourceCidrs() {
+			if _, _, e := net.ParseCIDR(cidr); e != nil {
+				err.AddViolationAt(path.Field("source_cidrs").Index(i), "must be a valid CIDR")
+			}
+		}
+
+		for i, san := range c.GetCertificateSans() {
+			if san == "" {
+				err.AddViolationAt(path.Field("certificate_sans").Index(i), "cannot be empty")
+			}
+		}
+	}
+
+	if g := conf.GetGrpc(); g != nil {
+		if conf.GetPath() != nil && g.GetService() != "" {
+			err.AddViolationAt(path.Field("path"), "cannot be used with a gRPC service match")
+		}
+
+		switch conf.GetMethod() {
+		case mesh_proto.GatewayRoute_HttpRoute_Match_NONE,
+			mesh_proto.GatewayRoute_HttpRoute_Match_POST:
+		default:
+			err.AddViolationAt(path.Field("method"), "must be POST for gRPC matches")
+		}
+
+		path := path.Field("grpc")
+		if strings.Contains(g.GetService(), "/") {
+			err.AddViolationAt(path.Field("service"), "cannot contain '/'")
+		}
+		if strings.Contains(g.GetMethod(), "/") {
+			err.AddViolationAt(path.Field("method"), "cannot contain '/'")
+		}
+		if g.GetMethod() != "" && g.GetService() == "" {
+			err.AddViolationAt(path.Field("service"), "cannot be empty when the method is given")
+		}
+
+		for i, m := range g.GetMetadata() {
+			err.Add(validateGatewayRouteHeaderMatch(path.Field("metadata").Index(i), m))
 		}
 	}
 
 	return err
 }
 
-func validateGatewayRouteHTTPFilter(
+func validateGatewayRouteHeaderMatch(
 	path validators.PathBuilder,
-	conf *mesh_proto.GatewayRoute_HttpRoute_Filter,
+	conf *mesh_proto.GatewayRoute_HttpRoute_Match_Header,
 ) validators.ValidationError {
 	var err validators.ValidationError
 
-	if r := conf.GetRequestHeader(); r != nil {
-		header := func(
-			path validators.PathBuilder,
-			headers []*mesh_proto.GatewayRoute_HttpRoute_Filter_RequestHeader_Header,
-		) validators.ValidationError {
-			var err validators.ValidationError
-			for i, h := range headers {
-				if h.GetName() == "" {
-					err.AddViolationAt(path.Index(i).Field("name"), "cannot be empty")
-				}
-				if h.GetValue() == "" {
-					err.AddViolationAt(path.Index(i).Field("value"), "cannot be empty")
-				}
-			}
+	if conf.GetName() == "" {
+		err.AddViolationAt(path.Field("name"), "cannot be empty")
+	}
 
-			return err
+	switch conf.GetMatch() {
+	case mesh_proto.GatewayRoute_HttpRoute_Match_Header_PRESENT,
+		mesh_proto.GatewayRoute_HttpRoute_Match_Header_ABSENT:
+		if conf.GetValue() != "" {
+			err.AddViolationAt(path.Field("value"), "must be empty for PRESENT and ABSENT matches")
 		}
-
-		path := path.Field("request_header")
-		if len(r.GetSet()) < 1 &&
-			len(r.GetAdd()) < 1 &&
-			len(r.GetRemove()) < 1 {
-			err.AddViolationAt(path, "cannot be empty")
+	default:
+		if conf.GetValue() == "" {
+			err.AddViolationAt(path.Field("value"), "cannot be empty")
 		}
+	}
 
-		err.Add(header(path.Field("set"), r.GetSet()))
-		err.Add(header(path.Field("add"), r.GetAdd()))
+	return err
+}
 
-		for i, h := range r.GetRemove() {
-			if h == "" {
-				err.AddViolationAt(path.Field("remove").Index(i), "cannot be empty")
-			}
-		}
+func validateGatewayRouteHTTPFilter(
+	path validators.PathBuilder,
+	conf *mesh_proto.GatewayRoute_HttpRoute_Filter,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	if r := conf.GetRequestHeader(); r != nil {
+		err.Add(validateGatewayRouteHeaderFilter(
+			path.Field("request_header"), r.GetSet(), r.GetAdd(), r.GetRemove()))
+	}
+
+	if r := conf.GetResponseHeader(); r != nil {
+		err.Add(validateGatewayRouteHeaderFilter(
+			path.Field("response_header"), r.GetSet(), r.GetAdd(), r.GetRemove()))
 	}
 
 	if r := conf.GetRedirect(); r != nil {
@@ -261,6 +525,71 @@ func validateGatewayRouteHTTPFilter(
 		}
 	}
 
+	if r := conf.GetDirectResponse(); r != nil {
+		path := path.Field("direct_response")
+
+		if r.GetStatusCode() < 200 || r.GetStatusCode() > 599 {
+			err.AddViolationAt(path.Field("status_code"), "must be in the range [200, 599]")
+		}
+
+		if len(r.GetBody()) > maxDirectResponseBodySize {
+			err.AddViolationAt(path.Field("body"), fmt.Sprintf("must be at most %d bytes", maxDirectResponseBodySize))
+		}
+	}
+
+	if r := conf.GetCache(); r != nil {
+		if r.GetTtl().AsDuration() < minCacheTtl {
+			err.AddViolationAt(path.Field("cache").Field("ttl"), "must be at least 1s")
+		}
+	}
+
+	if c := conf.GetCors(); c != nil {
+		err.Add(validateGatewayRouteHTTPCors(path.Field("cors"), c))
+	}
+
+	if b := conf.GetBuffer(); b != nil && b.GetMaxRequestBytes() == 0 {
+		err.AddViolationAt(path.Field("buffer").Field("max_request_bytes"), "must be greater than 0")
+	}
+
+	if f := conf.GetFaultInjection(); f != nil {
+		err.Add(validateGatewayRouteHTTPFaultInjection(path.Field("fault_injection"), f))
+	}
+
+	if r := conf.GetRewrite(); r != nil {
+		path := path.Field("rewrite")
+
+		if r.GetReplaceFullPath() == "" &&
+			r.GetReplacePrefixMatch() == "" &&
+			r.GetReplaceRegex() == nil &&
+			r.GetHostname() == "" {
+			err.AddViolationAt(path, "cannot be empty")
+		}
+
+		if r.GetReplaceFullPath() != "" && r.GetReplacePrefixMatch() != "" {
+			err.AddViolationAt(path, "replace_full_path and replace_prefix_match cannot be used together")
+		}
+
+		if r.GetReplaceRegex() != nil && (r.GetReplaceFullPath() != "" || r.GetReplacePrefixMatch() != "") {
+			err.AddViolationAt(path, "replace_regex cannot be used together with replace_full_path or replace_prefix_match")
+		}
+
+		if re := r.GetReplaceRegex(); re != nil {
+			if re.GetPattern() == "" {
+				err.AddViolationAt(path.Field("replace_regex").Field("pattern"), "cannot be empty")
+			} else if _, e := regexp.Compile(re.GetPattern()); e != nil {
+				err.AddViolationAt(path.Field("replace_regex").Field("pattern"), "must be a valid regular expression")
+			}
+		}
+
+		if p := r.GetReplaceFullPath(); p != "" && !strings.HasPrefix(p, "/") {
+			err.AddViolationAt(path.Field("replace_full_path"), "must be an absolute path")
+		}
+
+		if p := r.GetReplacePrefixMatch(); p != "" && !strings.HasPrefix(p, "/") {
+			err.AddViolationAt(path.Field("replace_prefix_match"), "must be an absolute path")
+		}
+	}
+
 	if m := conf.GetMirror(); m != nil {
 		path := path.Field("mirror")
 
@@ -274,6 +603,100 @@ func validateGatewayRouteHTTPFilter(
 	return err
 }
 
+func validateGatewayRouteHTTPCors(
+	path validators.PathBuilder,
+	conf *mesh_proto.GatewayRoute_HttpRoute_Filter_Cors,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	if len(conf.GetAllowOrigins()) == 0 {
+		err.AddViolationAt(path.Field("allow_origins"), "cannot be empty")
+	}
+
+	anyOrigin := false
+	for i, o := range conf.GetAllowOrigins() {
+		if o == "*" {
+			anyOrigin = true
+			continue
+		}
+
+		u, e := url.Parse(o)
+		if e != nil || u.Scheme == "" || u.Host == "" || u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+			err.AddViolationAt(path.Field("allow_origins").Index(i), `must be "*" or an origin, like "https://example.com"`)
+		}
+	}
+
+	for i, m := range conf.GetAllowMethods() {
+		if !httpTokenRegex.MatchString(m) {
+			err.AddViolationAt(path.Field("allow_methods").Index(i), "must be a valid method")
+		}
+	}
+
+	for i, h := range conf.GetAllowHeaders() {
+		if !httpTokenRegex.MatchString(h) {
+			err.AddViolationAt(path.Field("allow_headers").Index(i), "must be a valid header name")
+		}
+	}
+
+	for i, h := range conf.GetExposeHeaders() {
+		if !httpTokenRegex.MatchString(h) {
+			err.AddViolationAt(path.Field("expose_headers").Index(i), "must be a valid header name")
+		}
+	}
+
+	if maxAge := conf.GetMaxAge(); maxAge != nil && maxAge.AsDuration() < minCorsMaxAge {
+		err.AddViolationAt(path.Field("max_age"), "must be at least 1s")
+	}
+
+	// Browsers don't send credentials to servers that allow any origin.
+	if conf.GetAllowCredentials() && anyOrigin {
+		err.AddViolationAt(path.Field("allow_credentials"), `cannot be used when "*" is allowed`)
+	}
+
+	return err
+}
+
+func validateGatewayRouteHeaderFilter(
+	path validators.PathBuilder,
+	set []*mesh_proto.GatewayRoute_HttpRoute_Filter_RequestHeader_Header,
+	add []*mesh_proto.GatewayRoute_HttpRoute_Filter_RequestHeader_Header,
+	remove []string,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	header := func(
+		path validators.PathBuilder,
+		headers []*mesh_proto.GatewayRoute_HttpRoute_Filter_RequestHeader_Header,
+	) validators.ValidationError {
+		var err validators.ValidationError
+		for i, h := range headers {
+			if h.GetName() == "" {
+				err.AddViolationAt(path.Index(i).Field("name"), "cannot be empty")
+			}
+			if h.GetValue() == "" {
+				err.AddViolationAt(path.Index(i).Field("value"), "cannot be empty")
+			}
+		}
+
+		return err
+	}
+
+	if len(set) < 1 && len(add) < 1 && len(remove) < 1 {
+		err.AddViolationAt(path, "cannot be empty")
+	}
+
+	err.Add(header(path.Field("set"), set))
+	err.Add(header(path.Field("add"), add))
+
+	for i, h := range remove {
+		if h == "" {
+			err.AddViolationAt(path.Field("remove").Index(i), "cannot be empty")
+		}
+	}
+
+	return err
+}
+
 func validateGatewayRouteBackend(
 	path validators.PathBuilder,
 	conf *mesh_proto.GatewayRoute_Backend,
@@ -290,3 +713,30 @@ func validateGatewayRouteBackend(
 			RequireService:       true,
 		})
 }
+
+// validateGatewayRouteHTTPFaultInjection validates the faults with the
+// same rules as the FaultInjection policy.
+func validateGatewayRouteHTTPFaultInjection(
+	path validators.PathBuilder,
+	conf *mesh_proto.FaultInjection_Conf,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	if conf.GetDelay() == nil && conf.GetAbort() == nil && conf.GetResponseBandwidth() == nil {
+		err.AddViolationAt(path, "must have at least one of the faults configured")
+	}
+
+	if d := conf.GetDelay(); d != nil {
+		err.Add(validateDelay(path.Field("delay"), d))
+	}
+
+	if a := conf.GetAbort(); a != nil {
+		err.Add(validateAbort(path.Field("abort"), a))
+	}
+
+	if b := conf.GetResponseBandwidth(); b != nil {
+		err.Add(validateResponseBandwidth(path.Field("response_bandwidth"), b))
+	}
+
+	return err
+}
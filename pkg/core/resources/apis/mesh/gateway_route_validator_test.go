@@ -48,10 +48,34 @@ conf:
         - match: REGEX
           name: x-count
           value: "[0-9]+"
+        - match: PREFIX
+          name: x-version
+          value: "v2."
+        - match: PRESENT
+          name: x-debug
+        - match: ABSENT
+          name: x-canary
         query_parameters:
         - match: EXACT
           name: customer
           value: kong
+        - match: PRESENT
+          name: page
+        - match: ABSENT
+          name: debug
+      - client:
+          source_cidrs:
+          - 10.0.0.0/8
+          - fd00::/8
+          certificate_sans:
+          - spiffe://example.com/partner
+      - grpc:
+          service: helloworld.Greeter
+          method: SayHello
+          metadata:
+          - match: EXACT
+            name: x-tenant
+            value: kong
       filters:
       - request_header:
           set:
@@ -66,6 +90,15 @@ conf:
             value: added
           remove:
           - x-deleted
+      - response_header:
+          set:
+          - name: cache-control
+            value: no-store
+          add:
+          - name: x-served-by
+            value: gateway
+          remove:
+          - server
       - mirror:
          percentage: 1.12
          backend:
@@ -113,6 +146,327 @@ conf:
          hostname: foo.example.com
          port: 80
          status_code: 307
+`),
+		Entry("HTTP direct response", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /robots.txt
+      filters:
+      - direct_response:
+          status_code: 200
+          body: |
+            User-agent: *
+            Disallow: /
+      - response_header:
+          set:
+          - name: content-type
+            value: text/plain
+`),
+		Entry("HTTP cache", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /catalog
+      filters:
+      - cache:
+          ttl: 5m
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		Entry("HTTP upgrades", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /ws
+      upgrades:
+        websocket: true
+        connect: true
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		Entry("HTTP WAF exclusions", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /upload
+      waf:
+        excluded_rules:
+        - 920420
+        - 921110
+      backends:
+      - destination:
+          kuma.io/service: target-1
+    - matches:
+      - path:
+          match: EXACT
+          value: /healthz
+      waf:
+        disabled: true
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		Entry("HTTP JWT requirements", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /admin
+      jwt:
+        providers:
+        - corp-sso
+      backends:
+      - destination:
+          kuma.io/service: target-1
+    - matches:
+      - path:
+          match: EXACT
+          value: /healthz
+      jwt:
+        disabled: true
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		Entry("HTTP sticky backends", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      sticky:
+        cookie: canary
+        ttl: 1h
+      backends:
+      - weight: 90
+        destination:
+          kuma.io/service: target-1
+          version: v1
+      - weight: 10
+        destination:
+          kuma.io/service: target-1
+          version: v2
+`),
+		Entry("HTTP CORS filter", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+      filters:
+      - cors:
+          allow_origins:
+          - https://app.example.com
+          - http://localhost:3000
+          allow_methods:
+          - GET
+          - POST
+          allow_headers:
+          - Content-Type
+          - Authorization
+          expose_headers:
+          - X-Request-Id
+          max_age: 10m
+          allow_credentials: true
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		Entry("HTTP buffer filter", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /upload
+      filters:
+      - buffer:
+          max_request_bytes: 1048576
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		Entry("HTTP fault injection filter", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      filters:
+      - fault_injection:
+          delay:
+            percentage: 50
+            value: 2s
+          abort:
+            percentage: 10
+            httpStatus: 503
+          responseBandwidth:
+            percentage: 100
+            limit: 100kbps
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		Entry("HTTP rewrite", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+      - path:
+          match: PREFIX
+          value: /v1/api
+      filters:
+      - rewrite:
+          replace_prefix_match: /
+          hostname: backend.example.com
+      backends:
+      - destination:
+          kuma.io/service: target-1
+    - matches:
+      - path:
+          match: EXACT
+          value: /health
+      filters:
+      - rewrite:
+          replace_full_path: /ready
+      backends:
+      - destination:
+          kuma.io/service: target-1
+    - matches:
+      - path:
+          match: REGEX
+          value: ^/service/[^/]+/.*$
+      filters:
+      - rewrite:
+          replace_regex:
+            pattern: ^/service/([^/]+)(/.*)$
+            substitution: \2/instance/\1
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		Entry("TCP route", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tcp:
+    rules:
+    - backends:
+      - weight: 80
+        destination:
+          kuma.io/service: target-1
+      - weight: 20
+        destination:
+          kuma.io/service: target-2
+`),
+		Entry("TLS route", `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tls:
+    hostnames:
+    - db.example.com
+    - "*.internal.example.com"
+    rules:
+    - backends:
+      - weight: 1
+        destination:
+          kuma.io/service: target-1
 `),
 	)
 
@@ -128,8 +482,8 @@ selectors:
 - match:
     kuma.io/service: gateway
 `),
-		ErrorCase("missing HTTP rules", validators.Violation{
-			Field:   "conf.http.rules",
+		ErrorCase("missing TCP rules", validators.Violation{
+			Field:   "conf.tcp.rules",
 			Message: "cannot be empty",
 		}, `
 type: GatewayRoute
@@ -139,11 +493,11 @@ selectors:
 - match:
     kuma.io/service: gateway
 conf:
-  http:
+  tcp:
     rules: []
 `),
-		ErrorCase("missing HTTP rule matches", validators.Violation{
-			Field:   "conf.http.rules[0].matches",
+		ErrorCase("missing TCP rule backends", validators.Violation{
+			Field:   "conf.tcp.rules[0].backends",
 			Message: "cannot be empty",
 		}, `
 type: GatewayRoute
@@ -153,16 +507,921 @@ selectors:
 - match:
     kuma.io/service: gateway
 conf:
-  http:
+  tcp:
+    rules:
+    - matches:
+      - {}
+`),
+		ErrorCase("TCP backends with no service", validators.Violation{
+			Field:   "conf.tcp.rules[0].backends[0]",
+			Message: `mandatory tag "kuma.io/service" is missing`,
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tcp:
     rules:
     - backends:
       - weight: 5
         destination:
-          kuma.io/service: target-2
+          phoney: target-2
+`),
+		ErrorCase("missing TLS rules", validators.Violation{
+			Field:   "conf.tls.rules",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tls:
+    hostnames:
+    - db.example.com
+    rules: []
+`),
+		ErrorCase("missing TLS rule backends", validators.Violation{
+			Field:   "conf.tls.rules[0].backends",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tls:
+    rules:
+    - matches:
+      - {}
+`),
+		ErrorCase("invalid TLS hostname", validators.Violation{
+			Field:   "conf.tls.hostnames[0]",
+			Message: "invalid hostname",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  tls:
+    hostnames:
+    - "db.example$.com"
+    rules:
+    - backends:
+      - weight: 1
+        destination:
+          kuma.io/service: target-1
+`),
+		ErrorCase("missing HTTP rules", validators.Violation{
+			Field:   "conf.http.rules",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules: []
+`),
+		ErrorCase("missing HTTP rule matches", validators.Violation{
+			Field:   "conf.http.rules[0].matches",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("missing HTTP rule backends", validators.Violation{
+			Field:   "conf.http.rules[0].backends",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /
+`),
+		ErrorCase("backends with no service", validators.Violation{
+			Field:   "conf.http.rules[0].backends[0]",
+			Message: `mandatory tag "kuma.io/service" is missing`,
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /
+      backends:
+      - weight: 5
+        destination:
+          phoney: target-2
+`),
+		ErrorCase("rule with missing match", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0]",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - {}
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("match with empty path", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].value",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: REGEX
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("match with relative path", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].value",
+			Message: "must be an absolute path",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: some/path
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("match with a prefix match on an exact path", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].path.prefix_match",
+			Message: "can only be set on PREFIX matches",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /api
+          prefix_match: BYTES
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("match with empty header name", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].headers[0].name",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - headers:
+        - value: value
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("match with empty header value", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].headers[0].value",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - headers:
+        - name: value
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("match with empty query name", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].query_parameters[0].name",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - query_parameters:
+        - value: value
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("match with empty query value", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].query_parameters[0].value",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - query_parameters:
+        - name: value
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("present header match with a value", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].headers[0].value",
+			Message: "must be empty for PRESENT and ABSENT matches",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - headers:
+        - match: PRESENT
+          name: x-debug
+          value: "true"
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("absent query match with a value", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].query_parameters[0].value",
+			Message: "must be empty for PRESENT and ABSENT matches",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - query_parameters:
+        - match: ABSENT
+          name: debug
+          value: "true"
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("match with empty client", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].client",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - client: {}
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("match with invalid client source CIDR", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].client.source_cidrs[1]",
+			Message: "must be a valid CIDR",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - client:
+          source_cidrs:
+          - 192.168.0.0/16
+          - 10.0.0.1
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("match with empty client certificate SAN", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].client.certificate_sans[0]",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - client:
+          certificate_sans:
+          - ""
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("gRPC match with a path", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].path",
+			Message: "cannot be used with a gRPC service match",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+        grpc:
+          service: helloworld.Greeter
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("gRPC match with a GET method", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].method",
+			Message: "must be POST for gRPC matches",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - method: GET
+        grpc: {}
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("gRPC method match without a service", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].grpc.service",
+			Message: "cannot be empty when the method is given",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - grpc:
+          method: SayHello
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("gRPC match with empty metadata name", validators.Violation{
+			Field:   "conf.http.rules[0].matches[0].grpc.metadata[0].name",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - grpc:
+          service: helloworld.Greeter
+          metadata:
+          - value: kong
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("empty request header filter", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].request_header",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - request_header: {}
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("empty response header filter", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].response_header",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - response_header: {}
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("empty response header filter add", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].response_header.add[0].name",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - response_header:
+          add:
+          - value: foo
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("empty request header filter set", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].request_header.set[0].value",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - request_header:
+          set:
+          - name: x-foo
+          add:
+          - name: x-foo
+            value: foo
+          remove:
+          - foo
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("empty request header filter add", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].request_header.add[0].name",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - request_header:
+          set:
+          - name: x-foo
+            value: foo
+          add:
+          - value: foo
+          remove:
+          - foo
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("empty request header filter remove", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].request_header.remove[0]",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - request_header:
+          set:
+          - name: x-foo
+            value: foo
+          add:
+          - name: x-foo
+            value: foo
+          remove:
+          - ""
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("empty mirror filter backend", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].mirror.backend",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - mirror:
+          percentage: 0.0
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("mirror filter invalid percentage", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].mirror.percentage",
+			Message: "has to be in [0.0 - 100.0] range",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - mirror:
+          percentage: -1.0
+          backend:
+            destination:
+              kuma.io/service: target-2
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("direct response filter with invalid status", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].direct_response.status_code",
+			Message: "must be in the range [200, 599]",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - direct_response:
+          status_code: 101
+`),
+		ErrorCase("direct response filter with backends", validators.Violation{
+			Field:   "conf.http.rules[0].backends",
+			Message: "must be empty when using direct response filters",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - direct_response:
+          status_code: 503
+          body: down for maintenance
+      backends:
+      - destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("direct response filter with request filters", validators.Violation{
+			Field:   "conf.http.rules[0].filters[1]",
+			Message: "direct responses can only be used with response header filters",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - direct_response:
+          status_code: 503
+      - request_header:
+          remove:
+          - x-foo
+`),
+		ErrorCase("multiple direct response filters", validators.Violation{
+			Field:   "conf.http.rules[0].filters[1]",
+			Message: "cannot have more than one direct response filter",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - direct_response:
+          status_code: 503
+      - direct_response:
+          status_code: 200
+`),
+		ErrorCase("cache filter without a ttl", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].cache.ttl",
+			Message: "must be at least 1s",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - cache: {}
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		ErrorCase("multiple cache filters", validators.Violation{
+			Field:   "conf.http.rules[0].filters[1]",
+			Message: "cannot have more than one cache filter",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - cache:
+          ttl: 1m
+      - cache:
+          ttl: 1h
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		ErrorCase("JWT providers of a disabled JWT authentication", validators.Violation{
+			Field:   "conf.http.rules[0].jwt.providers",
+			Message: "must be empty when the JWT authentication is disabled",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      jwt:
+        disabled: true
+        providers:
+        - corp-sso
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		ErrorCase("invalid JWT provider name", validators.Violation{
+			Field:   "conf.http.rules[0].jwt.providers[0]",
+			Message: "must be a valid provider name",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      jwt:
+        providers:
+        - ""
+      backends:
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("missing HTTP rule backends", validators.Violation{
-			Field:   "conf.http.rules[0].backends",
-			Message: "cannot be empty",
+		ErrorCase("WAF exclusions of a disabled WAF", validators.Violation{
+			Field:   "conf.http.rules[0].waf.excluded_rules",
+			Message: "must be empty when the WAF is disabled",
 		}, `
 type: GatewayRoute
 name: route
@@ -175,12 +1434,18 @@ conf:
     rules:
     - matches:
       - path:
-          match: EXACT
           value: /
+      waf:
+        disabled: true
+        excluded_rules:
+        - 920420
+      backends:
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("backends with no service", validators.Violation{
-			Field:   "conf.http.rules[0].backends[0]",
-			Message: `mandatory tag "kuma.io/service" is missing`,
+		ErrorCase("WAF exclusion of rule 0", validators.Violation{
+			Field:   "conf.http.rules[0].waf.excluded_rules[1]",
+			Message: "must be a rule ID",
 		}, `
 type: GatewayRoute
 name: route
@@ -193,16 +1458,18 @@ conf:
     rules:
     - matches:
       - path:
-          match: EXACT
           value: /
+      waf:
+        excluded_rules:
+        - 920420
+        - 0
       backends:
-      - weight: 5
-        destination:
-          phoney: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("rule with missing match", validators.Violation{
-			Field:   "conf.http.rules[0].matches[0]",
-			Message: "cannot be empty",
+		ErrorCase("CORS filter with an invalid origin", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].cors.allow_origins[0]",
+			Message: `must be "*" or an origin, like "https://example.com"`,
 		}, `
 type: GatewayRoute
 name: route
@@ -214,15 +1481,19 @@ conf:
   http:
     rules:
     - matches:
-      - {}
+      - path:
+          value: /
+      filters:
+      - cors:
+          allow_origins:
+          - https://app.example.com/index.html
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("match with empty path", validators.Violation{
-			Field:   "conf.http.rules[0].matches[0].value",
-			Message: "cannot be empty",
+		ErrorCase("CORS filter with an invalid method", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].cors.allow_methods[0]",
+			Message: "must be a valid method",
 		}, `
 type: GatewayRoute
 name: route
@@ -235,15 +1506,20 @@ conf:
     rules:
     - matches:
       - path:
-          match: REGEX
+          value: /
+      filters:
+      - cors:
+          allow_origins:
+          - https://app.example.com
+          allow_methods:
+          - "GET, POST"
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("match with relative path", validators.Violation{
-			Field:   "conf.http.rules[0].matches[0].value",
-			Message: "must be an absolute path",
+		ErrorCase("CORS filter with a short max age", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].cors.max_age",
+			Message: "must be at least 1s",
 		}, `
 type: GatewayRoute
 name: route
@@ -256,15 +1532,19 @@ conf:
     rules:
     - matches:
       - path:
-          value: some/path
+          value: /
+      filters:
+      - cors:
+          allow_origins:
+          - https://app.example.com
+          max_age: 0s
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("match with empty header name", validators.Violation{
-			Field:   "conf.http.rules[0].matches[0].headers[0].name",
-			Message: "cannot be empty",
+		ErrorCase("CORS filter with credentials for any origin", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].cors.allow_credentials",
+			Message: `cannot be used when "*" is allowed`,
 		}, `
 type: GatewayRoute
 name: route
@@ -276,16 +1556,20 @@ conf:
   http:
     rules:
     - matches:
-      - headers:
-        - value: value
+      - path:
+          value: /
+      filters:
+      - cors:
+          allow_origins:
+          - "*"
+          allow_credentials: true
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("match with empty header value", validators.Violation{
-			Field:   "conf.http.rules[0].matches[0].headers[0].value",
-			Message: "cannot be empty",
+		ErrorCase("buffer filter without a limit", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].buffer.max_request_bytes",
+			Message: "must be greater than 0",
 		}, `
 type: GatewayRoute
 name: route
@@ -297,16 +1581,17 @@ conf:
   http:
     rules:
     - matches:
-      - headers:
-        - name: value
+      - path:
+          value: /
+      filters:
+      - buffer: {}
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("match with empty query name", validators.Violation{
-			Field:   "conf.http.rules[0].matches[0].query_parameters[0].name",
-			Message: "cannot be empty",
+		ErrorCase("multiple buffer filters", validators.Violation{
+			Field:   "conf.http.rules[0].filters[1]",
+			Message: "cannot have more than one buffer filter",
 		}, `
 type: GatewayRoute
 name: route
@@ -318,16 +1603,20 @@ conf:
   http:
     rules:
     - matches:
-      - query_parameters:
-        - value: value
+      - path:
+          value: /
+      filters:
+      - buffer:
+          max_request_bytes: 1024
+      - buffer:
+          max_request_bytes: 2048
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("match with empty query value", validators.Violation{
-			Field:   "conf.http.rules[0].matches[0].query_parameters[0].value",
-			Message: "cannot be empty",
+		ErrorCase("fault injection filter without faults", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].fault_injection",
+			Message: "must have at least one of the faults configured",
 		}, `
 type: GatewayRoute
 name: route
@@ -339,16 +1628,17 @@ conf:
   http:
     rules:
     - matches:
-      - query_parameters:
-        - name: value
+      - path:
+          value: /
+      filters:
+      - fault_injection: {}
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("empty request header filter", validators.Violation{
-			Field:   "conf.http.rules[0].filters[0].request_header",
-			Message: "cannot be empty",
+		ErrorCase("fault injection filter with an invalid abort", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].fault_injection.abort.percentage",
+			Message: "has to be in [0.0 - 100.0] range",
 		}, `
 type: GatewayRoute
 name: route
@@ -363,15 +1653,17 @@ conf:
       - path:
           value: /
       filters:
-      - request_header: {}
+      - fault_injection:
+          abort:
+            percentage: 120
+            httpStatus: 503
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("empty request header filter set", validators.Violation{
-			Field:   "conf.http.rules[0].filters[0].request_header.set[0].value",
-			Message: "cannot be empty",
+		ErrorCase("multiple fault injection filters", validators.Violation{
+			Field:   "conf.http.rules[0].filters[1]",
+			Message: "cannot have more than one fault injection filter",
 		}, `
 type: GatewayRoute
 name: route
@@ -386,21 +1678,20 @@ conf:
       - path:
           value: /
       filters:
-      - request_header:
-          set:
-          - name: x-foo
-          add:
-          - name: x-foo
-            value: foo
-          remove:
-          - foo
+      - fault_injection:
+          delay:
+            percentage: 50
+            value: 2s
+      - fault_injection:
+          abort:
+            percentage: 10
+            httpStatus: 503
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("empty request header filter add", validators.Violation{
-			Field:   "conf.http.rules[0].filters[0].request_header.add[0].name",
+		ErrorCase("CORS filter without origins", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].cors.allow_origins",
 			Message: "cannot be empty",
 		}, `
 type: GatewayRoute
@@ -416,22 +1707,16 @@ conf:
       - path:
           value: /
       filters:
-      - request_header:
-          set:
-          - name: x-foo
-            value: foo
-          add:
-          - value: foo
-          remove:
-          - foo
+      - cors:
+          allow_methods:
+          - GET
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("empty request header filter remove", validators.Violation{
-			Field:   "conf.http.rules[0].filters[0].request_header.remove[0]",
-			Message: "cannot be empty",
+		ErrorCase("sticky cookie with an invalid name", validators.Violation{
+			Field:   "conf.http.rules[0].sticky.cookie",
+			Message: "must be a valid cookie name",
 		}, `
 type: GatewayRoute
 name: route
@@ -445,24 +1730,15 @@ conf:
     - matches:
       - path:
           value: /
-      filters:
-      - request_header:
-          set:
-          - name: x-foo
-            value: foo
-          add:
-          - name: x-foo
-            value: foo
-          remove:
-          - ""
+      sticky:
+        cookie: "canary;"
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("empty mirror filter backend", validators.Violation{
-			Field:   "conf.http.rules[0].filters[0].mirror.backend",
-			Message: "cannot be empty",
+		ErrorCase("sticky cookie with a short TTL", validators.Violation{
+			Field:   "conf.http.rules[0].sticky.ttl",
+			Message: "must be at least 1s",
 		}, `
 type: GatewayRoute
 name: route
@@ -476,17 +1752,15 @@ conf:
     - matches:
       - path:
           value: /
-      filters:
-      - mirror:
-          percentage: 0.0
+      sticky:
+        ttl: 500ms
       backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - destination:
+          kuma.io/service: target-1
 `),
-		ErrorCase("mirror filter invalid percentage", validators.Violation{
-			Field:   "conf.http.rules[0].filters[0].mirror.percentage",
-			Message: "has to be in [0.0 - 100.0] range",
+		ErrorCase("upgrades without backends", validators.Violation{
+			Field:   "conf.http.rules[0].upgrades",
+			Message: "can only be used when forwarding to backends",
 		}, `
 type: GatewayRoute
 name: route
@@ -501,15 +1775,10 @@ conf:
       - path:
           value: /
       filters:
-      - mirror:
-          percentage: -1.0
-          backend:
-            destination:
-              kuma.io/service: target-2
-      backends:
-      - weight: 5
-        destination:
-          kuma.io/service: target-2
+      - direct_response:
+          status_code: 200
+      upgrades:
+        websocket: true
 `),
 		ErrorCase("redirect filter with empty scheme", validators.Violation{
 			Field:   "conf.http.rules[0].filters[0].redirect.scheme",
@@ -650,6 +1919,134 @@ conf:
         - weight: 5
           destination:
             kuma.io/service: target-2
+`),
+		ErrorCase("rewrite of the prefix without a prefix match", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].rewrite.replace_prefix_match",
+			Message: "can only be used when all the matches are prefix path matches",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+      - path:
+          match: EXACT
+          value: /health
+      filters:
+      - rewrite:
+          replace_prefix_match: /
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		ErrorCase("rewrite of the full path and the prefix", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].rewrite",
+			Message: "replace_full_path and replace_prefix_match cannot be used together",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+      filters:
+      - rewrite:
+          replace_full_path: /ready
+          replace_prefix_match: /
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		ErrorCase("rewrite of the full path and a regex", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].rewrite",
+			Message: "replace_regex cannot be used together with replace_full_path or replace_prefix_match",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+      filters:
+      - rewrite:
+          replace_full_path: /ready
+          replace_regex:
+            pattern: ^/api
+            substitution: /v2
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		ErrorCase("invalid rewrite regex", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].rewrite.replace_regex.pattern",
+			Message: "must be a valid regular expression",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+      filters:
+      - rewrite:
+          replace_regex:
+            pattern: ^/api/(
+            substitution: /v2
+      backends:
+      - destination:
+          kuma.io/service: target-1
+`),
+		ErrorCase("empty rewrite", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].rewrite",
+			Message: "cannot be empty",
+		}, `
+type: GatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+      filters:
+      - rewrite: {}
+      backends:
+      - destination:
+          kuma.io/service: target-1
 `),
 	)
 })
@@ -1,8 +1,18 @@
 package mesh
 
 import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
+	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
 )
 
 // Validate checks GatewayResource semantic constraints.
@@ -54,29 +64,79 @@ func validateGatewayConf(path validators.PathBuilder, conf *mesh_proto.Gateway_C
 		return err
 	}
 
+	if explicit := conf.GetExplicitRoutes(); explicit != nil {
+		switch explicit.GetStatus() {
+		case 0, 403, 404:
+		default:
+			err.AddViolationAt(path.Field("explicit_routes").Field("status"), "must be 403 or 404")
+		}
+	}
+
 	path = path.Field("listeners")
 
 	if len(conf.GetListeners()) == 0 {
 		err.AddViolationAt(path, "cannot be empty")
 	}
 
+	err.Add(validateGatewayListenerHostnameConflicts(path, conf.GetListeners()))
+
+	// Listeners on the same port share a filter chain, so they
+	// either all accept connections from other meshes or none does.
+	crossMeshPorts := map[uint32]bool{}
+	for _, l := range conf.GetListeners() {
+		if l.GetCrossMesh() {
+			crossMeshPorts[l.GetPort()] = true
+		}
+	}
+
 	for i, l := range conf.GetListeners() {
 		// Hostname is optional, since it might be given on the route(s).
 		if l.GetHostname() != "" {
 			err.Add(ValidateHostname(path.Index(i).Field("hostname"), l.GetHostname()))
 		}
 
+		if len(l.GetHostnames()) > 0 && l.GetHostname() != "" {
+			err.AddViolationAt(path.Index(i).Field("hostnames"), "must be empty when hostname is given")
+		}
+
+		for j, h := range l.GetHostnames() {
+			err.Add(ValidateHostname(path.Index(i).Field("hostnames").Index(j), h))
+		}
+
 		// Port is required, and must not be 0.
 		err.Add(ValidatePort(path.Index(i).Field("port"), l.GetPort()))
 
-		// For now, only support HTTP and HTTPS.
+		// For now, only support TCP, TLS, HTTP, HTTPS and GRPC.
 		switch l.GetProtocol() {
 		case mesh_proto.Gateway_Listener_NONE:
 			err.AddViolationAt(path.Index(i).Field("protocol"), "cannot be empty")
-		case mesh_proto.Gateway_Listener_UDP,
-			mesh_proto.Gateway_Listener_TCP,
-			mesh_proto.Gateway_Listener_TLS:
+		case mesh_proto.Gateway_Listener_UDP:
 			err.AddViolationAt(path.Index(i).Field("protocol"), "protocol type is not supported")
+		case mesh_proto.Gateway_Listener_TLS:
+			// TLS listeners route by the client SNI name, so
+			// the TLS stream has to reach the backends intact.
+			if l.GetTls() == nil {
+				err.AddViolationAt(path.Index(i).Field("tls"), "cannot be empty for TLS listeners")
+			} else if l.GetTls().GetMode() == mesh_proto.Gateway_TLS_TERMINATE {
+				err.AddViolationAt(path.Index(i).Field("tls").Field("mode"), "must be PASSTHROUGH for TLS listeners")
+			}
+		case mesh_proto.Gateway_Listener_TCP:
+			// TCP connections don't carry a hostname, so
+			// there is nothing to match it against.
+			if l.GetHostname() != "" && l.GetHostname() != "*" {
+				err.AddViolationAt(path.Index(i).Field("hostname"), "must be empty for TCP listeners")
+			}
+			if len(l.GetHostnames()) > 0 {
+				err.AddViolationAt(path.Index(i).Field("hostnames"), "must be empty for TCP listeners")
+			}
+			if l.GetTls() != nil {
+				err.AddViolationAt(path.Index(i).Field("tls"), "must be empty for TCP listeners")
+			}
+		case mesh_proto.Gateway_Listener_GRPC:
+			// gRPC over TLS is served by HTTPS listeners.
+			if l.GetTls() != nil {
+				err.AddViolationAt(path.Index(i).Field("tls"), "must be empty for GRPC listeners")
+			}
 		}
 
 		if tls := l.GetTls(); tls != nil {
@@ -91,12 +151,77 @@ func validateGatewayConf(path validators.PathBuilder, conf *mesh_proto.Gateway_C
 						path.Index(i).Field("tls").Field("certificate"),
 						"must be empty in TLS passthrough mode")
 				}
+				if len(tls.GetCertificates()) > 0 {
+					err.AddViolationAt(
+						path.Index(i).Field("tls").Field("certificates"),
+						"must be empty in TLS passthrough mode")
+				}
+				if tls.GetOptions().GetClientCertificateAuthority() != nil {
+					err.AddViolationAt(
+						path.Index(i).Field("tls").Field("options").Field("client_certificate_authority"),
+						"must be empty in TLS passthrough mode")
+				}
 			case mesh_proto.Gateway_TLS_TERMINATE:
-				if tls.GetCertificate() == nil {
+				if tls.GetCertificate() == nil && len(tls.GetCertificates()) == 0 {
 					err.AddViolationAt(
 						path.Index(i).Field("tls").Field("certificate"),
 						"cannot be empty in TLS termination mode")
 				}
+				err.Add(validateGatewayListenerCertificates(path.Index(i).Field("tls").Field("certificates"), l, tls.GetCertificates()))
+			}
+		}
+
+		if l.GetCrossMesh() {
+			if l.GetProtocol() != mesh_proto.Gateway_Listener_HTTP {
+				err.AddViolationAt(path.Index(i).Field("cross_mesh"), "can only be used with HTTP listeners")
+			}
+		} else if crossMeshPorts[l.GetPort()] {
+			err.AddViolationAt(path.Index(i).Field("cross_mesh"), "must be set on all the listeners of a cross-mesh port")
+		}
+
+		if sec := l.GetSecurity(); sec != nil {
+			err.Add(validateGatewayListenerSecurity(path.Index(i).Field("security"), l.GetProtocol(), sec))
+		}
+
+		if c := l.GetCache(); c != nil {
+			err.Add(validateGatewayListenerCache(path.Index(i).Field("cache"), l.GetProtocol(), c))
+		}
+
+		if rl := l.GetRateLimit(); rl != nil {
+			err.Add(validateGatewayListenerRateLimit(path.Index(i).Field("rate_limit"), l.GetProtocol(), rl))
+		}
+
+		if al := l.GetAccessLog(); al != nil {
+			err.Add(validateGatewayListenerAccessLog(path.Index(i).Field("access_log"), l.GetProtocol(), al))
+		}
+
+		if waf := l.GetWaf(); waf != nil {
+			err.Add(validateGatewayListenerWaf(path.Index(i).Field("waf"), l.GetProtocol(), waf))
+		}
+
+		if jwt := l.GetJwt(); jwt != nil {
+			err.Add(validateGatewayListenerJwt(path.Index(i).Field("jwt"), l.GetProtocol(), jwt))
+		}
+
+		if oidc := l.GetOidc(); oidc != nil {
+			err.Add(validateGatewayListenerOidc(path.Index(i).Field("oidc"), l.GetProtocol(), oidc))
+		}
+
+		if limits := l.GetLimits(); limits != nil {
+			err.Add(validateGatewayListenerLimits(path.Index(i).Field("limits"), l.GetProtocol(), limits))
+		}
+
+		if paths := l.GetPaths(); paths != nil {
+			err.Add(validateGatewayListenerPaths(path.Index(i).Field("paths"), l.GetProtocol(), paths))
+		}
+
+		if l.GetXffNumTrustedHops() > 0 {
+			switch l.GetProtocol() {
+			case mesh_proto.Gateway_Listener_HTTP,
+				mesh_proto.Gateway_Listener_HTTPS,
+				mesh_proto.Gateway_Listener_GRPC:
+			default:
+				err.AddViolationAt(path.Index(i).Field("xff_num_trusted_hops"), "must be empty for TCP and TLS listeners")
 			}
 		}
 
@@ -118,3 +243,562 @@ func validateGatewayConf(path validators.PathBuilder, conf *mesh_proto.Gateway_C
 
 	return err
 }
+
+func validateGatewayListenerSecurity(
+	path validators.PathBuilder,
+	protocol mesh_proto.Gateway_Listener_Protocol,
+	conf *mesh_proto.Gateway_Listener_Security,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	switch protocol {
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS:
+	default:
+		err.AddViolationAt(path, "must be empty for TCP, TLS and GRPC listeners")
+		return err
+	}
+
+	for i, origin := range conf.GetCsrf().GetAdditionalOrigins() {
+		if origin == "" {
+			err.AddViolationAt(path.Field("csrf").Field("additional_origins").Index(i), "cannot be empty")
+		}
+	}
+
+	if hsts := conf.GetHeaders().GetStrictTransportSecurity(); hsts != "" {
+		if !strings.Contains(strings.ToLower(hsts), "max-age=") {
+			err.AddViolationAt(path.Field("headers").Field("strict_transport_security"), "must have a max-age directive")
+		}
+	}
+
+	switch conf.GetHeaders().GetFrameOptions() {
+	case "", "DENY", "SAMEORIGIN":
+	default:
+		err.AddViolationAt(path.Field("headers").Field("frame_options"), `must be "DENY" or "SAMEORIGIN"`)
+	}
+
+	return err
+}
+
+func validateGatewayListenerCache(
+	path validators.PathBuilder,
+	protocol mesh_proto.Gateway_Listener_Protocol,
+	conf *mesh_proto.Gateway_Listener_Cache,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	switch protocol {
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS:
+	default:
+		err.AddViolationAt(path, "must be empty for TCP, TLS and GRPC listeners")
+		return err
+	}
+
+	for i, name := range conf.GetKey().GetQueryParameters() {
+		if name == "" {
+			err.AddViolationAt(path.Field("key").Field("query_parameters").Index(i), "cannot be empty")
+		}
+	}
+
+	for i, name := range conf.GetKey().GetHeaders() {
+		if name == "" {
+			err.AddViolationAt(path.Field("key").Field("headers").Index(i), "cannot be empty")
+		}
+	}
+
+	return err
+}
+
+func validateGatewayListenerAccessLog(
+	path validators.PathBuilder,
+	protocol mesh_proto.Gateway_Listener_Protocol,
+	conf *mesh_proto.Gateway_Listener_AccessLog,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	switch protocol {
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS,
+		mesh_proto.Gateway_Listener_GRPC:
+	default:
+		err.AddViolationAt(path, "must be empty for TCP and TLS listeners")
+		return err
+	}
+
+	if e := accesslog.ValidateFormat(conf.GetFormat()); e != nil {
+		err.AddViolationAt(path.Field("format"), e.Error())
+	}
+
+	return err
+}
+
+func validateGatewayListenerWaf(
+	path validators.PathBuilder,
+	protocol mesh_proto.Gateway_Listener_Protocol,
+	conf *mesh_proto.Gateway_Listener_Waf,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	switch protocol {
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS,
+		mesh_proto.Gateway_Listener_GRPC:
+	default:
+		err.AddViolationAt(path, "must be empty for TCP and TLS listeners")
+		return err
+	}
+
+	if conf.GetRuleSet() == "" {
+		err.AddViolationAt(path.Field("rule_set"), "cannot be empty")
+	}
+
+	return err
+}
+
+// jwtProviderNameRegex matches the names of JWT providers, which are
+// listed in the JWT requirements of GatewayRoutes.
+var jwtProviderNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+func validateGatewayListenerJwt(
+	path validators.PathBuilder,
+	protocol mesh_proto.Gateway_Listener_Protocol,
+	conf *mesh_proto.Gateway_Listener_Jwt,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	switch protocol {
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS,
+		mesh_proto.Gateway_Listener_GRPC:
+	default:
+		err.AddViolationAt(path, "must be empty for TCP and TLS listeners")
+		return err
+	}
+
+	if len(conf.GetProviders()) == 0 {
+		err.AddViolationAt(path.Field("providers"), "must have at least one element")
+		return err
+	}
+
+	names := map[string]bool{}
+
+	for i, p := range conf.GetProviders() {
+		pathAt := path.Field("providers").Index(i)
+
+		switch {
+		case p.GetName() == "":
+			err.AddViolationAt(pathAt.Field("name"), "cannot be empty")
+		case !jwtProviderNameRegex.MatchString(p.GetName()):
+			err.AddViolationAt(pathAt.Field("name"), "must only contain alphanumeric characters, '.', '_' and '-'")
+		case names[p.GetName()]:
+			err.AddViolationAt(pathAt.Field("name"), "must be unique")
+		}
+		names[p.GetName()] = true
+
+		for j, a := range p.GetAudiences() {
+			if a == "" {
+				err.AddViolationAt(pathAt.Field("audiences").Index(j), "cannot be empty")
+			}
+		}
+
+		switch {
+		case p.GetRemoteJwks() != nil && p.GetLocalJwks() != nil:
+			err.AddViolationAt(pathAt, "cannot have both remote_jwks and local_jwks")
+		case p.GetRemoteJwks() != nil:
+			err.Add(validateJwtRemoteJwks(pathAt.Field("remote_jwks"), p.GetRemoteJwks()))
+		case p.GetLocalJwks() != nil:
+			if p.GetLocalJwks().GetType() == nil {
+				err.AddViolationAt(pathAt.Field("local_jwks"), "cannot be empty")
+			}
+		default:
+			err.AddViolationAt(pathAt, "must have either remote_jwks or local_jwks")
+		}
+
+		for j, c := range p.GetClaimToHeaders() {
+			if c.GetClaim() == "" {
+				err.AddViolationAt(pathAt.Field("claim_to_headers").Index(j).Field("claim"), "cannot be empty")
+			}
+			if !httpTokenRegex.MatchString(c.GetHeader()) || strings.HasPrefix(c.GetHeader(), ":") {
+				err.AddViolationAt(pathAt.Field("claim_to_headers").Index(j).Field("header"), "must be a valid HTTP header name")
+			}
+		}
+	}
+
+	return err
+}
+
+func validateJwtRemoteJwks(
+	path validators.PathBuilder,
+	conf *mesh_proto.Gateway_Listener_Jwt_Provider_RemoteJwks,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	u, e := url.Parse(conf.GetUri())
+	switch {
+	case conf.GetUri() == "":
+		err.AddViolationAt(path.Field("uri"), "cannot be empty")
+	case e != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https"):
+		err.AddViolationAt(path.Field("uri"), "must be an absolute HTTP or HTTPS URI")
+	}
+
+	if d := conf.GetCacheDuration(); d != nil && d.AsDuration() <= 0 {
+		err.AddViolationAt(path.Field("cache_duration"), "must be positive")
+	}
+
+	if ca := conf.GetCertificateAuthority(); ca != nil {
+		if e == nil && u.Scheme != "" && u.Scheme != "https" {
+			err.AddViolationAt(path.Field("certificate_authority"), "can only be used with HTTPS URIs")
+		}
+		if ca.GetType() == nil {
+			err.AddViolationAt(path.Field("certificate_authority"), "cannot be empty")
+		}
+	}
+
+	return err
+}
+
+func validateGatewayListenerOidc(
+	path validators.PathBuilder,
+	protocol mesh_proto.Gateway_Listener_Protocol,
+	conf *mesh_proto.Gateway_Listener_Oidc,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	switch protocol {
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS,
+		mesh_proto.Gateway_Listener_GRPC:
+	default:
+		err.AddViolationAt(path, "must be empty for TCP and TLS listeners")
+		return err
+	}
+
+	provider := conf.GetProvider()
+	if provider == nil {
+		err.AddViolationAt(path.Field("provider"), "cannot be empty")
+	} else {
+		err.Add(validateOidcEndpoint(path.Field("provider").Field("authorization_endpoint"), provider.GetAuthorizationEndpoint()))
+		err.Add(validateOidcEndpoint(path.Field("provider").Field("token_endpoint"), provider.GetTokenEndpoint()))
+
+		if ca := provider.GetCertificateAuthority(); ca != nil {
+			if u, e := url.Parse(provider.GetTokenEndpoint()); e == nil && u.Scheme != "" && u.Scheme != "https" {
+				err.AddViolationAt(path.Field("provider").Field("certificate_authority"), "can only be used with a HTTPS token endpoint")
+			}
+			if ca.GetType() == nil {
+				err.AddViolationAt(path.Field("provider").Field("certificate_authority"), "cannot be empty")
+			}
+		}
+	}
+
+	if conf.GetClientId() == "" {
+		err.AddViolationAt(path.Field("client_id"), "cannot be empty")
+	}
+	if conf.GetClientSecret().GetType() == nil {
+		err.AddViolationAt(path.Field("client_secret"), "cannot be empty")
+	}
+	if conf.GetHmacSecret().GetType() == nil {
+		err.AddViolationAt(path.Field("hmac_secret"), "cannot be empty")
+	}
+
+	for _, p := range []struct {
+		field string
+		value string
+	}{
+		{"redirect_path", conf.GetRedirectPath()},
+		{"signout_path", conf.GetSignoutPath()},
+	} {
+		if p.value != "" && !strings.HasPrefix(p.value, "/") {
+			err.AddViolationAt(path.Field(p.field), "must be an absolute path")
+		}
+	}
+	if conf.GetRedirectPath() != "" && conf.GetRedirectPath() == conf.GetSignoutPath() {
+		err.AddViolationAt(path.Field("signout_path"), "must be different from redirect_path")
+	}
+
+	for i, s := range conf.GetScopes() {
+		if s == "" || strings.ContainsAny(s, " \"") {
+			err.AddViolationAt(path.Field("scopes").Index(i), "must be a non-empty scope without spaces or quotes")
+		}
+	}
+
+	return err
+}
+
+func validateOidcEndpoint(path validators.PathBuilder, uri string) validators.ValidationError {
+	var err validators.ValidationError
+
+	u, e := url.Parse(uri)
+	switch {
+	case uri == "":
+		err.AddViolationAt(path, "cannot be empty")
+	case e != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https"):
+		err.AddViolationAt(path, "must be an absolute HTTP or HTTPS URI")
+	}
+
+	return err
+}
+
+// maxHTTP2ConcurrentStreams is the largest number of concurrent
+// streams that Envoy accepts on a HTTP/2 connection.
+const maxHTTP2ConcurrentStreams = 2147483647
+
+// validateGatewayListenerHostnameConflicts rejects the hostnames that
+// are matched by several listeners on the same port, since the gateway
+// couldn't tell which listener a request or a connection is for.
+// Wildcard domains only conflict with the same wildcard domain, more
+// specific hostnames take precedence over them.
+func validateGatewayListenerHostnameConflicts(
+	path validators.PathBuilder,
+	listeners []*mesh_proto.Gateway_Listener,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	type owner struct {
+		listener int
+		port     uint32
+	}
+
+	owners := map[string]owner{}
+
+	for i, l := range listeners {
+		hostnamePath := func(j int) validators.PathBuilder {
+			if l.GetHostname() != "" || len(l.GetHostnames()) == 0 {
+				return path.Index(i).Field("hostname")
+			}
+			return path.Index(i).Field("hostnames").Index(j)
+		}
+
+		for j, h := range l.MatchedHostnames() {
+			key := fmt.Sprintf("%d/%s", l.GetPort(), h)
+
+			if o, ok := owners[key]; ok {
+				if o.listener == i {
+					err.AddViolationAt(hostnamePath(j), fmt.Sprintf("%q is given more than once", h))
+				} else {
+					err.AddViolationAt(hostnamePath(j), fmt.Sprintf(
+						"%q conflicts with listener %d on port %d", h, o.listener, o.port))
+				}
+				continue
+			}
+
+			owners[key] = owner{listener: i, port: l.GetPort()}
+		}
+
+		// The hostnames of the certificates get their own filter
+		// chains, that match the SNI names of the port.
+		if l.GetTls().GetMode() != mesh_proto.Gateway_TLS_TERMINATE {
+			continue
+		}
+		for j, c := range l.GetTls().GetCertificates() {
+			for k, h := range c.GetHostnames() {
+				if h == "*" {
+					continue // Not a valid certificate hostname.
+				}
+				key := fmt.Sprintf("%d/%s", l.GetPort(), h)
+				certPath := path.Index(i).Field("tls").Field("certificates").Index(j).Field("hostnames").Index(k)
+
+				if o, ok := owners[key]; ok {
+					if o.listener == i {
+						err.AddViolationAt(certPath, fmt.Sprintf("%q is given more than once", h))
+					} else {
+						err.AddViolationAt(certPath, fmt.Sprintf(
+							"%q conflicts with listener %d on port %d", h, o.listener, o.port))
+					}
+					continue
+				}
+
+				owners[key] = owner{listener: i, port: l.GetPort()}
+			}
+		}
+	}
+
+	return err
+}
+
+// validateGatewayListenerCertificates validates the certificates that
+// are selected by SNI. Their hostnames must be served by the listener.
+// Conflicts with the hostnames of other listeners are validated by
+// validateGatewayListenerHostnameConflicts.
+func validateGatewayListenerCertificates(
+	path validators.PathBuilder,
+	l *mesh_proto.Gateway_Listener,
+	certificates []*mesh_proto.Gateway_TLS_Certificate,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	for i, c := range certificates {
+		if c.GetCertificate() == nil {
+			err.AddViolationAt(path.Index(i).Field("certificate"), "cannot be empty")
+		}
+
+		if len(c.GetHostnames()) == 0 {
+			err.AddViolationAt(path.Index(i).Field("hostnames"), "cannot be empty")
+		}
+
+		for j, h := range c.GetHostnames() {
+			if h == "*" {
+				err.AddViolationAt(path.Index(i).Field("hostnames").Index(j),
+					"must not be \"*\", the certificate of the listener is served for any other hostname")
+				continue
+			}
+
+			hostnameErr := ValidateHostname(path.Index(i).Field("hostnames").Index(j), h)
+			if !hostnameErr.HasViolations() && !listenerServesHostname(l, h) {
+				hostnameErr.AddViolationAt(path.Index(i).Field("hostnames").Index(j),
+					fmt.Sprintf("%q is not matched by the hostnames of the listener", h))
+			}
+			err.Add(hostnameErr)
+		}
+	}
+
+	return err
+}
+
+// listenerServesHostname returns whether one of the hostnames of the
+// listener matches the given hostname, that can itself be a wildcard.
+func listenerServesHostname(l *mesh_proto.Gateway_Listener, hostname string) bool {
+	for _, h := range l.MatchedHostnames() {
+		switch {
+		case h == "*", h == hostname:
+			return true
+		case strings.HasPrefix(h, "*.") && strings.HasSuffix(hostname, h[1:]):
+			return true
+		}
+	}
+	return false
+}
+
+func validateGatewayListenerPaths(
+	path validators.PathBuilder,
+	protocol mesh_proto.Gateway_Listener_Protocol,
+	conf *mesh_proto.Gateway_Listener_Paths,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	switch protocol {
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS,
+		mesh_proto.Gateway_Listener_GRPC:
+	default:
+		err.AddViolationAt(path, "must be empty for TCP and TLS listeners")
+		return err
+	}
+
+	// Normalization would remove the segments that are rejected.
+	if conf.GetRejectTraversal() && conf.GetNormalize().GetValue() {
+		err.AddViolationAt(path.Field("normalize"), "cannot be enabled when traversals are rejected")
+	}
+
+	return err
+}
+
+func validateGatewayListenerLimits(
+	path validators.PathBuilder,
+	protocol mesh_proto.Gateway_Listener_Protocol,
+	conf *mesh_proto.Gateway_Listener_Limits,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	if d := conf.GetIdleTimeout(); d != nil && d.AsDuration() <= 0 {
+		err.AddViolationAt(path.Field("idle_timeout"), "must be positive")
+	}
+
+	switch protocol {
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS,
+		mesh_proto.Gateway_Listener_GRPC:
+		if d := conf.GetRequestTimeout(); d != nil && d.AsDuration() <= 0 {
+			err.AddViolationAt(path.Field("request_timeout"), "must be positive")
+		}
+
+		if conf.GetMaxConcurrentStreams() > maxHTTP2ConcurrentStreams {
+			err.AddViolationAt(path.Field("max_concurrent_streams"),
+				fmt.Sprintf("must be at most %d", maxHTTP2ConcurrentStreams))
+		}
+	default:
+		if conf.GetRequestTimeout() != nil {
+			err.AddViolationAt(path.Field("request_timeout"), "must be empty for TCP and TLS listeners")
+		}
+
+		if conf.GetMaxConcurrentStreams() != 0 {
+			err.AddViolationAt(path.Field("max_concurrent_streams"), "must be empty for TCP and TLS listeners")
+		}
+	}
+
+	return err
+}
+
+// minRateLimitInterval is the shortest interval Envoy accepts for
+// refilling a token bucket.
+const minRateLimitInterval = 50 * time.Millisecond
+
+func validateGatewayListenerRateLimit(
+	path validators.PathBuilder,
+	protocol mesh_proto.Gateway_Listener_Protocol,
+	conf *mesh_proto.Gateway_Listener_RateLimit,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	switch protocol {
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS,
+		mesh_proto.Gateway_Listener_GRPC:
+	default:
+		err.AddViolationAt(path, "must be empty for TCP and TLS listeners")
+		return err
+	}
+
+	err.Add(validateRateLimitBucket(path, conf.GetRequests(), conf.GetInterval()))
+
+	key := conf.GetKey()
+	if key != nil {
+		switch {
+		case key.GetHeader() == "" && !key.GetClientAddress():
+			err.AddViolationAt(path.Field("key"), "must have a header or the client address")
+		case key.GetHeader() != "" && key.GetClientAddress():
+			err.AddViolationAt(path.Field("key"), "header and client_address cannot be used together")
+		}
+	}
+
+	if len(conf.GetLimits()) > 0 && key == nil {
+		err.AddViolationAt(path.Field("key"), "cannot be empty when limits are given")
+	}
+
+	values := map[string]bool{}
+	for i, limit := range conf.GetLimits() {
+		path := path.Field("limits").Index(i)
+
+		switch {
+		case limit.GetValue() == "":
+			err.AddViolationAt(path.Field("value"), "cannot be empty")
+		case key.GetClientAddress() && net.ParseIP(limit.GetValue()) == nil:
+			err.AddViolationAt(path.Field("value"), "must be a valid IP address")
+		case values[limit.GetValue()]:
+			err.AddViolationAt(path.Field("value"), "must be unique")
+		}
+		values[limit.GetValue()] = true
+
+		err.Add(validateRateLimitBucket(path, limit.GetRequests(), limit.GetInterval()))
+	}
+
+	return err
+}
+
+func validateRateLimitBucket(
+	path validators.PathBuilder,
+	requests uint32,
+	interval *durationpb.Duration,
+) validators.ValidationError {
+	var err validators.ValidationError
+
+	if requests == 0 {
+		err.AddViolationAt(path.Field("requests"), "must be greater than 0")
+	}
+
+	if interval.AsDuration() < minRateLimitInterval {
+		err.AddViolationAt(path.Field("interval"), "must be at least 50ms")
+	}
+
+	return err
+}
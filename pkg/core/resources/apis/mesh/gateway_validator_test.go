@@ -41,6 +41,340 @@ conf:
     tags:
       name: https`,
 		),
+		Entry("TCP listener", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 5432
+    protocol: TCP
+    tags:
+      name: postgres`,
+		),
+		Entry("TLS passthrough listener", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: "*.example.com"
+    port: 443
+    protocol: TLS
+    tls:
+      mode: PASSTHROUGH
+    tags:
+      name: tls`,
+		),
+		Entry("GRPC listener", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 9090
+    protocol: GRPC
+    tags:
+      name: grpc`,
+		),
+		Entry("HTTP listener with a rate limit", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 80
+    protocol: HTTP
+    tags:
+      name: http
+    rate_limit:
+      requests: 100
+      interval: 1s
+      key:
+        header: x-api-key
+      limits:
+      - value: premium
+        requests: 1000
+        interval: 1s`,
+		),
+		Entry("cross-mesh HTTP listener", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 80
+    protocol: HTTP
+    hostname: foo.example.com
+    cross_mesh: true
+    tags:
+      name: foo
+  - port: 80
+    protocol: HTTP
+    hostname: bar.example.com
+    cross_mesh: true
+    tags:
+      name: bar`,
+		),
+		Entry("HTTPS listener with security features", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 443
+    protocol: HTTPS
+    tags:
+      name: https
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: example-com
+    security:
+      csrf:
+        additional_origins:
+        - app.example.com
+      headers:
+        strict_transport_security: max-age=63072000; includeSubDomains; preload
+        frame_options: SAMEORIGIN`,
+		),
+		Entry("HTTP listener with a cache", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 80
+    protocol: HTTP
+    tags:
+      name: http
+    cache:
+      max_body_bytes: 65536
+      key:
+        exclude_host: true
+        query_parameters:
+        - page
+        headers:
+        - accept-language`,
+		),
+		Entry("HTTP listener with an access log", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 80
+    protocol: HTTP
+    tags:
+      name: http
+    access_log:
+      backend: file
+      format: '[%START_TIME%] %KUMA_GATEWAY_VIRTUAL_HOST% %KUMA_GATEWAY_ROUTE% "%REQ(:METHOD)% %REQ(:PATH)%" %RESPONSE_CODE%'`,
+		),
+		Entry("HTTPS listener with a WAF", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 443
+    protocol: HTTPS
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: foo
+    tags:
+      name: https
+    waf:
+      rule_set: owasp
+      detection_only: true`,
+		),
+		Entry("HTTP listener with JWT authentication", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 80
+    protocol: HTTP
+    tags:
+      name: http
+    jwt:
+      providers:
+      - name: auth0
+        issuer: https://example.auth0.com/
+        audiences: [api]
+        remote_jwks:
+          uri: https://example.auth0.com/.well-known/jwks.json
+          cache_duration: 10m
+        claim_to_headers:
+        - claim: sub
+          header: x-user
+      - name: local
+        local_jwks:
+          secret: jwks`,
+		),
+		Entry("HTTPS listener with OIDC authentication", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 443
+    protocol: HTTPS
+    tags:
+      name: https
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: cert
+    oidc:
+      provider:
+        authorization_endpoint: https://example.auth0.com/authorize
+        token_endpoint: https://example.auth0.com/oauth/token
+      client_id: gateway
+      client_secret:
+        secret: oidc-client
+      hmac_secret:
+        secret: oidc-hmac
+      redirect_path: /login/callback
+      scopes: [profile, email]`,
+		),
+		Entry("listeners with limits", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 80
+    protocol: HTTP
+    tags:
+      name: http
+    limits:
+      idle_timeout: 1m
+      request_timeout: 30s
+      max_concurrent_streams: 50
+      max_connections: 10000
+  - port: 99
+    protocol: TCP
+    tags:
+      name: tcp
+    limits:
+      idle_timeout: 10m
+      max_connections: 100`,
+		),
+		Entry("listeners with several hostnames", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 443
+    protocol: HTTPS
+    hostnames:
+    - example.com
+    - "*.example.com"
+    tags:
+      name: example
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: example-com
+  - port: 443
+    protocol: HTTPS
+    hostname: api.example.com
+    tags:
+      name: api
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: api-example-com`,
+		),
+		Entry("HTTP listener with path options", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 80
+    protocol: HTTP
+    tags:
+      name: http
+    paths:
+      prefix_match: BYTES
+      merge_slashes: false
+      reject_traversal: true`,
+		),
+		Entry("HTTPS listener with a certificate per hostname", `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - port: 443
+    protocol: HTTPS
+    tags:
+      name: example
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: default
+      certificates:
+      - hostnames:
+        - example.com
+        - "*.example.com"
+        certificate:
+          secret: example-com
+      - hostnames:
+        - api.example.org
+        certificate:
+          secret: api-example-org`,
+		),
 	)
 
 	DescribeErrorCases(
@@ -190,6 +524,55 @@ conf:
         secret: foo
 `),
 
+		ErrorCase("has an invalid explicit routes status",
+			validators.Violation{
+				Field:   "conf.explicit_routes.status",
+				Message: "must be 403 or 404",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+tags:
+  product: edge
+conf:
+  explicitRoutes:
+    status: 200
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+`),
+
+		ErrorCase("has a passthrough TLS client certificate authority",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls.options.client_certificate_authority",
+				Message: "must be empty in TLS passthrough mode",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+tags:
+  product: edge
+conf:
+  listeners:
+  - protocol: HTTPS
+    port: 99
+    tags:
+      name: https
+    tls:
+      mode: PASSTHROUGH
+      options:
+        clientCertificateAuthority:
+          secret: ca
+`),
+
 		ErrorCase("is missing a TLS termination secret",
 			validators.Violation{
 				Field:   "conf.listeners[0].tls.certificate",
@@ -256,5 +639,1091 @@ conf:
     tags:
       name: https
 `),
+
+		ErrorCase("has a hostname on a TCP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].hostname",
+				Message: "must be empty for TCP listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - hostname: db.example.com
+    protocol: TCP
+    port: 5432
+    tags:
+      name: postgres
+`),
+
+		ErrorCase("has TLS on a TCP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls",
+				Message: "must be empty for TCP listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TCP
+    port: 5432
+    tags:
+      name: postgres
+    tls:
+      mode: PASSTHROUGH
+`),
+
+		ErrorCase("has a TLS listener without TLS configuration",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls",
+				Message: "cannot be empty for TLS listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TLS
+    port: 443
+    tags:
+      name: tls
+`),
+
+		ErrorCase("terminates TLS on a TLS listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls.mode",
+				Message: "must be PASSTHROUGH for TLS listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TLS
+    port: 443
+    tags:
+      name: tls
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: example-com
+`),
+
+		ErrorCase("has a GRPC listener with TLS configuration",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls",
+				Message: "must be empty for GRPC listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: GRPC
+    port: 9090
+    tags:
+      name: grpc
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: example-com
+`),
+
+		ErrorCase("has a rate limit on a TCP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].rate_limit",
+				Message: "must be empty for TCP and TLS listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TCP
+    port: 5432
+    tags:
+      name: tcp
+    rate_limit:
+      requests: 10
+      interval: 1s
+`),
+
+		ErrorCase("has a rate limit with a short interval",
+			validators.Violation{
+				Field:   "conf.listeners[0].rate_limit.interval",
+				Message: "must be at least 50ms",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    rate_limit:
+      requests: 10
+      interval: 10ms
+`),
+
+		ErrorCase("has rate limits without a key",
+			validators.Violation{
+				Field:   "conf.listeners[0].rate_limit.key",
+				Message: "cannot be empty when limits are given",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    rate_limit:
+      requests: 10
+      interval: 1s
+      limits:
+      - value: premium
+        requests: 100
+        interval: 1s
+`),
+
+		ErrorCase("has a rate limit for an invalid client address",
+			validators.Violation{
+				Field:   "conf.listeners[0].rate_limit.limits[0].value",
+				Message: "must be a valid IP address",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    rate_limit:
+      requests: 10
+      interval: 1s
+      key:
+        client_address: true
+      limits:
+      - value: 10.0.0.0/8
+        requests: 100
+        interval: 1s
+`),
+
+		ErrorCase("has a cross-mesh HTTPS listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].cross_mesh",
+				Message: "can only be used with HTTP listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTPS
+    port: 443
+    cross_mesh: true
+    tags:
+      name: https
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: example-com
+`),
+
+		ErrorCase("mixes cross-mesh and mesh listeners on a port",
+			validators.Violation{
+				Field:   "conf.listeners[1].cross_mesh",
+				Message: "must be set on all the listeners of a cross-mesh port",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    hostname: foo.example.com
+    cross_mesh: true
+    tags:
+      name: foo
+  - protocol: HTTP
+    port: 80
+    hostname: bar.example.com
+    tags:
+      name: bar
+`),
+
+		ErrorCase("has security features on a TCP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].security",
+				Message: "must be empty for TCP, TLS and GRPC listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TCP
+    port: 5432
+    tags:
+      name: tcp
+    security:
+      csrf: {}
+`),
+
+		ErrorCase("has an invalid frame options header",
+			validators.Violation{
+				Field:   "conf.listeners[0].security.headers.frame_options",
+				Message: `must be "DENY" or "SAMEORIGIN"`,
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    security:
+      headers:
+        frame_options: ALLOW-FROM https://example.com
+`),
+
+		ErrorCase("has a strict transport security header without max-age",
+			validators.Violation{
+				Field:   "conf.listeners[0].security.headers.strict_transport_security",
+				Message: "must have a max-age directive",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    security:
+      headers:
+        strict_transport_security: includeSubDomains
+`),
+
+		ErrorCase("has a cache on a TCP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].cache",
+				Message: "must be empty for TCP, TLS and GRPC listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TCP
+    port: 5432
+    tags:
+      name: tcp
+    cache: {}
+`),
+
+		ErrorCase("has an empty cache key header",
+			validators.Violation{
+				Field:   "conf.listeners[0].cache.key.headers[0]",
+				Message: "cannot be empty",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    cache:
+      key:
+        headers:
+        - ""
+`),
+
+		ErrorCase("has an access log on a TCP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].access_log",
+				Message: "must be empty for TCP and TLS listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TCP
+    port: 5432
+    tags:
+      name: tcp
+    access_log: {}
+`),
+
+		ErrorCase("has an invalid access log format",
+			validators.Violation{
+				Field:   "conf.listeners[0].access_log.format",
+				Message: `format string is not valid: expected a command operator to start at position 1, instead got: "%START_TIME"`,
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    access_log:
+      format: "%START_TIME"
+`),
+
+		ErrorCase("has a WAF on a TLS listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].waf",
+				Message: "must be empty for TCP and TLS listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TLS
+    port: 443
+    tls:
+      mode: PASSTHROUGH
+    tags:
+      name: tls
+    waf:
+      rule_set: owasp
+`),
+
+		ErrorCase("has a WAF without a rule set",
+			validators.Violation{
+				Field:   "conf.listeners[0].waf.rule_set",
+				Message: "cannot be empty",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    waf:
+      detection_only: true
+`),
+
+		ErrorCase("has JWT authentication on a TCP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].jwt",
+				Message: "must be empty for TCP and TLS listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TCP
+    port: 443
+    tags:
+      name: tcp
+    jwt:
+      providers:
+      - name: local
+        local_jwks:
+          secret: jwks
+`),
+
+		ErrorCase("has JWT authentication without providers",
+			validators.Violation{
+				Field:   "conf.listeners[0].jwt.providers",
+				Message: "must have at least one element",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    jwt: {}
+`),
+
+		ErrorCase("has JWT providers with the same name",
+			validators.Violation{
+				Field:   "conf.listeners[0].jwt.providers[1].name",
+				Message: "must be unique",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    jwt:
+      providers:
+      - name: local
+        local_jwks:
+          secret: jwks
+      - name: local
+        local_jwks:
+          secret: other-jwks
+`),
+
+		ErrorCase("has a JWT provider with an invalid name",
+			validators.Violation{
+				Field:   "conf.listeners[0].jwt.providers[0].name",
+				Message: "must only contain alphanumeric characters, '.', '_' and '-'",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    jwt:
+      providers:
+      - name: a,b
+        local_jwks:
+          secret: jwks
+`),
+
+		ErrorCase("has a JWT provider without keys",
+			validators.Violation{
+				Field:   "conf.listeners[0].jwt.providers[0]",
+				Message: "must have either remote_jwks or local_jwks",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    jwt:
+      providers:
+      - name: auth0
+        issuer: https://example.auth0.com/
+`),
+
+		ErrorCase("has a JWT provider with remote and local keys",
+			validators.Violation{
+				Field:   "conf.listeners[0].jwt.providers[0]",
+				Message: "cannot have both remote_jwks and local_jwks",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    jwt:
+      providers:
+      - name: auth0
+        remote_jwks:
+          uri: https://example.auth0.com/.well-known/jwks.json
+        local_jwks:
+          secret: jwks
+`),
+
+		ErrorCase("has a JWT provider with a relative JWKS URI",
+			validators.Violation{
+				Field:   "conf.listeners[0].jwt.providers[0].remote_jwks.uri",
+				Message: "must be an absolute HTTP or HTTPS URI",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    jwt:
+      providers:
+      - name: auth0
+        remote_jwks:
+          uri: /.well-known/jwks.json
+`),
+
+		ErrorCase("has a certificate authority for an HTTP JWKS URI",
+			validators.Violation{
+				Field:   "conf.listeners[0].jwt.providers[0].remote_jwks.certificate_authority",
+				Message: "can only be used with HTTPS URIs",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    jwt:
+      providers:
+      - name: keycloak
+        remote_jwks:
+          uri: http://keycloak:8080/realms/kuma/protocol/openid-connect/certs
+          certificate_authority:
+            secret: ca
+`),
+
+		ErrorCase("has a JWT claim mapped to an invalid header",
+			validators.Violation{
+				Field:   "conf.listeners[0].jwt.providers[0].claim_to_headers[0].header",
+				Message: "must be a valid HTTP header name",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    jwt:
+      providers:
+      - name: local
+        local_jwks:
+          secret: jwks
+        claim_to_headers:
+        - claim: sub
+          header: ":authority"
+`),
+
+		ErrorCase("has OIDC authentication on a TCP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].oidc",
+				Message: "must be empty for TCP and TLS listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TCP
+    port: 443
+    tags:
+      name: tcp
+    oidc:
+      provider:
+        authorization_endpoint: https://example.auth0.com/authorize
+        token_endpoint: https://example.auth0.com/oauth/token
+      client_id: gateway
+      client_secret:
+        secret: oidc-client
+      hmac_secret:
+        secret: oidc-hmac
+`),
+
+		ErrorCase("has OIDC authentication with a relative token endpoint",
+			validators.Violation{
+				Field:   "conf.listeners[0].oidc.provider.token_endpoint",
+				Message: "must be an absolute HTTP or HTTPS URI",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    oidc:
+      provider:
+        authorization_endpoint: https://example.auth0.com/authorize
+        token_endpoint: /oauth/token
+      client_id: gateway
+      client_secret:
+        secret: oidc-client
+      hmac_secret:
+        secret: oidc-hmac
+`),
+
+		ErrorCase("has OIDC authentication without a client secret",
+			validators.Violation{
+				Field:   "conf.listeners[0].oidc.client_secret",
+				Message: "cannot be empty",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    oidc:
+      provider:
+        authorization_endpoint: https://example.auth0.com/authorize
+        token_endpoint: https://example.auth0.com/oauth/token
+      client_id: gateway
+      hmac_secret:
+        secret: oidc-hmac
+`),
+
+		ErrorCase("has the same OIDC redirect and signout paths",
+			validators.Violation{
+				Field:   "conf.listeners[0].oidc.signout_path",
+				Message: "must be different from redirect_path",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    oidc:
+      provider:
+        authorization_endpoint: https://example.auth0.com/authorize
+        token_endpoint: https://example.auth0.com/oauth/token
+      client_id: gateway
+      client_secret:
+        secret: oidc-client
+      hmac_secret:
+        secret: oidc-hmac
+      redirect_path: /oauth2
+      signout_path: /oauth2
+`),
+
+		ErrorCase("has a negative idle timeout",
+			validators.Violation{
+				Field:   "conf.listeners[0].limits.idle_timeout",
+				Message: "must be positive",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    limits:
+      idle_timeout: -1s
+`),
+
+		ErrorCase("has too many concurrent streams",
+			validators.Violation{
+				Field:   "conf.listeners[0].limits.max_concurrent_streams",
+				Message: "must be at most 2147483647",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    limits:
+      max_concurrent_streams: 4294967295
+`),
+
+		ErrorCase("has a request timeout on a TCP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].limits.request_timeout",
+				Message: "must be empty for TCP and TLS listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TCP
+    port: 99
+    tags:
+      name: tcp
+    limits:
+      request_timeout: 30s
+`),
+		ErrorCase("has both hostname and hostnames",
+			validators.Violation{
+				Field:   "conf.listeners[0].hostnames",
+				Message: "must be empty when hostname is given",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    hostname: example.com
+    hostnames:
+    - www.example.com
+    tags:
+      name: http
+`),
+		ErrorCase("has the same hostname on listeners of the same port",
+			validators.Violation{
+				Field:   "conf.listeners[1].hostnames[1]",
+				Message: `"*.example.com" conflicts with listener 0 on port 80`,
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    hostname: "*.example.com"
+    tags:
+      name: wildcard
+  - protocol: HTTP
+    port: 80
+    hostnames:
+    - example.com
+    - "*.example.com"
+    tags:
+      name: example
+`),
+		ErrorCase("has two wildcard listeners on the same port",
+			validators.Violation{
+				Field:   "conf.listeners[1].hostname",
+				Message: `"*" conflicts with listener 0 on port 80`,
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: first
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: second
+`),
+		ErrorCase("has a certificate for a hostname of another listener",
+			validators.Violation{
+				Field:   "conf.listeners[1].tls.certificates[0].hostnames[0]",
+				Message: `"api.example.com" conflicts with listener 0 on port 443`,
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTPS
+    port: 443
+    hostname: api.example.com
+    tags:
+      name: api
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: api-example-com
+  - protocol: HTTPS
+    port: 443
+    hostname: "*.example.com"
+    tags:
+      name: example
+    tls:
+      mode: TERMINATE
+      certificates:
+      - hostnames:
+        - api.example.com
+        certificate:
+          secret: example-com
+`),
+		ErrorCase("has a certificate for a hostname that the listener doesn't serve",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls.certificates[0].hostnames[0]",
+				Message: `"example.org" is not matched by the hostnames of the listener`,
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTPS
+    port: 443
+    hostname: "*.example.com"
+    tags:
+      name: example
+    tls:
+      mode: TERMINATE
+      certificates:
+      - hostnames:
+        - example.org
+        certificate:
+          secret: example-org
+`),
+		ErrorCase("has a certificate without a certificate",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls.certificates[0].certificate",
+				Message: "cannot be empty",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTPS
+    port: 443
+    tags:
+      name: example
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: default
+      certificates:
+      - hostnames:
+        - example.com
+`),
+		ErrorCase("has certificates in TLS passthrough mode",
+			validators.Violation{
+				Field:   "conf.listeners[0].tls.certificates",
+				Message: "must be empty in TLS passthrough mode",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TLS
+    port: 443
+    tags:
+      name: example
+    tls:
+      mode: PASSTHROUGH
+      certificates:
+      - hostnames:
+        - example.com
+        certificate:
+          secret: example-com
+`),
+		ErrorCase("has path options on a TCP listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].paths",
+				Message: "must be empty for TCP and TLS listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TCP
+    port: 9000
+    tags:
+      name: tcp
+    paths:
+      reject_traversal: true
+`),
+		ErrorCase("normalizes the paths that it rejects traversals of",
+			validators.Violation{
+				Field:   "conf.listeners[0].paths.normalize",
+				Message: "cannot be enabled when traversals are rejected",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: HTTP
+    port: 80
+    tags:
+      name: http
+    paths:
+      normalize: true
+      reject_traversal: true
+`),
+		ErrorCase("has trusted hops on a TLS listener",
+			validators.Violation{
+				Field:   "conf.listeners[0].xff_num_trusted_hops",
+				Message: "must be empty for TCP and TLS listeners",
+			}, `
+type: Gateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+conf:
+  listeners:
+  - protocol: TLS
+    port: 443
+    hostname: example.com
+    tags:
+      name: tls
+    tls:
+      mode: PASSTHROUGH
+    xff_num_trusted_hops: 1
+`),
 	)
 })
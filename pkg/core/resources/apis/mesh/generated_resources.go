@@ -116,6 +116,110 @@ func init() {
 	registry.RegisterType(CircuitBreakerResourceTypeDescriptor)
 }
 
+const (
+	ConnectionPoolType model.ResourceType = "ConnectionPool"
+)
+
+var _ model.Resource = &ConnectionPoolResource{}
+
+type ConnectionPoolResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.ConnectionPool
+}
+
+func NewConnectionPoolResource() *ConnectionPoolResource {
+	return &ConnectionPoolResource{
+		Spec: &mesh_proto.ConnectionPool{},
+	}
+}
+
+func (t *ConnectionPoolResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *ConnectionPoolResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *ConnectionPoolResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *ConnectionPoolResource) Sources() []*mesh_proto.Selector {
+	return t.Spec.GetSources()
+}
+
+func (t *ConnectionPoolResource) Destinations() []*mesh_proto.Selector {
+	return t.Spec.GetDestinations()
+}
+
+func (t *ConnectionPoolResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.ConnectionPool)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		t.Spec = protoType
+		return nil
+	}
+}
+
+func (t *ConnectionPoolResource) Descriptor() model.ResourceTypeDescriptor {
+	return ConnectionPoolResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &ConnectionPoolResourceList{}
+
+type ConnectionPoolResourceList struct {
+	Items      []*ConnectionPoolResource
+	Pagination model.Pagination
+}
+
+func (l *ConnectionPoolResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *ConnectionPoolResourceList) GetItemType() model.ResourceType {
+	return ConnectionPoolType
+}
+
+func (l *ConnectionPoolResourceList) NewItem() model.Resource {
+	return NewConnectionPoolResource()
+}
+
+func (l *ConnectionPoolResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*ConnectionPoolResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*ConnectionPoolResource)(nil), r)
+	}
+}
+
+func (l *ConnectionPoolResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var ConnectionPoolResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           ConnectionPoolType,
+	Resource:       NewConnectionPoolResource(),
+	ResourceList:   &ConnectionPoolResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "connection-pools",
+	KumactlArg:     "connection-pool",
+	KumactlListArg: "connection-pools",
+}
+
+func init() {
+	registry.RegisterType(ConnectionPoolResourceTypeDescriptor)
+}
+
 const (
 	DataplaneType model.ResourceType = "Dataplane"
 )
@@ -702,6 +806,101 @@ var GatewayResourceTypeDescriptor = model.ResourceTypeDescriptor{
 	KumactlListArg: "gateways",
 }
 
+const (
+	GatewayInsightType model.ResourceType = "GatewayInsight"
+)
+
+var _ model.Resource = &GatewayInsightResource{}
+
+type GatewayInsightResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.GatewayInsight
+}
+
+func NewGatewayInsightResource() *GatewayInsightResource {
+	return &GatewayInsightResource{
+		Spec: &mesh_proto.GatewayInsight{},
+	}
+}
+
+func (t *GatewayInsightResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *GatewayInsightResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *GatewayInsightResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *GatewayInsightResource) Validate() error {
+	return nil
+}
+
+func (t *GatewayInsightResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.GatewayInsight)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		t.Spec = protoType
+		return nil
+	}
+}
+
+func (t *GatewayInsightResource) Descriptor() model.ResourceTypeDescriptor {
+	return GatewayInsightResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &GatewayInsightResourceList{}
+
+type GatewayInsightResourceList struct {
+	Items      []*GatewayInsightResource
+	Pagination model.Pagination
+}
+
+func (l *GatewayInsightResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *GatewayInsightResourceList) GetItemType() model.ResourceType {
+	return GatewayInsightType
+}
+
+func (l *GatewayInsightResourceList) NewItem() model.Resource {
+	return NewGatewayInsightResource()
+}
+
+func (l *GatewayInsightResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*GatewayInsightResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*GatewayInsightResource)(nil), r)
+	}
+}
+
+func (l *GatewayInsightResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var GatewayInsightResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           GatewayInsightType,
+	Resource:       NewGatewayInsightResource(),
+	ResourceList:   &GatewayInsightResourceList{},
+	ReadOnly:       true,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	WsPath:         "gateway-insights",
+	KumactlArg:     "",
+	KumactlListArg: "",
+}
+
 const (
 	GatewayRouteType model.ResourceType = "GatewayRoute"
 )
@@ -797,6 +996,101 @@ var GatewayRouteResourceTypeDescriptor = model.ResourceTypeDescriptor{
 	KumactlListArg: "gateway-routes",
 }
 
+const (
+	GatewayRouteInsightType model.ResourceType = "GatewayRouteInsight"
+)
+
+var _ model.Resource = &GatewayRouteInsightResource{}
+
+type GatewayRouteInsightResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.GatewayRouteInsight
+}
+
+func NewGatewayRouteInsightResource() *GatewayRouteInsightResource {
+	return &GatewayRouteInsightResource{
+		Spec: &mesh_proto.GatewayRouteInsight{},
+	}
+}
+
+func (t *GatewayRouteInsightResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *GatewayRouteInsightResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *GatewayRouteInsightResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *GatewayRouteInsightResource) Validate() error {
+	return nil
+}
+
+func (t *GatewayRouteInsightResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.GatewayRouteInsight)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		t.Spec = protoType
+		return nil
+	}
+}
+
+func (t *GatewayRouteInsightResource) Descriptor() model.ResourceTypeDescriptor {
+	return GatewayRouteInsightResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &GatewayRouteInsightResourceList{}
+
+type GatewayRouteInsightResourceList struct {
+	Items      []*GatewayRouteInsightResource
+	Pagination model.Pagination
+}
+
+func (l *GatewayRouteInsightResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *GatewayRouteInsightResourceList) GetItemType() model.ResourceType {
+	return GatewayRouteInsightType
+}
+
+func (l *GatewayRouteInsightResourceList) NewItem() model.Resource {
+	return NewGatewayRouteInsightResource()
+}
+
+func (l *GatewayRouteInsightResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*GatewayRouteInsightResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*GatewayRouteInsightResource)(nil), r)
+	}
+}
+
+func (l *GatewayRouteInsightResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var GatewayRouteInsightResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           GatewayRouteInsightType,
+	Resource:       NewGatewayRouteInsightResource(),
+	ResourceList:   &GatewayRouteInsightResourceList{},
+	ReadOnly:       true,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	WsPath:         "gateway-route-insights",
+	KumactlArg:     "",
+	KumactlListArg: "",
+}
+
 const (
 	HealthCheckType model.ResourceType = "HealthCheck"
 )
@@ -1096,6 +1390,106 @@ func init() {
 	registry.RegisterType(MeshInsightResourceTypeDescriptor)
 }
 
+const (
+	ProxyPatchType model.ResourceType = "ProxyPatch"
+)
+
+var _ model.Resource = &ProxyPatchResource{}
+
+type ProxyPatchResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.ProxyPatch
+}
+
+func NewProxyPatchResource() *ProxyPatchResource {
+	return &ProxyPatchResource{
+		Spec: &mesh_proto.ProxyPatch{},
+	}
+}
+
+func (t *ProxyPatchResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *ProxyPatchResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *ProxyPatchResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *ProxyPatchResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.GetSelectors()
+}
+
+func (t *ProxyPatchResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.ProxyPatch)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		t.Spec = protoType
+		return nil
+	}
+}
+
+func (t *ProxyPatchResource) Descriptor() model.ResourceTypeDescriptor {
+	return ProxyPatchResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &ProxyPatchResourceList{}
+
+type ProxyPatchResourceList struct {
+	Items      []*ProxyPatchResource
+	Pagination model.Pagination
+}
+
+func (l *ProxyPatchResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *ProxyPatchResourceList) GetItemType() model.ResourceType {
+	return ProxyPatchType
+}
+
+func (l *ProxyPatchResourceList) NewItem() model.Resource {
+	return NewProxyPatchResource()
+}
+
+func (l *ProxyPatchResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*ProxyPatchResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*ProxyPatchResource)(nil), r)
+	}
+}
+
+func (l *ProxyPatchResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var ProxyPatchResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           ProxyPatchType,
+	Resource:       NewProxyPatchResource(),
+	ResourceList:   &ProxyPatchResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "proxypatches",
+	KumactlArg:     "proxypatch",
+	KumactlListArg: "proxypatches",
+}
+
+func init() {
+	registry.RegisterType(ProxyPatchResourceTypeDescriptor)
+}
+
 const (
 	ProxyTemplateType model.ResourceType = "ProxyTemplate"
 )
@@ -2215,6 +2609,97 @@ func init() {
 	registry.RegisterType(VirtualOutboundResourceTypeDescriptor)
 }
 
+const (
+	WafRuleSetType model.ResourceType = "WafRuleSet"
+)
+
+var _ model.Resource = &WafRuleSetResource{}
+
+type WafRuleSetResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.WafRuleSet
+}
+
+func NewWafRuleSetResource() *WafRuleSetResource {
+	return &WafRuleSetResource{
+		Spec: &mesh_proto.WafRuleSet{},
+	}
+}
+
+func (t *WafRuleSetResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *WafRuleSetResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *WafRuleSetResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *WafRuleSetResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.WafRuleSet)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		t.Spec = protoType
+		return nil
+	}
+}
+
+func (t *WafRuleSetResource) Descriptor() model.ResourceTypeDescriptor {
+	return WafRuleSetResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &WafRuleSetResourceList{}
+
+type WafRuleSetResourceList struct {
+	Items      []*WafRuleSetResource
+	Pagination model.Pagination
+}
+
+func (l *WafRuleSetResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *WafRuleSetResourceList) GetItemType() model.ResourceType {
+	return WafRuleSetType
+}
+
+func (l *WafRuleSetResourceList) NewItem() model.Resource {
+	return NewWafRuleSetResource()
+}
+
+func (l *WafRuleSetResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*WafRuleSetResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*WafRuleSetResource)(nil), r)
+	}
+}
+
+func (l *WafRuleSetResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var WafRuleSetResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           WafRuleSetType,
+	Resource:       NewWafRuleSetResource(),
+	ResourceList:   &WafRuleSetResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	WsPath:         "waf-rule-sets",
+	KumactlArg:     "waf-rule-set",
+	KumactlListArg: "waf-rule-sets",
+}
+
 const (
 	ZoneIngressType model.ResourceType = "ZoneIngress"
 )
@@ -0,0 +1,34 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+const deniedByGuardrails = "is denied by the guardrails of the mesh"
+
+// ValidateGuardrails validates that a resource does not use features that are denied by the guardrails of the mesh.
+func ValidateGuardrails(mesh *mesh_proto.Mesh, resource model.Resource) validators.ValidationError {
+	var verr validators.ValidationError
+	switch r := resource.(type) {
+	case *ProxyTemplateResource:
+		if mesh.IsDenied(mesh_proto.GuardrailProxyTemplateCustomization) {
+			if len(r.Spec.GetConf().GetResources()) > 0 {
+				verr.AddViolation("conf.resources", deniedByGuardrails)
+			}
+			if len(r.Spec.GetConf().GetModifications()) > 0 {
+				verr.AddViolation("conf.modifications", deniedByGuardrails)
+			}
+		}
+	case *ProxyPatchResource:
+		if mesh.IsDenied(mesh_proto.GuardrailProxyPatch) {
+			verr.AddViolation("conf.patches", deniedByGuardrails)
+		}
+	case *ExternalServiceResource:
+		if mesh.IsDenied(mesh_proto.GuardrailPlaintextExternalService) && !r.Spec.GetNetworking().GetTls().GetEnabled() {
+			verr.AddViolation("networking.tls.enabled", "has to be true, plaintext external services are denied by the guardrails of the mesh")
+		}
+	}
+	return verr
+}
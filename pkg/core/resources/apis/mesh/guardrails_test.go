@@ -0,0 +1,102 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("ValidateGuardrails()", func() {
+
+	type testCase struct {
+		resource model.Resource
+		spec     string
+		expected string
+	}
+
+	mesh := &mesh_proto.Mesh{
+		Guardrails: &mesh_proto.Guardrails{
+			Deny: []string{
+				mesh_proto.GuardrailProxyTemplateCustomization,
+				mesh_proto.GuardrailProxyPatch,
+				mesh_proto.GuardrailPlaintextExternalService,
+			},
+		},
+	}
+
+	DescribeTable("should reject denied features",
+		func(given testCase) {
+			// given
+			Expect(util_proto.FromYAML([]byte(given.spec), given.resource.GetSpec())).To(Succeed())
+
+			// when
+			verr := ValidateGuardrails(mesh, given.resource)
+			// and
+			actual, err := yaml.Marshal(verr)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("proxy template with modifications", testCase{
+			resource: NewProxyTemplateResource(),
+			spec: `
+            conf:
+              imports:
+              - default-proxy
+              modifications:
+              - cluster:
+                  operation: remove
+                  match:
+                    name: backend`,
+			expected: `
+            violations:
+            - field: conf.modifications
+              message: is denied by the guardrails of the mesh`,
+		}),
+		Entry("proxy patch", testCase{
+			resource: NewProxyPatchResource(),
+			spec: `
+            conf:
+              patches:
+              - match:
+                  type: Cluster
+                mergePatch:
+                  connectTimeout: 10s`,
+			expected: `
+            violations:
+            - field: conf.patches
+              message: is denied by the guardrails of the mesh`,
+		}),
+		Entry("plaintext external service", testCase{
+			resource: NewExternalServiceResource(),
+			spec: `
+            networking:
+              address: httpbin.org:80`,
+			expected: `
+            violations:
+            - field: networking.tls.enabled
+              message: has to be true, plaintext external services are denied by the guardrails of the mesh`,
+		}),
+	)
+
+	It("should allow features that are not denied", func() {
+		// given
+		template := NewProxyTemplateResource()
+		template.Spec.Conf = &mesh_proto.ProxyTemplate_Conf{
+			Imports: []string{"default-proxy"},
+		}
+
+		// when
+		verr := ValidateGuardrails(mesh, template)
+
+		// then
+		Expect(verr.OrNil()).ToNot(HaveOccurred())
+	})
+})
@@ -31,6 +31,11 @@ func (m *MeshResource) MTLSEnabled() bool {
 	return m != nil && m.Spec.GetMtls().GetEnabledBackend() != ""
 }
 
+// FIPSEnabled returns true if mTLS is enabled and restricted to FIPS approved algorithms.
+func (m *MeshResource) FIPSEnabled() bool {
+	return m.MTLSEnabled() && m.Spec.GetMtls().GetFips()
+}
+
 func (m *MeshResource) GetTracingBackend(name string) *mesh_proto.TracingBackend {
 	backends := map[string]*mesh_proto.TracingBackend{}
 	for _, backend := range m.Spec.GetTracing().GetBackends() {
@@ -4,10 +4,15 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"regexp"
+	"strings"
 
 	"google.golang.org/protobuf/types/known/structpb"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/pkg/core/validators"
 	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
 	"github.com/kumahq/kuma/pkg/util/proto"
@@ -21,6 +26,17 @@ func (m *MeshResource) Validate() error {
 	verr.AddError("logging", validateLogging(m.Spec.Logging))
 	verr.AddError("tracing", validateTracing(m.Spec.Tracing))
 	verr.AddError("metrics", validateMetrics(m.Spec.Metrics))
+	verr.AddError("overloadManager", validateOverloadManager(m.Spec.OverloadManager))
+	verr.AddError("guardrails", validateGuardrails(m.Spec.Guardrails))
+	verr.AddError("recording", validateRecording(m.Spec.Recording))
+	verr.AddError("requestId", validateRequestId(m.Spec.RequestId))
+	verr.AddError("headerPropagation", validateHeaderPropagation(m.Spec.HeaderPropagation))
+	verr.AddError("networking.transparentProxying", validateTransparentProxying(m.Spec.GetNetworking().GetTransparentProxying()))
+	verr.AddError("networking.outbound.tcpKeepalive", validateOutboundTcpKeepalive(m.Spec.GetNetworking().GetOutbound().GetTcpKeepalive()))
+	verr.Add(validateSkipCreatingInitialPolicies(m.Spec.GetSkipCreatingInitialPolicies()))
+	if m.Spec.IsDenied(mesh_proto.GuardrailPassthrough) && m.Spec.GetNetworking().GetOutbound().GetPassthrough().GetValue() {
+		verr.AddViolation("networking.outbound.passthrough", "is denied by the guardrails of the mesh")
+	}
 	return verr.OrNil()
 }
 
@@ -81,13 +97,20 @@ func validateLoggingBackend(backend *mesh_proto.LoggingBackend) validators.Valid
 	if err := accesslog.ValidateFormat(backend.Format); err != nil {
 		verr.AddViolation("format", err.Error())
 	}
+	if err := accesslog.ValidateFormat(backend.TcpFormat); err != nil {
+		verr.AddViolation("tcpFormat", err.Error())
+	}
 	switch backend.GetType() {
 	case mesh_proto.LoggingFileType:
 		verr.AddError("config", validateLoggingFile(backend.Conf))
 	case mesh_proto.LoggingTcpType:
 		verr.AddError("config", validateLoggingTcp(backend.Conf))
+	case mesh_proto.LoggingSplunkType:
+		verr.AddError("config", validateLoggingSplunk(backend.Conf))
+	case mesh_proto.LoggingGrpcType:
+		verr.AddError("config", validateLoggingGrpc(backend.Conf))
 	default:
-		verr.AddViolation("type", fmt.Sprintf("unknown backend type. Available backends: %q, %q", mesh_proto.LoggingTcpType, mesh_proto.LoggingFileType))
+		verr.AddViolation("type", fmt.Sprintf("unknown backend type. Available backends: %q, %q, %q, %q", mesh_proto.LoggingTcpType, mesh_proto.LoggingFileType, mesh_proto.LoggingSplunkType, mesh_proto.LoggingGrpcType))
 	}
 	return verr
 }
@@ -110,6 +133,24 @@ func validateLoggingTcp(cfgStr *structpb.Struct) validators.ValidationError {
 	return verr
 }
 
+func validateLoggingGrpc(cfgStr *structpb.Struct) validators.ValidationError {
+	var verr validators.ValidationError
+	cfg := mesh_proto.GrpcLoggingBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		verr.AddViolation("", fmt.Sprintf("could not parse config: %s", err.Error()))
+		return verr
+	}
+	if cfg.Address == "" {
+		verr.AddViolation("address", "cannot be empty")
+		return verr
+	}
+	host, port, err := net.SplitHostPort(cfg.Address)
+	if host == "" || port == "" || err != nil {
+		verr.AddViolation("address", "has to be in format of HOST:PORT")
+	}
+	return verr
+}
+
 func validateLoggingFile(cfgStr *structpb.Struct) validators.ValidationError {
 	var verr validators.ValidationError
 	cfg := mesh_proto.FileLoggingBackendConfig{}
@@ -121,6 +162,86 @@ func validateLoggingFile(cfgStr *structpb.Struct) validators.ValidationError {
 	return verr
 }
 
+func validateLoggingSplunk(cfgStr *structpb.Struct) validators.ValidationError {
+	var verr validators.ValidationError
+	cfg := mesh_proto.SplunkLoggingBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		verr.AddViolation("", fmt.Sprintf("could not parse config: %s", err.Error()))
+		return verr
+	}
+	if cfg.Url == "" {
+		verr.AddViolation("url", "cannot be empty")
+	} else if u, err := url.ParseRequestURI(cfg.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		verr.AddViolation("url", "has to be a valid http or https url")
+	}
+	switch cfg.Token.GetType().(type) {
+	case nil:
+		verr.AddViolation("token", "cannot be empty")
+	case *system_proto.DataSource_Secret:
+		if cfg.Token.GetSecret() == "" {
+			verr.AddViolation("token.secret", "cannot be empty")
+		}
+	case *system_proto.DataSource_File:
+		if cfg.Token.GetFile() == "" {
+			verr.AddViolation("token.file", "cannot be empty")
+		}
+	default:
+		verr.AddViolation("token", "has to be a secret or a file, inline values would be put in the Envoy configuration")
+	}
+	verr.AddError("tls", validateLoggingTls(cfg.Tls))
+	verr.AddError("batching", validateLoggingBatching(cfg.Batching))
+	verr.AddError("retry", validateLoggingRetry(cfg.Retry))
+	return verr
+}
+
+func validateLoggingTls(tls *mesh_proto.LoggingBackendTls) validators.ValidationError {
+	var verr validators.ValidationError
+	if tls == nil {
+		return verr
+	}
+	if !tls.Enabled && (tls.CaCert != "" || tls.ServerName != "" || tls.InsecureSkipVerify) {
+		verr.AddViolation("enabled", "has to be true when other TLS settings are provided")
+	}
+	return verr
+}
+
+func validateLoggingBatching(batching *mesh_proto.LoggingBackendBatching) validators.ValidationError {
+	var verr validators.ValidationError
+	if batching == nil {
+		return verr
+	}
+	if batching.MaxEntries != nil && batching.MaxEntries.GetValue() == 0 {
+		verr.AddViolation("maxEntries", "must be greater than 0")
+	}
+	if batching.FlushInterval != nil && batching.FlushInterval.AsDuration() <= 0 {
+		verr.AddViolation("flushInterval", "must be greater than 0s")
+	}
+	if batching.BufferSize != nil && batching.BufferSize.GetValue() == 0 {
+		verr.AddViolation("bufferSize", "must be greater than 0")
+	}
+	if batching.MaxEntries != nil && batching.BufferSize != nil && batching.MaxEntries.GetValue() > batching.BufferSize.GetValue() {
+		verr.AddViolation("maxEntries", "cannot be greater than bufferSize")
+	}
+	return verr
+}
+
+func validateLoggingRetry(retry *mesh_proto.LoggingBackendRetry) validators.ValidationError {
+	var verr validators.ValidationError
+	if retry == nil {
+		return verr
+	}
+	if retry.Backoff != nil && retry.Backoff.AsDuration() <= 0 {
+		verr.AddViolation("backoff", "must be greater than 0s")
+	}
+	if retry.MaxBackoff != nil && retry.MaxBackoff.AsDuration() <= 0 {
+		verr.AddViolation("maxBackoff", "must be greater than 0s")
+	}
+	if retry.Backoff != nil && retry.MaxBackoff != nil && retry.Backoff.AsDuration() > retry.MaxBackoff.AsDuration() {
+		verr.AddViolation("maxBackoff", "cannot be lower than backoff")
+	}
+	return verr
+}
+
 func validateTracing(tracing *mesh_proto.Tracing) validators.ValidationError {
 	var verr validators.ValidationError
 	if tracing == nil {
@@ -218,3 +339,178 @@ func validateMetrics(metrics *mesh_proto.Metrics) validators.ValidationError {
 	}
 	return verr
 }
+
+func validateOverloadManager(overloadManager *mesh_proto.OverloadManager) validators.ValidationError {
+	var verr validators.ValidationError
+	if overloadManager == nil {
+		return verr
+	}
+	if overloadManager.MaxHeapSizeBytes != nil && overloadManager.MaxHeapSizeBytes.GetValue() == 0 {
+		verr.AddViolation("maxHeapSizeBytes", "must be greater than 0")
+	}
+	shrinkHeap := overloadManager.ShrinkHeapThreshold
+	if shrinkHeap != nil && (shrinkHeap.GetValue() <= 0 || shrinkHeap.GetValue() > 1) {
+		verr.AddViolation("shrinkHeapThreshold", "has to be in (0.0 - 1.0] range")
+	}
+	stopAcceptingRequests := overloadManager.StopAcceptingRequestsThreshold
+	if stopAcceptingRequests != nil && (stopAcceptingRequests.GetValue() <= 0 || stopAcceptingRequests.GetValue() > 1) {
+		verr.AddViolation("stopAcceptingRequestsThreshold", "has to be in (0.0 - 1.0] range")
+	}
+	if shrinkHeap != nil && stopAcceptingRequests != nil && shrinkHeap.GetValue() > stopAcceptingRequests.GetValue() {
+		verr.AddViolation("stopAcceptingRequestsThreshold", "cannot be lower than shrinkHeapThreshold")
+	}
+	if overloadManager.MaxActiveDownstreamConnections != nil && overloadManager.MaxActiveDownstreamConnections.GetValue() == 0 {
+		verr.AddViolation("maxActiveDownstreamConnections", "must be greater than 0")
+	}
+	return verr
+}
+
+func validateGuardrails(guardrails *mesh_proto.Guardrails) validators.ValidationError {
+	var verr validators.ValidationError
+	for i, feature := range guardrails.GetDeny() {
+		known := false
+		for _, guardrail := range mesh_proto.AllGuardrailFeatures {
+			if feature == guardrail {
+				known = true
+			}
+		}
+		if !known {
+			verr.AddViolationAt(validators.RootedAt("deny").Index(i), fmt.Sprintf("unknown feature. Available features: %s", strings.Join(mesh_proto.AllGuardrailFeatures, ", ")))
+		}
+	}
+	return verr
+}
+
+// maxRecordingBodyBytes and maxRecordingRequests keep a single recording small
+// enough to be stored as a secret.
+const (
+	maxRecordingBodyBytes = 65536
+	maxRecordingRequests  = 1000
+)
+
+func validateRecording(recording *mesh_proto.Recording) validators.ValidationError {
+	var verr validators.ValidationError
+	if recording == nil {
+		return verr
+	}
+	if recording.MaxBodyBytes != nil && recording.MaxBodyBytes.GetValue() > maxRecordingBodyBytes {
+		verr.AddViolation("maxBodyBytes", fmt.Sprintf("must not be greater than %d", maxRecordingBodyBytes))
+	}
+	if recording.MaxRequests != nil && (recording.MaxRequests.GetValue() == 0 || recording.MaxRequests.GetValue() > maxRecordingRequests) {
+		verr.AddViolation("maxRequests", fmt.Sprintf("has to be in [1 - %d] range", maxRecordingRequests))
+	}
+	return verr
+}
+
+var headerNameRegex = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+func validateRequestId(requestId *mesh_proto.RequestId) validators.ValidationError {
+	var verr validators.ValidationError
+	if requestId == nil {
+		return verr
+	}
+	if generator := requestId.GetGenerator(); generator != "" {
+		known := false
+		for _, g := range mesh_proto.RequestIdGenerators {
+			if generator == g {
+				known = true
+			}
+		}
+		if !known {
+			verr.AddViolation("generator", fmt.Sprintf("unknown generator. Available generators: %s", strings.Join(mesh_proto.RequestIdGenerators, ", ")))
+		}
+	}
+	if header := requestId.GetHeader(); header != "" && !headerNameRegex.MatchString(header) {
+		verr.AddViolation("header", "must be a lowercase HTTP header name")
+	}
+	return verr
+}
+
+// reservedPropagatedHeaders are managed by proxies, so they cannot be propagated.
+var reservedPropagatedHeaders = map[string]bool{
+	"host":                            true,
+	"x-kuma-tags":                     true,
+	mesh_proto.DefaultRequestIdHeader: true,
+}
+
+func validateHeaderPropagation(propagation *mesh_proto.HeaderPropagation) validators.ValidationError {
+	var verr validators.ValidationError
+	names := map[string]bool{}
+	for i, header := range propagation.GetHeaders() {
+		path := validators.RootedAt("headers").Index(i).Field("name")
+		name := header.GetName()
+		switch {
+		case name == "":
+			verr.AddViolationAt(path, "cannot be empty")
+		case !headerNameRegex.MatchString(name):
+			verr.AddViolationAt(path, "must be a lowercase HTTP header name")
+		case reservedPropagatedHeaders[name]:
+			verr.AddViolationAt(path, "is managed by proxies and cannot be propagated")
+		case names[name]:
+			verr.AddViolationAt(path, "must be unique")
+		}
+		names[name] = true
+	}
+	return verr
+}
+
+func validateSkipCreatingInitialPolicies(policies []string) validators.ValidationError {
+	var verr validators.ValidationError
+	types := map[string]bool{}
+	for i, policy := range policies {
+		path := validators.RootedAt("skipCreatingInitialPolicies").Index(i)
+		switch {
+		case policy == "*":
+			if len(policies) > 1 {
+				verr.AddViolationAt(path, `"*" cannot be combined with other policies`)
+			}
+		case !isMeshPolicyType(policy):
+			verr.AddViolationAt(path, fmt.Sprintf("%q is not a type of the policies of a mesh", policy))
+		case types[policy]:
+			verr.AddViolationAt(path, "must be unique")
+		}
+		types[policy] = true
+	}
+	return verr
+}
+
+func isMeshPolicyType(name string) bool {
+	descriptor, err := registry.Global().DescriptorFor(model.ResourceType(name))
+	if err != nil {
+		return false
+	}
+	return descriptor.Scope == model.ScopeMesh && !descriptor.ReadOnly && descriptor.Name != DataplaneType
+}
+
+func validateTransparentProxying(tp *mesh_proto.Networking_TransparentProxying) validators.ValidationError {
+	var verr validators.ValidationError
+	for i, port := range tp.GetExcludeInboundPorts() {
+		verr.Add(ValidatePort(validators.RootedAt("excludeInboundPorts").Index(i), port))
+	}
+	for i, port := range tp.GetExcludeOutboundPorts() {
+		verr.Add(ValidatePort(validators.RootedAt("excludeOutboundPorts").Index(i), port))
+	}
+	for i, cidr := range tp.GetExcludeOutboundIPs() {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			verr.AddViolationAt(validators.RootedAt("excludeOutboundIPs").Index(i), "must be a valid CIDR")
+		}
+	}
+	return verr
+}
+
+func validateOutboundTcpKeepalive(keepalive *mesh_proto.Networking_Outbound_TcpKeepalive) validators.ValidationError {
+	var verr validators.ValidationError
+	if keepalive == nil {
+		return verr
+	}
+	if keepalive.Probes == nil && keepalive.Time == nil && keepalive.Interval == nil {
+		verr.AddViolation("", "at least one setting in section has to be defined")
+		return verr
+	}
+	if keepalive.Probes != nil && keepalive.Probes.GetValue() == 0 {
+		verr.AddViolation("probes", WhenDefinedHasToBeGreaterThan0Violation)
+	}
+	verr.Add(validateDuration_GreaterThan0OrNil(validators.RootedAt("time"), keepalive.Time))
+	verr.Add(validateDuration_GreaterThan0OrNil(validators.RootedAt("interval"), keepalive.Interval))
+	return verr
+}
@@ -43,9 +43,29 @@ var _ = Describe("Mesh", func() {
                   address: kibana:1234
               - name: tcp-2
                 format: '%START_TIME% %KUMA_DESTINATION_SERVICE%'
+                tcpFormat: '%START_TIME% %REQUESTED_SERVER_NAME% %BYTES_SENT%'
                 type: tcp
                 conf:
                   address: kibana:1234
+              - name: splunk-1
+                type: splunk
+                conf:
+                  url: https://splunk.local:8088/services/collector/event
+                  token:
+                    secret: splunk-token
+                  tls:
+                    enabled: true
+                  batching:
+                    maxEntries: 50
+                    flushInterval: 5s
+                  retry:
+                    maxAttempts: 3
+                    backoff: 0.2s
+              - name: als-1
+                type: grpc
+                conf:
+                  address: als-collector.kuma-logging:9001
+                  logName: mesh
               defaultBackend: tcp-1
             tracing:
               backends:
@@ -70,6 +90,48 @@ var _ = Describe("Mesh", func() {
                 conf:
                   port: 5670
                   path: /metrics
+            overloadManager:
+              maxHeapSizeBytes: 1073741824
+              shrinkHeapThreshold: 0.9
+              stopAcceptingRequestsThreshold: 0.95
+              maxActiveDownstreamConnections: 10000
+            guardrails:
+              deny:
+              - ProxyTemplateCustomization
+              - ProxyPatch
+              - Passthrough
+              - PlaintextExternalService
+            recording:
+              enabled: true
+              maxBodyBytes: 8192
+              maxRequests: 50
+            requestId:
+              generator: TraceContext
+              header: x-correlation-id
+              overwriteOnGateways: false
+            headerPropagation:
+              headers:
+              - name: baggage
+              - name: x-tenant-id
+                stripAtGateways: true
+            networking:
+              outbound:
+                tcpKeepalive:
+                  probes: 3
+                  time: 60s
+                  interval: 10s
+                dnsLookupFamily: V4_ONLY
+              transparentProxying:
+                excludeInboundPorts:
+                - 9090
+                excludeOutboundPorts:
+                - 5432
+                excludeOutboundIPs:
+                - 169.254.169.254/32
+                - fd00::/8
+            skipCreatingInitialPolicies:
+            - TrafficPermission
+            - Timeout
 `
 			mesh := NewMeshResource()
 
@@ -220,6 +282,78 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: logging.backends[0].config.path
                   message: cannot be empty`,
+			}),
+			Entry("splunk logging without url and token", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    type: splunk
+                    conf:
+                      index: mesh
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].config.url
+                  message: cannot be empty
+                - field: logging.backends[0].config.token
+                  message: cannot be empty`,
+			}),
+			Entry("splunk logging with invalid url and batching", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    type: splunk
+                    conf:
+                      url: splunk:8088
+                      token:
+                        file: /etc/splunk/token
+                      batching:
+                        maxEntries: 200
+                        bufferSize: 100
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].config.url
+                  message: has to be a valid http or https url
+                - field: logging.backends[0].config.batching.maxEntries
+                  message: cannot be greater than bufferSize`,
+			}),
+			Entry("grpc logging with invalid address", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    type: grpc
+                    conf:
+                      address: als-collector
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].config.address
+                  message: has to be in format of HOST:PORT`,
+			}),
+			Entry("splunk logging with inline token and invalid retry", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    type: splunk
+                    conf:
+                      url: https://splunk.local:8088/services/collector/event
+                      token:
+                        inlineString: s3cr3t
+                      retry:
+                        backoff: 1s
+                        maxBackoff: 0.1s
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].config.token
+                  message: has to be a secret or a file, inline values would be put in the Envoy configuration
+                - field: logging.backends[0].config.retry.maxBackoff
+                  message: cannot be lower than backoff`,
 			}),
 			Entry("invalid access log format", testCase{
 				mesh: `
@@ -235,6 +369,21 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: logging.backends[0].format
                   message: 'format string is not valid: expected a command operator to start at position 14, instead got: "%sent_bytes%"'`,
+			}),
+			Entry("invalid access log format of TCP connections", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    tcpFormat: "%START_TIME% %sni%"
+                    type: file
+                    conf:
+                      path: /var/logs
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].tcpFormat
+                  message: 'format string is not valid: expected a command operator to start at position 14, instead got: "%sni%"'`,
 			}),
 			Entry("default backend has to be set to one of the backends", testCase{
 				mesh: `
@@ -386,6 +535,160 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: metrics.enabledBackend
                   message: has to be set to one of the backends in the mesh`,
+			}),
+			Entry("overload manager with invalid values", testCase{
+				mesh: `
+                overloadManager:
+                  maxHeapSizeBytes: 0
+                  shrinkHeapThreshold: 1.5
+                  stopAcceptingRequestsThreshold: 0
+                  maxActiveDownstreamConnections: 0`,
+				expected: `
+                violations:
+                - field: overloadManager.maxHeapSizeBytes
+                  message: must be greater than 0
+                - field: overloadManager.shrinkHeapThreshold
+                  message: has to be in (0.0 - 1.0] range
+                - field: overloadManager.stopAcceptingRequestsThreshold
+                  message: has to be in (0.0 - 1.0] range
+                - field: overloadManager.stopAcceptingRequestsThreshold
+                  message: cannot be lower than shrinkHeapThreshold
+                - field: overloadManager.maxActiveDownstreamConnections
+                  message: must be greater than 0`,
+			}),
+			Entry("overload manager with stop accepting requests threshold lower than shrink heap threshold", testCase{
+				mesh: `
+                overloadManager:
+                  shrinkHeapThreshold: 0.95
+                  stopAcceptingRequestsThreshold: 0.9`,
+				expected: `
+                violations:
+                - field: overloadManager.stopAcceptingRequestsThreshold
+                  message: cannot be lower than shrinkHeapThreshold`,
+			}),
+			Entry("recording with invalid limits", testCase{
+				mesh: `
+                recording:
+                  enabled: true
+                  maxBodyBytes: 1048576
+                  maxRequests: 0`,
+				expected: `
+                violations:
+                - field: recording.maxBodyBytes
+                  message: must not be greater than 65536
+                - field: recording.maxRequests
+                  message: has to be in [1 - 1000] range`,
+			}),
+			Entry("request id with unknown generator and invalid header", testCase{
+				mesh: `
+                requestId:
+                  generator: Random
+                  header: X Correlation`,
+				expected: `
+                violations:
+                - field: requestId.generator
+                  message: 'unknown generator. Available generators: Uuid, TraceContext'
+                - field: requestId.header
+                  message: must be a lowercase HTTP header name`,
+			}),
+			Entry("invalid propagated headers", testCase{
+				mesh: `
+                headerPropagation:
+                  headers:
+                  - name: ""
+                  - name: X-Tenant
+                  - name: x-request-id
+                  - name: baggage
+                  - name: baggage`,
+				expected: `
+                violations:
+                - field: headerPropagation.headers[0].name
+                  message: cannot be empty
+                - field: headerPropagation.headers[1].name
+                  message: must be a lowercase HTTP header name
+                - field: headerPropagation.headers[2].name
+                  message: is managed by proxies and cannot be propagated
+                - field: headerPropagation.headers[4].name
+                  message: must be unique`,
+			}),
+			Entry("invalid policies to skip creating", testCase{
+				mesh: `
+                skipCreatingInitialPolicies:
+                - TrafficRoute
+                - Dataplane
+                - Unknown
+                - TrafficRoute
+                - "*"`,
+				expected: `
+                violations:
+                - field: skipCreatingInitialPolicies[1]
+                  message: '"Dataplane" is not a type of the policies of a mesh'
+                - field: skipCreatingInitialPolicies[2]
+                  message: '"Unknown" is not a type of the policies of a mesh'
+                - field: skipCreatingInitialPolicies[3]
+                  message: must be unique
+                - field: skipCreatingInitialPolicies[4]
+                  message: '"*" cannot be combined with other policies'`,
+			}),
+			Entry("invalid transparent proxying exclusions", testCase{
+				mesh: `
+                networking:
+                  transparentProxying:
+                    excludeInboundPorts:
+                    - 0
+                    excludeOutboundPorts:
+                    - 65536
+                    excludeOutboundIPs:
+                    - 10.0.0.0/8
+                    - 169.254.169.254`,
+				expected: `
+                violations:
+                - field: networking.transparentProxying.excludeInboundPorts[0]
+                  message: port must be in the range [1, 65535]
+                - field: networking.transparentProxying.excludeOutboundPorts[0]
+                  message: port must be in the range [1, 65535]
+                - field: networking.transparentProxying.excludeOutboundIPs[1]
+                  message: must be a valid CIDR`,
+			}),
+			Entry("invalid outbound tcp keepalive", testCase{
+				mesh: `
+                networking:
+                  outbound:
+                    tcpKeepalive:
+                      probes: 0
+                      time: 0s`,
+				expected: `
+                violations:
+                - field: networking.outbound.tcpKeepalive.probes
+                  message: has to be greater than 0 when defined
+                - field: networking.outbound.tcpKeepalive.time
+                  message: has to be greater than 0 when defined`,
+			}),
+			Entry("empty outbound tcp keepalive", testCase{
+				mesh: `
+                networking:
+                  outbound:
+                    tcpKeepalive: {}`,
+				expected: `
+                violations:
+                - field: networking.outbound.tcpKeepalive
+                  message: at least one setting in section has to be defined`,
+			}),
+			Entry("unknown guardrail and denied passthrough", testCase{
+				mesh: `
+                networking:
+                  outbound:
+                    passthrough: true
+                guardrails:
+                  deny:
+                  - Passthrough
+                  - RawConfig`,
+				expected: `
+                violations:
+                - field: guardrails.deny[1]
+                  message: 'unknown feature. Available features: ProxyTemplateCustomization, ProxyPatch, Passthrough, PlaintextExternalService'
+                - field: networking.outbound.passthrough
+                  message: is denied by the guardrails of the mesh`,
 			}),
 			Entry("unknown backend types", testCase{
 				mesh: `
@@ -404,7 +707,7 @@ var _ = Describe("Mesh", func() {
 `,
 				expected: `violations:
                 - field: logging.backends[0].type
-                  message: 'unknown backend type. Available backends: "tcp", "file"'
+                  message: 'unknown backend type. Available backends: "tcp", "file", "splunk", "grpc"'
                 - field: tracing.backends[0].type
                   message: 'unknown backend type. Available backends: "zipkin", "datadog"'
                 - field: metrics.backends[0].type
@@ -0,0 +1,89 @@
+package mesh
+
+import (
+	"fmt"
+	"strings"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+var availablePatchTypes = []string{
+	mesh_proto.PatchTypeListener,
+	mesh_proto.PatchTypeCluster,
+	mesh_proto.PatchTypeRouteConfiguration,
+	mesh_proto.PatchTypeClusterLoadAssignment,
+}
+
+func (p *ProxyPatchResource) Validate() error {
+	var verr validators.ValidationError
+	verr.Add(ValidateSelectors(validators.RootedAt("selectors"), p.Spec.GetSelectors(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateSelectorOpts: ValidateSelectorOpts{
+			RequireService:       true,
+			RequireAtLeastOneTag: true,
+		},
+	}))
+	verr.AddError("conf", validateProxyPatchConf(p.Spec.GetConf()))
+	return verr.OrNil()
+}
+
+func validateProxyPatchConf(conf *mesh_proto.ProxyPatch_Conf) validators.ValidationError {
+	var verr validators.ValidationError
+	if len(conf.GetPatches()) == 0 {
+		verr.AddViolation("patches", "must have at least one element")
+	}
+	for i, patch := range conf.GetPatches() {
+		verr.AddErrorAt(validators.RootedAt("patches").Index(i), validatePatch(patch))
+	}
+	return verr
+}
+
+func validatePatch(patch *mesh_proto.ProxyPatch_Patch) validators.ValidationError {
+	var verr validators.ValidationError
+	if !isAvailablePatchType(patch.GetMatch().GetType()) {
+		verr.AddViolation("match.type", fmt.Sprintf("invalid type. Available types: %s", strings.Join(availablePatchTypes, ", ")))
+	}
+	switch {
+	case len(patch.GetJsonPatch()) == 0 && patch.GetMergePatch() == nil:
+		verr.AddViolation("", "either jsonPatch or mergePatch has to be defined")
+	case len(patch.GetJsonPatch()) != 0 && patch.GetMergePatch() != nil:
+		verr.AddViolation("", "jsonPatch and mergePatch cannot be defined together")
+	}
+	for i, operation := range patch.GetJsonPatch() {
+		verr.AddErrorAt(validators.RootedAt("jsonPatch").Index(i), validateJsonPatchOperation(operation))
+	}
+	return verr
+}
+
+func validateJsonPatchOperation(operation *mesh_proto.ProxyPatch_Patch_JsonPatchOperation) validators.ValidationError {
+	var verr validators.ValidationError
+	if !strings.HasPrefix(operation.GetPath(), "/") {
+		verr.AddViolation("path", "must be a JSON Pointer starting with /")
+	}
+	switch operation.GetOp() {
+	case mesh_proto.JsonPatchOpAdd, mesh_proto.JsonPatchOpReplace, mesh_proto.JsonPatchOpTest:
+		if operation.GetValue() == nil {
+			verr.AddViolation("value", "cannot be empty")
+		}
+	case mesh_proto.JsonPatchOpMove, mesh_proto.JsonPatchOpCopy:
+		if !strings.HasPrefix(operation.GetFrom(), "/") {
+			verr.AddViolation("from", "must be a JSON Pointer starting with /")
+		}
+	case mesh_proto.JsonPatchOpRemove:
+	default:
+		verr.AddViolation("op", fmt.Sprintf("invalid operation. Available operations: %q, %q, %q, %q, %q, %q",
+			mesh_proto.JsonPatchOpAdd, mesh_proto.JsonPatchOpRemove, mesh_proto.JsonPatchOpReplace,
+			mesh_proto.JsonPatchOpMove, mesh_proto.JsonPatchOpCopy, mesh_proto.JsonPatchOpTest))
+	}
+	return verr
+}
+
+func isAvailablePatchType(typ string) bool {
+	for _, available := range availablePatchTypes {
+		if typ == available {
+			return true
+		}
+	}
+	return false
+}
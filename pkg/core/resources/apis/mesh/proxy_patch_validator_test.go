@@ -0,0 +1,161 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("ProxyPatch", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(proxyPatchYAML string) {
+				// setup
+				proxyPatch := NewProxyPatchResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(proxyPatchYAML), proxyPatch.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := proxyPatch.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("json patch", `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  patches:
+                  - match:
+                      type: Cluster
+                      name: inbound:192.168.0.1:8080
+                      origin: inbound
+                    jsonPatch:
+                    - op: replace
+                      path: /connectTimeout
+                      value: 10s
+                    - op: remove
+                      path: /circuitBreakers
+                    - op: copy
+                      from: /name
+                      path: /altStatName`,
+			),
+			Entry("merge patch", `
+                selectors:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  patches:
+                  - match:
+                      type: Listener
+                    mergePatch:
+                      perConnectionBufferLimitBytes: 32768`,
+			),
+		)
+
+		type testCase struct {
+			proxyPatch string
+			expected   string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				proxyPatch := NewProxyPatchResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.proxyPatch), proxyPatch.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := proxyPatch.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				proxyPatch: ``,
+				expected: `
+                violations:
+                - field: selectors
+                  message: must have at least one element
+                - field: conf.patches
+                  message: must have at least one element`,
+			}),
+			Entry("invalid match type and no patch", testCase{
+				proxyPatch: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  patches:
+                  - match:
+                      type: VirtualHost`,
+				expected: `
+                violations:
+                - field: conf.patches[0].match.type
+                  message: 'invalid type. Available types: Listener, Cluster, RouteConfiguration, ClusterLoadAssignment'
+                - field: conf.patches[0]
+                  message: either jsonPatch or mergePatch has to be defined`,
+			}),
+			Entry("both json and merge patch", testCase{
+				proxyPatch: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  patches:
+                  - match:
+                      type: Cluster
+                    jsonPatch:
+                    - op: remove
+                      path: /circuitBreakers
+                    mergePatch:
+                      connectTimeout: 10s`,
+				expected: `
+                violations:
+                - field: conf.patches[0]
+                  message: jsonPatch and mergePatch cannot be defined together`,
+			}),
+			Entry("invalid json patch operations", testCase{
+				proxyPatch: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  patches:
+                  - match:
+                      type: Cluster
+                    jsonPatch:
+                    - op: replace
+                      path: connectTimeout
+                    - op: move
+                      path: /altStatName
+                    - op: patch
+                      path: /name`,
+				expected: `
+                violations:
+                - field: conf.patches[0].jsonPatch[0].path
+                  message: must be a JSON Pointer starting with /
+                - field: conf.patches[0].jsonPatch[0].value
+                  message: cannot be empty
+                - field: conf.patches[0].jsonPatch[1].from
+                  message: must be a JSON Pointer starting with /
+                - field: conf.patches[0].jsonPatch[2].op
+                  message: 'invalid operation. Available operations: "add", "remove", "replace", "move", "copy", "test"'`,
+			}),
+		)
+	})
+})
@@ -8,6 +8,7 @@ func (d *TrafficTraceResource) Validate() error {
 	var err validators.ValidationError
 	err.Add(d.validateSelectors())
 	// d.Spec.Conf and d.Spec.Conf.DefaultBackend can be empty, then default backend of the mesh is chosen.
+	err.Add(d.validateSampling())
 	return err.OrNil()
 }
 
@@ -19,3 +20,30 @@ func (d *TrafficTraceResource) validateSelectors() validators.ValidationError {
 		},
 	})
 }
+
+func (d *TrafficTraceResource) validateSampling() validators.ValidationError {
+	var err validators.ValidationError
+	sampling := d.Spec.GetConf().GetSampling()
+	if sampling == nil {
+		return err
+	}
+	path := validators.RootedAt("conf").Field("sampling")
+	for i, service := range sampling.GetServices() {
+		path := path.Field("services").Index(i)
+		if service.GetService() == "" {
+			err.AddViolationAt(path.Field("service"), "cannot be empty")
+		}
+		err.Add(validatePercentage(path, service.GetPercentage()))
+	}
+	for i, route := range sampling.GetRoutes() {
+		path := path.Field("routes").Index(i)
+		if route.GetName() == "" {
+			err.AddViolationAt(path.Field("name"), "cannot be empty")
+		}
+		err.Add(validatePercentage(path, route.GetPercentage()))
+	}
+	if max := sampling.GetMaxPercentage(); max != nil && (max.GetValue() < 0.0 || max.GetValue() > 100.0) {
+		err.AddViolationAt(path.Field("maxPercentage"), "has to be in [0.0 - 100.0] range")
+	}
+	return err
+}
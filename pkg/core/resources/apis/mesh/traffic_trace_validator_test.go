@@ -47,6 +47,21 @@ var _ = Describe("TrafficTrace", func() {
                 - match:
                     region: eu`,
 			),
+			Entry("sampling of services and routes", `
+                selectors:
+                - match:
+                    region: eu
+                conf:
+                  backend: zipkin-eu
+                  sampling:
+                    services:
+                    - service: backend
+                      percentage: 0.1
+                    routes:
+                    - name: checkout
+                      percentage: 100
+                    maxPercentage: 50`,
+			),
 		)
 
 		type testCase struct {
@@ -123,6 +138,33 @@ var _ = Describe("TrafficTrace", func() {
                   message: tag value must be non-empty
                 - field: selectors[1].match
                   message: must have at least one tag
+`,
+			}),
+			Entry("invalid sampling", testCase{
+				trafficTrace: `
+                selectors:
+                - match:
+                    region: eu
+                conf:
+                  sampling:
+                    services:
+                    - percentage: 0.1
+                    routes:
+                    - name: checkout
+                      percentage: 101
+                    - name: catalog
+                    maxPercentage: -1
+`,
+				expected: `
+                violations:
+                - field: conf.sampling.services[0].service
+                  message: cannot be empty
+                - field: conf.sampling.routes[0].percentage
+                  message: has to be in [0.0 - 100.0] range
+                - field: conf.sampling.routes[1].percentage
+                  message: cannot be empty
+                - field: conf.sampling.maxPercentage
+                  message: has to be in [0.0 - 100.0] range
 `,
 			}),
 		)
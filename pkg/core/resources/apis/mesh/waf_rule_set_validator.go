@@ -0,0 +1,82 @@
+package mesh
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// Rule IDs of the WAF rules that the gateway generates to exclude the
+// requests of GatewayRoutes. Rule sets can't use them.
+const (
+	WafRouteExclusionFirstRuleID = 4200000
+	WafRouteExclusionLastRuleID  = 4299999
+)
+
+// maxWafParanoiaLevel is the highest paranoia level of the OWASP Core
+// Rule Set.
+const maxWafParanoiaLevel = 4
+
+// wafRuleIDAction matches the id action of SecLang rules.
+var wafRuleIDAction = regexp.MustCompile(`\bid:'?([0-9]+)`)
+
+// Validate checks WafRuleSetResource semantic constraints.
+func (w *WafRuleSetResource) Validate() error {
+	var err validators.ValidationError
+
+	err.Add(validateWafRuleSetConf(
+		validators.RootedAt("conf"),
+		w.Spec.GetConf(),
+	))
+
+	return err.OrNil()
+}
+
+func validateWafRuleSetConf(path validators.PathBuilder, conf *mesh_proto.WafRuleSet_Conf) validators.ValidationError {
+	var err validators.ValidationError
+
+	if conf.GetCoreRuleSet() == nil && len(conf.GetDirectives()) == 0 {
+		err.AddViolationAt(path, "must enable the core rule set or have directives")
+		return err
+	}
+
+	if crs := conf.GetCoreRuleSet(); crs != nil {
+		if crs.GetParanoiaLevel() > maxWafParanoiaLevel {
+			err.AddViolationAt(path.Field("core_rule_set").Field("paranoia_level"), "must be between 1 and 4")
+		}
+
+		err.Add(validateWafRuleIDs(path.Field("core_rule_set").Field("excluded_rules"), crs.GetExcludedRules()))
+	}
+
+	for i, d := range conf.GetDirectives() {
+		if strings.TrimSpace(d) == "" {
+			err.AddViolationAt(path.Field("directives").Index(i), "cannot be empty")
+			continue
+		}
+
+		for _, m := range wafRuleIDAction.FindAllStringSubmatch(d, -1) {
+			id, e := strconv.ParseUint(m[1], 10, 32)
+			if e == nil && id >= WafRouteExclusionFirstRuleID && id <= WafRouteExclusionLastRuleID {
+				err.AddViolationAt(path.Field("directives").Index(i), "rule IDs from 4200000 to 4299999 are reserved")
+				break
+			}
+		}
+	}
+
+	return err
+}
+
+func validateWafRuleIDs(path validators.PathBuilder, ids []uint32) validators.ValidationError {
+	var err validators.ValidationError
+
+	for i, id := range ids {
+		if id == 0 {
+			err.AddViolationAt(path.Index(i), "must be a rule ID")
+		}
+	}
+
+	return err
+}
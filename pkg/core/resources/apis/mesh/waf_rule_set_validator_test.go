@@ -0,0 +1,115 @@
+package mesh_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// WafRuleSetGenerator is a ResourceGenerator that creates WafRuleSetResource objects.
+type WafRuleSetGenerator func() *WafRuleSetResource
+
+func (g WafRuleSetGenerator) New() model.Resource {
+	if g != nil {
+		return g()
+	}
+
+	return nil
+}
+
+var _ = Describe("WafRuleSet", func() {
+	DescribeValidCases(
+		WafRuleSetGenerator(NewWafRuleSetResource),
+		Entry("core rule set", `
+type: WafRuleSet
+name: owasp
+mesh: default
+conf:
+  core_rule_set:
+    paranoia_level: 2
+    inbound_anomaly_threshold: 10
+    excluded_rules:
+    - 920350`,
+		),
+		Entry("directives", `
+type: WafRuleSet
+name: custom
+mesh: default
+conf:
+  directives:
+  - SecRuleEngine On
+  - SecRule REQUEST_URI "@contains /admin" "id:1001,phase:1,deny,status:403"`,
+		),
+	)
+
+	DescribeErrorCases(
+		WafRuleSetGenerator(NewWafRuleSetResource),
+		ErrorCase("doesn't have any rules",
+			validators.Violation{
+				Field:   "conf",
+				Message: "must enable the core rule set or have directives",
+			}, `
+type: WafRuleSet
+name: owasp
+mesh: default
+conf: {}
+`),
+
+		ErrorCase("has an invalid paranoia level",
+			validators.Violation{
+				Field:   "conf.core_rule_set.paranoia_level",
+				Message: "must be between 1 and 4",
+			}, `
+type: WafRuleSet
+name: owasp
+mesh: default
+conf:
+  core_rule_set:
+    paranoia_level: 5
+`),
+
+		ErrorCase("excludes rule 0",
+			validators.Violation{
+				Field:   "conf.core_rule_set.excluded_rules[0]",
+				Message: "must be a rule ID",
+			}, `
+type: WafRuleSet
+name: owasp
+mesh: default
+conf:
+  core_rule_set:
+    excluded_rules:
+    - 0
+`),
+
+		ErrorCase("has an empty directive",
+			validators.Violation{
+				Field:   "conf.directives[1]",
+				Message: "cannot be empty",
+			}, `
+type: WafRuleSet
+name: custom
+mesh: default
+conf:
+  directives:
+  - SecRuleEngine On
+  - " "
+`),
+
+		ErrorCase("uses a reserved rule ID",
+			validators.Violation{
+				Field:   "conf.directives[0]",
+				Message: "rule IDs from 4200000 to 4299999 are reserved",
+			}, `
+type: WafRuleSet
+name: custom
+mesh: default
+conf:
+  directives:
+  - SecAction "id:4200001,phase:1,pass,nolog"
+`),
+	)
+})
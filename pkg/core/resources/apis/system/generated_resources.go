@@ -302,6 +302,7 @@ var ZoneResourceTypeDescriptor = model.ResourceTypeDescriptor{
 	ReadOnly:       false,
 	AdminOnly:      false,
 	Scope:          model.ScopeGlobal,
+	KDSFlags:       model.FromGlobalToZone,
 	WsPath:         "zones",
 	KumactlArg:     "zone",
 	KumactlListArg: "zones",
@@ -55,13 +55,13 @@ func (r *resourcesManager) Create(ctx context.Context, resource model.Resource,
 	var owner model.Resource
 	if resource.Descriptor().Scope == model.ScopeMesh {
 		owner = core_mesh.NewMeshResource()
-		if err := r.Store.Get(ctx, owner, store.GetByKey(opts.Mesh, model.NoMesh)); err != nil {
+		if err := r.Store.Get(ctx, owner, store.GetByKey(opts.Mesh, model.NoMesh), store.GetConsistent()); err != nil {
 			return MeshNotFound(opts.Mesh)
 		}
 	}
 	if resource.Descriptor().Name == core_mesh.MeshInsightType {
 		owner = core_mesh.NewMeshResource()
-		if err := r.Store.Get(ctx, owner, store.GetByKey(opts.Name, model.NoMesh)); err != nil {
+		if err := r.Store.Get(ctx, owner, store.GetByKey(opts.Name, model.NoMesh), store.GetConsistent()); err != nil {
 			return MeshNotFound(opts.Name)
 		}
 	}
@@ -79,7 +79,7 @@ func (r *resourcesManager) DeleteAll(ctx context.Context, list model.ResourceLis
 
 func DeleteAllResources(manager ResourceManager, ctx context.Context, list model.ResourceList, fs ...store.DeleteAllOptionsFunc) error {
 	opts := store.NewDeleteAllOptions(fs...)
-	if err := manager.List(ctx, list, store.ListByMesh(opts.Mesh)); err != nil {
+	if err := manager.List(ctx, list, store.ListByMesh(opts.Mesh), store.ListConsistent()); err != nil {
 		return err
 	}
 	for _, item := range list.GetItems() {
@@ -126,7 +126,7 @@ func NewUpsertOpts(fs ...UpsertFunc) UpsertOpts {
 func Upsert(manager ResourceManager, key model.ResourceKey, resource model.Resource, fn func(resource model.Resource) error, fs ...UpsertFunc) error {
 	upsert := func() error {
 		create := false
-		err := manager.Get(context.Background(), resource, store.GetBy(key))
+		err := manager.Get(context.Background(), resource, store.GetBy(key), store.GetConsistent())
 		if err != nil {
 			if store.IsResourceNotFound(err) {
 				create = true
@@ -117,6 +117,9 @@ type GetOptions struct {
 	Name    string
 	Mesh    string
 	Version string
+	// Consistent is true when the result has to reflect all previous writes,
+	// i.e. it cannot be served by a read replica that is behind the primary
+	Consistent bool
 }
 
 type GetOptionsFunc func(*GetOptions)
@@ -146,6 +149,13 @@ func GetByVersion(version string) GetOptionsFunc {
 	}
 }
 
+// GetConsistent forces a read that reflects all previous writes, for example when the resource is updated afterwards.
+func GetConsistent() GetOptionsFunc {
+	return func(opts *GetOptions) {
+		opts.Consistent = true
+	}
+}
+
 func (g *GetOptions) HashCode() string {
 	return fmt.Sprintf("%s:%s", g.Name, g.Mesh)
 }
@@ -157,6 +167,9 @@ type ListOptions struct {
 	PageSize   int
 	PageOffset string
 	FilterFunc ListFilterFunc
+	// Consistent is true when the result has to reflect all previous writes,
+	// i.e. it cannot be served by a read replica that is behind the primary
+	Consistent bool
 }
 
 type ListOptionsFunc func(*ListOptions)
@@ -191,6 +204,13 @@ func ListByPage(size int, offset string) ListOptionsFunc {
 	}
 }
 
+// ListConsistent forces a read that reflects all previous writes.
+func ListConsistent() ListOptionsFunc {
+	return func(opts *ListOptions) {
+		opts.Consistent = true
+	}
+}
+
 func (l *ListOptions) HashCode() string {
 	return l.Mesh
 }
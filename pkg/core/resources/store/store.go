@@ -142,6 +142,10 @@ func IsResourceNotFound(err error) bool {
 	return err != nil && strings.HasPrefix(err.Error(), "Resource not found")
 }
 
+func IsResourceAlreadyExists(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "Resource already exists")
+}
+
 func IsResourcePreconditionFailed(err error) bool {
 	return err != nil && strings.HasPrefix(err.Error(), "Resource precondition failed")
 }
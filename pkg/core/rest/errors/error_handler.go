@@ -38,6 +38,10 @@ func HandleError(response *restful.Response, err error, title string) {
 		var accessErr *access.AccessDeniedError
 		errors.As(err, &accessErr)
 		handleAccessDenied(accessErr, response)
+	case errors.Is(err, &RateLimited{}):
+		var rateLimited *RateLimited
+		errors.As(err, &rateLimited)
+		handleRateLimited(rateLimited, title, response)
 	case errors.Is(err, &Unauthenticated{}):
 		var unauthenticated *Unauthenticated
 		errors.As(err, &err)
@@ -166,6 +170,15 @@ func handleUnauthenticated(err *Unauthenticated, title string, response *restful
 	writeError(response, 401, kumaErr)
 }
 
+func handleRateLimited(err *RateLimited, title string, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: err.Error(),
+	}
+	response.AddHeader("Retry-After", "1")
+	writeError(response, 429, kumaErr)
+}
+
 func writeError(response *restful.Response, httpStatus int, kumaErr types.Error) {
 	if err := response.WriteHeaderAndJson(httpStatus, kumaErr, "application/json"); err != nil {
 		core.Log.Error(err, "Could not write the error response")
@@ -1,8 +1,24 @@
 package errors
 
+import "reflect"
+
 type Unauthenticated struct {
 }
 
 func (u *Unauthenticated) Error() string {
 	return "Unauthenticated"
 }
+
+// RateLimited is returned when a request exceeds a rate limit of the API Server.
+type RateLimited struct {
+	// Limit that was exceeded, ex. "client" or "endpoint"
+	Limit string
+}
+
+func (r *RateLimited) Error() string {
+	return "rate limit of the " + r.Limit + " exceeded"
+}
+
+func (r *RateLimited) Is(err error) bool {
+	return reflect.TypeOf(r) == reflect.TypeOf(err)
+}
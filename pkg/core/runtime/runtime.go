@@ -59,6 +59,7 @@ type RuntimeContext interface {
 	Metrics() metrics.Metrics
 	EventReaderFactory() events.ListenerFactory
 	APIInstaller() api_server.APIInstaller
+	APIManager() api_server.APIManager
 	XDSHooks() *xds_hooks.Hooks
 	CAProvider() secrets.CaProvider
 	DpServer() *dp_server.DpServer
@@ -127,7 +128,7 @@ type runtimeContext struct {
 	eac      admin.EnvoyAdminClient
 	metrics  metrics.Metrics
 	erf      events.ListenerFactory
-	apim     api_server.APIInstaller
+	apim     api_server.APIManager
 	xdsh     *xds_hooks.Hooks
 	cap      secrets.CaProvider
 	dps      *dp_server.DpServer
@@ -205,6 +206,11 @@ func (rc *runtimeContext) EnvoyAdminClient() admin.EnvoyAdminClient {
 func (rc *runtimeContext) APIInstaller() api_server.APIInstaller {
 	return rc.apim
 }
+
+func (rc *runtimeContext) APIManager() api_server.APIManager {
+	return rc.apim
+}
+
 func (rc *runtimeContext) DpServer() *dp_server.DpServer {
 	return rc.dps
 }
@@ -0,0 +1,11 @@
+package external_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestExternalSecrets(t *testing.T) {
+	test.RunSpecs(t, "External Secrets Suite")
+}
@@ -0,0 +1,212 @@
+package external_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	external_secrets "github.com/kumahq/kuma/pkg/config/external-secrets"
+	"github.com/kumahq/kuma/pkg/core/secrets/external"
+)
+
+var _ = Describe("Vault provider", func() {
+
+	var server *httptest.Server
+	var requests []*http.Request
+
+	BeforeEach(func() {
+		requests = nil
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1/kv/data/kuma/gateway", func(w http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			_, _ = w.Write([]byte(`{"data": {"data": {"tls.pem": "certificate", "port": 8443}, "metadata": {"version": 3}}}`))
+		})
+		mux.HandleFunc("/v1/kv/data/kuma/missing", func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": []}`))
+		})
+		mux.HandleFunc("/v1/kv/data/kuma/forbidden", func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+		})
+		server = httptest.NewServer(mux)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should fetch the value of the key", func() {
+		// given
+		provider, err := external.NewVaultProvider(http.DefaultClient, &external_secrets.VaultConfig{
+			Address:   server.URL,
+			Token:     "s.token",
+			Namespace: "mesh",
+			Mount:     "kv",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		value, err := provider.Fetch(context.Background(), "kuma/gateway", "tls.pem")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal([]byte("certificate")))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Header.Get("X-Vault-Token")).To(Equal("s.token"))
+		Expect(requests[0].Header.Get("X-Vault-Namespace")).To(Equal("mesh"))
+	})
+
+	It("should read the token from the file on every fetch", func() {
+		// given
+		dir, err := ioutil.TempDir("", "vault")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		tokenFile := filepath.Join(dir, "token")
+		Expect(ioutil.WriteFile(tokenFile, []byte("s.first\n"), os.ModePerm)).To(Succeed())
+		provider, err := external.NewVaultProvider(http.DefaultClient, &external_secrets.VaultConfig{
+			Address:   server.URL,
+			TokenFile: tokenFile,
+			Mount:     "kv",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = provider.Fetch(context.Background(), "kuma/gateway", "tls.pem")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.WriteFile(tokenFile, []byte("s.second\n"), os.ModePerm)).To(Succeed())
+		_, err = provider.Fetch(context.Background(), "kuma/gateway", "tls.pem")
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(requests[0].Header.Get("X-Vault-Token")).To(Equal("s.first"))
+		Expect(requests[1].Header.Get("X-Vault-Token")).To(Equal("s.second"))
+	})
+
+	It("should encode values that are not strings", func() {
+		// given
+		provider, err := external.NewVaultProvider(http.DefaultClient, &external_secrets.VaultConfig{
+			Address: server.URL,
+			Token:   "s.token",
+			Mount:   "kv",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		value, err := provider.Fetch(context.Background(), "kuma/gateway", "port")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal([]byte("8443")))
+	})
+
+	It("should fail when the secret cannot be fetched", func() {
+		// given
+		provider, err := external.NewVaultProvider(http.DefaultClient, &external_secrets.VaultConfig{
+			Address: server.URL,
+			Token:   "s.token",
+			Mount:   "kv",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, errMissing := provider.Fetch(context.Background(), "kuma/missing", "tls.pem")
+		_, errForbidden := provider.Fetch(context.Background(), "kuma/forbidden", "tls.pem")
+		_, errKey := provider.Fetch(context.Background(), "kuma/gateway", "tls.key")
+
+		// then
+		Expect(errMissing).To(MatchError(`Vault responded with status code 404: {"errors": []}`))
+		Expect(errForbidden).To(MatchError("Vault responded with status code 403: permission denied"))
+		Expect(errKey).To(MatchError(`secret has no key "tls.key"`))
+	})
+})
+
+var _ = Describe("AWS Secrets Manager provider", func() {
+
+	var server *httptest.Server
+	var requests []*http.Request
+	var secretIDs []string
+
+	BeforeEach(func() {
+		requests = nil
+		secretIDs = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			in := struct{ SecretId string }{}
+			Expect(json.NewDecoder(req.Body).Decode(&in)).To(Succeed())
+			secretIDs = append(secretIDs, in.SecretId)
+
+			switch in.SecretId {
+			case "kuma/gateway":
+				_, _ = w.Write([]byte(`{"Name": "kuma/gateway", "SecretString": "{\"tls.pem\": \"certificate\"}"}`))
+			case "kuma/binary":
+				_, _ = w.Write([]byte(`{"Name": "kuma/binary", "SecretBinary": "YmluYXJ5"}`))
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"__type": "ResourceNotFoundException", "message": "Secrets Manager can't find the specified secret."}`))
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newProvider := func() external.Provider {
+		provider, err := external.NewAwsSecretsManagerProvider(http.DefaultClient, &external_secrets.AwsSecretsManagerConfig{
+			Region:          "eu-central-1",
+			AccessKeyID:     "AKIASECRETS",
+			SecretAccessKey: "secret",
+			Endpoint:        server.URL,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return provider
+	}
+
+	It("should fetch a signed secret value", func() {
+		// when
+		value, err := newProvider().Fetch(context.Background(), "kuma/gateway", "")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal([]byte(`{"tls.pem": "certificate"}`)))
+		Expect(secretIDs).To(Equal([]string{"kuma/gateway"}))
+		Expect(requests[0].Header.Get("X-Amz-Target")).To(Equal("secretsmanager.GetSecretValue"))
+		Expect(strings.HasPrefix(requests[0].Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIASECRETS/")).To(BeTrue())
+		Expect(requests[0].Header.Get("Authorization")).To(ContainSubstring("/eu-central-1/secretsmanager/aws4_request"))
+	})
+
+	It("should pick the key of a JSON secret", func() {
+		// when
+		value, err := newProvider().Fetch(context.Background(), "kuma/gateway", "tls.pem")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal([]byte("certificate")))
+	})
+
+	It("should fetch a binary secret value", func() {
+		// when
+		value, err := newProvider().Fetch(context.Background(), "kuma/binary", "")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal([]byte("binary")))
+	})
+
+	It("should fail when the secret doesn't exist", func() {
+		// when
+		_, err := newProvider().Fetch(context.Background(), "kuma/missing", "")
+
+		// then
+		Expect(err).To(MatchError("GetSecretValue failed: ResourceNotFoundException: Secrets Manager can't find the specified secret."))
+	})
+})
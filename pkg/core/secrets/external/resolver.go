@@ -0,0 +1,176 @@
+package external
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	external_secrets "github.com/kumahq/kuma/pkg/config/external-secrets"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+var log = core.Log.WithName("secrets").WithName("external")
+
+const (
+	ProviderVault             = "vault"
+	ProviderAwsSecretsManager = "awsSecretsManager"
+)
+
+// Provider fetches values from an external secret manager.
+type Provider interface {
+	Fetch(ctx context.Context, path string, key string) ([]byte, error)
+}
+
+// Resolver resolves the values of secrets that reference external secret managers.
+type Resolver interface {
+	Resolve(ctx context.Context, ref *system_proto.Secret_External) ([]byte, error)
+}
+
+type cacheKey struct {
+	provider string
+	path     string
+	key      string
+}
+
+type cacheEntry struct {
+	value []byte
+	// used is set when the value is resolved, values that are not used between two refreshes are evicted
+	used bool
+}
+
+// CachingResolver is a Resolver that caches the values and periodically fetches them again, so rotated values are picked up
+// without fetching them every time the configuration of the data plane proxies is generated.
+// When a value cannot be fetched again, the cached value is used until the next refresh.
+type CachingResolver struct {
+	providers       map[string]Provider
+	refreshInterval time.Duration
+
+	sync.Mutex
+	cache map[cacheKey]*cacheEntry
+}
+
+var _ Resolver = &CachingResolver{}
+var _ component.Component = &CachingResolver{}
+
+// NewResolver creates a CachingResolver of the secret managers that are enabled in the configuration.
+func NewResolver(cfg *external_secrets.ExternalSecretsConfig) (*CachingResolver, error) {
+	providers := map[string]Provider{}
+	client := &http.Client{Timeout: 10 * time.Second}
+	if cfg.Vault.Enabled {
+		vault, err := NewVaultProvider(client, cfg.Vault)
+		if err != nil {
+			return nil, err
+		}
+		providers[ProviderVault] = vault
+	}
+	if cfg.AwsSecretsManager.Enabled {
+		awsSecretsManager, err := NewAwsSecretsManagerProvider(client, cfg.AwsSecretsManager)
+		if err != nil {
+			return nil, err
+		}
+		providers[ProviderAwsSecretsManager] = awsSecretsManager
+	}
+	return NewCachingResolver(providers, cfg.RefreshInterval), nil
+}
+
+func NewCachingResolver(providers map[string]Provider, refreshInterval time.Duration) *CachingResolver {
+	return &CachingResolver{
+		providers:       providers,
+		refreshInterval: refreshInterval,
+		cache:           map[cacheKey]*cacheEntry{},
+	}
+}
+
+func (r *CachingResolver) Resolve(ctx context.Context, ref *system_proto.Secret_External) ([]byte, error) {
+	key := cacheKey{provider: ref.GetProvider(), path: ref.GetPath(), key: ref.GetKey()}
+
+	r.Lock()
+	if entry, ok := r.cache[key]; ok {
+		entry.used = true
+		r.Unlock()
+		return entry.value, nil
+	}
+	r.Unlock()
+
+	value, err := r.fetch(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Lock()
+	r.cache[key] = &cacheEntry{value: value, used: true}
+	r.Unlock()
+
+	return value, nil
+}
+
+// Refresh fetches the cached values again and evicts the values that were not resolved since the previous refresh.
+func (r *CachingResolver) Refresh(ctx context.Context) {
+	r.Lock()
+	var keys []cacheKey
+	for key, entry := range r.cache {
+		if !entry.used {
+			delete(r.cache, key)
+			continue
+		}
+		entry.used = false
+		keys = append(keys, key)
+	}
+	r.Unlock()
+
+	for _, key := range keys {
+		value, err := r.fetch(ctx, key)
+		if err != nil {
+			log.Error(err, "unable to refresh the value of the external secret, the cached value is used",
+				"provider", key.provider, "path", key.path, "key", key.key)
+			continue
+		}
+
+		r.Lock()
+		if entry, ok := r.cache[key]; ok {
+			entry.value = value
+		}
+		r.Unlock()
+	}
+}
+
+func (r *CachingResolver) fetch(ctx context.Context, key cacheKey) ([]byte, error) {
+	provider, ok := r.providers[key.provider]
+	if !ok {
+		return nil, errors.Errorf("external secret manager %q is not enabled", key.provider)
+	}
+	value, err := provider.Fetch(ctx, key.path, key.key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not fetch %q from %s", key.path, key.provider)
+	}
+	return value, nil
+}
+
+func (r *CachingResolver) NeedLeaderElection() bool {
+	return false
+}
+
+func (r *CachingResolver) Start(stop <-chan struct{}) error {
+	if len(r.providers) == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(r.refreshInterval)
+	defer ticker.Stop()
+
+	log.Info("starting the refresh of external secrets", "interval", r.refreshInterval)
+	for {
+		select {
+		case <-ticker.C:
+			r.Refresh(context.Background())
+		case <-stop:
+			log.Info("stopping the refresh of external secrets")
+			return nil
+		}
+	}
+}
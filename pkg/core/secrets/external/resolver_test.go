@@ -0,0 +1,125 @@
+package external_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/secrets/external"
+)
+
+type fakeProvider struct {
+	values  map[string]string
+	err     error
+	fetches int
+}
+
+func (f *fakeProvider) Fetch(_ context.Context, path string, key string) ([]byte, error) {
+	f.fetches++
+	if f.err != nil {
+		return nil, f.err
+	}
+	value, ok := f.values[path+"#"+key]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return []byte(value), nil
+}
+
+var _ = Describe("Caching Resolver", func() {
+
+	var provider *fakeProvider
+	var resolver *external.CachingResolver
+
+	ref := &system_proto.Secret_External{
+		Provider: external.ProviderVault,
+		Path:     "kuma/gateway",
+		Key:      "tls.pem",
+	}
+
+	BeforeEach(func() {
+		provider = &fakeProvider{
+			values: map[string]string{
+				"kuma/gateway#tls.pem": "v1",
+			},
+		}
+		resolver = external.NewCachingResolver(map[string]external.Provider{
+			external.ProviderVault: provider,
+		}, time.Minute)
+	})
+
+	It("should fetch the value once", func() {
+		// when
+		first, err := resolver.Resolve(context.Background(), ref)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := resolver.Resolve(context.Background(), ref)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(first).To(Equal([]byte("v1")))
+		Expect(second).To(Equal([]byte("v1")))
+		Expect(provider.fetches).To(Equal(1))
+	})
+
+	It("should pick up rotated values on refresh", func() {
+		// given
+		_, err := resolver.Resolve(context.Background(), ref)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		provider.values["kuma/gateway#tls.pem"] = "v2"
+		resolver.Refresh(context.Background())
+
+		// then
+		value, err := resolver.Resolve(context.Background(), ref)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal([]byte("v2")))
+		Expect(provider.fetches).To(Equal(2))
+	})
+
+	It("should keep the cached value when it cannot be refreshed", func() {
+		// given
+		_, err := resolver.Resolve(context.Background(), ref)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		provider.err = errors.New("unavailable")
+		resolver.Refresh(context.Background())
+
+		// then
+		value, err := resolver.Resolve(context.Background(), ref)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal([]byte("v1")))
+	})
+
+	It("should evict values that are not resolved between refreshes", func() {
+		// given
+		_, err := resolver.Resolve(context.Background(), ref)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resolver.Refresh(context.Background())
+		resolver.Refresh(context.Background())
+
+		// then the value was refreshed once and then evicted
+		Expect(provider.fetches).To(Equal(2))
+		_, err = resolver.Resolve(context.Background(), ref)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provider.fetches).To(Equal(3))
+	})
+
+	It("should fail when the provider is not enabled", func() {
+		// when
+		_, err := resolver.Resolve(context.Background(), &system_proto.Secret_External{
+			Provider: external.ProviderAwsSecretsManager,
+			Path:     "kuma/gateway",
+		})
+
+		// then
+		Expect(err).To(MatchError(`external secret manager "awsSecretsManager" is not enabled`))
+	})
+})
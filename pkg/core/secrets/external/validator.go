@@ -0,0 +1,29 @@
+package external
+
+import (
+	"fmt"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// Validate checks the reference to an external value. It doesn't check whether the secret manager is enabled,
+// because secrets are synced to Zone Control Planes, which resolve them with their own configuration.
+func Validate(ref *system_proto.Secret_External) validators.ValidationError {
+	verr := validators.ValidationError{}
+	switch ref.GetProvider() {
+	case "":
+		verr.AddViolation("provider", "cannot be empty")
+	case ProviderVault:
+		if ref.GetKey() == "" {
+			verr.AddViolation("key", "cannot be empty for Vault secrets")
+		}
+	case ProviderAwsSecretsManager:
+	default:
+		verr.AddViolation("provider", fmt.Sprintf("must be one of %q, %q", ProviderVault, ProviderAwsSecretsManager))
+	}
+	if ref.GetPath() == "" {
+		verr.AddViolation("path", "cannot be empty")
+	}
+	return verr
+}
@@ -0,0 +1,116 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	external_secrets "github.com/kumahq/kuma/pkg/config/external-secrets"
+)
+
+// vaultProvider fetches values from the KV version 2 secrets engine of HashiCorp Vault.
+type vaultProvider struct {
+	client    *http.Client
+	address   *url.URL
+	token     string
+	tokenFile string
+	namespace string
+	mount     string
+}
+
+// vaultSecret is the response of the /v1/:mount/data/:path endpoint of the Vault HTTP API
+type vaultSecret struct {
+	Data struct {
+		Data map[string]interface{}
+	}
+}
+
+type vaultErrors struct {
+	Errors []string
+}
+
+func NewVaultProvider(client *http.Client, cfg *external_secrets.VaultConfig) (Provider, error) {
+	address, err := url.Parse(cfg.Address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid address of Vault %q", cfg.Address)
+	}
+	return &vaultProvider{
+		client:    client,
+		address:   address,
+		token:     cfg.Token,
+		tokenFile: cfg.TokenFile,
+		namespace: cfg.Namespace,
+		mount:     cfg.Mount,
+	}, nil
+}
+
+func (v *vaultProvider) Fetch(ctx context.Context, secretPath string, key string) ([]byte, error) {
+	if key == "" {
+		return nil, errors.New("key of the value in the Vault secret is required")
+	}
+
+	token, err := v.currentToken()
+	if err != nil {
+		return nil, err
+	}
+
+	u := *v.address
+	u.Path = path.Join(u.Path, "v1", v.mount, "data", secretPath)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		vaultErr := vaultErrors{}
+		if err := json.Unmarshal(body, &vaultErr); err != nil || len(vaultErr.Errors) == 0 {
+			return nil, errors.Errorf("Vault responded with status code %d: %s", resp.StatusCode, string(body))
+		}
+		return nil, errors.Errorf("Vault responded with status code %d: %s", resp.StatusCode, strings.Join(vaultErr.Errors, ", "))
+	}
+
+	secret := vaultSecret{}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return nil, errors.Wrap(err, "could not parse the secret")
+	}
+	value, ok := secret.Data.Data[key]
+	if !ok {
+		return nil, errors.Errorf("secret has no key %q", key)
+	}
+	// Values are usually strings, other JSON values are used as they are encoded.
+	if s, ok := value.(string); ok {
+		return []byte(s), nil
+	}
+	return json.Marshal(value)
+}
+
+// currentToken reads the token file every time, so the token can be rotated without restarting the Control Plane.
+func (v *vaultProvider) currentToken() (string, error) {
+	if v.tokenFile == "" {
+		return v.token, nil
+	}
+	token, err := ioutil.ReadFile(v.tokenFile)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read the Vault token file")
+	}
+	return strings.TrimSpace(string(token)), nil
+}
@@ -62,6 +62,9 @@ func (s *secretManager) Create(ctx context.Context, resource model.Resource, fs
 	if !ok {
 		return newInvalidTypeError()
 	}
+	if err := ValidateSecret(secret.Spec); err != nil {
+		return err
+	}
 	if err := s.encrypt(secret); err != nil {
 		return err
 	}
@@ -76,6 +79,9 @@ func (s *secretManager) Update(ctx context.Context, resource model.Resource, fs
 	if !ok {
 		return newInvalidTypeError()
 	}
+	if err := ValidateSecret(secret.Spec); err != nil {
+		return err
+	}
 	if err := s.encrypt(secret); err != nil {
 		return err
 	}
@@ -7,11 +7,13 @@ import (
 	"github.com/pkg/errors"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/ca"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/secrets/external"
 	"github.com/kumahq/kuma/pkg/core/validators"
 )
 
@@ -27,6 +29,19 @@ func (f ValidateDelete) ValidateDelete(ctx context.Context, secretName string, s
 	return f(ctx, secretName, secretMesh)
 }
 
+// ValidateSecret checks that a secret that references an external value doesn't hold the data.
+func ValidateSecret(spec *system_proto.Secret) error {
+	var verr validators.ValidationError
+	if spec.GetExternal() == nil {
+		return nil
+	}
+	if len(spec.GetData().GetValue()) > 0 {
+		verr.AddViolation("data", "must be empty when the secret references an external value")
+	}
+	verr.AddError("external", external.Validate(spec.GetExternal()))
+	return verr.OrNil()
+}
+
 func NewSecretValidator(caManagers ca.Managers, store core_store.ResourceStore) SecretValidator {
 	return &secretValidator{
 		caManagers: caManagers,
@@ -41,8 +41,8 @@ var _ = Describe("Secret Validator", func() {
 		validator = secrets_manager.NewSecretValidator(caManagers, memoryStore)
 		secManager := secrets_manager.NewSecretManager(secrets_store.NewSecretStore(memoryStore), cipher.None(), validator)
 
-		caManagers["builtin"] = ca_builtin.NewBuiltinCaManager(secManager)
-		caManagers["provided"] = ca_provided.NewProvidedCaManager(core_datasource.NewDataSourceLoader(secManager))
+		caManagers["builtin"] = ca_builtin.NewBuiltinCaManager(secManager, core_datasource.NewDataSourceLoader(secManager, nil), "")
+		caManagers["provided"] = ca_provided.NewProvidedCaManager(core_datasource.NewDataSourceLoader(secManager, nil))
 	})
 
 	type testCase struct {
@@ -25,6 +25,7 @@ const (
 	fieldDataplaneDataplaneResource = "dataplane.resource"
 	fieldDynamicMetadata            = "dynamicMetadata"
 	fieldDataplaneProxyType         = "dataplane.proxyType"
+	fieldDataplaneSecretsDir        = "dataplane.secrets.dir"
 	fieldVersion                    = "version"
 )
 
@@ -51,6 +52,7 @@ type DataplaneMetadata struct {
 	DynamicMetadata map[string]string
 	ProxyType       mesh_proto.ProxyType
 	Version         *mesh_proto.Version
+	SecretsDir      string
 }
 
 func (m *DataplaneMetadata) GetDataplaneToken() string {
@@ -119,6 +121,15 @@ func (m *DataplaneMetadata) GetDynamicMetadata(key string) string {
 	return m.DynamicMetadata[key]
 }
 
+// GetSecretsDir returns a directory in which kuma-dp delivers the secrets as files.
+// It is empty when the secrets are delivered over the xDS stream.
+func (m *DataplaneMetadata) GetSecretsDir() string {
+	if m == nil {
+		return ""
+	}
+	return m.SecretsDir
+}
+
 func (m *DataplaneMetadata) GetVersion() *mesh_proto.Version {
 	if m == nil {
 		return nil
@@ -137,6 +148,9 @@ func DataplaneMetadataFromXdsMetadata(xdsMetadata *structpb.Struct) *DataplaneMe
 	if field := xdsMetadata.Fields[fieldDataplaneProxyType]; field != nil {
 		metadata.ProxyType = mesh_proto.ProxyType(field.GetStringValue())
 	}
+	if field := xdsMetadata.Fields[fieldDataplaneSecretsDir]; field != nil {
+		metadata.SecretsDir = field.GetStringValue()
+	}
 	metadata.AdminPort = uint32Metadata(xdsMetadata, fieldDataplaneAdminPort)
 	metadata.DNSPort = uint32Metadata(xdsMetadata, fieldDataplaneDNSPort)
 	metadata.EmptyDNSPort = uint32Metadata(xdsMetadata, fieldDataplaneDNSEmptyPort)
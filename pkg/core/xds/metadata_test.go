@@ -48,12 +48,18 @@ var _ = Describe("DataplaneMetadataFromXdsMetadata", func() {
 							StringValue: "8001",
 						},
 					},
+					"dataplane.secrets.dir": {
+						Kind: &structpb.Value_StringValue{
+							StringValue: "/var/run/kuma-dp/secrets",
+						},
+					},
 				},
 			},
 			expected: xds.DataplaneMetadata{
 				AdminPort:    1234,
 				DNSPort:      8000,
 				EmptyDNSPort: 8001,
+				SecretsDir:   "/var/run/kuma-dp/secrets",
 			},
 		}),
 	)
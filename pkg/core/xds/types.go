@@ -89,6 +89,9 @@ type HealthCheckMap map[ServiceName]*core_mesh.HealthCheckResource
 // CircuitBreakerMap holds the most specific CircuitBreaker for each reachable service.
 type CircuitBreakerMap map[ServiceName]*core_mesh.CircuitBreakerResource
 
+// ConnectionPoolMap holds the most specific ConnectionPool for each reachable service.
+type ConnectionPoolMap map[ServiceName]*core_mesh.ConnectionPoolResource
+
 // RetryMap holds the most specific Retry for each reachable service.
 type RetryMap map[ServiceName]*core_mesh.RetryResource
 
@@ -152,12 +155,23 @@ type MatchedPolicies struct {
 	Logs               LogMap
 	HealthChecks       HealthCheckMap
 	CircuitBreakers    CircuitBreakerMap
+	ConnectionPools    ConnectionPoolMap
 	Retries            RetryMap
 	TrafficTrace       *core_mesh.TrafficTraceResource
 	TracingBackend     *mesh_proto.TracingBackend
 	FaultInjections    FaultInjectionMap
 	Timeouts           TimeoutMap
 	RateLimits         RateLimitsMap
+	ProxyPatches       []*core_mesh.ProxyPatchResource
+}
+
+// TracingSampling returns the sampling of services and routes of the
+// matched TrafficTrace or nil if the traffic is not traced.
+func (p MatchedPolicies) TracingSampling() *mesh_proto.TrafficTrace_Conf_Sampling {
+	if p.TracingBackend == nil || p.TrafficTrace == nil {
+		return nil
+	}
+	return p.TrafficTrace.Spec.GetConf().GetSampling()
 }
 
 type CaSecret struct {
@@ -81,6 +81,16 @@ func (d *defaultsComponent) Start(stop <-chan struct{}) error {
 		}
 	}()
 
+	if d.cpMode == config_core.Global {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := doWithRetry(ctx, d.createZoneEnrollmentSigningKeyIfNotExist); err != nil {
+				errChan <- errors.Wrap(err, "could not create the Zone Enrollment Token's Signing Key")
+			}
+		}()
+	}
+
 	done := make(chan struct{})
 	go func() {
 		wg.Wait()
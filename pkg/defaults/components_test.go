@@ -152,4 +152,44 @@ var _ = Describe("Defaults Component", func() {
 			Expect(actual.Spec.GetData().GetValue()).To(Equal([]byte("hello")))
 		})
 	})
+
+	Describe("zone enrollment signing key creation", func() {
+
+		var manager core_manager.ResourceManager
+		var store core_store.ResourceStore
+
+		BeforeEach(func() {
+			store = resources_memory.NewStore()
+			defaultManager := core_manager.NewResourceManager(store)
+			customManagers := map[core_model.ResourceType]core_manager.ResourceManager{}
+			customManagers[system.GlobalSecretType] = secret_manager.NewGlobalSecretManager(store, cipher.None())
+			manager = core_manager.NewCustomizableResourceManager(defaultManager, customManagers)
+		})
+
+		It("should create zone enrollment signing key on Global CP", func() {
+			// given
+			component := defaults.NewDefaultsComponent(&kuma_cp.Defaults{}, core.Global, core.UniversalEnvironment, manager, store)
+
+			// when
+			err := component.Start(nil)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			err = manager.Get(context.Background(), system.NewGlobalSecretResource(), core_store.GetByKey("zone-enrollment-token-signing-key", core_model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not create zone enrollment signing key outside of Global CP", func() {
+			// given
+			component := defaults.NewDefaultsComponent(&kuma_cp.Defaults{}, core.Standalone, core.UniversalEnvironment, manager, store)
+
+			// when
+			err := component.Start(nil)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			err = manager.Get(context.Background(), system.NewGlobalSecretResource(), core_store.GetByKey("zone-enrollment-token-signing-key", core_model.NoMesh))
+			Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+		})
+	})
 })
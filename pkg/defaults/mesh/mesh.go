@@ -1,12 +1,16 @@
 package mesh
 
 import (
+	"context"
 	"sync"
 
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
@@ -19,62 +23,109 @@ var log = core.Log.WithName("defaults").WithName("mesh")
 // 2 invocation can check that TrafficPermission is absent, but it was just created, so it tries to created it which results in error
 var ensureMux = sync.Mutex{}
 
-func EnsureDefaultMeshResources(resManager manager.ResourceManager, meshName string) error {
+// EnsureDefaultMeshResources creates the default resources of the Mesh. The default policies whose types are
+// in skippedPolicies, or all of them when it contains "*", are not created. The policies rendered from the templates
+// are created in addition to the default ones, and are skipped the same way.
+func EnsureDefaultMeshResources(resManager manager.ResourceManager, meshName string, skippedPolicies []string, templates PolicyTemplates) error {
 	ensureMux.Lock()
 	defer ensureMux.Unlock()
 	log.Info("ensuring default resources for Mesh exist", "mesh", meshName)
 
-	err, created := ensureDefaultTrafficPermission(resManager, meshName)
-	if err != nil {
-		return errors.Wrap(err, "could not create default TrafficPermission")
-	}
-	if created {
-		log.Info("default TrafficPermission created", "mesh", meshName, "name", defaultTrafficPermissionKey(meshName).Name)
+	skipped := skippedPolicyTypes(skippedPolicies)
+
+	if skipped(core_mesh.TrafficPermissionType) {
+		log.Info("default TrafficPermission skipped", "mesh", meshName)
 	} else {
-		log.Info("default TrafficPermission already exist", "mesh", meshName, "name", defaultTrafficPermissionKey(meshName).Name)
+		err, created := ensureDefaultTrafficPermission(resManager, meshName)
+		if err != nil {
+			return errors.Wrap(err, "could not create default TrafficPermission")
+		}
+		if created {
+			log.Info("default TrafficPermission created", "mesh", meshName, "name", defaultTrafficPermissionKey(meshName).Name)
+		} else {
+			log.Info("default TrafficPermission already exist", "mesh", meshName, "name", defaultTrafficPermissionKey(meshName).Name)
+		}
 	}
 
-	err, created = ensureDefaultTrafficRoute(resManager, meshName)
-	if err != nil {
-		return errors.Wrap(err, "could not create default TrafficRoute")
-	}
-	if created {
-		log.Info("default TrafficRoute created", "mesh", meshName, "name", defaultTrafficRouteKey(meshName).Name)
+	if skipped(core_mesh.TrafficRouteType) {
+		log.Info("default TrafficRoute skipped", "mesh", meshName)
 	} else {
-		log.Info("default TrafficRoute already exist", "mesh", meshName, "name", defaultTrafficRouteKey(meshName).Name)
+		err, created := ensureDefaultTrafficRoute(resManager, meshName)
+		if err != nil {
+			return errors.Wrap(err, "could not create default TrafficRoute")
+		}
+		if created {
+			log.Info("default TrafficRoute created", "mesh", meshName, "name", defaultTrafficRouteKey(meshName).Name)
+		} else {
+			log.Info("default TrafficRoute already exist", "mesh", meshName, "name", defaultTrafficRouteKey(meshName).Name)
+		}
 	}
 
-	err, created = ensureDefaultTimeout(resManager, meshName)
-	if err != nil {
-		return errors.Wrap(err, "could not create default Timeout")
-	}
-	if created {
-		log.Info("default Timeout created", "mesh", meshName, "name", defaultTimeoutKey(meshName).Name)
+	if skipped(core_mesh.TimeoutType) {
+		log.Info("default Timeout skipped", "mesh", meshName)
 	} else {
-		log.Info("default Timeout already exist", "mesh", meshName, "name", defaultTimeoutKey(meshName).Name)
+		err, created := ensureDefaultTimeout(resManager, meshName)
+		if err != nil {
+			return errors.Wrap(err, "could not create default Timeout")
+		}
+		if created {
+			log.Info("default Timeout created", "mesh", meshName, "name", defaultTimeoutKey(meshName).Name)
+		} else {
+			log.Info("default Timeout already exist", "mesh", meshName, "name", defaultTimeoutKey(meshName).Name)
+		}
 	}
 
-	err, created = ensureDefaultCircuitBreaker(resManager, meshName)
-	if err != nil {
-		return errors.Wrap(err, "could not create default CircuitBreaker")
+	if skipped(core_mesh.CircuitBreakerType) {
+		log.Info("default CircuitBreaker skipped", "mesh", meshName)
+	} else {
+		err, created := ensureDefaultCircuitBreaker(resManager, meshName)
+		if err != nil {
+			return errors.Wrap(err, "could not create default CircuitBreaker")
+		}
+		if created {
+			log.Info("default CircuitBreaker created", "mesh", meshName, "name", defaultCircuitBreakerKey(meshName).Name)
+		} else {
+			log.Info("default CircuitBreaker already exist", "mesh", meshName, "name", defaultCircuitBreakerKey(meshName).Name)
+		}
 	}
-	if created {
-		log.Info("default CircuitBreaker created", "mesh", meshName, "name", defaultCircuitBreakerKey(meshName).Name)
+
+	if skipped(core_mesh.RetryType) {
+		log.Info("default Retry skipped", "mesh", meshName)
 	} else {
-		log.Info("default CircuitBreaker already exist", "mesh", meshName, "name", defaultCircuitBreakerKey(meshName).Name)
+		err, created := ensureDefaultRetry(resManager, meshName)
+		if err != nil {
+			return errors.Wrap(err, "could not create default Retry")
+		}
+		if created {
+			log.Info("default Retry created", "mesh", meshName, "name", defaultRetryKey(meshName).Name)
+		} else {
+			log.Info("default Retry already exist", "mesh", meshName, "name", defaultRetryKey(meshName).Name)
+		}
 	}
 
-	err, created = ensureDefaultRetry(resManager, meshName)
+	policies, err := templates.Render(meshName)
 	if err != nil {
-		return errors.Wrap(err, "could not create default Retry")
+		return errors.Wrap(err, "could not render policy templates")
 	}
-	if created {
-		log.Info("default Retry created", "mesh", meshName, "name", defaultRetryKey(meshName).Name)
-	} else {
-		log.Info("default Retry already exist", "mesh", meshName, "name", defaultRetryKey(meshName).Name)
+	for _, policy := range policies {
+		resType := policy.Descriptor().Name
+		name := policy.GetMeta().GetName()
+		if skipped(resType) {
+			log.Info("templated policy skipped", "mesh", meshName, "type", resType, "name", name)
+			continue
+		}
+		created, err := ensurePolicy(resManager, policy)
+		if err != nil {
+			return errors.Wrapf(err, "could not create templated %s %q", resType, name)
+		}
+		if created {
+			log.Info("templated policy created", "mesh", meshName, "type", resType, "name", name)
+		} else {
+			log.Info("templated policy already exist", "mesh", meshName, "type", resType, "name", name)
+		}
 	}
 
-	created, err = ensureDataplaneTokenSigningKey(resManager, meshName)
+	created, err := ensureDataplaneTokenSigningKey(resManager, meshName)
 	if err != nil {
 		return errors.Wrap(err, "could not create default Dataplane Token Signing Key")
 	}
@@ -95,3 +146,34 @@ func EnsureDefaultMeshResources(resManager manager.ResourceManager, meshName str
 	}
 	return nil
 }
+
+// skippedPolicyTypes returns a function that tells whether policies of a type are not created with the Mesh.
+func skippedPolicyTypes(skippedPolicies []string) func(model.ResourceType) bool {
+	skipped := map[model.ResourceType]bool{}
+	for _, policy := range skippedPolicies {
+		skipped[model.ResourceType(policy)] = true
+	}
+	return func(resType model.ResourceType) bool {
+		return skipped["*"] || skipped[resType]
+	}
+}
+
+// ensurePolicy creates the policy unless a policy of the same type and name already exists.
+func ensurePolicy(resManager manager.ResourceManager, policy model.Resource) (created bool, err error) {
+	key := model.MetaToResourceKey(policy.GetMeta())
+	err = resManager.Get(context.Background(), policy.Descriptor().NewObject(), store.GetBy(key))
+	if err == nil {
+		return false, nil
+	}
+	if !store.IsResourceNotFound(err) {
+		return false, errors.Wrap(err, "could not retrieve a resource")
+	}
+	res := policy.Descriptor().NewObject()
+	if err := res.SetSpec(policy.GetSpec()); err != nil {
+		return false, err
+	}
+	if err := resManager.Create(context.Background(), res, store.CreateBy(key)); err != nil {
+		return false, errors.Wrap(err, "could not create a resource")
+	}
+	return true, nil
+}
@@ -2,6 +2,7 @@ package mesh_test
 
 import (
 	"context"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -13,6 +14,7 @@ import (
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/defaults/mesh"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/matchers"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
@@ -30,7 +32,7 @@ var _ = Describe("EnsureDefaultMeshResources", func() {
 
 	It("should create default resources", func() {
 		// when
-		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh)
+		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh, nil, nil)
 		Expect(err).ToNot(HaveOccurred())
 
 		// then default TrafficPermission for the mesh exist
@@ -56,11 +58,11 @@ var _ = Describe("EnsureDefaultMeshResources", func() {
 
 	It("should ignore subsequent calls to EnsureDefaultMeshResources", func() {
 		// given already ensured default resources
-		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh)
+		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh, nil, nil)
 		Expect(err).ToNot(HaveOccurred())
 
 		// when ensuring again
-		err = mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh)
+		err = mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh, nil, nil)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -77,4 +79,88 @@ var _ = Describe("EnsureDefaultMeshResources", func() {
 		err = resManager.Get(context.Background(), system.NewSecretResource(), core_store.GetBy(issuer.SigningKeyResourceKey(issuer.EnvoyAdminClientTokenPrefix, model.DefaultMesh)))
 		Expect(err).ToNot(HaveOccurred())
 	})
+
+	It("should describe the policies created by EnsureDefaultMeshResources", func() {
+		// given
+		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, policy := range mesh.DefaultPolicies(model.DefaultMesh) {
+			// when
+			actual := policy.Descriptor().NewObject()
+			err := resManager.Get(context.Background(), actual, core_store.GetBy(model.MetaToResourceKey(policy.GetMeta())))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual.GetSpec()).To(matchers.MatchProto(policy.GetSpec()))
+		}
+	})
+
+	It("should skip creating the given default policies", func() {
+		// when
+		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh, []string{"TrafficPermission", "Timeout"}, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then skipped policies don't exist
+		err = resManager.Get(context.Background(), core_mesh.NewTrafficPermissionResource(), core_store.GetByKey("allow-all-default", model.DefaultMesh))
+		Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+		err = resManager.Get(context.Background(), core_mesh.NewTimeoutResource(), core_store.GetByKey("timeout-all-default", model.DefaultMesh))
+		Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+
+		// and the rest of the policies exist
+		err = resManager.Get(context.Background(), core_mesh.NewTrafficRouteResource(), core_store.GetByKey("route-all-default", model.DefaultMesh))
+		Expect(err).ToNot(HaveOccurred())
+		err = resManager.Get(context.Background(), core_mesh.NewRetryResource(), core_store.GetByKey("retry-all-default", model.DefaultMesh))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should skip creating all the default policies but not the signing keys", func() {
+		// when
+		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh, []string{"*"}, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		for _, policy := range mesh.DefaultPolicies(model.DefaultMesh) {
+			err := resManager.Get(context.Background(), policy.Descriptor().NewObject(), core_store.GetBy(model.MetaToResourceKey(policy.GetMeta())))
+			Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+		}
+
+		// and Dataplane Token Signing Key for the mesh exists
+		err = resManager.Get(context.Background(), system.NewSecretResource(), core_store.GetBy(issuer.SigningKeyResourceKey(issuer.DataplaneTokenPrefix, model.DefaultMesh)))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should create the policies of the templates", func() {
+		// given
+		templates, err := mesh.LoadPolicyTemplates([]string{filepath.Join("testdata", "policies.yaml")})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh, []string{"TrafficPermission", "Timeout"}, templates)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then the templated TrafficRoute exists in the mesh
+		route := core_mesh.NewTrafficRouteResource()
+		err = resManager.Get(context.Background(), route, core_store.GetByKey("route-to-default-gateway", model.DefaultMesh))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(route.Spec.GetConf().GetDestination()).To(Equal(map[string]string{
+			"kuma.io/service": "default-gateway",
+		}))
+
+		// and the templated Timeout is skipped
+		err = resManager.Get(context.Background(), core_mesh.NewTimeoutResource(), core_store.GetByKey("timeout-default", model.DefaultMesh))
+		Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+	})
+
+	It("should not render templates of resources that are not policies of a mesh", func() {
+		// given
+		templates, err := mesh.LoadPolicyTemplates([]string{filepath.Join("testdata", "zone.yaml")})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = templates.Render(model.DefaultMesh)
+
+		// then
+		Expect(err).To(MatchError(`Zone "zone-default" in template "zone.yaml" is not a policy of a mesh`))
+	})
 })
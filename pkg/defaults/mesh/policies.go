@@ -0,0 +1,45 @@
+package mesh
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+)
+
+// DefaultPolicies returns the policies that EnsureDefaultMeshResources creates for a new Mesh,
+// so they can be rendered without access to the control plane.
+func DefaultPolicies(meshName string) []model.Resource {
+	return []model.Resource{
+		&core_mesh.TrafficPermissionResource{
+			Meta: defaultPolicyMeta(core_mesh.TrafficPermissionType, defaultTrafficPermissionKey(meshName)),
+			Spec: proto.Clone(&defaultTrafficPermission).(*mesh_proto.TrafficPermission),
+		},
+		&core_mesh.TrafficRouteResource{
+			Meta: defaultPolicyMeta(core_mesh.TrafficRouteType, defaultTrafficRouteKey(meshName)),
+			Spec: proto.Clone(&defaultTrafficRoute).(*mesh_proto.TrafficRoute),
+		},
+		&core_mesh.TimeoutResource{
+			Meta: defaultPolicyMeta(core_mesh.TimeoutType, defaultTimeoutKey(meshName)),
+			Spec: proto.Clone(&defaultTimeout).(*mesh_proto.Timeout),
+		},
+		&core_mesh.CircuitBreakerResource{
+			Meta: defaultPolicyMeta(core_mesh.CircuitBreakerType, defaultCircuitBreakerKey(meshName)),
+			Spec: proto.Clone(&defaultCircuitBreaker).(*mesh_proto.CircuitBreaker),
+		},
+		&core_mesh.RetryResource{
+			Meta: defaultPolicyMeta(core_mesh.RetryType, defaultRetryKey(meshName)),
+			Spec: proto.Clone(defaultRetry).(*mesh_proto.Retry),
+		},
+	}
+}
+
+func defaultPolicyMeta(resType model.ResourceType, key model.ResourceKey) model.ResourceMeta {
+	return &rest.ResourceMeta{
+		Type: string(resType),
+		Mesh: key.Mesh,
+		Name: key.Name,
+	}
+}
@@ -0,0 +1,67 @@
+package mesh
+
+import (
+	"bytes"
+	"path/filepath"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	util_yaml "github.com/kumahq/kuma/pkg/util/yaml"
+)
+
+// PolicyTemplates are the templates of the organization specific policies that are created for every new Mesh
+// in addition to the default ones. A template is rendered with the name of the Mesh as {{ .Mesh }} into policies
+// in the YAML format of Universal, separated by "---". The mesh of the policies is always the rendered Mesh.
+// On Kubernetes, the names of the policies have to be unique across meshes, so they should contain {{ .Mesh }}.
+type PolicyTemplates []*template.Template
+
+type policyTemplateData struct {
+	Mesh string
+}
+
+// LoadPolicyTemplates parses the templates from the given files.
+func LoadPolicyTemplates(paths []string) (PolicyTemplates, error) {
+	var templates PolicyTemplates
+	for _, path := range paths {
+		tmpl, err := template.New(filepath.Base(path)).Option("missingkey=error").ParseFiles(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse policy template %q", path)
+		}
+		templates = append(templates, tmpl)
+	}
+	return templates, nil
+}
+
+// Render returns the policies of the templates for the Mesh.
+func (t PolicyTemplates) Render(meshName string) ([]model.Resource, error) {
+	var policies []model.Resource
+	for _, tmpl := range t {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, policyTemplateData{Mesh: meshName}); err != nil {
+			return nil, errors.Wrapf(err, "could not render policy template %q", tmpl.Name())
+		}
+		for _, doc := range util_yaml.SplitYAML(buf.String()) {
+			policy, err := rest.UnmarshallToCore([]byte(doc))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid policy in template %q", tmpl.Name())
+			}
+			descriptor := policy.Descriptor()
+			name := policy.GetMeta().GetName()
+			if descriptor.Scope != model.ScopeMesh {
+				return nil, errors.Errorf("%s %q in template %q is not a policy of a mesh", descriptor.Name, name, tmpl.Name())
+			}
+			if name == "" {
+				return nil, errors.Errorf("%s in template %q has no name", descriptor.Name, tmpl.Name())
+			}
+			if err := policy.Validate(); err != nil {
+				return nil, errors.Wrapf(err, "invalid %s %q in template %q", descriptor.Name, name, tmpl.Name())
+			}
+			policy.SetMeta(defaultPolicyMeta(descriptor.Name, model.ResourceKey{Mesh: meshName, Name: name}))
+			policies = append(policies, policy)
+		}
+	}
+	return policies, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneenrollment"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
 )
 
@@ -31,3 +32,26 @@ func (d *defaultsComponent) createZoneIngressSigningKeyIfNotExist(ctx context.Co
 	log.Info("Zone Ingress signing key created")
 	return nil
 }
+
+func (d *defaultsComponent) createZoneEnrollmentSigningKeyIfNotExist(ctx context.Context) error {
+	signingKey, err := zoneenrollment.CreateSigningKey()
+	if err != nil {
+		return errors.Wrap(err, "could not create a Zone Enrollment signing key")
+	}
+	key := zoneenrollment.SigningKeyResourceKey()
+	err = d.resManager.Get(ctx, signingKey, core_store.GetBy(key))
+	if err == nil {
+		log.V(1).Info("Zone Enrollment signing key already exists. Skip creating zone enrollment signing key.")
+		return nil
+	}
+	if !core_store.IsResourceNotFound(err) {
+		return errors.Wrap(err, "could not retrieve a resource")
+	}
+	log.Info("trying to create a Zone Enrollment signing key")
+	if err := d.resManager.Create(ctx, signingKey, core_store.CreateBy(key)); err != nil {
+		log.V(1).Info("could not create a Zone Enrollment signing key", "err", err)
+		return errors.Wrap(err, "could not create a resource")
+	}
+	log.Info("Zone Enrollment signing key created")
+	return nil
+}
@@ -1,6 +1,7 @@
 package dns
 
 import (
+	dns_server "github.com/kumahq/kuma/pkg/config/dns-server"
 	"github.com/kumahq/kuma/pkg/core/runtime"
 )
 
@@ -15,6 +16,20 @@ func Setup(rt runtime.Runtime) error {
 		return err
 	}
 
+	// DNSEndpoint resources are published by the Kubernetes runtime plugin
+	if cfg := rt.Config().DNSServer; cfg.ExternalDNS.Type == dns_server.ExternalDNSTypeRFC2136 {
+		externalDNSSync := NewExternalDNSSynchronizer(
+			NewRFC2136Publisher(cfg.ExternalDNS.RFC2136, cfg.ExternalDNS.TTL),
+			rt.ReadOnlyResourceManager(),
+			rt.ConfigManager(),
+			cfg.ExternalDNSDomains(),
+			cfg.ExternalDNS.SyncInterval,
+		)
+		if err := rt.Add(externalDNSSync); err != nil {
+			return err
+		}
+	}
+
 	server, err := NewDNSServer(
 		rt.Config().DNSServer.Port,
 		rt.DNSResolver(),
@@ -0,0 +1,129 @@
+package dns
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kumahq/kuma/pkg/core"
+	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/dns/vips"
+)
+
+var (
+	externalDNSLog = core.Log.WithName("dns-external-dns-synchronizer")
+)
+
+// ExternalDNSRecord is an A record of a mesh service published to an external DNS.
+type ExternalDNSRecord struct {
+	// Name is a fully qualified domain name without the trailing dot, e.g. `backend.internal.corp`
+	Name string
+	// Addresses are sorted VIPs of the service. A service of the same name in several meshes has a VIP in each mesh.
+	Addresses []string
+}
+
+// ExternalDNSPublisher publishes records to an external DNS.
+// Every call to Publish receives the complete list of records, records that are not on the list should be removed.
+type ExternalDNSPublisher interface {
+	Publish(records []ExternalDNSRecord) error
+}
+
+// ExternalDNSRecords computes A records for all services that have a VIP assigned in any mesh.
+// Every service is published in every domain, service names like `backend_kuma-demo_svc_8080`
+// are also published as `backend.kuma-demo.svc.8080`, so they match names resolved by the DNS server.
+func ExternalDNSRecords(vipsByMesh map[string]map[vips.HostnameEntry]string, domains []string) []ExternalDNSRecord {
+	byName := map[string]map[string]bool{}
+	for _, vipList := range vipsByMesh {
+		for entry, address := range vipList {
+			if entry.Type != vips.Service || address == "" {
+				continue
+			}
+			names := []string{entry.Name}
+			if dotted := strings.ReplaceAll(entry.Name, "_", "."); dotted != entry.Name {
+				names = append(names, dotted)
+			}
+			for _, domain := range domains {
+				for _, name := range names {
+					fqdn := strings.ToLower(name + "." + domain)
+					if byName[fqdn] == nil {
+						byName[fqdn] = map[string]bool{}
+					}
+					byName[fqdn][address] = true
+				}
+			}
+		}
+	}
+	var records []ExternalDNSRecord
+	for name, addresses := range byName {
+		record := ExternalDNSRecord{Name: name}
+		for address := range addresses {
+			record.Addresses = append(record.Addresses, address)
+		}
+		sort.Strings(record.Addresses)
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Name < records[j].Name
+	})
+	return records
+}
+
+type externalDNSSynchronizer struct {
+	publisher   ExternalDNSPublisher
+	persistence *vips.Persistence
+	domains     []string
+	newTicker   func() *time.Ticker
+}
+
+func NewExternalDNSSynchronizer(
+	publisher ExternalDNSPublisher,
+	rm manager.ReadOnlyResourceManager,
+	configManager config_manager.ConfigManager,
+	domains []string,
+	interval time.Duration,
+) component.Component {
+	return &externalDNSSynchronizer{
+		publisher:   publisher,
+		persistence: vips.NewPersistence(rm, configManager),
+		domains:     domains,
+		newTicker: func() *time.Ticker {
+			return time.NewTicker(interval)
+		},
+	}
+}
+
+func (d *externalDNSSynchronizer) NeedLeaderElection() bool {
+	return true
+}
+
+func (d *externalDNSSynchronizer) Start(stop <-chan struct{}) error {
+	ticker := d.newTicker()
+	defer ticker.Stop()
+
+	externalDNSLog.Info("starting the external DNS Synchronizer", "domains", d.domains)
+	for {
+		select {
+		case <-ticker.C:
+			if err := d.synchronize(); err != nil {
+				externalDNSLog.Error(err, "unable to synchronize")
+			}
+		case <-stop:
+			externalDNSLog.Info("stopping")
+			return nil
+		}
+	}
+}
+
+func (d *externalDNSSynchronizer) synchronize() error {
+	voByMesh, err := d.persistence.Get()
+	if err != nil {
+		return err
+	}
+	vipsByMesh := map[string]map[vips.HostnameEntry]string{}
+	for mesh, voView := range voByMesh {
+		vipsByMesh[mesh] = vips.ToVIPMap(map[string]*vips.VirtualOutboundMeshView{mesh: voView})
+	}
+	return d.publisher.Publish(ExternalDNSRecords(vipsByMesh, d.domains))
+}
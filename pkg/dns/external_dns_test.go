@@ -0,0 +1,58 @@
+package dns_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/dns"
+	"github.com/kumahq/kuma/pkg/dns/vips"
+)
+
+var _ = Describe("ExternalDNSRecords", func() {
+
+	It("should generate records of services in every domain", func() {
+		// given
+		vipsByMesh := map[string]map[vips.HostnameEntry]string{
+			"default": {
+				vips.NewServiceEntry("backend"):              "240.0.0.1",
+				vips.NewServiceEntry("web_kuma-demo_svc_80"): "240.0.0.2",
+				vips.NewFqdnEntry("legacy.example.com"):      "240.0.0.3",
+				vips.NewHostEntry("host"):                    "240.0.0.4",
+				vips.NewServiceEntry("without-vip"):          "",
+			},
+		}
+
+		// when
+		records := dns.ExternalDNSRecords(vipsByMesh, []string{"mesh", "internal.corp"})
+
+		// then
+		Expect(records).To(Equal([]dns.ExternalDNSRecord{
+			{Name: "backend.internal.corp", Addresses: []string{"240.0.0.1"}},
+			{Name: "backend.mesh", Addresses: []string{"240.0.0.1"}},
+			{Name: "web.kuma-demo.svc.80.internal.corp", Addresses: []string{"240.0.0.2"}},
+			{Name: "web.kuma-demo.svc.80.mesh", Addresses: []string{"240.0.0.2"}},
+			{Name: "web_kuma-demo_svc_80.internal.corp", Addresses: []string{"240.0.0.2"}},
+			{Name: "web_kuma-demo_svc_80.mesh", Addresses: []string{"240.0.0.2"}},
+		}))
+	})
+
+	It("should keep the VIPs of a service from every mesh", func() {
+		// given
+		vipsByMesh := map[string]map[vips.HostnameEntry]string{
+			"mesh-1": {
+				vips.NewServiceEntry("backend"): "240.0.0.2",
+			},
+			"mesh-2": {
+				vips.NewServiceEntry("backend"): "240.0.0.1",
+			},
+		}
+
+		// when
+		records := dns.ExternalDNSRecords(vipsByMesh, []string{"internal.corp"})
+
+		// then
+		Expect(records).To(Equal([]dns.ExternalDNSRecord{
+			{Name: "backend.internal.corp", Addresses: []string{"240.0.0.1", "240.0.0.2"}},
+		}))
+	})
+})
@@ -12,26 +12,32 @@ import (
 
 type DNSResolver interface {
 	GetDomain() string
+	// GetDomains returns the domain and the additional domains that services are resolved for.
+	GetDomains() []string
 	SetVIPs(map[vips.HostnameEntry]string)
 	ForwardLookupFQDN(name string) (string, error)
 }
 
 type dnsResolver struct {
 	sync.RWMutex
-	domain  string
+	domains []string
 	viplist map[vips.HostnameEntry]string
 }
 
 var _ DNSResolver = &dnsResolver{}
 
-func NewDNSResolver(domain string) DNSResolver {
+func NewDNSResolver(domain string, additionalDomains ...string) DNSResolver {
 	return &dnsResolver{
-		domain: domain,
+		domains: append([]string{domain}, additionalDomains...),
 	}
 }
 
 func (d *dnsResolver) GetDomain() string {
-	return d.domain
+	return d.domains[0]
+}
+
+func (d *dnsResolver) GetDomains() []string {
+	return d.domains
 }
 
 func (s *dnsResolver) SetVIPs(list map[vips.HostnameEntry]string) {
@@ -45,18 +51,12 @@ func (s *dnsResolver) ForwardLookupFQDN(name string) (string, error) {
 	defer s.RUnlock()
 	ipFqdn, foundFqdn := s.viplist[vips.NewFqdnEntry(strings.TrimSuffix(name, "."))]
 
-	domain, err := s.domainFromName(name)
-	if err != nil {
-		return "", err
-	}
-
-	if domain == s.domain {
-		service, err := s.serviceFromName(name)
-		if err != nil {
-			return "", err
-		}
-
+	if service, domain, ok := s.splitName(name); ok {
 		ip, found := s.viplist[vips.NewServiceEntry(service)]
+		if !found {
+			// service names like `backend_kuma-demo_svc_8080` are resolvable as `backend.kuma-demo.svc.8080`
+			ip, found = s.viplist[vips.NewServiceEntry(strings.ReplaceAll(service, ".", "_"))]
+		}
 		if found {
 			return ip, nil
 		} else if foundFqdn {
@@ -66,6 +66,11 @@ func (s *dnsResolver) ForwardLookupFQDN(name string) (string, error) {
 	} else if foundFqdn {
 		return ipFqdn, nil
 	}
+
+	domain, err := s.domainFromName(name)
+	if err != nil {
+		return "", err
+	}
 	return "", errors.Errorf("domain [%s] not found.", domain)
 }
 
@@ -78,16 +83,20 @@ func (s *dnsResolver) domainFromName(name string) (string, error) {
 	return split[len(split)-1], nil
 }
 
-func (s *dnsResolver) serviceFromName(name string) (string, error) {
+// splitName splits a name into a service and one of the domains.
+// When the name matches several domains, e.g. `corp` and `internal.corp`, the longest one wins.
+func (s *dnsResolver) splitName(name string) (string, string, bool) {
 	split := dns.SplitDomainName(name)
-	if len(split) < 1 {
-		return "", errors.Errorf("wrong DNS name: %s", name)
-	}
-
-	// If it terminates with the domain we remove it.
-	if split[len(split)-1] == s.domain {
-		split = split[0 : len(split)-1]
+	var service, domain string
+	for _, d := range s.domains {
+		domainSplit := dns.SplitDomainName(d)
+		if len(domainSplit) > len(split) || len(d) <= len(domain) {
+			continue
+		}
+		if strings.EqualFold(strings.Join(split[len(split)-len(domainSplit):], "."), d) {
+			service = strings.Join(split[:len(split)-len(domainSplit)], ".")
+			domain = d
+		}
 	}
-
-	return strings.Join(split, "."), nil
+	return service, domain, domain != ""
 }
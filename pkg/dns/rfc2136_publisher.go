@@ -0,0 +1,217 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+
+	dns_server "github.com/kumahq/kuma/pkg/config/dns-server"
+)
+
+const rfc2136TsigFudge = 300
+
+// rfc2136Publisher publishes records using dynamic DNS updates (RFC 2136).
+//
+// Like the TXT registry of external-dns, every published name gets a TXT record with the owner of the records.
+// On start and after a failed update, the publisher transfers the zone (AXFR) to find the names it owns,
+// so records of services removed while the Control Plane was down are still cleaned up.
+// Names of other owners are never modified. Records outside of the zone are ignored.
+type rfc2136Publisher struct {
+	cfg *dns_server.RFC2136Config
+	ttl uint32
+	// published holds the addresses of the owned names, it is nil until it is loaded from the zone
+	published map[string][]string
+	// foreign holds the names of A records of the zone that are not owned by the publisher
+	foreign  map[string]bool
+	exchange func(msg *dns.Msg) (*dns.Msg, error)
+	transfer func(msg *dns.Msg) ([]dns.RR, error)
+}
+
+var _ ExternalDNSPublisher = &rfc2136Publisher{}
+
+func NewRFC2136Publisher(cfg *dns_server.RFC2136Config, ttl uint32) ExternalDNSPublisher {
+	client := &dns.Client{Net: "tcp"}
+	transfer := &dns.Transfer{}
+	if cfg.TSIGKeyName != "" {
+		client.TsigSecret = map[string]string{dns.Fqdn(cfg.TSIGKeyName): cfg.TSIGSecret}
+		transfer.TsigSecret = client.TsigSecret
+	}
+	return &rfc2136Publisher{
+		cfg: cfg,
+		ttl: ttl,
+		exchange: func(msg *dns.Msg) (*dns.Msg, error) {
+			resp, _, err := client.Exchange(msg, cfg.Server)
+			return resp, err
+		},
+		transfer: func(msg *dns.Msg) ([]dns.RR, error) {
+			envelopes, err := transfer.In(msg, cfg.Server)
+			if err != nil {
+				return nil, err
+			}
+			var rrs []dns.RR
+			for envelope := range envelopes {
+				if envelope.Error != nil {
+					return nil, envelope.Error
+				}
+				rrs = append(rrs, envelope.RR...)
+			}
+			return rrs, nil
+		},
+	}
+}
+
+func (p *rfc2136Publisher) Publish(records []ExternalDNSRecord) error {
+	if p.published == nil {
+		if err := p.load(); err != nil {
+			return errors.Wrapf(err, "could not transfer zone %s from %s", p.cfg.Zone, p.cfg.Server)
+		}
+	}
+
+	desired := map[string][]string{}
+	for _, record := range records {
+		name := strings.ToLower(record.Name)
+		if !dns.IsSubDomain(dns.Fqdn(p.cfg.Zone), dns.Fqdn(name)) {
+			continue
+		}
+		if p.foreign[name] {
+			externalDNSLog.V(1).Info("skipping a record that is not owned by Kuma", "name", name, "owner", p.cfg.OwnerID)
+			continue
+		}
+		for _, address := range record.Addresses {
+			ip := net.ParseIP(address)
+			if ip == nil || ip.To4() == nil {
+				return errors.Errorf("invalid IPv4 address %q of %q", address, name)
+			}
+		}
+		desired[name] = record.Addresses
+	}
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(dns.Fqdn(p.cfg.Zone))
+	for _, name := range sortedNames(p.published) {
+		if _, ok := desired[name]; !ok {
+			msg.RemoveRRset([]dns.RR{p.a(name, ""), p.txt(name, "")})
+		}
+	}
+	for _, name := range sortedNames(desired) {
+		published, owned := p.published[name]
+		if owned && reflect.DeepEqual(published, desired[name]) {
+			continue
+		}
+		msg.RemoveRRset([]dns.RR{p.a(name, "")})
+		var rrs []dns.RR
+		for _, address := range desired[name] {
+			rrs = append(rrs, p.a(name, address))
+		}
+		if !owned {
+			rrs = append(rrs, p.txt(name, p.ownership()))
+		}
+		msg.Insert(rrs)
+	}
+	if len(msg.Ns) == 0 {
+		return nil
+	}
+
+	if p.cfg.TSIGKeyName != "" {
+		msg.SetTsig(dns.Fqdn(p.cfg.TSIGKeyName), dns.Fqdn(strings.ToLower(p.cfg.TSIGAlgorithm)), rfc2136TsigFudge, time.Now().Unix())
+	}
+	resp, err := p.exchange(msg)
+	if err != nil {
+		p.published = nil // the update might have been partially applied, the zone is transferred again
+		return errors.Wrapf(err, "could not send DNS update to %s", p.cfg.Server)
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		p.published = nil
+		return errors.Errorf("DNS update to %s failed with %s", p.cfg.Server, dns.RcodeToString[resp.Rcode])
+	}
+	p.published = desired
+	return nil
+}
+
+// load finds the names of the zone owned by the publisher and their addresses.
+func (p *rfc2136Publisher) load() error {
+	msg := new(dns.Msg)
+	msg.SetAxfr(dns.Fqdn(p.cfg.Zone))
+	if p.cfg.TSIGKeyName != "" {
+		msg.SetTsig(dns.Fqdn(p.cfg.TSIGKeyName), dns.Fqdn(strings.ToLower(p.cfg.TSIGAlgorithm)), rfc2136TsigFudge, time.Now().Unix())
+	}
+	rrs, err := p.transfer(msg)
+	if err != nil {
+		return err
+	}
+
+	owned := map[string]bool{}
+	addresses := map[string][]string{}
+	for _, rr := range rrs {
+		name := strings.ToLower(strings.TrimSuffix(rr.Header().Name, "."))
+		switch rr := rr.(type) {
+		case *dns.TXT:
+			if strings.Join(rr.Txt, "") == p.ownership() {
+				owned[name] = true
+			}
+		case *dns.A:
+			addresses[name] = append(addresses[name], rr.A.String())
+		}
+	}
+
+	published := map[string][]string{}
+	foreign := map[string]bool{}
+	for name := range owned {
+		sort.Strings(addresses[name])
+		published[name] = addresses[name]
+	}
+	for name := range addresses {
+		if !owned[name] {
+			foreign[name] = true
+		}
+	}
+	p.published = published
+	p.foreign = foreign
+	return nil
+}
+
+func (p *rfc2136Publisher) ownership() string {
+	return fmt.Sprintf("heritage=kuma,kuma/owner=%s", p.cfg.OwnerID)
+}
+
+func (p *rfc2136Publisher) a(name string, address string) *dns.A {
+	return &dns.A{
+		Hdr: dns.RR_Header{
+			Name:   dns.Fqdn(name),
+			Rrtype: dns.TypeA,
+			Class:  dns.ClassINET,
+			Ttl:    p.ttl,
+		},
+		A: net.ParseIP(address),
+	}
+}
+
+func (p *rfc2136Publisher) txt(name string, value string) *dns.TXT {
+	txt := &dns.TXT{
+		Hdr: dns.RR_Header{
+			Name:   dns.Fqdn(name),
+			Rrtype: dns.TypeTXT,
+			Class:  dns.ClassINET,
+			Ttl:    p.ttl,
+		},
+	}
+	if value != "" {
+		txt.Txt = []string{value}
+	}
+	return txt
+}
+
+func sortedNames(records map[string][]string) []string {
+	var names []string
+	for name := range records {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
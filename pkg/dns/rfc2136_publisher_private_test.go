@@ -0,0 +1,164 @@
+package dns
+
+import (
+	"github.com/miekg/dns"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	dns_server "github.com/kumahq/kuma/pkg/config/dns-server"
+)
+
+var _ = Describe("rfc2136Publisher", func() {
+
+	var publisher *rfc2136Publisher
+	var sent []*dns.Msg
+	var transfers int
+	var zone []dns.RR
+	var rcode int
+
+	BeforeEach(func() {
+		sent = nil
+		transfers = 0
+		zone = nil
+		rcode = dns.RcodeSuccess
+		publisher = NewRFC2136Publisher(&dns_server.RFC2136Config{
+			Server:  "127.0.0.1:53",
+			Zone:    "internal.corp",
+			OwnerID: "kuma",
+		}, 60).(*rfc2136Publisher)
+		publisher.exchange = func(msg *dns.Msg) (*dns.Msg, error) {
+			sent = append(sent, msg)
+			resp := new(dns.Msg)
+			resp.SetRcode(msg, rcode)
+			return resp, nil
+		}
+		publisher.transfer = func(msg *dns.Msg) ([]dns.RR, error) {
+			Expect(msg.Question[0].Qtype).To(Equal(dns.TypeAXFR))
+			Expect(msg.Question[0].Name).To(Equal("internal.corp."))
+			transfers++
+			return zone, nil
+		}
+	})
+
+	rrs := func(msg *dns.Msg) []string {
+		var result []string
+		for _, rr := range msg.Ns {
+			result = append(result, rr.String())
+		}
+		return result
+	}
+
+	mustRR := func(s string) dns.RR {
+		rr, err := dns.NewRR(s)
+		Expect(err).ToNot(HaveOccurred())
+		return rr
+	}
+
+	It("should send only changed records of the zone", func() {
+		// when
+		err := publisher.Publish([]ExternalDNSRecord{
+			{Name: "backend.internal.corp", Addresses: []string{"240.0.0.1", "240.0.0.2"}},
+			{Name: "backend.mesh", Addresses: []string{"240.0.0.1"}},
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(transfers).To(Equal(1))
+		Expect(sent).To(HaveLen(1))
+		Expect(sent[0].Opcode).To(Equal(dns.OpcodeUpdate))
+		Expect(sent[0].Question[0].Name).To(Equal("internal.corp."))
+		Expect(rrs(sent[0])).To(Equal([]string{
+			"backend.internal.corp.\t0\tCLASS255\tA\t",
+			"backend.internal.corp.\t60\tIN\tA\t240.0.0.1",
+			"backend.internal.corp.\t60\tIN\tA\t240.0.0.2",
+			"backend.internal.corp.\t60\tIN\tTXT\t\"heritage=kuma,kuma/owner=kuma\"",
+		}))
+
+		// when the same records are published
+		err = publisher.Publish([]ExternalDNSRecord{
+			{Name: "backend.internal.corp", Addresses: []string{"240.0.0.1", "240.0.0.2"}},
+		})
+
+		// then nothing is sent
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sent).To(HaveLen(1))
+
+		// when an address is removed
+		err = publisher.Publish([]ExternalDNSRecord{
+			{Name: "backend.internal.corp", Addresses: []string{"240.0.0.1"}},
+		})
+
+		// then the ownership is not sent again
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sent).To(HaveLen(2))
+		Expect(rrs(sent[1])).To(Equal([]string{
+			"backend.internal.corp.\t0\tCLASS255\tA\t",
+			"backend.internal.corp.\t60\tIN\tA\t240.0.0.1",
+		}))
+
+		// when the record is removed
+		err = publisher.Publish(nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sent).To(HaveLen(3))
+		Expect(rrs(sent[2])).To(Equal([]string{
+			"backend.internal.corp.\t0\tCLASS255\tA\t",
+			"backend.internal.corp.\t0\tCLASS255\tTXT\t",
+		}))
+		// and the zone is transferred only once
+		Expect(transfers).To(Equal(1))
+	})
+
+	It("should rebuild the published records from the zone", func() {
+		// given records published before a restart and records of other owners
+		zone = []dns.RR{
+			mustRR(`internal.corp. 60 IN SOA ns.internal.corp. admin.internal.corp. 1 3600 600 86400 60`),
+			mustRR(`backend.internal.corp. 60 IN A 240.0.0.1`),
+			mustRR(`backend.internal.corp. 60 IN TXT "heritage=kuma,kuma/owner=kuma"`),
+			mustRR(`removed.internal.corp. 60 IN A 240.0.0.2`),
+			mustRR(`removed.internal.corp. 60 IN TXT "heritage=kuma,kuma/owner=kuma"`),
+			mustRR(`other-zone.internal.corp. 60 IN A 240.0.0.3`),
+			mustRR(`other-zone.internal.corp. 60 IN TXT "heritage=kuma,kuma/owner=kuma-zone-2"`),
+			mustRR(`web.internal.corp. 60 IN A 10.0.0.1`),
+		}
+
+		// when
+		err := publisher.Publish([]ExternalDNSRecord{
+			{Name: "backend.internal.corp", Addresses: []string{"240.0.0.1"}},
+			{Name: "other-zone.internal.corp", Addresses: []string{"240.0.0.4"}},
+			{Name: "web.internal.corp", Addresses: []string{"240.0.0.5"}},
+		})
+
+		// then only the records owned by the publisher are changed
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sent).To(HaveLen(1))
+		Expect(rrs(sent[0])).To(Equal([]string{
+			"removed.internal.corp.\t0\tCLASS255\tA\t",
+			"removed.internal.corp.\t0\tCLASS255\tTXT\t",
+		}))
+	})
+
+	It("should retry records when the update is refused", func() {
+		// given
+		rcode = dns.RcodeRefused
+		records := []ExternalDNSRecord{
+			{Name: "backend.internal.corp", Addresses: []string{"240.0.0.1"}},
+		}
+
+		// when
+		err := publisher.Publish(records)
+
+		// then
+		Expect(err).To(MatchError("DNS update to 127.0.0.1:53 failed with REFUSED"))
+
+		// when the server accepts updates
+		rcode = dns.RcodeSuccess
+		err = publisher.Publish(records)
+
+		// then the zone is transferred again and the record is sent again
+		Expect(err).ToNot(HaveOccurred())
+		Expect(transfers).To(Equal(2))
+		Expect(sent).To(HaveLen(2))
+	})
+})
@@ -146,13 +146,15 @@ func (d *SimpleDNSServer) Start(stop <-chan struct{}) error {
 }
 
 func (h *SimpleDNSServer) registerDNSHandler() {
-	dns.HandleFunc(h.resolver.GetDomain(), func(writer dns.ResponseWriter, msg *dns.Msg) {
-		start := core.Now()
-		defer func() {
-			h.latencyMetric.Observe(float64(core.Now().Sub(start).Milliseconds()))
-		}()
-		h.handleDNSRequest(writer, msg)
-	})
+	for _, domain := range h.resolver.GetDomains() {
+		dns.HandleFunc(domain, func(writer dns.ResponseWriter, msg *dns.Msg) {
+			start := core.Now()
+			defer func() {
+				h.latencyMetric.Observe(float64(core.Now().Sub(start).Milliseconds()))
+			}()
+			h.handleDNSRequest(writer, msg)
+		})
+	}
 }
 
 func (h *SimpleDNSServer) lookup(qName string) (string, error) {
@@ -33,7 +33,7 @@ var _ = Describe("DNS server", func() {
 			port = uint32(p)
 			Expect(err).ToNot(HaveOccurred())
 
-			dnsResolver = resolver.NewDNSResolver("mesh")
+			dnsResolver = resolver.NewDNSResolver("mesh", "internal.corp")
 			m, err := core_metrics.NewMetrics("Standalone")
 			metrics = m
 			Expect(err).ToNot(HaveOccurred())
@@ -137,6 +137,18 @@ var _ = Describe("DNS server", func() {
 				whenType:  dns.Type(dns.TypeA),
 				thenIp:    "240.0.0.1",
 			}),
+			Entry("should resolve in an additional domain", dnsTestCase{
+				givenVips: map[vips.HostnameEntry]string{vips.NewServiceEntry("service"): "240.0.0.1"},
+				whenQuery: "service.internal.corp",
+				whenType:  dns.Type(dns.TypeA),
+				thenIp:    "240.0.0.1",
+			}),
+			Entry("should resolve converted services with '.' in an additional domain", dnsTestCase{
+				givenVips: map[vips.HostnameEntry]string{vips.NewServiceEntry("my-service_test-namespace_svc_80"): "240.0.0.1"},
+				whenQuery: "my-service.test-namespace.svc.80.internal.corp",
+				whenType:  dns.Type(dns.TypeA),
+				thenIp:    "240.0.0.1",
+			}),
 			Entry("should resolve fqdn service with .mesh", dnsTestCase{
 				givenVips: map[vips.HostnameEntry]string{vips.NewFqdnEntry("my.service.foo.mesh"): "240.0.0.1"},
 				whenQuery: "my.service.foo.mesh",
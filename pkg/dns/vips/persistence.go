@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -125,26 +126,38 @@ func (m *Persistence) unmarshal(config string, mesh string) (*VirtualOutboundMes
 }
 
 func (m *Persistence) GetByMesh(mesh string) (*VirtualOutboundMeshView, error) {
+	view, _, err := m.GetByMeshWithTime(mesh)
+	return view, err
+}
+
+// GetByMeshWithTime returns the VIPs of the mesh along with the time they
+// were last persisted. The time is zero when no VIP was persisted yet.
+func (m *Persistence) GetByMeshWithTime(mesh string) (*VirtualOutboundMeshView, time.Time, error) {
 	name := fmt.Sprintf(template, mesh)
 	resource := config_model.NewConfigResource()
 	err := m.configManager.Get(context.Background(), resource, store.GetByKey(name, ""))
 	if err != nil {
 		if store.IsResourceNotFound(err) {
-			return NewEmptyVirtualOutboundView(), nil
+			return NewEmptyVirtualOutboundView(), time.Time{}, nil
 		}
-		return nil, err
+		return nil, time.Time{}, err
+	}
+
+	var modificationTime time.Time
+	if resource.Meta != nil {
+		modificationTime = resource.Meta.GetModificationTime()
 	}
 
 	if resource.Spec.Config == "" {
-		return NewEmptyVirtualOutboundView(), nil
+		return NewEmptyVirtualOutboundView(), modificationTime, nil
 	}
 
 	virtualOutboundView, err := m.unmarshal(resource.Spec.GetConfig(), mesh)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not unmarshal")
+		return nil, time.Time{}, errors.Wrap(err, "could not unmarshal")
 	}
 
-	return virtualOutboundView, nil
+	return virtualOutboundView, modificationTime, nil
 }
 
 func (m *Persistence) Set(mesh string, vips *VirtualOutboundMeshView) error {
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	dp_server "github.com/kumahq/kuma/pkg/config/dp-server"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/util/ratelimit"
+)
+
+const (
+	clientRateLimit   = "client"
+	endpointRateLimit = "endpoint"
+)
+
+// rateLimiter limits HTTP requests of every client, identified by its address, and of every endpoint.
+type rateLimiter struct {
+	// clients is nil when requests of clients are not limited
+	clients *ratelimit.KeyedLimiter
+	// endpoints is nil when requests of endpoints are not limited
+	endpoints *ratelimit.KeyedLimiter
+	rejected  *prometheus.CounterVec
+}
+
+func newRateLimiter(cfg dp_server.DpServerRateLimit, metrics metrics.Metrics) *rateLimiter {
+	rejected := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dp_server_rate_limited_requests",
+		Help: "Number of HTTP requests rejected by the DP Server because of exceeded rate limit",
+	}, []string{"limit", "handler"})
+	metrics.MustRegister(rejected)
+	limiter := &rateLimiter{
+		rejected: rejected,
+	}
+	if cfg.ClientRequestsPerSecond > 0 {
+		limiter.clients = ratelimit.NewKeyedLimiter(cfg.ClientRequestsPerSecond, cfg.ClientBurst)
+	}
+	if cfg.EndpointRequestsPerSecond > 0 {
+		limiter.endpoints = ratelimit.NewKeyedLimiter(cfg.EndpointRequestsPerSecond, cfg.EndpointBurst)
+	}
+	return limiter
+}
+
+// allow reports whether the request to the endpoint may be handled. Otherwise, the request is rejected with 429.
+func (r *rateLimiter) allow(endpoint string, writer http.ResponseWriter, request *http.Request) bool {
+	if r.endpoints != nil && !r.endpoints.Allow(endpoint) {
+		r.reject(endpointRateLimit, endpoint, writer)
+		return false
+	}
+	if r.clients != nil && !r.clients.Allow(clientAddress(request)) {
+		r.reject(clientRateLimit, endpoint, writer)
+		return false
+	}
+	return true
+}
+
+func (r *rateLimiter) reject(limit string, endpoint string, writer http.ResponseWriter) {
+	r.rejected.WithLabelValues(limit, endpoint).Inc()
+	writer.Header().Set("Retry-After", "1")
+	http.Error(writer, "rate limit of the "+limit+" exceeded", http.StatusTooManyRequests)
+}
+
+func clientAddress(request *http.Request) string {
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+	return host
+}
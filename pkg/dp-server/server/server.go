@@ -31,6 +31,8 @@ type DpServer struct {
 	httpMux        *http.ServeMux
 	grpcServer     *grpc.Server
 	promMiddleware middleware.Middleware
+	// rateLimiter is nil when HTTP requests are not limited
+	rateLimiter *rateLimiter
 }
 
 var _ component.Component = &DpServer{}
@@ -57,11 +59,17 @@ func NewDpServer(config dp_server.DpServerConfig, metrics metrics.Metrics) *DpSe
 		}),
 	})
 
+	var limiter *rateLimiter
+	if config.RateLimit.Enabled {
+		limiter = newRateLimiter(config.RateLimit, metrics)
+	}
+
 	return &DpServer{
 		config:         config,
 		httpMux:        http.NewServeMux(),
 		grpcServer:     grpcServer,
 		promMiddleware: promMiddleware,
+		rateLimiter:    limiter,
 	}
 }
 
@@ -105,6 +113,12 @@ func (d *DpServer) handle(writer http.ResponseWriter, request *http.Request) {
 	} else {
 		// we only want to measure HTTP not GRPC requests because they can mess up metrics
 		// for example ADS bi-directional stream counts as one really long request
+		if d.rateLimiter != nil {
+			_, endpoint := d.httpMux.Handler(request)
+			if !d.rateLimiter.allow(endpoint, writer, request) {
+				return
+			}
+		}
 		std.Handler("", d.promMiddleware, d.httpMux).ServeHTTP(writer, request)
 	}
 }
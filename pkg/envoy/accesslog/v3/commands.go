@@ -72,6 +72,7 @@ const (
 	CMD_KUMA_DESTINATION_SERVICE         = "KUMA_DESTINATION_SERVICE"
 	CMD_KUMA_MESH                        = "KUMA_MESH"
 	CMD_KUMA_TRAFFIC_DIRECTION           = "KUMA_TRAFFIC_DIRECTION"
+	CMD_KUMA_ROUTE_NAME                  = "KUMA_ROUTE_NAME"
 )
 
 // CommandOperatorDescriptor represents a descriptor of an Envoy access log command operator.
@@ -179,6 +180,8 @@ func (o CommandOperatorDescriptor) String() string {
 		return "%KUMA_MESH%"
 	case CMD_KUMA_TRAFFIC_DIRECTION:
 		return "%KUMA_TRAFFIC_DIRECTION%"
+	case CMD_KUMA_ROUTE_NAME:
+		return "%KUMA_ROUTE_NAME%"
 	case CMD_GRPC_STATUS:
 		return "%CMD_GRPC_STATUS%"
 	default:
@@ -1,11 +1,13 @@
 package v3
 
 import (
+	"encoding/json"
 	"strconv"
 	"strings"
 
 	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
 	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // DynamicMetadataOperator represents a `%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%` command operator.
@@ -24,8 +26,30 @@ func (f *DynamicMetadataOperator) FormatTcpLogEntry(entry *accesslog_data.TCPAcc
 }
 
 func (f *DynamicMetadataOperator) format(entry *accesslog_data.AccessLogCommon) (string, error) {
-	// TODO(yskopets): implement
-	return "UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)", nil
+	namespace, exists := entry.GetMetadata().GetFilterMetadata()[f.FilterNamespace]
+	if !exists {
+		return "", nil
+	}
+	value := structpb.NewStructValue(namespace)
+	for _, key := range f.Path {
+		value, exists = value.GetStructValue().GetFields()[key]
+		if !exists {
+			return "", nil
+		}
+	}
+	// like Envoy, render strings as they are and other values as JSON
+	text, isString := value.AsInterface().(string)
+	if !isString {
+		bytes, err := json.Marshal(value.AsInterface())
+		if err != nil {
+			return "", err
+		}
+		text = string(bytes)
+	}
+	if f.MaxLength > 0 && len(text) > f.MaxLength {
+		return text[:f.MaxLength], nil
+	}
+	return text, nil
 }
 
 func (f *DynamicMetadataOperator) ConfigureHttpLog(config *accesslog_config.HttpGrpcAccessLogConfig) error {
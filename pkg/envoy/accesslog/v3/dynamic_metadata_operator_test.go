@@ -1,15 +1,91 @@
 package v3_test
 
 import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	. "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
 )
 
 var _ = Describe("DynamicMetadataOperator", func() {
 
+	Describe("FormatHttpLogEntry()", func() {
+		metadata := &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"virtual_host": structpb.NewStringValue("*.example.com"),
+				"test_object": structpb.NewStructValue(&structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"inner_key": structpb.NewNumberValue(42),
+					},
+				}),
+			},
+		}
+
+		entry := &accesslog_data.HTTPAccessLogEntry{
+			CommonProperties: &accesslog_data.AccessLogCommon{
+				Metadata: &envoy_core.Metadata{
+					FilterMetadata: map[string]*structpb.Struct{
+						"io.kuma.gateway": metadata,
+					},
+				},
+			},
+		}
+
+		type testCase struct {
+			filterNamespace string
+			path            []string
+			maxLength       int
+			expected        string
+		}
+
+		DescribeTable("should format the dynamic metadata",
+			func(given testCase) {
+				// setup
+				fragment := &DynamicMetadataOperator{FilterNamespace: given.filterNamespace, Path: given.path, MaxLength: given.maxLength}
+
+				// when
+				actual, err := fragment.FormatHttpLogEntry(entry)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(Equal(given.expected))
+			},
+			Entry("string value", testCase{
+				filterNamespace: "io.kuma.gateway",
+				path:            []string{"virtual_host"},
+				expected:        `*.example.com`,
+			}),
+			Entry("string value with max length", testCase{
+				filterNamespace: "io.kuma.gateway",
+				path:            []string{"virtual_host"},
+				maxLength:       3,
+				expected:        `*.e`,
+			}),
+			Entry("nested value", testCase{
+				filterNamespace: "io.kuma.gateway",
+				path:            []string{"test_object", "inner_key"},
+				expected:        `42`,
+			}),
+			Entry("object value", testCase{
+				filterNamespace: "io.kuma.gateway",
+				path:            []string{"test_object"},
+				expected:        `{"inner_key":42}`,
+			}),
+			Entry("missing key", testCase{
+				filterNamespace: "io.kuma.gateway",
+				path:            []string{"virtual_host", "inner_key"},
+				expected:        ``,
+			}),
+			Entry("missing namespace", testCase{
+				filterNamespace: "com.test.my_filter",
+				expected:        ``,
+			}),
+		)
+	})
+
 	Describe("String()", func() {
 		type testCase struct {
 			filterNamespace string
@@ -359,43 +359,43 @@ var _ = Describe("ParseFormat()", func() {
 			}),
 			Entry("%DYNAMIC_METADATA()%", testCase{ // apparently, Envoy allows both `FilterNamespace` and `Path` to be empty
 				format:       `%DYNAMIC_METADATA()%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `-`, // replicate Envoy's behavior
+				expectedTCP:  `-`, // replicate Envoy's behavior
 			}),
 			Entry("%DYNAMIC_METADATA():10%", testCase{ // apparently, Envoy allows both `FilterNamespace` and `Path` to be empty
 				format:       `%DYNAMIC_METADATA():10%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `-`, // replicate Envoy's behavior
+				expectedTCP:  `-`, // replicate Envoy's behavior
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter)%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter)%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `-`, // replicate Envoy's behavior
+				expectedTCP:  `-`, // replicate Envoy's behavior
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter):10%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter):10%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `-`, // replicate Envoy's behavior
+				expectedTCP:  `-`, // replicate Envoy's behavior
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter:test_key)%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter:test_key)%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `-`, // replicate Envoy's behavior
+				expectedTCP:  `-`, // replicate Envoy's behavior
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter:test_key):10%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter:test_key):10%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `-`, // replicate Envoy's behavior
+				expectedTCP:  `-`, // replicate Envoy's behavior
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter:test_object:inner_key)%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter:test_object:inner_key)%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `-`, // replicate Envoy's behavior
+				expectedTCP:  `-`, // replicate Envoy's behavior
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter:test_object:inner_key):10%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter:test_object:inner_key):10%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `-`, // replicate Envoy's behavior
+				expectedTCP:  `-`, // replicate Envoy's behavior
 			}),
 			Entry("%FILTER_STATE(key)%", testCase{
 				format:       `%FILTER_STATE(key)%`,
@@ -552,6 +552,16 @@ var _ = Describe("ParseFormat()", func() {
 				expectedHTTP: `%KUMA_DESTINATION_SERVICE%`, // placeholder must be rendered "as is"
 				expectedTCP:  `%KUMA_DESTINATION_SERVICE%`,
 			}),
+			Entry("%KUMA_MESH%", testCase{
+				format:       `%KUMA_MESH%`,
+				expectedHTTP: `%KUMA_MESH%`, // placeholder must be rendered "as is"
+				expectedTCP:  `%KUMA_MESH%`,
+			}),
+			Entry("%KUMA_ROUTE_NAME%", testCase{
+				format:       `%KUMA_ROUTE_NAME%`,
+				expectedHTTP: `%KUMA_ROUTE_NAME%`, // placeholder must be rendered "as is"
+				expectedTCP:  `%KUMA_ROUTE_NAME%`,
+			}),
 			Entry("composite", testCase{
 				format:       `[%START_TIME%] "%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL%" %RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% %BYTES_SENT% %DURATION% %RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)% "%REQ(X-FORWARDED-FOR)%" "%REQ(USER-AGENT)%" "%REQ(X-REQUEST-ID)%" "%REQ(:AUTHORITY)%"`,
 				expectedHTTP: `[2020-02-18T21:52:17.987Z] "- /api HTTP/1.1" 200 UF,URX 234 567 123 - "-" "-" "-" "backend.internal:8080"`,
@@ -0,0 +1,48 @@
+package v3
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// sinkSchemeSeparator separates the type of a logging backend from its configuration.
+//
+// The address of a TCP logging backend is in format of HOST:PORT and never contains
+// the separator, which keeps the log names generated by older versions of Kuma valid.
+const sinkSchemeSeparator = "://"
+
+// EncodeSink returns a string that describes a logging backend of a given type,
+// e.g. `splunk://eyJ1cmwiOi...`.
+//
+// The string is passed to kuma-dp as a part of the log name of `envoy.access_loggers.http_grpc`,
+// which is why the configuration is encoded in a way that never contains a `;`. The log name is
+// a part of the Envoy configuration, so credentials in the configuration have to be references
+// to secrets, which kuma-dp resolves on its own.
+func EncodeSink(backendType string, conf proto.Message) (string, error) {
+	bytes, err := util_proto.ToJSON(conf)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not marshal %T", conf)
+	}
+	return backendType + sinkSchemeSeparator + base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// DecodeSink is the opposite of EncodeSink. It returns the type of a logging backend
+// and its configuration in JSON format.
+//
+// A sink without a type is considered to be the address of a TCP logging backend.
+func DecodeSink(sink string) (backendType string, conf []byte, err error) {
+	parts := strings.SplitN(sink, sinkSchemeSeparator, 2)
+	if len(parts) != 2 {
+		return "", nil, nil
+	}
+	conf, err = base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "configuration of %q logging backend is not valid", parts[0])
+	}
+	return parts[0], conf, nil
+}
@@ -0,0 +1,67 @@
+package v3_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	. "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("EncodeSink() and DecodeSink()", func() {
+
+	It("should be possible to encode and decode a sink", func() {
+		// given
+		conf := &mesh_proto.SplunkLoggingBackendConfig{
+			Url: "https://splunk.example.com:8088/services/collector/event",
+			Token: &system_proto.DataSource{
+				Type: &system_proto.DataSource_Secret{Secret: "splunk-token"},
+			},
+			Index: "mesh",
+		}
+
+		// when
+		sink, err := EncodeSink(mesh_proto.LoggingSplunkType, conf)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(sink).To(HavePrefix("splunk://"))
+		// and
+		Expect(strings.Contains(sink, ";")).To(BeFalse())
+
+		// when
+		backendType, bytes, err := DecodeSink(sink)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(backendType).To(Equal(mesh_proto.LoggingSplunkType))
+
+		// when
+		actual := &mesh_proto.SplunkLoggingBackendConfig{}
+		// then
+		Expect(util_proto.FromJSON(bytes, actual)).To(Succeed())
+		// and
+		Expect(actual.Url).To(Equal(conf.Url))
+		Expect(actual.Token.GetSecret()).To(Equal("splunk-token"))
+		Expect(actual.Index).To(Equal(conf.Index))
+	})
+
+	It("should treat an address without a type as a TCP sink", func() {
+		// when
+		backendType, bytes, err := DecodeSink("127.0.0.1:5000")
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(backendType).To(BeEmpty())
+		Expect(bytes).To(BeNil())
+	})
+
+	It("should reject a sink with a malformed configuration", func() {
+		// when
+		_, _, err := DecodeSink("splunk://not base64!")
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`configuration of "splunk" logging backend is not valid`)))
+	})
+})
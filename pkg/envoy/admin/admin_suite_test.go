@@ -43,13 +43,13 @@ var _ = BeforeSuite(func() {
 	err = resManager.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(testMesh, model.NoMesh))
 	Expect(err).ToNot(HaveOccurred())
 
-	err = mesh.EnsureDefaultMeshResources(runtime.ResourceManager(), testMesh)
+	err = mesh.EnsureDefaultMeshResources(runtime.ResourceManager(), testMesh, nil, nil)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = resManager.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(anotherMesh, model.NoMesh))
 	Expect(err).ToNot(HaveOccurred())
 
-	err = mesh.EnsureDefaultMeshResources(runtime.ResourceManager(), anotherMesh)
+	err = mesh.EnsureDefaultMeshResources(runtime.ResourceManager(), anotherMesh, nil, nil)
 	Expect(err).ToNot(HaveOccurred())
 
 	// setup the Envoy Admin Client
@@ -2,27 +2,43 @@ package admin
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"crypto/tls"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net"
 	"net/http"
+	"net/url"
 	"strconv"
 	"time"
 
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoy_config_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	envoy_data_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/data/tap/v3"
 	"github.com/pkg/errors"
 
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
 type EnvoyAdminClient interface {
 	GenerateAPIToken(dataplane *core_mesh.DataplaneResource) (string, error)
 	PostQuit(dataplane *core_mesh.DataplaneResource) error
+	// Stats returns the values of the counters and gauges of the
+	// dataplane whose names match the filter regex.
+	Stats(dataplane *core_mesh.DataplaneResource, filter string) (map[string]uint64, error)
+	// Tap streams the requests that pass through the tap filter with the
+	// given config ID until maxTraces are collected or the duration elapses.
+	// Bodies are truncated to maxBodyBytes.
+	Tap(dataplane *core_mesh.DataplaneResource, configID string, maxTraces uint32, maxBodyBytes uint32, duration time.Duration) ([]*envoy_data_tap_v3.TraceWrapper, error)
 }
 
 type envoyAdminClient struct {
@@ -55,6 +71,8 @@ func NewEnvoyAdminClient(rm manager.ResourceManager, cfg kuma_cp.Config) EnvoyAd
 
 const (
 	quitquitquit = "quitquitquit"
+	stats        = "stats"
+	tap          = "tap"
 )
 
 func (a *envoyAdminClient) GenerateAPIToken(dataplane *core_mesh.DataplaneResource) (string, error) {
@@ -122,3 +140,147 @@ func (a *envoyAdminClient) PostQuit(dataplane *core_mesh.DataplaneResource) erro
 
 	return nil
 }
+
+// statsResponse is the response of the /stats?format=json endpoint of the
+// Envoy admin API. Histograms are also listed in stats, but they don't
+// have a name and a value.
+type statsResponse struct {
+	Stats []struct {
+		Name  string  `json:"name"`
+		Value *uint64 `json:"value"`
+	} `json:"stats"`
+}
+
+func (a *envoyAdminClient) Stats(dataplane *core_mesh.DataplaneResource, filter string) (map[string]uint64, error) {
+	token, err := a.GenerateAPIToken(dataplane)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{
+		"format": []string{"json"},
+		"filter": []string{filter},
+	}
+	url := fmt.Sprintf("%s://%s/%s?%s", a.scheme, a.adminAddress(dataplane), stats, query.Encode())
+
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	response, err := a.httpClient.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to send GET to %s", stats)
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("envoy response [%d %s] [%s]", response.StatusCode, response.Status, body)
+	}
+
+	parsed := statsResponse{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, errors.Wrap(err, "unable to parse Envoy stats")
+	}
+
+	values := map[string]uint64{}
+	for _, stat := range parsed.Stats {
+		if stat.Name != "" && stat.Value != nil {
+			values[stat.Name] = *stat.Value
+		}
+	}
+
+	return values, nil
+}
+
+func (a *envoyAdminClient) Tap(
+	dataplane *core_mesh.DataplaneResource,
+	configID string,
+	maxTraces uint32,
+	maxBodyBytes uint32,
+	duration time.Duration,
+) ([]*envoy_data_tap_v3.TraceWrapper, error) {
+	token, err := a.GenerateAPIToken(dataplane)
+	if err != nil {
+		return nil, err
+	}
+
+	tapRequest := &envoy_admin_v3.TapRequest{
+		ConfigId: configID,
+		TapConfig: &envoy_config_tap_v3.TapConfig{
+			MatchConfig: &envoy_config_tap_v3.MatchPredicate{
+				Rule: &envoy_config_tap_v3.MatchPredicate_AnyMatch{
+					AnyMatch: true,
+				},
+			},
+			OutputConfig: &envoy_config_tap_v3.OutputConfig{
+				Sinks: []*envoy_config_tap_v3.OutputSink{{
+					Format: envoy_config_tap_v3.OutputSink_JSON_BODY_AS_BYTES,
+					OutputSinkType: &envoy_config_tap_v3.OutputSink_StreamingAdmin{
+						StreamingAdmin: &envoy_config_tap_v3.StreamingAdminSink{},
+					},
+				}},
+				MaxBufferedRxBytes: util_proto.UInt32(maxBodyBytes),
+				MaxBufferedTxBytes: util_proto.UInt32(maxBodyBytes),
+			},
+		},
+	}
+	body, err := util_proto.ToJSON(tapRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s://%s/%s", a.scheme, a.adminAddress(dataplane), tap)
+
+	// Envoy streams the traces until the request is closed, so the
+	// duration bounds the request instead of the timeout of the client.
+	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	client := *a.httpClient
+	client.Timeout = 0
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to send POST to %s", tap)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(response.Body)
+		return nil, errors.Errorf("envoy response [%d %s] [%s]", response.StatusCode, response.Status, body)
+	}
+
+	var traces []*envoy_data_tap_v3.TraceWrapper
+	decoder := json.NewDecoder(response.Body)
+	for uint32(len(traces)) < maxTraces {
+		raw := json.RawMessage{}
+		if err := decoder.Decode(&raw); err != nil {
+			// the stream ends when the duration elapses
+			if ctx.Err() != nil || errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, errors.Wrap(err, "unable to read Envoy taps")
+		}
+		trace := &envoy_data_tap_v3.TraceWrapper{}
+		if err := util_proto.FromJSON(raw, trace); err != nil {
+			return nil, errors.Wrap(err, "unable to parse Envoy taps")
+		}
+		traces = append(traces, trace)
+	}
+
+	return traces, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/api/generic"
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
@@ -118,6 +119,9 @@ func (f *subscriptionFinalizer) checkGeneration(typ core_model.ResourceType) err
 
 		log.V(1).Info("mark subscription as disconnected")
 		insight.GetLastSubscription().SetDisconnectTime(core.Now())
+		if s, ok := insight.GetLastSubscription().(*mesh_proto.DiscoverySubscription); ok {
+			s.DisconnectReason = mesh_proto.DisconnectReasonIdleTimeout
+		}
 
 		upsertInsight, _ := registry.Global().NewObject(typ)
 		err := manager.Upsert(f.rm, key, upsertInsight, func(r core_model.Resource) error {
@@ -1,8 +1,6 @@
 package insights
 
 import (
-	"golang.org/x/time/rate"
-
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
@@ -14,10 +12,8 @@ func Setup(rt runtime.Runtime) error {
 		EventReaderFactory: rt.EventReaderFactory(),
 		MinResyncTimeout:   rt.Config().Metrics.Mesh.MinResyncTimeout,
 		MaxResyncTimeout:   rt.Config().Metrics.Mesh.MaxResyncTimeout,
-		RateLimiterFactory: func() *rate.Limiter {
-			return rate.NewLimiter(rate.Every(rt.Config().Metrics.Mesh.MinResyncTimeout), 0)
-		},
-		Registry: registry.Global(),
+		ResyncJitter:       rt.Config().Metrics.Mesh.ResyncJitter,
+		Registry:           registry.Global(),
 	})
 	return rt.Add(component.NewResilientComponent(log, resyncer))
 }
@@ -3,11 +3,11 @@ package insights
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/time/rate"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
@@ -35,21 +35,45 @@ type Config struct {
 	EventReaderFactory events.ListenerFactory
 	MinResyncTimeout   time.Duration
 	MaxResyncTimeout   time.Duration
-	Tick               func(d time.Duration) <-chan time.Time
-	RateLimiterFactory func() *rate.Limiter
+	// ResyncJitter is a fraction of MaxResyncTimeout - MinResyncTimeout over which periodic resyncs of meshes are spread
+	ResyncJitter float64
+	Tick         func(d time.Duration) <-chan time.Time
+}
+
+// insightTypes are the insights of a mesh that have to be resynced.
+type insightTypes struct {
+	mesh    bool
+	service bool
+	// changed is true when resources of the mesh changed, so the resync cannot be skipped
+	// even if insights were synced less than MinResyncTimeout ago
+	changed bool
+}
+
+func (t insightTypes) merge(other insightTypes) insightTypes {
+	return insightTypes{
+		mesh:    t.mesh || other.mesh,
+		service: t.service || other.service,
+		changed: t.changed || other.changed,
+	}
 }
 
 type resyncer struct {
-	rm                 manager.ResourceManager
-	eventFactory       events.ListenerFactory
-	minResyncTimeout   time.Duration
-	maxResyncTimeout   time.Duration
-	tick               func(d time.Duration) <-chan time.Time
-	rateLimiterFactory func() *rate.Limiter
-	meshInsightMux     sync.Mutex
-	serviceInsightMux  sync.Mutex
-	rateLimiters       map[string]*rate.Limiter
-	registry           registry.TypeRegistry
+	rm                manager.ResourceManager
+	eventFactory      events.ListenerFactory
+	minResyncTimeout  time.Duration
+	maxResyncTimeout  time.Duration
+	resyncJitter      float64
+	tick              func(d time.Duration) <-chan time.Time
+	meshInsightMux    sync.Mutex
+	serviceInsightMux sync.Mutex
+	registry          registry.TypeRegistry
+
+	// pending are insights waiting for the worker, at most one entry per mesh
+	pendingMux sync.Mutex
+	pending    map[string]insightTypes
+	// deferred are insights of meshes that changed within MinResyncTimeout since the last resync
+	deferred map[string]insightTypes
+	wakeup   chan struct{}
 }
 
 // NewResyncer creates a new Component that periodically updates insights
@@ -57,18 +81,23 @@ type resyncer struct {
 //
 // It operates with 2 timeouts: MinResyncTimeout and MaxResyncTimeout. Component
 // guarantees resync won't happen more often than MinResyncTimeout. It also guarantees
-// during MaxResyncTimeout at least one resync will happen. MinResyncTimeout is provided
-// by RateLimiter. MaxResyncTimeout is provided by goroutine with Ticker, it runs
-// resync every t = MaxResyncTimeout - MinResyncTimeout.
+// during MaxResyncTimeout at least one resync will happen. MaxResyncTimeout is provided
+// by goroutine with Ticker, it runs resync every t = MaxResyncTimeout - MinResyncTimeout.
+//
+// Insights are computed by a dedicated worker. Store events only mark the insights of the affected mesh
+// as pending, so many events of a mesh result in a single resync and the event loop is never blocked.
+// Periodic resyncs of meshes are spread by ResyncJitter to avoid recomputing insights of all meshes at once.
 func NewResyncer(config *Config) component.Component {
 	r := &resyncer{
-		minResyncTimeout:   config.MinResyncTimeout,
-		maxResyncTimeout:   config.MaxResyncTimeout,
-		eventFactory:       config.EventReaderFactory,
-		rm:                 config.ResourceManager,
-		rateLimiterFactory: config.RateLimiterFactory,
-		rateLimiters:       map[string]*rate.Limiter{},
-		registry:           config.Registry,
+		minResyncTimeout: config.MinResyncTimeout,
+		maxResyncTimeout: config.MaxResyncTimeout,
+		resyncJitter:     config.ResyncJitter,
+		eventFactory:     config.EventReaderFactory,
+		rm:               config.ResourceManager,
+		registry:         config.Registry,
+		pending:          map[string]insightTypes{},
+		deferred:         map[string]insightTypes{},
+		wakeup:           make(chan struct{}, 1),
 	}
 
 	r.tick = config.Tick
@@ -80,16 +109,17 @@ func NewResyncer(config *Config) component.Component {
 }
 
 func (r *resyncer) Start(stop <-chan struct{}) error {
+	go r.runWorker(stop)
+
 	go func(stop <-chan struct{}) {
-		ticker := r.tick(r.maxResyncTimeout - r.minResyncTimeout)
+		interval := r.maxResyncTimeout - r.minResyncTimeout
+		// jitter delays resyncs, so the interval is shortened to keep the guarantee of MaxResyncTimeout
+		ticker := r.tick(interval - time.Duration(float64(interval)*r.resyncJitter))
 		for {
 			select {
 			case <-ticker:
-				if err := r.createOrUpdateMeshInsights(); err != nil {
-					log.Error(err, "unable to resync MeshInsight")
-				}
-				if err := r.createOrUpdateServiceInsights(); err != nil {
-					log.Error(err, "unable to resync ServiceInsight")
+				if err := r.scheduleResyncOfAllMeshes(time.Duration(float64(interval) * r.resyncJitter)); err != nil {
+					log.Error(err, "unable to schedule resync of insights")
 				}
 			case <-stop:
 				log.Info("stop")
@@ -114,63 +144,137 @@ func (r *resyncer) Start(stop <-chan struct{}) error {
 		desc, err := r.registry.DescriptorFor(resourceChanged.Type)
 		if err != nil {
 			log.Error(err, "Resource is not registered in the registry, ignoring it", "resource", resourceChanged.Type)
+			continue
 		}
 		if resourceChanged.Type == core_mesh.MeshType && resourceChanged.Operation == events.Delete {
-			r.deleteRateLimiter(resourceChanged.Key.Name)
-		}
-		if !r.getRateLimiter(resourceChanged.Key.Mesh).Allow() {
+			r.forget(resourceChanged.Key.Name)
 			continue
 		}
+		types := insightTypes{changed: true}
 		if resourceChanged.Type == core_mesh.DataplaneType || resourceChanged.Type == core_mesh.DataplaneInsightType {
-			if err := r.createOrUpdateServiceInsight(resourceChanged.Key.Mesh); err != nil {
-				log.Error(err, "unable to resync ServiceInsight", "mesh", resourceChanged.Key.Mesh)
-			}
+			types.service = true
 		}
-		if desc.Scope == model.ScopeGlobal {
-			continue
+		// 'Update' events doesn't affect MeshInsight except for DataplaneInsight,
+		// because that's how we find online/offline Dataplane's status
+		if desc.Scope == model.ScopeMesh && (resourceChanged.Operation != events.Update || resourceChanged.Type == core_mesh.DataplaneInsightType) {
+			types.mesh = true
 		}
-		if resourceChanged.Operation == events.Update && resourceChanged.Type != core_mesh.DataplaneInsightType {
-			// 'Update' events doesn't affect MeshInsight except for DataplaneInsight,
-			// because that's how we find online/offline Dataplane's status
+		if !types.mesh && !types.service {
 			continue
 		}
-		if err := r.createOrUpdateMeshInsight(resourceChanged.Key.Mesh); err != nil {
-			log.Error(err, "unable to resync MeshInsight", "mesh", resourceChanged.Key.Mesh)
+		r.enqueue(resourceChanged.Key.Mesh, types)
+	}
+}
+
+// scheduleResyncOfAllMeshes enqueues insights of every mesh, each after a random delay lower than maxDelay.
+func (r *resyncer) scheduleResyncOfAllMeshes(maxDelay time.Duration) error {
+	meshes := &core_mesh.MeshResourceList{}
+	if err := r.rm.List(context.Background(), meshes); err != nil {
+		return err
+	}
+	for _, mesh := range meshes.Items {
+		meshName := mesh.GetMeta().GetName()
+		types := insightTypes{mesh: true, service: true}
+		if maxDelay <= 0 {
+			r.enqueue(meshName, types)
 			continue
 		}
+		time.AfterFunc(time.Duration(rand.Int63n(int64(maxDelay))), func() {
+			r.enqueue(meshName, types)
+		})
 	}
+	return nil
 }
 
-func (r *resyncer) getRateLimiter(mesh string) *rate.Limiter {
-	if _, ok := r.rateLimiters[mesh]; !ok {
-		r.rateLimiters[mesh] = r.rateLimiterFactory()
+func (r *resyncer) enqueue(mesh string, types insightTypes) {
+	r.pendingMux.Lock()
+	r.pending[mesh] = r.pending[mesh].merge(types)
+	r.pendingMux.Unlock()
+	select {
+	case r.wakeup <- struct{}{}:
+	default: // the worker is already notified
 	}
-	return r.rateLimiters[mesh]
 }
 
-func (r *resyncer) deleteRateLimiter(mesh string) {
-	if _, ok := r.rateLimiters[mesh]; !ok {
+func (r *resyncer) forget(mesh string) {
+	r.pendingMux.Lock()
+	defer r.pendingMux.Unlock()
+	delete(r.pending, mesh)
+	delete(r.deferred, mesh)
+}
+
+func (r *resyncer) takePending() map[string]insightTypes {
+	r.pendingMux.Lock()
+	defer r.pendingMux.Unlock()
+	pending := r.pending
+	r.pending = map[string]insightTypes{}
+	return pending
+}
+
+// deferResync enqueues changed insights of a mesh again after MinResyncTimeout.
+// There is at most one deferred resync per mesh.
+func (r *resyncer) deferResync(mesh string, types insightTypes) {
+	r.pendingMux.Lock()
+	defer r.pendingMux.Unlock()
+	if deferred, ok := r.deferred[mesh]; ok {
+		r.deferred[mesh] = deferred.merge(types)
 		return
 	}
-	delete(r.rateLimiters, mesh)
+	r.deferred[mesh] = types
+	time.AfterFunc(r.minResyncTimeout, func() {
+		r.pendingMux.Lock()
+		types := r.deferred[mesh]
+		delete(r.deferred, mesh)
+		r.pendingMux.Unlock()
+		r.enqueue(mesh, types)
+	})
 }
 
-func (r *resyncer) createOrUpdateServiceInsights() error {
-	meshes := &core_mesh.MeshResourceList{}
-	if err := r.rm.List(context.Background(), meshes); err != nil {
-		return err
+func (r *resyncer) runWorker(stop <-chan struct{}) {
+	for {
+		select {
+		case <-r.wakeup:
+		case <-stop:
+			return
+		}
+		for mesh, types := range r.takePending() {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			r.resync(mesh, types)
+		}
 	}
-	for _, mesh := range meshes.Items {
-		if need, err := r.needResyncServiceInsight(mesh.GetMeta().GetName()); err != nil || !need {
-			continue
+}
+
+func (r *resyncer) resync(mesh string, types insightTypes) {
+	if types.service {
+		need, err := r.needResyncServiceInsight(mesh)
+		switch {
+		case err != nil:
+			log.Error(err, "unable to check ServiceInsight", "mesh", mesh)
+		case need:
+			if err := r.createOrUpdateServiceInsight(mesh); err != nil {
+				log.Error(err, "unable to resync ServiceInsight", "mesh", mesh)
+			}
+		case types.changed:
+			r.deferResync(mesh, insightTypes{service: true, changed: true})
 		}
-		err := r.createOrUpdateServiceInsight(mesh.GetMeta().GetName())
-		if err != nil {
-			log.Error(err, "unable to resync resources", "mesh", mesh.GetMeta().GetName())
-			continue
+	}
+	if types.mesh {
+		need, err := r.needResyncMeshInsight(mesh)
+		switch {
+		case err != nil:
+			log.Error(err, "unable to check MeshInsight", "mesh", mesh)
+		case need:
+			if err := r.createOrUpdateMeshInsight(mesh); err != nil {
+				log.Error(err, "unable to resync MeshInsight", "mesh", mesh)
+			}
+		case types.changed:
+			r.deferResync(mesh, insightTypes{mesh: true, changed: true})
 		}
 	}
-	return nil
 }
 
 func addDpStatusToInsight(insight *mesh_proto.ServiceInsight, svcName string, status core_mesh.Status) {
@@ -269,24 +373,6 @@ func (r *resyncer) createOrUpdateServiceInsight(mesh string) error {
 	return nil
 }
 
-func (r *resyncer) createOrUpdateMeshInsights() error {
-	meshes := &core_mesh.MeshResourceList{}
-	if err := r.rm.List(context.Background(), meshes); err != nil {
-		return err
-	}
-	for _, mesh := range meshes.Items {
-		if need, err := r.needResyncMeshInsight(mesh.GetMeta().GetName()); err != nil || !need {
-			continue
-		}
-		err := r.createOrUpdateMeshInsight(mesh.GetMeta().GetName())
-		if err != nil {
-			log.Error(err, "unable to resync resources", "mesh", mesh.GetMeta().GetName())
-			continue
-		}
-	}
-	return nil
-}
-
 func (r *resyncer) createOrUpdateMeshInsight(mesh string) error {
 	r.meshInsightMux.Lock()
 	defer r.meshInsightMux.Unlock()
@@ -308,6 +394,17 @@ func (r *resyncer) createOrUpdateMeshInsight(mesh string) error {
 		},
 	}
 
+	meshRes := core_mesh.NewMeshResource()
+	if err := r.rm.Get(context.Background(), meshRes, store.GetByKey(mesh, model.NoMesh)); err != nil && !store.IsResourceNotFound(err) {
+		return err
+	}
+	if meshRes.FIPSEnabled() {
+		insight.Fips = &mesh_proto.MeshInsight_FIPS{
+			Compliant:    &mesh_proto.MeshInsight_DataplaneStat{},
+			NonCompliant: &mesh_proto.MeshInsight_DataplaneStat{},
+		}
+	}
+
 	dataplanes := &core_mesh.DataplaneResourceList{}
 	if err := r.rm.List(context.Background(), dataplanes, store.ListByMesh(mesh)); err != nil {
 		return err
@@ -364,6 +461,10 @@ func (r *resyncer) createOrUpdateMeshInsight(mesh string) error {
 		updateTotal(kumaDpVersion, insight.DpVersions.KumaDp)
 		updateTotal(envoyVersion, insight.DpVersions.Envoy)
 		updateMTLS(dpInsight.GetMTLS(), status, insight.MTLS)
+		updateFIPS(dpSubscription.GetVersion().GetEnvoy(), status, insight.Fips)
+		if status == core_mesh.Online || status == core_mesh.PartiallyDegraded {
+			insight.ResourceUsage = updateResourceUsage(dpInsight.GetLatestResourceUsage(), insight.ResourceUsage)
+		}
 
 		if svc := networking.GetGateway().GetTags()[mesh_proto.ServiceTag]; svc != "" {
 			internalServices[svc] = struct{}{}
@@ -459,6 +560,59 @@ func updateMTLS(mtlsInsight *mesh_proto.DataplaneInsight_MTLS, status core_mesh.
 	}
 }
 
+func updateFIPS(envoyVersion *mesh_proto.EnvoyVersion, status core_mesh.Status, stats *mesh_proto.MeshInsight_FIPS) {
+	if stats == nil {
+		return
+	}
+
+	stat := stats.NonCompliant
+	if envoyVersion.IsFIPS() {
+		stat = stats.Compliant
+	}
+
+	switch status {
+	case core_mesh.Online:
+		stat.Online++
+	case core_mesh.PartiallyDegraded:
+		stat.PartiallyDegraded++
+	case core_mesh.Offline:
+		stat.Offline++
+	}
+	stat.Total++
+}
+
+func updateResourceUsage(usage *mesh_proto.ResourceUsage, stats *mesh_proto.MeshInsight_ResourceUsage) *mesh_proto.MeshInsight_ResourceUsage {
+	if usage == nil {
+		return stats
+	}
+	if stats == nil {
+		stats = &mesh_proto.MeshInsight_ResourceUsage{
+			KumaDp: &mesh_proto.MeshInsight_ProcessResourceUsageStat{},
+			Envoy:  &mesh_proto.MeshInsight_ProcessResourceUsageStat{},
+		}
+	}
+	stats.Dataplanes++
+	stats.ActiveConnections += usage.GetActiveConnections()
+	updateProcessResourceUsage(usage.GetKumaDp(), stats.KumaDp)
+	updateProcessResourceUsage(usage.GetEnvoy(), stats.Envoy)
+	return stats
+}
+
+func updateProcessResourceUsage(usage *mesh_proto.ProcessResourceUsage, stat *mesh_proto.MeshInsight_ProcessResourceUsageStat) {
+	stat.TotalCpuMillicores += usage.GetCpuMillicores()
+	if usage.GetCpuMillicores() > stat.MaxCpuMillicores {
+		stat.MaxCpuMillicores = usage.GetCpuMillicores()
+	}
+	stat.TotalRssBytes += usage.GetRssBytes()
+	if usage.GetRssBytes() > stat.MaxRssBytes {
+		stat.MaxRssBytes = usage.GetRssBytes()
+	}
+	stat.TotalOpenFds += uint64(usage.GetOpenFds())
+	if usage.GetOpenFds() > stat.MaxOpenFds {
+		stat.MaxOpenFds = usage.GetOpenFds()
+	}
+}
+
 func updateTotal(version string, dpStats map[string]*mesh_proto.MeshInsight_DataplaneStat) {
 	dpStats[version].Total = dpStats[version].Online + dpStats[version].Offline
 }
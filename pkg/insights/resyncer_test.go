@@ -96,6 +96,44 @@ var _ = Describe("Insight Persistence", func() {
 		Expect(insight.Spec.LastSync).To(MatchProto(proto.MustTimestampProto(now)))
 	})
 
+	It("should resync insights of a mesh on store events without waiting for the periodic resync", func() {
+		// given
+		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		err = rm.Create(context.Background(), &core_mesh.TrafficPermissionResource{Spec: samples.TrafficPermission}, store.CreateByKey("tp-1", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		err = rm.Create(context.Background(), &core_mesh.DataplaneResource{Spec: samples.Dataplane}, store.CreateByKey("dp-1", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		eventCh <- events.ResourceChangedEvent{
+			Operation: events.Create,
+			Type:      core_mesh.TrafficPermissionType,
+			Key:       model.ResourceKey{Mesh: "mesh-1", Name: "tp-1"},
+		}
+		eventCh <- events.ResourceChangedEvent{
+			Operation: events.Create,
+			Type:      core_mesh.DataplaneType,
+			Key:       model.ResourceKey{Mesh: "mesh-1", Name: "dp-1"},
+		}
+
+		// then
+		meshInsight := core_mesh.NewMeshInsightResource()
+		Eventually(func() error {
+			return rm.Get(context.Background(), meshInsight, store.GetByKey("mesh-1", model.NoMesh))
+		}, "10s", "100ms").Should(BeNil())
+		Expect(meshInsight.Spec.Policies[string(core_mesh.TrafficPermissionType)].Total).To(Equal(uint32(1)))
+
+		// and
+		serviceInsight := core_mesh.NewServiceInsightResource()
+		Eventually(func() error {
+			return rm.Get(context.Background(), serviceInsight, store.GetByKey(insights.ServiceInsightName("mesh-1"), "mesh-1"))
+		}, "10s", "100ms").Should(BeNil())
+		Expect(serviceInsight.Spec.Services).To(HaveKey("backend"))
+	})
+
 	It("should count dataplanes by version", func() {
 		// setup
 		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
@@ -318,6 +356,140 @@ var _ = Describe("Insight Persistence", func() {
 		Expect(meshInsight.Spec.MTLS.SupportedBackends["ca-2"].Online).To(Equal(uint32(1)))
 	})
 
+	It("should count FIPS compliant dataplanes", func() {
+		// given mesh with FIPS mode
+		mesh := core_mesh.NewMeshResource()
+		mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+			EnabledBackend: "ca-1",
+			Backends: []*mesh_proto.CertificateAuthorityBackend{
+				{
+					Name: "ca-1",
+					Type: "builtin",
+				},
+			},
+			Fips: true,
+		}
+		err := rm.Create(context.Background(), mesh, store.CreateByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and dp1 with FIPS build of Envoy
+		err = rm.Create(context.Background(), &core_mesh.DataplaneResource{Spec: samples.Dataplane}, store.CreateByKey("dp1", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		dp1 := core_mesh.NewDataplaneInsightResource()
+		dp1.Spec.Subscriptions = append(dp1.Spec.Subscriptions, &mesh_proto.DiscoverySubscription{
+			ConnectTime: &timestamppb.Timestamp{
+				Seconds: 100,
+			},
+			Version: &mesh_proto.Version{
+				Envoy: &mesh_proto.EnvoyVersion{
+					Version: "1.18.3",
+					Build:   "98c1c9e9a40804b93b074badad1cdf284b47d58b/1.18.3/Clean/RELEASE/BoringSSL-FIPS",
+				},
+			},
+		})
+		err = rm.Create(context.Background(), dp1, store.CreateByKey("dp1", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and dp2 with regular build of Envoy
+		err = rm.Create(context.Background(), &core_mesh.DataplaneResource{Spec: samples.Dataplane}, store.CreateByKey("dp2", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		dp2 := core_mesh.NewDataplaneInsightResource()
+		dp2.Spec.Subscriptions = append(dp2.Spec.Subscriptions, &mesh_proto.DiscoverySubscription{
+			ConnectTime: &timestamppb.Timestamp{
+				Seconds: 100,
+			},
+			Version: &mesh_proto.Version{
+				Envoy: &mesh_proto.EnvoyVersion{
+					Version: "1.18.3",
+					Build:   "98c1c9e9a40804b93b074badad1cdf284b47d58b/1.18.3/Clean/RELEASE/BoringSSL",
+				},
+			},
+		})
+		err = rm.Create(context.Background(), dp2, store.CreateByKey("dp2", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when resyncer generates insight
+		nowMtx.Lock()
+		now = now.Add(60 * time.Second)
+		nowMtx.Unlock()
+		tickCh <- now
+
+		meshInsight := core_mesh.NewMeshInsightResource()
+		Eventually(func() error {
+			return rm.Get(context.Background(), meshInsight, store.GetByKey("mesh-1", model.NoMesh))
+		}, "10s", "100ms").Should(BeNil())
+
+		// then
+		Expect(meshInsight.Spec.Fips.Compliant.Total).To(Equal(uint32(1)))
+		Expect(meshInsight.Spec.Fips.Compliant.Online).To(Equal(uint32(1)))
+		Expect(meshInsight.Spec.Fips.NonCompliant.Total).To(Equal(uint32(1)))
+		Expect(meshInsight.Spec.Fips.NonCompliant.Online).To(Equal(uint32(1)))
+	})
+
+	It("should aggregate the resource usage of online dataplanes", func() {
+		// given
+		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		usages := []*mesh_proto.ResourceUsage{
+			{
+				KumaDp:            &mesh_proto.ProcessResourceUsage{CpuMillicores: 5, RssBytes: 100, OpenFds: 10},
+				Envoy:             &mesh_proto.ProcessResourceUsage{CpuMillicores: 50, RssBytes: 1000, OpenFds: 40},
+				ActiveConnections: 3,
+			},
+			{
+				KumaDp:            &mesh_proto.ProcessResourceUsage{CpuMillicores: 7, RssBytes: 200, OpenFds: 12},
+				Envoy:             &mesh_proto.ProcessResourceUsage{CpuMillicores: 20, RssBytes: 3000, OpenFds: 30},
+				ActiveConnections: 4,
+			},
+		}
+		for i, usage := range usages {
+			name := "dp-" + strconv.Itoa(i)
+			err = rm.Create(context.Background(), &core_mesh.DataplaneResource{Spec: samples.Dataplane}, store.CreateByKey(name, "mesh-1"))
+			Expect(err).ToNot(HaveOccurred())
+
+			dpInsight := core_mesh.NewDataplaneInsightResource()
+			dpInsight.Spec.Subscriptions = append(dpInsight.Spec.Subscriptions, &mesh_proto.DiscoverySubscription{
+				ConnectTime: &timestamppb.Timestamp{
+					Seconds: 100,
+				},
+			})
+			dpInsight.Spec.AddResourceUsage(usage)
+			err = rm.Create(context.Background(), dpInsight, store.CreateByKey(name, "mesh-1"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// when resyncer generates insight
+		nowMtx.Lock()
+		now = now.Add(60 * time.Second)
+		nowMtx.Unlock()
+		tickCh <- now
+
+		meshInsight := core_mesh.NewMeshInsightResource()
+		Eventually(func() error {
+			return rm.Get(context.Background(), meshInsight, store.GetByKey("mesh-1", model.NoMesh))
+		}, "10s", "100ms").Should(BeNil())
+
+		// then
+		usage := meshInsight.Spec.ResourceUsage
+		Expect(usage.Dataplanes).To(Equal(uint32(2)))
+		Expect(usage.ActiveConnections).To(Equal(uint64(7)))
+		Expect(usage.KumaDp.TotalCpuMillicores).To(Equal(uint64(12)))
+		Expect(usage.KumaDp.MaxCpuMillicores).To(Equal(uint64(7)))
+		Expect(usage.KumaDp.TotalRssBytes).To(Equal(uint64(300)))
+		Expect(usage.KumaDp.MaxRssBytes).To(Equal(uint64(200)))
+		Expect(usage.KumaDp.TotalOpenFds).To(Equal(uint64(22)))
+		Expect(usage.KumaDp.MaxOpenFds).To(Equal(uint32(12)))
+		Expect(usage.Envoy.TotalCpuMillicores).To(Equal(uint64(70)))
+		Expect(usage.Envoy.MaxCpuMillicores).To(Equal(uint64(50)))
+		Expect(usage.Envoy.TotalRssBytes).To(Equal(uint64(4000)))
+		Expect(usage.Envoy.MaxRssBytes).To(Equal(uint64(3000)))
+		Expect(usage.Envoy.TotalOpenFds).To(Equal(uint64(70)))
+		Expect(usage.Envoy.MaxOpenFds).To(Equal(uint32(40)))
+	})
+
 	It("should not count dataplane as a policy", func() {
 		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
 		Expect(err).ToNot(HaveOccurred())
@@ -10,9 +10,11 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	kds_mtls "github.com/kumahq/kuma/pkg/kds/mtls"
 	"github.com/kumahq/kuma/pkg/kds/mux"
 	"github.com/kumahq/kuma/pkg/kds/reconcile"
 	"github.com/kumahq/kuma/pkg/kds/util"
+	builtin_zone "github.com/kumahq/kuma/pkg/plugins/ca/builtin/zone"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
 )
 
@@ -40,16 +42,34 @@ func DefaultContext(manager manager.ResourceManager, zone string) *Context {
 }
 
 // GlobalProvidedFilter returns ResourceFilter which filters Resources provided by Global, specifically
-// excludes Dataplanes and Ingresses from 'clusterID' cluster
+// excludes Dataplanes and Ingresses from 'clusterID' cluster, Ingresses of disabled or drained zones
+// and Zones other than 'clusterID'
 func GlobalProvidedFilter(rm manager.ResourceManager, configs map[string]bool) reconcile.ResourceFilter {
 	return func(clusterID string, r model.Resource) bool {
 		resType := r.Descriptor().Name
 		if resType == system.ConfigType && !configs[r.GetMeta().GetName()] {
 			return false
 		}
+		if resType == system.ZoneType {
+			// every zone needs only its own Zone to know whether it's draining
+			return r.GetMeta().GetName() == clusterID
+		}
 		if resType == system.GlobalSecretType {
+			if zone, ok := kds_mtls.ZoneOfCertResource(model.MetaToResourceKey(r.GetMeta())); ok {
+				// a certificate of the zone is synced only to the zone it was issued to
+				return zone == clusterID
+			}
+			if zone, ok := zoneingress.ZoneOfTokenResource(model.MetaToResourceKey(r.GetMeta())); ok {
+				// a zone ingress token provisioned on enrollment is synced only to its zone
+				return zone == clusterID
+			}
 			return zoneingress.IsSigningKeyResource(model.MetaToResourceKey(r.GetMeta()))
 		}
+		if resType == system.SecretType {
+			if zone, isKey, ok := builtin_zone.ZoneOfSecret(r.GetMeta().GetName()); ok {
+				return zoneCaSecretFilter(rm, clusterID, zone, isKey)
+			}
+		}
 		if resType != mesh.DataplaneType && resType != mesh.ZoneIngressType {
 			return true
 		}
@@ -67,8 +87,25 @@ func GlobalProvidedFilter(rm manager.ResourceManager, configs map[string]bool) r
 			// make any strong decisions which might affect connectivity
 			return true
 		}
-		return zone.Spec.IsEnabled()
+		return zone.Spec.IsEnabled() && !zone.Spec.IsDrained(core.Now())
+	}
+}
+
+// zoneCaSecretFilter syncs the private key of a zone CA only to its own zone,
+// and the certificate of a zone CA to all zones as long as its zone is enabled.
+func zoneCaSecretFilter(rm manager.ResourceManager, clusterID string, zone string, isKey bool) bool {
+	if isKey {
+		return clusterID == zone
+	}
+	zoneRes := system.NewZoneResource()
+	if err := rm.Get(context.Background(), zoneRes, store.GetByKey(zone, model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return false
+		}
+		log.Error(err, "failed to get zone", "zone", zone)
+		return true
 	}
+	return zoneRes.Spec.IsEnabled()
 }
 
 // ZoneProvidedFilter filter Resources provided by Zone, specifically Ingresses that belongs to another zones
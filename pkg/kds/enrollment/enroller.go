@@ -0,0 +1,86 @@
+package enrollment
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	kds_mtls "github.com/kumahq/kuma/pkg/kds/mtls"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneenrollment"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var log = core.Log.WithName("kds-zone-enrollment")
+
+// Enroller registers a new zone in Global CP using a Zone Enrollment Token.
+// It provisions everything the Zone CP needs: the Zone resource, KDS credentials and the Zone Ingress Token.
+type Enroller struct {
+	resManager        manager.ResourceManager
+	enrollmentIssuer  zoneenrollment.TokenIssuer
+	zoneIngressIssuer zoneingress.TokenIssuer
+	kdsIssuer         *kds_mtls.Issuer
+}
+
+// NewEnroller creates an Enroller. kdsIssuer is nil when mTLS of KDS is disabled.
+func NewEnroller(
+	resManager manager.ResourceManager,
+	enrollmentIssuer zoneenrollment.TokenIssuer,
+	zoneIngressIssuer zoneingress.TokenIssuer,
+	kdsIssuer *kds_mtls.Issuer,
+) *Enroller {
+	return &Enroller{
+		resManager:        resManager,
+		enrollmentIssuer:  enrollmentIssuer,
+		zoneIngressIssuer: zoneIngressIssuer,
+		kdsIssuer:         kdsIssuer,
+	}
+}
+
+func (e *Enroller) Enroll(ctx context.Context, zone string, token zoneenrollment.Token) error {
+	identity, err := e.enrollmentIssuer.Validate(token)
+	if err != nil {
+		return errors.Wrap(err, "invalid zone enrollment token")
+	}
+	if identity.Zone != zone {
+		return errors.Errorf("zone enrollment token was generated for zone %q, not %q", identity.Zone, zone)
+	}
+	if err := zoneenrollment.MarkUsed(e.resManager, identity); err != nil {
+		return err
+	}
+	log.Info("enrolling a zone", "zone", zone)
+	zoneRes := &system.ZoneResource{
+		Spec: &system_proto.Zone{
+			Enabled: util_proto.Bool(true),
+		},
+	}
+	if err := e.resManager.Create(ctx, zoneRes, store.CreateByKey(zone, core_model.NoMesh)); err != nil && !store.IsResourceAlreadyExists(err) {
+		return errors.Wrap(err, "could not create a zone")
+	}
+	if e.kdsIssuer != nil {
+		if err := e.kdsIssuer.EnsureZoneCert(ctx, zone); err != nil {
+			return errors.Wrap(err, "could not issue a KDS certificate of the zone")
+		}
+	}
+	ingressToken, err := e.zoneIngressIssuer.Generate(zoneingress.Identity{Zone: zone})
+	if err != nil {
+		return errors.Wrap(err, "could not generate a zone ingress token")
+	}
+	err = manager.Upsert(e.resManager, zoneingress.ZoneTokenResourceKey(zone), system.NewGlobalSecretResource(), func(resource core_model.Resource) error {
+		resource.(*system.GlobalSecretResource).Spec = &system_proto.Secret{
+			Data: util_proto.Bytes([]byte(ingressToken)),
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not store a zone ingress token")
+	}
+	log.Info("zone enrolled", "zone", zone)
+	return nil
+}
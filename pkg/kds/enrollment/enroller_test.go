@@ -0,0 +1,109 @@
+package enrollment_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/config/multizone"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/kds/enrollment"
+	kds_mtls "github.com/kumahq/kuma/pkg/kds/mtls"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/tokens/builtin"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneenrollment"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
+)
+
+var _ = Describe("Enroller", func() {
+
+	var resManager manager.ResourceManager
+	var enrollmentIssuer zoneenrollment.TokenIssuer
+	var zoneIngressIssuer zoneingress.TokenIssuer
+	var enroller *enrollment.Enroller
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+
+		enrollmentKey, err := zoneenrollment.CreateSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resManager.Create(context.Background(), enrollmentKey, store.CreateBy(zoneenrollment.SigningKeyResourceKey()))).To(Succeed())
+		ingressKey, err := zoneingress.CreateSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resManager.Create(context.Background(), ingressKey, store.CreateBy(zoneingress.SigningKeyResourceKey()))).To(Succeed())
+
+		enrollmentIssuer, err = builtin.NewZoneEnrollmentTokenIssuer(resManager)
+		Expect(err).ToNot(HaveOccurred())
+		zoneIngressIssuer, err = builtin.NewZoneIngressTokenIssuer(resManager)
+		Expect(err).ToNot(HaveOccurred())
+		kdsIssuer := kds_mtls.NewIssuer(resManager, multizone.DefaultGlobalConfig().KDS.MTLS)
+		enroller = enrollment.NewEnroller(resManager, enrollmentIssuer, zoneIngressIssuer, kdsIssuer)
+	})
+
+	It("should provision the zone", func() {
+		// given
+		token, err := enrollmentIssuer.Generate("zone-1", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = enroller.Enroll(context.Background(), "zone-1", token)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		zone := system.NewZoneResource()
+		Expect(resManager.Get(context.Background(), zone, store.GetByKey("zone-1", model.NoMesh))).To(Succeed())
+		Expect(zone.Spec.IsEnabled()).To(BeTrue())
+
+		cert, err := kds_mtls.GetKeyPair(context.Background(), resManager, kds_mtls.ZoneCertResourceKey("zone-1"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert).ToNot(BeNil())
+
+		ingressToken := system.NewGlobalSecretResource()
+		Expect(resManager.Get(context.Background(), ingressToken, store.GetBy(zoneingress.ZoneTokenResourceKey("zone-1")))).To(Succeed())
+		identity, err := zoneIngressIssuer.Validate(string(ingressToken.Spec.GetData().GetValue()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(identity.Zone).To(Equal("zone-1"))
+	})
+
+	It("should not allow to use the token twice", func() {
+		// given
+		token, err := enrollmentIssuer.Generate("zone-1", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enroller.Enroll(context.Background(), "zone-1", token)).To(Succeed())
+
+		// when
+		err = enroller.Enroll(context.Background(), "zone-1", token)
+
+		// then
+		Expect(zoneenrollment.IsTokenAlreadyUsed(err)).To(BeTrue())
+	})
+
+	It("should reject a token generated for another zone", func() {
+		// given
+		token, err := enrollmentIssuer.Generate("zone-1", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = enroller.Enroll(context.Background(), "zone-2", token)
+
+		// then
+		Expect(err).To(MatchError(`zone enrollment token was generated for zone "zone-1", not "zone-2"`))
+		err = resManager.Get(context.Background(), system.NewZoneResource(), store.GetByKey("zone-2", model.NoMesh))
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+	})
+
+	It("should reject an invalid token", func() {
+		// when
+		err := enroller.Enroll(context.Background(), "zone-1", "not-a-token")
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid zone enrollment token"))
+	})
+})
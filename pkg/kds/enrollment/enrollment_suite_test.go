@@ -0,0 +1,11 @@
+package enrollment_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestEnrollment(t *testing.T) {
+	test.RunSpecs(t, "KDS Zone Enrollment Suite")
+}
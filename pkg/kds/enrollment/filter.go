@@ -0,0 +1,56 @@
+package enrollment
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/kds/mux"
+)
+
+// TokenHeaderKey is a key of the KDS stream metadata in which Zone CP sends the Zone Enrollment Token.
+const TokenHeaderKey = "zone-enrollment-token"
+
+type sessionFilter struct {
+	resManager manager.ReadOnlyResourceManager
+	enroller   *Enroller
+	required   bool
+}
+
+var _ mux.Filter = &sessionFilter{}
+
+// NewSessionFilter returns a filter that enrolls zones which are not registered yet and presented a Zone Enrollment Token.
+// If enrollment is required, zones that are not registered and did not present a token are rejected.
+func NewSessionFilter(resManager manager.ReadOnlyResourceManager, enroller *Enroller, required bool) mux.Filter {
+	return &sessionFilter{
+		resManager: resManager,
+		enroller:   enroller,
+		required:   required,
+	}
+}
+
+func (f *sessionFilter) InterceptSession(session mux.Session) error {
+	ctx := session.ServerStream().Context()
+	zone := session.PeerID()
+	err := f.resManager.Get(ctx, system.NewZoneResource(), store.GetByKey(zone, core_model.NoMesh))
+	if err == nil {
+		return nil // zone is already registered
+	}
+	if !store.IsResourceNotFound(err) {
+		return err
+	}
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md[TokenHeaderKey]) > 0 {
+		token = md[TokenHeaderKey][0]
+	}
+	if token == "" {
+		if f.required {
+			return errors.Errorf("zone %q is not registered, Zone CP has to present a zone enrollment token", zone)
+		}
+		return nil
+	}
+	return f.enroller.Enroll(ctx, zone, token)
+}
@@ -10,6 +10,7 @@ import (
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	store_config "github.com/kumahq/kuma/pkg/config/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core"
+	mesh_managers "github.com/kumahq/kuma/pkg/core/managers/apis/mesh"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -18,12 +19,15 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/kds/client"
+	"github.com/kumahq/kuma/pkg/kds/enrollment"
+	kds_mtls "github.com/kumahq/kuma/pkg/kds/mtls"
 	"github.com/kumahq/kuma/pkg/kds/mux"
 	kds_server "github.com/kumahq/kuma/pkg/kds/server"
 	sync_store "github.com/kumahq/kuma/pkg/kds/store"
 	"github.com/kumahq/kuma/pkg/kds/util"
 	resources_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s"
 	k8s_model "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/kumahq/kuma/pkg/tokens/builtin"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
@@ -56,6 +60,10 @@ func Setup(rt runtime.Runtime) (err error) {
 			log.Error(err, "Global CP could not create a zone")
 			return errors.New("Global CP could not create a zone") // send back message without details. Zone CP will retry
 		}
+		if err := ensureCAs(rt); err != nil {
+			// CAs are also ensured on every change of the Mesh, so we don't fail the session
+			log.Error(err, "Global CP could not ensure CAs of meshes")
+		}
 		sink := client.NewKDSSink(log, reg.ObjectTypes(model.HasKDSFlag(model.ConsumedByGlobal)), kdsStream, Callbacks(resourceSyncer, rt.Config().Store.Type == store_config.KubernetesStore, kubeFactory))
 		go func() {
 			if err := sink.Receive(); err != nil {
@@ -66,7 +74,26 @@ func Setup(rt runtime.Runtime) (err error) {
 		}()
 		return nil
 	})
-	return rt.Add(mux.NewServer(onSessionStarted, rt.KDSContext().GlobalServerFilters, *rt.Config().Multizone.Global.KDS, rt.Metrics()))
+	filters := append([]mux.Filter{}, rt.KDSContext().GlobalServerFilters...)
+	var kdsIssuer *kds_mtls.Issuer
+	if mtlsCfg := rt.Config().Multizone.Global.KDS.MTLS; mtlsCfg.Enabled {
+		kdsIssuer = kds_mtls.NewIssuer(rt.ResourceManager(), mtlsCfg)
+		filters = append(filters, kds_mtls.NewSessionFilter(rt.ResourceManager()))
+		if err := rt.Add(kdsIssuer); err != nil {
+			return err
+		}
+	}
+	enrollmentIssuer, err := builtin.NewZoneEnrollmentTokenIssuer(rt.ResourceManager())
+	if err != nil {
+		return err
+	}
+	zoneIngressIssuer, err := builtin.NewZoneIngressTokenIssuer(rt.ResourceManager())
+	if err != nil {
+		return err
+	}
+	enroller := enrollment.NewEnroller(rt.ResourceManager(), enrollmentIssuer, zoneIngressIssuer, kdsIssuer)
+	filters = append(filters, enrollment.NewSessionFilter(rt.ResourceManager(), enroller, rt.Config().Multizone.Global.KDS.RequireZoneEnrollment))
+	return rt.Add(mux.NewServer(onSessionStarted, filters, *rt.Config().Multizone.Global.KDS, rt.Metrics()))
 }
 
 func createZoneIfAbsent(name string, resManager manager.ResourceManager) error {
@@ -87,6 +114,20 @@ func createZoneIfAbsent(name string, resManager manager.ResourceManager) error {
 	return nil
 }
 
+// ensureCAs ensures CA backends of all meshes. It's required for CA backends that create a CA for every zone.
+func ensureCAs(rt runtime.Runtime) error {
+	meshes := &core_mesh.MeshResourceList{}
+	if err := rt.ResourceManager().List(context.Background(), meshes); err != nil {
+		return err
+	}
+	for _, mesh := range meshes.Items {
+		if err := mesh_managers.EnsureCAs(context.Background(), rt.CaManagers(), mesh, mesh.GetMeta().GetName()); err != nil {
+			return errors.Wrapf(err, "could not ensure CAs of mesh %q", mesh.GetMeta().GetName())
+		}
+	}
+	return nil
+}
+
 func Callbacks(s sync_store.ResourceSyncer, k8sStore bool, kubeFactory resources_k8s.KubeFactory) *client.Callbacks {
 	return &client.Callbacks{
 		OnResourcesReceived: func(clusterName string, rs model.ResourceList) error {
@@ -179,12 +179,13 @@ var _ = Describe("Global Sync", func() {
 			return !excludeTypes[descriptor.Name]
 		}))
 
-		// plus 4 global-scope types
+		// plus 5 global-scope types
 		extraTypes := []model.ResourceType{
 			mesh.MeshType,
 			mesh.ZoneIngressType,
 			system.ConfigType,
 			system.GlobalSecretType,
+			system.ZoneType,
 		}
 
 		actualProvidedTypes = append(actualProvidedTypes, extraTypes...)
@@ -0,0 +1,124 @@
+package mtls
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+)
+
+const (
+	rsaBits              = 2048
+	allowedClockSkew     = 10 * time.Second
+	caCertValidityPeriod = 10 * 365 * 24 * time.Hour
+	caCommonName         = "Kuma KDS CA"
+)
+
+// NewCA generates the self-signed CA that Global CP uses to issue certificates of Zone CPs.
+func NewCA() (*util_tls.KeyPair, error) {
+	key, err := rsa.GenerateKey(rand.Reader, rsaBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate a private key")
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+	now := core.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization:       []string{"Kuma"},
+			OrganizationalUnit: []string{"KDS"},
+			CommonName:         caCommonName,
+		},
+		NotBefore:             now.Add(-allowedClockSkew),
+		NotAfter:              now.Add(caCertValidityPeriod),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate X509 certificate")
+	}
+	return util_tls.ToKeyPair(key, cert)
+}
+
+// IssueZoneCert issues a client certificate of the Zone CP. The zone is encoded as a Common Name of the certificate.
+func IssueZoneCert(ca util_tls.KeyPair, zone string, validity time.Duration) (*util_tls.KeyPair, error) {
+	caCert, caKey, err := parseCA(ca)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rsa.GenerateKey(rand.Reader, rsaBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate a private key")
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+	now := core.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization:       []string{"Kuma"},
+			OrganizationalUnit: []string{"Zone"},
+			CommonName:         zone,
+		},
+		NotBefore:             now.Add(-allowedClockSkew),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, caCert, key.Public(), caKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate X509 certificate")
+	}
+	return util_tls.ToKeyPair(key, cert)
+}
+
+// ParseCert returns the leaf certificate of the PEM encoded key pair.
+func ParseCert(pair util_tls.KeyPair) (*x509.Certificate, error) {
+	cert, err := tls.X509KeyPair(pair.CertPEM, pair.KeyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse a key pair")
+	}
+	return x509.ParseCertificate(cert.Certificate[0])
+}
+
+func parseCA(ca util_tls.KeyPair) (*x509.Certificate, crypto.Signer, error) {
+	pair, err := tls.X509KeyPair(ca.CertPEM, ca.KeyPEM)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not parse the CA")
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not parse the certificate of the CA")
+	}
+	signer, ok := pair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, nil, errors.Errorf("unsupported private key type %T", pair.PrivateKey)
+	}
+	return cert, signer, nil
+}
+
+func newSerialNumber() (*big.Int, error) {
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serial, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate serial number")
+	}
+	return serial, nil
+}
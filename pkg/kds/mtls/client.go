@@ -0,0 +1,25 @@
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/kds/mux"
+)
+
+// ZoneCertificateLoader returns the certificate that Global CP issued to the zone and synced over KDS.
+// The certificate is loaded on every connection, so a rotated certificate is picked up on reconnect.
+func ZoneCertificateLoader(resManager manager.ReadOnlyResourceManager, zone string) mux.ClientCertificateFunc {
+	return func() (*tls.Certificate, error) {
+		pair, err := GetKeyPair(context.Background(), resManager, ZoneCertResourceKey(zone))
+		if err != nil || pair == nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair(pair.CertPEM, pair.KeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		return &cert, nil
+	}
+}
@@ -0,0 +1,181 @@
+package mtls
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/kumahq/kuma/pkg/config/multizone"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+)
+
+var issuerLog = core.Log.WithName("kds-mtls-issuer")
+
+// Issuer manages certificates of Zone CPs. It issues a certificate to every registered zone,
+// reissues it before it expires and revokes it when the zone is removed.
+type Issuer struct {
+	resManager manager.ResourceManager
+	config     multizone.KdsMTLSConfig
+}
+
+var _ component.Component = &Issuer{}
+
+func NewIssuer(resManager manager.ResourceManager, config multizone.KdsMTLSConfig) *Issuer {
+	return &Issuer{
+		resManager: resManager,
+		config:     config,
+	}
+}
+
+func (i *Issuer) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(i.config.ReconcileInterval)
+	defer ticker.Stop()
+	issuerLog.Info("starting")
+	for {
+		if err := i.Reconcile(context.Background()); err != nil {
+			issuerLog.Error(err, "could not reconcile certificates of zones")
+		}
+		select {
+		case <-ticker.C:
+		case <-stop:
+			issuerLog.Info("stopping")
+			return nil
+		}
+	}
+}
+
+func (i *Issuer) NeedLeaderElection() bool {
+	return true
+}
+
+func (i *Issuer) Reconcile(ctx context.Context) error {
+	ca, err := i.ensureCA(ctx)
+	if err != nil {
+		return err
+	}
+	zones := &system.ZoneResourceList{}
+	if err := i.resManager.List(ctx, zones); err != nil {
+		return errors.Wrap(err, "could not list zones")
+	}
+	secrets := &system.GlobalSecretResourceList{}
+	if err := i.resManager.List(ctx, secrets); err != nil {
+		return errors.Wrap(err, "could not list global secrets")
+	}
+	issued := map[string]*system.GlobalSecretResource{}
+	for _, secret := range secrets.Items {
+		if zone, ok := ZoneOfCertResource(core_model.MetaToResourceKey(secret.GetMeta())); ok {
+			issued[zone] = secret
+		}
+	}
+
+	var errs error
+	registered := map[string]bool{}
+	for _, zone := range zones.Items {
+		name := zone.GetMeta().GetName()
+		registered[name] = true
+		if err := i.ensureZoneCert(ctx, *ca, name); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "could not issue a certificate of zone %q", name))
+		}
+	}
+
+	revocations, err := GetRevocationList(ctx, i.resManager)
+	if err != nil {
+		return multierr.Append(errs, err)
+	}
+	pruned := revocations.Prune(core.Now())
+	changed := len(pruned) != len(revocations)
+	var removed []string
+	for zone, secret := range issued {
+		if registered[zone] {
+			continue
+		}
+		removed = append(removed, zone)
+		if cert, err := parseSecretCert(secret); err == nil {
+			pruned = append(pruned, RevokedCert{
+				Serial:   cert.SerialNumber.String(),
+				Zone:     zone,
+				NotAfter: cert.NotAfter,
+			})
+			changed = true
+		}
+	}
+	// the revocation list has to be stored before certificates are deleted, otherwise we could lose track of them
+	if changed {
+		if err := upsertRevocationList(i.resManager, pruned); err != nil {
+			return multierr.Append(errs, errors.Wrap(err, "could not store the revocation list"))
+		}
+	}
+	for _, zone := range removed {
+		issuerLog.Info("revoking a certificate of the removed zone", "zone", zone)
+		if err := i.resManager.Delete(ctx, system.NewGlobalSecretResource(), store.DeleteBy(ZoneCertResourceKey(zone))); err != nil && !store.IsResourceNotFound(err) {
+			errs = multierr.Append(errs, errors.Wrapf(err, "could not delete a certificate of zone %q", zone))
+		}
+	}
+	return errs
+}
+
+// EnsureZoneCert issues a certificate of the zone right away if it does not have one yet.
+func (i *Issuer) EnsureZoneCert(ctx context.Context, zone string) error {
+	ca, err := i.ensureCA(ctx)
+	if err != nil {
+		return err
+	}
+	return i.ensureZoneCert(ctx, *ca, zone)
+}
+
+func (i *Issuer) ensureCA(ctx context.Context) (*util_tls.KeyPair, error) {
+	ca, err := GetKeyPair(ctx, i.resManager, CAResourceKey())
+	if err != nil {
+		return nil, err
+	}
+	if ca != nil {
+		return ca, nil
+	}
+	issuerLog.Info("generating the CA of KDS")
+	ca, err = NewCA()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate the CA of KDS")
+	}
+	if err := upsertKeyPair(i.resManager, CAResourceKey(), *ca); err != nil {
+		return nil, errors.Wrap(err, "could not store the CA of KDS")
+	}
+	return ca, nil
+}
+
+func (i *Issuer) ensureZoneCert(ctx context.Context, ca util_tls.KeyPair, zone string) error {
+	current, err := GetKeyPair(ctx, i.resManager, ZoneCertResourceKey(zone))
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		cert, err := ParseCert(*current)
+		if err == nil && core.Now().Add(i.config.RotationThreshold).Before(cert.NotAfter) {
+			return nil
+		}
+		issuerLog.Info("rotating a certificate of the zone", "zone", zone)
+	} else {
+		issuerLog.Info("issuing a certificate of the zone", "zone", zone)
+	}
+	pair, err := IssueZoneCert(ca, zone, i.config.CertValidityPeriod)
+	if err != nil {
+		return err
+	}
+	return upsertKeyPair(i.resManager, ZoneCertResourceKey(zone), *pair)
+}
+
+func parseSecretCert(secret *system.GlobalSecretResource) (*x509.Certificate, error) {
+	pair, err := util_tls.ParsePEMKeyPair(secret.Spec.GetData().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return ParseCert(*pair)
+}
@@ -0,0 +1,140 @@
+package mtls_test
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/config/multizone"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	kds_mtls "github.com/kumahq/kuma/pkg/kds/mtls"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Issuer", func() {
+
+	var resManager manager.ResourceManager
+	var issuer *kds_mtls.Issuer
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Now()
+		core.Now = func() time.Time {
+			return now
+		}
+		resManager = manager.NewResourceManager(memory.NewStore())
+		issuer = kds_mtls.NewIssuer(resManager, multizone.KdsMTLSConfig{
+			Enabled:            true,
+			CertValidityPeriod: 30 * 24 * time.Hour,
+			RotationThreshold:  10 * 24 * time.Hour,
+			ReconcileInterval:  time.Second,
+		})
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	createZone := func(name string) {
+		err := resManager.Create(context.Background(), &system.ZoneResource{Spec: &system_proto.Zone{Enabled: util_proto.Bool(true)}}, store.CreateByKey(name, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	zoneCertSerial := func(zone string) string {
+		pair, err := kds_mtls.GetKeyPair(context.Background(), resManager, kds_mtls.ZoneCertResourceKey(zone))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pair).ToNot(BeNil())
+		cert, err := kds_mtls.ParseCert(*pair)
+		Expect(err).ToNot(HaveOccurred())
+		return cert.SerialNumber.String()
+	}
+
+	It("should issue certificates of registered zones", func() {
+		// given
+		createZone("zone-1")
+
+		// when
+		Expect(issuer.Reconcile(context.Background())).To(Succeed())
+
+		// then
+		ca, err := kds_mtls.GetKeyPair(context.Background(), resManager, kds_mtls.CAResourceKey())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ca).ToNot(BeNil())
+
+		pair, err := kds_mtls.GetKeyPair(context.Background(), resManager, kds_mtls.ZoneCertResourceKey("zone-1"))
+		Expect(err).ToNot(HaveOccurred())
+		cert, err := kds_mtls.ParseCert(*pair)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert.Subject.CommonName).To(Equal("zone-1"))
+		Expect(kds_mtls.NewVerifier(resManager).Verify(context.Background(), "zone-1", []*x509.Certificate{cert})).To(Succeed())
+	})
+
+	It("should not reissue a certificate that is not close to expiration", func() {
+		// given
+		createZone("zone-1")
+		Expect(issuer.Reconcile(context.Background())).To(Succeed())
+		serial := zoneCertSerial("zone-1")
+
+		// when
+		now = now.Add(19 * 24 * time.Hour)
+		Expect(issuer.Reconcile(context.Background())).To(Succeed())
+
+		// then
+		Expect(zoneCertSerial("zone-1")).To(Equal(serial))
+	})
+
+	It("should rotate a certificate that is close to expiration", func() {
+		// given
+		createZone("zone-1")
+		Expect(issuer.Reconcile(context.Background())).To(Succeed())
+		serial := zoneCertSerial("zone-1")
+
+		// when
+		now = now.Add(21 * 24 * time.Hour)
+		Expect(issuer.Reconcile(context.Background())).To(Succeed())
+
+		// then
+		Expect(zoneCertSerial("zone-1")).ToNot(Equal(serial))
+	})
+
+	It("should revoke a certificate of a removed zone", func() {
+		// given
+		createZone("zone-1")
+		Expect(issuer.Reconcile(context.Background())).To(Succeed())
+		serial := zoneCertSerial("zone-1")
+
+		// when
+		err := resManager.Delete(context.Background(), system.NewZoneResource(), store.DeleteByKey("zone-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(issuer.Reconcile(context.Background())).To(Succeed())
+
+		// then
+		pair, err := kds_mtls.GetKeyPair(context.Background(), resManager, kds_mtls.ZoneCertResourceKey("zone-1"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pair).To(BeNil())
+
+		revocations, err := kds_mtls.GetRevocationList(context.Background(), resManager)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revocations).To(HaveLen(1))
+		Expect(revocations[0].Serial).To(Equal(serial))
+		Expect(revocations[0].Zone).To(Equal("zone-1"))
+
+		// and when the certificate expires
+		now = now.Add(31 * 24 * time.Hour)
+		Expect(issuer.Reconcile(context.Background())).To(Succeed())
+
+		// then it's pruned from the revocation list
+		revocations, err = kds_mtls.GetRevocationList(context.Background(), resManager)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revocations).To(BeEmpty())
+	})
+})
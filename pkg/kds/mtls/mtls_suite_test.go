@@ -0,0 +1,11 @@
+package mtls_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestMTLS(t *testing.T) {
+	test.RunSpecs(t, "KDS mTLS Suite")
+}
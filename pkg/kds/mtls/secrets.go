@@ -0,0 +1,48 @@
+package mtls
+
+import (
+	"strings"
+
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+// Certificates of the KDS mTLS are kept by Global CP as GlobalSecrets.
+// The CA never leaves Global CP, a certificate of a zone is synced only to its own zone.
+const (
+	caSecretName         = "kds-ca"
+	revocationSecretName = "kds-revoked-certs"
+	zoneCertSecretPrefix = "kds-zone-cert."
+)
+
+func CAResourceKey() core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: core_model.NoMesh,
+		Name: caSecretName,
+	}
+}
+
+func RevocationListResourceKey() core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: core_model.NoMesh,
+		Name: revocationSecretName,
+	}
+}
+
+func ZoneCertResourceKey(zone string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: core_model.NoMesh,
+		Name: zoneCertSecretPrefix + zone,
+	}
+}
+
+// ZoneOfCertResource returns the zone to which the certificate kept in the GlobalSecret was issued.
+func ZoneOfCertResource(resKey core_model.ResourceKey) (string, bool) {
+	if resKey.Mesh != core_model.NoMesh || !strings.HasPrefix(resKey.Name, zoneCertSecretPrefix) {
+		return "", false
+	}
+	zone := strings.TrimPrefix(resKey.Name, zoneCertSecretPrefix)
+	if zone == "" {
+		return "", false
+	}
+	return zone, true
+}
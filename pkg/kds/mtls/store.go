@@ -0,0 +1,104 @@
+package mtls
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// RevokedCert is a certificate of a Zone CP that was revoked because the zone was removed.
+type RevokedCert struct {
+	Serial   string    `json:"serial"`
+	Zone     string    `json:"zone"`
+	NotAfter time.Time `json:"notAfter"`
+}
+
+type RevocationList []RevokedCert
+
+func (l RevocationList) IsRevoked(serial *big.Int) bool {
+	for _, revoked := range l {
+		if revoked.Serial == serial.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// Prune drops certificates that expired, there is no need to keep them since they are rejected anyway.
+func (l RevocationList) Prune(now time.Time) RevocationList {
+	var pruned RevocationList
+	for _, revoked := range l {
+		if now.Before(revoked.NotAfter) {
+			pruned = append(pruned, revoked)
+		}
+	}
+	return pruned
+}
+
+// GetKeyPair returns the key pair kept in the GlobalSecret. It returns nil if the GlobalSecret does not exist.
+func GetKeyPair(ctx context.Context, rm manager.ReadOnlyResourceManager, key core_model.ResourceKey) (*util_tls.KeyPair, error) {
+	secret := system.NewGlobalSecretResource()
+	if err := rm.Get(ctx, secret, store.GetBy(key)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "could not retrieve %q", key.Name)
+	}
+	pair, err := util_tls.ParsePEMKeyPair(secret.Spec.GetData().GetValue())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse a key pair of %q", key.Name)
+	}
+	return pair, nil
+}
+
+func upsertKeyPair(rm manager.ResourceManager, key core_model.ResourceKey, pair util_tls.KeyPair) error {
+	data := append(append([]byte{}, pair.CertPEM...), pair.KeyPEM...)
+	return upsertSecret(rm, key, data)
+}
+
+// GetRevocationList returns the list of revoked certificates of Zone CPs.
+func GetRevocationList(ctx context.Context, rm manager.ReadOnlyResourceManager) (RevocationList, error) {
+	secret := system.NewGlobalSecretResource()
+	if err := rm.Get(ctx, secret, store.GetBy(RevocationListResourceKey())); err != nil {
+		if store.IsResourceNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "could not retrieve the revocation list")
+	}
+	var list RevocationList
+	if err := json.Unmarshal(secret.Spec.GetData().GetValue(), &list); err != nil {
+		return nil, errors.Wrap(err, "could not parse the revocation list")
+	}
+	return list, nil
+}
+
+func upsertRevocationList(rm manager.ResourceManager, list RevocationList) error {
+	if list == nil {
+		list = RevocationList{}
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	return upsertSecret(rm, RevocationListResourceKey(), data)
+}
+
+func upsertSecret(rm manager.ResourceManager, key core_model.ResourceKey, data []byte) error {
+	return manager.Upsert(rm, key, system.NewGlobalSecretResource(), func(resource core_model.Resource) error {
+		resource.(*system.GlobalSecretResource).Spec = &system_proto.Secret{
+			Data: util_proto.Bytes(data),
+		}
+		return nil
+	})
+}
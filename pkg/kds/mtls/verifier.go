@@ -0,0 +1,104 @@
+package mtls
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/kds/mux"
+)
+
+// Verifier verifies the certificate presented by a Zone CP.
+type Verifier struct {
+	resManager manager.ReadOnlyResourceManager
+}
+
+func NewVerifier(resManager manager.ReadOnlyResourceManager) *Verifier {
+	return &Verifier{
+		resManager: resManager,
+	}
+}
+
+// Verify checks that the certificate chain was issued by the CA of KDS to the zone and that it was not revoked.
+// A zone that does not have a certificate yet is allowed to connect without one, so it can register and receive it.
+func (v *Verifier) Verify(ctx context.Context, zone string, chain []*x509.Certificate) error {
+	if len(chain) == 0 {
+		issued, err := GetKeyPair(ctx, v.resManager, ZoneCertResourceKey(zone))
+		if err != nil {
+			return err
+		}
+		if issued != nil {
+			return errors.Errorf("zone %q has a certificate issued by Global CP but did not present it", zone)
+		}
+		return nil
+	}
+	ca, err := GetKeyPair(ctx, v.resManager, CAResourceKey())
+	if err != nil {
+		return err
+	}
+	if ca == nil {
+		return errors.New("there is no CA of KDS in the Global CP")
+	}
+	caCert, err := ParseCert(*ca)
+	if err != nil {
+		return err
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	leaf := chain[0]
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   core.Now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return errors.Wrap(err, "certificate was not issued by the CA of KDS")
+	}
+	if leaf.Subject.CommonName != zone {
+		return errors.Errorf("certificate was issued to zone %q, not %q", leaf.Subject.CommonName, zone)
+	}
+	revocations, err := GetRevocationList(ctx, v.resManager)
+	if err != nil {
+		return err
+	}
+	if revocations.IsRevoked(leaf.SerialNumber) {
+		return errors.Errorf("certificate of zone %q was revoked", zone)
+	}
+	return nil
+}
+
+type sessionFilter struct {
+	verifier *Verifier
+}
+
+var _ mux.Filter = &sessionFilter{}
+
+// NewSessionFilter returns a filter that rejects KDS sessions of Zone CPs that did not present a valid certificate.
+func NewSessionFilter(resManager manager.ReadOnlyResourceManager) mux.Filter {
+	return &sessionFilter{
+		verifier: NewVerifier(resManager),
+	}
+}
+
+func (f *sessionFilter) InterceptSession(session mux.Session) error {
+	ctx := session.ServerStream().Context()
+	var chain []*x509.Certificate
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			chain = tlsInfo.State.PeerCertificates
+		}
+	}
+	if err := f.verifier.Verify(ctx, session.PeerID(), chain); err != nil {
+		return errors.Wrap(err, "zone could not be authenticated")
+	}
+	return nil
+}
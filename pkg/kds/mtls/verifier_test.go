@@ -0,0 +1,106 @@
+package mtls_test
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/config/multizone"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	kds_mtls "github.com/kumahq/kuma/pkg/kds/mtls"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Verifier", func() {
+
+	var resManager manager.ResourceManager
+	var verifier *kds_mtls.Verifier
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		verifier = kds_mtls.NewVerifier(resManager)
+	})
+
+	registerZone := func(name string) *x509.Certificate {
+		err := resManager.Create(context.Background(), &system.ZoneResource{Spec: &system_proto.Zone{Enabled: util_proto.Bool(true)}}, store.CreateByKey(name, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		issuer := kds_mtls.NewIssuer(resManager, multizone.DefaultGlobalConfig().KDS.MTLS)
+		Expect(issuer.Reconcile(context.Background())).To(Succeed())
+		pair, err := kds_mtls.GetKeyPair(context.Background(), resManager, kds_mtls.ZoneCertResourceKey(name))
+		Expect(err).ToNot(HaveOccurred())
+		cert, err := kds_mtls.ParseCert(*pair)
+		Expect(err).ToNot(HaveOccurred())
+		return cert
+	}
+
+	It("should allow a zone without a certificate to register", func() {
+		// when
+		err := verifier.Verify(context.Background(), "zone-1", nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject a zone that did not present the issued certificate", func() {
+		// given
+		registerZone("zone-1")
+
+		// when
+		err := verifier.Verify(context.Background(), "zone-1", nil)
+
+		// then
+		Expect(err).To(MatchError(`zone "zone-1" has a certificate issued by Global CP but did not present it`))
+	})
+
+	It("should reject a certificate of another zone", func() {
+		// given
+		cert := registerZone("zone-1")
+
+		// when
+		err := verifier.Verify(context.Background(), "zone-2", []*x509.Certificate{cert})
+
+		// then
+		Expect(err).To(MatchError(`certificate was issued to zone "zone-1", not "zone-2"`))
+	})
+
+	It("should reject a certificate that was not issued by the CA of KDS", func() {
+		// given
+		registerZone("zone-1")
+		otherCA, err := kds_mtls.NewCA()
+		Expect(err).ToNot(HaveOccurred())
+		pair, err := kds_mtls.IssueZoneCert(*otherCA, "zone-1", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		cert, err := kds_mtls.ParseCert(*pair)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = verifier.Verify(context.Background(), "zone-1", []*x509.Certificate{cert})
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("certificate was not issued by the CA of KDS"))
+	})
+
+	It("should reject a revoked certificate", func() {
+		// given
+		cert := registerZone("zone-1")
+		err := resManager.Delete(context.Background(), system.NewZoneResource(), store.DeleteByKey("zone-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		issuer := kds_mtls.NewIssuer(resManager, multizone.DefaultGlobalConfig().KDS.MTLS)
+		Expect(issuer.Reconcile(context.Background())).To(Succeed())
+
+		// when
+		err = verifier.Verify(context.Background(), "zone-1", []*x509.Certificate{cert})
+
+		// then
+		Expect(err).To(MatchError(`certificate of zone "zone-1" was revoked`))
+	})
+})
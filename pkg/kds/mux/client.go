@@ -23,23 +23,29 @@ var (
 	muxClientLog = core.Log.WithName("kds-mux-client")
 )
 
+// ClientCertificateFunc returns a certificate that the client presents to the server.
+// It returns nil if the client has no certificate.
+type ClientCertificateFunc func() (*tls.Certificate, error)
+
 type client struct {
-	callbacks Callbacks
-	globalURL string
-	clientID  string
-	config    multizone.KdsClientConfig
-	metrics   metrics.Metrics
-	ctx       context.Context
+	callbacks  Callbacks
+	globalURL  string
+	clientID   string
+	config     multizone.KdsClientConfig
+	clientCert ClientCertificateFunc
+	metrics    metrics.Metrics
+	ctx        context.Context
 }
 
-func NewClient(globalURL string, clientID string, callbacks Callbacks, config multizone.KdsClientConfig, metrics metrics.Metrics, ctx context.Context) component.Component {
+func NewClient(globalURL string, clientID string, callbacks Callbacks, config multizone.KdsClientConfig, clientCert ClientCertificateFunc, metrics metrics.Metrics, ctx context.Context) component.Component {
 	return &client{
-		callbacks: callbacks,
-		globalURL: globalURL,
-		clientID:  clientID,
-		config:    config,
-		metrics:   metrics,
-		ctx:       ctx,
+		callbacks:  callbacks,
+		globalURL:  globalURL,
+		clientID:   clientID,
+		config:     config,
+		clientCert: clientCert,
+		metrics:    metrics,
+		ctx:        ctx,
 	}
 }
 
@@ -61,6 +67,9 @@ func (c *client) Start(stop <-chan struct{}) (errs error) {
 		if err != nil {
 			return errors.Wrap(err, "could not ")
 		}
+		if c.clientCert != nil {
+			tlsConfig.GetClientCertificate = c.getClientCertificate
+		}
 		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 	default:
 		return errors.Errorf("unsupported scheme %q. Use one of %s", u.Scheme, []string{"grpc", "grpcs"})
@@ -114,6 +123,19 @@ func (c *client) NeedLeaderElection() bool {
 	return true
 }
 
+func (c *client) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	cert, err := c.clientCert()
+	if err != nil {
+		// don't fail the handshake, the server decides whether a client without a certificate is allowed
+		muxClientLog.Error(err, "could not load the client certificate, connecting without it")
+		return &tls.Certificate{}, nil
+	}
+	if cert == nil {
+		return &tls.Certificate{}, nil
+	}
+	return cert, nil
+}
+
 func tlsConfig(rootCaFile string) (*tls.Config, error) {
 	if rootCaFile == "" {
 		return &tls.Config{
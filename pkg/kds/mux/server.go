@@ -1,6 +1,7 @@
 package mux
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"time"
@@ -76,12 +77,22 @@ func (s *server) Start(stop <-chan struct{}) error {
 	}
 	grpcOptions = append(grpcOptions, s.metrics.GRPCServerInterceptors()...)
 	useTLS := s.config.TlsCertFile != ""
+	if s.config.MTLS.Enabled && !useTLS {
+		return errors.New("mTLS of KDS requires TLS certificate of the server")
+	}
 	if useTLS {
-		creds, err := credentials.NewServerTLSFromFile(s.config.TlsCertFile, s.config.TlsKeyFile)
+		cert, err := tls.LoadX509KeyPair(s.config.TlsCertFile, s.config.TlsKeyFile)
 		if err != nil {
 			return errors.Wrap(err, "failed to load TLS certificate")
 		}
-		grpcOptions = append(grpcOptions, grpc.Creds(creds))
+		tlsConfig := &tls.Config{
+			Certificates: []tls.Certificate{cert},
+		}
+		if s.config.MTLS.Enabled {
+			// certificates of zones are verified by the filters, because the CA is managed in the store and can change at runtime
+			tlsConfig.ClientAuth = tls.RequestClientCert
+		}
+		grpcOptions = append(grpcOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
 	grpcServer := grpc.NewServer(grpcOptions...)
 
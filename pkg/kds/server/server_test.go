@@ -61,6 +61,7 @@ var _ = Describe("KDS Server", func() {
 		Expect(registry.Global().ObjectTypes(model.HasKdsEnabled())).
 			To(HaveLen(len([]proto.Message{
 				kds_samples.CircuitBreaker,
+				kds_samples.ConnectionPool,
 				kds_samples.DataplaneInsight,
 				kds_samples.ServiceInsight,
 				kds_samples.ExternalService,
@@ -69,6 +70,7 @@ var _ = Describe("KDS Server", func() {
 				kds_samples.HealthCheck,
 				kds_samples.Ingress, // mesh.DataplaneType
 				kds_samples.Mesh1,
+				kds_samples.ProxyPatch,
 				kds_samples.ProxyTemplate,
 				kds_samples.RateLimit,
 				kds_samples.Retry,
@@ -92,12 +94,14 @@ var _ = Describe("KDS Server", func() {
 			// same snapshot, so resources that aren't already present won't be reported.
 
 			Exec(kds_verifier.Create(ctx, &mesh.CircuitBreakerResource{Spec: kds_samples.CircuitBreaker}, store.CreateByKey("cb-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.ConnectionPoolResource{Spec: kds_samples.ConnectionPool}, store.CreateByKey("cp-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.DataplaneInsightResource{Spec: kds_samples.DataplaneInsight}, store.CreateByKey("insight-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.DataplaneResource{Spec: kds_samples.Ingress}, store.CreateByKey("Ingress-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.ExternalServiceResource{Spec: kds_samples.ExternalService}, store.CreateByKey("es-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.FaultInjectionResource{Spec: kds_samples.FaultInjection}, store.CreateByKey("fi-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.HealthCheckResource{Spec: kds_samples.HealthCheck}, store.CreateByKey("hc-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.MeshResource{Spec: kds_samples.Mesh1}, store.CreateByKey("mesh-1", model.NoMesh))).
+			Exec(kds_verifier.Create(ctx, &mesh.ProxyPatchResource{Spec: kds_samples.ProxyPatch}, store.CreateByKey("pp-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.ProxyTemplateResource{Spec: kds_samples.ProxyTemplate}, store.CreateByKey("pt-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.RateLimitResource{Spec: kds_samples.RateLimit}, store.CreateByKey("rl-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.RetryResource{Spec: kds_samples.Retry}, store.CreateByKey("retry-1", "mesh-1"))).
@@ -132,6 +136,11 @@ var _ = Describe("KDS Server", func() {
 				Expect(rs).To(HaveLen(1))
 				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.CircuitBreaker))
 			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.ConnectionPoolType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.ConnectionPool))
+			})).
 			Exec(kds_verifier.DiscoveryRequest(node, mesh.FaultInjectionType)).
 			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
 				Expect(rs).To(HaveLen(1))
@@ -162,6 +171,11 @@ var _ = Describe("KDS Server", func() {
 				Expect(rs).To(HaveLen(1))
 				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.TrafficTrace))
 			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.ProxyPatchType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.ProxyPatch))
+			})).
 			Exec(kds_verifier.DiscoveryRequest(node, mesh.ProxyTemplateType)).
 			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
 				Expect(rs).To(HaveLen(1))
@@ -2,6 +2,7 @@ package zone
 
 import (
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/kumahq/kuma/pkg/config"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
@@ -13,6 +14,8 @@ import (
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
 	kds_client "github.com/kumahq/kuma/pkg/kds/client"
+	"github.com/kumahq/kuma/pkg/kds/enrollment"
+	kds_mtls "github.com/kumahq/kuma/pkg/kds/mtls"
 	"github.com/kumahq/kuma/pkg/kds/mux"
 	kds_server "github.com/kumahq/kuma/pkg/kds/server"
 	sync_store "github.com/kumahq/kuma/pkg/kds/store"
@@ -64,13 +67,18 @@ func Setup(rt core_runtime.Runtime) error {
 		}()
 		return nil
 	})
+	clientCtx := rt.KDSContext().ZoneClientCtx
+	if token := rt.Config().Multizone.Zone.EnrollmentToken; token != "" {
+		clientCtx = metadata.AppendToOutgoingContext(clientCtx, enrollment.TokenHeaderKey, token)
+	}
 	muxClient := mux.NewClient(
 		rt.Config().Multizone.Zone.GlobalAddress,
 		zone,
 		onSessionStarted,
 		*rt.Config().Multizone.Zone.KDS,
+		kds_mtls.ZoneCertificateLoader(rt.ResourceManager(), zone),
 		rt.Metrics(),
-		rt.KDSContext().ZoneClientCtx,
+		clientCtx,
 	)
 	return rt.Add(component.NewResilientComponent(kdsZoneLog.WithName("kds-mux-client"), muxClient))
 }
@@ -107,6 +115,12 @@ func Callbacks(rt core_runtime.Runtime, syncer sync_store.ResourceSyncer, k8sSto
 			}
 			if rs.GetItemType() == system.GlobalSecretType {
 				return syncer.Sync(rs, sync_store.PrefilterBy(func(r model.Resource) bool {
+					if _, ok := kds_mtls.ZoneOfCertResource(model.MetaToResourceKey(r.GetMeta())); ok {
+						return true
+					}
+					if _, ok := zoneingress.ZoneOfTokenResource(model.MetaToResourceKey(r.GetMeta())); ok {
+						return true
+					}
 					return r.GetMeta().GetName() == zoneingress.SigningKeyResourceKey().Name
 				}))
 			}
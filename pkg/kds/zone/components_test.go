@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/api/system/v1alpha1"
@@ -143,6 +146,52 @@ var _ = Describe("Zone Sync", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
+	It("should sync only the Zone of the current zone", func() {
+		err := globalStore.Create(context.Background(), &system.ZoneResource{Spec: &v1alpha1.Zone{}}, store.CreateByKey(zoneName, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		err = globalStore.Create(context.Background(), &system.ZoneResource{Spec: &v1alpha1.Zone{}}, store.CreateByKey("another-zone-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(func() int {
+			actual := system.ZoneResourceList{}
+			err := zoneStore.List(context.Background(), &actual)
+			Expect(err).ToNot(HaveOccurred())
+			return len(actual.Items)
+		}, "5s", "100ms").Should(Equal(1))
+
+		actual := system.ZoneResourceList{}
+		err = zoneStore.List(context.Background(), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.Items[0].GetMeta().GetName()).To(Equal(zoneName))
+	})
+
+	It("should not sync ingresses of drained zones", func() {
+		drainedZone := &v1alpha1.Zone{
+			Drain: &v1alpha1.Zone_Drain{
+				StartTime: timestamppb.New(core.Now().Add(-time.Hour)),
+				Period:    durationpb.New(time.Minute),
+			},
+		}
+		err := globalStore.Create(context.Background(), &system.ZoneResource{Spec: drainedZone}, store.CreateByKey("another-zone-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		err = globalStore.Create(context.Background(), &mesh.DataplaneResource{Spec: ingressFunc("another-zone-1")}, store.CreateByKey("dp-1", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+		err = globalStore.Create(context.Background(), &mesh.DataplaneResource{Spec: ingressFunc("another-zone-2")}, store.CreateByKey("dp-2", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(func() int {
+			actual := mesh.DataplaneResourceList{}
+			err := zoneStore.List(context.Background(), &actual)
+			Expect(err).ToNot(HaveOccurred())
+			return len(actual.Items)
+		}, "5s", "100ms").Should(Equal(1))
+
+		actual := mesh.DataplaneResourceList{}
+		err = zoneStore.List(context.Background(), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.Items[0].GetMeta().GetName()).To(Equal("dp-2"))
+	})
+
 	It("should have up to date list of consumed types", func() {
 		excludeTypes := map[model.ResourceType]bool{
 			mesh.DataplaneInsightType:  true,
@@ -158,12 +207,13 @@ var _ = Describe("Zone Sync", func() {
 			return !excludeTypes[descriptor.Name]
 		}))
 
-		// plus 2 global-scope types
+		// plus 5 global-scope types
 		extraTypes := []model.ResourceType{
 			mesh.MeshType,
 			mesh.ZoneIngressType,
 			system.ConfigType,
 			system.GlobalSecretType,
+			system.ZoneType,
 		}
 
 		actualConsumedTypes = append(actualConsumedTypes, extraTypes...)
@@ -0,0 +1,148 @@
+package log
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// ComponentLevels are the log levels of the named subsystems of an application. The name of
+// a logger is the names of its components joined with ".", e.g. "xds-server.reconcile".
+// The level of a logger is the level of its longest prefix that has a level of its own, so
+// setting the level of "xds-server" also sets the level of "xds-server.reconcile". Loggers
+// without such a prefix log with the default level.
+//
+// The levels can be changed at runtime and take effect immediately for the loggers created
+// with NewComponentLogger.
+type ComponentLevels struct {
+	sync.RWMutex
+	defaultLevel LogLevel
+	components   map[string]LogLevel
+}
+
+// DefaultComponentLevels are the levels of the logger of the Control Plane.
+var DefaultComponentLevels = NewComponentLevels(InfoLevel)
+
+func NewComponentLevels(defaultLevel LogLevel) *ComponentLevels {
+	return &ComponentLevels{
+		defaultLevel: defaultLevel,
+		components:   map[string]LogLevel{},
+	}
+}
+
+// ComponentLevel is the level of a component that overrides the default level.
+type ComponentLevel struct {
+	Component string
+	Level     LogLevel
+}
+
+func (c *ComponentLevels) Default() LogLevel {
+	c.RLock()
+	defer c.RUnlock()
+	return c.defaultLevel
+}
+
+func (c *ComponentLevels) SetDefault(level LogLevel) {
+	c.Lock()
+	defer c.Unlock()
+	c.defaultLevel = level
+}
+
+// Set overrides the default level for the component and its subcomponents.
+func (c *ComponentLevels) Set(component string, level LogLevel) {
+	c.Lock()
+	defer c.Unlock()
+	c.components[component] = level
+}
+
+// Reset makes the component log with the level of its parent again.
+func (c *ComponentLevels) Reset(component string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.components, component)
+}
+
+// Components returns the components that have a level of their own, sorted by name.
+func (c *ComponentLevels) Components() []ComponentLevel {
+	c.RLock()
+	defer c.RUnlock()
+	var levels []ComponentLevel
+	for component, level := range c.components {
+		levels = append(levels, ComponentLevel{Component: component, Level: level})
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].Component < levels[j].Component
+	})
+	return levels
+}
+
+// Level returns the level of the logger of the given name.
+func (c *ComponentLevels) Level(name string) LogLevel {
+	c.RLock()
+	defer c.RUnlock()
+	for name != "" {
+		if level, ok := c.components[name]; ok {
+			return level
+		}
+		idx := strings.LastIndex(name, ".")
+		if idx < 0 {
+			break
+		}
+		name = name[:idx]
+	}
+	return c.defaultLevel
+}
+
+// mostVerbose returns the level of the most verbose logger.
+func (c *ComponentLevels) mostVerbose() LogLevel {
+	c.RLock()
+	defer c.RUnlock()
+	level := c.defaultLevel
+	for _, l := range c.components {
+		if l > level {
+			level = l
+		}
+	}
+	return level
+}
+
+// enables returns whether the entries of the given zap level are logged with the level.
+func (l LogLevel) enables(lvl zapcore.Level) bool {
+	switch l {
+	case OffLevel:
+		return false
+	case DebugLevel:
+		return lvl >= debugZapLevel
+	default:
+		return lvl >= zapcore.InfoLevel
+	}
+}
+
+// componentCore filters the entries of the wrapped core by the level of the logger
+// that produced them. The wrapped core has to accept the entries of every level.
+type componentCore struct {
+	zapcore.Core
+	levels *ComponentLevels
+}
+
+var _ zapcore.Core = &componentCore{}
+
+func (c *componentCore) Enabled(lvl zapcore.Level) bool {
+	return c.levels.mostVerbose().enables(lvl)
+}
+
+func (c *componentCore) With(fields []zapcore.Field) zapcore.Core {
+	return &componentCore{
+		Core:   c.Core.With(fields),
+		levels: c.levels,
+	}
+}
+
+func (c *componentCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.levels.Level(ent.LoggerName).enables(ent.Level) {
+		return ce
+	}
+	return c.Core.Check(ent, ce)
+}
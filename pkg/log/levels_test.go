@@ -0,0 +1,95 @@
+package log_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	kuma_log "github.com/kumahq/kuma/pkg/log"
+)
+
+var _ = Describe("ComponentLevels", func() {
+
+	var levels *kuma_log.ComponentLevels
+
+	BeforeEach(func() {
+		levels = kuma_log.NewComponentLevels(kuma_log.InfoLevel)
+	})
+
+	It("should resolve the level of the longest component with a level", func() {
+		// when
+		levels.Set("xds-server", kuma_log.DebugLevel)
+		levels.Set("xds-server.reconcile", kuma_log.OffLevel)
+
+		// then
+		Expect(levels.Level("xds-server")).To(Equal(kuma_log.DebugLevel))
+		Expect(levels.Level("xds-server.callbacks")).To(Equal(kuma_log.DebugLevel))
+		Expect(levels.Level("xds-server.reconcile")).To(Equal(kuma_log.OffLevel))
+		Expect(levels.Level("xds-server.reconcile.snapshot")).To(Equal(kuma_log.OffLevel))
+		Expect(levels.Level("xds-server-extra")).To(Equal(kuma_log.InfoLevel))
+		Expect(levels.Level("kds")).To(Equal(kuma_log.InfoLevel))
+		Expect(levels.Level("")).To(Equal(kuma_log.InfoLevel))
+
+		// when
+		levels.Reset("xds-server.reconcile")
+
+		// then
+		Expect(levels.Level("xds-server.reconcile")).To(Equal(kuma_log.DebugLevel))
+		Expect(levels.Components()).To(Equal([]kuma_log.ComponentLevel{
+			{Component: "xds-server", Level: kuma_log.DebugLevel},
+		}))
+	})
+
+	Describe("NewComponentLoggerTo()", func() {
+
+		var out *bytes.Buffer
+
+		BeforeEach(func() {
+			out = &bytes.Buffer{}
+		})
+
+		It("should log with the levels of the components", func() {
+			// given
+			logger := kuma_log.NewComponentLoggerTo(out, levels, kuma_log.ConsoleFormat)
+			reconcileLog := logger.WithName("xds-server").WithName("reconcile")
+			kdsLog := logger.WithName("kds")
+
+			// when
+			reconcileLog.V(1).Info("debug of xds-server before")
+			levels.Set("xds-server", kuma_log.DebugLevel)
+			levels.Set("kds", kuma_log.OffLevel)
+			reconcileLog.V(1).Info("debug of xds-server after")
+			kdsLog.Info("info of kds")
+			logger.V(1).Info("debug of the root")
+			logger.Info("info of the root")
+
+			// then
+			Expect(out.String()).ToNot(ContainSubstring("debug of xds-server before"))
+			Expect(out.String()).To(ContainSubstring("debug of xds-server after"))
+			Expect(out.String()).ToNot(ContainSubstring("info of kds"))
+			Expect(out.String()).ToNot(ContainSubstring("debug of the root"))
+			Expect(out.String()).To(ContainSubstring("info of the root"))
+		})
+
+		It("should log in JSON", func() {
+			// given
+			logger := kuma_log.NewComponentLoggerTo(out, levels, kuma_log.JSONFormat)
+
+			// when
+			logger.WithName("xds-server").WithValues("changeIds", []string{"5f2a6b1c9d3e"}).Info("configuration generated", "proxy", "default.backend-01")
+
+			// then
+			lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+			Expect(lines).To(HaveLen(1))
+			entry := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(lines[0]), &entry)).To(Succeed())
+			Expect(entry).To(HaveKeyWithValue("logger", "xds-server"))
+			Expect(entry).To(HaveKeyWithValue("msg", "configuration generated"))
+			Expect(entry).To(HaveKeyWithValue("proxy", "default.backend-01"))
+			Expect(entry).To(HaveKeyWithValue("changeIds", []interface{}{"5f2a6b1c9d3e"}))
+		})
+	})
+})
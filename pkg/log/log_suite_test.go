@@ -0,0 +1,11 @@
+package log_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestLog(t *testing.T) {
+	test.RunSpecs(t, "Log Suite")
+}
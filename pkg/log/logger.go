@@ -47,6 +47,42 @@ func ParseLogLevel(text string) (LogLevel, error) {
 	}
 }
 
+// LogFormat is the format of the log entries.
+type LogFormat int
+
+const (
+	ConsoleFormat LogFormat = iota
+	JSONFormat
+)
+
+func (f LogFormat) String() string {
+	switch f {
+	case ConsoleFormat:
+		return "console"
+	case JSONFormat:
+		return "json"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseLogFormat(text string) (LogFormat, error) {
+	switch text {
+	case "console":
+		return ConsoleFormat, nil
+	case "json":
+		return JSONFormat, nil
+	default:
+		return ConsoleFormat, errors.Errorf("unknown log format %q", text)
+	}
+}
+
+// The value we pass here is the most verbose level that
+// will end up being emitted through the `V(level int)`
+// accessor. Passing -10 ensures that levels up to `V(10)`
+// will work, which seems like plenty.
+const debugZapLevel = zapcore.Level(-10)
+
 func NewLogger(level LogLevel) logr.Logger {
 	return NewLoggerTo(os.Stderr, level)
 }
@@ -69,11 +105,7 @@ func newZapLoggerTo(destWriter io.Writer, level LogLevel, opts ...zap.Option) *z
 	case OffLevel:
 		return zap.NewNop()
 	case DebugLevel:
-		// The value we pass here is the most verbose level that
-		// will end up being emitted through the `V(level int)`
-		// accessor. Passing -10 ensures that levels up to `V(10)`
-		// will work, which seems like plenty.
-		lvl = zap.NewAtomicLevelAt(-10)
+		lvl = zap.NewAtomicLevelAt(debugZapLevel)
 		opts = append(opts, zap.AddStacktrace(zap.ErrorLevel))
 	default:
 		lvl = zap.NewAtomicLevelAt(zap.InfoLevel)
@@ -85,3 +117,35 @@ func newZapLoggerTo(destWriter io.Writer, level LogLevel, opts ...zap.Option) *z
 	return zap.New(zapcore.NewCore(&kube_log_zap.KubeAwareEncoder{Encoder: enc, Verbose: level == DebugLevel}, sink, lvl)).
 		WithOptions(opts...)
 }
+
+// NewComponentLogger returns a logger whose entries are filtered by the levels of the
+// components that log them, see ComponentLevels.
+func NewComponentLogger(levels *ComponentLevels, format LogFormat) logr.Logger {
+	return NewComponentLoggerTo(os.Stderr, levels, format)
+}
+
+func NewComponentLoggerWithRotation(levels *ComponentLevels, format LogFormat, outputPath string, maxSize int, maxBackups int, maxAge int) logr.Logger {
+	return NewComponentLoggerTo(&lumberjack.Logger{
+		Filename:   outputPath,
+		MaxSize:    maxSize,
+		MaxBackups: maxBackups,
+		MaxAge:     maxAge}, levels, format)
+}
+
+func NewComponentLoggerTo(destWriter io.Writer, levels *ComponentLevels, format LogFormat) logr.Logger {
+	var enc zapcore.Encoder
+	switch format {
+	case JSONFormat:
+		enc = zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	default:
+		enc = zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig())
+	}
+	sink := zapcore.AddSync(destWriter)
+	// the level of the wrapped core is the most verbose one, the entries are filtered by the levels of the components
+	core := zapcore.NewCore(&kube_log_zap.KubeAwareEncoder{Encoder: enc, Verbose: levels.Default() == DebugLevel}, sink, debugZapLevel)
+	opts := []zap.Option{zap.AddCallerSkip(1), zap.ErrorOutput(sink)}
+	if levels.Default() == DebugLevel {
+		opts = append(opts, zap.AddStacktrace(zap.ErrorLevel))
+	}
+	return zapr.NewLogger(zap.New(&componentCore{Core: core, levels: levels}).WithOptions(opts...))
+}
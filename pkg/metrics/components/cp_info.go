@@ -53,12 +53,17 @@ func Setup(rt runtime.Runtime) error {
 	// We don't want to use cached ResourceManager because the cache is just for a couple of seconds
 	// and we will be retrieving resources every minute. There is no other place in the system for now that needs all resources from all meshes
 	// therefore it makes no sense to cache all content of the Database in the cache.
-	counter, err := metrics.NewStoreCounter(rt.ResourceManager(), rt.Metrics())
+	maxLabeledMeshes := rt.Config().Metrics.Mesh.MaxLabeledMeshes
+	counter, err := metrics.NewStoreCounter(rt.ResourceManager(), rt.Metrics(), maxLabeledMeshes)
 	if err != nil {
 		return err
 	}
 	if err := rt.Add(counter); err != nil {
 		return err
 	}
+	// The leader ranks the meshes when it counts the resources, the other instances need their own ranking.
+	if err := rt.Add(metrics.NewMeshRanker(rt.ResourceManager(), rt.Metrics(), maxLabeledMeshes)); err != nil {
+		return err
+	}
 	return nil
 }
@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"sort"
+	"sync"
+)
+
+// OtherMeshes is the value of the mesh label of the meshes that don't get
+// their own value.
+const OtherMeshes = "_other"
+
+// MeshLabels limits the cardinality of the mesh label of the per-mesh metrics
+// of the Control Plane. Only the top meshes by number of data plane proxies get
+// their own value of the label, the metrics of the other meshes are aggregated
+// under OtherMeshes. A Control Plane shared by many meshes can then be observed
+// per mesh without an unbounded number of series.
+type MeshLabels struct {
+	sync.RWMutex
+	top map[string]bool
+}
+
+func NewMeshLabels() *MeshLabels {
+	return &MeshLabels{
+		top: map[string]bool{},
+	}
+}
+
+// Rank selects the meshes that get their own value of the label, the given
+// limit of meshes with the most data plane proxies. Meshes with the same number
+// of data plane proxies are ranked by name, so the selection is stable.
+func (m *MeshLabels) Rank(dataplanes map[string]uint32, limit int) {
+	var meshes []string
+	for mesh := range dataplanes {
+		meshes = append(meshes, mesh)
+	}
+	sort.Slice(meshes, func(i, j int) bool {
+		if dataplanes[meshes[i]] != dataplanes[meshes[j]] {
+			return dataplanes[meshes[i]] > dataplanes[meshes[j]]
+		}
+		return meshes[i] < meshes[j]
+	})
+	if len(meshes) > limit {
+		meshes = meshes[:limit]
+	}
+
+	top := map[string]bool{}
+	for _, mesh := range meshes {
+		top[mesh] = true
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.top = top
+}
+
+// Label returns the value of the mesh label of the given mesh.
+func (m *MeshLabels) Label(mesh string) string {
+	m.RLock()
+	defer m.RUnlock()
+	if m.top[mesh] {
+		return mesh
+	}
+	return OtherMeshes
+}
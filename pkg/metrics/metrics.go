@@ -14,6 +14,8 @@ type Metrics interface {
 	GRPCServerInterceptors() []grpc.ServerOption
 	GRPCClientInterceptors() []grpc.DialOption
 	BulkRegister(...prometheus.Collector) error
+	// MeshLabels limits the values of the mesh label of the per-mesh metrics.
+	MeshLabels() *MeshLabels
 }
 
 type metrics struct {
@@ -21,6 +23,7 @@ type metrics struct {
 	prometheus.Gatherer
 	grpcServerMetrics *grpc_prometheus.ServerMetrics
 	grpcClientMetrics *grpc_prometheus.ClientMetrics
+	meshLabels        *MeshLabels
 }
 
 func (m *metrics) RegisterGRPC(server *grpc.Server) {
@@ -66,6 +69,7 @@ func NewMetrics(zone string) (Metrics, error) {
 		Gatherer:          registry,
 		grpcServerMetrics: grpcServerMetrics,
 		grpcClientMetrics: grpcClientMetrics,
+		meshLabels:        NewMeshLabels(),
 	}
 	return m, nil
 }
@@ -78,3 +82,7 @@ func (m *metrics) BulkRegister(cs ...prometheus.Collector) error {
 	}
 	return nil
 }
+
+func (m *metrics) MeshLabels() *MeshLabels {
+	return m.meshLabels
+}
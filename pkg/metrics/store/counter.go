@@ -18,24 +18,42 @@ import (
 var log = core.Log.WithName("metrics").WithName("store-counter")
 
 type storeCounter struct {
-	resManager manager.ReadOnlyResourceManager
-	counts     *prometheus.GaugeVec
+	resManager          manager.ReadOnlyResourceManager
+	meshLabels          *metrics.MeshLabels
+	maxLabeledMeshes    int
+	counts              *prometheus.GaugeVec
+	meshCounts          *prometheus.GaugeVec
+	dataplanesConnected *prometheus.GaugeVec
 }
 
 var _ component.Component = &storeCounter{}
 
-func NewStoreCounter(resManager manager.ReadOnlyResourceManager, metrics metrics.Metrics) (*storeCounter, error) {
+func NewStoreCounter(resManager manager.ReadOnlyResourceManager, metrics metrics.Metrics, maxLabeledMeshes int) (*storeCounter, error) {
 	counts := prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "resources_count",
 	}, []string{"resource_type"})
 
-	if err := metrics.Register(counts); err != nil {
+	meshCounts := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mesh_resources_count",
+		Help: "Number of resources by mesh, only the top meshes by data plane proxies have their own mesh label",
+	}, []string{"mesh", "resource_type"})
+
+	dataplanesConnected := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mesh_dataplanes_connected",
+		Help: "Number of data plane proxies connected to the control plane by mesh, only the top meshes by data plane proxies have their own mesh label",
+	}, []string{"mesh"})
+
+	if err := metrics.BulkRegister(counts, meshCounts, dataplanesConnected); err != nil {
 		return nil, err
 	}
 
 	return &storeCounter{
-		resManager: resManager,
-		counts:     counts,
+		resManager:          resManager,
+		meshLabels:          metrics.MeshLabels(),
+		maxLabeledMeshes:    maxLabeledMeshes,
+		counts:              counts,
+		meshCounts:          meshCounts,
+		dataplanesConnected: dataplanesConnected,
 	}, nil
 }
 
@@ -73,15 +91,52 @@ func (s *storeCounter) count() error {
 	if err := s.countGlobalScopedResources(resourceCount); err != nil {
 		return err
 	}
-	if err := s.countMeshScopedResources(resourceCount); err != nil {
+	insights := &mesh.MeshInsightResourceList{}
+	if err := s.resManager.List(context.Background(), insights); err != nil {
 		return err
 	}
+	for _, meshInsight := range insights.Items {
+		countMeshScopedResources(meshInsight, resourceCount)
+	}
 	for resType, counter := range resourceCount {
 		s.counts.WithLabelValues(resType).Set(float64(counter))
 	}
+
+	s.meshLabels.Rank(dataplanesByMesh(insights), s.maxLabeledMeshes)
+	s.countByMesh(insights)
 	return nil
 }
 
+// countByMesh sets the per-mesh metrics. The meshes that share the mesh label
+// are summed up, and the gauges are reset so the meshes that lost their own
+// label, or were deleted, don't leave stale series.
+func (s *storeCounter) countByMesh(insights *mesh.MeshInsightResourceList) {
+	type key struct {
+		mesh    string
+		resType string
+	}
+	meshCount := map[key]uint32{}
+	connected := map[string]uint32{}
+	for _, meshInsight := range insights.Items {
+		label := s.meshLabels.Label(meshInsight.Meta.GetName())
+		resourceCount := map[string]uint32{}
+		countMeshScopedResources(meshInsight, resourceCount)
+		for resType, counter := range resourceCount {
+			meshCount[key{mesh: label, resType: resType}] += counter
+		}
+		connected[label] += meshInsight.Spec.GetDataplanes().GetOnline()
+	}
+
+	s.meshCounts.Reset()
+	for k, counter := range meshCount {
+		s.meshCounts.WithLabelValues(k.mesh, k.resType).Set(float64(counter))
+	}
+	s.dataplanesConnected.Reset()
+	for label, counter := range connected {
+		s.dataplanesConnected.WithLabelValues(label).Set(float64(counter))
+	}
+}
+
 func (s *storeCounter) countGlobalScopedResources(resourceCount map[string]uint32) error {
 	for _, resDesc := range registry.Global().ObjectDescriptors() {
 		if resDesc.Scope == model.ScopeMesh {
@@ -96,16 +151,67 @@ func (s *storeCounter) countGlobalScopedResources(resourceCount map[string]uint3
 	return nil
 }
 
-func (s *storeCounter) countMeshScopedResources(resourceCount map[string]uint32) error {
-	insights := &mesh.MeshInsightResourceList{}
-	if err := s.resManager.List(context.Background(), insights); err != nil {
-		return err
+func countMeshScopedResources(meshInsight *mesh.MeshInsightResource, resourceCount map[string]uint32) {
+	resourceCount[string(mesh.DataplaneType)] += meshInsight.Spec.GetDataplanes().GetTotal()
+	for policy, stats := range meshInsight.Spec.GetPolicies() {
+		resourceCount[policy] += stats.GetTotal()
 	}
+}
+
+func dataplanesByMesh(insights *mesh.MeshInsightResourceList) map[string]uint32 {
+	dataplanes := map[string]uint32{}
 	for _, meshInsight := range insights.Items {
-		resourceCount[string(mesh.DataplaneType)] += meshInsight.Spec.GetDataplanes().GetTotal()
-		for policy, stats := range meshInsight.Spec.GetPolicies() {
-			resourceCount[policy] += stats.GetTotal()
+		dataplanes[meshInsight.Meta.GetName()] = meshInsight.Spec.GetDataplanes().GetTotal()
+	}
+	return dataplanes
+}
+
+// meshRanker ranks the meshes for the mesh label on every instance of the
+// control plane, because the per-mesh metrics of XDS generation are observed
+// by the instance that the data plane proxy is connected to, not the leader.
+type meshRanker struct {
+	resManager       manager.ReadOnlyResourceManager
+	meshLabels       *metrics.MeshLabels
+	maxLabeledMeshes int
+}
+
+var _ component.Component = &meshRanker{}
+
+func NewMeshRanker(resManager manager.ReadOnlyResourceManager, metrics metrics.Metrics, maxLabeledMeshes int) *meshRanker {
+	return &meshRanker{
+		resManager:       resManager,
+		meshLabels:       metrics.MeshLabels(),
+		maxLabeledMeshes: maxLabeledMeshes,
+	}
+}
+
+func (r *meshRanker) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+
+	log.Info("starting the mesh ranker")
+	for {
+		if err := r.rank(); err != nil {
+			log.Error(err, "unable to rank meshes")
 		}
+		select {
+		case <-ticker.C:
+		case <-stop:
+			log.Info("stopping the mesh ranker")
+			return nil
+		}
+	}
+}
+
+func (r *meshRanker) NeedLeaderElection() bool {
+	return false
+}
+
+func (r *meshRanker) rank() error {
+	insights := &mesh.MeshInsightResourceList{}
+	if err := r.resManager.List(context.Background(), insights); err != nil {
+		return err
 	}
+	r.meshLabels.Rank(dataplanesByMesh(insights), r.maxLabeledMeshes)
 	return nil
 }
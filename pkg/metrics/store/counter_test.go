@@ -64,7 +64,7 @@ var _ = Describe("Counter", func() {
 		resManager = manager.NewResourceManager(store)
 
 		counterTicker := time.NewTicker(500 * time.Millisecond)
-		counter, err := metrics_store.NewStoreCounter(resManager, metrics)
+		counter, err := metrics_store.NewStoreCounter(resManager, metrics, 1)
 		Expect(err).ToNot(HaveOccurred())
 
 		resyncer := insights.NewResyncer(&insights.Config{
@@ -146,4 +146,47 @@ var _ = Describe("Counter", func() {
 		Expect(findGauge("Dataplane").GetValue()).To(Equal(float64(1)))
 		Expect(findGauge("TrafficPermission").GetValue()).To(Equal(float64(2)))
 	})
+
+	It("should count resources by mesh with the top meshes labeled", func() {
+		// given
+		for _, name := range []string{"mesh-1", "mesh-2", "mesh-3"} {
+			err := resManager.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(name, model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+
+			err = resManager.Create(
+				context.Background(),
+				&core_mesh.TrafficPermissionResource{Spec: samples.TrafficPermission},
+				core_store.CreateByKey("tp", name),
+			)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		err := resManager.Create(
+			context.Background(),
+			&core_mesh.DataplaneResource{Spec: samples.Dataplane},
+			core_store.CreateByKey("dp-1", "mesh-2"),
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		// trigger the resyncer
+		nowMtx.Lock()
+		now = now.Add(1 * time.Minute)
+		nowMtx.Unlock()
+		tickCh <- now
+		// wait for the counter
+		time.Sleep(1 * time.Second)
+
+		// then
+		findMeshGauge := func(mesh, resTypeName string) *io_prometheus_client.Gauge {
+			return test_metrics.FindMetric(metrics, "mesh_resources_count", "mesh", mesh, "resource_type", resTypeName).GetGauge()
+		}
+		Expect(findMeshGauge("mesh-2", "Dataplane").GetValue()).To(Equal(float64(1)))
+		Expect(findMeshGauge("mesh-2", "TrafficPermission").GetValue()).To(Equal(float64(1)))
+		Expect(findMeshGauge(core_metrics.OtherMeshes, "TrafficPermission").GetValue()).To(Equal(float64(2)))
+		Expect(test_metrics.FindMetric(metrics, "mesh_resources_count", "mesh", "mesh-1")).To(BeNil())
+		Expect(test_metrics.FindMetric(metrics, "mesh_dataplanes_connected", "mesh", "mesh-2")).ToNot(BeNil())
+		Expect(metrics.MeshLabels().Label("mesh-2")).To(Equal("mesh-2"))
+		Expect(metrics.MeshLabels().Label("mesh-3")).To(Equal(core_metrics.OtherMeshes))
+	})
 })
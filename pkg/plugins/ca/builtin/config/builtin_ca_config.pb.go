@@ -7,6 +7,7 @@
 package config
 
 import (
+	v1alpha1 "github.com/kumahq/kuma/api/system/v1alpha1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
@@ -30,6 +31,15 @@ type BuiltinCertificateAuthorityConfig struct {
 
 	// Configuration of CA Certificate
 	CaCert *BuiltinCertificateAuthorityConfig_CaCert `protobuf:"bytes,1,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	// Intermediate CA used to issue workload certificates. When defined, no
+	// CA is generated and caCert configuration is ignored.
+	Intermediate *BuiltinCertificateAuthorityConfig_Intermediate `protobuf:"bytes,2,opt,name=intermediate,proto3" json:"intermediate,omitempty"`
+	// PerZone enables zone-local issuance in multizone deployments. The global
+	// CP generates a CA for every zone and each zone CP receives only the key of
+	// its own CA. Workload certificates are issued by the CA of the local zone and
+	// the trust bundle is the union of the CAs of all enabled zones. A zone is
+	// revoked by deleting the secrets of its CA or by disabling the zone.
+	PerZone bool `protobuf:"varint,3,opt,name=perZone,proto3" json:"perZone,omitempty"`
 }
 
 func (x *BuiltinCertificateAuthorityConfig) Reset() {
@@ -71,6 +81,20 @@ func (x *BuiltinCertificateAuthorityConfig) GetCaCert() *BuiltinCertificateAutho
 	return nil
 }
 
+func (x *BuiltinCertificateAuthorityConfig) GetIntermediate() *BuiltinCertificateAuthorityConfig_Intermediate {
+	if x != nil {
+		return x.Intermediate
+	}
+	return nil
+}
+
+func (x *BuiltinCertificateAuthorityConfig) GetPerZone() bool {
+	if x != nil {
+		return x.PerZone
+	}
+	return false
+}
+
 // CaCert defines configuration for Certificate of CA.
 type BuiltinCertificateAuthorityConfig_CaCert struct {
 	state         protoimpl.MessageState
@@ -129,6 +153,76 @@ func (x *BuiltinCertificateAuthorityConfig_CaCert) GetExpiration() string {
 	return ""
 }
 
+// Intermediate defines an intermediate CA provided by the operator.
+// Workload certificates are issued by the intermediate CA, the key of the
+// root CA is never required by the control plane.
+type BuiltinCertificateAuthorityConfig_Intermediate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data source for the certificate of the intermediate CA. It can contain
+	// a chain of intermediate certificates, leaf-most certificate first.
+	Cert *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=cert,proto3" json:"cert,omitempty"`
+	// Data source for the key of the intermediate CA
+	Key *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Data source for the certificate of the root CA that signed the chain
+	RootCert *v1alpha1.DataSource `protobuf:"bytes,3,opt,name=rootCert,proto3" json:"rootCert,omitempty"`
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) Reset() {
+	*x = BuiltinCertificateAuthorityConfig_Intermediate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinCertificateAuthorityConfig_Intermediate) ProtoMessage() {}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinCertificateAuthorityConfig_Intermediate.ProtoReflect.Descriptor instead.
+func (*BuiltinCertificateAuthorityConfig_Intermediate) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) GetCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) GetKey() *v1alpha1.DataSource {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *BuiltinCertificateAuthorityConfig_Intermediate) GetRootCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.RootCert
+	}
+	return nil
+}
+
 var File_pkg_plugins_ca_builtin_config_builtin_ca_config_proto protoreflect.FileDescriptor
 
 var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDesc = []byte{
@@ -138,24 +232,46 @@ var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDesc = []byte{
 	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c,
 	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x01, 0x0a, 0x21, 0x42, 0x75, 0x69,
-	0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x51,
-	0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39,
-	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61,
-	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72,
-	0x74, 0x1a, 0x60, 0x0a, 0x06, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x52,
-	0x53, 0x41, 0x62, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x52, 0x53, 0x41, 0x62,
-	0x69, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x70, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x04, 0x0a, 0x21, 0x42,
+	0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x51, 0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x63, 0x61, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x52, 0x06, 0x63, 0x61, 0x43,
+	0x65, 0x72, 0x74, 0x12, 0x63, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x5a,
+	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x72, 0x5a, 0x6f,
+	0x6e, 0x65, 0x1a, 0x60, 0x0a, 0x06, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x07,
+	0x52, 0x53, 0x41, 0x62, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x52, 0x53, 0x41,
+	0x62, 0x69, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xb6, 0x01, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x3c, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x42, 0x2a, 0x5a,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61,
+	0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
+	0x63, 0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -170,20 +286,26 @@ func file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescGZIP() []
 	return file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescData
 }
 
-var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_goTypes = []interface{}{
-	(*BuiltinCertificateAuthorityConfig)(nil),        // 0: kuma.plugins.ca.BuiltinCertificateAuthorityConfig
-	(*BuiltinCertificateAuthorityConfig_CaCert)(nil), // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert
-	(*wrapperspb.UInt32Value)(nil),                   // 2: google.protobuf.UInt32Value
+	(*BuiltinCertificateAuthorityConfig)(nil),              // 0: kuma.plugins.ca.BuiltinCertificateAuthorityConfig
+	(*BuiltinCertificateAuthorityConfig_CaCert)(nil),       // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert
+	(*BuiltinCertificateAuthorityConfig_Intermediate)(nil), // 2: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate
+	(*wrapperspb.UInt32Value)(nil),                         // 3: google.protobuf.UInt32Value
+	(*v1alpha1.DataSource)(nil),                            // 4: kuma.system.v1alpha1.DataSource
 }
 var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_depIdxs = []int32{
 	1, // 0: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.caCert:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert
-	2, // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert.RSAbits:type_name -> google.protobuf.UInt32Value
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.intermediate:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate
+	3, // 2: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert.RSAbits:type_name -> google.protobuf.UInt32Value
+	4, // 3: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.cert:type_name -> kuma.system.v1alpha1.DataSource
+	4, // 4: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.key:type_name -> kuma.system.v1alpha1.DataSource
+	4, // 5: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.Intermediate.rootCert:type_name -> kuma.system.v1alpha1.DataSource
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_init() }
@@ -216,6 +338,18 @@ func file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinCertificateAuthorityConfig_Intermediate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -223,7 +357,7 @@ func file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
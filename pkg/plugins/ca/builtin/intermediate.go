@@ -0,0 +1,84 @@
+package builtin
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/kumahq/kuma/pkg/core"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// validateIntermediateChain checks that the intermediate CA can issue workload certificates
+// and that the chain of intermediates can be verified against the root CA.
+// Path length constraints of all certificates in the chain are enforced, so the workload
+// certificates issued by the intermediate CA are accepted by the peers that trust the root CA.
+func validateIntermediateChain(pair core_ca.KeyPair, rootCertPEM []byte) (verr validators.ValidationError) {
+	tlsKeyPair, err := tls.X509KeyPair(pair.CertPEM, pair.KeyPEM)
+	if err != nil {
+		verr.AddViolation("cert", fmt.Sprintf("not a valid TLS key pair: %s", err))
+		return
+	}
+	var chain []*x509.Certificate
+	for i, certificate := range tlsKeyPair.Certificate {
+		path := validators.RootedAt("cert").Index(i)
+		cert, err := x509.ParseCertificate(certificate)
+		if err != nil {
+			verr.AddViolationAt(path, fmt.Sprintf("not a valid x509 certificate: %s", err))
+			return
+		}
+		if !cert.IsCA {
+			verr.AddViolationAt(path, "basic constraint 'CA' must be set to 'true' (see X509-SVID: 4.1. Basic Constraints)")
+		}
+		if cert.KeyUsage&x509.KeyUsageCertSign == 0 {
+			verr.AddViolationAt(path, "key usage extension 'keyCertSign' must be set (see X509-SVID: 4.3. Key Usage)")
+		}
+		chain = append(chain, cert)
+	}
+
+	roots := x509.NewCertPool()
+	rootsCount := 0
+	rest := rootCertPEM
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		root, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			verr.AddViolation("rootCert", fmt.Sprintf("not a valid x509 certificate: %s", err))
+			return
+		}
+		if !root.IsCA {
+			verr.AddViolation("rootCert", "basic constraint 'CA' must be set to 'true' (see X509-SVID: 4.1. Basic Constraints)")
+			return
+		}
+		roots.AddCert(root)
+		rootsCount++
+	}
+	if rootsCount == 0 {
+		verr.AddViolation("rootCert", "has to contain at least one PEM encoded certificate")
+		return
+	}
+	if verr.HasViolations() {
+		return
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   core.Now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		verr.AddViolation("cert", fmt.Sprintf("could not be verified against the root CA: %s", err))
+	}
+	return
+}
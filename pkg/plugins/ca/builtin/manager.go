@@ -10,6 +10,7 @@ import (
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	ca_issuer "github.com/kumahq/kuma/pkg/core/ca/issuer"
+	"github.com/kumahq/kuma/pkg/core/datasource"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_system "github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -21,12 +22,17 @@ import (
 )
 
 type builtinCaManager struct {
-	secretManager manager.ResourceManager
+	secretManager    manager.ResourceManager
+	dataSourceLoader datasource.Loader
+	// zone is the name of the local zone, it's empty on Global and Standalone CP
+	zone string
 }
 
-func NewBuiltinCaManager(secretManager manager.ResourceManager) core_ca.Manager {
+func NewBuiltinCaManager(secretManager manager.ResourceManager, dataSourceLoader datasource.Loader, zone string) core_ca.Manager {
 	return &builtinCaManager{
-		secretManager: secretManager,
+		secretManager:    secretManager,
+		dataSourceLoader: dataSourceLoader,
+		zone:             zone,
 	}
 }
 
@@ -34,6 +40,23 @@ var _ core_ca.Manager = &builtinCaManager{}
 
 func (b *builtinCaManager) EnsureBackends(ctx context.Context, mesh string, backends []*mesh_proto.CertificateAuthorityBackend) error {
 	for _, backend := range backends {
+		cfg := &config.BuiltinCertificateAuthorityConfig{}
+		if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+			return errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+		}
+		if cfg.GetIntermediate() != nil { // intermediate CA is provided by the user and validated first
+			continue
+		}
+		if cfg.GetPerZone() {
+			if b.zone != "" { // CAs of zones are generated by Global CP and synced to zones
+				continue
+			}
+			if err := b.ensureZoneCas(ctx, mesh, backend, cfg); err != nil {
+				return errors.Wrapf(err, "failed to create zone CAs for mesh %q and backend %q", mesh, backend.Name)
+			}
+			continue
+		}
+
 		_, err := b.getCa(ctx, mesh, backend.Name)
 		if err == nil { // CA is there, nothing to ensure
 			continue
@@ -57,10 +80,67 @@ func (b *builtinCaManager) ValidateBackend(ctx context.Context, mesh string, bac
 		verr.AddViolation("", "could not convert backend config: "+err.Error())
 		return verr.OrNil()
 	}
-	return nil
+	if intermediate := cfg.GetIntermediate(); intermediate != nil {
+		verr.AddError("intermediate", b.validateIntermediate(ctx, mesh, intermediate))
+		if cfg.GetPerZone() {
+			verr.AddViolation("perZone", "cannot be used together with intermediate")
+		}
+	}
+	return verr.OrNil()
+}
+
+func (b *builtinCaManager) validateIntermediate(ctx context.Context, mesh string, intermediate *config.BuiltinCertificateAuthorityConfig_Intermediate) core_validators.ValidationError {
+	verr := core_validators.ValidationError{}
+	sources := []struct {
+		field  string
+		source *system_proto.DataSource
+	}{
+		{field: "cert", source: intermediate.GetCert()},
+		{field: "key", source: intermediate.GetKey()},
+		{field: "rootCert", source: intermediate.GetRootCert()},
+	}
+	for _, s := range sources {
+		if s.source == nil {
+			verr.AddViolation(s.field, "has to be defined")
+		} else {
+			verr.AddError(s.field, datasource.Validate(s.source))
+		}
+	}
+	if verr.HasViolations() {
+		return verr
+	}
+
+	pair, err := b.loadIntermediateCa(ctx, mesh, intermediate)
+	if err != nil {
+		verr.AddViolation("", err.Error())
+		return verr
+	}
+	rootCert, err := b.dataSourceLoader.Load(ctx, mesh, intermediate.GetRootCert())
+	if err != nil {
+		verr.AddViolation("rootCert", err.Error())
+		return verr
+	}
+	verr.Add(validateIntermediateChain(pair, rootCert))
+	return verr
 }
 
 func (b *builtinCaManager) UsedSecrets(mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]string, error) {
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	if intermediate := cfg.GetIntermediate(); intermediate != nil {
+		var secrets []string
+		for _, source := range []*system_proto.DataSource{intermediate.GetCert(), intermediate.GetKey(), intermediate.GetRootCert()} {
+			if source.GetSecret() != "" {
+				secrets = append(secrets, source.GetSecret())
+			}
+		}
+		return secrets, nil
+	}
+	if cfg.GetPerZone() {
+		return b.zoneCaSecrets(context.Background(), mesh, backend.Name)
+	}
 	return []string{
 		certSecretResKey(mesh, backend.Name).Name,
 		keySecretResKey(mesh, backend.Name).Name,
@@ -121,6 +201,26 @@ func keySecretResKey(mesh string, backendName string) core_model.ResourceKey {
 }
 
 func (b *builtinCaManager) GetRootCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]core_ca.Cert, error) {
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	if intermediate := cfg.GetIntermediate(); intermediate != nil {
+		// only the root CA is trusted, intermediates are distributed with the workload certs
+		rootCert, err := b.dataSourceLoader.Load(ctx, mesh, intermediate.GetRootCert())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load root CA cert for Mesh %q and backend %q", mesh, backend.Name)
+		}
+		return []core_ca.Cert{rootCert}, nil
+	}
+	if cfg.GetPerZone() {
+		// trust bundle is the union of CAs of all zones
+		certs, err := b.zoneTrustBundle(ctx, mesh, backend.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load zone CA certs for Mesh %q and backend %q", mesh, backend.Name)
+		}
+		return certs, nil
+	}
 	ca, err := b.getCa(ctx, mesh, backend.Name)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
@@ -129,7 +229,20 @@ func (b *builtinCaManager) GetRootCert(ctx context.Context, mesh string, backend
 }
 
 func (b *builtinCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (core_ca.KeyPair, error) {
-	ca, err := b.getCa(ctx, mesh, backend.Name)
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return core_ca.KeyPair{}, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	var ca core_ca.KeyPair
+	var err error
+	switch {
+	case cfg.GetIntermediate() != nil:
+		ca, err = b.loadIntermediateCa(ctx, mesh, cfg.GetIntermediate())
+	case cfg.GetPerZone():
+		ca, err = b.getZoneCa(ctx, mesh, backend.Name)
+	default:
+		ca, err = b.getCa(ctx, mesh, backend.Name)
+	}
 	if err != nil {
 		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
 	}
@@ -149,6 +262,21 @@ func (b *builtinCaManager) GenerateDataplaneCert(ctx context.Context, mesh strin
 	return *keyPair, nil
 }
 
+func (b *builtinCaManager) loadIntermediateCa(ctx context.Context, mesh string, intermediate *config.BuiltinCertificateAuthorityConfig_Intermediate) (core_ca.KeyPair, error) {
+	cert, err := b.dataSourceLoader.Load(ctx, mesh, intermediate.GetCert())
+	if err != nil {
+		return core_ca.KeyPair{}, err
+	}
+	key, err := b.dataSourceLoader.Load(ctx, mesh, intermediate.GetKey())
+	if err != nil {
+		return core_ca.KeyPair{}, err
+	}
+	return core_ca.KeyPair{
+		CertPEM: cert,
+		KeyPEM:  key,
+	}, nil
+}
+
 func (b *builtinCaManager) getCa(ctx context.Context, mesh string, backendName string) (core_ca.KeyPair, error) {
 	certSecret := core_system.NewSecretResource()
 	if err := b.secretManager.Get(ctx, certSecret, core_store.GetBy(certSecretResKey(mesh, backendName))); err != nil {
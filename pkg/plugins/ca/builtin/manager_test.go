@@ -2,18 +2,27 @@ package builtin_test
 
 import (
 	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
+	"math/big"
 	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/core/datasource"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
 	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
@@ -21,6 +30,7 @@ import (
 	"github.com/kumahq/kuma/pkg/plugins/ca/builtin"
 	"github.com/kumahq/kuma/pkg/plugins/ca/builtin/config"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
@@ -36,7 +46,7 @@ var _ = Describe("Builtin CA Manager", func() {
 			return now
 		}
 		secretManager = secret_manager.NewSecretManager(store.NewSecretStore(memory.NewStore()), cipher.None(), nil)
-		caManager = builtin.NewBuiltinCaManager(secretManager)
+		caManager = builtin.NewBuiltinCaManager(secretManager, datasource.NewDataSourceLoader(secretManager, nil), "")
 	})
 
 	AfterEach(func() {
@@ -229,6 +239,41 @@ var _ = Describe("Builtin CA Manager", func() {
 			Expect(cert.NotAfter).To(Equal(now.UTC().Truncate(time.Second).Add(1 * time.Second))) // time in cert is in UTC and truncated to seconds
 		})
 
+		It("should generate instance-scoped identities for dataplanes with an instance tag", func() {
+			// given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+			err := caManager.EnsureBackends(context.Background(), mesh, []*mesh_proto.CertificateAuthorityBackend{backend})
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			tags := map[string]map[string]bool{
+				"kuma.io/service": {
+					"web": true,
+				},
+				"kuma.io/instance": {
+					"web-0": true,
+				},
+			}
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, tags)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and should generate cert for dataplane with service and instance spiffe URIs
+			block, _ := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.URIs).To(HaveLen(4))
+			Expect(cert.URIs[0].String()).To(Equal("spiffe://default/web"))
+			Expect(cert.URIs[1].String()).To(Equal("spiffe://default/web/instance/web-0"))
+			Expect(cert.URIs[2].String()).To(Equal("kuma://kuma.io/instance/web-0"))
+			Expect(cert.URIs[3].String()).To(Equal("kuma://kuma.io/service/web"))
+		})
+
 		It("should throw an error on generate dataplane certs on non-existing CA", func() {
 			// given
 			mesh := "default"
@@ -244,4 +289,291 @@ var _ = Describe("Builtin CA Manager", func() {
 			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "builtin-non-existent": Resource not found: type="Secret" name="default.ca-builtin-cert-builtin-non-existent" mesh="default"`))
 		})
 	})
+
+	Context("Per zone CA", func() {
+		var rm manager.ResourceManager
+		var globalCaManager, zoneCaManager core_ca.Manager
+
+		backend := &mesh_proto.CertificateAuthorityBackend{
+			Name: "builtin-1",
+			Type: "builtin",
+			Conf: util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+				PerZone: true,
+			}),
+		}
+
+		BeforeEach(func() {
+			rm = manager.NewResourceManager(memory.NewStore())
+			for _, zone := range []string{"zone-1", "zone-2"} {
+				zoneRes := &system.ZoneResource{
+					Spec: &system_proto.Zone{
+						Enabled: util_proto.Bool(true),
+					},
+				}
+				err := rm.Create(context.Background(), zoneRes, core_store.CreateByKey(zone, core_model.NoMesh))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			globalCaManager = builtin.NewBuiltinCaManager(rm, datasource.NewDataSourceLoader(rm, nil), "")
+			zoneCaManager = builtin.NewBuiltinCaManager(rm, datasource.NewDataSourceLoader(rm, nil), "zone-1")
+		})
+
+		It("should create CA for every zone on Global CP", func() {
+			// when
+			err := globalCaManager.EnsureBackends(context.Background(), "default", []*mesh_proto.CertificateAuthorityBackend{backend})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			secrets, err := globalCaManager.UsedSecrets("default", backend)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets).To(Equal([]string{
+				"default.ca-builtin-zone-cert-builtin-1.zone-1",
+				"default.ca-builtin-zone-cert-builtin-1.zone-2",
+				"default.ca-builtin-zone-key-builtin-1.zone-1",
+				"default.ca-builtin-zone-key-builtin-1.zone-2",
+			}))
+
+			// and mesh wide CA is not created
+			err = rm.Get(context.Background(), system.NewSecretResource(), core_store.GetByKey("default.ca-builtin-cert-builtin-1", "default"))
+			Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should not create CAs on Zone CP", func() {
+			// when
+			err := zoneCaManager.EnsureBackends(context.Background(), "default", []*mesh_proto.CertificateAuthorityBackend{backend})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			secrets, err := zoneCaManager.UsedSecrets("default", backend)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets).To(BeEmpty())
+		})
+
+		It("should issue dataplane certs from CA of the local zone and trust CAs of all zones", func() {
+			// given
+			err := globalCaManager.EnsureBackends(context.Background(), "default", []*mesh_proto.CertificateAuthorityBackend{backend})
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			tags := map[string]map[string]bool{
+				"kuma.io/service": {
+					"web": true,
+				},
+			}
+			pair, err := zoneCaManager.GenerateDataplaneCert(context.Background(), "default", backend, tags)
+			Expect(err).ToNot(HaveOccurred())
+			trustBundle, err := zoneCaManager.GetRootCert(context.Background(), "default", backend)
+			Expect(err).ToNot(HaveOccurred())
+
+			// then trust bundle contains CAs of all zones
+			Expect(trustBundle).To(HaveLen(2))
+
+			// and the cert is signed only by CA of the local zone
+			block, _ := pem.Decode(pair.CertPEM)
+			leaf, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			verify := func(ca core_ca.Cert) error {
+				roots := x509.NewCertPool()
+				Expect(roots.AppendCertsFromPEM(ca)).To(BeTrue())
+				_, err := leaf.Verify(x509.VerifyOptions{
+					Roots:     roots,
+					KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+				})
+				return err
+			}
+			Expect(verify(trustBundle[0])).To(Succeed())    // zone-1
+			Expect(verify(trustBundle[1])).ToNot(Succeed()) // zone-2
+		})
+
+		It("should not issue dataplane certs on Global CP", func() {
+			// given
+			err := globalCaManager.EnsureBackends(context.Background(), "default", []*mesh_proto.CertificateAuthorityBackend{backend})
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			_, err = globalCaManager.GenerateDataplaneCert(context.Background(), "default", backend, mesh_proto.MultiValueTagSet{})
+
+			// then
+			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "builtin-1": certificates of the per zone CA can only be issued by Zone CP`))
+		})
+	})
+
+	Context("Intermediate CA", func() {
+		var root, intermediate *util_tls.KeyPair
+		var backend *mesh_proto.CertificateAuthorityBackend
+
+		inline := func(data []byte) *system_proto.DataSource {
+			return &system_proto.DataSource{
+				Type: &system_proto.DataSource_Inline{
+					Inline: util_proto.Bytes(data),
+				},
+			}
+		}
+
+		backendWith := func(intermediate, root *util_tls.KeyPair) *mesh_proto.CertificateAuthorityBackend {
+			return &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					Intermediate: &config.BuiltinCertificateAuthorityConfig_Intermediate{
+						Cert:     inline(intermediate.CertPEM),
+						Key:      inline(intermediate.KeyPEM),
+						RootCert: inline(root.CertPEM),
+					},
+				}),
+			}
+		}
+
+		BeforeEach(func() {
+			var err error
+			root, err = newTestCa(nil, "root")
+			Expect(err).ToNot(HaveOccurred())
+			intermediate, err = newTestCa(root, "intermediate")
+			Expect(err).ToNot(HaveOccurred())
+			backend = backendWith(intermediate, root)
+		})
+
+		It("should not create a CA", func() {
+			// when
+			err := caManager.EnsureBackends(context.Background(), "default", []*mesh_proto.CertificateAuthorityBackend{backend})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and no secrets are created
+			secretRes := system.NewSecretResource()
+			err = secretManager.Get(context.Background(), secretRes, core_store.GetByKey("default.ca-builtin-cert-builtin-1", "default"))
+			Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should return only root CA as root cert", func() {
+			// when
+			certs, err := caManager.GetRootCert(context.Background(), "default", backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(certs).To(HaveLen(1))
+			Expect(certs[0]).To(Equal(core_ca.Cert(root.CertPEM)))
+		})
+
+		It("should issue dataplane certs with the full chain", func() {
+			// given
+			tags := map[string]map[string]bool{
+				"kuma.io/service": {
+					"web": true,
+				},
+			}
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend, tags)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and the chain contains workload cert and intermediate CA
+			block, rest := pem.Decode(pair.CertPEM)
+			leaf, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			block, rest = pem.Decode(rest)
+			Expect(block).ToNot(BeNil())
+			Expect(pem.EncodeToMemory(block)).To(Equal(intermediate.CertPEM))
+			Expect(rest).To(BeEmpty())
+
+			// and the workload cert can be verified against the root CA
+			roots := x509.NewCertPool()
+			Expect(roots.AppendCertsFromPEM(root.CertPEM)).To(BeTrue())
+			intermediates := x509.NewCertPool()
+			Expect(intermediates.AppendCertsFromPEM(intermediate.CertPEM)).To(BeTrue())
+			_, err = leaf.Verify(x509.VerifyOptions{
+				Roots:         roots,
+				Intermediates: intermediates,
+				KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should validate correct intermediate CA", func() {
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject intermediate CA without data sources", func() {
+			// given
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					Intermediate: &config.BuiltinCertificateAuthorityConfig_Intermediate{},
+				}),
+			}
+
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend)
+
+			// then
+			Expect(err).To(MatchError(`intermediate.cert: has to be defined; intermediate.key: has to be defined; intermediate.rootCert: has to be defined`))
+		})
+
+		It("should reject intermediate CA that is not signed by the root CA", func() {
+			// given
+			otherRoot, err := newTestCa(nil, "other-root")
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = caManager.ValidateBackend(context.Background(), "default", backendWith(intermediate, otherRoot))
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("intermediate.cert: could not be verified against the root CA"))
+		})
+
+		It("should reject intermediate that cannot issue certificates", func() {
+			// given
+			leaf, err := util_tls.NewSelfSignedCert("leaf", util_tls.ServerCertType)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = caManager.ValidateBackend(context.Background(), "default", backendWith(&leaf, root))
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("intermediate.cert[0]: basic constraint 'CA' must be set to 'true'"))
+		})
+	})
 })
+
+// newTestCa generates a CA signed by the given parent or a self-signed CA when parent is nil.
+func newTestCa(parent *util_tls.KeyPair, commonName string) (*util_tls.KeyPair, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	issuer, signer := template, crypto.Signer(key)
+	if parent != nil {
+		parentPair, err := tls.X509KeyPair(parent.CertPEM, parent.KeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		issuer, err = x509.ParseCertificate(parentPair.Certificate[0])
+		if err != nil {
+			return nil, err
+		}
+		signer = parentPair.PrivateKey.(crypto.Signer)
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, issuer, key.Public(), signer)
+	if err != nil {
+		return nil, err
+	}
+	return util_tls.ToKeyPair(key, cert)
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/core/ca"
 	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
 )
@@ -14,5 +15,9 @@ func init() {
 }
 
 func (p plugin) NewCaManager(context core_plugins.PluginContext, config core_plugins.PluginConfig) (ca.Manager, error) {
-	return NewBuiltinCaManager(context.ResourceManager()), nil
+	zone := ""
+	if context.Config().Mode == config_core.Zone {
+		zone = context.Config().Multizone.Zone.Name
+	}
+	return NewBuiltinCaManager(context.ResourceManager(), context.DataSourceLoader(), zone), nil
 }
@@ -0,0 +1,154 @@
+package builtin
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_system "github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/ca/builtin/config"
+	builtin_zone "github.com/kumahq/kuma/pkg/plugins/ca/builtin/zone"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+func (b *builtinCaManager) ensureZoneCas(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, cfg *config.BuiltinCertificateAuthorityConfig) error {
+	zones := &core_system.ZoneResourceList{}
+	if err := b.secretManager.List(ctx, zones); err != nil {
+		return err
+	}
+	for _, zone := range zones.Items {
+		zoneName := zone.GetMeta().GetName()
+		err := b.secretManager.Get(ctx, core_system.NewSecretResource(), core_store.GetBy(builtin_zone.CertSecretResKey(mesh, backend.Name, zoneName)))
+		if err == nil { // CA of the zone is there, nothing to ensure
+			continue
+		}
+		if !core_store.IsResourceNotFound(err) {
+			return err
+		}
+		if err := b.createZoneCa(ctx, mesh, backend.Name, zoneName, cfg); err != nil {
+			return errors.Wrapf(err, "failed to create CA of zone %q", zoneName)
+		}
+	}
+	return nil
+}
+
+func (b *builtinCaManager) createZoneCa(ctx context.Context, mesh string, backendName string, zone string, cfg *config.BuiltinCertificateAuthorityConfig) error {
+	opts := []certOptsFn{withZone(zone)}
+	if cfg.GetCaCert().GetExpiration() != "" {
+		duration, err := core_mesh.ParseDuration(cfg.GetCaCert().GetExpiration())
+		if err != nil {
+			return err
+		}
+		opts = append(opts, withExpirationTime(duration))
+	}
+	keyPair, err := newRootCa(mesh, int(cfg.GetCaCert().GetRSAbits().GetValue()), opts...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to generate a CA cert for Mesh %q", mesh)
+	}
+
+	// key is created first, so the zone does not receive the cert without the key
+	keySecret := &core_system.SecretResource{
+		Spec: &system_proto.Secret{
+			Data: util_proto.Bytes(keyPair.KeyPEM),
+		},
+	}
+	if err := b.secretManager.Create(ctx, keySecret, core_store.CreateBy(builtin_zone.KeySecretResKey(mesh, backendName, zone))); err != nil {
+		return err
+	}
+
+	certSecret := &core_system.SecretResource{
+		Spec: &system_proto.Secret{
+			Data: util_proto.Bytes(keyPair.CertPEM),
+		},
+	}
+	if err := b.secretManager.Create(ctx, certSecret, core_store.CreateBy(builtin_zone.CertSecretResKey(mesh, backendName, zone))); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (b *builtinCaManager) listZoneCaSecrets(ctx context.Context, mesh string, backendName string) ([]*core_system.SecretResource, error) {
+	secrets := &core_system.SecretResourceList{}
+	if err := b.secretManager.List(ctx, secrets, core_store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	certPrefix := builtin_zone.CertSecretPrefix(mesh, backendName)
+	keyPrefix := builtin_zone.KeySecretPrefix(mesh, backendName)
+	var result []*core_system.SecretResource
+	for _, secret := range secrets.Items {
+		name := secret.GetMeta().GetName()
+		if strings.HasPrefix(name, certPrefix) || strings.HasPrefix(name, keyPrefix) {
+			result = append(result, secret)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetMeta().GetName() < result[j].GetMeta().GetName()
+	})
+	return result, nil
+}
+
+func (b *builtinCaManager) zoneCaSecrets(ctx context.Context, mesh string, backendName string) ([]string, error) {
+	secrets, err := b.listZoneCaSecrets(ctx, mesh, backendName)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, secret := range secrets {
+		names = append(names, secret.GetMeta().GetName())
+	}
+	return names, nil
+}
+
+func (b *builtinCaManager) zoneTrustBundle(ctx context.Context, mesh string, backendName string) ([]core_ca.Cert, error) {
+	secrets, err := b.listZoneCaSecrets(ctx, mesh, backendName)
+	if err != nil {
+		return nil, err
+	}
+	var certs []core_ca.Cert
+	for _, secret := range secrets {
+		if _, isKey, _ := builtin_zone.ZoneOfSecret(secret.GetMeta().GetName()); isKey {
+			continue
+		}
+		certs = append(certs, secret.Spec.GetData().GetValue())
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("there are no CAs of zones")
+	}
+	return certs, nil
+}
+
+func (b *builtinCaManager) getZoneCa(ctx context.Context, mesh string, backendName string) (core_ca.KeyPair, error) {
+	if b.zone == "" {
+		return core_ca.KeyPair{}, errors.New("certificates of the per zone CA can only be issued by Zone CP")
+	}
+	certSecret := core_system.NewSecretResource()
+	if err := b.secretManager.Get(ctx, certSecret, core_store.GetBy(builtin_zone.CertSecretResKey(mesh, backendName, b.zone))); err != nil {
+		return core_ca.KeyPair{}, err
+	}
+
+	keySecret := core_system.NewSecretResource()
+	if err := b.secretManager.Get(ctx, keySecret, core_store.GetBy(builtin_zone.KeySecretResKey(mesh, backendName, b.zone))); err != nil {
+		return core_ca.KeyPair{}, err
+	}
+
+	return core_ca.KeyPair{
+		CertPEM: certSecret.Spec.Data.Value,
+		KeyPEM:  keySecret.Spec.Data.Value,
+	}, nil
+}
+
+func withZone(zone string) certOptsFn {
+	return func(certificate *x509.Certificate) {
+		// every zone has its own CA, so we need to distinguish the subjects in the trust bundle
+		certificate.Subject.OrganizationalUnit = append(certificate.Subject.OrganizationalUnit, fmt.Sprintf("Zone %s", zone))
+	}
+}
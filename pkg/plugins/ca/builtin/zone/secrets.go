@@ -0,0 +1,59 @@
+package zone
+
+import (
+	"fmt"
+	"strings"
+
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+// Secrets of zone CAs are generated by Global CP when the "perZone" mode of the builtin CA is used.
+// The zone is encoded in the name of the Secret, so KDS can decide to which zones the Secret is synced.
+const (
+	certSecretMarker = ".ca-builtin-zone-cert-"
+	keySecretMarker  = ".ca-builtin-zone-key-"
+)
+
+func CertSecretResKey(mesh string, backendName string, zone string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: CertSecretPrefix(mesh, backendName) + zone,
+	}
+}
+
+func KeySecretResKey(mesh string, backendName string, zone string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: KeySecretPrefix(mesh, backendName) + zone,
+	}
+}
+
+// CertSecretPrefix returns the prefix of names of Secrets that contain certificates of zone CAs of the given backend.
+func CertSecretPrefix(mesh string, backendName string) string {
+	return fmt.Sprintf("%s%s%s.", mesh, certSecretMarker, backendName)
+}
+
+// KeySecretPrefix returns the prefix of names of Secrets that contain keys of zone CAs of the given backend.
+func KeySecretPrefix(mesh string, backendName string) string {
+	return fmt.Sprintf("%s%s%s.", mesh, keySecretMarker, backendName)
+}
+
+// ZoneOfSecret returns the zone of the Secret that holds a zone CA.
+// isKey is true when the Secret contains the private key of the CA.
+func ZoneOfSecret(name string) (zone string, isKey bool, ok bool) {
+	marker := certSecretMarker
+	idx := strings.Index(name, marker)
+	if idx == -1 {
+		marker = keySecretMarker
+		isKey = true
+		if idx = strings.Index(name, marker); idx == -1 {
+			return "", false, false
+		}
+	}
+	backendAndZone := name[idx+len(marker):]
+	dot := strings.Index(backendAndZone, ".")
+	if dot == -1 || dot == len(backendAndZone)-1 {
+		return "", false, false
+	}
+	return backendAndZone[dot+1:], isKey, true
+}
@@ -0,0 +1,53 @@
+package zone_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/plugins/ca/builtin/zone"
+)
+
+var _ = Describe("ZoneOfSecret()", func() {
+
+	type testCase struct {
+		name  string
+		zone  string
+		isKey bool
+		ok    bool
+	}
+
+	DescribeTable("should parse the name of the secret",
+		func(given testCase) {
+			// when
+			z, isKey, ok := zone.ZoneOfSecret(given.name)
+
+			// then
+			Expect(z).To(Equal(given.zone))
+			Expect(isKey).To(Equal(given.isKey))
+			Expect(ok).To(Equal(given.ok))
+		},
+		Entry("cert of zone CA", testCase{
+			name: zone.CertSecretResKey("default", "ca-1", "zone-1").Name,
+			zone: "zone-1",
+			ok:   true,
+		}),
+		Entry("key of zone CA", testCase{
+			name:  zone.KeySecretResKey("default", "ca-1", "zone-1").Name,
+			zone:  "zone-1",
+			isKey: true,
+			ok:    true,
+		}),
+		Entry("zone with dots", testCase{
+			name: zone.CertSecretResKey("default", "ca-1", "us.east").Name,
+			zone: "us.east",
+			ok:   true,
+		}),
+		Entry("cert of mesh CA", testCase{
+			name: "default.ca-builtin-cert-ca-1",
+		}),
+		Entry("user defined secret", testCase{
+			name: "my-secret",
+		}),
+	)
+})
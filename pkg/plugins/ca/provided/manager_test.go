@@ -33,7 +33,7 @@ var _ = Describe("Provided CA", func() {
 		core.Now = func() time.Time {
 			return now
 		}
-		caManager = provided.NewProvidedCaManager(datasource.NewDataSourceLoader(nil))
+		caManager = provided.NewProvidedCaManager(datasource.NewDataSourceLoader(nil, nil))
 	})
 
 	AfterEach(func() {
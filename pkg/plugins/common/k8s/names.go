@@ -1,6 +1,7 @@
 package k8s
 
 import (
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 )
 
@@ -25,6 +26,26 @@ const (
 	GlobalSecretType = "system.kuma.io/global-secret"
 )
 
+// Keys of the data of Kuma System secrets that reference a value of an external secret manager instead of holding it.
+const (
+	SecretExternalProviderKey = "externalProvider"
+	SecretExternalPathKey     = "externalPath"
+	SecretExternalKeyKey      = "externalKey"
+)
+
+// SecretExternal returns the reference to an external value of the data of the Kuma System secret, or nil if the secret
+// doesn't reference an external value.
+func SecretExternal(data map[string][]byte) *system_proto.Secret_External {
+	if _, ok := data[SecretExternalProviderKey]; !ok {
+		return nil
+	}
+	return &system_proto.Secret_External{
+		Provider: string(data[SecretExternalProviderKey]),
+		Path:     string(data[SecretExternalPathKey]),
+		Key:      string(data[SecretExternalKeyKey]),
+	}
+}
+
 func ResourceNameExtensions(namespace, name string) core_model.ResourceNameExtensions {
 	return core_model.ResourceNameExtensions{
 		k8sNamespaceComponent: namespace,
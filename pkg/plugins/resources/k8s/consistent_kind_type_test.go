@@ -13,12 +13,15 @@ import (
 
 // Those types are not mapped directly to Kubernetes Resource
 var IgnoredTypes = map[model.ResourceType]bool{
-	system.SecretType:           true,
-	system.GlobalSecretType:     true,
-	system.ConfigType:           true,
-	mesh.ZoneIngressInsightType: true, // uses DataplaneInsight under the hood
-	mesh.GatewayType:            true, // Gateway is only in Universal ATM.
-	mesh.GatewayRouteType:       true, // GatewayRoute is only in Universal ATM.
+	system.SecretType:            true,
+	system.GlobalSecretType:      true,
+	system.ConfigType:            true,
+	mesh.ZoneIngressInsightType:  true, // uses DataplaneInsight under the hood
+	mesh.GatewayType:             true, // Gateway is only in Universal ATM.
+	mesh.GatewayInsightType:      true, // GatewayInsight is only used by Gateways.
+	mesh.GatewayRouteType:        true, // GatewayRoute is only in Universal ATM.
+	mesh.GatewayRouteInsightType: true, // GatewayRouteInsight is only used by Gateways.
+	mesh.WafRuleSetType:          true, // WafRuleSet is only used by Gateways.
 }
 
 var _ = Describe("Consistent Kind Types", func() {
@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (cp *ConnectionPool) GetObjectMeta() *metav1.ObjectMeta {
+	return &cp.ObjectMeta
+}
+
+func (cp *ConnectionPool) SetObjectMeta(m *metav1.ObjectMeta) {
+	cp.ObjectMeta = *m
+}
+
+func (cp *ConnectionPool) GetMesh() string {
+	return cp.Mesh
+}
+
+func (cp *ConnectionPool) SetMesh(mesh string) {
+	cp.Mesh = mesh
+}
+
+func (cp *ConnectionPool) GetSpec() map[string]interface{} {
+	return cp.Spec
+}
+
+func (cp *ConnectionPool) SetSpec(spec map[string]interface{}) {
+	cp.Spec = spec
+}
+
+func (cp *ConnectionPool) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *ConnectionPoolList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.ConnectionPool{}, &ConnectionPool{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "ConnectionPool",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.ConnectionPool{}, &ConnectionPoolList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "ConnectionPoolList",
+		},
+	})
+}
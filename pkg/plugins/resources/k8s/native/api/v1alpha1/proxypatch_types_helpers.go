@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (pp *ProxyPatch) GetObjectMeta() *metav1.ObjectMeta {
+	return &pp.ObjectMeta
+}
+
+func (pp *ProxyPatch) SetObjectMeta(m *metav1.ObjectMeta) {
+	pp.ObjectMeta = *m
+}
+
+func (pp *ProxyPatch) GetMesh() string {
+	return pp.Mesh
+}
+
+func (pp *ProxyPatch) SetMesh(mesh string) {
+	pp.Mesh = mesh
+}
+
+func (pp *ProxyPatch) GetSpec() map[string]interface{} {
+	return pp.Spec
+}
+
+func (pp *ProxyPatch) SetSpec(spec map[string]interface{}) {
+	pp.Spec = spec
+}
+
+func (l *ProxyPatch) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *ProxyPatchList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.ProxyPatch{}, &ProxyPatch{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "ProxyPatch",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.ProxyPatch{}, &ProxyPatchList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "ProxyPatchList",
+		},
+	})
+}
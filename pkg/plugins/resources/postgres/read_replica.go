@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	config "github.com/kumahq/kuma/pkg/config/plugins/resources/postgres"
+	"github.com/kumahq/kuma/pkg/core"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	common_postgres "github.com/kumahq/kuma/pkg/plugins/common/postgres"
+)
+
+var replicaLog = core.Log.WithName("postgres-read-replica")
+
+// replicationLagQuery returns the replication lag of a replica in seconds.
+// When the replica replayed everything it received, it is up to date even if there were no recent writes on the primary.
+const replicationLagQuery = `SELECT CASE
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// readReplica serves reads that tolerate bounded staleness.
+// The replication lag is checked in the background every LagCheckInterval, so reads never wait for the replica.
+// When the lag exceeds MaxStaleness or cannot be checked, reads are served by the primary.
+type readReplica struct {
+	db  *sql.DB
+	cfg config.ReadReplicaPostgresStoreConfig
+
+	sync.RWMutex
+	checkedAt time.Time
+	lag       time.Duration
+	available bool
+
+	stop chan struct{}
+}
+
+func newReadReplica(metrics core_metrics.Metrics, cfg config.PostgresStoreConfig) (*readReplica, error) {
+	replicaCfg := cfg
+	replicaCfg.Host = cfg.ReadReplica.Host
+	replicaCfg.Port = cfg.ReadReplica.Port
+	db, err := common_postgres.ConnectToDb(replicaCfg)
+	if err != nil {
+		return nil, err
+	}
+	replica := &readReplica{
+		db:   db,
+		cfg:  cfg.ReadReplica,
+		stop: make(chan struct{}),
+	}
+	lagMetric := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "store_postgres_read_replica_lag",
+		Help: "Replication lag of the postgres store read replica in seconds",
+	}, func() float64 {
+		replica.RLock()
+		defer replica.RUnlock()
+		return replica.lag.Seconds()
+	})
+	if err := metrics.Register(lagMetric); err != nil {
+		return nil, err
+	}
+	replica.checkLag()
+	go replica.checkLagPeriodically()
+	return replica, nil
+}
+
+// Usable returns true if the replication lag of the replica is within MaxStaleness.
+// The lag grows by the time that passed since it was checked, so a replica whose check is stuck becomes unusable.
+func (r *readReplica) Usable() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return r.available && r.lag+core.Now().Sub(r.checkedAt) <= r.cfg.MaxStaleness
+}
+
+func (r *readReplica) checkLagPeriodically() {
+	ticker := time.NewTicker(r.cfg.LagCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.checkLag()
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+func (r *readReplica) checkLag() {
+	ctx, cancel := context.WithTimeout(context.Background(), r.cfg.LagCheckInterval)
+	defer cancel()
+	checkedAt := core.Now()
+	var lagSeconds float64
+	err := r.db.QueryRowContext(ctx, replicationLagQuery).Scan(&lagSeconds)
+
+	r.Lock()
+	defer r.Unlock()
+	if err != nil {
+		if r.available {
+			replicaLog.Error(err, "could not check the replication lag, reads are served by the primary")
+		}
+		r.available = false
+		return
+	}
+	r.checkedAt = checkedAt
+	r.lag = time.Duration(lagSeconds * float64(time.Second))
+	available := r.lag <= r.cfg.MaxStaleness
+	if available != r.available {
+		replicaLog.Info("availability of the read replica changed", "available", available, "lag", r.lag)
+	}
+	r.available = available
+}
+
+func (r *readReplica) Close() error {
+	close(r.stop)
+	return r.db.Close()
+}
@@ -24,6 +24,8 @@ const duplicateKeyErrorMsg = "duplicate key value violates unique constraint"
 
 type postgresResourceStore struct {
 	db *sql.DB
+	// replica is nil when the read replica is not configured
+	replica *readReplica
 }
 
 var _ store.ResourceStore = &postgresResourceStore{}
@@ -38,11 +40,28 @@ func NewStore(metrics core_metrics.Metrics, config config.PostgresStoreConfig) (
 		return nil, errors.Wrapf(err, "could not register DB metrics")
 	}
 
+	var replica *readReplica
+	if config.ReadReplica.Enabled() {
+		replica, err = newReadReplica(metrics, config)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not connect to the read replica")
+		}
+	}
+
 	return &postgresResourceStore{
-		db: db,
+		db:      db,
+		replica: replica,
 	}, nil
 }
 
+// readDb returns the read replica when it is within the bounded staleness and the read does not have to be consistent.
+func (r *postgresResourceStore) readDb(consistent bool) *sql.DB {
+	if consistent || r.replica == nil || !r.replica.Usable() {
+		return r.db
+	}
+	return r.replica.db
+}
+
 func (r *postgresResourceStore) Create(_ context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
 	opts := store.NewCreateOptions(fs...)
 
@@ -144,7 +163,7 @@ func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource,
 	opts := store.NewGetOptions(fs...)
 
 	statement := `SELECT spec, version, creation_time, modification_time FROM resources WHERE name=$1 AND mesh=$2 AND type=$3;`
-	row := r.db.QueryRow(statement, opts.Name, opts.Mesh, resource.Descriptor().Name)
+	row := r.readDb(opts.Consistent).QueryRow(statement, opts.Name, opts.Mesh, resource.Descriptor().Name)
 
 	var spec string
 	var version int
@@ -190,7 +209,7 @@ func (r *postgresResourceStore) List(_ context.Context, resources model.Resource
 	}
 	statement += " ORDER BY name, mesh"
 
-	rows, err := r.db.Query(statement, statementArgs...)
+	rows, err := r.readDb(opts.Consistent).Query(statement, statementArgs...)
 	if err != nil {
 		return errors.Wrapf(err, "failed to execute query: %s", statement)
 	}
@@ -238,6 +257,11 @@ func rowToItem(resources model.ResourceList, rows *sql.Rows) (model.Resource, er
 }
 
 func (r *postgresResourceStore) Close() error {
+	if r.replica != nil {
+		if err := r.replica.Close(); err != nil {
+			return err
+		}
+	}
 	return r.db.Close()
 }
 
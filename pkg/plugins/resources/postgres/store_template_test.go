@@ -40,3 +40,33 @@ var _ = Describe("PostgresStore template", func() {
 	test_store.ExecuteStoreTests(createStore)
 	test_store.ExecuteOwnerTests(createStore)
 })
+
+var _ = Describe("PostgresStore template with read replica", func() {
+	createStore := func() store.ResourceStore {
+		cfg := postgres.PostgresStoreConfig{}
+		err := config.Load("", &cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		metrics, err := core_metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+
+		dbName, err := common_postgres.CreateRandomDb(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		cfg.DbName = dbName
+
+		_, err = migrateDb(cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		// the primary acts as a replica without replication lag
+		cfg.ReadReplica = postgres.DefaultReadReplicaPostgresStoreConfig()
+		cfg.ReadReplica.Host = cfg.Host
+		cfg.ReadReplica.Port = cfg.Port
+
+		pStore, err := NewStore(metrics, cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		return pStore
+	}
+
+	test_store.ExecuteStoreTests(createStore)
+})
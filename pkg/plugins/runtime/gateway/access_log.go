@@ -0,0 +1,126 @@
+package gateway
+
+import (
+	"strings"
+
+	envoy_config_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_header_to_metadata "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_to_metadata/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes"
+	routes_v3 "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
+)
+
+const headerToMetadataFilterName = "envoy.filters.http.header_to_metadata"
+
+// accessLogMetadataNamespace is the namespace of the dynamic metadata
+// that the gateway sets for its access logs.
+const accessLogMetadataNamespace = "io.kuma.gateway"
+
+// Placeholders of the access log format of gateway listeners, and the
+// Envoy command operators they are replaced with. The route name is set
+// on the Envoy routes, and the virtual host is set in the dynamic
+// metadata of the request by VirtualHostAccessLogMetadata.
+var accessLogPlaceholders = strings.NewReplacer(
+	"%KUMA_GATEWAY_ROUTE%", "%ROUTE_NAME%",
+	"%KUMA_GATEWAY_VIRTUAL_HOST%", "%DYNAMIC_METADATA("+accessLogMetadataNamespace+":virtual_host)%",
+)
+
+// AccessLogBackend returns the logging backend of the mesh that the
+// listener logs to, with the format of the listener. It returns nil if
+// the mesh doesn't have the backend.
+func AccessLogBackend(
+	mesh *core_mesh.MeshResource,
+	conf *mesh_proto.Gateway_Listener_AccessLog,
+) *mesh_proto.LoggingBackend {
+	name := conf.GetBackend()
+	if name == "" {
+		name = mesh.Spec.GetLogging().GetDefaultBackend()
+	}
+
+	for _, b := range mesh.Spec.GetLogging().GetBackends() {
+		if b.GetName() != name {
+			continue
+		}
+
+		backend := proto.Clone(b).(*mesh_proto.LoggingBackend)
+		if conf.GetFormat() != "" {
+			backend.Format = conf.GetFormat()
+		}
+		backend.Format = accessLogPlaceholders.Replace(backend.Format)
+
+		return backend
+	}
+
+	return nil
+}
+
+// AccessLogMetadataFilter adds the header to metadata HTTP filter to
+// the filter chain. The filter has no effect on its own, virtual hosts
+// configure it to record their name in the dynamic metadata.
+func AccessLogMetadataFilter() envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) error {
+			// Requests that don't match a virtual host are
+			// logged with the host they are sent to.
+			config, err := util_proto.MarshalAnyDeterministic(virtualHostMetadata(""))
+			if err != nil {
+				return err
+			}
+
+			hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+				Name: headerToMetadataFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: config,
+				},
+			}}, hcm.HttpFilters...)
+
+			return nil
+		}),
+	)
+}
+
+// VirtualHostAccessLogMetadata records the hostname of the virtual host
+// in the dynamic metadata of its requests, so that the access logs can
+// report it.
+func VirtualHostAccessLogMetadata(hostname string) envoy_routes.VirtualHostBuilderOpt {
+	return envoy_routes.AddVirtualHostConfigurer(
+		routes_v3.VirtualHostConfigureFunc(func(vh *envoy_config_route.VirtualHost) error {
+			config, err := util_proto.MarshalAnyDeterministic(virtualHostMetadata(hostname))
+			if err != nil {
+				return err
+			}
+
+			if vh.TypedPerFilterConfig == nil {
+				vh.TypedPerFilterConfig = map[string]*anypb.Any{}
+			}
+
+			vh.TypedPerFilterConfig[headerToMetadataFilterName] = config
+
+			return nil
+		}),
+	)
+}
+
+// virtualHostMetadata sets the virtual host in the dynamic metadata.
+// The :authority header is always present, so it only triggers the
+// rule. When the hostname is empty, the value of the header is used.
+func virtualHostMetadata(hostname string) *envoy_header_to_metadata.Config {
+	return &envoy_header_to_metadata.Config{
+		RequestRules: []*envoy_header_to_metadata.Config_Rule{{
+			Header: ":authority",
+			OnHeaderPresent: &envoy_header_to_metadata.Config_KeyValuePair{
+				MetadataNamespace: accessLogMetadataNamespace,
+				Key:               "virtual_host",
+				Value:             hostname,
+			},
+		}},
+	}
+}
@@ -0,0 +1,101 @@
+package gateway
+
+import (
+	envoy_config_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_buffer "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+)
+
+const bufferFilterName = "envoy.filters.http.buffer"
+
+// DefaultMaxRequestBytes is the limit of the buffer filter of the
+// listener. Each route overrides it with its own limit or disables
+// buffering, so it never applies. It is a constant, rather than
+// the largest limit of the routes, so that changing the limit of a
+// route doesn't change the listener and drain its connections.
+const DefaultMaxRequestBytes = 1024 * 1024
+
+// BufferFilter adds the buffer HTTP filter to the filter chain.
+func BufferFilter() envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) error {
+			config, err := util_proto.MarshalAnyDeterministic(&envoy_buffer.Buffer{
+				MaxRequestBytes: util_proto.UInt32(DefaultMaxRequestBytes),
+			})
+			if err != nil {
+				return err
+			}
+
+			hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+				Name: bufferFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: config,
+				},
+			}}, hcm.HttpFilters...)
+
+			return nil
+		}),
+	)
+}
+
+// RouteBuffer sets the request buffering of the route, on listeners
+// that buffer requests. Routes without a buffer filter don't buffer
+// requests.
+func RouteBuffer(listenerBuffered bool, buffer *route.Buffer) route.RouteConfigurer {
+	if !listenerBuffered {
+		return route.RouteConfigureFunc(nil)
+	}
+
+	perRoute := &envoy_buffer.BufferPerRoute{
+		Override: &envoy_buffer.BufferPerRoute_Disabled{
+			Disabled: true,
+		},
+	}
+
+	if buffer != nil {
+		perRoute.Override = &envoy_buffer.BufferPerRoute_Buffer{
+			Buffer: &envoy_buffer.Buffer{
+				MaxRequestBytes: util_proto.UInt32(buffer.MaxRequestBytes),
+			},
+		}
+	}
+
+	return route.RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		config, err := util_proto.MarshalAnyDeterministic(perRoute)
+		if err != nil {
+			return err
+		}
+
+		if r.TypedPerFilterConfig == nil {
+			r.TypedPerFilterConfig = map[string]*anypb.Any{}
+		}
+
+		r.TypedPerFilterConfig[bufferFilterName] = config
+
+		return nil
+	})
+}
+
+// hostBuffered returns true if any of the GatewayRoutes of the given
+// hosts has a buffer filter.
+func hostBuffered(hosts []GatewayHost) bool {
+	for _, host := range hosts {
+		for _, gatewayRoute := range hostGatewayRoutes(host) {
+			for _, rule := range gatewayRoute.Spec.GetConf().GetHttp().GetRules() {
+				for _, f := range rule.GetFilters() {
+					if f.GetBuffer() != nil {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,119 @@
+package gateway
+
+import (
+	"fmt"
+	"time"
+
+	envoy_config_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_cache "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3alpha"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+)
+
+const cacheFilterName = "envoy.filters.http.cache"
+
+// defaultCacheMaxBodyBytes is the size of the largest response body
+// that is cached when the listener doesn't set one.
+const defaultCacheMaxBodyBytes = 1024 * 1024
+
+// simpleHttpCacheConfig is the configuration of the in-memory cache
+// storage of Envoy. The message is defined in the Envoy source tree
+// rather than in its API, so go-control-plane doesn't provide it. An
+// equivalent empty message is registered so that the configuration
+// can be marshaled like any other.
+var simpleHttpCacheConfig = registerEmptyMessage(
+	"envoy/source/extensions/filters/http/cache/simple_http_cache/config.proto",
+	"envoy.source.extensions.filters.http.cache",
+	"SimpleHttpCacheConfig",
+)
+
+func registerEmptyMessage(path string, pkg string, name string) protoreflect.MessageType {
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String(path),
+		Package: proto.String(pkg),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String(name),
+		}},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		panic(err)
+	}
+
+	messageType := dynamicpb.NewMessageType(file.Messages().Get(0))
+	if err := protoregistry.GlobalTypes.RegisterMessage(messageType); err != nil {
+		panic(err)
+	}
+
+	return messageType
+}
+
+// CacheFilter adds the cache HTTP filter to the filter chain. Responses
+// are stored in memory, and looked up by the key of the request.
+func CacheFilter(conf *mesh_proto.Gateway_Listener_Cache) envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) error {
+			cache := &envoy_cache.CacheConfig{
+				TypedConfig: &anypb.Any{
+					TypeUrl: "type.googleapis.com/" + string(simpleHttpCacheConfig.Descriptor().FullName()),
+				},
+				MaxBodyBytes: conf.GetMaxBodyBytes(),
+				KeyCreatorParams: &envoy_cache.CacheConfig_KeyCreatorParams{
+					ExcludeHost: conf.GetKey().GetExcludeHost(),
+				},
+			}
+
+			if cache.MaxBodyBytes == 0 {
+				cache.MaxBodyBytes = defaultCacheMaxBodyBytes
+			}
+
+			for _, name := range conf.GetKey().GetQueryParameters() {
+				cache.KeyCreatorParams.QueryParametersIncluded = append(cache.KeyCreatorParams.QueryParametersIncluded,
+					&envoy_config_route.QueryParameterMatcher{
+						Name: name,
+					})
+			}
+
+			for _, name := range conf.GetKey().GetHeaders() {
+				cache.AllowedVaryHeaders = append(cache.AllowedVaryHeaders, &envoy_type_matcher.StringMatcher{
+					MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+						Exact: name,
+					},
+					IgnoreCase: true,
+				})
+			}
+
+			config, err := util_proto.MarshalAnyDeterministic(cache)
+			if err != nil {
+				return err
+			}
+
+			hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+				Name: cacheFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: config,
+				},
+			}}, hcm.HttpFilters...)
+
+			return nil
+		}),
+	)
+}
+
+// CacheControl returns the value of the Cache-Control header that lets
+// the gateway, and any other cache, store a response for the given time.
+func CacheControl(ttl time.Duration) string {
+	return fmt.Sprintf("public, max-age=%d", int64(ttl.Seconds()))
+}
@@ -0,0 +1,258 @@
+package gateway
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
+	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
+)
+
+// ClientClass is a set of source CIDR blocks whose clients are matched
+// by the same rules. Each class gets a filter chain that matches the
+// blocks, and a route configuration with the routes of these rules.
+type ClientClass struct {
+	// Name is the name of the route configuration of the class.
+	Name string
+
+	// SourceCidrs are the CIDR blocks of the filter chain.
+	SourceCidrs []string
+
+	// Sources are the keys (see clientSourceKey) of the CIDR blocks
+	// of the rules that match the clients of the class.
+	Sources map[string]bool
+}
+
+// Accepts returns whether the route configuration of the class has a
+// route for the given entry. The class has all the entries that don't
+// match source addresses.
+func (c *ClientClass) Accepts(e route.Entry) bool {
+	if e.Client == nil || len(e.Client.SourceCidrs) == 0 {
+		return true
+	}
+
+	return c != nil && c.Sources[clientSourceKey(e.Client.SourceCidrs)]
+}
+
+// hostClientClasses returns the client classes of the source CIDR blocks
+// of the GatewayRoutes of the given hosts.
+//
+// Envoy selects the filter chain with the longest source prefix that
+// contains the client address. Since the blocks that contain an address
+// are nested, all of them contain the longest one, so a block is in the
+// same class as the other blocks that are contained by the blocks of the
+// same rules.
+func hostClientClasses(listener string, hosts []GatewayHost) []ClientClass {
+	blocks := map[string]*net.IPNet{}
+	sources := map[string][]*net.IPNet{}
+
+	for _, host := range hosts {
+		for _, gatewayRoute := range hostGatewayRoutes(host) {
+			for _, rule := range gatewayRoute.Spec.GetConf().GetHttp().GetRules() {
+				for _, m := range rule.GetMatches() {
+					cidrs := m.GetClient().GetSourceCidrs()
+					if len(cidrs) == 0 {
+						continue
+					}
+
+					key := clientSourceKey(cidrs)
+					if _, ok := sources[key]; ok {
+						continue
+					}
+
+					for _, ipNet := range parseCidrs(cidrs) {
+						blocks[ipNet.String()] = ipNet
+						sources[key] = append(sources[key], ipNet)
+					}
+				}
+			}
+		}
+	}
+
+	classes := map[string]*ClientClass{}
+
+	for block, ipNet := range blocks {
+		var keys []string
+		for key, ipNets := range sources {
+			if containsBlock(ipNets, ipNet) {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+		classKey := strings.Join(keys, "|")
+
+		class, ok := classes[classKey]
+		if !ok {
+			class = &ClientClass{Sources: map[string]bool{}}
+			for _, key := range keys {
+				class.Sources[key] = true
+			}
+			classes[classKey] = class
+		}
+
+		class.SourceCidrs = append(class.SourceCidrs, block)
+	}
+
+	var classKeys []string
+	for key := range classes {
+		classKeys = append(classKeys, key)
+	}
+	sort.Strings(classKeys)
+
+	var result []ClientClass
+	for i, key := range classKeys {
+		class := classes[key]
+		class.Name = envoy_names.GetGatewayClientRouteName(listener, i)
+		sort.Strings(class.SourceCidrs)
+		result = append(result, *class)
+	}
+
+	return result
+}
+
+// clientSourceKey returns a canonical key for the given CIDR blocks.
+func clientSourceKey(cidrs []string) string {
+	var blocks []string
+	for _, ipNet := range parseCidrs(cidrs) {
+		blocks = append(blocks, ipNet.String())
+	}
+
+	sort.Strings(blocks)
+
+	var unique []string
+	for i, block := range blocks {
+		if i == 0 || block != blocks[i-1] {
+			unique = append(unique, block)
+		}
+	}
+
+	return strings.Join(unique, ",")
+}
+
+// clientKey returns a canonical key for the given client match, so that
+// rules that match the same path for different clients get different
+// route table entries.
+func clientKey(c *route.Client) string {
+	if c == nil {
+		return ""
+	}
+
+	sans := append([]string{}, c.CertificateSans...)
+	sort.Strings(sans)
+
+	return clientSourceKey(c.SourceCidrs) + ";" + strings.Join(sans, ",")
+}
+
+// parseCidrs parses the given CIDR blocks. Invalid blocks are rejected
+// by validation, so they are skipped.
+func parseCidrs(cidrs []string) []*net.IPNet {
+	var result []*net.IPNet
+
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+
+		result = append(result, ipNet)
+	}
+
+	return result
+}
+
+// containsBlock returns whether any of the given blocks contains the
+// whole of the given block.
+func containsBlock(ipNets []*net.IPNet, block *net.IPNet) bool {
+	blockOnes, blockBits := block.Mask.Size()
+
+	for _, ipNet := range ipNets {
+		ones, bits := ipNet.Mask.Size()
+		if bits == blockBits && ones <= blockOnes && ipNet.Contains(block.IP) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// certificateSansRegex returns a regular expression that matches the
+// x-forwarded-client-cert header when the client certificate has any of
+// the given URI or DNS SANs. The gateway replaces the header with the
+// SANs of the certificate it verified, so the header has a single
+// element whose values are quoted when they have special characters.
+func certificateSansRegex(sans []string) string {
+	var quoted []string
+	for _, san := range sans {
+		quoted = append(quoted, regexp.QuoteMeta(san))
+	}
+
+	return fmt.Sprintf(`(?:.*;)?(?:URI|DNS)="?(?:%s)"?(?:;.*)?`, strings.Join(quoted, "|"))
+}
+
+// forwardedForRegex returns a regular expression that matches the
+// x-forwarded-for header when the client address is in any of the given
+// IPv4 CIDR blocks. Since the gateway appends the address of the last
+// trusted hop, the client address is followed by as many addresses as
+// there are trusted hops. IPv6 blocks are not matched, and the returned
+// string is empty when there is no IPv4 block.
+func forwardedForRegex(cidrs []string, trustedHops uint32) string {
+	var addresses []string
+	for _, ipNet := range parseCidrs(cidrs) {
+		if ipNet.IP.To4() == nil {
+			continue
+		}
+
+		addresses = append(addresses, ipv4BlockRegex(ipNet))
+	}
+
+	if len(addresses) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(`(?:.*,\s*)?(?:%s)(?:\s*,\s*[^,]*){%d}`, strings.Join(addresses, "|"), trustedHops)
+}
+
+// ipv4BlockRegex returns a regular expression that matches the decimal
+// addresses of the given IPv4 block.
+func ipv4BlockRegex(ipNet *net.IPNet) string {
+	ip := ipNet.IP.To4()
+	ones, _ := ipNet.Mask.Size()
+
+	var octets []string
+	for i := 0; i < net.IPv4len; i++ {
+		bits := ones - i*8
+
+		switch {
+		case bits >= 8:
+			octets = append(octets, strconv.Itoa(int(ip[i])))
+		case bits > 0:
+			var values []string
+			for v := int(ip[i]); v <= int(ip[i])|(0xff>>bits); v++ {
+				values = append(values, strconv.Itoa(v))
+			}
+			octets = append(octets, "(?:"+strings.Join(values, "|")+")")
+		default:
+			octets = append(octets, "[0-9]{1,3}")
+		}
+	}
+
+	return strings.Join(octets, `\.`)
+}
+
+// clientRouted returns whether the given entry is routed for the clients
+// of the given class. Behind trusted proxies, there are no classes, and
+// the entries that match source addresses are routed unless none of
+// their addresses can be matched.
+func clientRouted(info *GatewayResourceInfo, class *ClientClass, e route.Entry) bool {
+	if info.Listener.XffNumTrustedHops > 0 {
+		return e.Client == nil || len(e.Client.SourceCidrs) == 0 ||
+			forwardedForRegex(e.Client.SourceCidrs, info.Listener.XffNumTrustedHops) != ""
+	}
+
+	return class.Accepts(e)
+}
@@ -48,7 +48,7 @@ func (c *ClusterGenerator) GenerateHost(ctx xds_context.Context, info *GatewayRe
 	// an external service. Because the dataplane endpoints happen to be
 	// generated first, the mesh service will have priority.
 	for name, dest := range destinations {
-		matched := match.ExternalService(info.ExternalServices, mesh_proto.TagSelector(dest.Destination))
+		matched := externalServicesFor(info, &dest)
 		if len(matched.Items) > 0 {
 			if err := resources.Add(c.generateExternalCluster(ctx, info, matched, name, dest)); err != nil {
 				return nil, err
@@ -102,12 +102,11 @@ func (c *ClusterGenerator) generateMeshCluster(
 		Tags: dest.Destination,
 	}})
 
-	// HTTP is a better default than "unknown".
 	if protocol == core_mesh.ProtocolUnknown {
-		protocol = core_mesh.ProtocolHTTP
+		protocol = defaultClusterProtocol(info.Listener.Protocol)
 	}
 
-	builder := newClusterBuilder(info.Proxy.APIVersion, protocol, dest).Configure(
+	builder := newClusterBuilder(info.Proxy.APIVersion, ctx.Mesh.Resource, protocol, dest).Configure(
 		clusters.EdsCluster(name),
 		clusters.LB(nil /* TODO(jpeach) uses default Round Robin*/),
 		clusters.ClientSideMTLS(ctx, dest.Destination[mesh_proto.ServiceTag], true, []envoy.Tags{dest.Destination}),
@@ -147,21 +146,48 @@ func (c *ClusterGenerator) generateExternalCluster(
 
 	protocol := generator.InferServiceProtocol(endpoints)
 
-	// HTTP is a better default than "unknown".
 	if protocol == core_mesh.ProtocolUnknown {
-		protocol = core_mesh.ProtocolHTTP
+		protocol = defaultClusterProtocol(info.Listener.Protocol)
 	}
 
 	return BuildResourceSet(
-		newClusterBuilder(info.Proxy.APIVersion, protocol, dest).Configure(
+		newClusterBuilder(info.Proxy.APIVersion, ctx.Mesh.Resource, protocol, dest).Configure(
 			clusters.StrictDNSCluster(name, endpoints, info.Dataplane.IsIPv6()),
+			clusters.DnsLookupFamily(
+				ctx.Mesh.Resource.Spec.GetNetworking().GetOutbound().GetDnsLookupFamily(),
+				connectionPoolPolicyFor(&dest),
+			),
 			clusters.ClientSideTLS(endpoints),
 		),
 	)
 }
 
+// externalServicesFor returns the external services that the
+// destination forwards to. The destination is a mesh service when there
+// are none.
+func externalServicesFor(info *GatewayResourceInfo, dest *route.Destination) core_mesh.ExternalServiceResourceList {
+	return match.ExternalService(info.ExternalServices, mesh_proto.TagSelector(dest.Destination))
+}
+
+// defaultClusterProtocol returns the protocol of clusters whose services
+// don't specify one. TCP and TLS listeners proxy connections, so their
+// clusters must not expect HTTP. GRPC listeners forward gRPC requests,
+// which need HTTP/2. Otherwise, HTTP is a better default than "unknown".
+func defaultClusterProtocol(listener mesh_proto.Gateway_Listener_Protocol) core_mesh.Protocol {
+	switch listener {
+	case mesh_proto.Gateway_Listener_TCP,
+		mesh_proto.Gateway_Listener_TLS:
+		return core_mesh.ProtocolTCP
+	case mesh_proto.Gateway_Listener_GRPC:
+		return core_mesh.ProtocolGRPC
+	}
+
+	return core_mesh.ProtocolHTTP
+}
+
 func newClusterBuilder(
 	version envoy.APIVersion,
+	mesh *core_mesh.MeshResource,
 	protocol core_mesh.Protocol,
 	dest route.Destination,
 ) *clusters.ClusterBuilder {
@@ -183,6 +209,11 @@ func newClusterBuilder(
 	default:
 	}
 
+	builder.Configure(
+		clusters.TcpKeepalive(mesh.Spec.GetNetworking().GetOutbound().GetTcpKeepalive()),
+		clusters.ConnectionPool(protocol, connectionPoolPolicyFor(&dest)),
+	)
+
 	return builder
 }
 
@@ -193,7 +224,7 @@ func makeRouteDestinations(table *route.Table) (map[string]route.Destination, er
 	destinations := map[string]route.Destination{}
 
 	for _, e := range table.Entries {
-		if m := e.Mirror; m != nil {
+		for _, m := range e.Mirrors {
 			name, err := route.DestinationClusterName(m.Forward)
 			if err != nil {
 				return nil, err
@@ -223,6 +254,14 @@ func timeoutPolicyFor(dest *route.Destination) *core_mesh.TimeoutResource {
 	return nil // TODO(jpeach) default timeout policy
 }
 
+func retryPolicyFor(dest *route.Destination) *core_mesh.RetryResource {
+	if policy, ok := dest.Policies[core_mesh.RetryType]; ok {
+		return policy.(*core_mesh.RetryResource)
+	}
+
+	return nil
+}
+
 func circuitBreakerPolicyFor(dest *route.Destination) *core_mesh.CircuitBreakerResource {
 	if policy, ok := dest.Policies[core_mesh.CircuitBreakerType]; ok {
 		return policy.(*core_mesh.CircuitBreakerResource)
@@ -231,6 +270,14 @@ func circuitBreakerPolicyFor(dest *route.Destination) *core_mesh.CircuitBreakerR
 	return nil // TODO(jpeach) default breaker policy
 }
 
+func connectionPoolPolicyFor(dest *route.Destination) *core_mesh.ConnectionPoolResource {
+	if policy, ok := dest.Policies[core_mesh.ConnectionPoolType]; ok {
+		return policy.(*core_mesh.ConnectionPoolResource)
+	}
+
+	return nil
+}
+
 func healthCheckPolicyFor(dest *route.Destination) *core_mesh.HealthCheckResource {
 	if policy, ok := dest.Policies[core_mesh.HealthCheckType]; ok {
 		return policy.(*core_mesh.HealthCheckResource)
@@ -26,8 +26,8 @@ func (g *ConnectionPolicyGenerator) GenerateHost(ctx xds_context.Context, info *
 		for i, destination := range e.Action.Forward {
 			e.Action.Forward[i].Policies = mapPoliciesForDestination(destination.Destination, info)
 		}
-		if e.Mirror != nil {
-			e.Mirror.Forward.Policies = mapPoliciesForDestination(e.Mirror.Forward.Destination, info)
+		for i, mirror := range e.Mirrors {
+			e.Mirrors[i].Forward.Policies = mapPoliciesForDestination(mirror.Forward.Destination, info)
 		}
 	}
 
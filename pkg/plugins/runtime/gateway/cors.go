@@ -0,0 +1,67 @@
+package gateway
+
+import (
+	envoy_cors "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+)
+
+const corsFilterName = "envoy.filters.http.cors"
+
+// CorsFilter adds the CORS HTTP filter to the filter chain. The filter
+// has no effect on its own, it applies the CORS policies of the routes.
+func CorsFilter() envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) error {
+			config, err := util_proto.MarshalAnyDeterministic(&envoy_cors.Cors{})
+			if err != nil {
+				return err
+			}
+
+			hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+				Name: corsFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: config,
+				},
+			}}, hcm.HttpFilters...)
+
+			return nil
+		}),
+	)
+}
+
+// makeRouteCors converts the CORS filter of a rule to the CORS policy of
+// its route table entries.
+func makeRouteCors(conf *mesh_proto.GatewayRoute_HttpRoute_Filter_Cors) *route.Cors {
+	return &route.Cors{
+		AllowOrigins:     conf.GetAllowOrigins(),
+		AllowMethods:     conf.GetAllowMethods(),
+		AllowHeaders:     conf.GetAllowHeaders(),
+		ExposeHeaders:    conf.GetExposeHeaders(),
+		MaxAge:           conf.GetMaxAge().AsDuration(),
+		AllowCredentials: conf.GetAllowCredentials(),
+	}
+}
+
+// hostCors returns whether any of the GatewayRoutes of the given hosts
+// has a CORS filter.
+func hostCors(hosts []GatewayHost) bool {
+	for _, host := range hosts {
+		for _, gatewayRoute := range hostGatewayRoutes(host) {
+			for _, rule := range gatewayRoute.Spec.GetConf().GetHttp().GetRules() {
+				for _, f := range rule.GetFilters() {
+					if f.GetCors() != nil {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,115 @@
+package gateway
+
+import (
+	envoy_config_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_filter_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoy_http_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+)
+
+const faultFilterName = "envoy.filters.http.fault"
+
+// FaultFilter adds the fault HTTP filter to the filter chain. The
+// filter of the listener doesn't inject any fault, the faults are
+// configured by each route.
+func FaultFilter() envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) error {
+			config, err := util_proto.MarshalAnyDeterministic(&envoy_http_fault.HTTPFault{})
+			if err != nil {
+				return err
+			}
+
+			hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+				Name: faultFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: config,
+				},
+			}}, hcm.HttpFilters...)
+
+			return nil
+		}),
+	)
+}
+
+// RouteFault injects the faults of the route.
+func RouteFault(fault *route.Fault) route.RouteConfigurer {
+	if fault == nil {
+		return route.RouteConfigureFunc(nil)
+	}
+
+	return route.RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		httpFault := &envoy_http_fault.HTTPFault{}
+
+		if d := fault.Delay; d != nil {
+			httpFault.Delay = &envoy_filter_fault.FaultDelay{
+				FaultDelaySecifier: &envoy_filter_fault.FaultDelay_FixedDelay{
+					FixedDelay: util_proto.Duration(d.Duration),
+				},
+				Percentage: listeners_v3.ConvertPercentage(util_proto.Double(d.Percentage)),
+			}
+		}
+
+		if a := fault.Abort; a != nil {
+			httpFault.Abort = &envoy_http_fault.FaultAbort{
+				ErrorType: &envoy_http_fault.FaultAbort_HttpStatus{
+					HttpStatus: a.Status,
+				},
+				Percentage: listeners_v3.ConvertPercentage(util_proto.Double(a.Percentage)),
+			}
+		}
+
+		if b := fault.ResponseBandwidth; b != nil {
+			limitKbps, err := listeners_v3.ConvertBandwidthToKbps(b.Limit)
+			if err != nil {
+				return err
+			}
+
+			httpFault.ResponseRateLimit = &envoy_filter_fault.FaultRateLimit{
+				LimitType: &envoy_filter_fault.FaultRateLimit_FixedLimit_{
+					FixedLimit: &envoy_filter_fault.FaultRateLimit_FixedLimit{
+						LimitKbps: limitKbps,
+					},
+				},
+				Percentage: listeners_v3.ConvertPercentage(util_proto.Double(b.Percentage)),
+			}
+		}
+
+		config, err := util_proto.MarshalAnyDeterministic(httpFault)
+		if err != nil {
+			return err
+		}
+
+		if r.TypedPerFilterConfig == nil {
+			r.TypedPerFilterConfig = map[string]*anypb.Any{}
+		}
+
+		r.TypedPerFilterConfig[faultFilterName] = config
+
+		return nil
+	})
+}
+
+// hostFaulted returns true if any of the GatewayRoutes of the given
+// hosts has a fault injection filter.
+func hostFaulted(hosts []GatewayHost) bool {
+	for _, host := range hosts {
+		for _, gatewayRoute := range hostGatewayRoutes(host) {
+			for _, rule := range gatewayRoute.Spec.GetConf().GetHttp().GetRules() {
+				for _, f := range rule.GetFilters() {
+					if f.GetFaultInjection() != nil {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -1,6 +1,8 @@
 package gateway
 
 import (
+	"regexp"
+	"sort"
 	"strings"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
@@ -12,6 +14,10 @@ import (
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 )
 
+// defaultStickyCookie is the name of the cookie that records the backend
+// of sticky rules.
+const defaultStickyCookie = "kuma-canary"
+
 func filterGatewayRoutes(in []model.Resource, accept func(resource *core_mesh.GatewayRouteResource) bool) []*core_mesh.GatewayRouteResource {
 	routes := make([]*core_mesh.GatewayRouteResource, 0, len(in))
 
@@ -26,30 +32,43 @@ func filterGatewayRoutes(in []model.Resource, accept func(resource *core_mesh.Ga
 	return routes
 }
 
-// GatewayRouteGenerator generates Kuma gateway routes from GatewayRoute resources.
-type GatewayRouteGenerator struct {
-}
-
-func (*GatewayRouteGenerator) SupportsProtocol(p mesh_proto.Gateway_Listener_Protocol) bool {
-	return p == mesh_proto.Gateway_Listener_HTTP || p == mesh_proto.Gateway_Listener_HTTPS
-}
-
-func (g *GatewayRouteGenerator) GenerateHost(ctx xds_context.Context, info *GatewayResourceInfo) (*core_xds.ResourceSet, error) {
-	gatewayRoutes := filterGatewayRoutes(info.Host.Routes, func(route *core_mesh.GatewayRouteResource) bool {
+// hostGatewayRoutes returns the GatewayRoute resources that are applied
+// to the virtual host.
+func hostGatewayRoutes(host GatewayHost) []*core_mesh.GatewayRouteResource {
+	return filterGatewayRoutes(host.Routes, func(route *core_mesh.GatewayRouteResource) bool {
 		// Wildcard virtual host accepts all routes.
-		if info.Host.Hostname == WildcardHostname {
+		if host.Hostname == WildcardHostname {
 			return true
 		}
 
 		// If the route has no hostnames, it matches all virtualhosts.
-		names := route.Spec.GetConf().GetHttp().GetHostnames()
+		names := routeHostnames(route.Spec.GetConf())
 		if len(names) == 0 {
 			return true
 		}
 
 		// Otherwise, match the virtualhost name to the route names.
-		return match.Hostnames(info.Host.Hostname, names...)
+		return match.Hostnames(host.Hostname, names...)
 	})
+}
+
+// GatewayRouteGenerator generates Kuma gateway routes from GatewayRoute resources.
+type GatewayRouteGenerator struct {
+}
+
+func (*GatewayRouteGenerator) SupportsProtocol(p mesh_proto.Gateway_Listener_Protocol) bool {
+	switch p {
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS,
+		mesh_proto.Gateway_Listener_GRPC:
+		return true
+	default:
+		return false
+	}
+}
+
+func (g *GatewayRouteGenerator) GenerateHost(ctx xds_context.Context, info *GatewayResourceInfo) (*core_xds.ResourceSet, error) {
+	gatewayRoutes := hostGatewayRoutes(info.Host)
 
 	if len(gatewayRoutes) == 0 {
 		return nil, nil
@@ -60,12 +79,49 @@ func (g *GatewayRouteGenerator) GenerateHost(ctx xds_context.Context, info *Gate
 		"listener-name", info.Listener.ResourceName,
 	)
 
+	sampling := info.Proxy.Policies.TracingSampling()
+
+	info.RouteTable.Entries = append(info.RouteTable.Entries,
+		makeRouteTableEntries(gatewayRoutes, sampling, info.Listener.Paths)...)
+
+	return nil, nil
+}
+
+// makeRouteTableEntries builds the route table entries of the given
+// GatewayRoutes. The entries are in a deterministic order, but they
+// still need to be sorted by specificity. The path options of the
+// listener select how the prefixes are matched.
+func makeRouteTableEntries(
+	gatewayRoutes []*core_mesh.GatewayRouteResource,
+	sampling *mesh_proto.TrafficTrace_Conf_Sampling,
+	paths *mesh_proto.Gateway_Listener_Paths,
+) []route.Entry {
+	var entries []route.Entry
+
 	exactEntries := map[string]route.Entry{}
 	prefixEntries := map[string]route.Entry{}
+	bytePrefixEntries := map[string]route.Entry{}
+
+	// When several rules match the same path for the same clients,
+	// keep the one with the highest priority, or the last one if they
+	// are equal.
+	addEntry := func(entries map[string]route.Entry, path string, e route.Entry) {
+		key := entryKey(path, e)
+		if existing, ok := entries[key]; ok && existing.Priority > e.Priority {
+			return
+		}
+		entries[key] = e
+	}
+
+	for _, gatewayRoute := range gatewayRoutes {
+		tracing := makeRouteTracing(sampling, gatewayRoute.Meta.GetName())
 
-	for _, route := range gatewayRoutes {
-		for _, rule := range route.Spec.GetConf().GetHttp().GetRules() {
+		for _, rule := range gatewayRoute.Spec.GetConf().GetHttp().GetRules() {
 			entry := makeRouteEntry(rule)
+			entry.Route = gatewayRoute.Meta.GetName()
+			entry.Priority = rule.GetPriority()
+			entry.Upgrades = ruleUpgrades(rule)
+			entry.Tracing = tracing
 
 			// The rule matches if any of the matches is successful (it has OR
 			// semantics). That means that we have to duplicate the route table
@@ -73,15 +129,31 @@ func (g *GatewayRouteGenerator) GenerateHost(ctx xds_context.Context, info *Gate
 			// the criteria.
 			for _, m := range rule.GetMatches() {
 				routeEntry := entry // Shallow copy.
-				routeEntry.Match = makeRouteMatch(m)
+				routeEntry.Match = makeRouteMatch(m, paths)
+				routeEntry.Client = makeRouteClient(m.GetClient())
+
+				// CONNECT requests don't have a path or a
+				// regular method, so they need an entry that
+				// matches their headers only.
+				if rule.GetUpgrades().GetConnect() {
+					connectEntry := routeEntry
+					connectEntry.Match.ExactPath = ""
+					connectEntry.Match.PrefixPath = ""
+					connectEntry.Match.RegexPath = ""
+					connectEntry.Match.Method = ""
+					connectEntry.Match.Connect = true
+					entries = append(entries, connectEntry)
+				}
 
 				switch {
 				case routeEntry.Match.ExactPath != "":
-					exactEntries[routeEntry.Match.ExactPath] = routeEntry
+					addEntry(exactEntries, routeEntry.Match.ExactPath, routeEntry)
+				case routeEntry.Match.PrefixPath != "" && routeEntry.Match.BytePrefix:
+					addEntry(bytePrefixEntries, routeEntry.Match.PrefixPath, routeEntry)
 				case routeEntry.Match.PrefixPath != "":
-					prefixEntries[routeEntry.Match.PrefixPath] = routeEntry
+					addEntry(prefixEntries, routeEntry.Match.PrefixPath, routeEntry)
 				default:
-					info.RouteTable.Entries = append(info.RouteTable.Entries, routeEntry)
+					entries = append(entries, routeEntry)
 				}
 			}
 		}
@@ -93,13 +165,14 @@ func (g *GatewayRouteGenerator) GenerateHost(ctx xds_context.Context, info *Gate
 	// transformations. Unless there is already an exact match for the
 	// path in question, we expand each prefix path to both a prefix and
 	// an exact path, duplicating the route.
-	for _, prefixEntry := range prefixEntries {
+	for _, prefix := range sortedEntryPaths(prefixEntries) {
+		prefixEntry := prefixEntries[prefix]
 		exact := strings.TrimRight(prefixEntry.Match.PrefixPath, "/")
 
 		// Make sure the prefix has a trailing '/' so that it only matches
 		// complete path components.
 		prefixEntry.Match.PrefixPath = exact + "/"
-		info.RouteTable.Entries = append(info.RouteTable.Entries, prefixEntry)
+		entries = append(entries, prefixEntry)
 
 		// If the prefix is '/', it matches everything anyway,
 		// so we don't need to install an exact match.
@@ -109,19 +182,41 @@ func (g *GatewayRouteGenerator) GenerateHost(ctx xds_context.Context, info *Gate
 
 		// Duplicate the route to an exact match only if there
 		// isn't already an exact match for this path.
-		if _, ok := exactEntries[exact]; !ok {
+		if _, ok := exactEntries[entryKey(exact, prefixEntry)]; !ok {
 			exactMatch := prefixEntry
 			exactMatch.Match.PrefixPath = ""
 			exactMatch.Match.ExactPath = exact
-			exactEntries[exact] = exactMatch
+			exactEntries[entryKey(exact, exactMatch)] = exactMatch
 		}
 	}
 
-	for _, e := range exactEntries {
-		info.RouteTable.Entries = append(info.RouteTable.Entries, e)
+	// Byte-wise prefixes are matched as they are by Envoy.
+	for _, prefix := range sortedEntryPaths(bytePrefixEntries) {
+		entries = append(entries, bytePrefixEntries[prefix])
 	}
 
-	return nil, nil
+	for _, exact := range sortedEntryPaths(exactEntries) {
+		entries = append(entries, exactEntries[exact])
+	}
+
+	return entries
+}
+
+// entryKey returns the key of an entry for the given path. Rules that
+// match different clients on the same path get different entries.
+func entryKey(path string, e route.Entry) string {
+	return path + "\x00" + clientKey(e.Client)
+}
+
+func sortedEntryPaths(entries map[string]route.Entry) []string {
+	paths := make([]string, 0, len(entries))
+	for p := range entries {
+		paths = append(paths, p)
+	}
+
+	sort.Strings(paths)
+
+	return paths
 }
 
 func makeRouteEntry(rule *mesh_proto.GatewayRoute_HttpRoute_Rule) route.Entry {
@@ -137,6 +232,24 @@ func makeRouteEntry(rule *mesh_proto.GatewayRoute_HttpRoute_Rule) route.Entry {
 		entry.Action.Forward = append(entry.Action.Forward, target)
 	}
 
+	if sticky := rule.GetSticky(); sticky != nil && len(rule.GetBackends()) > 1 {
+		entry.Sticky = &route.Sticky{
+			Cookie: sticky.GetCookie(),
+			Ttl:    sticky.GetTtl().AsDuration(),
+		}
+
+		if entry.Sticky.Cookie == "" {
+			entry.Sticky.Cookie = defaultStickyCookie
+		}
+	}
+
+	if jwt := rule.GetJwt(); jwt != nil {
+		entry.Jwt = &route.Jwt{
+			Disabled:  jwt.GetDisabled(),
+			Providers: jwt.GetProviders(),
+		}
+	}
+
 	for _, f := range rule.GetFilters() {
 		if r := f.GetRedirect(); r != nil {
 			entry.Action.Redirect = &route.Redirection{
@@ -146,13 +259,18 @@ func makeRouteEntry(rule *mesh_proto.GatewayRoute_HttpRoute_Rule) route.Entry {
 				Port:       r.GetPort(),
 				StripQuery: true,
 			}
+		} else if r := f.GetDirectResponse(); r != nil {
+			entry.Action.Respond = &route.Response{
+				Status: r.GetStatusCode(),
+				Body:   r.GetBody(),
+			}
 		} else if m := f.GetMirror(); m != nil {
-			entry.Mirror = &route.Mirror{
+			entry.Mirrors = append(entry.Mirrors, route.Mirror{
 				Percentage: m.GetPercentage().GetValue(),
 				Forward: route.Destination{
 					Destination: m.Backend.GetDestination(),
 				},
-			}
+			})
 		} else if h := f.GetRequestHeader(); h != nil {
 			if entry.RequestHeaders == nil {
 				entry.RequestHeaders = &route.Headers{}
@@ -170,13 +288,102 @@ func makeRouteEntry(rule *mesh_proto.GatewayRoute_HttpRoute_Rule) route.Entry {
 
 			entry.RequestHeaders.Delete = append(
 				entry.RequestHeaders.Delete, h.GetRemove()...)
+		} else if h := f.GetResponseHeader(); h != nil {
+			if entry.ResponseHeaders == nil {
+				entry.ResponseHeaders = &route.Headers{}
+			}
+
+			for _, s := range h.GetSet() {
+				entry.ResponseHeaders.Replace = append(
+					entry.ResponseHeaders.Replace, route.Pair(s.GetName(), s.GetValue()))
+			}
+
+			for _, s := range h.GetAdd() {
+				entry.ResponseHeaders.Append = append(
+					entry.ResponseHeaders.Append, route.Pair(s.GetName(), s.GetValue()))
+			}
+
+			entry.ResponseHeaders.Delete = append(
+				entry.ResponseHeaders.Delete, h.GetRemove()...)
+		} else if c := f.GetCache(); c != nil {
+			if entry.ResponseHeaders == nil {
+				entry.ResponseHeaders = &route.Headers{}
+			}
+
+			entry.ResponseHeaders.Replace = append(
+				entry.ResponseHeaders.Replace, route.Pair("Cache-Control", CacheControl(c.GetTtl().AsDuration())))
+		} else if c := f.GetCors(); c != nil {
+			entry.Cors = makeRouteCors(c)
+		} else if b := f.GetBuffer(); b != nil {
+			entry.Buffer = &route.Buffer{
+				MaxRequestBytes: b.GetMaxRequestBytes(),
+			}
+		} else if fi := f.GetFaultInjection(); fi != nil {
+			entry.Fault = makeRouteFault(fi)
+		} else if r := f.GetRewrite(); r != nil {
+			entry.Rewrite = &route.Rewrite{
+				ReplaceFullPath:    r.GetReplaceFullPath(),
+				ReplacePrefixMatch: r.GetReplacePrefixMatch(),
+				Host:               r.GetHostname(),
+			}
+
+			if re := r.GetReplaceRegex(); re != nil {
+				entry.Rewrite.ReplaceRegex = &route.RegexReplace{
+					Pattern:      re.GetPattern(),
+					Substitution: re.GetSubstitution(),
+				}
+			}
 		}
 	}
 
 	return entry
 }
 
-func makeRouteMatch(ruleMatch *mesh_proto.GatewayRoute_HttpRoute_Match) route.Match {
+// makeRouteFault converts the faults of a fault injection filter.
+func makeRouteFault(conf *mesh_proto.FaultInjection_Conf) *route.Fault {
+	fault := &route.Fault{}
+
+	if d := conf.GetDelay(); d != nil {
+		fault.Delay = &route.FaultDelay{
+			Percentage: d.GetPercentage().GetValue(),
+			Duration:   d.GetValue().AsDuration(),
+		}
+	}
+
+	if a := conf.GetAbort(); a != nil {
+		fault.Abort = &route.FaultAbort{
+			Percentage: a.GetPercentage().GetValue(),
+			Status:     a.GetHttpStatus().GetValue(),
+		}
+	}
+
+	if b := conf.GetResponseBandwidth(); b != nil {
+		fault.ResponseBandwidth = &route.FaultBandwidth{
+			Percentage: b.GetPercentage().GetValue(),
+			Limit:      b.GetLimit().GetValue(),
+		}
+	}
+
+	return fault
+}
+
+// makeRouteTracing returns the sampling of the traffic routed by the
+// gateway route, if the TrafficTrace overrides it.
+func makeRouteTracing(sampling *mesh_proto.TrafficTrace_Conf_Sampling, name string) *route.Tracing {
+	percentage := sampling.RoutePercentage(name)
+	if percentage == nil {
+		return nil
+	}
+
+	return &route.Tracing{
+		Percentage: percentage.GetValue(),
+	}
+}
+
+func makeRouteMatch(
+	ruleMatch *mesh_proto.GatewayRoute_HttpRoute_Match,
+	paths *mesh_proto.Gateway_Listener_Paths,
+) route.Match {
 	match := route.Match{}
 
 	if p := ruleMatch.GetPath(); p != nil {
@@ -185,6 +392,13 @@ func makeRouteMatch(ruleMatch *mesh_proto.GatewayRoute_HttpRoute_Match) route.Ma
 			match.ExactPath = p.GetValue()
 		case mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX:
 			match.PrefixPath = p.GetValue()
+
+			switch p.GetPrefixMatch() {
+			case mesh_proto.GatewayRoute_HttpRoute_Match_Path_BYTES:
+				match.BytePrefix = true
+			case mesh_proto.GatewayRoute_HttpRoute_Match_Path_LISTENER:
+				match.BytePrefix = paths.GetPrefixMatch() == mesh_proto.Gateway_Listener_Paths_BYTES
+			}
 		case mesh_proto.GatewayRoute_HttpRoute_Match_Path_REGEX:
 			match.RegexPath = p.GetValue()
 		}
@@ -207,14 +421,7 @@ func makeRouteMatch(ruleMatch *mesh_proto.GatewayRoute_HttpRoute_Match) route.Ma
 	}
 
 	for _, h := range ruleMatch.GetHeaders() {
-		switch h.GetMatch() {
-		case mesh_proto.GatewayRoute_HttpRoute_Match_Header_EXACT:
-			match.ExactHeader = append(
-				match.ExactHeader, route.Pair(h.GetName(), h.GetValue()))
-		case mesh_proto.GatewayRoute_HttpRoute_Match_Header_REGEX:
-			match.RegexHeader = append(
-				match.RegexHeader, route.Pair(h.GetName(), h.GetValue()))
-		}
+		appendHeaderMatch(&match, h)
 	}
 
 	for _, q := range ruleMatch.GetQueryParameters() {
@@ -225,8 +432,64 @@ func makeRouteMatch(ruleMatch *mesh_proto.GatewayRoute_HttpRoute_Match) route.Ma
 		case mesh_proto.GatewayRoute_HttpRoute_Match_Query_REGEX:
 			match.RegexQuery = append(
 				match.RegexQuery, route.Pair(q.GetName(), q.GetValue()))
+		case mesh_proto.GatewayRoute_HttpRoute_Match_Query_PRESENT:
+			match.PresentQuery = append(match.PresentQuery, q.GetName())
+		case mesh_proto.GatewayRoute_HttpRoute_Match_Query_ABSENT:
+			match.AbsentQuery = append(match.AbsentQuery, q.GetName())
+		}
+	}
+
+	// A gRPC call is a HTTP/2 request with a gRPC content type to
+	// the "/<service>/<method>" path. gRPC metadata is sent in HTTP
+	// headers.
+	if g := ruleMatch.GetGrpc(); g != nil {
+		switch {
+		case g.GetMethod() != "":
+			match.ExactPath = "/" + g.GetService() + "/" + g.GetMethod()
+		case g.GetService() != "":
+			match.RegexPath = "/" + regexp.QuoteMeta(g.GetService()) + "/[^/]+"
+		}
+
+		match.RegexHeader = append(
+			match.RegexHeader, route.Pair("content-type", `application/grpc([+;].*)?`))
+
+		for _, m := range g.GetMetadata() {
+			appendHeaderMatch(&match, m)
 		}
 	}
 
 	return match
 }
+
+// makeRouteClient converts the client match of a rule. The source
+// addresses select the filter chain of the routes, unless the listener
+// is behind trusted proxies, and the SANs are matched in the client
+// certificate header (see makeRouteBuilder).
+func makeRouteClient(c *mesh_proto.GatewayRoute_HttpRoute_Match_Client) *route.Client {
+	if c == nil {
+		return nil
+	}
+
+	return &route.Client{
+		SourceCidrs:     c.GetSourceCidrs(),
+		CertificateSans: c.GetCertificateSans(),
+	}
+}
+
+func appendHeaderMatch(match *route.Match, h *mesh_proto.GatewayRoute_HttpRoute_Match_Header) {
+	switch h.GetMatch() {
+	case mesh_proto.GatewayRoute_HttpRoute_Match_Header_EXACT:
+		match.ExactHeader = append(
+			match.ExactHeader, route.Pair(h.GetName(), h.GetValue()))
+	case mesh_proto.GatewayRoute_HttpRoute_Match_Header_REGEX:
+		match.RegexHeader = append(
+			match.RegexHeader, route.Pair(h.GetName(), h.GetValue()))
+	case mesh_proto.GatewayRoute_HttpRoute_Match_Header_PREFIX:
+		match.PrefixHeader = append(
+			match.PrefixHeader, route.Pair(h.GetName(), h.GetValue()))
+	case mesh_proto.GatewayRoute_HttpRoute_Match_Header_PRESENT:
+		match.PresentHeader = append(match.PresentHeader, h.GetName())
+	case mesh_proto.GatewayRoute_HttpRoute_Match_Header_ABSENT:
+		match.AbsentHeader = append(match.AbsentHeader, h.GetName())
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"path"
 
 	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
-	"github.com/ghodss/yaml"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -12,6 +11,7 @@ import (
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/test/matchers"
+	test_xds "github.com/kumahq/kuma/pkg/test/xds"
 	xds_server "github.com/kumahq/kuma/pkg/xds/server/v3"
 )
 
@@ -21,7 +21,7 @@ var _ = Describe("Gateway Gateway Route", func() {
 
 	Do := func() (cache.Snapshot, error) {
 		serverCtx := xds_server.NewXdsContext()
-		reconciler := xds_server.DefaultReconciler(rt, serverCtx)
+		reconciler := xds_server.DefaultReconciler(rt, serverCtx, nil, nil)
 
 		// We expect there to be a Dataplane fixture named
 		// "default" in the current mesh.
@@ -81,8 +81,8 @@ var _ = Describe("Gateway Gateway Route", func() {
 			Expect(err).To(Succeed())
 
 			// then
-			Expect(yaml.Marshal(MakeProtoSnapshot(snap))).
-				To(matchers.MatchGoldenYAML(path.Join("testdata", goldenFileName)))
+			Expect(test_xds.SnapshotYAML(snap)).
+				To(matchers.MatchGoldenYAMLDiff(path.Join("testdata", goldenFileName)))
 
 		},
 		// When we have a route with multiple hostnames that is
@@ -866,6 +866,1079 @@ conf:
       backends:
       - destination:
           kuma.io/service: external-httpbin
+`,
+		),
+
+		// The clients of the overlapping blocks get the routes of
+		// both rules, and the other clients fall through to the
+		// rule without a client match.
+		Entry("should create a client route match",
+			"20-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /partner
+        client:
+          source_cidrs:
+          - 10.0.0.0/8
+          - 192.168.4.0/22
+          certificate_sans:
+          - spiffe://example.com/partner
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: EXACT
+          value: /partner
+        client:
+          source_cidrs:
+          - 10.1.0.0/16
+      backends:
+      - destination:
+          kuma.io/service: api-service
+    - matches:
+      - path:
+          match: EXACT
+          value: /partner
+      backends:
+      - destination:
+          kuma.io/service: exact-service
+`,
+		),
+
+		Entry("should create a gRPC route match",
+			"21-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - grpc:
+          service: helloworld.Greeter
+          method: SayHello
+      - grpc:
+          service: helloworld.Greeter
+          metadata:
+          - match: EXACT
+            name: x-tenant
+            value: kong
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		// With explicit routes, the route without hostnames
+		// is not applied to the wildcard virtual host, and
+		// unmatched requests are denied on all virtual hosts.
+		Entry("should deny requests that are not explicitly routed",
+			"22-gateway-route.yaml", `
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  explicitRoutes:
+    status: 403
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service-extra
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    hostnames:
+    - extra.example.com
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should rewrite the path and the host",
+			"23-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+      filters:
+      - rewrite:
+          replace_prefix_match: /v2
+          hostname: backend.example.com
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: EXACT
+          value: /health
+      filters:
+      - rewrite:
+          replace_full_path: /ready
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should modify response headers",
+			"24-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /api
+      filters:
+      - response_header:
+          set:
+          - name: cache-control
+            value: no-store
+          add:
+          - name: x-served-by
+            value: gateway
+          remove:
+          - server
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should proxy TCP connections to weighted backends",
+			"25-gateway-route.yaml", `
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 9000
+    protocol: TCP
+    tags:
+      port: tcp/9000
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tcp:
+    rules:
+    - backends:
+      - weight: 80
+        destination:
+          kuma.io/service: echo-service
+      - weight: 20
+        destination:
+          kuma.io/service: api-service
+`,
+		),
+
+		Entry("should pass TLS connections through by SNI name",
+			"26-gateway-route.yaml", `
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 9443
+    protocol: TLS
+    tls:
+      mode: PASSTHROUGH
+    tags:
+      port: tls/9443
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tls:
+    hostnames:
+    - echo.example.com
+    rules:
+    - backends:
+      - weight: 1
+        destination:
+          kuma.io/service: echo-service
+`, `
+type: GatewayRoute
+mesh: default
+name: api-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  tls:
+    rules:
+    - backends:
+      - weight: 1
+        destination:
+          kuma.io/service: api-service
+`,
+		),
+
+		Entry("match retry policy",
+			"27-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /api
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`, `
+type: Retry
+mesh: default
+name: echo-service
+sources:
+- match:
+    kuma.io/service: gateway-default
+destinations:
+- match:
+    kuma.io/service: echo-service
+conf:
+  http:
+    numRetries: 5
+    perTryTimeout: 2s
+    backOff:
+      baseInterval: 1s
+      maxInterval: 10s
+    retriableStatusCodes:
+    - 500
+    - 504
+`,
+		),
+
+		Entry("should route gRPC requests on a GRPC listener",
+			"28-gateway-route.yaml", `
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 9090
+    protocol: GRPC
+    tags:
+      port: grpc/9090
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - grpc:
+          service: helloworld.Greeter
+          method: SayHello
+      - grpc:
+          service: helloworld.Greeter
+          metadata:
+          - match: EXACT
+            name: x-tenant
+            value: kong
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should match header and query presence",
+			"29-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+        headers:
+        - match: PREFIX
+          name: x-version
+          value: v2.
+        - match: PRESENT
+          name: x-debug
+        - match: ABSENT
+          name: x-canary
+        query_parameters:
+        - match: PRESENT
+          name: page
+        - match: ABSENT
+          name: debug
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should respond directly with a static body",
+			"30-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /robots.txt
+      filters:
+      - direct_response:
+          status_code: 200
+          body: |
+            User-agent: *
+            Disallow: /
+      - response_header:
+          set:
+          - name: content-type
+            value: text/plain
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      filters:
+      - direct_response:
+          status_code: 503
+          body: down for maintenance
+`,
+		),
+
+		Entry("should mirror to multiple backends",
+			"31-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /
+      filters:
+      - mirror:
+          percentage: 50
+          backend:
+            destination:
+              kuma.io/service: echo-mirror
+      - mirror:
+          percentage: 0.25
+          backend:
+            destination:
+              kuma.io/service: echo-exact
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should rate limit requests on a listener",
+			"32-gateway-route.yaml", `
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    rateLimit:
+      requests: 100
+      interval: 1s
+      key:
+        header: x-api-key
+      limits:
+      - value: premium
+        requests: 1000
+        interval: 1s
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should protect a listener from CSRF and add security headers",
+			"33-gateway-route.yaml", `
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    security:
+      csrf:
+        additionalOrigins:
+        - app.example.com
+      headers:
+        frameOptions: SAMEORIGIN
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should cache responses on a listener",
+			"34-gateway-route.yaml", `
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    cache:
+      key:
+        queryParameters:
+        - page
+        headers:
+        - accept-language
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /catalog
+      filters:
+      - cache:
+          ttl: 5m
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should enable HTTP upgrades on routes",
+			"35-gateway-route.yaml", `
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /ws
+      upgrades:
+        websocket: true
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      upgrades:
+        connect: true
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should rewrite the Host header for external service backends",
+			"36-gateway-route.yaml", `
+type: ExternalService
+mesh: default
+name: external-api
+tags:
+  kuma.io/service: external-api
+  kuma.io/protocol: http
+networking:
+  address: api.example.com:443
+  tls:
+    enabled: true
+    serverName: internal.example.com
+    caCert:
+      inlineString: ca-certificate
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /v2/status
+      filters:
+      - request_header:
+          set:
+          - name: Host
+            value: v2.api.example.com
+      backends:
+      - destination:
+          kuma.io/service: external-api
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: external-api
+`,
+		),
+
+		Entry("should inspect requests with a WAF",
+			"37-gateway-route.yaml", `
+type: WafRuleSet
+mesh: default
+name: owasp
+conf:
+  core_rule_set:
+    paranoia_level: 2
+    excluded_rules:
+    - 920350
+  directives:
+  - SecRule REQUEST_HEADERS:User-Agent "@contains badbot" "id:1001,phase:1,deny,status:403"
+`, `
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    waf:
+      rule_set: owasp
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /upload
+      waf:
+        excluded_rules:
+        - 920420
+        - 921110
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: EXACT
+          value: /healthz
+      waf:
+        disabled: true
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should keep clients on their canary backend",
+			"38-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      sticky:
+        ttl: 1h
+      backends:
+      - weight: 90
+        destination:
+          kuma.io/service: echo-service
+      - weight: 10
+        destination:
+          kuma.io/service: echo-exact
+`,
+		),
+
+		Entry("should apply CORS policies to routes",
+			"39-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+      filters:
+      - cors:
+          allow_origins:
+          - https://app.example.com
+          allow_methods:
+          - GET
+          - POST
+          allow_headers:
+          - Content-Type
+          - Authorization
+          expose_headers:
+          - X-Request-Id
+          max_age: 10m
+          allow_credentials: true
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should authenticate requests with JWTs",
+			"40-gateway-route.yaml", `
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    jwt:
+      providers:
+      - name: auth0
+        issuer: https://example.auth0.com/
+        audiences:
+        - echo
+        remote_jwks:
+          uri: https://example.auth0.com/.well-known/jwks.json
+        claim_to_headers:
+        - claim: sub
+          header: x-user-id
+      - name: internal
+        issuer: internal.example.com
+        local_jwks:
+          inlineString: '{"keys":[]}'
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /healthz
+      jwt:
+        disabled: true
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: PREFIX
+          value: /admin
+      jwt:
+        providers:
+        - internal
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should buffer requests of routes with a buffer filter",
+			"41-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /upload
+      filters:
+      - buffer:
+          max_request_bytes: 65536
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should rewrite the path with a regex",
+			"42-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /service
+      filters:
+      - rewrite:
+          replace_regex:
+            pattern: ^/service/([^/]+)(/.*)$
+            substitution: \2/instance/\1
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should inject the faults of routes with a fault injection filter",
+			"43-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /chaos
+      filters:
+      - fault_injection:
+          delay:
+            percentage: 50
+            value: 2s
+          abort:
+            percentage: 10
+            httpStatus: 503
+          responseBandwidth:
+            percentage: 100
+            limit: 100kbps
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		Entry("should match and rewrite byte-wise prefixes",
+			"44-gateway-route.yaml", `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+          prefix_match: BYTES
+      filters:
+      - rewrite:
+          replace_prefix_match: /v2
+          hostname: backend.example.com
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+
+		// Behind trusted proxies, the client addresses are matched
+		// in the forwarded header, so there is a single filter chain.
+		Entry("should match client addresses forwarded by trusted proxies",
+			"45-gateway-route.yaml", `
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+    xff_num_trusted_hops: 2
+`, `
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: EXACT
+          value: /partner
+        client:
+          source_cidrs:
+          - 10.0.0.0/8
+          - 192.168.4.0/22
+          - fd00::/8
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+    - matches:
+      - path:
+          match: EXACT
+          value: /internal
+        client:
+          source_cidrs:
+          - fd00::/8
+      backends:
+      - destination:
+          kuma.io/service: echo-service
 `,
 		),
 	)
@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"sort"
 
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/match"
 	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/merge"
@@ -20,9 +23,12 @@ import (
 	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
 	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
 	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes"
+	envoy_secrets "github.com/kumahq/kuma/pkg/xds/envoy/secrets/v3"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+	"github.com/kumahq/kuma/pkg/xds/secrets"
 )
 
-const WildcardHostname = "*"
+const WildcardHostname = match.WildcardHostname
 
 // RoutePolicyTypes specifies the resource types the gateway will bind
 // for routes.
@@ -34,6 +40,7 @@ var RoutePolicyTypes = []model.ResourceType{
 // bind for connection policies.
 var ConnectionPolicyTypes = []model.ResourceType{
 	core_mesh.CircuitBreakerType,
+	core_mesh.ConnectionPoolType,
 	core_mesh.FaultInjectionType,
 	core_mesh.HealthCheckType,
 	core_mesh.RateLimitType,
@@ -46,7 +53,41 @@ type GatewayHost struct {
 	Routes   []model.Resource
 	Policies map[model.ResourceType][]match.RankedPolicy
 
-	// TODO(jpeach) Track TLS state for this host.
+	// TLS is the TLS configuration of the listener the host comes
+	// from. It is nil for hosts that are created from route hostnames,
+	// TLS for those is handled by the listener that matches them.
+	TLS *mesh_proto.Gateway_TLS_Conf
+
+	// Certificates are the certificates of the listener the host comes
+	// from, that are selected by SNI. A listener with several hostnames
+	// gives them to its first host only, so that their filter chains
+	// are generated once.
+	Certificates []*mesh_proto.Gateway_TLS_Certificate
+
+	// RateLimit is the rate limit of the listener the host comes
+	// from. Hosts that are created from route hostnames inherit the
+	// rate limit of the wildcard listener.
+	RateLimit *mesh_proto.Gateway_Listener_RateLimit
+
+	// Security is the browser security configuration of the listener
+	// the host comes from. Like the rate limit, it is inherited from
+	// the wildcard listener.
+	Security *mesh_proto.Gateway_Listener_Security
+
+	// Gateway is the name of the Gateway whose listener the host
+	// comes from. Like the rate limit, it is inherited from the
+	// wildcard listener.
+	Gateway string
+}
+
+// GatewayListenerHosts is a listener with the hosts that are bound to it.
+type GatewayListenerHosts struct {
+	// Gateway is the Gateway that the port of the listener is bound
+	// to. The hosts can also come from other Gateways that are merged
+	// on the port.
+	Gateway  *core_mesh.GatewayResource
+	Listener GatewayListener
+	Hosts    []GatewayHost
 }
 
 // Resources tracks partially-built xDS resources that can be updated
@@ -54,12 +95,78 @@ type GatewayHost struct {
 type Resources struct {
 	Listener           *envoy_listeners.ListenerBuilder
 	RouteConfiguration *envoy_routes.RouteConfigurationBuilder
+
+	// ClientRouteConfigurations are the route configurations of the
+	// client classes of the listener, in the same order.
+	ClientRouteConfigurations []*envoy_routes.RouteConfigurationBuilder
 }
 
 type GatewayListener struct {
 	Port         uint32
 	Protocol     mesh_proto.Gateway_Listener_Protocol
 	ResourceName string
+
+	// RateLimited is set when any of the hosts of the listener
+	// has a rate limit.
+	RateLimited bool
+
+	// CSRFProtected is set when any of the hosts of the listener
+	// enables the CSRF protection.
+	CSRFProtected bool
+
+	// SecurityHeaders is set when any of the hosts of the listener
+	// adds security headers to its responses.
+	SecurityHeaders bool
+
+	// CrossMesh is set when the listener accepts connections
+	// from the data plane proxies of other meshes.
+	CrossMesh bool
+
+	// Cache is the response cache configuration of the listener.
+	Cache *mesh_proto.Gateway_Listener_Cache
+
+	// AccessLog is the access log configuration of the listener.
+	AccessLog *mesh_proto.Gateway_Listener_AccessLog
+
+	// Waf is the web application firewall of the listener.
+	Waf *ListenerWaf
+
+	// Jwt is the JWT authentication of the listener.
+	Jwt *ListenerJwt
+
+	// Oidc is the OpenID Connect authentication of the listener.
+	Oidc *mesh_proto.Gateway_Listener_Oidc
+
+	// Limits are the connection limits and timeouts of the listener.
+	Limits *mesh_proto.Gateway_Listener_Limits
+
+	// Paths are the path options of the listener.
+	Paths *mesh_proto.Gateway_Listener_Paths
+
+	// Upgrades are the HTTP upgrade types that are enabled by
+	// any of the routes of the listener.
+	Upgrades []string
+
+	// Cors is set when any of the routes of the listener has a
+	// CORS policy.
+	Cors bool
+
+	// Buffered is set when any of the routes of the listener
+	// buffers requests.
+	Buffered bool
+
+	// Faulted is set when any of the routes of the listener
+	// injects faults.
+	Faulted bool
+
+	// XffNumTrustedHops is the number of trusted proxies in front
+	// of the listener. When it is set, the client addresses of the
+	// routes are matched in the x-forwarded-for header.
+	XffNumTrustedHops uint32
+
+	// ClientClasses are the classes of the source addresses that
+	// the routes of the listener match.
+	ClientClasses []ClientClass
 }
 
 type GatewayResourceInfo struct {
@@ -72,6 +179,10 @@ type GatewayResourceInfo struct {
 	Host       GatewayHost
 	Resources  Resources
 	RouteTable route.Table
+
+	// CrossMeshes are the names of the meshes whose data plane
+	// proxies can connect to cross-mesh listeners.
+	CrossMeshes []string
 }
 
 // GatewayHostGenerator is responsible for generating xDS resources for a single GatewayHost.
@@ -83,15 +194,16 @@ type GatewayHostGenerator interface {
 // Generator generates xDS resources for an entire Gateway.
 type Generator struct {
 	ResourceManager core_manager.ReadOnlyResourceManager
+	CaProvider      secrets.CaProvider
 	Generators      []GatewayHostGenerator
 }
 
 func (g Generator) Generate(ctx xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
 	mesh := ctx.Mesh.Resource.Meta.GetName()
 	manager := match.ManagerForMesh(g.ResourceManager, mesh)
-	gateway := match.Gateway(manager, proxy.Dataplane)
+	gateways := match.Gateways(manager, proxy.Dataplane)
 
-	if gateway == nil {
+	if len(gateways) == 0 {
 		log.V(1).Info("no matching gateway for dataplane",
 			"name", proxy.Dataplane.Meta.GetName(),
 			"mesh", proxy.Dataplane.Meta.GetMesh(),
@@ -101,25 +213,25 @@ func (g Generator) Generate(ctx xds_context.Context, proxy *core_xds.Proxy) (*co
 		return nil, nil
 	}
 
-	log.V(1).Info(fmt.Sprintf("matched gateway %q to dataplane %q",
-		gateway.Meta.GetName(), proxy.Dataplane.Meta.GetName()))
+	for _, gateway := range gateways {
+		log.V(1).Info(fmt.Sprintf("matched gateway %q to dataplane %q",
+			gateway.Meta.GetName(), proxy.Dataplane.Meta.GetName()))
+	}
 
-	// Canonicalize the tags on each listener to be the merged resources
-	// of dataplane, gateway and listener tags.
-	for _, listener := range gateway.Spec.GetConf().GetListeners() {
-		listener.Tags = match.MergeSelectors(
-			proxy.Dataplane.Spec.GetNetworking().GetGateway().GetTags(),
-			gateway.Spec.GetTags(),
-			listener.GetTags(),
-		)
+	listeners, conflicts, err := MakeGatewayListenerHosts(manager, gateways, proxy.Dataplane)
+	if err != nil {
+		return nil, err
 	}
 
-	// Multiple listener specifications can have the same port. If
-	// they are compatible, then we can collapse those specifications
-	// down to a single listener.
-	collapsed := map[uint32][]*mesh_proto.Gateway_Listener{}
-	for _, ep := range gateway.Spec.GetConf().GetListeners() {
-		collapsed[ep.GetPort()] = append(collapsed[ep.GetPort()], ep)
+	for _, c := range conflicts {
+		log.Info("gateway listeners are not merged on dataplane",
+			"name", proxy.Dataplane.Meta.GetName(),
+			"mesh", proxy.Dataplane.Meta.GetMesh(),
+			"gateway", c.Gateway,
+			"port", c.Port,
+			"with", c.With,
+			"reason", c.Reason,
+		)
 	}
 
 	resources := ResourceAggregator{core_xds.NewResourceSet()}
@@ -130,49 +242,47 @@ func (g Generator) Generate(ctx xds_context.Context, proxy *core_xds.Proxy) (*co
 		return nil, errors.Wrapf(err, "failed to list ExternalServices")
 	}
 
-	for port, listeners := range collapsed {
-		// Force all listeners on the same port to have the same protocol.
-		for i := range listeners {
-			if listeners[i].GetProtocol() != listeners[0].GetProtocol() {
-				return nil, errors.Errorf(
-					"cannot collapse listener protocols %s and %s on port %d",
-					listeners[i].GetProtocol(), listeners[0].GetProtocol(), port,
-				)
-			}
+	// Cross-mesh listeners verify the clients with the CAs of all
+	// the meshes that have mTLS enabled.
+	var crossMeshes []string
+	for _, listener := range listeners {
+		if !listener.Listener.CrossMesh {
+			continue
 		}
 
-		listener, hosts, err := MakeGatewayListener(manager, gateway, listeners)
+		meshes, secret, err := g.crossMeshCaSecret()
 		if err != nil {
-			return nil, err
+			return nil, errors.Wrap(err, "failed to generate cross-mesh CA secret")
 		}
 
-		hosts = RedistributeWildcardRoutes(hosts)
+		crossMeshes = meshes
+		resources.ResourceSet.Add(NewResource(secret.Name, secret))
+		break
+	}
 
-		// Sort by reverse hostname, so that fully qualified hostnames sort
-		// before wildcard domains, and "*" is last.
-		sort.Slice(hosts, func(i, j int) bool {
-			return hosts[i].Hostname > hosts[j].Hostname
-		})
+	// The access logs of listeners can be streamed to collectors
+	// that no logging policy selects, so their clusters are missing.
+	if err := g.addAccessLogClusters(ctx, proxy, listeners, &resources); err != nil {
+		return nil, err
+	}
 
+	for _, listener := range listeners {
 		info := GatewayResourceInfo{
 			Proxy:            proxy,
 			Dataplane:        proxy.Dataplane,
-			Gateway:          gateway,
+			Gateway:          listener.Gateway,
 			ExternalServices: externalServices.(*core_mesh.ExternalServiceResourceList),
-			Listener:         listener,
+			Listener:         listener.Listener,
+			CrossMeshes:      crossMeshes,
 		}
 
 		// Make a pass over the generators for each virtual host.
-		for _, host := range hosts {
+		for _, host := range listener.Hosts {
 			info.RouteTable = route.Table{}
 			info.Host = host
 
-			// Ensure that generators don't get duplicate routes,
-			// which could happen after redistributing wildcards.
-			info.Host.Routes = merge.UniqueResources(info.Host.Routes)
-
 			for _, generator := range g.Generators {
-				if !generator.SupportsProtocol(listener.Protocol) {
+				if !generator.SupportsProtocol(listener.Listener.Protocol) {
 					continue
 				}
 
@@ -190,14 +300,331 @@ func (g Generator) Generate(ctx xds_context.Context, proxy *core_xds.Proxy) (*co
 			return nil, errors.Wrapf(err, "failed to build listener resource")
 		}
 
-		if err := resources.Add(BuildResourceSet(info.Resources.RouteConfiguration)); err != nil {
-			return nil, errors.Wrapf(err, "failed to build route configuration resource")
+		// Only HTTP listeners have a route configuration.
+		if info.Resources.RouteConfiguration != nil {
+			if err := resources.Add(BuildResourceSet(info.Resources.RouteConfiguration)); err != nil {
+				return nil, errors.Wrapf(err, "failed to build route configuration resource")
+			}
+		}
+
+		for _, routeConfiguration := range info.Resources.ClientRouteConfigurations {
+			if err := resources.Add(BuildResourceSet(routeConfiguration)); err != nil {
+				return nil, errors.Wrapf(err, "failed to build client route configuration resource")
+			}
 		}
 	}
 
 	return resources.Get(), nil
 }
 
+// addAccessLogClusters adds the clusters of the gRPC collectors that
+// the listeners log to, unless the logging policies already added them.
+func (g Generator) addAccessLogClusters(
+	ctx xds_context.Context,
+	proxy *core_xds.Proxy,
+	listeners []GatewayListenerHosts,
+	resources *ResourceAggregator,
+) error {
+	added := map[string]bool{}
+	for _, backend := range proxy.Policies.Logs {
+		added[backend.GetName()] = true
+	}
+
+	for _, listener := range listeners {
+		if listener.Listener.AccessLog == nil {
+			continue
+		}
+
+		backend := AccessLogBackend(ctx.Mesh.Resource, listener.Listener.AccessLog)
+		if backend.GetType() != mesh_proto.LoggingGrpcType || added[backend.GetName()] {
+			continue
+		}
+
+		cluster, err := generator.AccessLogCollectorCluster(backend, proxy.APIVersion)
+		if err != nil {
+			return errors.Wrapf(err, "could not generate access log collector cluster for backend %q", backend.GetName())
+		}
+
+		added[backend.GetName()] = true
+		resources.ResourceSet.Add(cluster)
+	}
+
+	return nil
+}
+
+// crossMeshCaSecret returns the names of the meshes that have mTLS
+// enabled, and a secret with the CAs of all of them.
+func (g Generator) crossMeshCaSecret() ([]string, *envoy_auth.Secret, error) {
+	list, err := listResources(g.ResourceManager, core_mesh.MeshType)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list Meshes")
+	}
+
+	meshes := list.(*core_mesh.MeshResourceList).Items
+	sort.Slice(meshes, func(i, j int) bool {
+		return meshes[i].Meta.GetName() < meshes[j].Meta.GetName()
+	})
+
+	var names []string
+	ca := &core_xds.CaSecret{}
+
+	for _, mesh := range meshes {
+		if !mesh.MTLSEnabled() {
+			continue
+		}
+
+		secret, _, err := g.CaProvider.Get(context.Background(), mesh)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get CA of mesh %q", mesh.Meta.GetName())
+		}
+
+		names = append(names, mesh.Meta.GetName())
+		ca.PemCerts = append(ca.PemCerts, secret.PemCerts...)
+	}
+
+	return names, envoy_secrets.CreateCrossMeshCaSecret(ca), nil
+}
+
+// GatewayConflict is a port of a Gateway whose listeners can't be
+// merged with the listeners of a Gateway that takes precedence on the
+// same dataplane. The listeners of the Gateway on that port are not
+// configured.
+type GatewayConflict struct {
+	Gateway string `json:"gateway"`
+	Port    uint32 `json:"port"`
+	// With is the Gateway that the port is bound to.
+	With   string `json:"with"`
+	Reason string `json:"reason"`
+}
+
+// MakeGatewayListenerHosts binds the hosts and routes of the gateways to
+// the listeners of the given gateway dataplane. Listeners are ordered by
+// port and hosts by the precedence of their hostnames (see match.HostnameLess),
+// which is also the order in which the hostnames are matched.
+//
+// The gateways are merged in the given order (see match.Gateways). A port
+// is bound to the first gateway that has listeners on it, and the listeners
+// of the other gateways on the port are merged when they are compatible.
+// Otherwise they are dropped and reported as conflicts.
+func MakeGatewayListenerHosts(
+	manager *match.MeshedResourceManager,
+	gateways []*core_mesh.GatewayResource,
+	dataplane *core_mesh.DataplaneResource,
+) ([]GatewayListenerHosts, []GatewayConflict, error) {
+	// Multiple listener specifications can have the same port. If
+	// they are compatible, then we can collapse those specifications
+	// down to a single listener.
+	collapsed := map[uint32][]*mesh_proto.Gateway_Listener{}
+	owners := map[uint32]*core_mesh.GatewayResource{}
+	// The gateway of each hostname, for each port.
+	hostGateways := map[uint32]map[string]string{}
+	var ports []uint32
+	var conflicts []GatewayConflict
+
+	for _, gateway := range gateways {
+		gatewayListeners := map[uint32][]*mesh_proto.Gateway_Listener{}
+		var gatewayPorts []uint32
+
+		for _, listener := range gateway.Spec.GetConf().GetListeners() {
+			// Canonicalize the tags on each listener to be the merged resources
+			// of dataplane, gateway and listener tags.
+			listener.Tags = match.MergeSelectors(
+				dataplane.Spec.GetNetworking().GetGateway().GetTags(),
+				gateway.Spec.GetTags(),
+				listener.GetTags(),
+			)
+
+			if _, ok := gatewayListeners[listener.GetPort()]; !ok {
+				gatewayPorts = append(gatewayPorts, listener.GetPort())
+			}
+			gatewayListeners[listener.GetPort()] = append(gatewayListeners[listener.GetPort()], listener)
+		}
+
+		for _, port := range gatewayPorts {
+			listeners := gatewayListeners[port]
+
+			owner, ok := owners[port]
+			if !ok {
+				owners[port] = gateway
+				hostGateways[port] = map[string]string{}
+				ports = append(ports, port)
+			} else if reason := mergeConflict(owner, collapsed[port], hostGateways[port], gateway, listeners); reason != "" {
+				conflicts = append(conflicts, GatewayConflict{
+					Gateway: gateway.Meta.GetName(),
+					Port:    port,
+					With:    owner.Meta.GetName(),
+					Reason:  reason,
+				})
+				continue
+			}
+
+			for _, l := range listeners {
+				for _, hostname := range l.MatchedHostnames() {
+					if _, ok := hostGateways[port][hostname]; !ok {
+						hostGateways[port][hostname] = gateway.Meta.GetName()
+					}
+				}
+			}
+
+			collapsed[port] = append(collapsed[port], listeners...)
+		}
+	}
+
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
+
+	var result []GatewayListenerHosts
+	for _, port := range ports {
+		listeners := collapsed[port]
+		gateway := owners[port]
+
+		// Force all listeners on the same port to have the same protocol.
+		for i := range listeners {
+			if mismatch := listenerMismatch(listeners[0], listeners[i]); mismatch != "" {
+				return nil, nil, errors.Errorf("cannot collapse %s on port %d", mismatch, port)
+			}
+		}
+
+		listener, hosts, err := MakeGatewayListener(manager, gateway, listeners)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for i := range hosts {
+			hosts[i].Gateway = hostGateways[port][hosts[i].Hostname]
+		}
+
+		hosts = RedistributeWildcardRoutes(hosts)
+
+		for i := range hosts {
+			// Ensure that generators don't get duplicate routes,
+			// which could happen after redistributing wildcards.
+			hosts[i].Routes = merge.UniqueResources(hosts[i].Routes)
+
+			// Explicit routes list HTTP or TLS hostnames, TCP listeners don't have any.
+			if gateway.Spec.GetConf().GetExplicitRoutes() != nil &&
+				listener.Protocol != mesh_proto.Gateway_Listener_TCP {
+				hosts[i].Routes = explicitHostRoutes(hosts[i])
+			}
+		}
+
+		listener.Upgrades = hostUpgrades(hosts)
+		listener.Cors = hostCors(hosts)
+		listener.Buffered = hostBuffered(hosts)
+		listener.Faulted = hostFaulted(hosts)
+
+		// Behind trusted proxies, the source address of the
+		// connection is the address of the last proxy.
+		if listener.XffNumTrustedHops == 0 {
+			listener.ClientClasses = hostClientClasses(listener.ResourceName, hosts)
+		}
+
+		// Sort by hostname precedence, so that fully qualified hostnames
+		// sort before wildcard domains, and "*" is last.
+		sort.Slice(hosts, func(i, j int) bool {
+			return match.HostnameLess(hosts[i].Hostname, hosts[j].Hostname)
+		})
+
+		if listener.Waf != nil {
+			listener.Waf.Exclusions = hostWafExclusions(hosts)
+		}
+
+		if listener.Jwt != nil {
+			listener.Jwt.Requirements = hostJwtRequirements(listener.Jwt.Conf, hosts)
+		}
+
+		result = append(result, GatewayListenerHosts{
+			Gateway:  gateway,
+			Listener: listener,
+			Hosts:    hosts,
+		})
+	}
+
+	return result, conflicts, nil
+}
+
+// listenerMismatch returns what prevents the given listeners from being
+// collapsed on the same port, or an empty string if they can be.
+func listenerMismatch(a *mesh_proto.Gateway_Listener, b *mesh_proto.Gateway_Listener) string {
+	switch {
+	case a.GetProtocol() != b.GetProtocol():
+		return fmt.Sprintf("listener protocols %s and %s", b.GetProtocol(), a.GetProtocol())
+
+	case a.GetCrossMesh() != b.GetCrossMesh():
+		return "cross-mesh and mesh listeners"
+
+	// The cache is configured on the HTTP connection manager,
+	// which all the listeners on the port share.
+	case !proto.Equal(a.GetCache(), b.GetCache()):
+		return "listeners with different caches"
+
+	// Like the cache, the access log is configured on
+	// the HTTP connection manager.
+	case !proto.Equal(a.GetAccessLog(), b.GetAccessLog()):
+		return "listeners with different access logs"
+
+	// The firewall filter is also shared by the listeners.
+	case !proto.Equal(a.GetWaf(), b.GetWaf()):
+		return "listeners with different WAFs"
+
+	// And so is the JWT authentication filter.
+	case !proto.Equal(a.GetJwt(), b.GetJwt()):
+		return "listeners with different JWT authentications"
+
+	// And the OAuth2 filter of the OpenID Connect authentication.
+	case !proto.Equal(a.GetOidc(), b.GetOidc()):
+		return "listeners with different OIDC authentications"
+
+	// The limits apply to the connections on the port,
+	// whatever their hostname.
+	case !proto.Equal(a.GetLimits(), b.GetLimits()):
+		return "listeners with different limits"
+
+	// The paths are normalized by the HTTP connection manager,
+	// and the prefixes are matched by the shared routes.
+	case !proto.Equal(a.GetPaths(), b.GetPaths()):
+		return "listeners with different path options"
+
+	// The client addresses are resolved by the HTTP connection
+	// manager too.
+	case a.GetXffNumTrustedHops() != b.GetXffNumTrustedHops():
+		return "listeners with different numbers of trusted hops"
+	}
+
+	return ""
+}
+
+// mergeConflict returns why the listeners of a gateway can't be merged
+// with the listeners that are already bound to the port, or an empty
+// string if they can be.
+func mergeConflict(
+	owner *core_mesh.GatewayResource,
+	bound []*mesh_proto.Gateway_Listener,
+	boundHostnames map[string]string,
+	gateway *core_mesh.GatewayResource,
+	listeners []*mesh_proto.Gateway_Listener,
+) string {
+	// Explicit routes apply to all the hosts of the listener.
+	if !proto.Equal(owner.Spec.GetConf().GetExplicitRoutes(), gateway.Spec.GetConf().GetExplicitRoutes()) {
+		return "cannot merge Gateways with different explicit routes"
+	}
+
+	for _, l := range listeners {
+		if mismatch := listenerMismatch(bound[0], l); mismatch != "" {
+			return "cannot collapse " + mismatch
+		}
+
+		for _, hostname := range l.MatchedHostnames() {
+			if other, ok := boundHostnames[hostname]; ok {
+				return fmt.Sprintf("hostname %q is already bound by Gateway %q", hostname, other)
+			}
+		}
+	}
+
+	return ""
+}
+
 func listResources(mgr core_manager.ReadOnlyResourceManager, t model.ResourceType) (model.ResourceList, error) {
 	list, err := registry.Global().NewList(t)
 	if err != nil {
@@ -230,6 +657,33 @@ func MakeGatewayListener(
 			listeners[0].GetProtocol().String(),
 			listeners[0].GetPort(),
 		),
+		CrossMesh: listeners[0].GetCrossMesh(),
+		Cache:     listeners[0].GetCache(),
+		AccessLog: listeners[0].GetAccessLog(),
+		Oidc:      listeners[0].GetOidc(),
+		Limits:    listeners[0].GetLimits(),
+		Paths:     listeners[0].GetPaths(),
+
+		XffNumTrustedHops: listeners[0].GetXffNumTrustedHops(),
+	}
+
+	if conf := listeners[0].GetJwt(); conf != nil {
+		listener.Jwt = &ListenerJwt{
+			Conf: conf,
+		}
+	}
+
+	if conf := listeners[0].GetWaf(); conf != nil {
+		ruleSet := core_mesh.NewWafRuleSetResource()
+		if err := manager.Get(context.Background(), ruleSet,
+			store.GetByKey(conf.GetRuleSet(), gateway.Meta.GetMesh())); err != nil {
+			return listener, nil, errors.Wrapf(err, "failed to get WAF rule set %q", conf.GetRuleSet())
+		}
+
+		listener.Waf = &ListenerWaf{
+			RuleSet:       ruleSet.Spec.GetConf(),
+			DetectionOnly: conf.GetDetectionOnly(),
+		}
 	}
 
 	for _, t := range RoutePolicyTypes {
@@ -251,40 +705,79 @@ func MakeGatewayListener(
 	}
 
 	// Hostnames must be unique to a listener to remove ambiguity
-	// in policy selection and TLS configuration.
+	// in policy selection and TLS configuration. A listener with
+	// several hostnames makes a host for each of them.
 	for _, l := range listeners {
-		// An empty hostname is the same as "*", i.e. matches all hosts.
-		hostname := l.GetHostname()
-		if hostname == "" {
-			hostname = WildcardHostname
-		}
+		for i, hostname := range l.MatchedHostnames() {
+			if _, ok := hostsByName[hostname]; ok {
+				return listener, nil, errors.Errorf("duplicate hostname %q", hostname)
+			}
 
-		if _, ok := hostsByName[hostname]; ok {
-			return listener, nil, errors.Errorf("duplicate hostname %q", hostname)
-		}
+			host := GatewayHost{
+				Hostname:  hostname,
+				Policies:  map[model.ResourceType][]match.RankedPolicy{},
+				RateLimit: l.GetRateLimit(),
+				Security:  l.GetSecurity(),
+			}
 
-		host := GatewayHost{
-			Hostname: hostname,
-			Policies: map[model.ResourceType][]match.RankedPolicy{},
-		}
+			if host.RateLimit != nil {
+				listener.RateLimited = true
+			}
 
-		switch listener.Protocol {
-		case mesh_proto.Gateway_Listener_HTTP,
-			mesh_proto.Gateway_Listener_HTTPS:
-			host.Routes = append(host.Routes,
-				match.Routes(resourcesByType[core_mesh.GatewayRouteType], l.GetTags())...)
-		default:
-			// TODO(jpeach) match other route types that are appropriate to the protocol.
-		}
+			if host.Security.GetCsrf() != nil {
+				listener.CSRFProtected = true
+			}
 
-		for _, t := range ConnectionPolicyTypes {
-			matches := match.ConnectionPoliciesBySource(
-				l.GetTags(),
-				match.ToConnectionPolicies(resourcesByType[t]))
-			host.Policies[t] = matches
-		}
+			if host.Security.GetHeaders() != nil {
+				listener.SecurityHeaders = true
+			}
+
+			switch listener.Protocol {
+			case mesh_proto.Gateway_Listener_HTTPS:
+				if l.GetTls().GetMode() != mesh_proto.Gateway_TLS_TERMINATE {
+					return listener, nil, errors.Errorf(
+						"HTTPS listener for hostname %q on port %d must terminate TLS", hostname, listener.Port)
+				}
+				host.TLS = l.GetTls()
+				if i == 0 {
+					host.Certificates = l.GetTls().GetCertificates()
+				}
+			case mesh_proto.Gateway_Listener_TLS:
+				if l.GetTls().GetMode() != mesh_proto.Gateway_TLS_PASSTHROUGH {
+					return listener, nil, errors.Errorf(
+						"TLS listener for hostname %q on port %d must pass TLS through", hostname, listener.Port)
+				}
+				host.TLS = l.GetTls()
+			}
+
+			switch listener.Protocol {
+			case mesh_proto.Gateway_Listener_HTTP,
+				mesh_proto.Gateway_Listener_HTTPS,
+				mesh_proto.Gateway_Listener_GRPC:
+				host.Routes = append(host.Routes,
+					routesWithConf(match.Routes(resourcesByType[core_mesh.GatewayRouteType], l.GetTags()),
+						func(conf *mesh_proto.GatewayRoute_Conf) bool { return conf.GetHttp() != nil })...)
+			case mesh_proto.Gateway_Listener_TCP:
+				host.Routes = append(host.Routes,
+					routesWithConf(match.Routes(resourcesByType[core_mesh.GatewayRouteType], l.GetTags()),
+						func(conf *mesh_proto.GatewayRoute_Conf) bool { return conf.GetTcp() != nil })...)
+			case mesh_proto.Gateway_Listener_TLS:
+				host.Routes = append(host.Routes,
+					routesWithConf(match.Routes(resourcesByType[core_mesh.GatewayRouteType], l.GetTags()),
+						func(conf *mesh_proto.GatewayRoute_Conf) bool { return conf.GetTls() != nil })...)
+			default:
+				// TODO(jpeach) match other route types that are appropriate to the protocol.
+			}
 
-		hostsByName[hostname] = host
+			for _, t := range ConnectionPolicyTypes {
+				matches := match.ConnectionPoliciesBySource(
+					l.GetTags(),
+					match.ToConnectionPolicies(resourcesByType[t]))
+				host.Policies[t] = matches
+			}
+
+			hostsByName[hostname] = host
+		}
 	}
 
 	var hosts []GatewayHost
@@ -295,6 +788,56 @@ func MakeGatewayListener(
 	return listener, hosts, nil
 }
 
+// routesWithConf returns the GatewayRoute resources whose configuration
+// is accepted, so that listeners only get the routes for their protocol.
+func routesWithConf(routes []model.Resource, accept func(*mesh_proto.GatewayRoute_Conf) bool) []model.Resource {
+	var accepted []model.Resource
+
+	for _, r := range routes {
+		if gw, ok := r.(*core_mesh.GatewayRouteResource); ok && accept(gw.Spec.GetConf()) {
+			accepted = append(accepted, r)
+		}
+	}
+
+	return accepted
+}
+
+// routeHostnames returns the hostnames of a HTTP or TLS route. Other
+// routes don't have hostnames.
+func routeHostnames(conf *mesh_proto.GatewayRoute_Conf) []string {
+	switch {
+	case conf.GetHttp() != nil:
+		return conf.GetHttp().GetHostnames()
+	case conf.GetTls() != nil:
+		return conf.GetTls().GetHostnames()
+	default:
+		return nil
+	}
+}
+
+// explicitHostRoutes returns the routes of the host that list its
+// hostname. Routes that would only match the hostname because they don't
+// have hostnames or because of a wildcard are not explicit.
+func explicitHostRoutes(host GatewayHost) []model.Resource {
+	var routes []model.Resource
+
+	for _, r := range host.Routes {
+		gw, ok := r.(*core_mesh.GatewayRouteResource)
+		if !ok {
+			continue
+		}
+
+		for _, n := range routeHostnames(gw.Spec.GetConf()) {
+			if n == host.Hostname {
+				routes = append(routes, r)
+				break
+			}
+		}
+	}
+
+	return routes
+}
+
 // RedistributeWildcardRoutes takes the routes from the wildcard host
 // and redistributes them to hosts with matching names, creating new
 // hosts if necessary.
@@ -332,7 +875,7 @@ func RedistributeWildcardRoutes(
 			continue
 		}
 
-		names := gw.Spec.GetConf().GetHttp().GetHostnames()
+		names := routeHostnames(gw.Spec.GetConf())
 
 		// No hostnames on this route, it stays as a wildcard route.
 		if len(names) == 0 {
@@ -343,7 +886,15 @@ func RedistributeWildcardRoutes(
 		for _, n := range names {
 			// Note that if we already have a virtualhost for this
 			// name, and add the route to it, it might be a duplicate.
-			host := hostsByName[n]
+			host, ok := hostsByName[n]
+			if !ok {
+				// The host serves requests of the wildcard
+				// listener, so it is limited and secured in
+				// the same way.
+				host.RateLimit = wild.RateLimit
+				host.Security = wild.Security
+				host.Gateway = wild.Gateway
+			}
 			host.Hostname = n
 			host.Routes = append(host.Routes, r)
 			hostsByName[n] = host
@@ -1,6 +1,8 @@
 package gateway_test
 
 import (
+	"sort"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -26,5 +28,35 @@ var _ = Describe("Hostname matching", func() {
 		Entry("", "*.example.com", "foo.examples.com"),
 		Entry("", "foo.example.com", "*.examples.com"),
 		Entry("", "*.example.com", "*.examples.com"),
+		Entry("", "localhost", "*.example.com"),
 	)
 })
+
+var _ = Describe("Hostname precedence", func() {
+	It("should order exact hostnames before wildcards", func() {
+		// given
+		hostnames := []string{
+			"*",
+			"*.example.com",
+			"bar.example.com",
+			"*.foo.example.com",
+			"foo.example.com",
+			"*.com",
+		}
+
+		// when
+		sort.Slice(hostnames, func(i, j int) bool {
+			return match.HostnameLess(hostnames[i], hostnames[j])
+		})
+
+		// then
+		Expect(hostnames).To(Equal([]string{
+			"foo.example.com",
+			"bar.example.com",
+			"*.foo.example.com",
+			"*.example.com",
+			"*.com",
+			"*",
+		}))
+	})
+})
@@ -0,0 +1,348 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/match"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+)
+
+// HostsInspection describes how the hostnames and routes of a Gateway
+// are bound to the listeners of a gateway dataplane.
+type HostsInspection struct {
+	Gateway string `json:"gateway"`
+	// Gateways are all the Gateways that are merged on the dataplane,
+	// in precedence order, when there are several of them. Gateway is
+	// the first of them.
+	Gateways  []string             `json:"gateways,omitempty"`
+	Listeners []ListenerInspection `json:"listeners"`
+	// HostnameConflicts are the hostnames that are matched by several
+	// listeners of a Gateway on the same port. The dataplane isn't
+	// configured with any listener until they are resolved.
+	HostnameConflicts []HostnameConflictInspection `json:"hostnameConflicts,omitempty"`
+	// Conflicts are the ports of the Gateways whose listeners can't be
+	// merged with the listeners of the Gateways that take precedence.
+	Conflicts []GatewayConflict `json:"conflicts,omitempty"`
+}
+
+// HostnameConflictInspection describes a hostname that is matched by
+// several listeners on the same port.
+type HostnameConflictInspection struct {
+	// Gateway is only set when several Gateways are merged on the
+	// dataplane.
+	Gateway  string `json:"gateway,omitempty"`
+	Port     uint32 `json:"port"`
+	Hostname string `json:"hostname"`
+	// Listeners are the indices of the conflicting listeners in the
+	// Gateway configuration.
+	Listeners []int `json:"listeners"`
+}
+
+type ListenerInspection struct {
+	Port     uint32 `json:"port"`
+	Protocol string `json:"protocol"`
+	// StatPrefix is the prefix of the Envoy HTTP stats
+	// ("http.<prefix>.*") or TCP proxy stats ("tcp.<prefix>.*")
+	// of the listener.
+	StatPrefix string `json:"statPrefix"`
+	// Hosts are ordered by the hostname precedence, a request is
+	// handled by the first host that matches its hostname.
+	Hosts []HostInspection `json:"hosts"`
+	// UncoveredHostnames are the hostnames of the hosts that don't
+	// have any routes. Requests for them are denied.
+	UncoveredHostnames []string `json:"uncoveredHostnames"`
+	// Stats are only included when they are requested.
+	Stats *ListenerStats `json:"stats,omitempty"`
+}
+
+// ListenerStats are the connection and request stats of a listener
+// reported by the gateway dataplane.
+type ListenerStats struct {
+	ActiveConnections uint64 `json:"activeConnections"`
+	TotalConnections  uint64 `json:"totalConnections"`
+	ActiveRequests    uint64 `json:"activeRequests"`
+	TotalRequests     uint64 `json:"totalRequests"`
+	Responses5xx      uint64 `json:"responses5xx"`
+}
+
+type HostInspection struct {
+	Hostname string `json:"hostname"`
+	// Gateway is the Gateway that the host comes from. It is only set
+	// when several Gateways are merged on the dataplane.
+	Gateway string `json:"gateway,omitempty"`
+	// TLSMode is the TLS mode of the listener the host comes from.
+	// It is empty for hosts that are created from route hostnames.
+	TLSMode string   `json:"tlsMode,omitempty"`
+	Routes  []string `json:"routes"`
+	// RouteTable is the route table of HTTP hosts. Requests are
+	// handled by the first entry that matches them.
+	RouteTable []RouteTableEntryInspection `json:"routeTable,omitempty"`
+}
+
+// RouteTableEntryInspection describes an entry of the route table of a
+// host. Only the path and method of its match are included.
+type RouteTableEntryInspection struct {
+	Route     string `json:"route"`
+	PathMatch string `json:"pathMatch,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Method    string `json:"method,omitempty"`
+	Priority  uint32 `json:"priority,omitempty"`
+}
+
+// NewInspectWebService returns a WebService that exposes the hostname to
+// route binding of builtin gateway dataplanes.
+func NewInspectWebService(rm core_manager.ReadOnlyResourceManager, eac admin.EnvoyAdminClient) *restful.WebService {
+	ws := new(restful.WebService).
+		Path("/meshes/{mesh}/dataplanes/{name}/gateway-hosts").
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.GET("").To(func(request *restful.Request, response *restful.Response) {
+		mesh := request.PathParameter("mesh")
+		name := request.PathParameter("name")
+
+		var inspection *HostsInspection
+		var err error
+		if request.QueryParameter("stats") == "true" {
+			inspection, err = InspectListenerStats(request.Request.Context(), rm, eac, mesh, name)
+		} else {
+			inspection, err = InspectHosts(request.Request.Context(), rm, mesh, name)
+		}
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not inspect gateway hosts")
+			return
+		}
+		if err := response.WriteAsJson(inspection); err != nil {
+			log.Error(err, "failed marshaling response")
+		}
+	}).
+		Doc("Inspect hostnames and routes of a builtin gateway dataplane").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.QueryParameter("stats", "Include connection and request stats of each listener").DataType("boolean")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+
+	return ws
+}
+
+// InspectHosts computes the hostname to route binding of the given
+// builtin gateway dataplane, in the same way as the xDS generator.
+func InspectHosts(ctx context.Context, rm core_manager.ReadOnlyResourceManager, mesh string, name string) (*HostsInspection, error) {
+	manager := match.ManagerForMesh(rm, mesh)
+
+	dataplane := core_mesh.NewDataplaneResource()
+	if err := manager.Get(ctx, dataplane, store.GetByKey(name, mesh)); err != nil {
+		return nil, err
+	}
+	if !dataplane.Spec.IsBuiltinGateway() {
+		verr := validators.ValidationError{}
+		verr.AddViolation("name", "dataplane is not a builtin gateway")
+		return nil, verr.OrNil()
+	}
+
+	inspection := &HostsInspection{
+		Listeners: []ListenerInspection{},
+	}
+
+	gateways := match.Gateways(manager, dataplane)
+	if len(gateways) == 0 {
+		return inspection, nil
+	}
+	inspection.Gateway = gateways[0].Meta.GetName()
+
+	merged := len(gateways) > 1
+	if merged {
+		for _, gateway := range gateways {
+			inspection.Gateways = append(inspection.Gateways, gateway.Meta.GetName())
+		}
+	}
+
+	// Gateways that were stored before hostname conflicts were
+	// validated can still have them, and they fail the generation
+	// of the whole dataplane configuration.
+	for _, gateway := range gateways {
+		for _, conflict := range inspectHostnameConflicts(gateway) {
+			if merged {
+				conflict.Gateway = gateway.Meta.GetName()
+			}
+			inspection.HostnameConflicts = append(inspection.HostnameConflicts, conflict)
+		}
+	}
+	if len(inspection.HostnameConflicts) > 0 {
+		return inspection, nil
+	}
+
+	listeners, conflicts, err := MakeGatewayListenerHosts(manager, gateways, dataplane)
+	if err != nil {
+		return nil, err
+	}
+	inspection.Conflicts = conflicts
+
+	for _, listener := range listeners {
+		listenerInspection := ListenerInspection{
+			Port:               listener.Listener.Port,
+			Protocol:           listener.Listener.Protocol.String(),
+			StatPrefix:         util_xds.SanitizeMetric(listener.Listener.ResourceName),
+			Hosts:              []HostInspection{},
+			UncoveredHostnames: []string{},
+		}
+		for _, host := range listener.Hosts {
+			hostInspection := HostInspection{
+				Hostname: host.Hostname,
+				Routes:   []string{},
+			}
+			if merged {
+				hostInspection.Gateway = host.Gateway
+			}
+			if host.TLS != nil {
+				hostInspection.TLSMode = host.TLS.GetMode().String()
+			}
+			for _, gatewayRoute := range hostGatewayRoutes(host) {
+				hostInspection.Routes = append(hostInspection.Routes, gatewayRoute.Meta.GetName())
+			}
+			if (&GatewayRouteGenerator{}).SupportsProtocol(listener.Listener.Protocol) {
+				hostInspection.RouteTable = inspectRouteTable(listener.Listener, host)
+			}
+			if len(hostInspection.Routes) == 0 {
+				listenerInspection.UncoveredHostnames = append(listenerInspection.UncoveredHostnames, host.Hostname)
+			}
+			listenerInspection.Hosts = append(listenerInspection.Hosts, hostInspection)
+		}
+		inspection.Listeners = append(inspection.Listeners, listenerInspection)
+	}
+
+	return inspection, nil
+}
+
+// inspectHostnameConflicts lists the hostnames that are matched by
+// several listeners on the same port, ordered by port and by the first
+// listener that matches them.
+func inspectHostnameConflicts(gateway *core_mesh.GatewayResource) []HostnameConflictInspection {
+	type key struct {
+		port     uint32
+		hostname string
+	}
+
+	var keys []key
+	listenersByKey := map[key][]int{}
+
+	for i, l := range gateway.Spec.GetConf().GetListeners() {
+		for _, hostname := range l.MatchedHostnames() {
+			k := key{port: l.GetPort(), hostname: hostname}
+			if _, ok := listenersByKey[k]; !ok {
+				keys = append(keys, k)
+			}
+			listenersByKey[k] = append(listenersByKey[k], i)
+		}
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].port < keys[j].port
+	})
+
+	var conflicts []HostnameConflictInspection
+	for _, k := range keys {
+		if len(listenersByKey[k]) > 1 {
+			conflicts = append(conflicts, HostnameConflictInspection{
+				Port:      k.port,
+				Hostname:  k.hostname,
+				Listeners: listenersByKey[k],
+			})
+		}
+	}
+
+	return conflicts
+}
+
+// inspectRouteTable lists the route table entries of the host in the
+// same order as the route table generator.
+func inspectRouteTable(listener GatewayListener, host GatewayHost) []RouteTableEntryInspection {
+	entries := makeRouteTableEntries(hostGatewayRoutes(host), nil, listener.Paths)
+	sort.Stable(route.Sorter(entries))
+
+	var table []RouteTableEntryInspection
+	for _, e := range entries {
+		entry := RouteTableEntryInspection{
+			Route:    e.Route,
+			Method:   e.Match.Method,
+			Priority: e.Priority,
+		}
+
+		switch {
+		case e.Match.Connect:
+			entry.PathMatch = "CONNECT"
+		case e.Match.ExactPath != "":
+			entry.PathMatch, entry.Path = "EXACT", e.Match.ExactPath
+		case e.Match.PrefixPath != "":
+			entry.PathMatch, entry.Path = "PREFIX", e.Match.PrefixPath
+		case e.Match.RegexPath != "":
+			entry.PathMatch, entry.Path = "REGEX", e.Match.RegexPath
+		}
+
+		table = append(table, entry)
+	}
+
+	return table
+}
+
+// InspectListenerStats inspects the builtin gateway dataplane like
+// InspectHosts, and adds the current stats of each listener that are
+// fetched from the Envoy admin API of the dataplane.
+func InspectListenerStats(
+	ctx context.Context,
+	rm core_manager.ReadOnlyResourceManager,
+	eac admin.EnvoyAdminClient,
+	mesh string,
+	name string,
+) (*HostsInspection, error) {
+	inspection, err := InspectHosts(ctx, rm, mesh, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(inspection.Listeners) == 0 {
+		return inspection, nil
+	}
+
+	dataplane := core_mesh.NewDataplaneResource()
+	if err := rm.Get(ctx, dataplane, store.GetByKey(name, mesh)); err != nil {
+		return nil, err
+	}
+
+	var prefixes []string
+	for _, listener := range inspection.Listeners {
+		prefixes = append(prefixes, regexp.QuoteMeta(listener.StatPrefix))
+	}
+
+	values, err := eac.Stats(dataplane, fmt.Sprintf(`^(http|tcp)\.(%s)\.downstream_`, strings.Join(prefixes, "|")))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range inspection.Listeners {
+		prefix := inspection.Listeners[i].StatPrefix
+		// TCP proxies don't count their active connections, so
+		// they are only reported by HTTP listeners.
+		inspection.Listeners[i].Stats = &ListenerStats{
+			ActiveConnections: values["http."+prefix+".downstream_cx_active"],
+			TotalConnections:  values["http."+prefix+".downstream_cx_total"] + values["tcp."+prefix+".downstream_cx_total"],
+			ActiveRequests:    values["http."+prefix+".downstream_rq_active"],
+			TotalRequests:     values["http."+prefix+".downstream_rq_total"],
+			Responses5xx:      values["http."+prefix+".downstream_rq_5xx"],
+		}
+	}
+
+	return inspection, nil
+}
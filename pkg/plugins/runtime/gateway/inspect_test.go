@@ -0,0 +1,430 @@
+package gateway_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway"
+	test_runtime "github.com/kumahq/kuma/pkg/test/runtime"
+)
+
+var _ = Describe("Gateway hosts inspection", func() {
+	var rt runtime.Runtime
+
+	BeforeEach(func() {
+		var err error
+
+		rt, err = BuildRuntime()
+		Expect(err).To(Succeed(), "build runtime instance")
+
+		Expect(StoreNamedFixture(rt, "mesh-default.yaml")).To(Succeed())
+		Expect(StoreNamedFixture(rt, "dataplane-default.yaml")).To(Succeed())
+	})
+
+	It("should bind routes to hostnames in precedence order", func() {
+		// given
+		Expect(StoreInlineFixture(rt, []byte(`
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+  - port: 8080
+    protocol: HTTP
+    hostname: "*.example.com"
+    tags:
+      port: http/8080
+      hostname: wildcard
+`))).To(Succeed())
+		Expect(StoreInlineFixture(rt, []byte(`
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+    port: http/8080
+conf:
+  http:
+    hostnames:
+    - foo.example.com
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`))).To(Succeed())
+
+		// when
+		inspection, err := gateway.InspectHosts(context.Background(), rt.ReadOnlyResourceManager(), "default", "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inspection).To(Equal(&gateway.HostsInspection{
+			Gateway: "edge-gateway",
+			Listeners: []gateway.ListenerInspection{
+				{
+					Port:       8080,
+					Protocol:   "HTTP",
+					StatPrefix: "edge-gateway_HTTP_8080",
+					Hosts: []gateway.HostInspection{
+						{
+							Hostname: "foo.example.com",
+							Routes:   []string{"echo-service"},
+							RouteTable: []gateway.RouteTableEntryInspection{
+								{Route: "echo-service", PathMatch: "PREFIX", Path: "/"},
+							},
+						},
+						{
+							Hostname: "*.example.com",
+							Routes:   []string{"echo-service"},
+							RouteTable: []gateway.RouteTableEntryInspection{
+								{Route: "echo-service", PathMatch: "PREFIX", Path: "/"},
+							},
+						},
+						{
+							Hostname: "*",
+							Routes:   []string{},
+						},
+					},
+					UncoveredHostnames: []string{"*"},
+				},
+			},
+		}))
+	})
+
+	It("should list hostnames that are not explicitly routed", func() {
+		// given
+		Expect(StoreInlineFixture(rt, []byte(`
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  explicitRoutes: {}
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    hostname: "*.example.com"
+    tags:
+      port: http/8080
+`))).To(Succeed())
+		Expect(StoreInlineFixture(rt, []byte(`
+type: GatewayRoute
+mesh: default
+name: wildcard
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    hostnames:
+    - foo.example.com
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`))).To(Succeed())
+
+		// when
+		inspection, err := gateway.InspectHosts(context.Background(), rt.ReadOnlyResourceManager(), "default", "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inspection.Listeners).To(HaveLen(1))
+		Expect(inspection.Listeners[0].UncoveredHostnames).To(Equal([]string{"*.example.com"}))
+	})
+
+	It("should order equally specific routes by priority", func() {
+		// given
+		Expect(StoreInlineFixture(rt, []byte(`
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+`))).To(Succeed())
+		Expect(StoreInlineFixture(rt, []byte(`
+type: GatewayRoute
+mesh: default
+name: api
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: REGEX
+          value: ^/api/v[0-9]+/.*$
+      backends:
+      - destination:
+          kuma.io/service: api-service
+`))).To(Succeed())
+		Expect(StoreInlineFixture(rt, []byte(`
+type: GatewayRoute
+mesh: default
+name: jobs
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: REGEX
+          value: ^/api/v[12]/jobs$
+      priority: 10
+      backends:
+      - destination:
+          kuma.io/service: jobs-service
+    - matches:
+      - path:
+          match: EXACT
+          value: /api
+      backends:
+      - destination:
+          kuma.io/service: jobs-service
+`))).To(Succeed())
+
+		// when
+		inspection, err := gateway.InspectHosts(context.Background(), rt.ReadOnlyResourceManager(), "default", "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inspection.Listeners).To(HaveLen(1))
+		Expect(inspection.Listeners[0].Hosts).To(HaveLen(1))
+		Expect(inspection.Listeners[0].Hosts[0].RouteTable).To(Equal([]gateway.RouteTableEntryInspection{
+			{Route: "jobs", PathMatch: "EXACT", Path: "/api"},
+			{Route: "jobs", PathMatch: "REGEX", Path: "^/api/v[12]/jobs$", Priority: 10},
+			{Route: "api", PathMatch: "REGEX", Path: "^/api/v[0-9]+/.*$"},
+		}))
+	})
+
+	It("should add the stats of each listener", func() {
+		// given
+		Expect(StoreInlineFixture(rt, []byte(`
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+  - port: 9090
+    protocol: HTTP
+    tags:
+      port: http/9090
+`))).To(Succeed())
+		eac := &test_runtime.DummyEnvoyAdminClient{
+			StatValues: map[string]uint64{
+				"http.edge-gateway_HTTP_8080.downstream_cx_active": 2,
+				"http.edge-gateway_HTTP_8080.downstream_cx_total":  10,
+				"http.edge-gateway_HTTP_8080.downstream_rq_active": 1,
+				"http.edge-gateway_HTTP_8080.downstream_rq_total":  42,
+				"http.edge-gateway_HTTP_8080.downstream_rq_5xx":    3,
+			},
+		}
+
+		// when
+		inspection, err := gateway.InspectListenerStats(context.Background(), rt.ReadOnlyResourceManager(), eac, "default", "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inspection.Listeners).To(HaveLen(2))
+		Expect(inspection.Listeners[0].Stats).To(Equal(&gateway.ListenerStats{
+			ActiveConnections: 2,
+			TotalConnections:  10,
+			ActiveRequests:    1,
+			TotalRequests:     42,
+			Responses5xx:      3,
+		}))
+		Expect(inspection.Listeners[1].StatPrefix).To(Equal("edge-gateway_HTTP_9090"))
+		Expect(inspection.Listeners[1].Stats).To(Equal(&gateway.ListenerStats{}))
+	})
+
+	It("should merge the listeners of all the gateways of the dataplane", func() {
+		// given
+		Expect(StoreInlineFixture(rt, []byte(`
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+`))).To(Succeed())
+		Expect(StoreInlineFixture(rt, []byte(`
+type: Gateway
+mesh: default
+name: public-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    hostname: api.example.com
+    tags:
+      port: http/8080
+  - port: 9090
+    protocol: HTTP
+    tags:
+      port: http/9090
+`))).To(Succeed())
+		Expect(StoreInlineFixture(rt, []byte(`
+type: Gateway
+mesh: default
+name: tcp-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: TCP
+    tags:
+      port: tcp/8080
+`))).To(Succeed())
+
+		// when
+		inspection, err := gateway.InspectHosts(context.Background(), rt.ReadOnlyResourceManager(), "default", "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inspection).To(Equal(&gateway.HostsInspection{
+			Gateway:  "edge-gateway",
+			Gateways: []string{"edge-gateway", "public-gateway", "tcp-gateway"},
+			Listeners: []gateway.ListenerInspection{
+				{
+					Port:       8080,
+					Protocol:   "HTTP",
+					StatPrefix: "edge-gateway_HTTP_8080",
+					Hosts: []gateway.HostInspection{
+						{
+							Hostname: "api.example.com",
+							Gateway:  "public-gateway",
+							Routes:   []string{},
+						},
+						{
+							Hostname: "*",
+							Gateway:  "edge-gateway",
+							Routes:   []string{},
+						},
+					},
+					UncoveredHostnames: []string{"api.example.com", "*"},
+				},
+				{
+					Port:       9090,
+					Protocol:   "HTTP",
+					StatPrefix: "public-gateway_HTTP_9090",
+					Hosts: []gateway.HostInspection{
+						{
+							Hostname: "*",
+							Gateway:  "public-gateway",
+							Routes:   []string{},
+						},
+					},
+					UncoveredHostnames: []string{"*"},
+				},
+			},
+			Conflicts: []gateway.GatewayConflict{
+				{
+					Gateway: "tcp-gateway",
+					Port:    8080,
+					With:    "edge-gateway",
+					Reason:  "cannot collapse listener protocols TCP and HTTP",
+				},
+			},
+		}))
+	})
+
+	It("should report the hostname conflicts of the listeners", func() {
+		// given a Gateway that bypassed the validation, as it did
+		// before the conflicts were validated
+		conflicting, err := rest.UnmarshallToCore([]byte(`
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    hostnames:
+    - foo.example.com
+    - bar.example.com
+    tags:
+      port: http/8080
+  - port: 8080
+    protocol: HTTP
+    hostname: bar.example.com
+    tags:
+      port: http/8080
+  - port: 9090
+    protocol: HTTP
+    tags:
+      port: http/9090
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rt.ResourceStore().Create(context.Background(), conflicting,
+			store.CreateBy(core_model.MetaToResourceKey(conflicting.GetMeta())))).To(Succeed())
+
+		// when
+		inspection, err := gateway.InspectHosts(context.Background(), rt.ReadOnlyResourceManager(), "default", "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inspection).To(Equal(&gateway.HostsInspection{
+			Gateway:   "edge-gateway",
+			Listeners: []gateway.ListenerInspection{},
+			HostnameConflicts: []gateway.HostnameConflictInspection{
+				{Port: 8080, Hostname: "bar.example.com", Listeners: []int{0, 1}},
+			},
+		}))
+	})
+})
@@ -0,0 +1,428 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_jwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes"
+	routes_v3 "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
+)
+
+// JwksCertificateAuthorityPath is the path of the certificate authorities
+// that verify the HTTPS JWKS and OIDC token URIs by default, in the images
+// of the gateway data plane proxies.
+const JwksCertificateAuthorityPath = "/etc/ssl/certs/ca-certificates.crt"
+
+const jwtAuthnFilterName = "envoy.filters.http.jwt_authn"
+
+// jwtListenerRequirement is the name of the JWT requirement of the
+// requests whose rule doesn't override the authentication.
+const jwtListenerRequirement = "listener"
+
+const (
+	defaultJwksCacheDuration = 5 * time.Minute
+	jwksFetchTimeout         = 5 * time.Second
+)
+
+// ListenerJwt is the JWT authentication of a listener.
+type ListenerJwt struct {
+	Conf *mesh_proto.Gateway_Listener_Jwt
+
+	// Requirements are the providers that the rules of the
+	// GatewayRoutes of the listener accept, by requirement name.
+	Requirements map[string][]string
+}
+
+// JwtAuthnFilter adds the JWT authentication HTTP filter to the filter
+// chain. The virtual hosts and the routes select which JWT requirement
+// of the filter their requests must meet.
+func JwtAuthnFilter(config *envoy_jwt.JwtAuthentication) envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) error {
+			filter, err := util_proto.MarshalAnyDeterministic(config)
+			if err != nil {
+				return err
+			}
+
+			hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+				Name: jwtAuthnFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: filter,
+				},
+			}}, hcm.HttpFilters...)
+
+			return nil
+		}),
+	)
+}
+
+// jwtAuthentication builds the configuration of the JWT authentication
+// filter of the listener, and the clusters that the remote key sets are
+// fetched from.
+func (g *ListenerGenerator) jwtAuthentication(
+	ctx xds_context.Context,
+	info *GatewayResourceInfo,
+) (*envoy_jwt.JwtAuthentication, *core_xds.ResourceSet, error) {
+	jwt := info.Listener.Jwt
+	resources := core_xds.NewResourceSet()
+	mesh := ctx.Mesh.Resource.Meta.GetName()
+
+	config := &envoy_jwt.JwtAuthentication{
+		Providers:      map[string]*envoy_jwt.JwtProvider{},
+		RequirementMap: map[string]*envoy_jwt.JwtRequirement{},
+		// Preflight requests don't carry credentials.
+		BypassCorsPreflight: true,
+	}
+
+	var names []string
+
+	for _, p := range jwt.Conf.GetProviders() {
+		provider := &envoy_jwt.JwtProvider{
+			Issuer:    p.GetIssuer(),
+			Audiences: p.GetAudiences(),
+			Forward:   p.GetForward(),
+		}
+
+		// The claims are copied to the request headers from the
+		// payload, see VirtualHostJwt.
+		if len(p.GetClaimToHeaders()) > 0 {
+			provider.PayloadInMetadata = p.GetName()
+		}
+
+		if remote := p.GetRemoteJwks(); remote != nil {
+			cluster, err := g.jwksCluster(ctx, info, remote)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to generate JWKS cluster of JWT provider %q", p.GetName())
+			}
+
+			cacheDuration := defaultJwksCacheDuration
+			if d := remote.GetCacheDuration(); d != nil {
+				cacheDuration = d.AsDuration()
+			}
+
+			provider.JwksSourceSpecifier = &envoy_jwt.JwtProvider_RemoteJwks{
+				RemoteJwks: &envoy_jwt.RemoteJwks{
+					HttpUri: &envoy_config_core.HttpUri{
+						Uri: remote.GetUri(),
+						HttpUpstreamType: &envoy_config_core.HttpUri_Cluster{
+							Cluster: cluster.Name,
+						},
+						Timeout: util_proto.Duration(jwksFetchTimeout),
+					},
+					CacheDuration: util_proto.Duration(cacheDuration),
+				},
+			}
+
+			resources.Add(cluster)
+		} else {
+			jwks, err := g.DataSourceLoader.Load(context.Background(), mesh, p.GetLocalJwks())
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to load JWKS of JWT provider %q", p.GetName())
+			}
+
+			provider.JwksSourceSpecifier = &envoy_jwt.JwtProvider_LocalJwks{
+				LocalJwks: &envoy_config_core.DataSource{
+					Specifier: &envoy_config_core.DataSource_InlineString{
+						InlineString: string(jwks),
+					},
+				},
+			}
+		}
+
+		config.Providers[p.GetName()] = provider
+		names = append(names, p.GetName())
+	}
+
+	config.RequirementMap[jwtListenerRequirement] = jwtRequirement(names)
+	for name, providers := range jwt.Requirements {
+		config.RequirementMap[name] = jwtRequirement(providers)
+	}
+
+	return config, resources, nil
+}
+
+// jwksCluster returns the cluster that the key set of the given URI is
+// fetched from.
+func (g *ListenerGenerator) jwksCluster(
+	ctx xds_context.Context,
+	info *GatewayResourceInfo,
+	conf *mesh_proto.Gateway_Listener_Jwt_Provider_RemoteJwks,
+) (*core_xds.Resource, error) {
+	return g.httpUriCluster(ctx, info, conf.GetUri(), conf.GetCertificateAuthority(), envoy_names.GetGatewayJwksClusterName)
+}
+
+// httpUriCluster returns the cluster, named by clusterName, that the
+// gateway sends its own requests to the given URI through. The
+// certificate of HTTPS URIs is verified with the given certificate
+// authority, or the ones of the image.
+func (g *ListenerGenerator) httpUriCluster(
+	ctx xds_context.Context,
+	info *GatewayResourceInfo,
+	uri string,
+	caSource *system_proto.DataSource,
+	clusterName func(host string, port uint32) string,
+) (*core_xds.Resource, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	port := uint32(80)
+	if u.Scheme == "https" {
+		port = 443
+	}
+	if p := u.Port(); p != "" {
+		n, err := strconv.ParseUint(p, 10, 16)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid port %q", p)
+		}
+		port = uint32(n)
+	}
+
+	name := clusterName(u.Hostname(), port)
+	builder := clusters.NewClusterBuilder(info.Proxy.APIVersion).
+		Configure(clusters.DNSCluster(name, u.Hostname(), port))
+
+	if u.Scheme == "https" {
+		ca := &envoy_config_core.DataSource{
+			Specifier: &envoy_config_core.DataSource_Filename{
+				Filename: JwksCertificateAuthorityPath,
+			},
+		}
+
+		if caSource != nil {
+			data, err := g.DataSourceLoader.Load(context.Background(), ctx.Mesh.Resource.Meta.GetName(), caSource)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to load certificate authority")
+			}
+
+			ca = &envoy_config_core.DataSource{
+				Specifier: &envoy_config_core.DataSource_InlineBytes{
+					InlineBytes: data,
+				},
+			}
+		}
+
+		builder.Configure(clusters.ClusterBuilderOptFunc(func(config *clusters.ClusterBuilderConfig) {
+			config.AddV3(&httpUriTLSConfigurer{
+				Hostname: u.Hostname(),
+				CA:       ca,
+			})
+		}))
+	}
+
+	cluster, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResource(name, cluster), nil
+}
+
+// httpUriTLSConfigurer verifies the certificate of the server of a URI.
+type httpUriTLSConfigurer struct {
+	Hostname string
+	CA       *envoy_config_core.DataSource
+}
+
+func (c *httpUriTLSConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	tlsContext, err := util_proto.MarshalAnyDeterministic(&envoy_tls.UpstreamTlsContext{
+		Sni: c.Hostname,
+		CommonTlsContext: &envoy_tls.CommonTlsContext{
+			ValidationContextType: &envoy_tls.CommonTlsContext_ValidationContext{
+				ValidationContext: &envoy_tls.CertificateValidationContext{
+					TrustedCa: c.CA,
+					MatchSubjectAltNames: []*envoy_type_matcher.StringMatcher{{
+						MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+							Exact: c.Hostname,
+						},
+					}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	cluster.TransportSocket = &envoy_config_core.TransportSocket{
+		Name: "envoy.transport_sockets.tls",
+		ConfigType: &envoy_config_core.TransportSocket_TypedConfig{
+			TypedConfig: tlsContext,
+		},
+	}
+
+	return nil
+}
+
+// jwtRequirement requires a token of any of the given providers.
+func jwtRequirement(providers []string) *envoy_jwt.JwtRequirement {
+	if len(providers) == 1 {
+		return &envoy_jwt.JwtRequirement{
+			RequiresType: &envoy_jwt.JwtRequirement_ProviderName{
+				ProviderName: providers[0],
+			},
+		}
+	}
+
+	var requirements []*envoy_jwt.JwtRequirement
+	for _, p := range providers {
+		requirements = append(requirements, jwtRequirement([]string{p}))
+	}
+
+	return &envoy_jwt.JwtRequirement{
+		RequiresType: &envoy_jwt.JwtRequirement_RequiresAny{
+			RequiresAny: &envoy_jwt.JwtRequirementOrList{
+				Requirements: requirements,
+			},
+		},
+	}
+}
+
+// jwtRequirementName returns the name of the JWT requirement that
+// accepts the tokens of the given providers.
+func jwtRequirementName(providers []string) string {
+	return "providers:" + strings.Join(providers, ",")
+}
+
+// jwtRuleProviders returns the providers of the listener that a rule
+// accepts, sorted and without duplicates. Rules can be attached to
+// listeners that don't have some of their providers, those are ignored.
+func jwtRuleProviders(listener *mesh_proto.Gateway_Listener_Jwt, providers []string) []string {
+	known := map[string]bool{}
+	for _, p := range listener.GetProviders() {
+		known[p.GetName()] = true
+	}
+
+	var result []string
+	for _, p := range providers {
+		if known[p] {
+			result = append(result, p)
+			known[p] = false
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// hostJwtRequirements returns the JWT requirements of the rules of the
+// GatewayRoutes of the given hosts.
+func hostJwtRequirements(conf *mesh_proto.Gateway_Listener_Jwt, hosts []GatewayHost) map[string][]string {
+	requirements := map[string][]string{}
+
+	for _, host := range hosts {
+		for _, gatewayRoute := range hostGatewayRoutes(host) {
+			for _, rule := range gatewayRoute.Spec.GetConf().GetHttp().GetRules() {
+				if providers := jwtRuleProviders(conf, rule.GetJwt().GetProviders()); len(providers) > 0 {
+					requirements[jwtRequirementName(providers)] = providers
+				}
+			}
+		}
+	}
+
+	return requirements
+}
+
+// VirtualHostJwt requires the requests of the virtual host to carry a
+// token of any of the providers of the listener, and copies the claims
+// of the tokens to the request headers. The headers are removed from
+// the requests first, so that clients can't set them.
+func VirtualHostJwt(jwt *ListenerJwt) envoy_routes.VirtualHostBuilderOpt {
+	return envoy_routes.AddVirtualHostConfigurer(
+		routes_v3.VirtualHostConfigureFunc(func(vh *envoy_config_route.VirtualHost) error {
+			config, err := util_proto.MarshalAnyDeterministic(&envoy_jwt.PerRouteConfig{
+				RequirementSpecifier: &envoy_jwt.PerRouteConfig_RequirementName{
+					RequirementName: jwtListenerRequirement,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			if vh.TypedPerFilterConfig == nil {
+				vh.TypedPerFilterConfig = map[string]*anypb.Any{}
+			}
+
+			vh.TypedPerFilterConfig[jwtAuthnFilterName] = config
+
+			for _, p := range jwt.Conf.GetProviders() {
+				for _, c := range p.GetClaimToHeaders() {
+					vh.RequestHeadersToRemove = append(vh.RequestHeadersToRemove, c.GetHeader())
+					vh.RequestHeadersToAdd = append(vh.RequestHeadersToAdd, replaceHeader(
+						c.GetHeader(),
+						fmt.Sprintf(`%%DYNAMIC_METADATA(["%s", "%s", "%s"])%%`, jwtAuthnFilterName, p.GetName(), c.GetClaim()),
+					))
+				}
+			}
+
+			return nil
+		}),
+	)
+}
+
+// RouteJwt overrides the JWT requirement of the virtual host for the
+// route.
+func RouteJwt(listener *ListenerJwt, jwt *route.Jwt) route.RouteConfigurer {
+	if listener == nil || jwt == nil {
+		return route.RouteConfigureFunc(nil)
+	}
+
+	perRoute := &envoy_jwt.PerRouteConfig{}
+
+	if jwt.Disabled {
+		perRoute.RequirementSpecifier = &envoy_jwt.PerRouteConfig_Disabled{
+			Disabled: true,
+		}
+	} else {
+		providers := jwtRuleProviders(listener.Conf, jwt.Providers)
+		if len(providers) == 0 {
+			return route.RouteConfigureFunc(nil)
+		}
+
+		perRoute.RequirementSpecifier = &envoy_jwt.PerRouteConfig_RequirementName{
+			RequirementName: jwtRequirementName(providers),
+		}
+	}
+
+	return route.RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		config, err := util_proto.MarshalAnyDeterministic(perRoute)
+		if err != nil {
+			return err
+		}
+
+		if r.TypedPerFilterConfig == nil {
+			r.TypedPerFilterConfig = map[string]*anypb.Any{}
+		}
+
+		r.TypedPerFilterConfig[jwtAuthnFilterName] = config
+
+		return nil
+	})
+}
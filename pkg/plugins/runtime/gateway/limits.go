@@ -0,0 +1,95 @@
+package gateway
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
+)
+
+// ListenerLimits applies the limits of the listener to the filter
+// chain. On HTTP, HTTPS and GRPC listeners, it overrides the defaults
+// of the HTTP connection manager, so it has to be configured after
+// them.
+func ListenerLimits(info *GatewayResourceInfo) envoy_listeners.FilterChainBuilderOpt {
+	limits := info.Listener.Limits
+
+	return envoy_listeners.AddFilterChainConfigurer(
+		v3.FilterChainConfigureFunc(func(chain *envoy_listener.FilterChain) error {
+			var err error
+
+			switch info.Listener.Protocol {
+			case mesh_proto.Gateway_Listener_HTTP,
+				mesh_proto.Gateway_Listener_HTTPS,
+				mesh_proto.Gateway_Listener_GRPC:
+				err = v3.UpdateHTTPConnectionManager(chain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+					if d := limits.GetIdleTimeout(); d != nil {
+						hcm.CommonHttpProtocolOptions.IdleTimeout = d
+					}
+
+					if d := limits.GetRequestTimeout(); d != nil {
+						hcm.RequestTimeout = d
+					}
+
+					if n := limits.GetMaxConcurrentStreams(); n > 0 {
+						hcm.Http2ProtocolOptions.MaxConcurrentStreams = util_proto.UInt32(n)
+					}
+
+					return nil
+				})
+			default:
+				if d := limits.GetIdleTimeout(); d != nil {
+					err = v3.UpdateTCPProxy(chain, func(proxy *envoy_tcp.TcpProxy) error {
+						proxy.IdleTimeout = d
+						return nil
+					})
+				}
+			}
+
+			return err
+		}),
+	)
+}
+
+// ConnectionLimits returns the maximum number of connections of the
+// listeners of the given gateways, by the name of their Envoy listener.
+// Like in MakeGatewayListenerHosts, a port is bound to the first gateway
+// that has listeners on it. Envoy limits the connections of a listener
+// with a runtime key, so the limits are set in the bootstrap configuration.
+func ConnectionLimits(gateways []*core_mesh.GatewayResource) map[string]uint32 {
+	limits := map[string]uint32{}
+	bound := map[uint32]bool{}
+
+	for _, gateway := range gateways {
+		ports := map[uint32]bool{}
+
+		for _, listener := range gateway.Spec.GetConf().GetListeners() {
+			port := listener.GetPort()
+			if bound[port] || ports[port] {
+				continue
+			}
+			ports[port] = true
+
+			if n := listener.GetLimits().GetMaxConnections(); n > 0 {
+				name := envoy_names.GetGatewayListenerName(
+					gateway.Meta.GetName(),
+					listener.GetProtocol().String(),
+					port,
+				)
+				limits[name] = n
+			}
+		}
+
+		for port := range ports {
+			bound[port] = true
+		}
+	}
+
+	return limits
+}
@@ -1,20 +1,30 @@
 package gateway
 
 import (
+	"context"
+	"net/http"
 	"time"
 
 	envoy_config_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_jwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	envoy_oauth2 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/oauth2/v3alpha"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/pkg/errors"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/core/datasource"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/tls"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	"github.com/kumahq/kuma/pkg/xds/envoy"
 	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
 	v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
 	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
+	envoy_secrets "github.com/kumahq/kuma/pkg/xds/envoy/secrets/v3"
 )
 
 // TODO(jpeach) It's a lot to ask operators to tune these defaults,
@@ -39,7 +49,10 @@ const DefaultStreamIdleTimeout = 5 * time.Second
 const DefaultIdleTimeout = 5 * time.Minute
 
 // ListenerGenerator generates Kuma gateway listeners.
-type ListenerGenerator struct{}
+type ListenerGenerator struct {
+	DataSourceLoader datasource.Loader
+	Environment      config_core.EnvironmentType
+}
 
 func (*ListenerGenerator) SupportsProtocol(p mesh_proto.Gateway_Listener_Protocol) bool {
 	switch p {
@@ -47,14 +60,15 @@ func (*ListenerGenerator) SupportsProtocol(p mesh_proto.Gateway_Listener_Protoco
 		mesh_proto.Gateway_Listener_TCP,
 		mesh_proto.Gateway_Listener_TLS,
 		mesh_proto.Gateway_Listener_HTTP,
-		mesh_proto.Gateway_Listener_HTTPS:
+		mesh_proto.Gateway_Listener_HTTPS,
+		mesh_proto.Gateway_Listener_GRPC:
 		return true
 	default:
 		return false
 	}
 }
 
-func (*ListenerGenerator) GenerateHost(ctx xds_context.Context, info *GatewayResourceInfo) (*core_xds.ResourceSet, error) {
+func (g *ListenerGenerator) GenerateHost(ctx xds_context.Context, info *GatewayResourceInfo) (*core_xds.ResourceSet, error) {
 	// TODO(jpeach) what we really need to do here is build the
 	// listener once, then generate a HTTP filter chain for each
 	// host on the same HTTPConnectionManager. Each HTTP filter
@@ -64,48 +78,374 @@ func (*ListenerGenerator) GenerateHost(ctx xds_context.Context, info *GatewayRes
 	// listeners.
 	//
 	// https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/advanced/matching/matching_api
-	if info.Resources.Listener != nil {
-		return nil, nil
-	}
-
-	// A Gateway is a single service across all listeners.
-	service := info.Dataplane.Spec.GetIdentifyingService()
-
 	port := info.Listener.Port
 	protocol := info.Listener.Protocol
 	address := info.Dataplane.Spec.GetNetworking().Address
 
-	log.V(1).Info("generating listener",
-		"address", address,
-		"port", port,
-		"protocol", protocol,
-	)
-
 	switch protocol {
-	case mesh_proto.Gateway_Listener_UDP,
-		mesh_proto.Gateway_Listener_TCP,
-		mesh_proto.Gateway_Listener_TLS,
-		mesh_proto.Gateway_Listener_HTTPS:
+	case mesh_proto.Gateway_Listener_UDP:
 		return nil, errors.Errorf("unsupported protocol %q", protocol)
 	}
 
-	filters := envoy_listeners.NewFilterChainBuilder(info.Proxy.APIVersion)
+	var resources *core_xds.ResourceSet
 
-	switch protocol {
-	case mesh_proto.Gateway_Listener_HTTP,
-		mesh_proto.Gateway_Listener_HTTPS:
+	// The JWT authentication is shared by the filter chains of all
+	// the hosts, and the clusters of the remote key sets are the
+	// same for each of them.
+	var jwt *envoy_jwt.JwtAuthentication
+	if info.Listener.Jwt != nil {
+		var err error
+		jwt, resources, err = g.jwtAuthentication(ctx, info)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate JWT authentication")
+		}
+	}
+
+	// Like the JWT authentication, the OIDC authentication is
+	// shared by the filter chains of all the hosts.
+	var oauth2 *envoy_oauth2.OAuth2
+	if info.Listener.Oidc != nil {
+		var oidcResources *core_xds.ResourceSet
+		var err error
+		oauth2, oidcResources, err = g.oidcAuthentication(ctx, info)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate OIDC authentication")
+		}
+
+		if resources == nil {
+			resources = core_xds.NewResourceSet()
+		}
+		resources.AddSet(oidcResources)
+	}
+
+	if info.Resources.Listener == nil {
+		log.V(1).Info("generating listener",
+			"address", address,
+			"port", port,
+			"protocol", protocol,
+		)
+
+		info.Resources.Listener = envoy_listeners.NewListenerBuilder(info.Proxy.APIVersion).
+			Configure(
+				envoy_listeners.InboundListener(
+					envoy_names.GetGatewayListenerName(info.Gateway.Meta.GetName(), protocol.String(), port),
+					address, port, core_xds.SocketAddressProtocolTCP),
+				// Limit default buffering for edge connections.
+				envoy_listeners.ConnectionBufferLimit(DefaultConnectionBuffer),
+				// Roughly balance incoming connections.
+				envoy_listeners.EnableReusePort(true),
+			)
+
+		// Always sniff for TLS, except on TCP listeners. The
+		// inspector waits for the client to send data, which
+		// would stall protocols where the server speaks first.
+		if protocol != mesh_proto.Gateway_Listener_TCP {
+			info.Resources.Listener.Configure(envoy_listeners.TLSInspector())
+		}
+
+		// TODO(jpeach) if proxy protocol is enabled, add the proxy protocol listener filter.
+
+		// HTTP and GRPC listeners have a single filter chain for
+		// all the hosts, and one for each client class.
+		if protocol == mesh_proto.Gateway_Listener_HTTP || protocol == mesh_proto.Gateway_Listener_GRPC {
+			for _, class := range info.Listener.ClientClasses {
+				filters := newPlainHTTPFilterChain(ctx, info, jwt, oauth2, class.Name)
+				filters.Configure(envoy_listeners.MatchSourcePrefixRanges(class.SourceCidrs...))
+
+				info.Resources.Listener.Configure(
+					envoy_listeners.FilterChain(filters),
+				)
+			}
+
+			info.Resources.Listener.Configure(
+				envoy_listeners.FilterChain(
+					newPlainHTTPFilterChain(ctx, info, jwt, oauth2, info.Listener.ResourceName),
+				),
+			)
+		}
+	}
+
+	// For HTTPS, each host that comes from a listener gets its own
+	// filter chain that matches the hostname to the client SNI name
+	// and terminates TLS with the host's certificate. All the filter
+	// chains share the same dynamic HTTP routes, except for the filter
+	// chains of the client classes, that have their own. Envoy selects
+	// the filter chain with the same precedence as the virtual hosts, so
+	// exact hostnames are matched before wildcard ones. Hosts that
+	// come from route hostnames don't have TLS configuration and are
+	// served by the filter chain that matches their name.
+	//
+	// The certificates that are selected by SNI get filter chains
+	// that match their hostnames, so they take precedence over the
+	// filter chain of the host, that serves the other names.
+	if protocol == mesh_proto.Gateway_Listener_HTTPS && info.Host.TLS != nil {
+		for _, c := range info.Host.Certificates {
+			secret, err := g.httpsFilterChain(ctx, info, jwt, oauth2, c.GetCertificate(), c.GetHostnames())
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to configure TLS for hostnames %q", c.GetHostnames())
+			}
+			if secret != nil {
+				if resources == nil {
+					resources = core_xds.NewResourceSet()
+				}
+				resources.Add(NewResource(secret.Name, secret))
+			}
+		}
+
+		if source := info.Host.TLS.GetCertificate(); source != nil {
+			var serverNames []string
+			if info.Host.Hostname != WildcardHostname {
+				serverNames = []string{info.Host.Hostname}
+			}
+
+			secret, err := g.httpsFilterChain(ctx, info, jwt, oauth2, source, serverNames)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to configure TLS for hostname %q", info.Host.Hostname)
+			}
+			if secret != nil {
+				if resources == nil {
+					resources = core_xds.NewResourceSet()
+				}
+				resources.Add(NewResource(secret.Name, secret))
+			}
+		}
+	}
+
+	// TCP listeners get their filter chain from TCPRouteGenerator,
+	// and TLS listeners get a filter chain for each host from
+	// TLSRouteGenerator.
+
+	return resources, nil
+}
+
+// httpsFilterChain adds a filter chain that terminates TLS with the given
+// certificate for the given SNI names, or for any name when there is none,
+// and one for each client class. It returns the SDS secret of the
+// certificate, if the certificate is delivered over SDS.
+func (g *ListenerGenerator) httpsFilterChain(
+	ctx xds_context.Context,
+	info *GatewayResourceInfo,
+	jwt *envoy_jwt.JwtAuthentication,
+	oauth2 *envoy_oauth2.OAuth2,
+	source *system_proto.DataSource,
+	serverNames []string,
+) (*envoy_tls.Secret, error) {
+	cert, err := g.certificate(ctx, source)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load TLS certificate")
+	}
+
+	var ca []byte
+	if source := info.Host.TLS.GetOptions().GetClientCertificateAuthority(); source != nil {
+		ca, err = g.DataSourceLoader.Load(context.Background(), ctx.Mesh.Resource.Meta.GetName(), source)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load TLS client certificate authority")
+		}
+	}
+
+	addFilterChain := func(routeName string, sourceCidrs []string) {
+		filters := newHTTPFilterChain(ctx, info, jwt, oauth2, routeName)
+		if len(serverNames) > 0 {
+			filters.Configure(envoy_listeners.MatchServerNames(serverNames...))
+		}
+
+		if len(sourceCidrs) > 0 {
+			filters.Configure(envoy_listeners.MatchSourcePrefixRanges(sourceCidrs...))
+		}
+
+		// Certificates that can change without a change of the
+		// Gateway are delivered as SDS secrets, so that they are
+		// rotated without draining the connections of the listener.
+		switch {
+		case cert.Secret != nil && ca != nil:
+			filters.Configure(envoy_listeners.ServerSideSdsMTLS(cert.Secret.Name, ca))
+		case cert.Secret != nil:
+			filters.Configure(envoy_listeners.ServerSideSdsTLS(cert.Secret.Name))
+		case ca != nil:
+			filters.Configure(envoy_listeners.ServerSideStaticMTLS(cert.KeyPair, ca))
+		default:
+			filters.Configure(envoy_listeners.ServerSideStaticTLS(cert.KeyPair))
+		}
+
+		info.Resources.Listener.Configure(
+			envoy_listeners.FilterChain(filters),
+		)
+	}
+
+	for _, class := range info.Listener.ClientClasses {
+		addFilterChain(class.Name, class.SourceCidrs)
+	}
+
+	addFilterChain(info.Listener.ResourceName, nil)
+
+	return cert.Secret, nil
+}
+
+// listenerCertificate is the certificate of a HTTPS host. Either the key
+// pair is inlined in the listener, or the certificate is delivered by the
+// secret.
+type listenerCertificate struct {
+	KeyPair *tls.KeyPair
+	Secret  *envoy_tls.Secret
+}
+
+// certificate loads the certificate of the given source. Certificates
+// that are stored in Kuma secrets are delivered as SDS secrets. On Universal,
+// certificate files are read by the data plane proxy, which watches them
+// for changes, so the files have to be on the host of the proxy.
+func (g *ListenerGenerator) certificate(ctx xds_context.Context, source *system_proto.DataSource) (*listenerCertificate, error) {
+	if source.GetFile() != "" && g.Environment == config_core.UniversalEnvironment {
+		name := envoy_names.GetGatewayCertificateSecretName("file", source.GetFile())
+		return &listenerCertificate{
+			Secret: envoy_secrets.CreateFileCertificateSecret(name, source.GetFile()),
+		}, nil
+	}
+
+	data, err := g.DataSourceLoader.Load(context.Background(), ctx.Mesh.Resource.Meta.GetName(), source)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPair, err := tls.ParsePEMKeyPair(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var name string
+	switch {
+	case source.GetSecret() != "":
+		name = envoy_names.GetGatewayCertificateSecretName("secret", source.GetSecret())
+	case source.GetFile() != "":
+		name = envoy_names.GetGatewayCertificateSecretName("file", source.GetFile())
+	default:
+		return &listenerCertificate{KeyPair: keyPair}, nil
+	}
+
+	return &listenerCertificate{
+		Secret: envoy_secrets.CreateCertificateSecret(name, keyPair),
+	}, nil
+}
+
+// newPlainHTTPFilterChain builds the filter chain of a HTTP or GRPC
+// listener that passes requests to the given dynamic routes. Cross-mesh
+// listeners are served over the mTLS of the meshes.
+func newPlainHTTPFilterChain(
+	ctx xds_context.Context,
+	info *GatewayResourceInfo,
+	jwt *envoy_jwt.JwtAuthentication,
+	oauth2 *envoy_oauth2.OAuth2,
+	routeName string,
+) *envoy_listeners.FilterChainBuilder {
+	filters := newHTTPFilterChain(ctx, info, jwt, oauth2, routeName)
+
+	// Forward the SANs of the clients, so that routes can match
+	// the mesh and the service of the client.
+	if info.Listener.CrossMesh {
 		filters.Configure(
-			// Note that even for HTTPS cases, we don't enable client certificate
-			// forwarding. This is because this particular configurer will enable
-			// forwarding for the client certificate URI, which is OK for SPIFFE-
-			// oriented mesh use cases, but unlikely to be appropriate for a
-			// general-purpose gateway.
-			envoy_listeners.HttpConnectionManager(service, false),
-			envoy_listeners.ServerHeader("Kuma Gateway"),
-			envoy_listeners.HttpDynamicRoute(info.Listener.ResourceName),
+			envoy_listeners.ServerSideCrossMeshMTLS(ctx, info.CrossMeshes),
+			envoy_listeners.AddFilterChainConfigurer(
+				v3.HttpConnectionManagerMustConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) {
+					hcm.SetCurrentClientCertDetails = &envoy_hcm.HttpConnectionManager_SetCurrentClientCertDetails{
+						Uri: true,
+					}
+				}),
+			),
 		)
 	}
 
+	return filters
+}
+
+// newHTTPFilterChain builds a filter chain that passes HTTP requests to
+// the given dynamic routes of the listener. The JWT and the OIDC
+// authentications are optional.
+func newHTTPFilterChain(
+	ctx xds_context.Context,
+	info *GatewayResourceInfo,
+	jwt *envoy_jwt.JwtAuthentication,
+	oauth2 *envoy_oauth2.OAuth2,
+	routeName string,
+) *envoy_listeners.FilterChainBuilder {
+	// A Gateway is a single service across all listeners.
+	service := info.Dataplane.Spec.GetIdentifyingService()
+
+	filters := envoy_listeners.NewFilterChainBuilder(info.Proxy.APIVersion)
+
+	filters.Configure(
+		// Note that we don't use the client certificate forwarding
+		// of this configurer, which only forwards the client
+		// certificate URI. That is OK for SPIFFE-oriented mesh use
+		// cases, but unlikely to be appropriate for a general-purpose
+		// gateway, so the header is sanitized below.
+		envoy_listeners.HttpConnectionManager(info.Listener.ResourceName, false),
+		envoy_listeners.ServerHeader("Kuma Gateway"),
+		envoy_listeners.HttpDynamicRoute(routeName),
+		envoy_listeners.HttpTap(ctx.Mesh.Resource.Spec.GetRecording()),
+	)
+
+	if len(info.Listener.Upgrades) > 0 {
+		filters.Configure(HttpUpgrades(info.Listener.Upgrades))
+	}
+
+	// The filters below are prepended, so the cache filter is
+	// configured first to run after them, just before the router.
+	if c := info.Listener.Cache; c != nil {
+		filters.Configure(CacheFilter(c))
+	}
+
+	// Faults are injected after the other filters accepted the
+	// request, so that only requests that would be forwarded fail.
+	if info.Listener.Faulted {
+		filters.Configure(FaultFilter())
+	}
+
+	// Requests are buffered after they are authenticated and
+	// rate limited, so that rejected requests aren't buffered.
+	if info.Listener.Buffered {
+		filters.Configure(BufferFilter())
+	}
+
+	if info.Listener.RateLimited {
+		filters.Configure(LocalRateLimitFilter())
+	}
+
+	if info.Listener.CSRFProtected {
+		filters.Configure(CSRFFilter())
+	}
+
+	// Requests are authenticated before they are rate limited,
+	// but after they pass the firewall and the CORS filter.
+	if jwt != nil {
+		filters.Configure(JwtAuthnFilter(jwt))
+	}
+
+	// Browsers log in before their requests are authenticated, so
+	// that the JWT authentication can verify the forwarded tokens.
+	if oauth2 != nil {
+		filters.Configure(OAuth2Filter(oauth2))
+	}
+
+	// Preflight requests are answered before they are checked
+	// by the other filters.
+	if info.Listener.Cors {
+		filters.Configure(CorsFilter())
+	}
+
+	// The firewall inspects the requests before they are
+	// processed by the other filters.
+	if w := info.Listener.Waf; w != nil {
+		filters.Configure(WafFilter(w))
+	}
+
+	// Extended CONNECT over HTTP/2 is only allowed when a route
+	// of the listener accepts CONNECT requests.
+	allowConnect := false
+	for _, u := range info.Listener.Upgrades {
+		if u == http.MethodConnect {
+			allowConnect = true
+		}
+	}
+
 	// Add edge proxy recommendations.
 	filters.Configure(
 		envoy_listeners.EnablePathNormalization(),
@@ -115,6 +455,15 @@ func (*ListenerGenerator) GenerateHost(ctx xds_context.Context, info *GatewayRes
 				hcm.RequestHeadersTimeout = util_proto.Duration(DefaultRequestHeadersTimeout)
 				hcm.StreamIdleTimeout = util_proto.Duration(DefaultStreamIdleTimeout)
 
+				// Routes match the SANs of the client certificate in
+				// the forwarded header, so clients can't send their
+				// own. It is only set when the certificate is verified.
+				hcm.ForwardClientCertDetails = envoy_hcm.HttpConnectionManager_SANITIZE_SET
+				hcm.SetCurrentClientCertDetails = &envoy_hcm.HttpConnectionManager_SetCurrentClientCertDetails{
+					Uri: true,
+					Dns: true,
+				}
+
 				hcm.CommonHttpProtocolOptions = &envoy_config_core.HttpProtocolOptions{
 					IdleTimeout:                  util_proto.Duration(DefaultIdleTimeout),
 					HeadersWithUnderscoresAction: envoy_config_core.HttpProtocolOptions_REJECT_REQUEST,
@@ -124,62 +473,82 @@ func (*ListenerGenerator) GenerateHost(ctx xds_context.Context, info *GatewayRes
 					MaxConcurrentStreams:        util_proto.UInt32(DefaultConcurrentStreams),
 					InitialStreamWindowSize:     util_proto.UInt32(DefaultInitialStreamWindowSize),
 					InitialConnectionWindowSize: util_proto.UInt32(DefaultInitialConnectionWindowSize),
-					AllowConnect:                true,
+					AllowConnect:                allowConnect,
 				}
 			}),
 		),
+		envoy_listeners.RequestId(ctx.Mesh.Resource.Spec.GetRequestId(), true),
 	)
 
+	// Behind trusted proxies, the client address is the one that
+	// the outermost proxy appended to the forwarded header.
+	if hops := info.Listener.XffNumTrustedHops; hops > 0 {
+		filters.Configure(
+			envoy_listeners.AddFilterChainConfigurer(
+				v3.HttpConnectionManagerMustConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) {
+					hcm.UseRemoteAddress = util_proto.Bool(true)
+					hcm.XffNumTrustedHops = hops
+				}),
+			),
+		)
+	}
+
+	// The limits of the listener override the edge proxy defaults.
+	if info.Listener.Limits != nil {
+		filters.Configure(ListenerLimits(info))
+	}
+
+	// So do the path options, and the traversal check runs before
+	// the other filters, even the firewall.
+	if info.Listener.Paths != nil {
+		filters.Configure(ListenerPaths(info.Listener.Paths))
+	}
+
+	// The access log of the listener takes precedence over the
+	// logging policies.
+	accessLog := info.Proxy.Policies.Logs[service]
+	if conf := info.Listener.AccessLog; conf != nil {
+		accessLog = AccessLogBackend(ctx.Mesh.Resource, conf)
+		filters.Configure(AccessLogMetadataFilter())
+	}
+
 	// Tracing and logging have to be configured after the HttpConnectionManager is enabled.
 	filters.Configure(
 		envoy_listeners.Tracing(info.Proxy.Policies.TracingBackend, service),
+		// Gateway routes can override the sampling, so it is not
+		// specific to any destination service.
+		envoy_listeners.TracingSampling(info.Proxy.Policies.TracingBackend, info.Proxy.Policies.TracingSampling(), ""),
+		envoy_listeners.HeaderPropagation(ctx.Mesh.Resource.Spec.GetHeaderPropagation()),
 		// TODO(jpeach) Logging policy doesn't work at all. The logging backend is
 		// selected by matching against outbound service names, and gateway dataplanes
-		// don't have any of those.
+		// don't have any of those. Listeners can configure their own access log.
 		envoy_listeners.HttpAccessLog(
 			ctx.Mesh.Resource.Meta.GetName(),
 			envoy.TrafficDirectionInbound,
 			service, // Source service is the gateway service.
 			"*",     // Destination service could be anywhere, depending on the routes.
-			info.Proxy.Policies.Logs[service],
+			"",      // Route is selected per request, not per listener.
+			accessLog,
 			info.Proxy,
 		),
 	)
 
-	// TODO(jpeach) add compressor filter.
-	// TODO(jpeach) add decompressor filter.
-	// TODO(jpeach) add grpc_web filter.
-	// TODO(jpeach) add grpc_stats filter.
-
-	info.Resources.Listener = envoy_listeners.NewListenerBuilder(info.Proxy.APIVersion).
-		Configure(
-			envoy_listeners.InboundListener(
-				envoy_names.GetGatewayListenerName(info.Gateway.Meta.GetName(), protocol.String(), port),
-				address, port, core_xds.SocketAddressProtocolTCP),
-			// Limit default buffering for edge connections.
-			envoy_listeners.ConnectionBufferLimit(DefaultConnectionBuffer),
-			// Roughly balance incoming connections.
-			envoy_listeners.EnableReusePort(true),
-			// Always sniff for TLS.
-			envoy_listeners.TLSInspector(),
+	// gRPC clients use cleartext HTTP/2 with prior knowledge, so
+	// there is no HTTP/1 upgrade to negotiate.
+	if info.Listener.Protocol == mesh_proto.Gateway_Listener_GRPC {
+		filters.Configure(
+			envoy_listeners.GrpcStats(),
+			envoy_listeners.AddFilterChainConfigurer(
+				v3.HttpConnectionManagerMustConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) {
+					hcm.CodecType = envoy_hcm.HttpConnectionManager_HTTP2
+				}),
+			),
 		)
-
-	// TODO(jpeach) if proxy protocol is enabled, add the proxy protocol listener filter.
-
-	// Now, for each of the virtual hosts this port, configure the
-	// TLS transport sockets and matching.
-	switch protocol {
-	case mesh_proto.Gateway_Listener_HTTPS:
-		// TODO(jpeach) add a SNI listener to match the hostname
-		// and apply the right set of dynamic HTTP routes.
-	case mesh_proto.Gateway_Listener_TLS:
-		// TODO(jpeach) add a SNI listener to match the hostname
-		// and apply the right set of dynamic TCP or TLS routes.
 	}
 
-	info.Resources.Listener.Configure(
-		envoy_listeners.FilterChain(filters),
-	)
+	// TODO(jpeach) add compressor filter.
+	// TODO(jpeach) add decompressor filter.
+	// TODO(jpeach) add grpc_web filter.
 
-	return nil, nil
+	return filters
 }
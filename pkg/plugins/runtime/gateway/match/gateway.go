@@ -2,28 +2,76 @@ package match
 
 import (
 	"context"
+	"sort"
 
-	"github.com/kumahq/kuma/pkg/core/policy"
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 )
 
-// Gateway selects the matching GatewayResource (if any) for the given DataplaneResorce.
-func Gateway(m manager.ReadOnlyResourceManager, dp *core_mesh.DataplaneResource) *core_mesh.GatewayResource {
+// Gateways selects the GatewayResources that match the given DataplaneResource.
+// They are ordered by precedence: the Gateway with the most specific selector
+// first and, for equally specific selectors, the Gateway created first, so that
+// adding a Gateway doesn't change how the existing ones are bound.
+func Gateways(m manager.ReadOnlyResourceManager, dp *core_mesh.DataplaneResource) []*core_mesh.GatewayResource {
 	gatewayList := &core_mesh.GatewayResourceList{}
 
 	if err := m.List(context.Background(), gatewayList); err != nil {
 		return nil
 	}
 
-	candidates := make([]policy.DataplanePolicy, len(gatewayList.Items))
-	for i, gw := range gatewayList.Items {
-		candidates[i] = gw
+	var gateways []*core_mesh.GatewayResource
+	ranks := map[*core_mesh.GatewayResource]mesh_proto.TagSelectorRank{}
+
+	for _, gw := range gatewayList.Items {
+		if rank, ok := gatewayRank(dp, gw); ok {
+			gateways = append(gateways, gw)
+			ranks[gw] = rank
+		}
+	}
+
+	sort.SliceStable(gateways, func(i, j int) bool {
+		if cmp := ranks[gateways[i]].CompareTo(ranks[gateways[j]]); cmp != 0 {
+			return cmp > 0
+		}
+
+		ti := gateways[i].Meta.GetCreationTime()
+		tj := gateways[j].Meta.GetCreationTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+
+		return gateways[i].Meta.GetName() < gateways[j].Meta.GetName()
+	})
+
+	return gateways
+}
+
+// gatewayRank returns the rank of the most specific selector of the
+// Gateway that matches the dataplane. A Gateway without selectors, or
+// with an empty selector, matches any dataplane with a rank of 0.
+func gatewayRank(dp *core_mesh.DataplaneResource, gw *core_mesh.GatewayResource) (mesh_proto.TagSelectorRank, bool) {
+	if len(gw.Selectors()) == 0 {
+		return mesh_proto.TagSelectorRank{}, true
 	}
 
-	if p := policy.SelectDataplanePolicy(dp, candidates); p != nil {
-		return p.(*core_mesh.GatewayResource)
+	var best mesh_proto.TagSelectorRank
+	matched := false
+
+	for _, selector := range gw.Selectors() {
+		if len(selector.GetMatch()) == 0 {
+			matched = true
+			continue
+		}
+
+		tagSelector := mesh_proto.TagSelector(selector.GetMatch())
+		if dp.Spec.Matches(tagSelector) {
+			matched = true
+			if rank := tagSelector.Rank(); rank.CompareTo(best) > 0 {
+				best = rank
+			}
+		}
 	}
 
-	return nil
+	return best, matched
 }
@@ -2,6 +2,9 @@ package match
 
 import "strings"
 
+// WildcardHostname matches all hostnames.
+const WildcardHostname = "*"
+
 type hostname struct {
 	Host   string
 	Domain string
@@ -23,6 +26,9 @@ func (h *hostname) matches(name string) bool {
 
 func makeHostname(name string) hostname {
 	parts := strings.SplitN(name, ".", 2)
+	if len(parts) < 2 {
+		return hostname{Host: parts[0]}
+	}
 	return hostname{Host: parts[0], Domain: parts[1]}
 }
 
@@ -48,3 +54,45 @@ func Hostnames(target string, matches ...string) bool {
 
 	return false
 }
+
+// hostnamePrecedence returns the precedence class of the hostname. Exact
+// hostnames have the highest precedence, then suffix wildcards and the
+// global wildcard is last.
+func hostnamePrecedence(name string) int {
+	switch {
+	case name == WildcardHostname:
+		return 0
+	case strings.HasPrefix(name, "*."):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// HostnameLess orders hostnames by their matching precedence:
+//
+// 1. Exact hostnames (e.g. "foo.example.com").
+// 2. Suffix wildcards (e.g. "*.example.com"), the ones with more domain
+//    labels first, so "*.foo.example.com" is matched before "*.example.com".
+// 3. The global wildcard "*".
+//
+// This is the same order in which Envoy selects virtual hosts and
+// filter chains for a hostname, so a request is always handled by the
+// first hostname in this order that matches it. Hostnames with the same
+// precedence can't match the same name, they are ordered in reverse
+// lexicographic order to keep the generated configuration stable.
+func HostnameLess(a, b string) bool {
+	pa, pb := hostnamePrecedence(a), hostnamePrecedence(b)
+	if pa != pb {
+		return pa > pb
+	}
+
+	if pa == 1 {
+		la, lb := strings.Count(a, "."), strings.Count(b, ".")
+		if la != lb {
+			return la > lb
+		}
+	}
+
+	return a > b
+}
@@ -0,0 +1,171 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	envoy_config_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_oauth2 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/oauth2/v3alpha"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/pkg/errors"
+
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
+	envoy_secrets "github.com/kumahq/kuma/pkg/xds/envoy/secrets/v3"
+)
+
+const oauth2FilterName = "envoy.filters.http.oauth2"
+
+const (
+	defaultOidcRedirectPath = "/oauth2/callback"
+	defaultOidcSignoutPath  = "/oauth2/signout"
+	oidcTokenTimeout        = 5 * time.Second
+)
+
+// oidcScope is the scope that makes an OAuth2 authorization request an
+// OpenID Connect authentication request.
+const oidcScope = "openid"
+
+// oidcRedirectUri is the URI that the provider redirects the users to
+// after they logged in. It is the redirect path on the host that the
+// users requested, with the scheme that they requested it with.
+const oidcRedirectUri = "%REQ(x-forwarded-proto)%://%REQ(:authority)%"
+
+// OAuth2Filter adds the OAuth2 HTTP filter to the filter chain. It
+// redirects the requests without a valid session to the provider.
+func OAuth2Filter(config *envoy_oauth2.OAuth2) envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) error {
+			filter, err := util_proto.MarshalAnyDeterministic(config)
+			if err != nil {
+				return err
+			}
+
+			hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+				Name: oauth2FilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: filter,
+				},
+			}}, hcm.HttpFilters...)
+
+			return nil
+		}),
+	)
+}
+
+// oidcAuthentication builds the configuration of the OAuth2 filter of
+// the listener, with the cluster of the token endpoint and the SDS
+// secrets of the client and of the session cookies.
+func (g *ListenerGenerator) oidcAuthentication(
+	ctx xds_context.Context,
+	info *GatewayResourceInfo,
+) (*envoy_oauth2.OAuth2, *core_xds.ResourceSet, error) {
+	oidc := info.Listener.Oidc
+	resources := core_xds.NewResourceSet()
+	mesh := ctx.Mesh.Resource.Meta.GetName()
+
+	provider := oidc.GetProvider()
+
+	cluster, err := g.httpUriCluster(ctx, info,
+		provider.GetTokenEndpoint(), provider.GetCertificateAuthority(), envoy_names.GetGatewayOidcClusterName)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate the cluster of the token endpoint")
+	}
+
+	resources.Add(cluster)
+
+	clientSecret, err := g.DataSourceLoader.Load(context.Background(), mesh, oidc.GetClientSecret())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to load the client secret")
+	}
+
+	hmacSecret, err := g.DataSourceLoader.Load(context.Background(), mesh, oidc.GetHmacSecret())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to load the HMAC secret")
+	}
+
+	clientSecretName := envoy_names.GetGatewayOidcSecretName(info.Listener.ResourceName, "client")
+	hmacSecretName := envoy_names.GetGatewayOidcSecretName(info.Listener.ResourceName, "hmac")
+
+	resources.Add(NewResource(clientSecretName, envoy_secrets.CreateGenericSecret(clientSecretName, clientSecret)))
+	resources.Add(NewResource(hmacSecretName, envoy_secrets.CreateGenericSecret(hmacSecretName, hmacSecret)))
+
+	redirectPath := defaultOidcRedirectPath
+	if p := oidc.GetRedirectPath(); p != "" {
+		redirectPath = p
+	}
+
+	signoutPath := defaultOidcSignoutPath
+	if p := oidc.GetSignoutPath(); p != "" {
+		signoutPath = p
+	}
+
+	scopes := []string{oidcScope}
+	for _, s := range oidc.GetScopes() {
+		if s != oidcScope {
+			scopes = append(scopes, s)
+		}
+	}
+
+	credentials := &envoy_oauth2.OAuth2Credentials{
+		ClientId:    oidc.GetClientId(),
+		TokenSecret: adsSecretConfig(clientSecretName),
+		TokenFormation: &envoy_oauth2.OAuth2Credentials_HmacSecret{
+			HmacSecret: adsSecretConfig(hmacSecretName),
+		},
+	}
+
+	config := &envoy_oauth2.OAuth2{
+		Config: &envoy_oauth2.OAuth2Config{
+			TokenEndpoint: &envoy_config_core.HttpUri{
+				Uri: provider.GetTokenEndpoint(),
+				HttpUpstreamType: &envoy_config_core.HttpUri_Cluster{
+					Cluster: cluster.Name,
+				},
+				Timeout: util_proto.Duration(oidcTokenTimeout),
+			},
+			AuthorizationEndpoint: provider.GetAuthorizationEndpoint(),
+			Credentials:           credentials,
+			RedirectUri:           oidcRedirectUri + redirectPath,
+			RedirectPathMatcher:   exactPathMatcher(redirectPath),
+			SignoutPath:           exactPathMatcher(signoutPath),
+			// The backends get the access token of the session.
+			ForwardBearerToken: true,
+			AuthScopes:         scopes,
+		},
+	}
+
+	return config, resources, nil
+}
+
+// adsSecretConfig references the SDS secret of the given name, that is
+// delivered over ADS.
+func adsSecretConfig(name string) *envoy_tls.SdsSecretConfig {
+	return &envoy_tls.SdsSecretConfig{
+		Name: name,
+		SdsConfig: &envoy_config_core.ConfigSource{
+			ResourceApiVersion: envoy_config_core.ApiVersion_V3,
+			ConfigSourceSpecifier: &envoy_config_core.ConfigSource_Ads{
+				Ads: &envoy_config_core.AggregatedConfigSource{},
+			},
+		},
+	}
+}
+
+func exactPathMatcher(path string) *envoy_type_matcher.PathMatcher {
+	return &envoy_type_matcher.PathMatcher{
+		Rule: &envoy_type_matcher.PathMatcher_Path{
+			Path: &envoy_type_matcher.StringMatcher{
+				MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+					Exact: path,
+				},
+			},
+		},
+	}
+}
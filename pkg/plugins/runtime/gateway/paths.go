@@ -0,0 +1,92 @@
+package gateway
+
+import (
+	envoy_config_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+)
+
+const rbacFilterName = "envoy.filters.http.rbac"
+
+// traversalRegex matches the paths that have "." or ".." segments, even
+// percent-encoded, or percent-encoded slashes or backslashes, that could
+// hide such segments from the backends.
+const traversalRegex = `(?i).*((^|/)(\.|%2e){1,2}(/|$)|%2f|%5c).*`
+
+// ListenerPaths applies the path options of the listener to the HTTP
+// connection manager. It overrides the edge proxy defaults, so it has to
+// be configured after them.
+func ListenerPaths(paths *mesh_proto.Gateway_Listener_Paths) envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) error {
+			if v := paths.GetMergeSlashes(); v != nil {
+				hcm.MergeSlashes = v.GetValue()
+			}
+
+			if v := paths.GetNormalize(); v != nil {
+				hcm.NormalizePath = util_proto.Bool(v.GetValue())
+			}
+
+			if !paths.GetRejectTraversal() {
+				return nil
+			}
+
+			// The traversal segments have to reach the check,
+			// rather than being normalized away.
+			hcm.NormalizePath = util_proto.Bool(false)
+
+			config, err := util_proto.MarshalAnyDeterministic(&envoy_rbac.RBAC{
+				Rules: &envoy_config_rbac.RBAC{
+					Action: envoy_config_rbac.RBAC_DENY,
+					Policies: map[string]*envoy_config_rbac.Policy{
+						"path-traversal": {
+							Permissions: []*envoy_config_rbac.Permission{{
+								Rule: &envoy_config_rbac.Permission_UrlPath{
+									UrlPath: &envoy_type_matcher.PathMatcher{
+										Rule: &envoy_type_matcher.PathMatcher_Path{
+											Path: &envoy_type_matcher.StringMatcher{
+												MatchPattern: &envoy_type_matcher.StringMatcher_SafeRegex{
+													SafeRegex: &envoy_type_matcher.RegexMatcher{
+														Regex: traversalRegex,
+														EngineType: &envoy_type_matcher.RegexMatcher_GoogleRe2{
+															GoogleRe2: &envoy_type_matcher.RegexMatcher_GoogleRE2{},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							}},
+							Principals: []*envoy_config_rbac.Principal{{
+								Identifier: &envoy_config_rbac.Principal_Any{
+									Any: true,
+								},
+							}},
+						},
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			// Traversals are rejected before any other filter
+			// processes the request.
+			hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+				Name: rbacFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: config,
+				},
+			}}, hcm.HttpFilters...)
+
+			return nil
+		}),
+	)
+}
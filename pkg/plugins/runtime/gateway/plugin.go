@@ -1,16 +1,24 @@
 package gateway
 
 import (
+	"time"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server/customization"
 	"github.com/kumahq/kuma/pkg/core"
 	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/xds/generator"
+	"github.com/kumahq/kuma/pkg/xds/secrets"
 	"github.com/kumahq/kuma/pkg/xds/template"
 )
 
 // OriginGateway marks xDS resources generated by this plugin.
 const OriginGateway = "gateway"
 
+// statusUpdateInterval is how often the status of Gateways and
+// GatewayRoutes is refreshed.
+const statusUpdateInterval = 10 * time.Second
+
 var (
 	log = core.Log.WithName("plugin").WithName("runtime").WithName("gateway")
 )
@@ -29,6 +37,17 @@ func (p *plugin) Customize(rt core_runtime.Runtime) error {
 
 	generator.RegisterProfile(ProfileGatewayProxy, NewProxyProfile(rt))
 
+	if apiManager, ok := rt.APIInstaller().(api_server.APIManager); ok {
+		apiManager.Add(NewInspectWebService(rt.ReadOnlyResourceManager(), rt.EnvoyAdminClient()))
+	}
+
+	if err := rt.Add(&StatusUpdater{
+		ResourceManager: rt.ResourceManager(),
+		Interval:        statusUpdateInterval,
+	}); err != nil {
+		return err
+	}
+
 	// TODO(jpeach) As new gateway resources are added, register them here.
 
 	log.Info("registered gateway plugin")
@@ -46,17 +65,24 @@ func NewProxyProfile(rt core_runtime.Runtime) generator.ResourceGenerator {
 		generator.PrometheusEndpointGenerator{},
 		generator.SecretsProxyGenerator{},
 		generator.TracingProxyGenerator{},
+		generator.AccessLogProxyGenerator{},
 		generator.TransparentProxyGenerator{},
 		generator.DNSGenerator{},
 
 		Generator{
 			ResourceManager: rt.ReadOnlyResourceManager(),
+			CaProvider:      secrets.NewCaProvider(rt.CaManagers()),
 			Generators: []GatewayHostGenerator{
 				// The order here matters because generators can
 				// depend on state created by a previous generator.
-				&ListenerGenerator{},
+				&ListenerGenerator{
+					DataSourceLoader: rt.DataSourceLoader(),
+					Environment:      rt.Config().Environment,
+				},
 				&RouteConfigurationGenerator{},
 				&GatewayRouteGenerator{},
+				&TCPRouteGenerator{},
+				&TLSRouteGenerator{},
 				&ConnectionPolicyGenerator{},
 				&ClusterGenerator{
 					DataSourceLoader: rt.DataSourceLoader(),
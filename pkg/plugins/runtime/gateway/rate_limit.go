@@ -0,0 +1,136 @@
+package gateway
+
+import (
+	envoy_config_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	envoy_local_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes"
+	routes_v3 "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
+)
+
+const localRateLimitFilterName = "envoy.filters.http.local_ratelimit"
+
+// remoteAddressDescriptorKey is the descriptor key that Envoy generates
+// for the remote address rate limit action.
+const remoteAddressDescriptorKey = "remote_address"
+
+// LocalRateLimitFilter adds the local rate limit HTTP filter to the
+// filter chain. The filter has no limits of its own, the limits of
+// each listener are configured on its virtual hosts.
+func LocalRateLimitFilter() envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) error {
+			config, err := util_proto.MarshalAnyDeterministic(&envoy_local_ratelimit.LocalRateLimit{
+				StatPrefix: "rate_limit",
+			})
+			if err != nil {
+				return err
+			}
+
+			hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+				Name: localRateLimitFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: config,
+				},
+			}}, hcm.HttpFilters...)
+
+			return nil
+		}),
+	)
+}
+
+// VirtualHostRateLimit applies the rate limit of a listener to a virtual
+// host. Requests are accounted by the limit whose value matches their
+// key, or by the limit of the listener otherwise.
+func VirtualHostRateLimit(conf *mesh_proto.Gateway_Listener_RateLimit) envoy_routes.VirtualHostBuilderOpt {
+	return envoy_routes.AddVirtualHostConfigurer(
+		routes_v3.VirtualHostConfigureFunc(func(vh *envoy_config_route.VirtualHost) error {
+			limit := &envoy_local_ratelimit.LocalRateLimit{
+				StatPrefix:     "rate_limit",
+				TokenBucket:    tokenBucket(conf.GetRequests(), conf.GetInterval()),
+				FilterEnabled:  fullRuntimeFraction("local_rate_limit_enabled"),
+				FilterEnforced: fullRuntimeFraction("local_rate_limit_enforced"),
+			}
+
+			if len(conf.GetLimits()) > 0 {
+				key, action := rateLimitAction(conf.GetKey())
+
+				for _, l := range conf.GetLimits() {
+					limit.Descriptors = append(limit.Descriptors, &envoy_ratelimit.LocalRateLimitDescriptor{
+						Entries: []*envoy_ratelimit.RateLimitDescriptor_Entry{{
+							Key:   key,
+							Value: l.GetValue(),
+						}},
+						TokenBucket: tokenBucket(l.GetRequests(), l.GetInterval()),
+					})
+				}
+
+				vh.RateLimits = append(vh.RateLimits, &envoy_config_route.RateLimit{
+					Actions: []*envoy_config_route.RateLimit_Action{action},
+				})
+			}
+
+			config, err := util_proto.MarshalAnyDeterministic(limit)
+			if err != nil {
+				return err
+			}
+
+			if vh.TypedPerFilterConfig == nil {
+				vh.TypedPerFilterConfig = map[string]*anypb.Any{}
+			}
+
+			vh.TypedPerFilterConfig[localRateLimitFilterName] = config
+
+			return nil
+		}),
+	)
+}
+
+// rateLimitAction returns the action that generates the rate limit
+// descriptor of the key, along with the key of the descriptor entry.
+func rateLimitAction(key *mesh_proto.Gateway_Listener_RateLimit_Key) (string, *envoy_config_route.RateLimit_Action) {
+	if key.GetClientAddress() {
+		return remoteAddressDescriptorKey, &envoy_config_route.RateLimit_Action{
+			ActionSpecifier: &envoy_config_route.RateLimit_Action_RemoteAddress_{
+				RemoteAddress: &envoy_config_route.RateLimit_Action_RemoteAddress{},
+			},
+		}
+	}
+
+	return key.GetHeader(), &envoy_config_route.RateLimit_Action{
+		ActionSpecifier: &envoy_config_route.RateLimit_Action_RequestHeaders_{
+			RequestHeaders: &envoy_config_route.RateLimit_Action_RequestHeaders{
+				HeaderName:    key.GetHeader(),
+				DescriptorKey: key.GetHeader(),
+			},
+		},
+	}
+}
+
+func tokenBucket(requests uint32, interval *durationpb.Duration) *envoy_type.TokenBucket {
+	return &envoy_type.TokenBucket{
+		MaxTokens:     requests,
+		TokensPerFill: util_proto.UInt32(requests),
+		FillInterval:  interval,
+	}
+}
+
+func fullRuntimeFraction(key string) *envoy_config_core.RuntimeFractionalPercent {
+	return &envoy_config_core.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoy_type.FractionalPercent_HUNDRED,
+		},
+		RuntimeKey: key,
+	}
+}
@@ -21,5 +21,8 @@ func init() {
 	// resources from Universal -> Kubernetes and have to deal with namespace
 	// semantics and a lot of other unpleasantness.
 	registry.RegisterType(core_mesh.GatewayResourceTypeDescriptor)
+	registry.RegisterType(core_mesh.GatewayInsightResourceTypeDescriptor)
 	registry.RegisterType(core_mesh.GatewayRouteResourceTypeDescriptor)
+	registry.RegisterType(core_mesh.GatewayRouteInsightResourceTypeDescriptor)
+	registry.RegisterType(core_mesh.WafRuleSetResourceTypeDescriptor)
 }
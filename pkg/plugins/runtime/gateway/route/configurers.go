@@ -2,12 +2,17 @@ package route
 
 import (
 	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 
 	envoy_config_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/pkg/errors"
 
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
 	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes"
@@ -67,6 +72,20 @@ func RouteMatchRegexPath(regex string) RouteConfigurer {
 	})
 }
 
+// RouteMatchConnect updates the route to match CONNECT requests. This
+// replaces any previous path match specification.
+func RouteMatchConnect(connect bool) RouteConfigurer {
+	if !connect {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.Match.PathSpecifier = &envoy_config_route.RouteMatch_ConnectMatcher_{
+			ConnectMatcher: &envoy_config_route.RouteMatch_ConnectMatcher{},
+		}
+	})
+}
+
 // RouteMatchExactHeader appends an exact match for the value of the named HTTP request header.
 func RouteMatchExactHeader(name string, value string) RouteConfigurer {
 	if name == "" || value == "" {
@@ -103,6 +122,44 @@ func RouteMatchRegexHeader(name string, regex string) RouteConfigurer {
 	})
 }
 
+// RouteMatchPrefixHeader appends a prefix match for the value of the named HTTP request header.
+func RouteMatchPrefixHeader(name string, prefix string) RouteConfigurer {
+	if name == "" || prefix == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.Match.Headers = append(r.Match.Headers,
+			&envoy_config_route.HeaderMatcher{
+				Name: name,
+				HeaderMatchSpecifier: &envoy_config_route.HeaderMatcher_PrefixMatch{
+					PrefixMatch: prefix,
+				},
+			},
+		)
+	})
+}
+
+// RouteMatchPresentHeader appends a match on whether the named HTTP
+// request header is present (or absent).
+func RouteMatchPresentHeader(name string, present bool) RouteConfigurer {
+	if name == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.Match.Headers = append(r.Match.Headers,
+			&envoy_config_route.HeaderMatcher{
+				Name: name,
+				HeaderMatchSpecifier: &envoy_config_route.HeaderMatcher_PresentMatch{
+					PresentMatch: true,
+				},
+				InvertMatch: !present,
+			},
+		)
+	})
+}
+
 // RouteMatchExactQuery appends an exact match for the value of the named query parameter.
 func RouteMatchExactQuery(name string, value string) RouteConfigurer {
 	if name == "" || value == "" {
@@ -151,6 +208,44 @@ func RouteMatchRegexQuery(name string, regex string) RouteConfigurer {
 	})
 }
 
+// RouteMatchPresentQuery appends a match on whether the named query
+// parameter is present (or absent).
+func RouteMatchPresentQuery(name string, present bool) RouteConfigurer {
+	if name == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	// Envoy query parameter matches can't be inverted, so absent
+	// parameters are matched with an inverted match on the query
+	// string of the path pseudo-header.
+	if !present {
+		regex := `^[^?]*\?(.*&)?` + regexp.QuoteMeta(name) + `([=&].*)?$`
+
+		return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+			r.Match.Headers = append(r.Match.Headers,
+				&envoy_config_route.HeaderMatcher{
+					Name: ":path",
+					HeaderMatchSpecifier: &envoy_config_route.HeaderMatcher_SafeRegexMatch{
+						SafeRegexMatch: regexOf(regex),
+					},
+					InvertMatch: true,
+				},
+			)
+		})
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.Match.QueryParameters = append(r.Match.QueryParameters,
+			&envoy_config_route.QueryParameterMatcher{
+				Name: name,
+				QueryParameterMatchSpecifier: &envoy_config_route.QueryParameterMatcher_PresentMatch{
+					PresentMatch: true,
+				},
+			},
+		)
+	})
+}
+
 // RouteAppendRequestHeader appends the given value to the existing values of the given header.
 func RouteAppendRequestHeader(name string, value string) RouteConfigurer {
 	if name == "" || value == "" {
@@ -212,6 +307,65 @@ func RouteReplaceHostHeader(host string) RouteConfigurer {
 	})
 }
 
+// RouteRewritePath rewrites the path of the forwarded request. When
+// the matched prefix is replaced, the route must match a path or a path
+// prefix. Path prefixes are matched in terms of path components, so the
+// slashes following the prefix are replaced too, unless the prefix is
+// a byte-wise prefix. A regular expression substitution applies to the
+// path of any route. It is an error to rewrite the path if the route is
+// not forwarding. The route match and action must be configured
+// beforehand.
+func RouteRewritePath(rewrite *Rewrite, bytePrefix bool) RouteConfigurer {
+	if rewrite == nil || (rewrite.ReplaceFullPath == "" && rewrite.ReplacePrefixMatch == "" && rewrite.ReplaceRegex == nil) {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		if r.GetAction() == nil {
+			return errors.New("cannot configure the path rewrite before the route action")
+		}
+
+		action := r.GetRoute()
+		if action == nil {
+			return nil
+		}
+
+		if rewrite.ReplaceFullPath != "" {
+			action.RegexRewrite = &envoy_type_matcher.RegexMatchAndSubstitute{
+				Pattern:      regexOf(`^.*$`),
+				Substitution: rewrite.ReplaceFullPath,
+			}
+			return nil
+		}
+
+		if re := rewrite.ReplaceRegex; re != nil {
+			action.RegexRewrite = &envoy_type_matcher.RegexMatchAndSubstitute{
+				Pattern:      regexOf(re.Pattern),
+				Substitution: re.Substitution,
+			}
+			return nil
+		}
+
+		switch {
+		case r.GetMatch().GetPath() != "":
+			// The whole path is the matched prefix.
+			action.PrefixRewrite = rewrite.ReplacePrefixMatch
+		case r.GetMatch().GetPrefix() != "" && bytePrefix:
+			action.PrefixRewrite = rewrite.ReplacePrefixMatch
+		case r.GetMatch().GetPrefix() != "":
+			prefix := strings.TrimRight(r.GetMatch().GetPrefix(), "/")
+			action.RegexRewrite = &envoy_type_matcher.RegexMatchAndSubstitute{
+				Pattern:      regexOf("^" + regexp.QuoteMeta(prefix) + "/*"),
+				Substitution: strings.TrimRight(rewrite.ReplacePrefixMatch, "/") + "/",
+			}
+		default:
+			return errors.New("cannot replace the matched prefix of a route that does not match a path prefix")
+		}
+
+		return nil
+	})
+}
+
 // RouteDeleteRequestHeader deletes the given header from the HTTP request.
 func RouteDeleteRequestHeader(name string) RouteConfigurer {
 	if name == "" {
@@ -223,6 +377,55 @@ func RouteDeleteRequestHeader(name string) RouteConfigurer {
 	})
 }
 
+// RouteAppendResponseHeader appends the given value to the existing values of the given header.
+func RouteAppendResponseHeader(name string, value string) RouteConfigurer {
+	if name == "" || value == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.ResponseHeadersToAdd = append(r.ResponseHeadersToAdd,
+			&envoy_config_core.HeaderValueOption{
+				Append: util_proto.Bool(true),
+				Header: &envoy_config_core.HeaderValue{
+					Key:   http.CanonicalHeaderKey(name),
+					Value: value,
+				},
+			},
+		)
+	})
+}
+
+// RouteReplaceResponseHeader replaces all values of the given header with the given value.
+func RouteReplaceResponseHeader(name string, value string) RouteConfigurer {
+	if name == "" || value == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.ResponseHeadersToAdd = append(r.ResponseHeadersToAdd,
+			&envoy_config_core.HeaderValueOption{
+				Append: util_proto.Bool(false),
+				Header: &envoy_config_core.HeaderValue{
+					Key:   http.CanonicalHeaderKey(name),
+					Value: value,
+				},
+			},
+		)
+	})
+}
+
+// RouteDeleteResponseHeader deletes the given header from the HTTP response.
+func RouteDeleteResponseHeader(name string) RouteConfigurer {
+	if name == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.ResponseHeadersToRemove = append(r.ResponseHeadersToRemove, name)
+	})
+}
+
 // RouteMirror enables traffic mirroring on the route. It is an error to enable
 // mirroring if the route is not forwarding. The route action must be configured
 // beforehand.
@@ -262,6 +465,223 @@ func RouteMirror(percent float64, destination Destination) RouteConfigurer {
 	})
 }
 
+// RouteActionAutoHostRewrite replaces the Host header of the forwarded
+// request with the DNS name of the upstream host. Services outside the
+// mesh are generally reached by their own name, not the one the gateway
+// serves. The route action must be configured beforehand, and only
+// forwarding routes are rewritten.
+func RouteActionAutoHostRewrite(enabled bool) RouteConfigurer {
+	if !enabled {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		if action := r.GetRoute(); action != nil {
+			action.HostRewriteSpecifier = &envoy_config_route.RouteAction_AutoHostRewrite{
+				AutoHostRewrite: util_proto.Bool(true),
+			}
+		}
+	})
+}
+
+// RouteActionUpgrades enables the given HTTP upgrade types on the route.
+// CONNECT requests are terminated, and their payload is forwarded to the
+// upstream. The route action must be configured beforehand, and only
+// forwarding routes are upgraded.
+func RouteActionUpgrades(upgrades []string) RouteConfigurer {
+	if len(upgrades) == 0 {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		action := r.GetRoute()
+		if action == nil {
+			return
+		}
+
+		for _, u := range upgrades {
+			upgrade := &envoy_config_route.RouteAction_UpgradeConfig{
+				UpgradeType: u,
+				Enabled:     util_proto.Bool(true),
+			}
+
+			if u == http.MethodConnect {
+				upgrade.ConnectConfig = &envoy_config_route.RouteAction_UpgradeConfig_ConnectConfig{}
+			}
+
+			action.UpgradeConfigs = append(action.UpgradeConfigs, upgrade)
+		}
+	})
+}
+
+// RouteActionRetryPolicy configures the route to retry requests as
+// specified by the Retry policy. The route action must be configured
+// beforehand, and only forwarding routes are retried.
+func RouteActionRetryPolicy(retry *core_mesh.RetryResource, protocol core_mesh.Protocol) RouteConfigurer {
+	policy := envoy_listeners.RetryPolicy(retry, protocol)
+	if policy == nil {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		if action := r.GetRoute(); action != nil {
+			action.RetryPolicy = policy
+		}
+	})
+}
+
+// RouteActionTimeout configures the request timeouts of the route as
+// specified by the Timeout policy. The connection timeouts are
+// configured on the cluster. The route action must be configured
+// beforehand, and only forwarding routes have timeouts.
+func RouteActionTimeout(timeout *core_mesh.TimeoutResource, protocol core_mesh.Protocol) RouteConfigurer {
+	if timeout == nil {
+		return RouteConfigureFunc(nil)
+	}
+
+	conf := timeout.Spec.GetConf()
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		action := r.GetRoute()
+		if action == nil {
+			return
+		}
+
+		switch protocol {
+		case core_mesh.ProtocolGRPC:
+			if idle := conf.GetGrpc().GetStreamIdleTimeout(); idle != nil {
+				action.IdleTimeout = idle
+			}
+		default:
+			if request := conf.GetHttp().GetRequestTimeout(); request != nil {
+				action.Timeout = request
+			}
+		}
+	})
+}
+
+// RouteActionIncludeVirtualHostRateLimits configures the route to also
+// apply the rate limit actions of its virtual host. The route action
+// must be configured beforehand, and only forwarding routes are changed.
+func RouteActionIncludeVirtualHostRateLimits(include bool) RouteConfigurer {
+	if !include {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		if action := r.GetRoute(); action != nil {
+			action.IncludeVhRateLimits = util_proto.Bool(true)
+		}
+	})
+}
+
+// RouteActionStickyCookie records the destination that the request is
+// forwarded to in a cookie of the response. The cookie value is the name
+// of the destination cluster, see StickyCookieRegex. The route action
+// must be configured beforehand, and only forwarding routes set the
+// cookie.
+func RouteActionStickyCookie(sticky *Sticky) RouteConfigurer {
+	if sticky == nil {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		for _, c := range r.GetRoute().GetWeightedClusters().GetClusters() {
+			cookie := (&http.Cookie{
+				Name:     sticky.Cookie,
+				Value:    c.GetName(),
+				Path:     "/",
+				MaxAge:   int(sticky.Ttl.Seconds()),
+				HttpOnly: true,
+			}).String()
+
+			c.ResponseHeadersToAdd = append(c.ResponseHeadersToAdd,
+				&envoy_config_core.HeaderValueOption{
+					Append: util_proto.Bool(true),
+					Header: &envoy_config_core.HeaderValue{
+						Key:   "Set-Cookie",
+						Value: cookie,
+					},
+				},
+			)
+		}
+	})
+}
+
+// StickyCookieRegex returns a regex that matches a Cookie header value
+// that records the destination cluster in the sticky cookie.
+func StickyCookieRegex(sticky *Sticky, clusterName string) string {
+	return `^(.*;\s*)?` + regexp.QuoteMeta(sticky.Cookie) + "=" + regexp.QuoteMeta(clusterName) + `(;.*)?$`
+}
+
+// RouteActionCors applies the CORS policy to the route. The route action
+// must be configured beforehand, and only forwarding routes are changed.
+func RouteActionCors(cors *Cors) RouteConfigurer {
+	if cors == nil {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		action := r.GetRoute()
+		if action == nil {
+			return
+		}
+
+		policy := &envoy_config_route.CorsPolicy{
+			AllowMethods:  strings.Join(cors.AllowMethods, ","),
+			AllowHeaders:  strings.Join(cors.AllowHeaders, ","),
+			ExposeHeaders: strings.Join(cors.ExposeHeaders, ","),
+		}
+
+		// Envoy allows any origin when a matcher matches "*".
+		for _, o := range cors.AllowOrigins {
+			policy.AllowOriginStringMatch = append(policy.AllowOriginStringMatch,
+				&envoy_type_matcher.StringMatcher{
+					MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+						Exact: o,
+					},
+				},
+			)
+		}
+
+		if cors.MaxAge > 0 {
+			policy.MaxAge = strconv.Itoa(int(cors.MaxAge.Seconds()))
+		}
+
+		if cors.AllowCredentials {
+			policy.AllowCredentials = util_proto.Bool(true)
+		}
+
+		action.Cors = policy
+	})
+}
+
+// RouteName sets the name of the route, which access logs report as
+// the route that matched the request.
+func RouteName(name string) RouteConfigurer {
+	if name == "" {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.Name = name
+	})
+}
+
+// RouteTracing overrides the sampling of traced requests of the
+// route.
+func RouteTracing(tracing *Tracing) RouteConfigurer {
+	if tracing == nil {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		r.Tracing = &envoy_config_route.Tracing{
+			RandomSampling: envoy_listeners.ConvertPercentage(util_proto.Double(tracing.Percentage)),
+		}
+	})
+}
+
 // RouteActionRedirect configures the route to automatically response
 // with a HTTP redirection. This replaces any previous action specification.
 func RouteActionRedirect(redirect *Redirection) RouteConfigurer {
@@ -300,6 +720,33 @@ func RouteActionRedirect(redirect *Redirection) RouteConfigurer {
 	})
 }
 
+// RouteActionRespond configures the route to respond to requests with
+// the status code and body of the response. This replaces any previous
+// action specification.
+func RouteActionRespond(response *Response) RouteConfigurer {
+	if response == nil {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteMustConfigureFunc(func(r *envoy_config_route.Route) {
+		respond := &envoy_config_route.DirectResponseAction{
+			Status: response.Status,
+		}
+
+		if response.Body != "" {
+			respond.Body = &envoy_config_core.DataSource{
+				Specifier: &envoy_config_core.DataSource_InlineString{
+					InlineString: response.Body,
+				},
+			}
+		}
+
+		r.Action = &envoy_config_route.Route_DirectResponse{
+			DirectResponse: respond,
+		}
+	})
+}
+
 // RouteActionForward configures the route to forward traffic to the
 // given destinations, with the appropriate weights. This replaces any
 // previous action specification.
@@ -327,10 +774,20 @@ func RouteActionForward(destinations []Destination) RouteConfigurer {
 			}
 		}
 
+		var names []string
+		for n := range byName {
+			names = append(names, n)
+		}
+
+		// Sort the clusters so that the route doesn't change with
+		// the map iteration order.
+		sort.Strings(names)
+
 		var total uint32
 		var weights []*envoy_config_route.WeightedCluster_ClusterWeight
 
-		for n, d := range byName {
+		for _, n := range names {
+			d := byName[n]
 			total += d.Weight
 			weights = append(weights, &envoy_config_route.WeightedCluster_ClusterWeight{
 				Name:   n,
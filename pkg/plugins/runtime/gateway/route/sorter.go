@@ -11,7 +11,42 @@ func (s Sorter) Len() int {
 }
 
 func (s Sorter) Less(i, j int) bool {
-	return isMoreSpecific(&s[i].Match, &s[j].Match)
+	lhs, rhs := &s[i], &s[j]
+
+	switch {
+	case isMoreSpecific(&lhs.Match, &rhs.Match):
+		return true
+	case isMoreSpecific(&rhs.Match, &lhs.Match):
+		return false
+	case lhs.Priority != rhs.Priority:
+		return lhs.Priority > rhs.Priority
+	// Entries that match more properties of the clients come
+	// before the entries that match the same requests from more
+	// clients.
+	case clientMatches(lhs.Client) != clientMatches(rhs.Client):
+		return clientMatches(lhs.Client) > clientMatches(rhs.Client)
+	}
+
+	// Sorting on equal-specificity paths helps to mitigate YAML
+	// element ordering errors in tests.
+	return lhs.Match.ExactPath+lhs.Match.PrefixPath+lhs.Match.RegexPath <
+		rhs.Match.ExactPath+rhs.Match.PrefixPath+rhs.Match.RegexPath
+}
+
+// clientMatches returns the number of properties of the clients
+// that the given client matches.
+func clientMatches(c *Client) int {
+	n := 0
+
+	if c != nil && len(c.SourceCidrs) > 0 {
+		n++
+	}
+
+	if c != nil && len(c.CertificateSans) > 0 {
+		n++
+	}
+
+	return n
 }
 
 func (s Sorter) Swap(i, j int) {
@@ -70,18 +105,22 @@ func isMoreSpecific(lhs *Match, rhs *Match) bool {
 		return true
 	}
 
+	if len(lhs.PrefixHeader) > len(rhs.PrefixHeader) {
+		return true
+	}
+
 	if (len(lhs.RegexHeader) + len(lhs.RegexQuery)) >
 		(len(rhs.RegexHeader) + len(rhs.RegexQuery)) {
 		return true
 	}
 
-	// NOTE: this is a partial ordering, since we don't (yet?) order on
-	// the contents of the non-path match criteria. This means there
-	// is still a chance of churning Envoy config with changes that
-	// differ only in route order.
+	if (len(lhs.PresentHeader) + len(lhs.AbsentHeader) + len(lhs.PresentQuery) + len(lhs.AbsentQuery)) >
+		(len(rhs.PresentHeader) + len(rhs.AbsentHeader) + len(rhs.PresentQuery) + len(rhs.AbsentQuery)) {
+		return true
+	}
 
-	// Sorting on equal-specificity paths helps to mitigate YAML
-	// element ordering errors in tests.
-	return lhs.ExactPath+lhs.PrefixPath+lhs.RegexPath <
-		rhs.ExactPath+rhs.PrefixPath+rhs.RegexPath
+	// NOTE: this is a partial ordering, since we don't (yet?) order on
+	// the contents of the non-path match criteria. Entries that are
+	// equally specific are ordered by their priority.
+	return false
 }
@@ -1,6 +1,8 @@
 package route
 
 import (
+	"time"
+
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/xds/envoy"
 )
@@ -25,15 +27,59 @@ type Table struct {
 // and dispatched according to the Action. Other optional field specify
 // additional processing.
 type Entry struct {
+	// Route is the name of the route resource the entry comes from.
+	Route string
+
+	// Priority orders the entries that are equally specific. Entries
+	// with a higher priority are matched first.
+	Priority uint32
+
 	Match  Match
 	Action Action
 
-	// Mirror specifies whether to mirror matching traffic.
-	Mirror *Mirror
+	// Mirrors specifies the destinations to which matching traffic
+	// is mirrored.
+	Mirrors []Mirror
 
 	// RequestHeaders specifies transformations on the HTTP
 	// request headers.
 	RequestHeaders *Headers
+
+	// ResponseHeaders specifies transformations on the HTTP
+	// response headers.
+	ResponseHeaders *Headers
+
+	// Rewrite specifies transformations on the HTTP request path
+	// and host.
+	Rewrite *Rewrite
+
+	// Tracing specifies how to trace matching traffic.
+	Tracing *Tracing
+
+	// Upgrades are the HTTP upgrade types, e.g. "websocket" or
+	// "CONNECT", that are enabled for matching requests.
+	Upgrades []string
+
+	// Sticky specifies the cookie that keeps clients on the
+	// destination they were first forwarded to.
+	Sticky *Sticky
+
+	// Cors specifies which cross-origin requests are allowed.
+	Cors *Cors
+
+	// Jwt overrides the JWT authentication of the listener.
+	Jwt *Jwt
+
+	// Buffer specifies that request bodies are buffered before
+	// they are forwarded.
+	Buffer *Buffer
+
+	// Fault specifies the faults that are injected into matching
+	// requests.
+	Fault *Fault
+
+	// Client restricts the clients whose requests are matched.
+	Client *Client
 }
 
 // KeyValue is a generic pairing of key and value strings. Route table
@@ -58,20 +104,39 @@ type Match struct {
 	PrefixPath string
 	RegexPath  string
 
+	// BytePrefix is set when PrefixPath matches the beginning of the
+	// path byte-wise, rather than whole path components.
+	BytePrefix bool
+
 	Method string
 
-	ExactHeader []KeyValue // name -> value
-	RegexHeader []KeyValue // name -> regex
+	// Connect matches CONNECT requests, which don't have a path.
+	Connect bool
+
+	ExactHeader   []KeyValue // name -> value
+	RegexHeader   []KeyValue // name -> regex
+	PrefixHeader  []KeyValue // name -> prefix
+	PresentHeader []string   // names
+	AbsentHeader  []string   // names
 
-	ExactQuery []KeyValue // param -> value
-	RegexQuery []KeyValue // param -> regex
+	ExactQuery   []KeyValue // param -> value
+	RegexQuery   []KeyValue // param -> regex
+	PresentQuery []string   // params
+	AbsentQuery  []string   // params
 }
 
 // Action describes how a HTTP request should be dispatched.
 type Action struct {
 	Forward  []Destination
 	Redirect *Redirection
-	Respond  struct{} // TODO(jpeach) add DirectResponseAction support
+	Respond  *Response
+}
+
+// Response is an action that responds to a HTTP request directly,
+// without forwarding it.
+type Response struct {
+	Status uint32 // HTTP status code.
+	Body   string // Response body (optional).
 }
 
 // Redirection is an action that responds to a HTTP request with a HTTP
@@ -92,7 +157,10 @@ type Destination struct {
 	Weight      uint32
 
 	// Kuma connection policies for traffic forwarded to
-	// this destination.
+	// this destination. The policies are matched on the
+	// destination tags by the ConnectionPolicyGenerator, and
+	// applied to the destination cluster and to the routes
+	// that forward to it.
 	Policies map[model.ResourceType]model.Resource
 }
 
@@ -107,6 +175,89 @@ type Headers struct {
 	Delete []string
 }
 
+// Rewrite specifies how to rewrite a HTTP request before it is forwarded.
+type Rewrite struct {
+	ReplaceFullPath    string        // Path that replaces the whole path (optional).
+	ReplacePrefixMatch string        // Path that replaces the matched prefix (optional).
+	ReplaceRegex       *RegexReplace // Regex substitution of the path (optional).
+	Host               string        // Host header value (optional).
+}
+
+// RegexReplace replaces the matches of Pattern in the path with
+// Substitution.
+type RegexReplace struct {
+	Pattern      string
+	Substitution string
+}
+
+// Tracing specifies the sampling of traced requests. It overrides
+// the sampling of the listener.
+type Tracing struct {
+	Percentage float64
+}
+
+// Sticky specifies the cookie that records the destination a client is
+// forwarded to.
+type Sticky struct {
+	Cookie string
+	Ttl    time.Duration // Lifetime of the cookie (optional).
+}
+
+// Cors is a CORS policy.
+type Cors struct {
+	AllowOrigins     []string // Origins, "*" allows any origin.
+	AllowMethods     []string
+	AllowHeaders     []string
+	ExposeHeaders    []string
+	MaxAge           time.Duration // Preflight cache duration (optional).
+	AllowCredentials bool
+}
+
+// Jwt specifies the JWT providers whose tokens are accepted.
+type Jwt struct {
+	Disabled  bool     // Whether requests are not authenticated.
+	Providers []string // Provider names, any of them is accepted.
+}
+
+// Buffer specifies the request buffering of a route.
+type Buffer struct {
+	MaxRequestBytes uint32 // Largest accepted request body.
+}
+
+// Fault specifies the faults injected into the requests of a route. Each
+// fault is injected into the given percentage of the requests.
+type Fault struct {
+	Delay             *FaultDelay     // Delay before forwarding (optional).
+	Abort             *FaultAbort     // Immediate error response (optional).
+	ResponseBandwidth *FaultBandwidth // Response body rate limit (optional).
+}
+
+// FaultDelay delays requests before they are forwarded.
+type FaultDelay struct {
+	Percentage float64
+	Duration   time.Duration
+}
+
+// FaultAbort responds to requests with an error status.
+type FaultAbort struct {
+	Percentage float64
+	Status     uint32 // HTTP status code.
+}
+
+// FaultBandwidth limits the bandwidth of response bodies.
+type FaultBandwidth struct {
+	Percentage float64
+	Limit      string // Bandwidth, e.g. "100kbps".
+}
+
+// Client specifies the clients whose requests are matched by a route.
+// A client is matched if its address is in any of the CIDR blocks and
+// its certificate has any of the SANs, ignoring the empty lists.
+type Client struct {
+	SourceCidrs     []string // Downstream address CIDR blocks.
+	CertificateSans []string // URI or DNS SANs of the client certificate.
+}
+
 // Mirror specifies a traffic mirroring operation.
 type Mirror struct {
 	Forward    Destination
@@ -12,11 +12,9 @@ type RouteConfigurationGenerator struct{}
 
 func (*RouteConfigurationGenerator) SupportsProtocol(p mesh_proto.Gateway_Listener_Protocol) bool {
 	switch p {
-	case mesh_proto.Gateway_Listener_UDP,
-		mesh_proto.Gateway_Listener_TCP,
-		mesh_proto.Gateway_Listener_TLS,
-		mesh_proto.Gateway_Listener_HTTP,
-		mesh_proto.Gateway_Listener_HTTPS:
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS,
+		mesh_proto.Gateway_Listener_GRPC:
 		return true
 	default:
 		return false
@@ -28,15 +26,36 @@ func (*RouteConfigurationGenerator) GenerateHost(ctx xds_context.Context, info *
 		return nil, nil
 	}
 
-	info.Resources.RouteConfiguration = envoy_routes.NewRouteConfigurationBuilder(info.Proxy.APIVersion).
+	info.Resources.RouteConfiguration = newRouteConfiguration(ctx, info, info.Listener.ResourceName)
+
+	// Each client class has its own routes, with the same
+	// configuration.
+	for _, class := range info.Listener.ClientClasses {
+		info.Resources.ClientRouteConfigurations = append(info.Resources.ClientRouteConfigurations,
+			newRouteConfiguration(ctx, info, class.Name))
+	}
+
+	return nil, nil
+}
+
+// newRouteConfiguration builds a route configuration with the given name
+// for the listener.
+func newRouteConfiguration(ctx xds_context.Context, info *GatewayResourceInfo, name string) *envoy_routes.RouteConfigurationBuilder {
+	routeConfiguration := envoy_routes.NewRouteConfigurationBuilder(info.Proxy.APIVersion).
 		Configure(
-			envoy_routes.CommonRouteConfiguration(info.Listener.ResourceName),
+			envoy_routes.CommonRouteConfiguration(name),
 			// TODO(jpeach) propagate merged listener tags.
 			// envoy_routes.TagsHeader(...),
 			envoy_routes.ResetTagsHeader(),
+			envoy_routes.RequestIdHeader(ctx.Mesh.Resource.Spec.GetRequestId()),
+			envoy_routes.RemoveRequestHeaders(ctx.Mesh.Resource.Spec.GetHeaderPropagation().StrippedAtGateways()...),
 		)
 
+	if info.Listener.SecurityHeaders {
+		routeConfiguration.Configure(MostSpecificHeaderMutationsWins())
+	}
+
 	// TODO(jpeach) apply additional route configuration configuration.
 
-	return nil, nil
+	return routeConfiguration
 }
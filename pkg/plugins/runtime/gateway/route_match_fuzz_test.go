@@ -0,0 +1,230 @@
+package gateway
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+// The route match fuzzer generates random HTTP rules and request paths,
+// and checks that the first route of the sorted route table, which is
+// the route that Envoy picks, forwards to the rule that the GatewayRoute
+// semantics select:
+//
+//   - exact matches win over prefix matches,
+//   - prefixes match whole path components, and the longest one wins,
+//   - of the rules that match the same path, the one with the highest
+//     priority wins, or the last one if their priorities are equal.
+//
+// The paths are built from a few segments, so that the rules often share
+// a path or a prefix, including prefixes that only match bytes ("/a" and
+// "/ab").
+
+const fuzzIterations = 500
+
+var fuzzSegments = []string{"a", "b", "ab"}
+
+// fuzzMatch is a path match of a rule.
+type fuzzMatch struct {
+	rule     int
+	exact    bool
+	path     string
+	priority uint32
+}
+
+func (m fuzzMatch) String() string {
+	kind := "prefix"
+	if m.exact {
+		kind = "exact"
+	}
+
+	return fmt.Sprintf("rule-%d %s %s priority %d", m.rule, kind, m.path, m.priority)
+}
+
+// prefix returns the prefix that is matched in terms of path components,
+// without its trailing slash.
+func (m fuzzMatch) prefix() string {
+	return strings.TrimRight(m.path, "/")
+}
+
+func (m fuzzMatch) matches(path string) bool {
+	if m.exact {
+		return m.path == path
+	}
+
+	return m.prefix() == "" || path == m.prefix() || strings.HasPrefix(path, m.prefix()+"/")
+}
+
+// beats returns whether the match is selected over the other match when
+// they both match a request.
+func (m fuzzMatch) beats(other fuzzMatch) bool {
+	switch {
+	case m.exact != other.exact:
+		return m.exact
+	case !m.exact && len(m.prefix()) != len(other.prefix()):
+		return len(m.prefix()) > len(other.prefix())
+	default:
+		// Matches are visited in order, so equal priorities
+		// select the last match.
+		return m.priority >= other.priority
+	}
+}
+
+func fuzzPath(r *rand.Rand) string {
+	segments := make([]string, 1+r.Intn(3))
+	for i := range segments {
+		segments[i] = fuzzSegments[r.Intn(len(fuzzSegments))]
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+func fuzzMatches(r *rand.Rand) []fuzzMatch {
+	var matches []fuzzMatch
+
+	rules := 1 + r.Intn(8)
+	for rule := 0; rule < rules; rule++ {
+		priority := uint32(r.Intn(3))
+
+		n := 1 + r.Intn(2)
+		for i := 0; i < n; i++ {
+			m := fuzzMatch{
+				rule:     rule,
+				exact:    r.Intn(2) == 0,
+				path:     fuzzPath(r),
+				priority: priority,
+			}
+
+			if !m.exact && r.Intn(8) == 0 {
+				m.path = "/"
+			}
+
+			matches = append(matches, m)
+		}
+	}
+
+	return matches
+}
+
+func fuzzGatewayRoute(matches []fuzzMatch) *core_mesh.GatewayRouteResource {
+	var rules []*mesh_proto.GatewayRoute_HttpRoute_Rule
+
+	for _, m := range matches {
+		if m.rule == len(rules) {
+			rules = append(rules, &mesh_proto.GatewayRoute_HttpRoute_Rule{
+				Priority: m.priority,
+				Backends: []*mesh_proto.GatewayRoute_Backend{{
+					Weight: 1,
+					Destination: map[string]string{
+						mesh_proto.ServiceTag: fmt.Sprintf("rule-%d", m.rule),
+					},
+				}},
+			})
+		}
+
+		path := &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+			Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+			Value: m.path,
+		}
+		if m.exact {
+			path.Match = mesh_proto.GatewayRoute_HttpRoute_Match_Path_EXACT
+		}
+
+		rules[m.rule].Matches = append(rules[m.rule].Matches, &mesh_proto.GatewayRoute_HttpRoute_Match{
+			Path: path,
+		})
+	}
+
+	return &core_mesh.GatewayRouteResource{
+		Meta: &test_model.ResourceMeta{Mesh: "default", Name: "fuzz"},
+		Spec: &mesh_proto.GatewayRoute{
+			Conf: &mesh_proto.GatewayRoute_Conf{
+				Route: &mesh_proto.GatewayRoute_Conf_Http{
+					Http: &mesh_proto.GatewayRoute_HttpRoute{
+						Rules: rules,
+					},
+				},
+			},
+		},
+	}
+}
+
+// fuzzRequestPaths returns the request paths that are checked against
+// the rules: the paths of the matches, their subpaths, and random ones.
+func fuzzRequestPaths(r *rand.Rand, matches []fuzzMatch) []string {
+	paths := []string{"/"}
+
+	for _, m := range matches {
+		paths = append(paths, m.path, m.prefix()+"/", m.prefix()+"/"+fuzzSegments[r.Intn(len(fuzzSegments))])
+	}
+
+	for i := 0; i < 10; i++ {
+		paths = append(paths, fuzzPath(r))
+	}
+
+	return paths
+}
+
+// expectedService returns the service of the rule that the GatewayRoute
+// semantics select for the request path.
+func expectedService(matches []fuzzMatch, path string) string {
+	var selected *fuzzMatch
+
+	for i := range matches {
+		m := matches[i]
+		if !m.matches(path) {
+			continue
+		}
+
+		if selected == nil || m.beats(*selected) {
+			selected = &m
+		}
+	}
+
+	if selected == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("rule-%d", selected.rule)
+}
+
+// routedService returns the service that the first route table entry
+// that matches the request path forwards to, like Envoy does.
+func routedService(entries []route.Entry, path string) string {
+	for _, e := range entries {
+		switch {
+		case e.Match.ExactPath != "" && e.Match.ExactPath == path,
+			e.Match.PrefixPath != "" && strings.HasPrefix(path, e.Match.PrefixPath):
+			return e.Action.Forward[0].Destination[mesh_proto.ServiceTag]
+		}
+	}
+
+	return ""
+}
+
+var _ = Describe("Route match fuzzer", func() {
+	It("should route requests to the rule that GatewayRoute semantics select", func() {
+		for seed := int64(1); seed <= fuzzIterations; seed++ {
+			r := rand.New(rand.NewSource(seed))
+
+			matches := fuzzMatches(r)
+
+			entries := makeRouteTableEntries([]*core_mesh.GatewayRouteResource{fuzzGatewayRoute(matches)}, nil, nil)
+			sort.Stable(route.Sorter(entries))
+
+			for _, path := range fuzzRequestPaths(r, matches) {
+				Expect(routedService(entries, path)).To(Equal(expectedService(matches, path)),
+					"seed %d, request path %q, matches %v", seed, path, matches)
+			}
+		}
+	})
+})
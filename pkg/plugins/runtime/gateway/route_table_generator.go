@@ -1,29 +1,58 @@
 package gateway
 
 import (
+	"net/http"
 	"sort"
 	"strings"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes"
+	"github.com/kumahq/kuma/pkg/xds/generator"
 )
 
 // RouteTableGenerator generates Envoy xDS resources gateway routes from
 // the current route table.
 type RouteTableGenerator struct{}
 
-// SupportsProtocol is always true for RouteTableGenerator.
-func (*RouteTableGenerator) SupportsProtocol(mesh_proto.Gateway_Listener_Protocol) bool {
-	return true
+// SupportsProtocol is true for the protocols that are routed with
+// a route configuration.
+func (*RouteTableGenerator) SupportsProtocol(p mesh_proto.Gateway_Listener_Protocol) bool {
+	switch p {
+	case mesh_proto.Gateway_Listener_HTTP,
+		mesh_proto.Gateway_Listener_HTTPS,
+		mesh_proto.Gateway_Listener_GRPC:
+		return true
+	default:
+		return false
+	}
 }
 
 // GenerateHost generates xDS resources for the current route table.
 func (r *RouteTableGenerator) GenerateHost(ctx xds_context.Context, info *GatewayResourceInfo) (*core_xds.ResourceSet, error) {
 	resources := ResourceAggregator{}
 
+	// Sort routing table entries so the most specific match comes first.
+	sort.Stable(route.Sorter(info.RouteTable.Entries))
+
+	info.Resources.RouteConfiguration.Configure(
+		envoy_routes.VirtualHost(makeVirtualHost(info, nil)))
+
+	for i := range info.Listener.ClientClasses {
+		info.Resources.ClientRouteConfigurations[i].Configure(
+			envoy_routes.VirtualHost(makeVirtualHost(info, &info.Listener.ClientClasses[i])))
+	}
+
+	return resources.Get(), nil
+}
+
+// makeVirtualHost builds the virtual host of the current host, with the
+// routes of the given client class, or the routes that don't match
+// source addresses when there is no class.
+func makeVirtualHost(info *GatewayResourceInfo, class *ClientClass) *envoy_routes.VirtualHostBuilder {
 	vh := envoy_routes.NewVirtualHostBuilder(info.Proxy.APIVersion).Configure(
 		// TODO(jpeach) use separator from envoy names package.
 		envoy_routes.CommonVirtualHost(strings.Join([]string{info.Listener.ResourceName, info.Host.Hostname}, ":")),
@@ -35,69 +64,282 @@ func (r *RouteTableGenerator) GenerateHost(ctx xds_context.Context, info *Gatewa
 		vh.Configure(envoy_routes.RequireTLS())
 	}
 
-	// TODO(jpeach) apply additional virtual host configuration.
+	if rl := info.Host.RateLimit; rl != nil {
+		vh.Configure(VirtualHostRateLimit(rl))
+	}
 
-	// Sort routing table entries so the most specific match comes first.
-	sort.Sort(route.Sorter(info.RouteTable.Entries))
+	if sec := info.Host.Security; sec != nil {
+		vh.Configure(VirtualHostSecurity(sec, info.Listener.Protocol))
+	}
+
+	if info.Listener.AccessLog != nil {
+		vh.Configure(VirtualHostAccessLogMetadata(info.Host.Hostname))
+	}
+
+	if jwt := info.Listener.Jwt; jwt != nil {
+		vh.Configure(VirtualHostJwt(jwt))
+	}
+
+	// TODO(jpeach) apply additional virtual host configuration.
 
 	for _, e := range info.RouteTable.Entries {
+		if !clientRouted(info, class, e) {
+			continue
+		}
+
+		// Requests that carry the cookie of a sticky entry go to
+		// the destination that the cookie records, so these routes
+		// have to come before the route of the entry.
+		for _, pinned := range stickyRouteEntries(e) {
+			vh.Configure(route.VirtualHostRoute(makeRouteBuilder(info, pinned)))
+		}
+
+		vh.Configure(route.VirtualHostRoute(makeRouteBuilder(info, e)))
+	}
+
+	// When routes have to be explicit, reject all the requests that
+	// don't match a route, including all the requests to hosts that
+	// don't have any routes. This has to be the last route, after the
+	// sorted entries.
+	if explicit := info.Gateway.Spec.GetConf().GetExplicitRoutes(); explicit != nil {
 		routeBuilder := route.RouteBuilder{}
 
 		routeBuilder.Configure(
-			route.RouteMatchExactPath(e.Match.ExactPath),
-			route.RouteMatchPrefixPath(e.Match.PrefixPath),
-			route.RouteMatchRegexPath(e.Match.RegexPath),
-			route.RouteMatchExactHeader(":method", e.Match.Method),
+			route.RouteMatchPrefixPath("/"),
+			route.RouteActionRespond(&route.Response{
+				Status: explicitRoutesStatus(explicit),
+			}),
+		)
 
-			route.RouteActionRedirect(e.Action.Redirect),
-			route.RouteActionForward(e.Action.Forward),
+		vh.Configure(route.VirtualHostRoute(&routeBuilder))
+	}
+
+	return vh
+}
+
+// makeRouteBuilder builds the Envoy route of a route table entry.
+func makeRouteBuilder(info *GatewayResourceInfo, e route.Entry) *route.RouteBuilder {
+	routeBuilder := &route.RouteBuilder{}
+
+	routeBuilder.Configure(
+		route.RouteMatchExactPath(e.Match.ExactPath),
+		route.RouteMatchPrefixPath(e.Match.PrefixPath),
+		route.RouteMatchRegexPath(e.Match.RegexPath),
+		route.RouteMatchConnect(e.Match.Connect),
+		route.RouteMatchExactHeader(":method", e.Match.Method),
+
+		route.RouteActionRedirect(e.Action.Redirect),
+		route.RouteActionForward(e.Action.Forward),
+		route.RouteActionRespond(e.Action.Respond),
+		route.RouteActionStickyCookie(e.Sticky),
+		route.RouteActionCors(e.Cors),
+
+		route.RouteTracing(e.Tracing),
+		RouteJwt(info.Listener.Jwt, e.Jwt),
+		RouteBuffer(info.Listener.Buffered, e.Buffer),
+		RouteFault(e.Fault),
+	)
+
+	// The gateway replaces the client certificate header, and the
+	// client addresses are only matched in the forwarded header
+	// behind trusted proxies. Otherwise, the filter chain of the
+	// source address selects the routes.
+	if c := e.Client; c != nil {
+		if len(c.CertificateSans) > 0 {
+			routeBuilder.Configure(route.RouteMatchRegexHeader(
+				"x-forwarded-client-cert", certificateSansRegex(c.CertificateSans)))
+		}
+
+		if hops := info.Listener.XffNumTrustedHops; hops > 0 && len(c.SourceCidrs) > 0 {
+			routeBuilder.Configure(route.RouteMatchRegexHeader(
+				"x-forwarded-for", forwardedForRegex(c.SourceCidrs, hops)))
+		}
+	}
+
+	// Route names are only reported by the access log of the listener.
+	if info.Listener.AccessLog != nil {
+		routeBuilder.Configure(route.RouteName(e.Route))
+	}
+
+	// Envoy applies retries and request timeouts per route, not
+	// per weighted cluster, so use the policies of the first
+	// destination, as mesh outbound routes do.
+	if len(e.Action.Forward) > 0 {
+		dest := &e.Action.Forward[0]
+		protocol := routeProtocolFor(info, dest)
+
+		routeBuilder.Configure(
+			route.RouteActionAutoHostRewrite(forwardsToExternalService(info, e.Action.Forward)),
+			route.RouteActionRetryPolicy(retryPolicyFor(dest), protocol),
+			route.RouteActionTimeout(timeoutPolicyFor(dest), protocol),
+			route.RouteActionUpgrades(e.Upgrades),
+			route.RouteActionIncludeVirtualHostRateLimits(
+				len(info.Host.RateLimit.GetLimits()) > 0,
+			),
 		)
+	}
+
+	for _, m := range e.Match.ExactHeader {
+		routeBuilder.Configure(route.RouteMatchExactHeader(m.Key, m.Value))
+	}
+
+	for _, m := range e.Match.RegexHeader {
+		routeBuilder.Configure(route.RouteMatchRegexHeader(m.Key, m.Value))
+	}
+
+	for _, m := range e.Match.PrefixHeader {
+		routeBuilder.Configure(route.RouteMatchPrefixHeader(m.Key, m.Value))
+	}
 
-		for _, m := range e.Match.ExactHeader {
-			routeBuilder.Configure(route.RouteMatchExactHeader(m.Key, m.Value))
+	for _, name := range e.Match.PresentHeader {
+		routeBuilder.Configure(route.RouteMatchPresentHeader(name, true))
+	}
+
+	for _, name := range e.Match.AbsentHeader {
+		routeBuilder.Configure(route.RouteMatchPresentHeader(name, false))
+	}
+
+	for _, m := range e.Match.ExactQuery {
+		routeBuilder.Configure(route.RouteMatchExactQuery(m.Key, m.Value))
+	}
+
+	for _, m := range e.Match.RegexQuery {
+		routeBuilder.Configure(route.RouteMatchRegexQuery(m.Key, m.Value))
+	}
+
+	for _, name := range e.Match.PresentQuery {
+		routeBuilder.Configure(route.RouteMatchPresentQuery(name, true))
+	}
+
+	for _, name := range e.Match.AbsentQuery {
+		routeBuilder.Configure(route.RouteMatchPresentQuery(name, false))
+	}
+
+	if rq := e.RequestHeaders; rq != nil {
+		for _, h := range e.RequestHeaders.Replace {
+			switch h.Key {
+			case ":authority", "Host", "host":
+				routeBuilder.Configure(route.RouteReplaceHostHeader(h.Value))
+			default:
+				routeBuilder.Configure(route.RouteReplaceRequestHeader(h.Key, h.Value))
+			}
 		}
 
-		for _, m := range e.Match.RegexHeader {
-			routeBuilder.Configure(route.RouteMatchRegexHeader(m.Key, m.Value))
+		for _, h := range e.RequestHeaders.Append {
+			routeBuilder.Configure(route.RouteAppendRequestHeader(h.Key, h.Value))
 		}
 
-		for _, m := range e.Match.ExactQuery {
-			routeBuilder.Configure(route.RouteMatchExactQuery(m.Key, m.Value))
+		for _, name := range e.RequestHeaders.Delete {
+			routeBuilder.Configure(route.RouteDeleteRequestHeader(name))
 		}
+	}
 
-		for _, m := range e.Match.RegexQuery {
-			routeBuilder.Configure(route.RouteMatchRegexQuery(m.Key, m.Value))
+	if rs := e.ResponseHeaders; rs != nil {
+		for _, h := range rs.Replace {
+			routeBuilder.Configure(route.RouteReplaceResponseHeader(h.Key, h.Value))
 		}
 
-		if rq := e.RequestHeaders; rq != nil {
-			for _, h := range e.RequestHeaders.Replace {
-				switch h.Key {
-				case ":authority", "Host", "host":
-					routeBuilder.Configure(route.RouteReplaceHostHeader(h.Value))
-				default:
-					routeBuilder.Configure(route.RouteReplaceRequestHeader(h.Key, h.Value))
-				}
-			}
+		for _, h := range rs.Append {
+			routeBuilder.Configure(route.RouteAppendResponseHeader(h.Key, h.Value))
+		}
 
-			for _, h := range e.RequestHeaders.Append {
-				routeBuilder.Configure(route.RouteAppendRequestHeader(h.Key, h.Value))
-			}
+		for _, name := range rs.Delete {
+			routeBuilder.Configure(route.RouteDeleteResponseHeader(name))
+		}
+	}
 
-			for _, name := range e.RequestHeaders.Delete {
-				routeBuilder.Configure(route.RouteDeleteRequestHeader(name))
-			}
+	if rw := e.Rewrite; rw != nil {
+		routeBuilder.Configure(
+			route.RouteRewritePath(rw, e.Match.BytePrefix),
+			route.RouteReplaceHostHeader(rw.Host),
+		)
+	}
+
+	// After configuring the route action, attempt to configure mirroring.
+	// This only affects the forwarding action.
+	for _, m := range e.Mirrors {
+		routeBuilder.Configure(route.RouteMirror(m.Percentage, m.Forward))
+	}
+
+	return routeBuilder
+}
+
+// stickyRouteEntries returns the entries that forward the requests that
+// carry the sticky cookie of the entry to the destination the cookie
+// records. Destinations with a weight of 0 don't get an entry, so that
+// the clients that were assigned to them move to another destination.
+func stickyRouteEntries(e route.Entry) []route.Entry {
+	if e.Sticky == nil || len(e.Action.Forward) < 2 {
+		return nil
+	}
+
+	var entries []route.Entry
+
+	for _, d := range e.Action.Forward {
+		if d.Weight == 0 {
+			continue
 		}
 
-		// After configuring the route action, attempt to configure mirroring.
-		// This only affects the forwarding action.
-		if m := e.Mirror; m != nil {
-			routeBuilder.Configure(route.RouteMirror(m.Percentage, m.Forward))
+		clusterName, err := route.DestinationClusterName(d)
+		if err != nil {
+			continue
 		}
 
-		vh.Configure(route.VirtualHostRoute(&routeBuilder))
+		pinned := e // Shallow copy.
+		pinned.Sticky = nil
+		pinned.Action.Forward = []route.Destination{d}
+		pinned.Match.RegexHeader = append(
+			append([]route.KeyValue{}, e.Match.RegexHeader...),
+			route.Pair("cookie", route.StickyCookieRegex(e.Sticky, clusterName)),
+		)
+
+		entries = append(entries, pinned)
 	}
 
-	info.Resources.RouteConfiguration.Configure(envoy_routes.VirtualHost(vh))
+	return entries
+}
 
-	return resources.Get(), nil
+// explicitRoutesStatus returns the status code of the responses to
+// requests that are denied because they don't match any route.
+func explicitRoutesStatus(explicit *mesh_proto.Gateway_ExplicitRoutes) uint32 {
+	if explicit.GetStatus() == 0 {
+		return http.StatusNotFound
+	}
+
+	return explicit.GetStatus()
+}
+
+// routeProtocolFor returns the protocol used to convert the policies of
+// the destination to route configuration. It defaults to the protocol
+// of the destination cluster.
+func routeProtocolFor(info *GatewayResourceInfo, dest *route.Destination) core_mesh.Protocol {
+	endpoints := []core_xds.Endpoint{{Tags: dest.Destination}}
+
+	// The cluster of an external service takes its protocol from the
+	// tags of the service, which the destination may not select on.
+	if external := externalServicesFor(info, dest); len(external.Items) > 0 {
+		endpoints = nil
+		for _, ext := range external.Items {
+			endpoints = append(endpoints, core_xds.Endpoint{Tags: ext.Spec.GetTags()})
+		}
+	}
+
+	protocol := generator.InferServiceProtocol(endpoints)
+	if protocol == core_mesh.ProtocolUnknown {
+		return defaultClusterProtocol(info.Listener.Protocol)
+	}
+
+	return protocol
+}
+
+// forwardsToExternalService returns whether any of the destinations is
+// an external service.
+func forwardsToExternalService(info *GatewayResourceInfo, destinations []route.Destination) bool {
+	for i := range destinations {
+		if len(externalServicesFor(info, &destinations[i]).Items) > 0 {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,138 @@
+package gateway
+
+import (
+	envoy_config_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_csrf "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/csrf/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes"
+	routes_v3 "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
+)
+
+const csrfFilterName = "envoy.filters.http.csrf"
+
+// Default values of the security headers.
+const (
+	defaultStrictTransportSecurity = "max-age=31536000; includeSubDomains"
+	defaultFrameOptions            = "DENY"
+	defaultContentTypeOptions      = "nosniff"
+)
+
+// CSRFFilter adds the CSRF HTTP filter to the filter chain. The filter
+// is disabled by default, it is enabled on the virtual hosts of the
+// listeners that are protected.
+func CSRFFilter() envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) error {
+			config, err := util_proto.MarshalAnyDeterministic(&envoy_csrf.CsrfPolicy{
+				FilterEnabled: &envoy_config_core.RuntimeFractionalPercent{
+					DefaultValue: &envoy_type.FractionalPercent{
+						Numerator:   0,
+						Denominator: envoy_type.FractionalPercent_HUNDRED,
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+				Name: csrfFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: config,
+				},
+			}}, hcm.HttpFilters...)
+
+			return nil
+		}),
+	)
+}
+
+// MostSpecificHeaderMutationsWins makes the response headers of routes
+// take precedence over the security headers of their virtual host.
+func MostSpecificHeaderMutationsWins() envoy_routes.RouteConfigurationBuilderOpt {
+	return envoy_routes.AddRouteConfigurationConfigurer(
+		routes_v3.RouteConfigurationMustConfigureFunc(func(rc *envoy_config_route.RouteConfiguration) {
+			rc.MostSpecificHeaderMutationsWins = true
+		}),
+	)
+}
+
+// VirtualHostSecurity applies the browser security configuration of a
+// listener to a virtual host. The Strict-Transport-Security header is
+// only added to the responses of HTTPS listeners.
+func VirtualHostSecurity(
+	conf *mesh_proto.Gateway_Listener_Security,
+	protocol mesh_proto.Gateway_Listener_Protocol,
+) envoy_routes.VirtualHostBuilderOpt {
+	return envoy_routes.AddVirtualHostConfigurer(
+		routes_v3.VirtualHostConfigureFunc(func(vh *envoy_config_route.VirtualHost) error {
+			if csrf := conf.GetCsrf(); csrf != nil {
+				policy := &envoy_csrf.CsrfPolicy{
+					FilterEnabled: fullRuntimeFraction("csrf_enabled"),
+				}
+
+				for _, origin := range csrf.GetAdditionalOrigins() {
+					policy.AdditionalOrigins = append(policy.AdditionalOrigins, &envoy_type_matcher.StringMatcher{
+						MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+							Exact: origin,
+						},
+					})
+				}
+
+				config, err := util_proto.MarshalAnyDeterministic(policy)
+				if err != nil {
+					return err
+				}
+
+				if vh.TypedPerFilterConfig == nil {
+					vh.TypedPerFilterConfig = map[string]*anypb.Any{}
+				}
+
+				vh.TypedPerFilterConfig[csrfFilterName] = config
+			}
+
+			if headers := conf.GetHeaders(); headers != nil {
+				if protocol == mesh_proto.Gateway_Listener_HTTPS {
+					vh.ResponseHeadersToAdd = append(vh.ResponseHeadersToAdd, replaceHeader(
+						"Strict-Transport-Security",
+						valueOrDefault(headers.GetStrictTransportSecurity(), defaultStrictTransportSecurity),
+					))
+				}
+
+				vh.ResponseHeadersToAdd = append(vh.ResponseHeadersToAdd,
+					replaceHeader("X-Content-Type-Options", defaultContentTypeOptions),
+					replaceHeader("X-Frame-Options", valueOrDefault(headers.GetFrameOptions(), defaultFrameOptions)),
+				)
+			}
+
+			return nil
+		}),
+	)
+}
+
+func replaceHeader(name string, value string) *envoy_config_core.HeaderValueOption {
+	return &envoy_config_core.HeaderValueOption{
+		Header: &envoy_config_core.HeaderValue{
+			Key:   name,
+			Value: value,
+		},
+		Append: util_proto.Bool(false),
+	}
+}
+
+func valueOrDefault(value string, def string) string {
+	if value == "" {
+		return def
+	}
+
+	return value
+}
@@ -0,0 +1,498 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/match"
+)
+
+const (
+	conditionTrue  = "True"
+	conditionFalse = "False"
+
+	// GatewayConditionReady is set on Gateways that are configured
+	// on their dataplanes.
+	GatewayConditionReady = "Ready"
+
+	// GatewayConditionConflicted is set on Gateways whose listeners
+	// can't be merged with the listeners of other Gateways on the
+	// same dataplanes.
+	GatewayConditionConflicted = "Conflicted"
+
+	// RouteConditionAccepted is set on GatewayRoutes that are attached
+	// to at least one listener.
+	RouteConditionAccepted = "Accepted"
+
+	// RouteConditionResolvedRefs is set on GatewayRoutes whose
+	// backends all match some service.
+	RouteConditionResolvedRefs = "ResolvedRefs"
+)
+
+// MeshStatus is the status of the Gateways and GatewayRoutes of a mesh,
+// indexed by their names.
+type MeshStatus struct {
+	Gateways map[string]*mesh_proto.GatewayInsight
+	Routes   map[string]*mesh_proto.GatewayRouteInsight
+}
+
+// ComputeMeshStatus computes the status of the Gateways and the
+// GatewayRoutes of the mesh, by binding them in the same way as the
+// xDS generator. Each Gateway is bound on the first of its builtin
+// gateway dataplanes, since its dataplanes only differ by address,
+// together with the other Gateways of that dataplane.
+func ComputeMeshStatus(ctx context.Context, rm core_manager.ReadOnlyResourceManager, mesh string) (*MeshStatus, error) {
+	manager := match.ManagerForMesh(rm, mesh)
+
+	gateways := &core_mesh.GatewayResourceList{}
+	routes := &core_mesh.GatewayRouteResourceList{}
+	dataplanes := &core_mesh.DataplaneResourceList{}
+	externalServices := &core_mesh.ExternalServiceResourceList{}
+
+	for _, list := range []model.ResourceList{gateways, routes, dataplanes, externalServices} {
+		if err := manager.List(ctx, list); err != nil {
+			return nil, err
+		}
+	}
+
+	zoneIngresses := &core_mesh.ZoneIngressResourceList{}
+	if err := rm.List(ctx, zoneIngresses); err != nil {
+		return nil, err
+	}
+
+	status := &MeshStatus{
+		Gateways: map[string]*mesh_proto.GatewayInsight{},
+		Routes:   map[string]*mesh_proto.GatewayRouteInsight{},
+	}
+
+	for _, r := range routes.Items {
+		status.Routes[r.Meta.GetName()] = &mesh_proto.GatewayRouteInsight{}
+	}
+
+	sort.Slice(dataplanes.Items, func(i, j int) bool {
+		return dataplanes.Items[i].Meta.GetName() < dataplanes.Items[j].Meta.GetName()
+	})
+
+	for _, dp := range dataplanes.Items {
+		if !dp.Spec.IsBuiltinGateway() {
+			continue
+		}
+
+		gateways := match.Gateways(manager, dp)
+
+		var unbound []*core_mesh.GatewayResource
+		for _, gateway := range gateways {
+			if _, ok := status.Gateways[gateway.Meta.GetName()]; !ok {
+				unbound = append(unbound, gateway)
+			}
+		}
+		if len(unbound) == 0 {
+			continue
+		}
+
+		listeners, conflicts, err := MakeGatewayListenerHosts(manager, gateways, dp)
+		for _, gateway := range unbound {
+			if err != nil {
+				status.Gateways[gateway.Meta.GetName()] = &mesh_proto.GatewayInsight{
+					Conditions: []*mesh_proto.GatewayInsight_Condition{{
+						Type:    GatewayConditionReady,
+						Status:  conditionFalse,
+						Reason:  "Invalid",
+						Message: err.Error(),
+					}},
+				}
+				continue
+			}
+
+			status.Gateways[gateway.Meta.GetName()] = gatewayStatus(gateway, dp, listeners, conflicts, status.Routes)
+		}
+	}
+
+	for _, g := range gateways.Items {
+		if _, ok := status.Gateways[g.Meta.GetName()]; !ok {
+			status.Gateways[g.Meta.GetName()] = &mesh_proto.GatewayInsight{
+				Conditions: []*mesh_proto.GatewayInsight_Condition{{
+					Type:    GatewayConditionReady,
+					Status:  conditionFalse,
+					Reason:  "NoDataplanes",
+					Message: "no builtin gateway dataplane is selected by the Gateway",
+				}},
+			}
+		}
+	}
+
+	resolved := func(destination map[string]string) bool {
+		return backendExists(mesh, destination, dataplanes, externalServices, zoneIngresses)
+	}
+
+	for _, r := range routes.Items {
+		routeStatus := status.Routes[r.Meta.GetName()]
+		routeStatus.RejectedRules = rejectedRules(r.Spec.GetConf(), resolved)
+		routeStatus.Conditions = routeConditions(routeStatus)
+	}
+
+	return status, nil
+}
+
+// gatewayStatus records the listeners of the Gateway that are bound on
+// the dataplane, and the listeners that the routes are attached to in
+// the route statuses.
+func gatewayStatus(
+	gateway *core_mesh.GatewayResource,
+	dataplane *core_mesh.DataplaneResource,
+	listeners []GatewayListenerHosts,
+	conflicts []GatewayConflict,
+	routes map[string]*mesh_proto.GatewayRouteInsight,
+) *mesh_proto.GatewayInsight {
+	name := gateway.Meta.GetName()
+	status := &mesh_proto.GatewayInsight{}
+
+	for _, l := range listeners {
+		listenerStatus := &mesh_proto.GatewayInsight_Listener{
+			Port:     l.Listener.Port,
+			Protocol: l.Listener.Protocol,
+		}
+
+		attached := map[string]struct{}{}
+
+		for _, host := range l.Hosts {
+			if host.Gateway != name {
+				continue
+			}
+
+			listenerStatus.Hostnames = append(listenerStatus.Hostnames, host.Hostname)
+
+			for _, r := range hostGatewayRoutes(host) {
+				attached[r.Meta.GetName()] = struct{}{}
+
+				if routeStatus, ok := routes[r.Meta.GetName()]; ok {
+					attachRoute(routeStatus, name, l.Listener.Port, host.Hostname)
+				}
+			}
+		}
+
+		if len(listenerStatus.Hostnames) == 0 {
+			continue
+		}
+
+		listenerStatus.AttachedRoutes = uint32(len(attached))
+		status.Listeners = append(status.Listeners, listenerStatus)
+	}
+
+	var conflictMessages []string
+	for _, c := range conflicts {
+		if c.Gateway == name {
+			conflictMessages = append(conflictMessages,
+				fmt.Sprintf("port %d is bound to Gateway %q: %s", c.Port, c.With, c.Reason))
+		}
+	}
+
+	if len(status.Listeners) == 0 && len(conflictMessages) > 0 {
+		status.Conditions = append(status.Conditions, &mesh_proto.GatewayInsight_Condition{
+			Type:    GatewayConditionReady,
+			Status:  conditionFalse,
+			Reason:  "Conflicted",
+			Message: fmt.Sprintf("no listener of the Gateway can be merged on dataplane %q", dataplane.Meta.GetName()),
+		})
+	} else {
+		status.Conditions = append(status.Conditions, &mesh_proto.GatewayInsight_Condition{
+			Type:    GatewayConditionReady,
+			Status:  conditionTrue,
+			Reason:  "Ready",
+			Message: fmt.Sprintf("the Gateway is configured on dataplane %q", dataplane.Meta.GetName()),
+		})
+	}
+
+	if len(conflictMessages) > 0 {
+		status.Conditions = append(status.Conditions, &mesh_proto.GatewayInsight_Condition{
+			Type:    GatewayConditionConflicted,
+			Status:  conditionTrue,
+			Reason:  "ListenerConflict",
+			Message: strings.Join(conflictMessages, "; "),
+		})
+	}
+
+	return status
+}
+
+func attachRoute(status *mesh_proto.GatewayRouteInsight, gateway string, port uint32, hostname string) {
+	for _, a := range status.Attachments {
+		if a.GetGateway() == gateway && a.GetPort() == port {
+			a.Hostnames = append(a.Hostnames, hostname)
+			return
+		}
+	}
+
+	status.Attachments = append(status.Attachments, &mesh_proto.GatewayRouteInsight_Attachment{
+		Gateway:   gateway,
+		Port:      port,
+		Hostnames: []string{hostname},
+	})
+}
+
+// rejectedRules lists the rules that have backends, none of which
+// matches a service.
+func rejectedRules(
+	conf *mesh_proto.GatewayRoute_Conf,
+	resolved func(map[string]string) bool,
+) []*mesh_proto.GatewayRouteInsight_RejectedRule {
+	var backends [][]*mesh_proto.GatewayRoute_Backend
+
+	switch {
+	case conf.GetHttp() != nil:
+		for _, rule := range conf.GetHttp().GetRules() {
+			backends = append(backends, rule.GetBackends())
+		}
+	case conf.GetTcp() != nil:
+		for _, rule := range conf.GetTcp().GetRules() {
+			backends = append(backends, rule.GetBackends())
+		}
+	case conf.GetTls() != nil:
+		for _, rule := range conf.GetTls().GetRules() {
+			backends = append(backends, rule.GetBackends())
+		}
+	case conf.GetUdp() != nil:
+		for _, rule := range conf.GetUdp().GetRules() {
+			backends = append(backends, rule.GetBackends())
+		}
+	}
+
+	var rejected []*mesh_proto.GatewayRouteInsight_RejectedRule
+
+	for i, ruleBackends := range backends {
+		// Redirects and direct responses don't have backends.
+		if len(ruleBackends) == 0 {
+			continue
+		}
+
+		found := false
+		for _, b := range ruleBackends {
+			if resolved(b.GetDestination()) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			rejected = append(rejected, &mesh_proto.GatewayRouteInsight_RejectedRule{
+				Index:   uint32(i),
+				Reason:  "BackendNotFound",
+				Message: "no service matches the backends of the rule",
+			})
+		}
+	}
+
+	return rejected
+}
+
+func routeConditions(status *mesh_proto.GatewayRouteInsight) []*mesh_proto.GatewayRouteInsight_Condition {
+	accepted := &mesh_proto.GatewayRouteInsight_Condition{
+		Type:    RouteConditionAccepted,
+		Status:  conditionTrue,
+		Reason:  "Accepted",
+		Message: "the route is attached to a Gateway listener",
+	}
+	if len(status.GetAttachments()) == 0 {
+		accepted.Status = conditionFalse
+		accepted.Reason = "NotAttached"
+		accepted.Message = "the route doesn't match any Gateway listener"
+	}
+
+	resolvedRefs := &mesh_proto.GatewayRouteInsight_Condition{
+		Type:    RouteConditionResolvedRefs,
+		Status:  conditionTrue,
+		Reason:  "ResolvedRefs",
+		Message: "the backends of all the rules match a service",
+	}
+	if len(status.GetRejectedRules()) > 0 {
+		resolvedRefs.Status = conditionFalse
+		resolvedRefs.Reason = "BackendNotFound"
+		resolvedRefs.Message = fmt.Sprintf("%d rules have no backend that matches a service", len(status.GetRejectedRules()))
+	}
+
+	return []*mesh_proto.GatewayRouteInsight_Condition{accepted, resolvedRefs}
+}
+
+// backendExists returns whether the destination matches a dataplane or
+// an external service of the mesh, or a service that is available
+// through a zone ingress.
+func backendExists(
+	mesh string,
+	destination map[string]string,
+	dataplanes *core_mesh.DataplaneResourceList,
+	externalServices *core_mesh.ExternalServiceResourceList,
+	zoneIngresses *core_mesh.ZoneIngressResourceList,
+) bool {
+	selector := mesh_proto.TagSelector(destination)
+
+	for _, dp := range dataplanes.Items {
+		if !dp.Spec.IsBuiltinGateway() && dp.Spec.Matches(selector) {
+			return true
+		}
+	}
+
+	for _, es := range externalServices.Items {
+		if selector.Matches(es.Spec.GetTags()) {
+			return true
+		}
+	}
+
+	for _, zi := range zoneIngresses.Items {
+		for _, s := range zi.Spec.GetAvailableServices() {
+			if s.GetMesh() == mesh && selector.Matches(s.GetTags()) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// StatusUpdater periodically stores the status of the Gateways and
+// GatewayRoutes of all the meshes in their GatewayInsights and
+// GatewayRouteInsights, so that the resources that users own are never
+// written by the control plane. Insights are only written when the
+// status changes, and deleted with their resource.
+type StatusUpdater struct {
+	ResourceManager core_manager.ResourceManager
+	Interval        time.Duration
+}
+
+var _ component.Component = &StatusUpdater{}
+
+func (s *StatusUpdater) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Update(context.Background()); err != nil {
+				log.Error(err, "failed to update the gateway status")
+			}
+		case <-stop:
+			return nil
+		}
+	}
+}
+
+// NeedLeaderElection is true, since only one instance of the control
+// plane has to update the status.
+func (s *StatusUpdater) NeedLeaderElection() bool {
+	return true
+}
+
+// Update stores the status of the Gateways and GatewayRoutes of all
+// the meshes.
+func (s *StatusUpdater) Update(ctx context.Context) error {
+	meshes := &core_mesh.MeshResourceList{}
+	if err := s.ResourceManager.List(ctx, meshes); err != nil {
+		return err
+	}
+
+	for _, mesh := range meshes.Items {
+		if err := s.updateMesh(ctx, mesh.Meta.GetName()); err != nil {
+			return errors.Wrapf(err, "failed to update the gateway status of mesh %q", mesh.Meta.GetName())
+		}
+	}
+
+	return nil
+}
+
+func (s *StatusUpdater) updateMesh(ctx context.Context, mesh string) error {
+	status, err := ComputeMeshStatus(ctx, s.ResourceManager, mesh)
+	if err != nil {
+		return err
+	}
+
+	gatewayInsights := map[string]model.ResourceSpec{}
+	for name, gatewayStatus := range status.Gateways {
+		gatewayInsights[name] = gatewayStatus
+	}
+
+	if err := s.updateInsights(ctx, mesh, &core_mesh.GatewayInsightResourceList{}, gatewayInsights); err != nil {
+		return err
+	}
+
+	routeInsights := map[string]model.ResourceSpec{}
+	for name, routeStatus := range status.Routes {
+		routeInsights[name] = routeStatus
+	}
+
+	return s.updateInsights(ctx, mesh, &core_mesh.GatewayRouteInsightResourceList{}, routeInsights)
+}
+
+// updateInsights stores the insights whose status changed, and deletes
+// the insights of the resources that no longer exist. Insights that are
+// changed in the meantime are skipped, they are updated on the next
+// interval.
+func (s *StatusUpdater) updateInsights(
+	ctx context.Context,
+	mesh string,
+	list model.ResourceList,
+	insights map[string]model.ResourceSpec,
+) error {
+	if err := s.ResourceManager.List(ctx, list, store.ListByMesh(mesh)); err != nil {
+		return err
+	}
+
+	existing := map[string]model.Resource{}
+	for _, insight := range list.GetItems() {
+		existing[insight.GetMeta().GetName()] = insight
+	}
+
+	for name, insight := range existing {
+		if _, ok := insights[name]; ok {
+			continue
+		}
+
+		if err := s.ResourceManager.Delete(ctx, insight, store.DeleteByKey(name, mesh)); err != nil {
+			if !store.IsResourceNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	for name, spec := range insights {
+		insight, ok := existing[name]
+		if ok && proto.Equal(insight.GetSpec(), spec) {
+			continue
+		}
+
+		if !ok {
+			insight = list.NewItem()
+		}
+
+		if err := insight.SetSpec(spec); err != nil {
+			return err
+		}
+
+		var err error
+		if ok {
+			err = s.ResourceManager.Update(ctx, insight)
+		} else {
+			err = s.ResourceManager.Create(ctx, insight, store.CreateByKey(name, mesh))
+		}
+
+		if err != nil {
+			if store.IsResourceConflict(err) || store.IsResourceNotFound(err) || store.IsResourceAlreadyExists(err) {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,297 @@
+package gateway_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway"
+	. "github.com/kumahq/kuma/pkg/test/matchers"
+)
+
+var _ = Describe("Gateway status", func() {
+	var rt runtime.Runtime
+
+	BeforeEach(func() {
+		var err error
+
+		rt, err = BuildRuntime()
+		Expect(err).To(Succeed(), "build runtime instance")
+
+		Expect(StoreNamedFixture(rt, "mesh-default.yaml")).To(Succeed())
+		Expect(StoreNamedFixture(rt, "dataplane-default.yaml")).To(Succeed())
+
+		Expect(StoreInlineFixture(rt, []byte(`
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+`))).To(Succeed())
+		Expect(StoreInlineFixture(rt, []byte(`
+type: Gateway
+mesh: default
+name: other-gateway
+selectors:
+- match:
+    kuma.io/service: other-gateway
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+`))).To(Succeed())
+		Expect(StoreInlineFixture(rt, []byte(`
+type: ExternalService
+mesh: default
+name: external-echo
+tags:
+  kuma.io/service: external-echo
+networking:
+  address: echo.example.com:80
+`))).To(Succeed())
+		Expect(StoreInlineFixture(rt, []byte(`
+type: GatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    hostnames:
+    - foo.example.com
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /external
+      backends:
+      - destination:
+          kuma.io/service: external-echo
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: missing-service
+`))).To(Succeed())
+		Expect(StoreInlineFixture(rt, []byte(`
+type: GatewayRoute
+mesh: default
+name: unattached
+selectors:
+- match:
+    kuma.io/service: gateway-default
+    port: http/9090
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: external-echo
+`))).To(Succeed())
+	})
+
+	It("should compute the status of gateways and routes", func() {
+		// when
+		status, err := gateway.ComputeMeshStatus(context.Background(), rt.ReadOnlyResourceManager(), "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(status.Gateways).To(HaveLen(2))
+		Expect(status.Gateways["edge-gateway"]).To(MatchProto(&mesh_proto.GatewayInsight{
+			Conditions: []*mesh_proto.GatewayInsight_Condition{{
+				Type:    gateway.GatewayConditionReady,
+				Status:  "True",
+				Reason:  "Ready",
+				Message: `the Gateway is configured on dataplane "default"`,
+			}},
+			Listeners: []*mesh_proto.GatewayInsight_Listener{{
+				Port:           8080,
+				Protocol:       mesh_proto.Gateway_Listener_HTTP,
+				Hostnames:      []string{"foo.example.com", "*"},
+				AttachedRoutes: 1,
+			}},
+		}))
+		Expect(status.Gateways["other-gateway"]).To(MatchProto(&mesh_proto.GatewayInsight{
+			Conditions: []*mesh_proto.GatewayInsight_Condition{{
+				Type:    gateway.GatewayConditionReady,
+				Status:  "False",
+				Reason:  "NoDataplanes",
+				Message: "no builtin gateway dataplane is selected by the Gateway",
+			}},
+		}))
+
+		Expect(status.Routes).To(HaveLen(2))
+		Expect(status.Routes["echo-service"]).To(MatchProto(&mesh_proto.GatewayRouteInsight{
+			Conditions: []*mesh_proto.GatewayRouteInsight_Condition{{
+				Type:    gateway.RouteConditionAccepted,
+				Status:  "True",
+				Reason:  "Accepted",
+				Message: "the route is attached to a Gateway listener",
+			}, {
+				Type:    gateway.RouteConditionResolvedRefs,
+				Status:  "False",
+				Reason:  "BackendNotFound",
+				Message: "1 rules have no backend that matches a service",
+			}},
+			Attachments: []*mesh_proto.GatewayRouteInsight_Attachment{{
+				Gateway:   "edge-gateway",
+				Port:      8080,
+				Hostnames: []string{"foo.example.com"},
+			}},
+			RejectedRules: []*mesh_proto.GatewayRouteInsight_RejectedRule{{
+				Index:   1,
+				Reason:  "BackendNotFound",
+				Message: "no service matches the backends of the rule",
+			}},
+		}))
+		Expect(status.Routes["unattached"]).To(MatchProto(&mesh_proto.GatewayRouteInsight{
+			Conditions: []*mesh_proto.GatewayRouteInsight_Condition{{
+				Type:    gateway.RouteConditionAccepted,
+				Status:  "False",
+				Reason:  "NotAttached",
+				Message: "the route doesn't match any Gateway listener",
+			}, {
+				Type:    gateway.RouteConditionResolvedRefs,
+				Status:  "True",
+				Reason:  "ResolvedRefs",
+				Message: "the backends of all the rules match a service",
+			}},
+		}))
+	})
+
+	It("should report the conflicts of gateways that are merged on a dataplane", func() {
+		// given
+		Expect(StoreInlineFixture(rt, []byte(`
+type: Gateway
+mesh: default
+name: public-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    hostname: api.example.com
+    tags:
+      port: http/8080
+  - port: 9090
+    protocol: HTTP
+    tags:
+      port: http/9090
+`))).To(Succeed())
+		Expect(StoreInlineFixture(rt, []byte(`
+type: Gateway
+mesh: default
+name: tcp-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: TCP
+    tags:
+      port: tcp/8080
+`))).To(Succeed())
+
+		// when
+		status, err := gateway.ComputeMeshStatus(context.Background(), rt.ReadOnlyResourceManager(), "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(status.Gateways).To(HaveLen(4))
+		Expect(status.Gateways["edge-gateway"].GetListeners()).To(HaveLen(1))
+		Expect(status.Gateways["edge-gateway"].GetListeners()[0].GetHostnames()).To(Equal([]string{"foo.example.com", "*"}))
+
+		public := status.Gateways["public-gateway"]
+		Expect(public.GetConditions()).To(HaveLen(1))
+		Expect(public.GetConditions()[0].GetStatus()).To(Equal("True"))
+		Expect(public.GetListeners()).To(HaveLen(2))
+		Expect(public.GetListeners()[0].GetPort()).To(Equal(uint32(8080)))
+		Expect(public.GetListeners()[0].GetHostnames()).To(Equal([]string{"api.example.com"}))
+		Expect(public.GetListeners()[1].GetPort()).To(Equal(uint32(9090)))
+
+		Expect(status.Gateways["tcp-gateway"]).To(MatchProto(&mesh_proto.GatewayInsight{
+			Conditions: []*mesh_proto.GatewayInsight_Condition{{
+				Type:    gateway.GatewayConditionReady,
+				Status:  "False",
+				Reason:  "Conflicted",
+				Message: `no listener of the Gateway can be merged on dataplane "default"`,
+			}, {
+				Type:    gateway.GatewayConditionConflicted,
+				Status:  "True",
+				Reason:  "ListenerConflict",
+				Message: `port 8080 is bound to Gateway "edge-gateway": cannot collapse listener protocols TCP and HTTP`,
+			}},
+		}))
+	})
+
+	It("should store the status in insights", func() {
+		// given
+		updater := &gateway.StatusUpdater{
+			ResourceManager: rt.ResourceManager(),
+		}
+
+		gw := core_mesh.NewGatewayResource()
+		Expect(rt.ReadOnlyResourceManager().Get(context.Background(), gw, store.GetByKey("edge-gateway", "default"))).To(Succeed())
+		gatewayVersion := gw.Meta.GetVersion()
+
+		// when
+		Expect(updater.Update(context.Background())).To(Succeed())
+
+		// then
+		gatewayInsight := core_mesh.NewGatewayInsightResource()
+		Expect(rt.ReadOnlyResourceManager().Get(context.Background(), gatewayInsight, store.GetByKey("edge-gateway", "default"))).To(Succeed())
+		Expect(gatewayInsight.Spec.GetListeners()).To(HaveLen(1))
+
+		routeInsight := core_mesh.NewGatewayRouteInsightResource()
+		Expect(rt.ReadOnlyResourceManager().Get(context.Background(), routeInsight, store.GetByKey("unattached", "default"))).To(Succeed())
+		Expect(routeInsight.Spec.GetConditions()).To(HaveLen(2))
+		Expect(routeInsight.Spec.GetConditions()[0].GetStatus()).To(Equal("False"))
+
+		// and the gateway isn't updated
+		Expect(rt.ReadOnlyResourceManager().Get(context.Background(), gw, store.GetByKey("edge-gateway", "default"))).To(Succeed())
+		Expect(gw.Meta.GetVersion()).To(Equal(gatewayVersion))
+
+		// when the status doesn't change
+		version := routeInsight.Meta.GetVersion()
+		Expect(updater.Update(context.Background())).To(Succeed())
+
+		// then the insight isn't updated
+		Expect(rt.ReadOnlyResourceManager().Get(context.Background(), routeInsight, store.GetByKey("unattached", "default"))).To(Succeed())
+		Expect(routeInsight.Meta.GetVersion()).To(Equal(version))
+
+		// when the route is deleted
+		route := core_mesh.NewGatewayRouteResource()
+		Expect(rt.ResourceManager().Delete(context.Background(), route, store.DeleteByKey("unattached", "default"))).To(Succeed())
+		Expect(updater.Update(context.Background())).To(Succeed())
+
+		// then its insight is deleted
+		err := rt.ReadOnlyResourceManager().Get(context.Background(), routeInsight, store.GetByKey("unattached", "default"))
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+	})
+})
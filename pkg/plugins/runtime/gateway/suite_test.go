@@ -8,9 +8,6 @@ import (
 	"testing"
 
 	"github.com/Nordix/simple-ipam/pkg/ipam"
-	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
-	cache_v3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
-	"github.com/golang/protobuf/proto"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -32,7 +29,6 @@ import (
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	"github.com/kumahq/kuma/pkg/test"
 	test_runtime "github.com/kumahq/kuma/pkg/test/runtime"
-	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	"github.com/kumahq/kuma/pkg/xds/cache/cla"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	"github.com/kumahq/kuma/pkg/xds/envoy"
@@ -44,56 +40,6 @@ func TestGateway(t *testing.T) {
 	test.RunSpecs(t, "Gateway Suite")
 }
 
-type ProtoMessage struct {
-	Message proto.Message
-}
-
-func (p ProtoMessage) MarshalJSON() ([]byte, error) {
-	return util_proto.ToJSON(p.Message)
-}
-
-type ProtoResource struct {
-	Resources map[string]ProtoMessage
-}
-
-type ProtoSnapshot struct {
-	Clusters  ProtoResource
-	Endpoints ProtoResource
-	Listeners ProtoResource
-	Routes    ProtoResource
-	Runtimes  ProtoResource
-	Secrets   ProtoResource
-}
-
-// MakeProtoResource wraps Go Control Plane resources in a map that
-// implements the json.Marshaler so that the resulting JSON fully
-// expands embedded Any protobufs (which are otherwise serialized
-// as byte arrays).
-func MakeProtoResource(resources cache_v3.Resources) ProtoResource {
-	result := ProtoResource{
-		Resources: map[string]ProtoMessage{},
-	}
-
-	for name, values := range resources.Items {
-		result.Resources[name] = ProtoMessage{
-			Message: values.Resource,
-		}
-	}
-
-	return result
-}
-
-func MakeProtoSnapshot(snap cache_v3.Snapshot) ProtoSnapshot {
-	return ProtoSnapshot{
-		Clusters:  MakeProtoResource(snap.Resources[envoy_types.Cluster]),
-		Endpoints: MakeProtoResource(snap.Resources[envoy_types.Endpoint]),
-		Listeners: MakeProtoResource(snap.Resources[envoy_types.Listener]),
-		Routes:    MakeProtoResource(snap.Resources[envoy_types.Route]),
-		Runtimes:  MakeProtoResource(snap.Resources[envoy_types.Runtime]),
-		Secrets:   MakeProtoResource(snap.Resources[envoy_types.Secret]),
-	}
-}
-
 type mockMetadataTracker struct{}
 
 func (m mockMetadataTracker) Metadata(dpKey core_model.ResourceKey) *core_xds.DataplaneMetadata {
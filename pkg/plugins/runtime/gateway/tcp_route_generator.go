@@ -0,0 +1,144 @@
+package gateway
+
+import (
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+// TCPRouteGenerator generates the filter chain of TCP listeners from
+// the TCP rules of GatewayRoute resources.
+type TCPRouteGenerator struct {
+}
+
+func (*TCPRouteGenerator) SupportsProtocol(p mesh_proto.Gateway_Listener_Protocol) bool {
+	return p == mesh_proto.Gateway_Listener_TCP
+}
+
+func (g *TCPRouteGenerator) GenerateHost(ctx xds_context.Context, info *GatewayResourceInfo) (*core_xds.ResourceSet, error) {
+	gatewayRoutes := filterGatewayRoutes(info.Host.Routes, func(route *core_mesh.GatewayRouteResource) bool {
+		return route.Spec.GetConf().GetTcp() != nil
+	})
+
+	// TCP connections can't be matched yet, so each connection
+	// is forwarded to any of the backends of all the rules.
+	var forward []route.Destination
+	for _, r := range gatewayRoutes {
+		for _, rule := range r.Spec.GetConf().GetTcp().GetRules() {
+			forward = append(forward, makeBackendDestinations(rule.GetBackends())...)
+		}
+	}
+
+	log.V(1).Info("applying TCP routes",
+		"listener-port", info.Listener.Port,
+		"listener-name", info.Listener.ResourceName,
+	)
+
+	// Add the backends to the route table, so that the connection
+	// policies are matched and the clusters are generated.
+	if len(forward) > 0 {
+		info.RouteTable.Entries = append(info.RouteTable.Entries, route.Entry{
+			Action: route.Action{Forward: forward},
+		})
+	}
+
+	clusters, err := makeTCPClusters(forward)
+	if err != nil {
+		return nil, err
+	}
+
+	// The filter chain is generated even if there are no clusters,
+	// since Envoy rejects listeners without filter chains. Without
+	// the TCP proxy, connections are closed as soon as they are
+	// accepted.
+	info.Resources.Listener.Configure(
+		envoy_listeners.FilterChain(newTCPProxyFilterChain(ctx, info, clusters)),
+	)
+
+	return nil, nil
+}
+
+// makeBackendDestinations converts the backends of a TCP or TLS rule
+// to weighted destinations.
+func makeBackendDestinations(backends []*mesh_proto.GatewayRoute_Backend) []route.Destination {
+	var destinations []route.Destination
+
+	for _, b := range backends {
+		destinations = append(destinations, route.Destination{
+			Destination: b.GetDestination(),
+			Weight:      b.GetWeight(),
+		})
+	}
+
+	return destinations
+}
+
+// newTCPProxyFilterChain builds a filter chain that proxies connections
+// to the given clusters.
+func newTCPProxyFilterChain(
+	ctx xds_context.Context,
+	info *GatewayResourceInfo,
+	clusters []envoy.Cluster,
+) *envoy_listeners.FilterChainBuilder {
+	service := info.Dataplane.Spec.GetIdentifyingService()
+
+	filters := envoy_listeners.NewFilterChainBuilder(info.Proxy.APIVersion).Configure(
+		envoy_listeners.TcpProxy(info.Listener.ResourceName, clusters...),
+		envoy_listeners.NetworkAccessLog(
+			ctx.Mesh.Resource.Meta.GetName(),
+			envoy.TrafficDirectionInbound,
+			service, // Source service is the gateway service.
+			"*",     // Destination service could be any of the backends.
+			"",
+			info.Proxy.Policies.Logs[service],
+			info.Proxy,
+		),
+	)
+
+	if info.Listener.Limits != nil {
+		filters.Configure(ListenerLimits(info))
+	}
+
+	return filters
+}
+
+// makeTCPClusters returns the weighted clusters of the destinations.
+// The weights of destinations that share a cluster are summed, and
+// destinations with no weight are ignored unless there is only one.
+func makeTCPClusters(destinations []route.Destination) ([]envoy.Cluster, error) {
+	var names []string
+	weights := map[string]uint32{}
+
+	for _, d := range destinations {
+		name, err := route.DestinationClusterName(d)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate forwarding cluster name")
+		}
+
+		if _, ok := weights[name]; !ok {
+			names = append(names, name)
+		}
+
+		weights[name] += d.Weight
+	}
+
+	var clusters []envoy.Cluster
+	for _, name := range names {
+		if weights[name] == 0 && len(names) > 1 {
+			continue
+		}
+
+		clusters = append(clusters, envoy.NewCluster(
+			envoy.WithName(name),
+			envoy.WithWeight(weights[name]),
+		))
+	}
+
+	return clusters, nil
+}
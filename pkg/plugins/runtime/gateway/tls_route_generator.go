@@ -0,0 +1,70 @@
+package gateway
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/route"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+// TLSRouteGenerator generates a filter chain for each host of TLS
+// listeners from the TLS rules of GatewayRoute resources. The filter
+// chain matches the hostname to the client SNI name and passes the TLS
+// stream through to the backends without terminating it.
+type TLSRouteGenerator struct {
+}
+
+func (*TLSRouteGenerator) SupportsProtocol(p mesh_proto.Gateway_Listener_Protocol) bool {
+	return p == mesh_proto.Gateway_Listener_TLS
+}
+
+func (g *TLSRouteGenerator) GenerateHost(ctx xds_context.Context, info *GatewayResourceInfo) (*core_xds.ResourceSet, error) {
+	gatewayRoutes := hostGatewayRoutes(info.Host)
+
+	// The TLS stream can't be inspected past the SNI name, so each
+	// connection is forwarded to any of the backends of all the rules.
+	var forward []route.Destination
+	for _, r := range gatewayRoutes {
+		for _, rule := range r.Spec.GetConf().GetTls().GetRules() {
+			forward = append(forward, makeBackendDestinations(rule.GetBackends())...)
+		}
+	}
+
+	log.V(1).Info("applying TLS routes",
+		"listener-port", info.Listener.Port,
+		"listener-name", info.Listener.ResourceName,
+		"hostname", info.Host.Hostname,
+	)
+
+	// Add the backends to the route table, so that the connection
+	// policies are matched and the clusters are generated.
+	if len(forward) > 0 {
+		info.RouteTable.Entries = append(info.RouteTable.Entries, route.Entry{
+			Action: route.Action{Forward: forward},
+		})
+	}
+
+	clusters, err := makeTCPClusters(forward)
+	if err != nil {
+		return nil, err
+	}
+
+	// Envoy selects the filter chain with the most specific server
+	// name, so exact hostnames are matched before wildcard ones, and
+	// the wildcard host matches all the other clients. Connections
+	// that aren't TLS, or whose SNI name doesn't match any host, are
+	// closed.
+	filters := newTCPProxyFilterChain(ctx, info, clusters).Configure(
+		envoy_listeners.MatchTransportProtocol("tls"),
+	)
+	if info.Host.Hostname != WildcardHostname {
+		filters.Configure(envoy_listeners.MatchServerNames(info.Host.Hostname))
+	}
+
+	info.Resources.Listener.Configure(
+		envoy_listeners.FilterChain(filters),
+	)
+
+	return nil, nil
+}
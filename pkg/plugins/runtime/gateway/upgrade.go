@@ -0,0 +1,73 @@
+package gateway
+
+import (
+	"net/http"
+	"sort"
+
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+)
+
+// websocketUpgrade is the upgrade type of WebSocket connections.
+const websocketUpgrade = "websocket"
+
+// HttpUpgrades declares the given upgrade types on the HTTP connection
+// manager. Envoy only upgrades the requests of the types that the
+// connection manager knows about, so they are declared here but left
+// disabled, and enabled on the routes that allow them.
+func HttpUpgrades(upgrades []string) envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerMustConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) {
+			for _, u := range upgrades {
+				hcm.UpgradeConfigs = append(hcm.UpgradeConfigs, &envoy_hcm.HttpConnectionManager_UpgradeConfig{
+					UpgradeType: u,
+					Enabled:     util_proto.Bool(false),
+				})
+			}
+		}),
+	)
+}
+
+// ruleUpgrades returns the upgrade types that the rule enables.
+func ruleUpgrades(rule *mesh_proto.GatewayRoute_HttpRoute_Rule) []string {
+	var upgrades []string
+
+	if rule.GetUpgrades().GetWebsocket() {
+		upgrades = append(upgrades, websocketUpgrade)
+	}
+
+	if rule.GetUpgrades().GetConnect() {
+		upgrades = append(upgrades, http.MethodConnect)
+	}
+
+	return upgrades
+}
+
+// hostUpgrades returns the upgrade types that are enabled by any of the
+// GatewayRoutes of the given hosts.
+func hostUpgrades(hosts []GatewayHost) []string {
+	enabled := map[string]bool{}
+
+	for _, host := range hosts {
+		for _, gatewayRoute := range hostGatewayRoutes(host) {
+			for _, rule := range gatewayRoute.Spec.GetConf().GetHttp().GetRules() {
+				for _, u := range ruleUpgrades(rule) {
+					enabled[u] = true
+				}
+			}
+		}
+	}
+
+	var upgrades []string
+	for u := range enabled {
+		upgrades = append(upgrades, u)
+	}
+
+	sort.Strings(upgrades)
+
+	return upgrades
+}
@@ -0,0 +1,269 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	envoy_config_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_wasm_filter "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_wasm "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+)
+
+// WafFilterPath is the path of the Coraza WASM filter in the images of
+// the gateway data plane proxies.
+const WafFilterPath = "/usr/share/kuma/waf/coraza-proxy-wasm.wasm"
+
+const wasmFilterName = "envoy.filters.http.wasm"
+
+// wafDirectivesName is the name of the directive set that the filter
+// applies to all the requests.
+const wafDirectivesName = "default"
+
+// ListenerWaf is the web application firewall of a listener.
+type ListenerWaf struct {
+	RuleSet       *mesh_proto.WafRuleSet_Conf
+	DetectionOnly bool
+
+	// Exclusions are the SecLang rules that exclude the requests of
+	// the GatewayRoutes of the listener from the firewall.
+	Exclusions []string
+}
+
+// wafConfig is the configuration of the Coraza WASM filter.
+type wafConfig struct {
+	DirectivesMap     map[string][]string `json:"directives_map"`
+	DefaultDirectives string              `json:"default_directives"`
+}
+
+// WafFilter adds the Coraza WASM filter to the filter chain. The filter
+// evaluates the rules of the listener before any other filter.
+func WafFilter(waf *ListenerWaf) envoy_listeners.FilterChainBuilderOpt {
+	return envoy_listeners.AddFilterChainConfigurer(
+		listeners_v3.HttpConnectionManagerConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) error {
+			conf, err := json.Marshal(wafConfig{
+				DirectivesMap: map[string][]string{
+					wafDirectivesName: WafDirectives(waf),
+				},
+				DefaultDirectives: wafDirectivesName,
+			})
+			if err != nil {
+				return err
+			}
+
+			pluginConf, err := util_proto.MarshalAnyDeterministic(wrapperspb.String(string(conf)))
+			if err != nil {
+				return err
+			}
+
+			filter, err := util_proto.MarshalAnyDeterministic(&envoy_wasm_filter.Wasm{
+				Config: &envoy_wasm.PluginConfig{
+					Name: "kuma-gateway-waf",
+					Vm: &envoy_wasm.PluginConfig_VmConfig{
+						VmConfig: &envoy_wasm.VmConfig{
+							VmId:    "kuma-gateway-waf",
+							Runtime: "envoy.wasm.runtime.v8",
+							Code: &envoy_config_core.AsyncDataSource{
+								Specifier: &envoy_config_core.AsyncDataSource_Local{
+									Local: &envoy_config_core.DataSource{
+										Specifier: &envoy_config_core.DataSource_Filename{
+											Filename: WafFilterPath,
+										},
+									},
+								},
+							},
+						},
+					},
+					Configuration: pluginConf,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+				Name: wasmFilterName,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: filter,
+				},
+			}}, hcm.HttpFilters...)
+
+			return nil
+		}),
+	)
+}
+
+// WafDirectives returns the SecLang directives of the firewall. The
+// exclusions of the routes come before the rules, so that they can
+// remove rules while the request is processed.
+func WafDirectives(waf *ListenerWaf) []string {
+	engine := "On"
+	if waf.DetectionOnly {
+		engine = "DetectionOnly"
+	}
+
+	directives := []string{
+		"Include @recommended-conf",
+		"SecRuleEngine " + engine,
+	}
+
+	crs := waf.RuleSet.GetCoreRuleSet()
+	if crs != nil {
+		directives = append(directives, "Include @crs-setup-conf")
+
+		if l := crs.GetParanoiaLevel(); l > 0 {
+			directives = append(directives, fmt.Sprintf(
+				`SecAction "id:900000,phase:1,pass,t:none,nolog,setvar:tx.blocking_paranoia_level=%d"`, l))
+		}
+
+		var thresholds []string
+		if t := crs.GetInboundAnomalyThreshold(); t > 0 {
+			thresholds = append(thresholds, fmt.Sprintf("setvar:tx.inbound_anomaly_score_threshold=%d", t))
+		}
+		if t := crs.GetOutboundAnomalyThreshold(); t > 0 {
+			thresholds = append(thresholds, fmt.Sprintf("setvar:tx.outbound_anomaly_score_threshold=%d", t))
+		}
+		if len(thresholds) > 0 {
+			directives = append(directives, fmt.Sprintf(
+				`SecAction "id:900110,phase:1,pass,t:none,nolog,%s"`, strings.Join(thresholds, ",")))
+		}
+	}
+
+	directives = append(directives, waf.Exclusions...)
+
+	if crs != nil {
+		directives = append(directives, "Include @owasp_crs/*.conf")
+
+		for _, id := range crs.GetExcludedRules() {
+			directives = append(directives, fmt.Sprintf("SecRuleRemoveById %d", id))
+		}
+	}
+
+	return append(directives, waf.RuleSet.GetDirectives()...)
+}
+
+// hostWafExclusions returns the SecLang rules that exclude the requests
+// of the GatewayRoutes of the given hosts from the firewall. Each route
+// match gets a rule that matches the hostname and the path of the
+// request.
+func hostWafExclusions(hosts []GatewayHost) []string {
+	var exclusions []string
+
+	id := core_mesh.WafRouteExclusionFirstRuleID
+
+	for _, host := range hosts {
+		for _, gatewayRoute := range hostGatewayRoutes(host) {
+			hostnames := []string{host.Hostname}
+			if host.Hostname == WildcardHostname && len(routeHostnames(gatewayRoute.Spec.GetConf())) > 0 {
+				hostnames = routeHostnames(gatewayRoute.Spec.GetConf())
+			}
+
+			for _, rule := range gatewayRoute.Spec.GetConf().GetHttp().GetRules() {
+				actions := wafExclusionActions(rule.GetWaf())
+				if len(actions) == 0 {
+					continue
+				}
+
+				for _, hostname := range hostnames {
+					for _, m := range rule.GetMatches() {
+						if id > core_mesh.WafRouteExclusionLastRuleID {
+							log.Info("too many WAF exclusions, ignoring the remaining ones",
+								"route", gatewayRoute.Meta.GetName())
+							return exclusions
+						}
+
+						exclusions = append(exclusions, wafExclusion(id, hostname, m.GetPath(), actions)...)
+						id++
+					}
+				}
+			}
+		}
+	}
+
+	return exclusions
+}
+
+// wafExclusionActions returns the actions that exclude the requests of
+// a rule from the firewall.
+func wafExclusionActions(conf *mesh_proto.GatewayRoute_HttpRoute_Rule_Waf) []string {
+	if conf.GetDisabled() {
+		return []string{"ctl:ruleEngine=Off"}
+	}
+
+	var actions []string
+	for _, id := range conf.GetExcludedRules() {
+		actions = append(actions, fmt.Sprintf("ctl:ruleRemoveById=%d", id))
+	}
+
+	return actions
+}
+
+// wafExclusion returns a SecLang rule that applies the actions to the
+// requests that match the hostname and the path. When both are matched,
+// the rule is a chain of two rules, which must stay in order.
+func wafExclusion(
+	id int,
+	hostname string,
+	path *mesh_proto.GatewayRoute_HttpRoute_Match_Path,
+	actions []string,
+) []string {
+	var conditions []string
+
+	// Hostnames are matched case-insensitively, so the
+	// rule that matches the Host header lowercases it.
+	meta := fmt.Sprintf("id:%d,phase:1,pass,nolog", id)
+
+	switch {
+	case hostname == WildcardHostname:
+	case strings.HasPrefix(hostname, "*."):
+		conditions = append(conditions, fmt.Sprintf(`REQUEST_HEADERS:Host "@endsWith %s"`, seclangQuote(hostname[1:])))
+		meta += ",t:lowercase"
+	default:
+		conditions = append(conditions, fmt.Sprintf(`REQUEST_HEADERS:Host "@streq %s"`, seclangQuote(hostname)))
+		meta += ",t:lowercase"
+	}
+
+	switch path.GetMatch() {
+	case mesh_proto.GatewayRoute_HttpRoute_Match_Path_EXACT:
+		conditions = append(conditions, fmt.Sprintf(`REQUEST_FILENAME "@streq %s"`, seclangQuote(path.GetValue())))
+	case mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX:
+		// Like the routes, the prefix matches whole path components.
+		if prefix := strings.TrimSuffix(path.GetValue(), "/"); prefix != "" {
+			conditions = append(conditions, fmt.Sprintf(`REQUEST_FILENAME "@rx ^%s(/|$)"`, seclangQuote(regexp.QuoteMeta(prefix))))
+		}
+	case mesh_proto.GatewayRoute_HttpRoute_Match_Path_REGEX:
+		if path.GetValue() != "" {
+			conditions = append(conditions, fmt.Sprintf(`REQUEST_FILENAME "@rx ^(?:%s)$"`, seclangQuote(path.GetValue())))
+		}
+	}
+
+	switch len(conditions) {
+	case 0:
+		return []string{
+			fmt.Sprintf(`SecAction "%s,%s"`, meta, strings.Join(actions, ",")),
+		}
+	case 1:
+		return []string{
+			fmt.Sprintf(`SecRule %s "%s,%s"`, conditions[0], meta, strings.Join(actions, ",")),
+		}
+	default:
+		return []string{
+			fmt.Sprintf(`SecRule %s "%s,chain"`, conditions[0], meta),
+			fmt.Sprintf(`SecRule %s "%s"`, conditions[1], strings.Join(actions, ",")),
+		}
+	}
+}
+
+// seclangQuote escapes the double quotes of an operator argument.
+func seclangQuote(s string) string {
+	return strings.ReplaceAll(s, `"`, `\"`)
+}
@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kube_schema "k8s.io/apimachinery/pkg/runtime/schema"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kumahq/kuma/pkg/dns"
+)
+
+// DNSEndpointName is the name of the DNSEndpoint resource of external-dns that holds records of mesh services.
+const DNSEndpointName = "kuma-mesh-services"
+
+var dnsEndpointGVK = kube_schema.GroupVersionKind{
+	Group:   "externaldns.k8s.io",
+	Version: "v1alpha1",
+	Kind:    "DNSEndpoint",
+}
+
+// DNSEndpointPublisher publishes records of mesh services as a DNSEndpoint resource of external-dns.
+// The CRD of DNSEndpoint is not installed by Kuma, it has to be installed together with external-dns.
+type DNSEndpointPublisher struct {
+	Client          kube_client.Client
+	Reader          kube_client.Reader
+	SystemNamespace string
+	TTL             uint32
+}
+
+var _ dns.ExternalDNSPublisher = &DNSEndpointPublisher{}
+
+func (p *DNSEndpointPublisher) Publish(records []dns.ExternalDNSRecord) error {
+	ctx := context.Background()
+	endpoints := p.endpoints(records)
+
+	endpoint := &unstructured.Unstructured{}
+	endpoint.SetGroupVersionKind(dnsEndpointGVK)
+	err := p.Reader.Get(ctx, kube_types.NamespacedName{Namespace: p.SystemNamespace, Name: DNSEndpointName}, endpoint)
+	switch {
+	case kube_apierrs.IsNotFound(err):
+		endpoint.SetNamespace(p.SystemNamespace)
+		endpoint.SetName(DNSEndpointName)
+		if err := unstructured.SetNestedSlice(endpoint.Object, endpoints, "spec", "endpoints"); err != nil {
+			return err
+		}
+		if err := p.Client.Create(ctx, endpoint); err != nil {
+			return errors.Wrap(err, "could not create DNSEndpoint")
+		}
+		return nil
+	case err != nil:
+		return errors.Wrap(err, "could not get DNSEndpoint")
+	}
+
+	current, _, err := unstructured.NestedSlice(endpoint.Object, "spec", "endpoints")
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(current, endpoints) {
+		return nil
+	}
+	if err := unstructured.SetNestedSlice(endpoint.Object, endpoints, "spec", "endpoints"); err != nil {
+		return err
+	}
+	if err := p.Client.Update(ctx, endpoint); err != nil {
+		return errors.Wrap(err, "could not update DNSEndpoint")
+	}
+	return nil
+}
+
+// endpoints converts records to the endpoints of DNSEndpoint in the form that is returned by the API server,
+// so they can be compared with the existing ones.
+func (p *DNSEndpointPublisher) endpoints(records []dns.ExternalDNSRecord) []interface{} {
+	endpoints := []interface{}{}
+	for _, record := range records {
+		targets := []interface{}{}
+		for _, address := range record.Addresses {
+			targets = append(targets, address)
+		}
+		endpoints = append(endpoints, map[string]interface{}{
+			"dnsName":    record.Name,
+			"recordType": "A",
+			"recordTTL":  int64(p.TTL),
+			"targets":    targets,
+		})
+	}
+	return endpoints
+}
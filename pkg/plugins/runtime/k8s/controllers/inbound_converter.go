@@ -118,6 +118,17 @@ func InboundInterfacesFor(zone string, pod *kube_core.Pod, services []*kube_core
 
 		ifaces = append(ifaces, inboundForServiceless(zone, pod)...)
 	}
+
+	// A terminating Pod is still ready until its containers are stopped. We mark its inbounds
+	// as not ready straight away, so the endpoints are removed from other Dataplanes
+	// while the kuma-sidecar container drains the connections.
+	if pod.DeletionTimestamp != nil {
+		for _, iface := range ifaces {
+			iface.Health = &mesh_proto.Dataplane_Networking_Inbound_Health{
+				Ready: false,
+			}
+		}
+	}
 	return ifaces, nil
 }
 
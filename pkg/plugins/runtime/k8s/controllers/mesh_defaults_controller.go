@@ -24,6 +24,7 @@ import (
 // MeshDefaultsReconciler creates default resources for created Mesh
 type MeshDefaultsReconciler struct {
 	ResourceManager manager.ResourceManager
+	PolicyTemplates defaults_mesh.PolicyTemplates
 }
 
 func (r *MeshDefaultsReconciler) Reconcile(ctx context.Context, req kube_ctrl.Request) (kube_ctrl.Result, error) {
@@ -42,7 +43,7 @@ func (r *MeshDefaultsReconciler) Reconcile(ctx context.Context, req kube_ctrl.Re
 		return kube_ctrl.Result{}, nil
 	}
 
-	if err := defaults_mesh.EnsureDefaultMeshResources(r.ResourceManager, req.Name); err != nil {
+	if err := defaults_mesh.EnsureDefaultMeshResources(r.ResourceManager, req.Name, mesh.Spec.GetSkipCreatingInitialPolicies(), r.PolicyTemplates); err != nil {
 		return kube_ctrl.Result{}, errors.Wrap(err, "could not create default mesh resources")
 	}
 
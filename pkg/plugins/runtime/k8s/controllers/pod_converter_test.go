@@ -231,6 +231,11 @@ var _ = Describe("PodToDataplane(..)", func() {
 			servicesForPod: "15.services-for-pod.yaml",
 			dataplane:      "15.dataplane.yaml",
 		}),
+		Entry("16. Pod that is terminating", testCase{
+			pod:            "16.pod.yaml",
+			servicesForPod: "16.services-for-pod.yaml",
+			dataplane:      "16.dataplane.yaml",
+		}),
 	)
 
 	DescribeTable("should convert Ingress Pod into an Ingress Dataplane YAML version",
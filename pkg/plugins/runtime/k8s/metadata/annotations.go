@@ -67,6 +67,7 @@ const (
 
 	KumaTrafficExcludeInboundPorts  = "traffic.kuma.io/exclude-inbound-ports"
 	KumaTrafficExcludeOutboundPorts = "traffic.kuma.io/exclude-outbound-ports"
+	KumaTrafficExcludeOutboundIPs   = "traffic.kuma.io/exclude-outbound-ips"
 )
 
 // Annotations that are being automatically set by the Kuma Sidecar Injector.
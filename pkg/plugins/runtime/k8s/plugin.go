@@ -10,8 +10,10 @@ import (
 	kube_webhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	config_core "github.com/kumahq/kuma/pkg/config/core"
+	dns_server "github.com/kumahq/kuma/pkg/config/dns-server"
 	"github.com/kumahq/kuma/pkg/core"
 	externalservice "github.com/kumahq/kuma/pkg/core/managers/apis/external_service"
+	"github.com/kumahq/kuma/pkg/core/managers/apis/guardrails"
 	"github.com/kumahq/kuma/pkg/core/managers/apis/ratelimit"
 	"github.com/kumahq/kuma/pkg/core/managers/apis/zone"
 	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
@@ -20,6 +22,7 @@ import (
 	core_registry "github.com/kumahq/kuma/pkg/core/resources/registry"
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/core/secrets/manager"
+	defaults_mesh "github.com/kumahq/kuma/pkg/defaults/mesh"
 	"github.com/kumahq/kuma/pkg/dns"
 	"github.com/kumahq/kuma/pkg/dns/vips"
 	k8s_common "github.com/kumahq/kuma/pkg/plugins/common/k8s"
@@ -133,8 +136,13 @@ func addMeshReconciler(mgr kube_ctrl.Manager, rt core_runtime.Runtime, converter
 	if err := reconciler.SetupWithManager(mgr); err != nil {
 		return errors.Wrap(err, "could not setup mesh reconciller")
 	}
+	policyTemplates, err := defaults_mesh.LoadPolicyTemplates(rt.Config().Defaults.MeshPolicyTemplates)
+	if err != nil {
+		return errors.Wrap(err, "could not load templates of the default policies of meshes")
+	}
 	defaultsReconciller := &k8s_controllers.MeshDefaultsReconciler{
 		ResourceManager: rt.ResourceManager(),
+		PolicyTemplates: policyTemplates,
 	}
 	if err := defaultsReconciller.SetupWithManager(mgr); err != nil {
 		return errors.Wrap(err, "could not setup mesh defaults reconciller")
@@ -199,6 +207,24 @@ func addDNS(mgr kube_ctrl.Manager, rt core_runtime.Runtime, converter k8s_common
 	if err := reconciler.SetupWithManager(mgr); err != nil {
 		return err
 	}
+	if cfg := rt.Config().DNSServer; cfg.ExternalDNS.Type == dns_server.ExternalDNSTypeCRD {
+		publisher := &k8s_controllers.DNSEndpointPublisher{
+			Client:          mgr.GetClient(),
+			Reader:          mgr.GetAPIReader(),
+			SystemNamespace: rt.Config().Store.Kubernetes.SystemNamespace,
+			TTL:             cfg.ExternalDNS.TTL,
+		}
+		externalDNSSync := dns.NewExternalDNSSynchronizer(
+			publisher,
+			rt.ReadOnlyResourceManager(),
+			rt.ConfigManager(),
+			cfg.ExternalDNSDomains(),
+			cfg.ExternalDNS.SyncInterval,
+		)
+		if err := rt.Add(externalDNSSync); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -251,6 +277,12 @@ func addValidators(mgr kube_ctrl.Manager, rt core_runtime.Runtime, converter k8s
 	k8sExternalServiceValidator := k8s_webhooks.NewExternalServiceValidatorWebhook(externalServiceValidator, converter)
 	composite.AddValidator(k8sExternalServiceValidator)
 
+	guardrailsValidator := guardrails.Validator{
+		Store: rt.ResourceStore(),
+	}
+	k8sGuardrailsValidator := k8s_webhooks.NewGuardrailsValidatorWebhook(guardrailsValidator, converter, rt.Config().Store.Kubernetes.SystemNamespace)
+	composite.AddValidator(k8sGuardrailsValidator)
+
 	coreZoneValidator := zone.Validator{Store: rt.ResourceStore()}
 	k8sZoneValidator := k8s_webhooks.NewZoneValidatorWebhook(coreZoneValidator)
 	composite.AddValidator(k8sZoneValidator)
@@ -0,0 +1,82 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/api/admission/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	guardrails_managers "github.com/kumahq/kuma/pkg/core/managers/apis/guardrails"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_registry "github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	k8s_common "github.com/kumahq/kuma/pkg/plugins/common/k8s"
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	k8s_registry "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+var guardedTypes = map[core_model.ResourceType]bool{
+	core_mesh.ExternalServiceType: true,
+	core_mesh.ProxyPatchType:      true,
+	core_mesh.ProxyTemplateType:   true,
+}
+
+func NewGuardrailsValidatorWebhook(validator guardrails_managers.Validator, converter k8s_common.Converter, systemNamespace string) k8s_common.AdmissionValidator {
+	return &GuardrailsValidator{
+		validator:       validator,
+		converter:       converter,
+		systemNamespace: systemNamespace,
+	}
+}
+
+// GuardrailsValidator rejects resources that use features denied by the guardrails of their mesh.
+type GuardrailsValidator struct {
+	validator       guardrails_managers.Validator
+	converter       k8s_common.Converter
+	decoder         *admission.Decoder
+	systemNamespace string
+}
+
+func (h *GuardrailsValidator) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+func (h *GuardrailsValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != v1.Create && req.Operation != v1.Update {
+		return admission.Allowed("")
+	}
+	if isKumaServiceAccount(req.UserInfo, h.systemNamespace) {
+		// resources synced from Global are validated by Global
+		return admission.Allowed("")
+	}
+	coreRes, err := core_registry.Global().NewObject(core_model.ResourceType(req.Kind.Kind))
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	k8sObj, err := k8s_registry.Global().NewObject(coreRes.GetSpec())
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if err := h.decoder.Decode(req, k8sObj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if err := h.converter.ToCoreResource(k8sObj, coreRes); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if err := h.validator.ValidateCreate(ctx, k8sObj.GetMesh(), coreRes); err != nil {
+		if kumaErr, ok := err.(*validators.ValidationError); ok {
+			return convertSpecValidationError(kumaErr, k8sObj)
+		}
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("")
+}
+
+func (h *GuardrailsValidator) Supports(req admission.Request) bool {
+	return req.Kind.Group == mesh_k8s.GroupVersion.Group &&
+		req.Kind.Version == mesh_k8s.GroupVersion.Version &&
+		guardedTypes[core_model.ResourceType(req.Kind.Kind)]
+}
@@ -497,16 +497,28 @@ func (i *KumaInjector) NewAnnotations(pod *kube_core.Pod, mesh *core_mesh.MeshRe
 		return nil, errors.Wrap(err, fmt.Sprintf("unable to set %s", metadata.KumaVirtualProbesPortAnnotation))
 	}
 
+	// Exclusions of the pod take precedence over the ones of the mesh,
+	// which take precedence over the defaults of the control plane.
+	exclusions := mesh.Spec.GetNetworking().GetTransparentProxying()
 	if val, exist := metadata.Annotations(pod.Annotations).GetString(metadata.KumaTrafficExcludeInboundPorts); exist {
 		annotations[metadata.KumaTrafficExcludeInboundPorts] = val
+	} else if len(exclusions.GetExcludeInboundPorts()) > 0 {
+		annotations[metadata.KumaTrafficExcludeInboundPorts] = portsToAnnotationValue(exclusions.GetExcludeInboundPorts())
 	} else if len(i.cfg.SidecarTraffic.ExcludeInboundPorts) > 0 {
 		annotations[metadata.KumaTrafficExcludeInboundPorts] = portsToAnnotationValue(i.cfg.SidecarTraffic.ExcludeInboundPorts)
 	}
 	if val, exist := metadata.Annotations(pod.Annotations).GetString(metadata.KumaTrafficExcludeOutboundPorts); exist {
 		annotations[metadata.KumaTrafficExcludeOutboundPorts] = val
+	} else if len(exclusions.GetExcludeOutboundPorts()) > 0 {
+		annotations[metadata.KumaTrafficExcludeOutboundPorts] = portsToAnnotationValue(exclusions.GetExcludeOutboundPorts())
 	} else if len(i.cfg.SidecarTraffic.ExcludeOutboundPorts) > 0 {
 		annotations[metadata.KumaTrafficExcludeOutboundPorts] = portsToAnnotationValue(i.cfg.SidecarTraffic.ExcludeOutboundPorts)
 	}
+	if val, exist := metadata.Annotations(pod.Annotations).GetString(metadata.KumaTrafficExcludeOutboundIPs); exist {
+		annotations[metadata.KumaTrafficExcludeOutboundIPs] = val
+	} else if len(exclusions.GetExcludeOutboundIPs()) > 0 {
+		annotations[metadata.KumaTrafficExcludeOutboundIPs] = strings.Join(exclusions.GetExcludeOutboundIPs(), ",")
+	}
 	return annotations, nil
 }
 
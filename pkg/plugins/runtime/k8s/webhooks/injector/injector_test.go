@@ -550,5 +550,30 @@ var _ = Describe("Injector", func() {
                   kuma.io/sidecar-injection: enabled`,
 			cfgFile: "inject.high-resources.config.yaml",
 		}),
+		Entry("28. traffic exclusions of the mesh", testCase{
+			num: "28",
+			mesh: `
+              apiVersion: kuma.io/v1alpha1
+              kind: Mesh
+              metadata:
+                name: default
+              spec:
+                networking:
+                  transparentProxying:
+                    excludeInboundPorts:
+                    - 9090
+                    excludeOutboundPorts:
+                    - 5432
+                    excludeOutboundIPs:
+                    - 169.254.169.254/32`,
+			namespace: `
+              apiVersion: v1
+              kind: Namespace
+              metadata:
+                name: default
+                annotations:
+                  kuma.io/sidecar-injection: enabled`,
+			cfgFile: "inject.config.yaml",
+		}),
 	)
 })
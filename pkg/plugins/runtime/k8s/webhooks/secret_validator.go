@@ -12,6 +12,7 @@ import (
 	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	"github.com/kumahq/kuma/pkg/core/secrets/external"
 	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	"github.com/kumahq/kuma/pkg/core/validators"
 	common_k8s "github.com/kumahq/kuma/pkg/plugins/common/k8s"
@@ -120,10 +121,31 @@ func (v *SecretValidator) validateMeshSecret(ctx context.Context, verr *validato
 			verr.AddViolationAt(validators.RootedAt("metadata").Field("labels").Key(meshLabel), "cannot change mesh of the Secret. Delete the Secret first and apply it again.")
 		}
 	}
+	if ref := common_k8s.SecretExternal(secret.Data); ref != nil {
+		if len(secret.Data["value"]) > 0 {
+			verr.AddViolationAt(validators.RootedAt("data").Field("value"), "must be empty when the secret references an external value.")
+		}
+		refErr := external.Validate(ref)
+		verr.AddErrorAt(validators.RootedAt("data"), *refErr.Transform(externalDataKey))
+		return nil
+	}
 	v.validateSecretData(verr, secret)
 	return nil
 }
 
+// externalDataKey maps the fields of the reference to an external value to the keys of the data of the secret.
+func externalDataKey(violation validators.Violation) validators.Violation {
+	switch violation.Field {
+	case "provider":
+		violation.Field = common_k8s.SecretExternalProviderKey
+	case "path":
+		violation.Field = common_k8s.SecretExternalPathKey
+	case "key":
+		violation.Field = common_k8s.SecretExternalKeyKey
+	}
+	return violation
+}
+
 func (v *SecretValidator) validateGlobalSecret(verr *validators.ValidationError, secret *kube_core.Secret) {
 	if _, ok := secret.GetLabels()[meshLabel]; ok {
 		verr.AddViolationAt(validators.RootedAt("metadata").Field("labels").Key(meshLabel), "mesh cannot be set on global secret")
@@ -356,6 +356,90 @@ var _ = Describe("ServiceValidator", func() {
               reason: Invalid
               status: Failure
             uid: ""
+`,
+		}),
+		Entry("should allow mesh Secret that references an external value", testCase{
+			request: `
+            apiVersion: admission.k8s.io/v1
+            kind: AdmissionReview
+            request:
+              uid: 12345
+              kind:
+                group: ""
+                kind: Secret
+                version: v1
+              name: sec-1
+              namespace: kuma-system
+              object:
+                apiVersion: v1
+                kind: Secret
+                metadata:
+                  name: sec-1
+                  namespace: kuma-system
+                  labels:
+                    kuma.io/mesh: default
+                data:
+                  externalProvider: dmF1bHQ=
+                  externalPath: a3VtYS9nYXRld2F5
+                  externalKey: dGxzLnBlbQ==
+                type: system.kuma.io/secret
+              operation: CREATE
+`,
+			expected: `
+            allowed: true
+            status:
+              code: 200
+              metadata: {}
+            uid: ""
+`,
+		}),
+		Entry("should not allow Vault Secret without key", testCase{
+			request: `
+            apiVersion: admission.k8s.io/v1
+            kind: AdmissionReview
+            request:
+              uid: 12345
+              kind:
+                group: ""
+                kind: Secret
+                version: v1
+              name: sec-1
+              namespace: kuma-system
+              object:
+                apiVersion: v1
+                kind: Secret
+                metadata:
+                  name: sec-1
+                  namespace: kuma-system
+                  labels:
+                    kuma.io/mesh: default
+                data:
+                  value: dGVzdAo=
+                  externalProvider: dmF1bHQ=
+                  externalPath: a3VtYS9nYXRld2F5
+                type: system.kuma.io/secret
+              operation: CREATE
+`,
+			expected: `
+            allowed: false
+            status:
+              code: 422
+              details:
+                causes:
+                - field: data.value
+                  message: must be empty when the secret references an external value.
+                  reason: FieldValueInvalid
+                - field: data.externalKey
+                  message: cannot be empty for Vault secrets
+                  reason: FieldValueInvalid
+                kind: Secret
+                name: sec-1
+              message: 'data.value: must be empty when the secret references an external
+                value.; data.externalKey: cannot be empty for Vault secrets'
+              metadata: {}
+              reason: Invalid
+              status: Failure
+            uid: ""
 `,
 		}),
 		Entry("should not allow global Secret without data", testCase{
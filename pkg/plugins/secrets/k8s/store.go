@@ -229,8 +229,19 @@ func (c *SimpleConverter) ToKubernetesObject(r core_model.Resource) (*kube_core.
 	switch r.Descriptor().Name {
 	case secret_model.SecretType:
 		secret.Type = common_k8s.MeshSecretType
-		secret.Data = map[string][]byte{
-			"value": r.(*secret_model.SecretResource).Spec.GetData().GetValue(),
+		spec := r.(*secret_model.SecretResource).Spec
+		if ref := spec.GetExternal(); ref != nil {
+			secret.Data = map[string][]byte{
+				common_k8s.SecretExternalProviderKey: []byte(ref.GetProvider()),
+				common_k8s.SecretExternalPathKey:     []byte(ref.GetPath()),
+			}
+			if ref.GetKey() != "" {
+				secret.Data[common_k8s.SecretExternalKeyKey] = []byte(ref.GetKey())
+			}
+		} else {
+			secret.Data = map[string][]byte{
+				"value": spec.GetData().GetValue(),
+			}
 		}
 		if r.GetMeta() != nil {
 			labels := map[string]string{
@@ -262,9 +273,13 @@ func (c *SimpleConverter) ToCoreResource(secret *kube_core.Secret, out core_mode
 		SecretType: secret.Type,
 	})
 	if secret.Data != nil {
-		_ = out.SetSpec(&system_proto.Secret{
-			Data: util_proto.Bytes(secret.Data["value"]),
-		})
+		spec := &system_proto.Secret{}
+		if ref := common_k8s.SecretExternal(secret.Data); ref != nil && secret.Type == common_k8s.MeshSecretType {
+			spec.External = ref
+		} else {
+			spec.Data = util_proto.Bytes(secret.Data["value"])
+		}
+		_ = out.SetSpec(spec)
 	}
 	return nil
 }
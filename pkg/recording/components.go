@@ -0,0 +1,23 @@
+package recording
+
+import (
+	"time"
+
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+)
+
+// Setup exposes the recording of requests in the API server. Recordings are
+// stored as secrets, which a zone Control Plane receives from the global
+// one, so requests can be recorded only in the standalone mode.
+func Setup(rt core_runtime.Runtime) error {
+	endpoints := &recordingEndpoints{
+		recorder: &Recorder{
+			ResourceManager:  rt.ResourceManager(),
+			EnvoyAdminClient: rt.EnvoyAdminClient(),
+			Now:              time.Now,
+		},
+		resourceAccess: rt.Access().ResourceAccess,
+	}
+	rt.APIManager().Add(endpoints.webService())
+	return nil
+}
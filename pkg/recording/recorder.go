@@ -0,0 +1,196 @@
+package recording
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/data/tap/v3"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+)
+
+var log = core.Log.WithName("recording")
+
+const (
+	DefaultMaxBodyBytes = 4096
+	DefaultMaxRequests  = 100
+	DefaultDuration     = 30 * time.Second
+	// MaxDuration leaves time to store the recording before kumactl gives
+	// up waiting for the response after 60s.
+	MaxDuration = 45 * time.Second
+)
+
+// Recorder records requests handled by data plane proxies and stores them
+// encrypted as secrets of the mesh.
+type Recorder struct {
+	ResourceManager  manager.ResourceManager
+	EnvoyAdminClient admin.EnvoyAdminClient
+	Now              func() time.Time
+}
+
+// SecretName returns a name of the secret that stores a recording of the dataplane.
+func SecretName(dataplane string, creationTime time.Time) string {
+	return secretPrefix(dataplane) + strconv.FormatInt(creationTime.Unix(), 10)
+}
+
+func secretPrefix(dataplane string) string {
+	return fmt.Sprintf("recording-%s-", dataplane)
+}
+
+// Record records requests handled by the dataplane for the duration and
+// stores them encrypted with the key.
+func (r *Recorder) Record(ctx context.Context, meshName string, dataplaneName string, key []byte, duration time.Duration) (*types.RecordingSummary, error) {
+	var verr validators.ValidationError
+	if len(key) != KeySize {
+		verr.AddViolation("key", fmt.Sprintf("has to be %d bytes long", KeySize))
+	}
+	if duration <= 0 || duration > MaxDuration {
+		verr.AddViolation("duration", fmt.Sprintf("has to be in (0 - %s] range", MaxDuration))
+	}
+	if err := verr.OrNil(); err != nil {
+		return nil, err
+	}
+
+	mesh := core_mesh.NewMeshResource()
+	if err := r.ResourceManager.Get(ctx, mesh, store.GetByKey(meshName, core_model.NoMesh)); err != nil {
+		return nil, err
+	}
+	settings := mesh.Spec.GetRecording()
+	if !settings.GetEnabled() {
+		verr.AddViolation("mesh", "recording is not enabled in the mesh")
+		return nil, verr.OrNil()
+	}
+	maxBodyBytes := uint32(DefaultMaxBodyBytes)
+	if settings.GetMaxBodyBytes() != nil {
+		maxBodyBytes = settings.GetMaxBodyBytes().GetValue()
+	}
+	maxRequests := uint32(DefaultMaxRequests)
+	if settings.GetMaxRequests() != nil {
+		maxRequests = settings.GetMaxRequests().GetValue()
+	}
+
+	dataplane := core_mesh.NewDataplaneResource()
+	if err := r.ResourceManager.Get(ctx, dataplane, store.GetByKey(dataplaneName, meshName)); err != nil {
+		return nil, err
+	}
+
+	log.Info("recording requests", "mesh", meshName, "dataplane", dataplaneName, "duration", duration)
+	traces, err := r.EnvoyAdminClient.Tap(dataplane, envoy_listeners_v3.RecordingTapConfigID, maxRequests, maxBodyBytes, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.Now()
+	recording := &types.Recording{
+		Name:         SecretName(dataplaneName, now),
+		Mesh:         meshName,
+		Dataplane:    dataplaneName,
+		CreationTime: now,
+		Requests:     FromTraces(traces),
+	}
+	data, err := Seal(key, recording)
+	if err != nil {
+		return nil, err
+	}
+	secret := system.NewSecretResource()
+	secret.Spec.Data = util_proto.Bytes(data)
+	if err := r.ResourceManager.Create(ctx, secret, store.CreateByKey(recording.Name, meshName)); err != nil {
+		return nil, err
+	}
+
+	return &types.RecordingSummary{
+		Name:      recording.Name,
+		Mesh:      meshName,
+		Dataplane: dataplaneName,
+		Requests:  len(recording.Requests),
+	}, nil
+}
+
+// Get returns a stored recording of the dataplane. The recording stays
+// encrypted, only the holder of the key can read it.
+func (r *Recorder) Get(ctx context.Context, meshName string, dataplaneName string, name string) (*types.EncryptedRecording, error) {
+	// never expose other secrets of the mesh
+	if !strings.HasPrefix(name, secretPrefix(dataplaneName)) {
+		return nil, store.ErrorResourceNotFound(system.SecretType, name, meshName)
+	}
+	secret := system.NewSecretResource()
+	if err := r.ResourceManager.Get(ctx, secret, store.GetByKey(name, meshName)); err != nil {
+		return nil, err
+	}
+	return &types.EncryptedRecording{
+		Name: name,
+		Data: secret.Spec.GetData().GetValue(),
+	}, nil
+}
+
+// FromTraces converts the traces of the Envoy tap filter to recorded requests.
+func FromTraces(traces []*envoy_data_tap_v3.TraceWrapper) []types.RecordedRequest {
+	requests := []types.RecordedRequest{}
+	for _, trace := range traces {
+		http := trace.GetHttpBufferedTrace()
+		if http == nil {
+			continue
+		}
+		request := types.RecordedRequest{}
+		for _, header := range http.GetRequest().GetHeaders() {
+			switch header.GetKey() {
+			case ":method":
+				request.Method = header.GetValue()
+			case ":path":
+				request.Path = header.GetValue()
+			case ":authority":
+				request.Authority = header.GetValue()
+			default:
+				request.Headers = appendHeader(request.Headers, header)
+			}
+		}
+		request.Body, request.BodyTruncated = body(http.GetRequest().GetBody())
+
+		for _, header := range http.GetResponse().GetHeaders() {
+			if header.GetKey() == ":status" {
+				request.Response.Status, _ = strconv.Atoi(header.GetValue())
+				continue
+			}
+			request.Response.Headers = appendHeader(request.Response.Headers, header)
+		}
+		request.Response.Body, request.Response.BodyTruncated = body(http.GetResponse().GetBody())
+
+		requests = append(requests, request)
+	}
+	return requests
+}
+
+func appendHeader(headers []types.RecordedHeader, header *envoy_core.HeaderValue) []types.RecordedHeader {
+	// the remaining pseudo-headers, like :scheme, are not sent again
+	if strings.HasPrefix(header.GetKey(), ":") {
+		return headers
+	}
+	return append(headers, types.RecordedHeader{
+		Name:  header.GetKey(),
+		Value: header.GetValue(),
+	})
+}
+
+func body(body *envoy_data_tap_v3.Body) ([]byte, bool) {
+	if body == nil {
+		return nil, false
+	}
+	if body.GetAsString() != "" {
+		return []byte(body.GetAsString()), body.GetTruncated()
+	}
+	return body.GetAsBytes(), body.GetTruncated()
+}
@@ -0,0 +1,182 @@
+package recording_test
+
+import (
+	"context"
+	"time"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/data/tap/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/recording"
+	"github.com/kumahq/kuma/pkg/test/runtime"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+func httpTrace(method string, path string, body string, truncated bool, status string) *envoy_data_tap_v3.TraceWrapper {
+	return &envoy_data_tap_v3.TraceWrapper{
+		Trace: &envoy_data_tap_v3.TraceWrapper_HttpBufferedTrace{
+			HttpBufferedTrace: &envoy_data_tap_v3.HttpBufferedTrace{
+				Request: &envoy_data_tap_v3.HttpBufferedTrace_Message{
+					Headers: []*envoy_core.HeaderValue{
+						{Key: ":method", Value: method},
+						{Key: ":path", Value: path},
+						{Key: ":authority", Value: "backend"},
+						{Key: ":scheme", Value: "http"},
+						{Key: "x-request-id", Value: "1"},
+					},
+					Body: &envoy_data_tap_v3.Body{
+						BodyType:  &envoy_data_tap_v3.Body_AsBytes{AsBytes: []byte(body)},
+						Truncated: truncated,
+					},
+				},
+				Response: &envoy_data_tap_v3.HttpBufferedTrace_Message{
+					Headers: []*envoy_core.HeaderValue{
+						{Key: ":status", Value: status},
+						{Key: "content-type", Value: "text/plain"},
+					},
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("Recorder", func() {
+
+	var rm manager.ResourceManager
+	var recorder *recording.Recorder
+	var key []byte
+	now := time.Unix(1631000000, 0)
+
+	BeforeEach(func() {
+		rm = manager.NewResourceManager(memory_resources.NewStore())
+		recorder = &recording.Recorder{
+			ResourceManager: rm,
+			EnvoyAdminClient: &runtime.DummyEnvoyAdminClient{
+				Traces: []*envoy_data_tap_v3.TraceWrapper{
+					httpTrace("GET", "/orders?id=1", "", false, "200"),
+					httpTrace("POST", "/orders", `{"item": "book"}`, false, "500"),
+					httpTrace("POST", "/orders", "abc", true, "201"),
+				},
+			},
+			Now: func() time.Time {
+				return now
+			},
+		}
+
+		var err error
+		key, err = recording.NewKey()
+		Expect(err).ToNot(HaveOccurred())
+
+		mesh := core_mesh.NewMeshResource()
+		mesh.Spec.Recording = &mesh_proto.Recording{
+			Enabled:     true,
+			MaxRequests: util_proto.UInt32(2),
+		}
+		Expect(rm.Create(context.Background(), mesh, store.CreateByKey("default", core_model.NoMesh))).To(Succeed())
+
+		dataplane := core_mesh.NewDataplaneResource()
+		dataplane.Spec = &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+					Port: 8080,
+					Tags: map[string]string{
+						mesh_proto.ServiceTag:  "backend",
+						mesh_proto.ProtocolTag: "http",
+					},
+				}},
+			},
+		}
+		Expect(rm.Create(context.Background(), dataplane, store.CreateByKey("backend-01", "default"))).To(Succeed())
+	})
+
+	It("should store encrypted recording", func() {
+		// when
+		summary, err := recorder.Record(context.Background(), "default", "backend-01", key, time.Second)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*summary).To(Equal(types.RecordingSummary{
+			Name:      "recording-backend-01-1631000000",
+			Mesh:      "default",
+			Dataplane: "backend-01",
+			Requests:  2,
+		}))
+
+		// when
+		encrypted, err := recorder.Get(context.Background(), "default", "backend-01", summary.Name)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then the stored recording can be read only with the key
+		_, err = recording.Open(make([]byte, recording.KeySize), encrypted.Data)
+		Expect(err).To(MatchError(ContainSubstring("could not decrypt the recording")))
+
+		// when
+		rec, err := recording.Open(key, encrypted.Data)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rec.Dataplane).To(Equal("backend-01"))
+		Expect(rec.CreationTime.Equal(now)).To(BeTrue())
+		Expect(rec.Requests).To(Equal([]types.RecordedRequest{
+			{
+				Method:    "GET",
+				Path:      "/orders?id=1",
+				Authority: "backend",
+				Headers:   []types.RecordedHeader{{Name: "x-request-id", Value: "1"}},
+				Response: types.RecordedResponse{
+					Status:  200,
+					Headers: []types.RecordedHeader{{Name: "content-type", Value: "text/plain"}},
+				},
+			},
+			{
+				Method:    "POST",
+				Path:      "/orders",
+				Authority: "backend",
+				Headers:   []types.RecordedHeader{{Name: "x-request-id", Value: "1"}},
+				Body:      []byte(`{"item": "book"}`),
+				Response: types.RecordedResponse{
+					Status:  500,
+					Headers: []types.RecordedHeader{{Name: "content-type", Value: "text/plain"}},
+				},
+			},
+		}))
+	})
+
+	It("should reject recording when it is not enabled in the mesh", func() {
+		// given
+		mesh := core_mesh.NewMeshResource()
+		Expect(rm.Get(context.Background(), mesh, store.GetByKey("default", core_model.NoMesh))).To(Succeed())
+		mesh.Spec.Recording = nil
+		Expect(rm.Update(context.Background(), mesh)).To(Succeed())
+
+		// when
+		_, err := recorder.Record(context.Background(), "default", "backend-01", key, time.Second)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("recording is not enabled in the mesh")))
+	})
+
+	It("should not expose secrets that are not recordings", func() {
+		// given
+		secret := system.NewSecretResource()
+		secret.Spec.Data = util_proto.Bytes([]byte("password"))
+		Expect(rm.Create(context.Background(), secret, store.CreateByKey("db-password", "default"))).To(Succeed())
+
+		// when
+		_, err := recorder.Get(context.Background(), "default", "backend-01", "db-password")
+
+		// then
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,11 @@
+package recording_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestRecording(t *testing.T) {
+	test.RunSpecs(t, "Recording Suite")
+}
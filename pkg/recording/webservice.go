@@ -0,0 +1,103 @@
+package recording
+
+import (
+	"time"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/resources/access"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// recordingEndpoints exposes recordings of requests handled by data plane
+// proxies. Recordings are stored as secrets, so they require the same
+// access as secrets.
+type recordingEndpoints struct {
+	recorder       *Recorder
+	resourceAccess access.ResourceAccess
+}
+
+func (e *recordingEndpoints) webService() *restful.WebService {
+	ws := new(restful.WebService).
+		Path("/meshes/{mesh}/dataplanes/{name}/recordings").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.POST("").To(e.record).
+		Doc("Record requests handled by a dataplane").
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(400, "Bad request", nil).
+		Returns(404, "Not found", nil))
+	ws.Route(ws.GET("/{recording}").To(e.get).
+		Doc("Get an encrypted recording of requests handled by a dataplane").
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.PathParameter("recording", "Name of a recording").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+	return ws
+}
+
+func (e *recordingEndpoints) record(request *restful.Request, response *restful.Response) {
+	meshName := request.PathParameter("mesh")
+	dataplaneName := request.PathParameter("name")
+
+	recordingRequest := types.RecordingRequest{}
+	if err := request.ReadEntity(&recordingRequest); err != nil {
+		rest_errors.HandleError(response, err, "Could not record requests")
+		return
+	}
+	duration := DefaultDuration
+	if recordingRequest.Duration != "" {
+		var err error
+		if duration, err = time.ParseDuration(recordingRequest.Duration); err != nil {
+			verr := validators.ValidationError{}
+			verr.AddViolation("duration", "has to be a valid duration")
+			rest_errors.HandleError(response, &verr, "Could not record requests")
+			return
+		}
+	}
+
+	secret := system.NewSecretResource()
+	key := core_model.ResourceKey{Mesh: meshName, Name: SecretName(dataplaneName, e.recorder.Now())}
+	if err := e.resourceAccess.ValidateCreate(key, secret.Spec, secret.Descriptor(), user.FromCtx(request.Request.Context())); err != nil {
+		rest_errors.HandleError(response, err, "Access Denied")
+		return
+	}
+
+	summary, err := e.recorder.Record(request.Request.Context(), meshName, dataplaneName, recordingRequest.Key, duration)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not record requests")
+		return
+	}
+	if err := response.WriteAsJson(summary); err != nil {
+		rest_errors.HandleError(response, err, "Could not record requests")
+	}
+}
+
+func (e *recordingEndpoints) get(request *restful.Request, response *restful.Response) {
+	meshName := request.PathParameter("mesh")
+	dataplaneName := request.PathParameter("name")
+	name := request.PathParameter("recording")
+
+	key := core_model.ResourceKey{Mesh: meshName, Name: name}
+	if err := e.resourceAccess.ValidateGet(key, system.NewSecretResource().Descriptor(), user.FromCtx(request.Request.Context())); err != nil {
+		rest_errors.HandleError(response, err, "Access Denied")
+		return
+	}
+
+	recording, err := e.recorder.Get(request.Request.Context(), meshName, dataplaneName, name)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a recording")
+		return
+	}
+	if err := response.WriteAsJson(recording); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a recording")
+	}
+}
@@ -0,0 +1,203 @@
+package servicediscovery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	util_aws "github.com/kumahq/kuma/pkg/util/aws"
+)
+
+const (
+	cloudMapSigningName   = "servicediscovery"
+	cloudMapTargetPrefix  = "Route53AutoNaming_v20170314."
+	cloudMapContentType   = "application/x-amz-json-1.1"
+	cloudMapHTTPNamespace = "HTTP"
+)
+
+// cloudMapClient is a minimal client of the AWS Cloud Map API that implements only the actions used by the exporter.
+type cloudMapClient struct {
+	client   *http.Client
+	endpoint string
+	region   string
+	creds    util_aws.CredentialsProvider
+}
+
+// Types below mirror the shapes of the API, encoding/json matches their fields with the API case-insensitively.
+
+type cloudMapNamespace struct {
+	ID   string
+	Type string
+}
+
+type cloudMapService struct {
+	ID          string
+	Name        string
+	Description string
+}
+
+type cloudMapInstance struct {
+	ID         string
+	Attributes map[string]string
+}
+
+type cloudMapDnsRecord struct {
+	Type string
+	TTL  int64
+}
+
+type cloudMapDnsConfig struct {
+	RoutingPolicy string
+	DnsRecords    []cloudMapDnsRecord
+}
+
+type cloudMapFilter struct {
+	Name      string
+	Values    []string
+	Condition string
+}
+
+type cloudMapError struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+}
+
+func (c *cloudMapClient) getNamespace(ctx context.Context, id string) (*cloudMapNamespace, error) {
+	out := struct {
+		Namespace cloudMapNamespace
+	}{}
+	if err := c.call(ctx, "GetNamespace", map[string]interface{}{"Id": id}, &out); err != nil {
+		return nil, err
+	}
+	return &out.Namespace, nil
+}
+
+func (c *cloudMapClient) listServices(ctx context.Context, namespaceID string) ([]cloudMapService, error) {
+	var services []cloudMapService
+	nextToken := ""
+	for {
+		in := map[string]interface{}{
+			"Filters": []cloudMapFilter{{Name: "NAMESPACE_ID", Values: []string{namespaceID}, Condition: "EQ"}},
+		}
+		if nextToken != "" {
+			in["NextToken"] = nextToken
+		}
+		out := struct {
+			Services  []cloudMapService
+			NextToken string
+		}{}
+		if err := c.call(ctx, "ListServices", in, &out); err != nil {
+			return nil, err
+		}
+		services = append(services, out.Services...)
+		if out.NextToken == "" {
+			return services, nil
+		}
+		nextToken = out.NextToken
+	}
+}
+
+func (c *cloudMapClient) createService(ctx context.Context, namespaceID string, name string, description string, dnsConfig *cloudMapDnsConfig) (string, error) {
+	in := map[string]interface{}{
+		"Name":        name,
+		"NamespaceId": namespaceID,
+		"Description": description,
+	}
+	if dnsConfig != nil {
+		in["DnsConfig"] = dnsConfig
+	}
+	out := struct {
+		Service cloudMapService
+	}{}
+	if err := c.call(ctx, "CreateService", in, &out); err != nil {
+		return "", err
+	}
+	return out.Service.ID, nil
+}
+
+func (c *cloudMapClient) listInstances(ctx context.Context, serviceID string) ([]cloudMapInstance, error) {
+	var instances []cloudMapInstance
+	nextToken := ""
+	for {
+		in := map[string]interface{}{"ServiceId": serviceID}
+		if nextToken != "" {
+			in["NextToken"] = nextToken
+		}
+		out := struct {
+			Instances []cloudMapInstance
+			NextToken string
+		}{}
+		if err := c.call(ctx, "ListInstances", in, &out); err != nil {
+			return nil, err
+		}
+		instances = append(instances, out.Instances...)
+		if out.NextToken == "" {
+			return instances, nil
+		}
+		nextToken = out.NextToken
+	}
+}
+
+func (c *cloudMapClient) registerInstance(ctx context.Context, serviceID string, instance cloudMapInstance) error {
+	return c.call(ctx, "RegisterInstance", map[string]interface{}{
+		"ServiceId":  serviceID,
+		"InstanceId": instance.ID,
+		"Attributes": instance.Attributes,
+	}, nil)
+}
+
+func (c *cloudMapClient) deregisterInstance(ctx context.Context, serviceID string, instanceID string) error {
+	return c.call(ctx, "DeregisterInstance", map[string]interface{}{
+		"ServiceId":  serviceID,
+		"InstanceId": instanceID,
+	}, nil)
+}
+
+func (c *cloudMapClient) call(ctx context.Context, action string, in interface{}, out interface{}) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", cloudMapContentType)
+	req.Header.Set("X-Amz-Target", cloudMapTargetPrefix+action)
+	creds, err := c.creds.Retrieve(ctx)
+	if err != nil {
+		return err
+	}
+	util_aws.Sign(req, body, creds, c.region, cloudMapSigningName, time.Now())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not call %s", action)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		cmErr := cloudMapError{}
+		if err := json.Unmarshal(respBody, &cmErr); err != nil || cmErr.Type == "" {
+			return errors.Errorf("%s failed with status code %d: %s", action, resp.StatusCode, string(respBody))
+		}
+		return errors.Errorf("%s failed: %s", action, cmErr)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+func (e cloudMapError) String() string {
+	return fmt.Sprintf("%s: %s", e.Type, e.Message)
+}
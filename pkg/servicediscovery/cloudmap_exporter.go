@@ -0,0 +1,263 @@
+package servicediscovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	service_discovery "github.com/kumahq/kuma/pkg/config/service-discovery"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	util_aws "github.com/kumahq/kuma/pkg/util/aws"
+)
+
+const (
+	// CloudMapZoneAttribute is a custom attribute of an exported instance with the zone of the instance
+	CloudMapZoneAttribute = "kuma.io/zone"
+	// CloudMapInstanceTypeAttribute is a custom attribute of an exported instance with its type,
+	// either CloudMapDataplaneInstance or CloudMapZoneIngressInstance
+	CloudMapInstanceTypeAttribute = "kuma.io/instance-type"
+
+	CloudMapDataplaneInstance   = "dataplane"
+	CloudMapZoneIngressInstance = "zone-ingress"
+
+	cloudMapIPv4Attribute = "AWS_INSTANCE_IPV4"
+	cloudMapPortAttribute = "AWS_INSTANCE_PORT"
+	cloudMapDnsTTL        = 60
+)
+
+var invalidCloudMapNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+// CloudMapServiceName returns a name of the AWS Cloud Map service of the mesh service.
+func CloudMapServiceName(service string) string {
+	return strings.Trim(invalidCloudMapNameCharacters.ReplaceAllString(service, "-"), "-.")
+}
+
+type cloudMapExporter struct {
+	client      *cloudMapClient
+	rm          manager.ReadOnlyResourceManager
+	mesh        string
+	namespaceID string
+	newTicker   func() *time.Ticker
+}
+
+// NewCloudMapExporter creates a component that keeps AWS Cloud Map services in the namespace in sync with services of the mesh.
+// Only services created by the exporter are modified, they are recognized by their description.
+func NewCloudMapExporter(cfg *service_discovery.CloudMapConfig, rm manager.ReadOnlyResourceManager) (component.Component, error) {
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://servicediscovery.%s.amazonaws.com", cfg.Region)
+	}
+	creds, err := util_aws.NewCredentialsProvider(cfg.Region, util_aws.Credentials{
+		AccessKeyID:     cfg.AccessKeyID,
+		SecretAccessKey: cfg.SecretAccessKey,
+		SessionToken:    cfg.SessionToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cloudMapExporter{
+		client: &cloudMapClient{
+			client:   &http.Client{Timeout: 10 * time.Second},
+			endpoint: endpoint,
+			region:   cfg.Region,
+			creds:    creds,
+		},
+		rm:          rm,
+		mesh:        cfg.Mesh,
+		namespaceID: cfg.NamespaceID,
+		newTicker: func() *time.Ticker {
+			return time.NewTicker(cfg.SyncInterval)
+		},
+	}, nil
+}
+
+func (e *cloudMapExporter) NeedLeaderElection() bool {
+	return true
+}
+
+func (e *cloudMapExporter) Start(stop <-chan struct{}) error {
+	ticker := e.newTicker()
+	defer ticker.Stop()
+
+	log.Info("starting the export of services to AWS Cloud Map", "namespace", e.namespaceID, "mesh", e.mesh)
+	for {
+		select {
+		case <-ticker.C:
+			if err := e.export(context.Background()); err != nil {
+				log.Error(err, "unable to export services to AWS Cloud Map", "namespace", e.namespaceID)
+			}
+		case <-stop:
+			log.Info("stopping the export of services to AWS Cloud Map")
+			return nil
+		}
+	}
+}
+
+func (e *cloudMapExporter) description() string {
+	return fmt.Sprintf("Exported by Kuma from the mesh %q", e.mesh)
+}
+
+func (e *cloudMapExporter) export(ctx context.Context) error {
+	desired, err := e.desiredInstances(ctx)
+	if err != nil {
+		return err
+	}
+	namespace, err := e.client.getNamespace(ctx, e.namespaceID)
+	if err != nil {
+		return err
+	}
+	services, err := e.client.listServices(ctx, e.namespaceID)
+	if err != nil {
+		return err
+	}
+
+	owned := map[string]string{}
+	foreign := map[string]bool{}
+	for _, service := range services {
+		if service.Description == e.description() {
+			owned[service.Name] = service.ID
+		} else {
+			foreign[service.Name] = true
+		}
+	}
+
+	var names []string
+	for name := range desired {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		serviceID, ok := owned[name]
+		if !ok {
+			if foreign[name] {
+				log.Info("skipping the service, because it already exists in AWS Cloud Map and it was not exported by Kuma", "name", name)
+				continue
+			}
+			var dnsConfig *cloudMapDnsConfig
+			if namespace.Type != cloudMapHTTPNamespace {
+				dnsConfig = &cloudMapDnsConfig{
+					RoutingPolicy: "MULTIVALUE",
+					DnsRecords: []cloudMapDnsRecord{
+						{Type: "A", TTL: cloudMapDnsTTL},
+						{Type: "SRV", TTL: cloudMapDnsTTL},
+					},
+				}
+			}
+			log.Info("creating AWS Cloud Map service", "name", name)
+			if serviceID, err = e.client.createService(ctx, e.namespaceID, name, e.description(), dnsConfig); err != nil {
+				return err
+			}
+		}
+		if err := e.syncInstances(ctx, serviceID, desired[name]); err != nil {
+			return err
+		}
+	}
+	for name, serviceID := range owned {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		if err := e.syncInstances(ctx, serviceID, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *cloudMapExporter) syncInstances(ctx context.Context, serviceID string, desired map[string]cloudMapInstance) error {
+	current, err := e.client.listInstances(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+	registered := map[string]map[string]string{}
+	for _, instance := range current {
+		registered[instance.ID] = instance.Attributes
+		if _, ok := desired[instance.ID]; !ok {
+			log.Info("deregistering AWS Cloud Map instance", "service", serviceID, "instance", instance.ID)
+			if err := e.client.deregisterInstance(ctx, serviceID, instance.ID); err != nil {
+				return err
+			}
+		}
+	}
+	for id, instance := range desired {
+		if attributes, ok := registered[id]; ok && reflect.DeepEqual(attributes, instance.Attributes) {
+			continue
+		}
+		log.Info("registering AWS Cloud Map instance", "service", serviceID, "instance", id)
+		if err := e.client.registerInstance(ctx, serviceID, instance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// desiredInstances returns instances of services of the mesh by the name of the AWS Cloud Map service and the ID of the instance.
+func (e *cloudMapExporter) desiredInstances(ctx context.Context) (map[string]map[string]cloudMapInstance, error) {
+	desired := map[string]map[string]cloudMapInstance{}
+	add := func(service string, address string, port uint32, zone string, instanceType string) {
+		if service == "" {
+			return
+		}
+		if ip := net.ParseIP(address); ip == nil || ip.To4() == nil {
+			log.V(1).Info("skipping an instance without IPv4 address", "service", service, "address", address)
+			return
+		}
+		name := CloudMapServiceName(service)
+		if desired[name] == nil {
+			desired[name] = map[string]cloudMapInstance{}
+		}
+		id := net.JoinHostPort(address, strconv.Itoa(int(port)))
+		attributes := map[string]string{
+			cloudMapIPv4Attribute:         address,
+			cloudMapPortAttribute:         strconv.Itoa(int(port)),
+			CloudMapInstanceTypeAttribute: instanceType,
+		}
+		if zone != "" {
+			attributes[CloudMapZoneAttribute] = zone
+		}
+		desired[name][id] = cloudMapInstance{ID: id, Attributes: attributes}
+	}
+
+	dataplanes := &core_mesh.DataplaneResourceList{}
+	if err := e.rm.List(ctx, dataplanes, store.ListByMesh(e.mesh)); err != nil {
+		return nil, err
+	}
+	for _, dp := range dataplanes.Items {
+		networking := dp.Spec.GetNetworking()
+		for _, inbound := range networking.GetInbound() {
+			if inbound.GetHealth() != nil && !inbound.GetHealth().GetReady() {
+				continue
+			}
+			iface := networking.ToInboundInterface(inbound)
+			add(inbound.GetService(), iface.DataplaneIP, iface.DataplanePort, inbound.GetTags()[mesh_proto.ZoneTag], CloudMapDataplaneInstance)
+		}
+	}
+
+	zoneIngresses := &core_mesh.ZoneIngressResourceList{}
+	if err := e.rm.List(ctx, zoneIngresses); err != nil {
+		return nil, err
+	}
+	for _, zoneIngress := range zoneIngresses.Items {
+		networking := zoneIngress.Spec.GetNetworking()
+		if networking.GetAdvertisedAddress() == "" || networking.GetAdvertisedPort() == 0 {
+			continue
+		}
+		for _, available := range zoneIngress.Spec.GetAvailableServices() {
+			if available.GetMesh() != e.mesh {
+				continue
+			}
+			add(available.GetTags()[mesh_proto.ServiceTag], networking.GetAdvertisedAddress(), networking.GetAdvertisedPort(), zoneIngress.Spec.GetZone(), CloudMapZoneIngressInstance)
+		}
+	}
+	return desired, nil
+}
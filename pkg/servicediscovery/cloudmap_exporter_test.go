@@ -0,0 +1,275 @@
+package servicediscovery_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	service_discovery "github.com/kumahq/kuma/pkg/config/service-discovery"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	resources_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/servicediscovery"
+)
+
+type fakeCloudMapService struct {
+	Name        string
+	Description string
+	DnsConfig   interface{}
+	Instances   map[string]map[string]string
+}
+
+// fakeCloudMap implements actions of the AWS Cloud Map API used by the exporter
+type fakeCloudMap struct {
+	sync.Mutex
+	namespaceType  string
+	services       map[string]*fakeCloudMapService
+	authorizations []string
+}
+
+func (f *fakeCloudMap) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	f.authorizations = append(f.authorizations, req.Header.Get("Authorization"))
+
+	in := struct {
+		Id          string
+		Name        string
+		Description string
+		DnsConfig   interface{}
+		ServiceId   string
+		InstanceId  string
+		Attributes  map[string]string
+	}{}
+	if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var out interface{}
+	switch strings.TrimPrefix(req.Header.Get("X-Amz-Target"), "Route53AutoNaming_v20170314.") {
+	case "GetNamespace":
+		out = map[string]interface{}{"Namespace": map[string]string{"Id": in.Id, "Type": f.namespaceType}}
+	case "ListServices":
+		var services []map[string]string
+		for id, service := range f.services {
+			services = append(services, map[string]string{"Id": id, "Name": service.Name, "Description": service.Description})
+		}
+		out = map[string]interface{}{"Services": services}
+	case "CreateService":
+		id := fmt.Sprintf("srv-%d", len(f.services))
+		f.services[id] = &fakeCloudMapService{
+			Name:        in.Name,
+			Description: in.Description,
+			DnsConfig:   in.DnsConfig,
+			Instances:   map[string]map[string]string{},
+		}
+		out = map[string]interface{}{"Service": map[string]string{"Id": id}}
+	case "ListInstances":
+		var instances []map[string]interface{}
+		for id, attributes := range f.services[in.ServiceId].Instances {
+			instances = append(instances, map[string]interface{}{"Id": id, "Attributes": attributes})
+		}
+		out = map[string]interface{}{"Instances": instances}
+	case "RegisterInstance":
+		f.services[in.ServiceId].Instances[in.InstanceId] = in.Attributes
+		out = map[string]string{"OperationId": "op"}
+	case "DeregisterInstance":
+		delete(f.services[in.ServiceId].Instances, in.InstanceId)
+		out = map[string]string{"OperationId": "op"}
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"__type": "UnknownOperationException", "message": "unknown operation"}`))
+		return
+	}
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+func (f *fakeCloudMap) service(name string) *fakeCloudMapService {
+	f.Lock()
+	defer f.Unlock()
+	for _, service := range f.services {
+		if service.Name == name {
+			copied := *service
+			copied.Instances = map[string]map[string]string{}
+			for id, attributes := range service.Instances {
+				copied.Instances[id] = attributes
+			}
+			return &copied
+		}
+	}
+	return nil
+}
+
+var _ = Describe("AWS Cloud Map exporter", func() {
+
+	var resManager resources_manager.ResourceManager
+	var cloudMap *fakeCloudMap
+	var server *httptest.Server
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resManager = resources_manager.NewResourceManager(memory_resources.NewStore())
+		for _, mesh := range []string{model.DefaultMesh, "other"} {
+			err := resManager.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(mesh, model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		cloudMap = &fakeCloudMap{
+			namespaceType: "DNS_PRIVATE",
+			services:      map[string]*fakeCloudMapService{},
+		}
+		server = httptest.NewServer(cloudMap)
+		stop = make(chan struct{})
+	})
+
+	AfterEach(func() {
+		close(stop)
+		server.Close()
+	})
+
+	startExporter := func() {
+		exporter, err := servicediscovery.NewCloudMapExporter(&service_discovery.CloudMapConfig{
+			Enabled:         true,
+			Region:          "eu-west-1",
+			NamespaceID:     "ns-abcdefgh12345678",
+			Mesh:            model.DefaultMesh,
+			AccessKeyID:     "AKIAEXAMPLE",
+			SecretAccessKey: "secret",
+			Endpoint:        server.URL,
+			SyncInterval:    10 * time.Millisecond,
+		}, resManager)
+		Expect(err).ToNot(HaveOccurred())
+		go func() {
+			defer GinkgoRecover()
+			Expect(exporter.Start(stop)).To(Succeed())
+		}()
+	}
+
+	createDataplane := func(name string, mesh string, inbound *mesh_proto.Dataplane_Networking_Inbound) {
+		dp := core_mesh.NewDataplaneResource()
+		dp.Spec = &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{inbound},
+			},
+		}
+		Expect(resManager.Create(context.Background(), dp, core_store.CreateByKey(name, mesh))).To(Succeed())
+	}
+
+	It("should export healthy Dataplanes and Zone Ingresses", func() {
+		// given
+		createDataplane("backend-1", model.DefaultMesh, &mesh_proto.Dataplane_Networking_Inbound{
+			Port: 8080,
+			Tags: map[string]string{mesh_proto.ServiceTag: "backend", mesh_proto.ZoneTag: "zone-1"},
+		})
+		createDataplane("backend-2", model.DefaultMesh, &mesh_proto.Dataplane_Networking_Inbound{
+			Port:    8081,
+			Tags:    map[string]string{mesh_proto.ServiceTag: "backend"},
+			Health:  &mesh_proto.Dataplane_Networking_Inbound_Health{Ready: false},
+			Address: "192.168.0.2",
+		})
+		createDataplane("web-1", "other", &mesh_proto.Dataplane_Networking_Inbound{
+			Port: 8080,
+			Tags: map[string]string{mesh_proto.ServiceTag: "web"},
+		})
+		zoneIngress := core_mesh.NewZoneIngressResource()
+		zoneIngress.Spec = &mesh_proto.ZoneIngress{
+			Zone: "zone-2",
+			Networking: &mesh_proto.ZoneIngress_Networking{
+				Address:           "192.168.1.1",
+				Port:              10001,
+				AdvertisedAddress: "10.0.0.1",
+				AdvertisedPort:    10001,
+			},
+			AvailableServices: []*mesh_proto.ZoneIngress_AvailableService{
+				{Mesh: model.DefaultMesh, Tags: map[string]string{mesh_proto.ServiceTag: "backend"}, Instances: 2},
+				{Mesh: "other", Tags: map[string]string{mesh_proto.ServiceTag: "web"}, Instances: 1},
+			},
+		}
+		Expect(resManager.Create(context.Background(), zoneIngress, core_store.CreateByKey("ingress-2", model.NoMesh))).To(Succeed())
+
+		// when
+		startExporter()
+
+		// then
+		Eventually(func() map[string]map[string]string {
+			if service := cloudMap.service("backend"); service != nil {
+				return service.Instances
+			}
+			return nil
+		}, "5s", "10ms").Should(Equal(map[string]map[string]string{
+			"192.168.0.1:8080": {
+				"AWS_INSTANCE_IPV4":     "192.168.0.1",
+				"AWS_INSTANCE_PORT":     "8080",
+				"kuma.io/instance-type": "dataplane",
+				"kuma.io/zone":          "zone-1",
+			},
+			"10.0.0.1:10001": {
+				"AWS_INSTANCE_IPV4":     "10.0.0.1",
+				"AWS_INSTANCE_PORT":     "10001",
+				"kuma.io/instance-type": "zone-ingress",
+				"kuma.io/zone":          "zone-2",
+			},
+		}))
+		service := cloudMap.service("backend")
+		Expect(service.Description).To(Equal(`Exported by Kuma from the mesh "default"`))
+		Expect(service.DnsConfig).ToNot(BeNil())
+		Expect(cloudMap.service("web")).To(BeNil())
+
+		cloudMap.Lock()
+		defer cloudMap.Unlock()
+		Expect(cloudMap.authorizations[0]).To(HavePrefix("AWS4-HMAC-SHA256 Credential=AKIAEXAMPLE/"))
+		Expect(cloudMap.authorizations[0]).To(ContainSubstring("/eu-west-1/servicediscovery/aws4_request"))
+	})
+
+	It("should deregister instances of removed services and keep services not exported by Kuma", func() {
+		// given
+		cloudMap.services["srv-exported"] = &fakeCloudMapService{
+			Name:        "removed",
+			Description: `Exported by Kuma from the mesh "default"`,
+			Instances: map[string]map[string]string{
+				"192.168.0.3:8080": {"AWS_INSTANCE_IPV4": "192.168.0.3", "AWS_INSTANCE_PORT": "8080"},
+			},
+		}
+		cloudMap.services["srv-foreign"] = &fakeCloudMapService{
+			Name: "backend",
+			Instances: map[string]map[string]string{
+				"172.16.0.1:80": {"AWS_INSTANCE_IPV4": "172.16.0.1", "AWS_INSTANCE_PORT": "80"},
+			},
+		}
+		createDataplane("backend-1", model.DefaultMesh, &mesh_proto.Dataplane_Networking_Inbound{
+			Port: 8080,
+			Tags: map[string]string{mesh_proto.ServiceTag: "backend"},
+		})
+
+		// when
+		startExporter()
+
+		// then
+		Eventually(func() map[string]map[string]string {
+			return cloudMap.service("removed").Instances
+		}, "5s", "10ms").Should(BeEmpty())
+		Consistently(func() map[string]map[string]string {
+			return cloudMap.service("backend").Instances
+		}, "100ms", "10ms").Should(Equal(map[string]map[string]string{
+			"172.16.0.1:80": {"AWS_INSTANCE_IPV4": "172.16.0.1", "AWS_INSTANCE_PORT": "80"},
+		}))
+	})
+})
+
+var _ = Describe("CloudMapServiceName()", func() {
+	It("should generate a valid name", func() {
+		Expect(servicediscovery.CloudMapServiceName("backend_kuma-demo_svc_8080")).To(Equal("backend_kuma-demo_svc_8080"))
+		Expect(servicediscovery.CloudMapServiceName("web:v1/api")).To(Equal("web-v1-api"))
+	})
+})
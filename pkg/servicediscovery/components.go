@@ -0,0 +1,29 @@
+package servicediscovery
+
+import (
+	"github.com/kumahq/kuma/pkg/core/runtime"
+)
+
+func Setup(rt runtime.Runtime) error {
+	consul := rt.Config().ServiceDiscovery.Consul
+	if consul.Enabled {
+		source, err := NewConsulSource(consul)
+		if err != nil {
+			return err
+		}
+		if err := rt.Add(NewSynchronizer(source, rt.ResourceManager(), consul.Mesh, consul.SyncInterval)); err != nil {
+			return err
+		}
+	}
+	cloudMap := rt.Config().ServiceDiscovery.CloudMap
+	if cloudMap.Enabled {
+		exporter, err := NewCloudMapExporter(cloudMap, rt.ReadOnlyResourceManager())
+		if err != nil {
+			return err
+		}
+		if err := rt.Add(exporter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
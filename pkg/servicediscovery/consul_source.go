@@ -0,0 +1,121 @@
+package servicediscovery
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	service_discovery "github.com/kumahq/kuma/pkg/config/service-discovery"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+const consulSourceName = "consul"
+
+// consulService is the only service that is registered in every Consul catalog, it is never imported
+const consulService = "consul"
+
+type consulSource struct {
+	client     util_http.Client
+	datacenter string
+	services   []string
+}
+
+// consulServiceEntry is an entry of the response of the /v1/health/service/:service endpoint of the Consul HTTP API
+type consulServiceEntry struct {
+	Node struct {
+		Address string
+	}
+	Service struct {
+		Address string
+		Port    uint32
+	}
+}
+
+// NewConsulSource creates a Source of healthy instances of services in the Consul catalog.
+func NewConsulSource(cfg *service_discovery.ConsulConfig) (Source, error) {
+	baseURL, err := url.Parse(cfg.Address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid address of Consul %q", cfg.Address)
+	}
+	headers := map[string]string{}
+	if cfg.Token != "" {
+		headers["X-Consul-Token"] = cfg.Token
+	}
+	return &consulSource{
+		client:     util_http.ClientWithBaseURL(&http.Client{Timeout: 10 * time.Second}, baseURL, headers),
+		datacenter: cfg.Datacenter,
+		services:   cfg.Services,
+	}, nil
+}
+
+func (c *consulSource) Name() string {
+	return consulSourceName
+}
+
+func (c *consulSource) Instances(ctx context.Context) ([]Instance, error) {
+	services := c.services
+	if len(services) == 0 {
+		catalog := map[string][]string{}
+		if err := c.get(ctx, "/v1/catalog/services", url.Values{}, &catalog); err != nil {
+			return nil, errors.Wrap(err, "could not list services in the Consul catalog")
+		}
+		for service := range catalog {
+			if service != consulService {
+				services = append(services, service)
+			}
+		}
+		sort.Strings(services)
+	}
+
+	var instances []Instance
+	for _, service := range services {
+		var entries []consulServiceEntry
+		query := url.Values{"passing": []string{"true"}}
+		if err := c.get(ctx, "/v1/health/service/"+url.PathEscape(service), query, &entries); err != nil {
+			return nil, errors.Wrapf(err, "could not list instances of the service %q in the Consul catalog", service)
+		}
+		for _, entry := range entries {
+			// the address of the service is empty when it is the same as the address of the node
+			address := entry.Service.Address
+			if address == "" {
+				address = entry.Node.Address
+			}
+			instances = append(instances, Instance{
+				Service: service,
+				Address: address,
+				Port:    entry.Service.Port,
+			})
+		}
+	}
+	return instances, nil
+}
+
+func (c *consulSource) get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	if c.datacenter != "" {
+		query.Set("dc", c.datacenter)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	req.URL.RawQuery = query.Encode()
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("Consul responded with status code %d: %s", resp.StatusCode, string(body))
+	}
+	return json.Unmarshal(body, out)
+}
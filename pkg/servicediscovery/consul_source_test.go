@@ -0,0 +1,103 @@
+package servicediscovery_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	service_discovery "github.com/kumahq/kuma/pkg/config/service-discovery"
+	"github.com/kumahq/kuma/pkg/servicediscovery"
+)
+
+var _ = Describe("Consul source", func() {
+
+	var server *httptest.Server
+	var requests []*http.Request
+
+	BeforeEach(func() {
+		requests = nil
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1/catalog/services", func(w http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			_, _ = w.Write([]byte(`{"consul": [], "billing": ["v1"], "inventory": []}`))
+		})
+		mux.HandleFunc("/v1/health/service/billing", func(w http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			_, _ = w.Write([]byte(`[
+				{"Node": {"Address": "192.168.0.1"}, "Service": {"Address": "10.0.0.1", "Port": 8080}},
+				{"Node": {"Address": "192.168.0.2"}, "Service": {"Address": "", "Port": 8081}}
+			]`))
+		})
+		mux.HandleFunc("/v1/health/service/inventory", func(w http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			_, _ = w.Write([]byte(`[]`))
+		})
+		server = httptest.NewServer(mux)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should list healthy instances of all services", func() {
+		// given
+		source, err := servicediscovery.NewConsulSource(&service_discovery.ConsulConfig{
+			Address:    server.URL,
+			Token:      "secret",
+			Datacenter: "dc2",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		instances, err := source.Instances(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instances).To(Equal([]servicediscovery.Instance{
+			{Service: "billing", Address: "10.0.0.1", Port: 8080},
+			{Service: "billing", Address: "192.168.0.2", Port: 8081},
+		}))
+		Expect(requests).To(HaveLen(3))
+		for _, req := range requests {
+			Expect(req.Header.Get("X-Consul-Token")).To(Equal("secret"))
+			Expect(req.URL.Query().Get("dc")).To(Equal("dc2"))
+		}
+		Expect(requests[1].URL.Query().Get("passing")).To(Equal("true"))
+	})
+
+	It("should list instances only of the configured services", func() {
+		// given
+		source, err := servicediscovery.NewConsulSource(&service_discovery.ConsulConfig{
+			Address:  server.URL,
+			Services: []string{"inventory"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		instances, err := source.Instances(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instances).To(BeEmpty())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Path).To(Equal("/v1/health/service/inventory"))
+	})
+
+	It("should fail when Consul responds with an error", func() {
+		// given
+		source, err := servicediscovery.NewConsulSource(&service_discovery.ConsulConfig{
+			Address:  server.URL,
+			Services: []string{"unknown"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = source.Instances(context.Background())
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`could not list instances of the service "unknown"`)))
+	})
+})
@@ -0,0 +1,20 @@
+package servicediscovery
+
+import (
+	"context"
+)
+
+// Instance is a healthy instance of a service registered in an external service discovery system.
+type Instance struct {
+	Service string
+	Address string
+	Port    uint32
+}
+
+// Source lists instances of services registered in an external service discovery system.
+// Every call to Instances returns the complete list, instances that are not on the list were deregistered.
+type Source interface {
+	// Name identifies the source. It is a prefix of names of imported ExternalServices and the value of SourceTag.
+	Name() string
+	Instances(ctx context.Context) ([]Instance, error)
+}
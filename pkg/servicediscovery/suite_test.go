@@ -0,0 +1,11 @@
+package servicediscovery_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestServiceDiscovery(t *testing.T) {
+	test.RunSpecs(t, "Service Discovery Suite")
+}
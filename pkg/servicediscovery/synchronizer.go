@@ -0,0 +1,145 @@
+package servicediscovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+var (
+	log = core.Log.WithName("service-discovery")
+)
+
+// SourceTag marks ExternalServices imported from a Source. ExternalServices with this tag are owned by the synchronizer,
+// they are removed once their instances are deregistered and manual changes are overridden.
+const SourceTag = "kuma.io/service-discovery-source"
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// ExternalServiceName returns a name of the ExternalService of the instance imported from the source.
+func ExternalServiceName(source string, instance Instance) string {
+	name := fmt.Sprintf("%s-%s-%s-%d", source, instance.Service, instance.Address, instance.Port)
+	return strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(name), "-"), "-.")
+}
+
+// ExternalService returns a spec of the ExternalService of the instance imported from the source.
+func ExternalService(source string, instance Instance) *mesh_proto.ExternalService {
+	return &mesh_proto.ExternalService{
+		Networking: &mesh_proto.ExternalService_Networking{
+			Address: net.JoinHostPort(instance.Address, strconv.Itoa(int(instance.Port))),
+		},
+		Tags: map[string]string{
+			mesh_proto.ServiceTag: instance.Service,
+			SourceTag:             source,
+		},
+	}
+}
+
+type synchronizer struct {
+	source    Source
+	rm        manager.ResourceManager
+	mesh      string
+	newTicker func() *time.Ticker
+}
+
+// NewSynchronizer creates a component that keeps ExternalServices in the mesh in sync with instances of the source.
+func NewSynchronizer(source Source, rm manager.ResourceManager, mesh string, interval time.Duration) component.Component {
+	return &synchronizer{
+		source: source,
+		rm:     rm,
+		mesh:   mesh,
+		newTicker: func() *time.Ticker {
+			return time.NewTicker(interval)
+		},
+	}
+}
+
+func (s *synchronizer) NeedLeaderElection() bool {
+	return true
+}
+
+func (s *synchronizer) Start(stop <-chan struct{}) error {
+	ticker := s.newTicker()
+	defer ticker.Stop()
+
+	log.Info("starting the synchronization of services", "source", s.source.Name(), "mesh", s.mesh)
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.synchronize(context.Background()); err != nil {
+				log.Error(err, "unable to synchronize", "source", s.source.Name())
+			}
+		case <-stop:
+			log.Info("stopping", "source", s.source.Name())
+			return nil
+		}
+	}
+}
+
+func (s *synchronizer) synchronize(ctx context.Context) error {
+	instances, err := s.source.Instances(ctx)
+	if err != nil {
+		return err
+	}
+	desired := map[string]*mesh_proto.ExternalService{}
+	for _, instance := range instances {
+		desired[ExternalServiceName(s.source.Name(), instance)] = ExternalService(s.source.Name(), instance)
+	}
+
+	externalServices := &core_mesh.ExternalServiceResourceList{}
+	if err := s.rm.List(ctx, externalServices, store.ListByMesh(s.mesh)); err != nil {
+		return err
+	}
+	existing := map[string]bool{}
+	for _, es := range externalServices.Items {
+		name := es.GetMeta().GetName()
+		existing[name] = true
+		if es.Spec.GetTags()[SourceTag] != s.source.Name() {
+			continue
+		}
+		spec, ok := desired[name]
+		switch {
+		case !ok:
+			log.Info("deleting ExternalService of a deregistered instance", "name", name, "mesh", s.mesh)
+			if err := s.rm.Delete(ctx, es, store.DeleteByKey(name, s.mesh)); err != nil && !store.IsResourceNotFound(err) {
+				return err
+			}
+		case !proto.Equal(es.Spec, spec):
+			log.Info("updating ExternalService", "name", name, "mesh", s.mesh)
+			es.Spec = spec
+			if err := s.rm.Update(ctx, es); err != nil {
+				return err
+			}
+		}
+	}
+
+	for name, spec := range desired {
+		if existing[name] {
+			continue
+		}
+		es := core_mesh.NewExternalServiceResource()
+		es.Spec = spec
+		if err := es.Validate(); err != nil {
+			log.Error(err, "skipping an invalid instance", "name", name, "mesh", s.mesh)
+			continue
+		}
+		log.Info("creating ExternalService", "name", name, "mesh", s.mesh)
+		if err := s.rm.Create(ctx, es, store.CreateByKey(name, s.mesh)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package servicediscovery_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	resources_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/servicediscovery"
+)
+
+type staticSource struct {
+	sync.Mutex
+	instances []servicediscovery.Instance
+}
+
+func (s *staticSource) Name() string {
+	return "static"
+}
+
+func (s *staticSource) Instances(context.Context) ([]servicediscovery.Instance, error) {
+	s.Lock()
+	defer s.Unlock()
+	return s.instances, nil
+}
+
+func (s *staticSource) set(instances ...servicediscovery.Instance) {
+	s.Lock()
+	defer s.Unlock()
+	s.instances = instances
+}
+
+var _ = Describe("Synchronizer", func() {
+
+	var resManager resources_manager.ResourceManager
+	var source *staticSource
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resManager = resources_manager.NewResourceManager(memory_resources.NewStore())
+		err := resManager.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(model.DefaultMesh, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		source = &staticSource{}
+		stop = make(chan struct{})
+		synchronizer := servicediscovery.NewSynchronizer(source, resManager, model.DefaultMesh, 10*time.Millisecond)
+		go func() {
+			defer GinkgoRecover()
+			Expect(synchronizer.Start(stop)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	externalServices := func() map[string]string {
+		list := &core_mesh.ExternalServiceResourceList{}
+		Expect(resManager.List(context.Background(), list, core_store.ListByMesh(model.DefaultMesh))).To(Succeed())
+		addresses := map[string]string{}
+		for _, es := range list.Items {
+			addresses[es.GetMeta().GetName()] = es.Spec.GetNetworking().GetAddress()
+		}
+		return addresses
+	}
+
+	It("should import instances as ExternalServices and remove deregistered ones", func() {
+		// when
+		source.set(
+			servicediscovery.Instance{Service: "billing", Address: "10.0.0.1", Port: 8080},
+			servicediscovery.Instance{Service: "billing", Address: "10.0.0.2", Port: 8080},
+		)
+
+		// then
+		Eventually(externalServices, "5s", "10ms").Should(Equal(map[string]string{
+			"static-billing-10.0.0.1-8080": "10.0.0.1:8080",
+			"static-billing-10.0.0.2-8080": "10.0.0.2:8080",
+		}))
+
+		// when
+		source.set(servicediscovery.Instance{Service: "billing", Address: "10.0.0.2", Port: 8080})
+
+		// then
+		Eventually(externalServices, "5s", "10ms").Should(Equal(map[string]string{
+			"static-billing-10.0.0.2-8080": "10.0.0.2:8080",
+		}))
+	})
+
+	It("should override manual changes and keep ExternalServices that are not imported", func() {
+		// given
+		manual := core_mesh.NewExternalServiceResource()
+		manual.Spec = &mesh_proto.ExternalService{
+			Networking: &mesh_proto.ExternalService_Networking{Address: "httpbin.org:443"},
+			Tags:       map[string]string{mesh_proto.ServiceTag: "httpbin"},
+		}
+		Expect(resManager.Create(context.Background(), manual, core_store.CreateByKey("httpbin", model.DefaultMesh))).To(Succeed())
+		source.set(servicediscovery.Instance{Service: "billing", Address: "10.0.0.1", Port: 8080})
+		Eventually(externalServices, "5s", "10ms").Should(HaveLen(2))
+
+		// when
+		Eventually(func() error {
+			imported := core_mesh.NewExternalServiceResource()
+			if err := resManager.Get(context.Background(), imported, core_store.GetByKey("static-billing-10.0.0.1-8080", model.DefaultMesh)); err != nil {
+				return err
+			}
+			imported.Spec.Tags[mesh_proto.ProtocolTag] = "http"
+			return resManager.Update(context.Background(), imported)
+		}, "5s", "10ms").Should(Succeed())
+
+		// then
+		Eventually(func() map[string]string {
+			es := core_mesh.NewExternalServiceResource()
+			Expect(resManager.Get(context.Background(), es, core_store.GetByKey("static-billing-10.0.0.1-8080", model.DefaultMesh))).To(Succeed())
+			return es.Spec.Tags
+		}, "5s", "10ms").Should(Equal(map[string]string{
+			mesh_proto.ServiceTag:      "billing",
+			servicediscovery.SourceTag: "static",
+		}))
+		Expect(externalServices()).To(HaveKeyWithValue("httpbin", "httpbin.org:443"))
+	})
+})
+
+var _ = Describe("ExternalServiceName()", func() {
+	It("should generate a valid name", func() {
+		Expect(servicediscovery.ExternalServiceName("consul", servicediscovery.Instance{
+			Service: "Billing_API",
+			Address: "fd00::1",
+			Port:    8080,
+		})).To(Equal("consul-billing-api-fd00-1-8080"))
+	})
+})
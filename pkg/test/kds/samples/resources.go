@@ -162,6 +162,21 @@ var (
 			Detectors: &mesh_proto.CircuitBreaker_Conf_Detectors{},
 		},
 	}
+	ConnectionPool = &mesh_proto.ConnectionPool{
+		Sources: []*mesh_proto.Selector{{
+			Match: map[string]string{
+				"service": "*",
+			},
+		}},
+		Destinations: []*mesh_proto.Selector{{
+			Match: map[string]string{
+				"service": "*",
+			},
+		}},
+		Conf: &mesh_proto.ConnectionPool_Conf{
+			MaxRequestsPerConnection: util_proto.UInt32(100),
+		},
+	}
 	HealthCheck = &mesh_proto.HealthCheck{
 		Sources: []*mesh_proto.Selector{{
 			Match: map[string]string{
@@ -233,6 +248,22 @@ var (
 			Backend: "tracing-backend",
 		},
 	}
+	ProxyPatch = &mesh_proto.ProxyPatch{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{"service": "*"},
+		}},
+		Conf: &mesh_proto.ProxyPatch_Conf{
+			Patches: []*mesh_proto.ProxyPatch_Patch{{
+				Match: &mesh_proto.ProxyPatch_Patch_Match{
+					Type: mesh_proto.PatchTypeCluster,
+				},
+				JsonPatch: []*mesh_proto.ProxyPatch_Patch_JsonPatchOperation{{
+					Op:   mesh_proto.JsonPatchOpRemove,
+					Path: "/circuitBreakers",
+				}},
+			}},
+		},
+	}
 	ProxyTemplate = &mesh_proto.ProxyTemplate{
 		Selectors: []*mesh_proto.Selector{{
 			Match: map[string]string{"serivce": "*"},
@@ -14,6 +14,12 @@ func MatchGoldenYAML(goldenFilePath string) types.GomegaMatcher {
 	return MatchGolden(gomega.MatchYAML, goldenFilePath)
 }
 
+// MatchGoldenYAMLDiff matches the golden YAML file like MatchGoldenYAML,
+// but reports the paths of the values that differ.
+func MatchGoldenYAMLDiff(goldenFilePath string) types.GomegaMatcher {
+	return MatchGolden(MatchYAMLDiff, goldenFilePath)
+}
+
 func MatchGoldenJSON(goldenFilePath string) types.GomegaMatcher {
 	return MatchGolden(gomega.MatchJSON, goldenFilePath)
 }
@@ -0,0 +1,11 @@
+package matchers_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestMatchers(t *testing.T) {
+	test.RunSpecs(t, "Matchers Suite")
+}
@@ -0,0 +1,172 @@
+package matchers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/onsi/gomega/types"
+	"github.com/pkg/errors"
+)
+
+// MatchYAMLDiff succeeds if actual is YAML that is semantically equal to
+// the expected YAML, like gomega.MatchYAML. When they differ, the failure
+// message lists the paths of the values that differ instead of dumping
+// both documents, which is hard to read for large xDS snapshots.
+func MatchYAMLDiff(expected interface{}) types.GomegaMatcher {
+	return &YAMLDiffMatcher{
+		Expected: expected,
+	}
+}
+
+type YAMLDiffMatcher struct {
+	Expected interface{}
+	diffs    []string
+}
+
+var _ types.GomegaMatcher = &YAMLDiffMatcher{}
+
+func (m *YAMLDiffMatcher) Match(actual interface{}) (success bool, err error) {
+	expectedValue, err := unmarshalYAML(m.Expected)
+	if err != nil {
+		return false, errors.Wrap(err, "could not parse the expected YAML")
+	}
+
+	actualValue, err := unmarshalYAML(actual)
+	if err != nil {
+		return false, errors.Wrap(err, "could not parse the actual YAML")
+	}
+
+	m.diffs = YAMLDiff(expectedValue, actualValue)
+
+	return len(m.diffs) == 0, nil
+}
+
+func (m *YAMLDiffMatcher) FailureMessage(actual interface{}) (message string) {
+	return fmt.Sprintf("Expected YAML documents to be equal, found %d differences:\n\t%s",
+		len(m.diffs), strings.Join(m.diffs, "\n\t"))
+}
+
+func (m *YAMLDiffMatcher) NegatedFailureMessage(actual interface{}) (message string) {
+	return "Expected YAML documents not to be equal"
+}
+
+func unmarshalYAML(in interface{}) (interface{}, error) {
+	var bytes []byte
+
+	switch in := in.(type) {
+	case []byte:
+		bytes = in
+	case string:
+		bytes = []byte(in)
+	default:
+		return nil, errors.Errorf("not supported type %T for MatchYAMLDiff", in)
+	}
+
+	var value interface{}
+	if err := yaml.Unmarshal(bytes, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// YAMLDiff returns the differences between two unmarshaled YAML
+// documents. Each difference is reported as the path of the value, e.g.
+// `Routes.Resources["edge-gateway:HTTP:8080"].virtualHosts[0].name`, with
+// the expected and the actual value. Maps are compared key by key and
+// lists item by item.
+func YAMLDiff(expected interface{}, actual interface{}) []string {
+	var diffs []string
+	diffYAML(&diffs, "", expected, actual)
+	return diffs
+}
+
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func diffYAML(diffs *[]string, path string, expected interface{}, actual interface{}) {
+	root := path
+	if root == "" {
+		root = "."
+	}
+
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		var keys []string
+		for k := range e {
+			keys = append(keys, k)
+		}
+		for k := range a {
+			if _, ok := e[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			p := fmt.Sprintf("%s[%q]", path, k)
+			if identifierRegex.MatchString(k) {
+				p = k
+				if path != "" {
+					p = path + "." + k
+				}
+			}
+
+			ev, inExpected := e[k]
+			av, inActual := a[k]
+
+			switch {
+			case !inActual:
+				*diffs = append(*diffs, fmt.Sprintf("%s: missing, expected %s", p, formatYAMLValue(ev)))
+			case !inExpected:
+				*diffs = append(*diffs, fmt.Sprintf("%s: unexpected %s", p, formatYAMLValue(av)))
+			default:
+				diffYAML(diffs, p, ev, av)
+			}
+		}
+
+		return
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			break
+		}
+
+		for i := 0; i < len(e) || i < len(a); i++ {
+			p := fmt.Sprintf("%s[%d]", path, i)
+
+			switch {
+			case i >= len(a):
+				*diffs = append(*diffs, fmt.Sprintf("%s: missing, expected %s", p, formatYAMLValue(e[i])))
+			case i >= len(e):
+				*diffs = append(*diffs, fmt.Sprintf("%s: unexpected %s", p, formatYAMLValue(a[i])))
+			default:
+				diffYAML(diffs, p, e[i], a[i])
+			}
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		*diffs = append(*diffs, fmt.Sprintf("%s: expected %s, got %s", root, formatYAMLValue(expected), formatYAMLValue(actual)))
+	}
+}
+
+func formatYAMLValue(value interface{}) string {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(bytes)
+}
@@ -0,0 +1,86 @@
+package matchers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/test/matchers"
+)
+
+var _ = Describe("MatchYAMLDiff", func() {
+
+	expected := `
+Routes:
+  Resources:
+    edge-gateway:HTTP:8080:
+      virtualHosts:
+      - name: echo.example.com
+        routes:
+        - match:
+            prefix: /
+          route:
+            weightedClusters:
+              clusters:
+              - name: echo-service
+                weight: 1
+`
+
+	It("should match documents that only differ in formatting", func() {
+		// given
+		actual := `
+Routes:
+  Resources:
+    "edge-gateway:HTTP:8080":
+      virtualHosts:
+        - routes:
+            - route: {weightedClusters: {clusters: [{weight: 1, name: echo-service}]}}
+              match: {prefix: "/"}
+          name: echo.example.com
+`
+
+		// expect
+		Expect(actual).To(matchers.MatchYAMLDiff(expected))
+	})
+
+	It("should report the paths of the values that differ", func() {
+		// given
+		actual := `
+Routes:
+  Resources:
+    edge-gateway:HTTP:8080:
+      virtualHosts:
+      - name: echo.example.com
+        routes:
+        - match:
+            path: /
+          route:
+            weightedClusters:
+              clusters:
+              - name: echo-service
+                weight: 2
+              - name: echo-canary
+                weight: 1
+`
+		matcher := matchers.MatchYAMLDiff(expected)
+
+		// when
+		matched, err := matcher.Match(actual)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(matched).To(BeFalse())
+		Expect(matcher.FailureMessage(actual)).To(Equal(`Expected YAML documents to be equal, found 4 differences:
+	Routes.Resources["edge-gateway:HTTP:8080"].virtualHosts[0].routes[0].match.path: unexpected "/"
+	Routes.Resources["edge-gateway:HTTP:8080"].virtualHosts[0].routes[0].match.prefix: missing, expected "/"
+	Routes.Resources["edge-gateway:HTTP:8080"].virtualHosts[0].routes[0].route.weightedClusters.clusters[0].weight: expected 1, got 2
+	Routes.Resources["edge-gateway:HTTP:8080"].virtualHosts[0].routes[0].route.weightedClusters.clusters[1]: unexpected {"name":"echo-canary","weight":1}`))
+	})
+
+	It("should fail on invalid YAML", func() {
+		// when
+		_, err := matchers.MatchYAMLDiff(expected).Match("routes: [")
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,56 @@
+// Package builders constructs valid mesh resources in code, so that
+// tests and automation don't have to assemble them from YAML strings.
+// Each builder starts from sensible defaults, and its Build method
+// returns the resource only if it passes validation.
+package builders
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// DefaultMesh is the mesh of the resources that are built, unless
+// another one is given.
+const DefaultMesh = "default"
+
+// YAML renders the resource in the Universal format that kumactl
+// applies.
+func YAML(res model.Resource) (string, error) {
+	obj, err := util_proto.ToMap(res.GetSpec())
+	if err != nil {
+		return "", err
+	}
+
+	obj["type"] = string(res.Descriptor().Name)
+	obj["name"] = res.GetMeta().GetName()
+	if res.Descriptor().Scope == model.ScopeMesh {
+		obj["mesh"] = res.GetMeta().GetMesh()
+	}
+
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
+
+// MustYAML is like YAML, but it panics when the resource can't be
+// rendered.
+func MustYAML(res model.Resource) string {
+	out, err := YAML(res)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+func listenerPortTag(protocol mesh_proto.Gateway_Listener_Protocol, port uint32) string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(protocol.String()), port)
+}
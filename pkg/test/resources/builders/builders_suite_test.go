@@ -0,0 +1,11 @@
+package builders_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestBuilders(t *testing.T) {
+	test.RunSpecs(t, "Builders Suite")
+}
@@ -0,0 +1,138 @@
+package builders_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/test/resources/builders"
+)
+
+var _ = Describe("Builders", func() {
+	It("should build a Gateway", func() {
+		gateway, err := builders.Gateway().
+			WithService("gateway-default").
+			AddListener(builders.HTTPListener(8080).WithHostname("echo.example.com")).
+			AddListener(builders.HTTPSListener(8443, "echo-example-com")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(builders.YAML(gateway)).To(MatchYAML(`
+type: Gateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+  - port: 8443
+    protocol: HTTPS
+    tls:
+      mode: TERMINATE
+      certificate:
+        secret: echo-example-com
+    tags:
+      port: https/8443
+`))
+	})
+
+	It("should not build an invalid Gateway", func() {
+		_, err := builders.Gateway().
+			WithService("gateway-default").
+			Build()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("conf.listeners"))
+	})
+
+	It("should build a GatewayRoute", func() {
+		route, err := builders.GatewayRoute().
+			WithName("echo").
+			WithService("gateway-default").
+			WithHostnames("echo.example.com").
+			AddHTTPRule(builders.HTTPRule().
+				MatchPrefix("/").
+				AddBackends(
+					builders.WeightedBackend("echo-v1", 90),
+					builders.WeightedBackend("echo-v2", 10),
+				),
+			).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(builders.YAML(route)).To(MatchYAML(`
+type: GatewayRoute
+mesh: default
+name: echo
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    hostnames:
+    - echo.example.com
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - weight: 90
+        destination:
+          kuma.io/service: echo-v1
+      - weight: 10
+        destination:
+          kuma.io/service: echo-v2
+`))
+	})
+
+	It("should build a TCP GatewayRoute", func() {
+		route := builders.GatewayRoute().
+			WithService("gateway-default").
+			AddTCPRule(builders.Backend("redis")).
+			MustBuild()
+
+		Expect(route.Spec.GetConf().GetTcp().GetRules()).To(HaveLen(1))
+	})
+
+	It("should build a Dataplane", func() {
+		dataplane := builders.Dataplane().
+			WithName("web-01").
+			AddInbound("web", 10001, 8080).
+			AddOutbound("backend", 10002).
+			MustBuild()
+
+		Expect(builders.YAML(dataplane)).To(MatchYAML(`
+type: Dataplane
+mesh: default
+name: web-01
+networking:
+  address: 192.168.0.1
+  inbound:
+  - port: 10001
+    servicePort: 8080
+    tags:
+      kuma.io/service: web
+  outbound:
+  - port: 10002
+    tags:
+      kuma.io/service: backend
+`))
+	})
+
+	It("should not change the built resources", func() {
+		builder := builders.Dataplane().AddInbound("backend", 10001, 8080)
+		dataplane := builder.MustBuild()
+
+		builder.AddInbound("backend-admin", 10002, 9090)
+
+		Expect(dataplane.Spec.GetNetworking().GetInbound()).To(HaveLen(1))
+		Expect(dataplane.Spec.GetNetworking().GetInbound()[0].GetTags()).To(
+			HaveKeyWithValue(mesh_proto.ServiceTag, "backend"))
+	})
+})
@@ -0,0 +1,117 @@
+package builders
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+// DataplaneBuilder builds Dataplane resources.
+type DataplaneBuilder struct {
+	meta test_model.ResourceMeta
+	spec *mesh_proto.Dataplane
+}
+
+// Dataplane starts a Dataplane named "dp-1" in the default mesh, at
+// the address 192.168.0.1.
+func Dataplane() *DataplaneBuilder {
+	return &DataplaneBuilder{
+		meta: test_model.ResourceMeta{
+			Mesh: DefaultMesh,
+			Name: "dp-1",
+		},
+		spec: &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+			},
+		},
+	}
+}
+
+func (b *DataplaneBuilder) WithName(name string) *DataplaneBuilder {
+	b.meta.Name = name
+	return b
+}
+
+func (b *DataplaneBuilder) WithMesh(mesh string) *DataplaneBuilder {
+	b.meta.Mesh = mesh
+	return b
+}
+
+func (b *DataplaneBuilder) WithAddress(address string) *DataplaneBuilder {
+	b.spec.Networking.Address = address
+	return b
+}
+
+// WithBuiltinGateway makes the Dataplane a builtin gateway of the
+// given service.
+func (b *DataplaneBuilder) WithBuiltinGateway(service string) *DataplaneBuilder {
+	return b.WithGateway(mesh_proto.Dataplane_Networking_Gateway_BUILTIN, map[string]string{
+		mesh_proto.ServiceTag: service,
+	})
+}
+
+func (b *DataplaneBuilder) WithGateway(
+	typ mesh_proto.Dataplane_Networking_Gateway_GatewayType,
+	tags map[string]string,
+) *DataplaneBuilder {
+	b.spec.Networking.Gateway = &mesh_proto.Dataplane_Networking_Gateway{
+		Type: typ,
+		Tags: tags,
+	}
+	return b
+}
+
+// AddInbound adds an inbound of the given service, that forwards the
+// requests on the given port to the service port.
+func (b *DataplaneBuilder) AddInbound(service string, port, servicePort uint32) *DataplaneBuilder {
+	return b.AddInboundWithTags(port, servicePort, map[string]string{
+		mesh_proto.ServiceTag: service,
+	})
+}
+
+func (b *DataplaneBuilder) AddInboundWithTags(port, servicePort uint32, tags map[string]string) *DataplaneBuilder {
+	b.spec.Networking.Inbound = append(b.spec.Networking.Inbound, &mesh_proto.Dataplane_Networking_Inbound{
+		Port:        port,
+		ServicePort: servicePort,
+		Tags:        tags,
+	})
+	return b
+}
+
+// AddOutbound adds an outbound to the given service on the given port.
+func (b *DataplaneBuilder) AddOutbound(service string, port uint32) *DataplaneBuilder {
+	b.spec.Networking.Outbound = append(b.spec.Networking.Outbound, &mesh_proto.Dataplane_Networking_Outbound{
+		Port: port,
+		Tags: map[string]string{
+			mesh_proto.ServiceTag: service,
+		},
+	})
+	return b
+}
+
+// Build returns the Dataplane, or the violations of its validation.
+func (b *DataplaneBuilder) Build() (*core_mesh.DataplaneResource, error) {
+	meta := b.meta
+	res := &core_mesh.DataplaneResource{
+		Meta: &meta,
+		Spec: proto.Clone(b.spec).(*mesh_proto.Dataplane),
+	}
+
+	if err := res.Validate(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MustBuild is like Build, but it panics when the Dataplane is invalid.
+func (b *DataplaneBuilder) MustBuild() *core_mesh.DataplaneResource {
+	res, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
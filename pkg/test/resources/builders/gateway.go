@@ -0,0 +1,177 @@
+package builders
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+// GatewayBuilder builds Gateway resources.
+type GatewayBuilder struct {
+	meta test_model.ResourceMeta
+	spec *mesh_proto.Gateway
+}
+
+// Gateway starts a Gateway named "edge-gateway" in the default mesh.
+func Gateway() *GatewayBuilder {
+	return &GatewayBuilder{
+		meta: test_model.ResourceMeta{
+			Mesh: DefaultMesh,
+			Name: "edge-gateway",
+		},
+		spec: &mesh_proto.Gateway{
+			Conf: &mesh_proto.Gateway_Conf{},
+		},
+	}
+}
+
+func (b *GatewayBuilder) WithName(name string) *GatewayBuilder {
+	b.meta.Name = name
+	return b
+}
+
+func (b *GatewayBuilder) WithMesh(mesh string) *GatewayBuilder {
+	b.meta.Mesh = mesh
+	return b
+}
+
+// WithSelector adds a selector that matches the given tags.
+func (b *GatewayBuilder) WithSelector(tags map[string]string) *GatewayBuilder {
+	b.spec.Selectors = append(b.spec.Selectors, &mesh_proto.Selector{
+		Match: tags,
+	})
+	return b
+}
+
+// WithService adds a selector that matches the data plane proxies of
+// the given service.
+func (b *GatewayBuilder) WithService(service string) *GatewayBuilder {
+	return b.WithSelector(map[string]string{
+		mesh_proto.ServiceTag: service,
+	})
+}
+
+func (b *GatewayBuilder) WithTags(tags map[string]string) *GatewayBuilder {
+	b.spec.Tags = tags
+	return b
+}
+
+// AddListener adds the listener that the given builder builds.
+func (b *GatewayBuilder) AddListener(listener *GatewayListenerBuilder) *GatewayBuilder {
+	b.spec.Conf.Listeners = append(b.spec.Conf.Listeners, listener.Build())
+	return b
+}
+
+// Build returns the Gateway, or the violations of its validation.
+func (b *GatewayBuilder) Build() (*core_mesh.GatewayResource, error) {
+	meta := b.meta
+	res := &core_mesh.GatewayResource{
+		Meta: &meta,
+		Spec: proto.Clone(b.spec).(*mesh_proto.Gateway),
+	}
+
+	if err := res.Validate(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MustBuild is like Build, but it panics when the Gateway is invalid.
+func (b *GatewayBuilder) MustBuild() *core_mesh.GatewayResource {
+	res, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// GatewayListenerBuilder builds the listeners of Gateway resources.
+type GatewayListenerBuilder struct {
+	listener *mesh_proto.Gateway_Listener
+}
+
+// Listener starts a listener on the given port and protocol. Its
+// "port" tag is set from the protocol and port, e.g. "http/8080",
+// since listeners must have at least one tag.
+func Listener(protocol mesh_proto.Gateway_Listener_Protocol, port uint32) *GatewayListenerBuilder {
+	return &GatewayListenerBuilder{
+		listener: &mesh_proto.Gateway_Listener{
+			Port:     port,
+			Protocol: protocol,
+			Tags: map[string]string{
+				"port": listenerPortTag(protocol, port),
+			},
+		},
+	}
+}
+
+// HTTPListener starts a HTTP listener on the given port.
+func HTTPListener(port uint32) *GatewayListenerBuilder {
+	return Listener(mesh_proto.Gateway_Listener_HTTP, port)
+}
+
+// HTTPSListener starts a HTTPS listener on the given port, that
+// terminates TLS with the certificate of the given secret.
+func HTTPSListener(port uint32, secret string) *GatewayListenerBuilder {
+	return Listener(mesh_proto.Gateway_Listener_HTTPS, port).
+		WithTLS(mesh_proto.Gateway_TLS_TERMINATE, secret)
+}
+
+// TCPListener starts a TCP listener on the given port.
+func TCPListener(port uint32) *GatewayListenerBuilder {
+	return Listener(mesh_proto.Gateway_Listener_TCP, port)
+}
+
+func (b *GatewayListenerBuilder) WithHostname(hostname string) *GatewayListenerBuilder {
+	b.listener.Hostname = hostname
+	return b
+}
+
+// WithTags replaces the tags of the listener.
+func (b *GatewayListenerBuilder) WithTags(tags map[string]string) *GatewayListenerBuilder {
+	b.listener.Tags = tags
+	return b
+}
+
+// WithTLS configures the TLS mode of the listener. When a secret is
+// given, the certificate is loaded from it.
+func (b *GatewayListenerBuilder) WithTLS(mode mesh_proto.Gateway_TLS_Mode, secret string) *GatewayListenerBuilder {
+	b.listener.Tls = &mesh_proto.Gateway_TLS_Conf{
+		Mode: mode,
+	}
+
+	if secret != "" {
+		b.listener.Tls.Certificate = &system_proto.DataSource{
+			Type: &system_proto.DataSource_Secret{
+				Secret: secret,
+			},
+		}
+	}
+
+	return b
+}
+
+func (b *GatewayListenerBuilder) WithCrossMesh() *GatewayListenerBuilder {
+	b.listener.CrossMesh = true
+	return b
+}
+
+func (b *GatewayListenerBuilder) WithLimits(limits *mesh_proto.Gateway_Listener_Limits) *GatewayListenerBuilder {
+	b.listener.Limits = limits
+	return b
+}
+
+// Configure applies arbitrary changes to the listener, for the fields
+// that the builder doesn't cover.
+func (b *GatewayListenerBuilder) Configure(fn func(*mesh_proto.Gateway_Listener)) *GatewayListenerBuilder {
+	fn(b.listener)
+	return b
+}
+
+func (b *GatewayListenerBuilder) Build() *mesh_proto.Gateway_Listener {
+	return b.listener
+}
@@ -0,0 +1,204 @@
+package builders
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+// GatewayRouteBuilder builds GatewayRoute resources.
+type GatewayRouteBuilder struct {
+	meta test_model.ResourceMeta
+	spec *mesh_proto.GatewayRoute
+}
+
+// GatewayRoute starts a GatewayRoute named "edge-gateway" in the
+// default mesh.
+func GatewayRoute() *GatewayRouteBuilder {
+	return &GatewayRouteBuilder{
+		meta: test_model.ResourceMeta{
+			Mesh: DefaultMesh,
+			Name: "edge-gateway",
+		},
+		spec: &mesh_proto.GatewayRoute{
+			Conf: &mesh_proto.GatewayRoute_Conf{},
+		},
+	}
+}
+
+func (b *GatewayRouteBuilder) WithName(name string) *GatewayRouteBuilder {
+	b.meta.Name = name
+	return b
+}
+
+func (b *GatewayRouteBuilder) WithMesh(mesh string) *GatewayRouteBuilder {
+	b.meta.Mesh = mesh
+	return b
+}
+
+// WithSelector adds a selector that matches the given tags.
+func (b *GatewayRouteBuilder) WithSelector(tags map[string]string) *GatewayRouteBuilder {
+	b.spec.Selectors = append(b.spec.Selectors, &mesh_proto.Selector{
+		Match: tags,
+	})
+	return b
+}
+
+// WithService adds a selector that matches the gateway listeners of
+// the given service.
+func (b *GatewayRouteBuilder) WithService(service string) *GatewayRouteBuilder {
+	return b.WithSelector(map[string]string{
+		mesh_proto.ServiceTag: service,
+	})
+}
+
+// WithHostnames sets the hostnames of the HTTP route.
+func (b *GatewayRouteBuilder) WithHostnames(hostnames ...string) *GatewayRouteBuilder {
+	b.http().Hostnames = hostnames
+	return b
+}
+
+// AddHTTPRule adds the rule that the given builder builds to the HTTP
+// route.
+func (b *GatewayRouteBuilder) AddHTTPRule(rule *HTTPRuleBuilder) *GatewayRouteBuilder {
+	http := b.http()
+	http.Rules = append(http.Rules, rule.Build())
+	return b
+}
+
+// AddTCPRule adds a rule that forwards the connections of the TCP
+// route to the given backends.
+func (b *GatewayRouteBuilder) AddTCPRule(backends ...*mesh_proto.GatewayRoute_Backend) *GatewayRouteBuilder {
+	tcp, ok := b.spec.Conf.GetRoute().(*mesh_proto.GatewayRoute_Conf_Tcp)
+	if !ok {
+		tcp = &mesh_proto.GatewayRoute_Conf_Tcp{
+			Tcp: &mesh_proto.GatewayRoute_TcpRoute{},
+		}
+		b.spec.Conf.Route = tcp
+	}
+
+	tcp.Tcp.Rules = append(tcp.Tcp.Rules, &mesh_proto.GatewayRoute_TcpRoute_Rule{
+		Backends: backends,
+	})
+
+	return b
+}
+
+// http returns the HTTP route, replacing any other kind of route.
+func (b *GatewayRouteBuilder) http() *mesh_proto.GatewayRoute_HttpRoute {
+	http, ok := b.spec.Conf.GetRoute().(*mesh_proto.GatewayRoute_Conf_Http)
+	if !ok {
+		http = &mesh_proto.GatewayRoute_Conf_Http{
+			Http: &mesh_proto.GatewayRoute_HttpRoute{},
+		}
+		b.spec.Conf.Route = http
+	}
+
+	return http.Http
+}
+
+// Build returns the GatewayRoute, or the violations of its validation.
+func (b *GatewayRouteBuilder) Build() (*core_mesh.GatewayRouteResource, error) {
+	meta := b.meta
+	res := &core_mesh.GatewayRouteResource{
+		Meta: &meta,
+		Spec: proto.Clone(b.spec).(*mesh_proto.GatewayRoute),
+	}
+
+	if err := res.Validate(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MustBuild is like Build, but it panics when the GatewayRoute is
+// invalid.
+func (b *GatewayRouteBuilder) MustBuild() *core_mesh.GatewayRouteResource {
+	res, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Backend returns a backend that selects the endpoints of the given
+// service.
+func Backend(service string) *mesh_proto.GatewayRoute_Backend {
+	return WeightedBackend(service, 1)
+}
+
+// WeightedBackend returns a backend that selects the endpoints of the
+// given service with the given weight.
+func WeightedBackend(service string, weight uint32) *mesh_proto.GatewayRoute_Backend {
+	return &mesh_proto.GatewayRoute_Backend{
+		Weight: weight,
+		Destination: map[string]string{
+			mesh_proto.ServiceTag: service,
+		},
+	}
+}
+
+// HTTPRuleBuilder builds the rules of HTTP routes.
+type HTTPRuleBuilder struct {
+	rule *mesh_proto.GatewayRoute_HttpRoute_Rule
+}
+
+// HTTPRule starts a HTTP rule without matches.
+func HTTPRule() *HTTPRuleBuilder {
+	return &HTTPRuleBuilder{
+		rule: &mesh_proto.GatewayRoute_HttpRoute_Rule{},
+	}
+}
+
+// MatchPath adds a match on the request path.
+func (b *HTTPRuleBuilder) MatchPath(
+	match mesh_proto.GatewayRoute_HttpRoute_Match_Path_MatchType,
+	value string,
+) *HTTPRuleBuilder {
+	return b.Match(&mesh_proto.GatewayRoute_HttpRoute_Match{
+		Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+			Match: match,
+			Value: value,
+		},
+	})
+}
+
+// MatchPrefix adds a match on the prefix of the request path.
+func (b *HTTPRuleBuilder) MatchPrefix(prefix string) *HTTPRuleBuilder {
+	return b.MatchPath(mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX, prefix)
+}
+
+// MatchExact adds a match on the exact request path.
+func (b *HTTPRuleBuilder) MatchExact(path string) *HTTPRuleBuilder {
+	return b.MatchPath(mesh_proto.GatewayRoute_HttpRoute_Match_Path_EXACT, path)
+}
+
+// Match adds an arbitrary match.
+func (b *HTTPRuleBuilder) Match(match *mesh_proto.GatewayRoute_HttpRoute_Match) *HTTPRuleBuilder {
+	b.rule.Matches = append(b.rule.Matches, match)
+	return b
+}
+
+func (b *HTTPRuleBuilder) AddFilter(filter *mesh_proto.GatewayRoute_HttpRoute_Filter) *HTTPRuleBuilder {
+	b.rule.Filters = append(b.rule.Filters, filter)
+	return b
+}
+
+func (b *HTTPRuleBuilder) AddBackends(backends ...*mesh_proto.GatewayRoute_Backend) *HTTPRuleBuilder {
+	b.rule.Backends = append(b.rule.Backends, backends...)
+	return b
+}
+
+// Configure applies arbitrary changes to the rule, for the fields that
+// the builder doesn't cover.
+func (b *HTTPRuleBuilder) Configure(fn func(*mesh_proto.GatewayRoute_HttpRoute_Rule)) *HTTPRuleBuilder {
+	fn(b.rule)
+	return b
+}
+
+func (b *HTTPRuleBuilder) Build() *mesh_proto.GatewayRoute_HttpRoute_Rule {
+	return b.rule
+}
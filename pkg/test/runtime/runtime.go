@@ -3,6 +3,9 @@ package runtime
 import (
 	"context"
 	"net"
+	"time"
+
+	envoy_data_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/data/tap/v3"
 
 	"github.com/kumahq/kuma/pkg/api-server/customization"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
@@ -72,8 +75,8 @@ func BuilderFor(appCtx context.Context, cfg kuma_cp.Config) (*core_runtime.Build
 	metrics, _ := metrics.NewMetrics("Standalone")
 	builder.WithMetrics(metrics)
 
-	builder.WithDataSourceLoader(datasource.NewDataSourceLoader(builder.ResourceManager()))
-	builder.WithCaManager("builtin", builtin.NewBuiltinCaManager(builder.ResourceManager()))
+	builder.WithDataSourceLoader(datasource.NewDataSourceLoader(builder.ResourceManager(), nil))
+	builder.WithCaManager("builtin", builtin.NewBuiltinCaManager(builder.ResourceManager(), builder.DataSourceLoader(), ""))
 	builder.WithLeaderInfo(&component.LeaderInfoComponent{})
 	builder.WithLookupIP(net.LookupIP)
 	builder.WithEnvoyAdminClient(&DummyEnvoyAdminClient{})
@@ -110,7 +113,7 @@ func newResourceManager(builder *core_runtime.Builder) core_manager.Customizable
 	defaultManager := core_manager.NewResourceManager(builder.ResourceStore())
 	customManagers := map[core_model.ResourceType]core_manager.ResourceManager{}
 	customizableManager := core_manager.NewCustomizableResourceManager(defaultManager, customManagers)
-	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), builder.MeshValidator())
+	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), builder.MeshValidator(), nil)
 	customManagers[core_mesh.MeshType] = meshManager
 
 	secretManager := secret_manager.NewSecretManager(builder.SecretStore(), secret_cipher.None(), nil)
@@ -120,6 +123,8 @@ func newResourceManager(builder *core_runtime.Builder) core_manager.Customizable
 
 type DummyEnvoyAdminClient struct {
 	PostQuitCalled *int
+	StatValues     map[string]uint64
+	Traces         []*envoy_data_tap_v3.TraceWrapper
 }
 
 func (d *DummyEnvoyAdminClient) GenerateAPIToken(dp *core_mesh.DataplaneResource) (string, error) {
@@ -133,3 +138,14 @@ func (d *DummyEnvoyAdminClient) PostQuit(dataplane *core_mesh.DataplaneResource)
 
 	return nil
 }
+
+func (d *DummyEnvoyAdminClient) Stats(dataplane *core_mesh.DataplaneResource, filter string) (map[string]uint64, error) {
+	return d.StatValues, nil
+}
+
+func (d *DummyEnvoyAdminClient) Tap(dataplane *core_mesh.DataplaneResource, configID string, maxTraces uint32, maxBodyBytes uint32, duration time.Duration) ([]*envoy_data_tap_v3.TraceWrapper, error) {
+	if uint32(len(d.Traces)) > maxTraces {
+		return d.Traces[:maxTraces], nil
+	}
+	return d.Traces, nil
+}
@@ -0,0 +1,71 @@
+package xds
+
+import (
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	cache_v3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/proto"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// ProtoMessage marshals the wrapped xDS resource with the protobuf JSON
+// mapping.
+type ProtoMessage struct {
+	Message proto.Message
+}
+
+func (p ProtoMessage) MarshalJSON() ([]byte, error) {
+	return util_proto.ToJSON(p.Message)
+}
+
+type ProtoResource struct {
+	Resources map[string]ProtoMessage
+}
+
+// ProtoSnapshot holds the resources of a xDS snapshot, keyed by their
+// names, so that it can be compared with golden files.
+type ProtoSnapshot struct {
+	Clusters  ProtoResource
+	Endpoints ProtoResource
+	Listeners ProtoResource
+	Routes    ProtoResource
+	Runtimes  ProtoResource
+	Secrets   ProtoResource
+}
+
+// MakeProtoResource wraps Go Control Plane resources in a map that
+// implements the json.Marshaler so that the resulting JSON fully
+// expands embedded Any protobufs (which are otherwise serialized
+// as byte arrays).
+func MakeProtoResource(resources cache_v3.Resources) ProtoResource {
+	result := ProtoResource{
+		Resources: map[string]ProtoMessage{},
+	}
+
+	for name, values := range resources.Items {
+		result.Resources[name] = ProtoMessage{
+			Message: values.Resource,
+		}
+	}
+
+	return result
+}
+
+func MakeProtoSnapshot(snap cache_v3.Snapshot) ProtoSnapshot {
+	return ProtoSnapshot{
+		Clusters:  MakeProtoResource(snap.Resources[envoy_types.Cluster]),
+		Endpoints: MakeProtoResource(snap.Resources[envoy_types.Endpoint]),
+		Listeners: MakeProtoResource(snap.Resources[envoy_types.Listener]),
+		Routes:    MakeProtoResource(snap.Resources[envoy_types.Route]),
+		Runtimes:  MakeProtoResource(snap.Resources[envoy_types.Runtime]),
+		Secrets:   MakeProtoResource(snap.Resources[envoy_types.Secret]),
+	}
+}
+
+// SnapshotYAML returns the YAML of all the resources of the snapshot,
+// which is what the golden files of generators hold. Resources are
+// sorted by type and name, so that the YAML is stable.
+func SnapshotYAML(snap cache_v3.Snapshot) ([]byte, error) {
+	return yaml.Marshal(MakeProtoSnapshot(snap))
+}
@@ -7,6 +7,7 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -59,3 +60,39 @@ func pemEncodeCert(derBytes []byte) ([]byte, error) {
 	}
 	return certBuf.Bytes(), nil
 }
+
+// ParsePEMKeyPair splits PEM encoded data that contains a certificate chain
+// and a private key into a KeyPair. The blocks can be in any order.
+func ParsePEMKeyPair(data []byte) (*KeyPair, error) {
+	var certBuf, keyBuf bytes.Buffer
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		switch {
+		case block.Type == "CERTIFICATE":
+			if err := pem.Encode(&certBuf, block); err != nil {
+				return nil, err
+			}
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			if keyBuf.Len() > 0 {
+				return nil, errors.New("found more than one private key")
+			}
+			if err := pem.Encode(&keyBuf, block); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if certBuf.Len() == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	if keyBuf.Len() == 0 {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+	return &KeyPair{
+		CertPEM: certBuf.Bytes(),
+		KeyPEM:  keyBuf.Bytes(),
+	}, nil
+}
@@ -3,6 +3,7 @@ package builtin
 import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneenrollment"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
 )
 
@@ -17,3 +18,9 @@ func NewZoneIngressTokenIssuer(resManager manager.ReadOnlyResourceManager) (zone
 		return zoneingress.GetSigningKey(resManager)
 	}), nil
 }
+
+func NewZoneEnrollmentTokenIssuer(resManager manager.ReadOnlyResourceManager) (zoneenrollment.TokenIssuer, error) {
+	return zoneenrollment.NewTokenIssuer(func() ([]byte, error) {
+		return zoneenrollment.GetSigningKey(resManager)
+	}), nil
+}
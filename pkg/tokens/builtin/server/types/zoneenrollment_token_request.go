@@ -0,0 +1,7 @@
+package types
+
+type ZoneEnrollmentTokenRequest struct {
+	Zone string `json:"zone"`
+	// ValidFor is a duration, ex. "24h", after which the token expires.
+	ValidFor string `json:"validFor"`
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/emicklei/go-restful"
 
@@ -13,26 +14,30 @@ import (
 	"github.com/kumahq/kuma/pkg/tokens/builtin/access"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneenrollment"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
 )
 
 var log = core.Log.WithName("dataplane-token-ws")
 
 type tokenWebService struct {
-	issuer            issuer.DataplaneTokenIssuer
-	zoneIngressIssuer zoneingress.TokenIssuer
-	access            access.GenerateDataplaneTokenAccess
+	issuer               issuer.DataplaneTokenIssuer
+	zoneIngressIssuer    zoneingress.TokenIssuer
+	zoneEnrollmentIssuer zoneenrollment.TokenIssuer
+	access               access.GenerateDataplaneTokenAccess
 }
 
 func NewWebservice(
 	issuer issuer.DataplaneTokenIssuer,
 	zoneIngressIssuer zoneingress.TokenIssuer,
+	zoneEnrollmentIssuer zoneenrollment.TokenIssuer,
 	access access.GenerateDataplaneTokenAccess,
 ) *restful.WebService {
 	ws := tokenWebService{
-		issuer:            issuer,
-		zoneIngressIssuer: zoneIngressIssuer,
-		access:            access,
+		issuer:               issuer,
+		zoneIngressIssuer:    zoneIngressIssuer,
+		zoneEnrollmentIssuer: zoneEnrollmentIssuer,
+		access:               access,
 	}
 	return ws.createWs()
 }
@@ -44,7 +49,8 @@ func (d *tokenWebService) createWs() *restful.WebService {
 	ws.Path("/tokens").
 		Route(ws.POST("").To(d.handleIdentityRequest)). // backwards compatibility
 		Route(ws.POST("/dataplane").To(d.handleIdentityRequest)).
-		Route(ws.POST("/zone-ingress").To(d.handleZoneIngressIdentityRequest))
+		Route(ws.POST("/zone-ingress").To(d.handleZoneIngressIdentityRequest)).
+		Route(ws.POST("/zone-enrollment").To(d.handleZoneEnrollmentRequest))
 	return ws
 }
 
@@ -112,3 +118,38 @@ func (d *tokenWebService) handleZoneIngressIdentityRequest(request *restful.Requ
 		log.Error(err, "Could not write a response")
 	}
 }
+
+func (d *tokenWebService) handleZoneEnrollmentRequest(request *restful.Request, response *restful.Response) {
+	idReq := types.ZoneEnrollmentTokenRequest{}
+	if err := request.ReadEntity(&idReq); err != nil {
+		log.Error(err, "Could not read a request")
+		response.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	verr := validators.ValidationError{}
+	if idReq.Zone == "" {
+		verr.AddViolation("zone", "cannot be empty")
+	}
+	validFor, err := time.ParseDuration(idReq.ValidFor)
+	if err != nil {
+		verr.AddViolation("validFor", "has to be a valid duration, ex. 24h")
+	} else if validFor <= 0 {
+		verr.AddViolation("validFor", "has to be positive")
+	}
+	if err := verr.OrNil(); err != nil {
+		errors.HandleError(response, err, "Invalid request")
+		return
+	}
+
+	token, err := d.zoneEnrollmentIssuer.Generate(idReq.Zone, validFor)
+	if err != nil {
+		errors.HandleError(response, err, "Could not issue a token")
+		return
+	}
+
+	response.Header().Set("content-type", "text/plain")
+	if _, err := response.Write([]byte(token)); err != nil {
+		log.Error(err, "Could not write a response")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"time"
 
 	"github.com/emicklei/go-restful"
 	. "github.com/onsi/ginkgo"
@@ -19,6 +20,7 @@ import (
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/server"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneenrollment"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
 )
 
@@ -49,13 +51,26 @@ func (z *zoneIngressStaticTokenIssuer) Validate(token zoneingress.Token) (zonein
 	return zoneingress.Identity{}, errors.New("not implemented")
 }
 
+type zoneEnrollmentStaticTokenIssuer struct {
+}
+
+var _ zoneenrollment.TokenIssuer = &zoneEnrollmentStaticTokenIssuer{}
+
+func (z *zoneEnrollmentStaticTokenIssuer) Generate(zone string, validFor time.Duration) (zoneenrollment.Token, error) {
+	return fmt.Sprintf("enrollment-token-for-%s-valid-for-%s", zone, validFor), nil
+}
+
+func (z *zoneEnrollmentStaticTokenIssuer) Validate(token zoneenrollment.Token) (zoneenrollment.Identity, error) {
+	return zoneenrollment.Identity{}, errors.New("not implemented")
+}
+
 var _ = Describe("Dataplane Token Webservice", func() {
 
 	const credentials = "test"
 	var url string
 
 	BeforeEach(func() {
-		ws := server.NewWebservice(&staticTokenIssuer{credentials}, &zoneIngressStaticTokenIssuer{}, &access.NoopGenerateDpTokenAccess{})
+		ws := server.NewWebservice(&staticTokenIssuer{credentials}, &zoneIngressStaticTokenIssuer{}, &zoneEnrollmentStaticTokenIssuer{}, &access.NoopGenerateDpTokenAccess{})
 
 		container := restful.NewContainer()
 		container.Add(ws)
@@ -112,4 +127,47 @@ var _ = Describe("Dataplane Token Webservice", func() {
 		},
 		Entry("not valid json", `not-valid-json`),
 	)
+
+	It("should respond with generated zone enrollment token", func() {
+		// given
+		reqBytes, err := json.Marshal(types.ZoneEnrollmentTokenRequest{
+			Zone:     "zone-1",
+			ValidFor: "24h",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		req, err := http.NewRequest("POST", fmt.Sprintf("%s/tokens/zone-enrollment", url), bytes.NewReader(reqBytes))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Add("content-type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(200))
+		respBody, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(respBody)).To(Equal("enrollment-token-for-zone-1-valid-for-24h0m0s"))
+	})
+
+	DescribeTable("should return bad request on invalid zone enrollment token request",
+		func(given types.ZoneEnrollmentTokenRequest) {
+			// given
+			reqBytes, err := json.Marshal(given)
+			Expect(err).ToNot(HaveOccurred())
+			req, err := http.NewRequest("POST", fmt.Sprintf("%s/tokens/zone-enrollment", url), bytes.NewReader(reqBytes))
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Add("content-type", "application/json")
+
+			// when
+			resp, err := http.DefaultClient.Do(req)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(400))
+		},
+		Entry("empty zone", types.ZoneEnrollmentTokenRequest{ValidFor: "24h"}),
+		Entry("invalid duration", types.ZoneEnrollmentTokenRequest{Zone: "zone-1", ValidFor: "forever"}),
+		Entry("negative duration", types.ZoneEnrollmentTokenRequest{Zone: "zone-1", ValidFor: "-1h"}),
+	)
 })
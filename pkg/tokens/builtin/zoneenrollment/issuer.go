@@ -0,0 +1,107 @@
+package zoneenrollment
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+type Token = string
+
+type Identity struct {
+	Zone string
+	// ID identifies the token, so it can be used only once.
+	ID        string
+	ExpiresAt time.Time
+}
+
+// TokenIssuer issues Zone Enrollment Tokens used by a new Zone CP to register itself in Global CP.
+// Issued token is bound by zone name and expires after the given period.
+type TokenIssuer interface {
+	Generate(zone string, validFor time.Duration) (Token, error)
+	Validate(token Token) (Identity, error)
+}
+
+type claims struct {
+	Zone string
+	jwt.RegisteredClaims
+}
+
+type SigningKeyAccessor func() ([]byte, error)
+
+var _ TokenIssuer = &jwtTokenIssuer{}
+
+func NewTokenIssuer(signingKeyAccessor SigningKeyAccessor) TokenIssuer {
+	return &jwtTokenIssuer{signingKeyAccessor}
+}
+
+type jwtTokenIssuer struct {
+	signingKeyAccessor SigningKeyAccessor
+}
+
+func (j *jwtTokenIssuer) signingKey() ([]byte, error) {
+	signingKey, err := j.signingKeyAccessor()
+	if err != nil {
+		return nil, err
+	}
+	if len(signingKey) == 0 {
+		return nil, SigningKeyNotFound()
+	}
+	return signingKey, nil
+}
+
+func (j *jwtTokenIssuer) Generate(zone string, validFor time.Duration) (Token, error) {
+	signingKey, err := j.signingKey()
+	if err != nil {
+		return "", err
+	}
+
+	now := core.Now()
+	c := claims{
+		Zone: zone,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        core.NewUUID(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(validFor)),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
+	tokenString, err := token.SignedString(signingKey)
+	if err != nil {
+		return "", errors.Wrap(err, "could not sign a token")
+	}
+	return tokenString, nil
+}
+
+func (j *jwtTokenIssuer) Validate(rawToken Token) (Identity, error) {
+	signingKey, err := j.signingKey()
+	if err != nil {
+		return Identity{}, err
+	}
+
+	c := &claims{}
+
+	token, err := jwt.ParseWithClaims(rawToken, c, func(*jwt.Token) (interface{}, error) {
+		return signingKey, nil
+	})
+	if err != nil {
+		return Identity{}, errors.Wrap(err, "could not parse token")
+	}
+	if !token.Valid {
+		return Identity{}, errors.New("token is not valid")
+	}
+	if c.ID == "" || c.ExpiresAt == nil {
+		return Identity{}, errors.New("token has to have an ID and an expiration time")
+	}
+
+	id := Identity{
+		Zone:      c.Zone,
+		ID:        c.ID,
+		ExpiresAt: c.ExpiresAt.Time,
+	}
+	return id, nil
+}
@@ -0,0 +1,57 @@
+package zoneenrollment
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const (
+	defaultRsaBits = 2048
+	// The signing key is never synced to zones, otherwise every zone could enroll any other zone.
+	signingKeyName = "zone-enrollment-token-signing-key"
+)
+
+func SigningKeyNotFound() error {
+	return errors.Errorf("there is no Zone Enrollment Signing Key in the Control Plane. Zone Enrollment Tokens can only be generated on Global CP.")
+}
+
+func SigningKeyResourceKey() core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: core_model.NoMesh,
+		Name: signingKeyName,
+	}
+}
+
+func GetSigningKey(manager manager.ReadOnlyResourceManager) ([]byte, error) {
+	resource := system.NewGlobalSecretResource()
+	if err := manager.Get(context.Background(), resource, store.GetBy(SigningKeyResourceKey())); err != nil {
+		if store.IsResourceNotFound(err) {
+			return nil, SigningKeyNotFound()
+		}
+		return nil, errors.Wrap(err, "could not retrieve global signing key from secret manager")
+	}
+	return resource.Spec.GetData().GetValue(), nil
+}
+
+func CreateSigningKey() (*system.GlobalSecretResource, error) {
+	res := system.NewGlobalSecretResource()
+	key, err := rsa.GenerateKey(rand.Reader, defaultRsaBits)
+	if err != nil {
+		return res, errors.Wrap(err, "failed to generate rsa key")
+	}
+	res.Spec = &system_proto.Secret{
+		Data: util_proto.Bytes(x509.MarshalPKCS1PrivateKey(key)),
+	}
+	return res, nil
+}
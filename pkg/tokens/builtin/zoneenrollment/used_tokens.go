@@ -0,0 +1,73 @@
+package zoneenrollment
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const usedTokensName = "zone-enrollment-used-tokens"
+
+func UsedTokensResourceKey() core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: core_model.NoMesh,
+		Name: usedTokensName,
+	}
+}
+
+type usedToken struct {
+	ID        string    `json:"id"`
+	Zone      string    `json:"zone"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+var errTokenAlreadyUsed = errors.New("zone enrollment token was already used")
+
+func IsTokenAlreadyUsed(err error) bool {
+	return errors.Is(err, errTokenAlreadyUsed)
+}
+
+// MarkUsed records that the token was used, so it cannot be used again.
+// Tokens that expired are dropped from the record, since they are rejected anyway.
+func MarkUsed(resManager manager.ResourceManager, identity Identity) error {
+	return manager.Upsert(resManager, UsedTokensResourceKey(), system.NewGlobalSecretResource(), func(resource core_model.Resource) error {
+		secret := resource.(*system.GlobalSecretResource)
+		var used []usedToken
+		if data := secret.Spec.GetData().GetValue(); len(data) > 0 {
+			if err := json.Unmarshal(data, &used); err != nil {
+				return errors.Wrap(err, "could not parse used zone enrollment tokens")
+			}
+		}
+		now := core.Now()
+		pruned := []usedToken{}
+		for _, token := range used {
+			if token.ID == identity.ID {
+				return errTokenAlreadyUsed
+			}
+			if now.Before(token.ExpiresAt) {
+				pruned = append(pruned, token)
+			}
+		}
+		pruned = append(pruned, usedToken{
+			ID:        identity.ID,
+			Zone:      identity.Zone,
+			ExpiresAt: identity.ExpiresAt,
+		})
+		data, err := json.Marshal(pruned)
+		if err != nil {
+			return err
+		}
+		secret.Spec = &system_proto.Secret{
+			Data: util_proto.Bytes(data),
+		}
+		return nil
+	})
+}
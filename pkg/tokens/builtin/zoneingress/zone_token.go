@@ -0,0 +1,30 @@
+package zoneingress
+
+import (
+	"strings"
+
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+// Zone Ingress Token provisioned by Global CP when the zone is enrolled.
+// It's kept as a GlobalSecret that is synced only to the zone it was generated for.
+const zoneTokenPrefix = "zone-ingress-token."
+
+func ZoneTokenResourceKey(zone string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: core_model.NoMesh,
+		Name: zoneTokenPrefix + zone,
+	}
+}
+
+// ZoneOfTokenResource returns the zone for which the token kept in the GlobalSecret was generated.
+func ZoneOfTokenResource(resKey core_model.ResourceKey) (string, bool) {
+	if resKey.Mesh != core_model.NoMesh || !strings.HasPrefix(resKey.Name, zoneTokenPrefix) {
+		return "", false
+	}
+	zone := strings.TrimPrefix(resKey.Name, zoneTokenPrefix)
+	if zone == "" {
+		return "", false
+	}
+	return zone, true
+}
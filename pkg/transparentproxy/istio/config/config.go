@@ -9,6 +9,7 @@ type TransparentProxyConfig struct {
 	RedirectPortInBoundV6  string
 	ExcludeInboundPorts    string
 	ExcludeOutboundPorts   string
+	ExcludeOutboundIPs     string
 	UID                    string
 	GID                    string
 	RedirectDNS            bool
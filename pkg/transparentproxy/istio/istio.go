@@ -36,6 +36,7 @@ func (tp *IstioTransparentProxy) Setup(cfg *config.TransparentProxyConfig) (stri
 	}
 	viper.Set(constants.LocalExcludePorts, cfg.ExcludeInboundPorts)
 	viper.Set(constants.ServiceCidr, "*")
+	viper.Set(constants.ServiceExcludeCidr, cfg.ExcludeOutboundIPs)
 	viper.Set(constants.LocalOutboundPortsExclude, cfg.ExcludeOutboundPorts)
 	viper.Set(constants.DryRun, cfg.DryRun)
 	viper.Set(constants.SkipRuleApply, false)
@@ -30,6 +30,7 @@ type PodRedirect struct {
 	BuiltinDNSEnabled     bool
 	BuiltinDNSPort        uint32
 	ExcludeOutboundPorts  string
+	ExcludeOutboundIPs    string
 	RedirectPortOutbound  uint32
 	RedirectInbound       bool
 	ExcludeInboundPorts   string
@@ -54,6 +55,8 @@ func NewPodRedirectForPod(pod *kube_core.Pod) (*PodRedirect, error) {
 
 	podRedirect.ExcludeOutboundPorts, _ = metadata.Annotations(pod.Annotations).GetString(metadata.KumaTrafficExcludeOutboundPorts)
 
+	podRedirect.ExcludeOutboundIPs, _ = metadata.Annotations(pod.Annotations).GetString(metadata.KumaTrafficExcludeOutboundIPs)
+
 	podRedirect.RedirectPortOutbound, _, err = metadata.Annotations(pod.Annotations).GetUint32(metadata.KumaTransparentProxyingOutboundPortAnnotation)
 	if err != nil {
 		return nil, err
@@ -95,6 +98,7 @@ func (pr *PodRedirect) AsTransparentProxyConfig() *config.TransparentProxyConfig
 		RedirectPortInBoundV6:  fmt.Sprintf("%d", pr.RedirectPortInboundV6),
 		ExcludeInboundPorts:    pr.ExcludeInboundPorts,
 		ExcludeOutboundPorts:   pr.ExcludeOutboundPorts,
+		ExcludeOutboundIPs:     pr.ExcludeOutboundIPs,
 		UID:                    pr.UID,
 		GID:                    pr.UID, // TODO: shall we have a separate annotation here?
 		RedirectDNS:            pr.BuiltinDNSEnabled,
@@ -123,6 +127,12 @@ func (pr *PodRedirect) AsKumactlCommandLine() []string {
 		"--skip-resolv-conf",
 	}
 
+	if pr.ExcludeOutboundIPs != "" {
+		result = append(result,
+			"--exclude-outbound-ips", pr.ExcludeOutboundIPs,
+		)
+	}
+
 	if pr.BuiltinDNSEnabled {
 		result = append(result,
 			"--redirect-all-dns-traffic",
@@ -0,0 +1,11 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestAws(t *testing.T) {
+	test.RunSpecs(t, "Aws Suite")
+}
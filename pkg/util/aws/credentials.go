@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/pkg/errors"
+)
+
+// CredentialsProvider returns credentials used to sign a request. Temporary credentials are refreshed by the provider
+// before they expire, so the provider has to be asked for every request.
+type CredentialsProvider interface {
+	Retrieve(ctx context.Context) (Credentials, error)
+}
+
+// StaticCredentials is a provider of credentials set explicitly in the configuration.
+type StaticCredentials Credentials
+
+func (s StaticCredentials) Retrieve(context.Context) (Credentials, error) {
+	return Credentials(s), nil
+}
+
+// NewCredentialsProvider returns the static credentials if the access key ID is set
+// and the default credential chain of the AWS SDK otherwise.
+func NewCredentialsProvider(region string, static Credentials) (CredentialsProvider, error) {
+	if static.AccessKeyID != "" {
+		return StaticCredentials(static), nil
+	}
+	return NewDefaultCredentialsProvider(region)
+}
+
+type sdkCredentialsProvider struct {
+	session *session.Session
+}
+
+// NewDefaultCredentialsProvider returns a provider of the default credential chain of the AWS SDK, which tries in order
+// the environment variables, the shared credentials and config files, the web identity token file (IAM roles for
+// service accounts), the ECS container credentials and the EC2 instance metadata service.
+func NewDefaultCredentialsProvider(region string) (CredentialsProvider, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            aws.Config{Region: aws.String(region)},
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create AWS session")
+	}
+	return &sdkCredentialsProvider{session: sess}, nil
+}
+
+func (s *sdkCredentialsProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	value, err := s.session.Config.Credentials.GetWithContext(ctx)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "could not retrieve AWS credentials")
+	}
+	return Credentials{
+		AccessKeyID:     value.AccessKeyID,
+		SecretAccessKey: value.SecretAccessKey,
+		SessionToken:    value.SessionToken,
+	}, nil
+}
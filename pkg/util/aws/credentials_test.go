@@ -0,0 +1,71 @@
+package aws_test
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	util_aws "github.com/kumahq/kuma/pkg/util/aws"
+)
+
+var _ = Describe("NewCredentialsProvider()", func() {
+
+	envVars := map[string]string{
+		"AWS_ACCESS_KEY_ID":         "AKIAENV",
+		"AWS_SECRET_ACCESS_KEY":     "env-secret",
+		"AWS_SESSION_TOKEN":         "env-session-token",
+		"AWS_EC2_METADATA_DISABLED": "true",
+	}
+	backup := map[string]string{}
+
+	BeforeEach(func() {
+		for key, value := range envVars {
+			backup[key] = os.Getenv(key)
+			Expect(os.Setenv(key, value)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		for key, value := range backup {
+			if value == "" {
+				Expect(os.Unsetenv(key)).To(Succeed())
+			} else {
+				Expect(os.Setenv(key, value)).To(Succeed())
+			}
+		}
+	})
+
+	It("should return the static credentials", func() {
+		// given
+		static := util_aws.Credentials{
+			AccessKeyID:     "AKIASTATIC",
+			SecretAccessKey: "static-secret",
+		}
+
+		// when
+		provider, err := util_aws.NewCredentialsProvider("eu-west-1", static)
+		Expect(err).ToNot(HaveOccurred())
+		creds, err := provider.Retrieve(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(creds).To(Equal(static))
+	})
+
+	It("should fall back to the default credential chain", func() {
+		// when
+		provider, err := util_aws.NewCredentialsProvider("eu-west-1", util_aws.Credentials{})
+		Expect(err).ToNot(HaveOccurred())
+		creds, err := provider.Retrieve(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(creds).To(Equal(util_aws.Credentials{
+			AccessKeyID:     "AKIAENV",
+			SecretAccessKey: "env-secret",
+			SessionToken:    "env-session-token",
+		}))
+	})
+})
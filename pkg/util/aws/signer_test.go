@@ -0,0 +1,75 @@
+package aws_test
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	util_aws "github.com/kumahq/kuma/pkg/util/aws"
+)
+
+var _ = Describe("Sign()", func() {
+
+	// examples from the AWS Signature Version 4 test suite
+	creds := util_aws.Credentials{
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	}
+	now := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+
+	type testCase struct {
+		method        string
+		url           string
+		authorization string
+	}
+
+	table.DescribeTable("should sign the request",
+		func(given testCase) {
+			// given
+			req, err := http.NewRequest(given.method, given.url, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			util_aws.Sign(req, nil, creds, "us-east-1", "service", now)
+
+			// then
+			Expect(req.Header.Get("X-Amz-Date")).To(Equal("20150830T123600Z"))
+			Expect(req.Header.Get("Authorization")).To(Equal(given.authorization))
+		},
+		table.Entry("get-vanilla", testCase{
+			method:        http.MethodGet,
+			url:           "https://example.amazonaws.com/",
+			authorization: "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+		}),
+		table.Entry("post-vanilla", testCase{
+			method:        http.MethodPost,
+			url:           "https://example.amazonaws.com/",
+			authorization: "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5da7c1a2acd57cee7505fc6676e4e544621c30862966e37dddb68e92efbe5d6b",
+		}),
+		table.Entry("get-vanilla-query-order-key", testCase{
+			method:        http.MethodGet,
+			url:           "https://example.amazonaws.com/?Param2=value2&Param1=value1",
+			authorization: "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=b97d918cfa904a5beff61c982a1b6f458b799221646efd99d3219ec94cdf2500",
+		}),
+	)
+
+	It("should sign the session token", func() {
+		// given
+		req, err := http.NewRequest(http.MethodPost, "https://example.amazonaws.com/", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		util_aws.Sign(req, nil, util_aws.Credentials{
+			AccessKeyID:     creds.AccessKeyID,
+			SecretAccessKey: creds.SecretAccessKey,
+			SessionToken:    "session-token",
+		}, "us-east-1", "service", now)
+
+		// then
+		Expect(req.Header.Get("X-Amz-Security-Token")).To(Equal("session-token"))
+		Expect(req.Header.Get("Authorization")).To(ContainSubstring("SignedHeaders=host;x-amz-date;x-amz-security-token,"))
+	})
+})
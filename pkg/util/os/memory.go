@@ -0,0 +1,55 @@
+package os
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const cgroupRoot = "/sys/fs/cgroup"
+
+// unlimitedMemory is the lowest value that cgroup v1 reports when the memory is not limited.
+// The exact value depends on the page size of the kernel, but it is always close to math.MaxInt64.
+const unlimitedMemory = uint64(1) << 62
+
+// MemoryLimit returns the memory limit of the cgroup of the current process in bytes.
+// It returns 0 if the memory is not limited or the limit cannot be determined.
+func MemoryLimit() (uint64, error) {
+	return memoryLimit(cgroupRoot)
+}
+
+func memoryLimit(root string) (uint64, error) {
+	// cgroup v2
+	if limit, ok, err := readMemoryLimit(filepath.Join(root, "memory.max")); ok || err != nil {
+		return limit, err
+	}
+	// cgroup v1
+	limit, _, err := readMemoryLimit(filepath.Join(root, "memory", "memory.limit_in_bytes"))
+	return limit, err
+}
+
+func readMemoryLimit(path string) (uint64, bool, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, false, nil
+		}
+		return 0, false, errors.Wrapf(err, "could not read %s", path)
+	}
+	value := strings.TrimSpace(string(content))
+	if value == "max" {
+		return 0, true, nil
+	}
+	limit, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "could not parse %s", path)
+	}
+	if limit >= unlimitedMemory {
+		return 0, true, nil
+	}
+	return limit, true, nil
+}
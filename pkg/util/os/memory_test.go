@@ -0,0 +1,70 @@
+package os
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Memory limit", func() {
+	var root string
+
+	BeforeEach(func() {
+		dir, err := ioutil.TempDir("", "cgroup")
+		Expect(err).ToNot(HaveOccurred())
+		root = dir
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(root)).To(Succeed())
+	})
+
+	writeFile := func(path string, content string) {
+		Expect(os.MkdirAll(filepath.Dir(filepath.Join(root, path)), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(root, path), []byte(content), 0600)).To(Succeed())
+	}
+
+	DescribeTable("should read the memory limit of the cgroup",
+		func(files map[string]string, expected uint64) {
+			// given
+			for path, content := range files {
+				writeFile(path, content)
+			}
+
+			// when
+			limit, err := memoryLimit(root)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(limit).To(Equal(expected))
+		},
+		Entry("cgroup v2", map[string]string{
+			"memory.max": "536870912\n",
+		}, uint64(536870912)),
+		Entry("cgroup v2 without limit", map[string]string{
+			"memory.max": "max\n",
+		}, uint64(0)),
+		Entry("cgroup v1", map[string]string{
+			"memory/memory.limit_in_bytes": "536870912\n",
+		}, uint64(536870912)),
+		Entry("cgroup v1 without limit", map[string]string{
+			"memory/memory.limit_in_bytes": "9223372036854771712\n",
+		}, uint64(0)),
+		Entry("no cgroup", map[string]string{}, uint64(0)),
+	)
+
+	It("should fail on invalid limit", func() {
+		// given
+		writeFile("memory.max", "invalid")
+
+		// when
+		_, err := memoryLimit(root)
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
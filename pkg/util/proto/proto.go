@@ -18,6 +18,16 @@ func FromYAML(content []byte, pb proto.Message) error {
 	return FromJSON(json, pb)
 }
 
+// FromYAMLStrict is like FromYAML, but it fails on fields that are not defined in the message.
+func FromYAMLStrict(content []byte, pb proto.Message) error {
+	json, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return err
+	}
+	unmarshaler := &jsonpb.Unmarshaler{}
+	return unmarshaler.Unmarshal(bytes.NewReader(json), pb)
+}
+
 func ToYAML(pb proto.Message) ([]byte, error) {
 	marshaler := &jsonpb.Marshaler{}
 	json, err := marshaler.MarshalToString(pb)
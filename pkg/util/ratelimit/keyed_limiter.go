@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+// minIdleTimeout is the minimal time after which a limiter of a key that is not used is removed
+const minIdleTimeout = time.Minute
+
+type keyedEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// KeyedLimiter is a token bucket rate limiter with a separate bucket for every key, ex. for every client.
+// Buckets of keys that are not used are removed once they are full again, so the number of buckets is bounded
+// by the number of keys that are active at the same time.
+type KeyedLimiter struct {
+	limit       rate.Limit
+	burst       int
+	idleTimeout time.Duration
+
+	sync.Mutex
+	entries     map[string]*keyedEntry
+	lastCleanup time.Time
+}
+
+// NewKeyedLimiter creates a limiter that allows requestsPerSecond per key with bursts of at most burst requests.
+func NewKeyedLimiter(requestsPerSecond float64, burst int) *KeyedLimiter {
+	idleTimeout := minIdleTimeout
+	// after this time the bucket is full, so removing it does not change the behaviour
+	if refill := time.Duration(float64(burst) / requestsPerSecond * float64(time.Second)); refill > idleTimeout {
+		idleTimeout = refill
+	}
+	return &KeyedLimiter{
+		limit:       rate.Limit(requestsPerSecond),
+		burst:       burst,
+		idleTimeout: idleTimeout,
+		entries:     map[string]*keyedEntry{},
+		lastCleanup: core.Now(),
+	}
+}
+
+// Allow reports whether a request of the key may happen now.
+func (k *KeyedLimiter) Allow(key string) bool {
+	k.Lock()
+	defer k.Unlock()
+	now := core.Now()
+	k.cleanup(now)
+	entry, ok := k.entries[key]
+	if !ok {
+		entry = &keyedEntry{
+			limiter: rate.NewLimiter(k.limit, k.burst),
+		}
+		k.entries[key] = entry
+	}
+	entry.lastSeen = now
+	return entry.limiter.AllowN(now, 1)
+}
+
+func (k *KeyedLimiter) cleanup(now time.Time) {
+	if now.Sub(k.lastCleanup) < k.idleTimeout {
+		return
+	}
+	k.lastCleanup = now
+	for key, entry := range k.entries {
+		if now.Sub(entry.lastSeen) >= k.idleTimeout {
+			delete(k.entries, key)
+		}
+	}
+}
+
+// Len returns the number of keys that are tracked by the limiter.
+func (k *KeyedLimiter) Len() int {
+	k.Lock()
+	defer k.Unlock()
+	return len(k.entries)
+}
@@ -0,0 +1,67 @@
+package ratelimit_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/util/ratelimit"
+)
+
+var _ = Describe("KeyedLimiter", func() {
+
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Now()
+		core.Now = func() time.Time {
+			return now
+		}
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	It("should limit every key separately", func() {
+		// given
+		limiter := ratelimit.NewKeyedLimiter(1, 2)
+
+		// expect
+		Expect(limiter.Allow("client-1")).To(BeTrue())
+		Expect(limiter.Allow("client-1")).To(BeTrue())
+		Expect(limiter.Allow("client-1")).To(BeFalse())
+		// and
+		Expect(limiter.Allow("client-2")).To(BeTrue())
+	})
+
+	It("should refill the bucket over time", func() {
+		// given
+		limiter := ratelimit.NewKeyedLimiter(1, 1)
+		Expect(limiter.Allow("client-1")).To(BeTrue())
+		Expect(limiter.Allow("client-1")).To(BeFalse())
+
+		// when
+		now = now.Add(time.Second)
+
+		// then
+		Expect(limiter.Allow("client-1")).To(BeTrue())
+	})
+
+	It("should remove keys that are not used", func() {
+		// given
+		limiter := ratelimit.NewKeyedLimiter(1, 1)
+		Expect(limiter.Allow("client-1")).To(BeTrue())
+		Expect(limiter.Allow("client-2")).To(BeTrue())
+		Expect(limiter.Len()).To(Equal(2))
+
+		// when
+		now = now.Add(time.Minute)
+		Expect(limiter.Allow("client-2")).To(BeTrue())
+
+		// then
+		Expect(limiter.Len()).To(Equal(1))
+	})
+})
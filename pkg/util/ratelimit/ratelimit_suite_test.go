@@ -0,0 +1,11 @@
+package ratelimit_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestRateLimit(t *testing.T) {
+	test.RunSpecs(t, "Rate Limit Suite")
+}
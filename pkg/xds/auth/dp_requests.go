@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// AuthenticationError is returned when the dataplane token of a request of Kuma DP to the DP Server is rejected.
+type AuthenticationError struct {
+	err error
+}
+
+func NewAuthenticationError(err error) error {
+	return &AuthenticationError{err: errors.Wrap(err, "authentication failed")}
+}
+
+func (a *AuthenticationError) Error() string {
+	return a.err.Error()
+}
+
+// HandleDpRequestError writes the response to a request of Kuma DP that failed.
+// Invalid requests and rejected tokens are reported back to Kuma DP, other errors are logged with msg.
+func HandleDpRequestError(resp http.ResponseWriter, err error, logger logr.Logger, msg string) {
+	if validators.IsValidationError(err) {
+		resp.WriteHeader(http.StatusUnprocessableEntity)
+		if _, err := resp.Write([]byte(err.Error())); err != nil {
+			logger.Error(err, "Error while writing the response")
+		}
+		return
+	}
+	if _, ok := err.(*AuthenticationError); ok {
+		resp.WriteHeader(http.StatusUnauthorized)
+		if _, err := resp.Write([]byte(err.Error())); err != nil {
+			logger.Error(err, "Error while writing the response")
+		}
+		return
+	}
+	if core_store.IsResourceNotFound(err) {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	logger.Error(err, msg)
+	resp.WriteHeader(http.StatusInternalServerError)
+}
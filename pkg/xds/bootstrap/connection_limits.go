@@ -0,0 +1,43 @@
+package bootstrap
+
+import (
+	"fmt"
+
+	envoy_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/gateway/match"
+)
+
+// listenerConnectionLimitKey is the runtime key that limits active connections of a single listener
+func listenerConnectionLimitKey(listener string) string {
+	return fmt.Sprintf("envoy.resource_limits.listener.%s.connection_limit", listener)
+}
+
+// connectionLimitsFor returns the connection limits of the listeners of a builtin gateway by the names of the listeners.
+// It returns nil for other data plane proxies.
+func connectionLimitsFor(resManager core_manager.ReadOnlyResourceManager, dataplane *core_mesh.DataplaneResource) map[string]uint32 {
+	if !dataplane.Spec.IsBuiltinGateway() {
+		return nil
+	}
+	manager := match.ManagerForMesh(resManager, dataplane.Meta.GetMesh())
+	return gateway.ConnectionLimits(match.Gateways(manager, dataplane))
+}
+
+func applyConnectionLimits(config *envoy_bootstrap_v3.Bootstrap, limits map[string]uint32) error {
+	if len(limits) == 0 {
+		return nil
+	}
+	layer := kumaRuntimeLayer(config)
+	if layer == nil {
+		return errors.New("static runtime layer is not defined")
+	}
+	for listener, limit := range limits {
+		layer.Fields[listenerConnectionLimitKey(listener)] = structpb.NewNumberValue(float64(limit))
+	}
+	return nil
+}
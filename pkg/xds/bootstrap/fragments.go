@@ -0,0 +1,109 @@
+package bootstrap
+
+import (
+	"io/ioutil"
+
+	envoy_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	bootstrap_config "github.com/kumahq/kuma/pkg/config/xds/bootstrap"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// bootstrapFragment is a fragment of Envoy bootstrap configuration provided by an operator.
+type bootstrapFragment struct {
+	name      string
+	zones     []string
+	selector  mesh_proto.TagSelector
+	bootstrap *envoy_bootstrap_v3.Bootstrap
+}
+
+// matches returns true if the fragment should be applied to the proxy.
+// Zone Ingresses are only matched by the fragments without tags.
+func (f *bootstrapFragment) matches(zone string, dataplane *mesh_proto.Dataplane) bool {
+	if len(f.zones) > 0 {
+		found := false
+		for _, z := range f.zones {
+			if z == zone {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.selector) == 0 {
+		return true
+	}
+	return dataplane.Matches(f.selector)
+}
+
+func loadFragments(cfgs []*bootstrap_config.BootstrapFragmentConfig) ([]*bootstrapFragment, error) {
+	var fragments []*bootstrapFragment
+	for _, cfg := range cfgs {
+		fragment, err := loadFragment(cfg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not load bootstrap fragment %q", cfg.Name)
+		}
+		fragments = append(fragments, fragment)
+	}
+	return fragments, nil
+}
+
+func loadFragment(cfg *bootstrap_config.BootstrapFragmentConfig) (*bootstrapFragment, error) {
+	content := []byte(cfg.Config)
+	if cfg.Path != "" {
+		bytes, err := ioutil.ReadFile(cfg.Path)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read file")
+		}
+		content = bytes
+	}
+	bootstrap := &envoy_bootstrap_v3.Bootstrap{}
+	if err := util_proto.FromYAMLStrict(content, bootstrap); err != nil {
+		return nil, errors.Wrap(err, "could not parse Envoy bootstrap config")
+	}
+	if err := bootstrap.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Envoy bootstrap config is not valid")
+	}
+	// node and dynamic resources are how the proxy identifies itself and connects to the control plane
+	if bootstrap.Node != nil {
+		return nil, errors.New("node cannot be overridden, it is managed by Kuma")
+	}
+	if bootstrap.DynamicResources != nil {
+		return nil, errors.New("dynamic_resources cannot be overridden, they are managed by Kuma")
+	}
+	return &bootstrapFragment{
+		name:      cfg.Name,
+		zones:     cfg.Zones,
+		selector:  cfg.Tags,
+		bootstrap: bootstrap,
+	}, nil
+}
+
+// mergeFragment merges the fragment into the generated bootstrap config.
+// Repeated fields are appended, singular fields are overridden.
+func mergeFragment(config *envoy_bootstrap_v3.Bootstrap, fragment *bootstrapFragment) error {
+	if fragment.bootstrap.Admin != nil && config.Admin == nil {
+		return errors.Errorf("bootstrap fragment %q configures admin, but the admin interface of the proxy is disabled", fragment.name)
+	}
+	proto.Merge(config, fragment.bootstrap)
+	clusters := map[string]bool{}
+	for _, cluster := range config.GetStaticResources().GetClusters() {
+		if clusters[cluster.Name] {
+			return errors.Errorf("bootstrap fragment %q defines cluster %q that already exists", fragment.name, cluster.Name)
+		}
+		clusters[cluster.Name] = true
+	}
+	listeners := map[string]bool{}
+	for _, listener := range config.GetStaticResources().GetListeners() {
+		if listeners[listener.Name] {
+			return errors.Errorf("bootstrap fragment %q defines listener %q that already exists", fragment.name, listener.Name)
+		}
+		listeners[listener.Name] = true
+	}
+	return nil
+}
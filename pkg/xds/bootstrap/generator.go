@@ -16,6 +16,7 @@ import (
 
 	"github.com/asaskevich/govalidator"
 	envoy_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoy_metrics_v3 "github.com/envoyproxy/go-control-plane/envoy/config/metrics/v3"
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 
@@ -45,6 +46,7 @@ func NewDefaultBootstrapGenerator(
 	dpServerCertFile string,
 	dpAuthEnabled bool,
 	hdsEnabled bool,
+	zone string,
 ) (BootstrapGenerator, error) {
 	hostsAndIps, err := hostsAndIPsFromCertFile(dpServerCertFile)
 	if err != nil {
@@ -53,6 +55,10 @@ func NewDefaultBootstrapGenerator(
 	if config.Params.XdsHost != "" && !hostsAndIps[config.Params.XdsHost] {
 		return nil, errors.Errorf("hostname: %s set by KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_HOST is not available in the DP Server certificate. Available hostnames: %q. Change the hostname or generate certificate with proper hostname.", config.Params.XdsHost, hostsAndIps.slice())
 	}
+	fragments, err := loadFragments(config.Fragments)
+	if err != nil {
+		return nil, err
+	}
 	return &bootstrapGenerator{
 		resManager:    resManager,
 		config:        config,
@@ -60,6 +66,8 @@ func NewDefaultBootstrapGenerator(
 		dpAuthEnabled: dpAuthEnabled,
 		hostsAndIps:   hostsAndIps,
 		hdsEnabled:    hdsEnabled,
+		zone:          zone,
+		fragments:     fragments,
 	}, nil
 }
 
@@ -70,6 +78,8 @@ type bootstrapGenerator struct {
 	xdsCertFile   string
 	hostsAndIps   SANSet
 	hdsEnabled    bool
+	zone          string
+	fragments     []*bootstrapFragment
 }
 
 func (b *bootstrapGenerator) Generate(ctx context.Context, request types.BootstrapRequest) (proto.Message, error) {
@@ -93,7 +103,8 @@ func (b *bootstrapGenerator) Generate(ctx context.Context, request types.Bootstr
 		if err != nil {
 			return nil, err
 		}
-		return b.generateFor(*proxyId, request, "ingress", adminPort)
+		overloadManager := overloadManagerParamsFor(b.config.OverloadManager, nil, request.MemoryLimit)
+		return b.generateFor(*proxyId, request, "ingress", adminPort, nil, overloadManager, nil, nil)
 	case mesh_proto.DataplaneProxyType:
 		proxyId := core_xds.BuildProxyId(request.Mesh, request.Name)
 		dataplane, err := b.dataplaneFor(ctx, request, proxyId)
@@ -105,7 +116,14 @@ func (b *bootstrapGenerator) Generate(ctx context.Context, request types.Bootstr
 		if err != nil {
 			return nil, err
 		}
-		return b.generateFor(*proxyId, request, service, adminPort)
+		mesh := core_mesh.NewMeshResource()
+		if err := b.resManager.Get(ctx, mesh, core_store.GetByKey(dataplane.Meta.GetMesh(), core_model.NoMesh)); err != nil {
+			return nil, err
+		}
+		overloadManager := overloadManagerParamsFor(b.config.OverloadManager, mesh.Spec, request.MemoryLimit)
+		statsMatcher := statsMatcherFor(b.config.StatsPruning, mesh.Spec, dataplane.Spec)
+		connectionLimits := connectionLimitsFor(b.resManager, dataplane)
+		return b.generateFor(*proxyId, request, service, adminPort, dataplane.Spec, overloadManager, statsMatcher, connectionLimits)
 	default:
 		return nil, errors.Errorf("unknown proxy type %v", proxyType)
 	}
@@ -240,7 +258,17 @@ func (b *bootstrapGenerator) adminPortForIngress(request types.BootstrapRequest,
 	return adminPort, nil
 }
 
-func (b *bootstrapGenerator) generateFor(proxyId core_xds.ProxyId, request types.BootstrapRequest, service string, adminPort uint32) (proto.Message, error) {
+// generateFor generates bootstrap config of the proxy. Dataplane is nil for Zone Ingress.
+func (b *bootstrapGenerator) generateFor(
+	proxyId core_xds.ProxyId,
+	request types.BootstrapRequest,
+	service string,
+	adminPort uint32,
+	dataplane *mesh_proto.Dataplane,
+	overloadManager *overloadManagerParams,
+	statsMatcher *envoy_metrics_v3.StatsMatcher,
+	connectionLimits map[string]uint32,
+) (proto.Message, error) {
 	cert, origin, err := b.caCert(request)
 	if err != nil {
 		return nil, err
@@ -285,9 +313,39 @@ func (b *bootstrapGenerator) generateFor(proxyId core_xds.ProxyId, request types
 		DNSPort:            request.DNSPort,
 		EmptyDNSPort:       request.EmptyDNSPort,
 		ProxyType:          request.ProxyType,
+		SecretsDir:         request.SecretsDir,
 	}
 	log.WithValues("params", params).Info("Generating bootstrap config")
-	return b.configForParametersV3(params)
+	config, err := b.configForParametersV3(params)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyOverloadManager(config, overloadManager); err != nil {
+		return nil, errors.Wrap(err, "could not configure overload manager")
+	}
+	applyStatsMatcher(config, statsMatcher)
+	if err := applyConnectionLimits(config, connectionLimits); err != nil {
+		return nil, errors.Wrap(err, "could not configure connection limits")
+	}
+	if err := b.applyFragments(config, dataplane); err != nil {
+		return nil, err
+	}
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Envoy bootstrap config is not valid")
+	}
+	return config, nil
+}
+
+func (b *bootstrapGenerator) applyFragments(config *envoy_bootstrap_v3.Bootstrap, dataplane *mesh_proto.Dataplane) error {
+	for _, fragment := range b.fragments {
+		if !fragment.matches(b.zone, dataplane) {
+			continue
+		}
+		if err := mergeFragment(config, fragment); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (b *bootstrapGenerator) validateCaCert(cert []byte, origin string, request types.BootstrapRequest) error {
@@ -332,7 +390,7 @@ func (b *bootstrapGenerator) xdsHost(request types.BootstrapRequest) string {
 	}
 }
 
-func (b *bootstrapGenerator) configForParametersV3(params configParameters) (proto.Message, error) {
+func (b *bootstrapGenerator) configForParametersV3(params configParameters) (*envoy_bootstrap_v3.Bootstrap, error) {
 	tmpl, err := template.New("bootstrap").Parse(configTemplateV3)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse config template")
@@ -345,9 +403,6 @@ func (b *bootstrapGenerator) configForParametersV3(params configParameters) (pro
 	if err := util_proto.FromYAML(buf.Bytes(), config); err != nil {
 		return nil, errors.Wrap(err, "failed to parse bootstrap config")
 	}
-	if err := config.Validate(); err != nil {
-		return nil, errors.Wrap(err, "Envoy bootstrap config is not valid")
-	}
 	return config, nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"time"
 
+	envoy_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -84,11 +85,12 @@ var _ = Describe("bootstrapGenerator", func() {
 		request            types.BootstrapRequest
 		expectedConfigFile string
 		hdsEnabled         bool
+		zone               string
 	}
 	DescribeTable("should generate bootstrap configuration",
 		func(given testCase) {
 			// setup
-			generator, err := NewDefaultBootstrapGenerator(resManager, given.config(), filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), given.dpAuthEnabled, given.hdsEnabled)
+			generator, err := NewDefaultBootstrapGenerator(resManager, given.config(), filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), given.dpAuthEnabled, given.hdsEnabled, given.zone)
 			Expect(err).ToNot(HaveOccurred())
 
 			// when
@@ -134,6 +136,7 @@ var _ = Describe("bootstrapGenerator", func() {
 				Version:        defaultVersion,
 				DNSPort:        53001,
 				EmptyDNSPort:   53002,
+				SecretsDir:     "/var/run/kuma-dp/secrets",
 			},
 			expectedConfigFile: "generator.default-config.golden.yaml",
 			hdsEnabled:         true,
@@ -244,8 +247,489 @@ var _ = Describe("bootstrapGenerator", func() {
 			expectedConfigFile: "generator.default-config.kubernetes.ipv6.golden.yaml",
 			hdsEnabled:         false,
 		}),
+		Entry("default config with memory limit", testCase{
+			dpAuthEnabled: true,
+			config: func() *bootstrap_config.BootstrapServerConfig {
+				cfg := bootstrap_config.DefaultBootstrapServerConfig()
+				cfg.Params.XdsHost = "localhost"
+				cfg.Params.XdsPort = 5678
+				return cfg
+			},
+			request: types.BootstrapRequest{
+				Mesh:           "mesh",
+				Name:           "name.namespace",
+				AdminPort:      1234,
+				DataplaneToken: "token",
+				Version:        defaultVersion,
+				MemoryLimit:    536870912,
+			},
+			expectedConfigFile: "generator.default-config.memory-limit.golden.yaml",
+			hdsEnabled:         false,
+		}),
+		Entry("default config with bootstrap fragments", testCase{
+			dpAuthEnabled: true,
+			config: func() *bootstrap_config.BootstrapServerConfig {
+				cfg := bootstrap_config.DefaultBootstrapServerConfig()
+				cfg.Params.XdsHost = "localhost"
+				cfg.Params.XdsPort = 5678
+				cfg.Fragments = []*bootstrap_config.BootstrapFragmentConfig{
+					{
+						Name: "statsd",
+						Tags: map[string]string{
+							"kuma.io/service": "backend",
+						},
+						Config: `
+stats_sinks:
+- name: envoy.stat_sinks.statsd
+  typed_config:
+    '@type': type.googleapis.com/envoy.config.metrics.v3.StatsdSink
+    prefix: kuma
+    tcp_cluster_name: statsd
+static_resources:
+  clusters:
+  - name: statsd
+    type: STATIC
+    connect_timeout: 1s
+    load_assignment:
+      cluster_name: statsd
+      endpoints:
+      - lb_endpoints:
+        - endpoint:
+            address:
+              socket_address:
+                address: 127.0.0.1
+                port_value: 8125
+`,
+					},
+					{
+						Name: "overload-manager",
+						Config: `
+overload_manager:
+  refresh_interval: 0.25s
+  resource_monitors:
+  - name: envoy.resource_monitors.fixed_heap
+    typed_config:
+      '@type': type.googleapis.com/envoy.extensions.resource_monitors.fixed_heap.v3.FixedHeapConfig
+      max_heap_size_bytes: 1073741824
+  actions:
+  - name: envoy.overload_actions.shrink_heap
+    triggers:
+    - name: envoy.resource_monitors.fixed_heap
+      threshold:
+        value: 0.95
+`,
+					},
+					{
+						Name:  "admin-access-log",
+						Zones: []string{"zone-1"},
+						Config: `
+admin:
+  access_log_path: /var/log/envoy-admin.log
+`,
+					},
+					{
+						Name:  "other-zone",
+						Zones: []string{"zone-2"},
+						Config: `
+stats_flush_interval: 10s
+`,
+					},
+					{
+						Name: "other-service",
+						Tags: map[string]string{
+							"kuma.io/service": "web",
+						},
+						Config: `
+stats_flush_interval: 20s
+`,
+					},
+				}
+				return cfg
+			},
+			request: types.BootstrapRequest{
+				Mesh:           "mesh",
+				Name:           "name.namespace",
+				AdminPort:      1234,
+				DataplaneToken: "token",
+				Version:        defaultVersion,
+			},
+			expectedConfigFile: "generator.default-config.fragments.golden.yaml",
+			hdsEnabled:         false,
+			zone:               "zone-1",
+		}),
+	)
+
+	Context("overload manager", func() {
+
+		BeforeEach(func() {
+			// given
+			meshRes := mesh.NewMeshResource()
+			meshRes.Spec.OverloadManager = &mesh_proto.OverloadManager{
+				MaxHeapSizeBytes:               util_proto.UInt64(1073741824),
+				ShrinkHeapThreshold:            util_proto.Double(0.9),
+				StopAcceptingRequestsThreshold: util_proto.Double(0.95),
+				MaxActiveDownstreamConnections: util_proto.UInt32(10000),
+			}
+			err := resManager.Create(context.Background(), meshRes, store.CreateByKey("overloaded", model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+
+			meshRes = mesh.NewMeshResource()
+			meshRes.Spec.OverloadManager = &mesh_proto.OverloadManager{
+				Enabled: util_proto.Bool(false),
+			}
+			err = resManager.Create(context.Background(), meshRes, store.CreateByKey("not-overloaded", model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, meshName := range []string{"overloaded", "not-overloaded"} {
+				dataplane := mesh.NewDataplaneResource()
+				dataplane.Spec.Networking = &mesh_proto.Dataplane_Networking{
+					Address: "8.8.8.8",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port:        443,
+							ServicePort: 8443,
+							Tags: map[string]string{
+								"kuma.io/service": "backend",
+							},
+						},
+					},
+				}
+				err := resManager.Create(context.Background(), dataplane, store.CreateByKey("name.namespace", meshName))
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		generate := func(meshName string) *envoy_bootstrap_v3.Bootstrap {
+			cfg := bootstrap_config.DefaultBootstrapServerConfig()
+			cfg.Params.XdsHost = "localhost"
+			cfg.Params.XdsPort = 5678
+			generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, true, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			bootstrapConfig, err := generator.Generate(context.Background(), types.BootstrapRequest{
+				Mesh:        meshName,
+				Name:        "name.namespace",
+				AdminPort:   1234,
+				Version:     defaultVersion,
+				MemoryLimit: 536870912,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return bootstrapConfig.(*envoy_bootstrap_v3.Bootstrap)
+		}
+
+		It("should override the defaults with the settings of the mesh", func() {
+			// when
+			bootstrapConfig := generate("overloaded")
+
+			// then
+			actual, err := util_proto.ToYAML(bootstrapConfig.OverloadManager)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(`
+actions:
+- name: envoy.overload_actions.shrink_heap
+  triggers:
+  - name: envoy.resource_monitors.fixed_heap
+    threshold:
+      value: 0.9
+- name: envoy.overload_actions.stop_accepting_requests
+  triggers:
+  - name: envoy.resource_monitors.fixed_heap
+    threshold:
+      value: 0.95
+refreshInterval: 0.250s
+resourceMonitors:
+- name: envoy.resource_monitors.fixed_heap
+  typedConfig:
+    '@type': type.googleapis.com/envoy.extensions.resource_monitors.fixed_heap.v3.FixedHeapConfig
+    maxHeapSizeBytes: "1073741824"
+`))
+			// and
+			staticLayer := bootstrapConfig.LayeredRuntime.Layers[0].GetStaticLayer()
+			Expect(staticLayer.Fields["overload.global_downstream_max_connections"].GetNumberValue()).To(Equal(float64(10000)))
+		})
+
+		It("should not configure overload manager when it is disabled in the mesh", func() {
+			// when
+			bootstrapConfig := generate("not-overloaded")
+
+			// then
+			Expect(bootstrapConfig.OverloadManager).To(BeNil())
+			// and
+			staticLayer := bootstrapConfig.LayeredRuntime.Layers[0].GetStaticLayer()
+			Expect(staticLayer.Fields).ToNot(HaveKey("overload.global_downstream_max_connections"))
+		})
+	})
+
+	Context("stats pruning", func() {
+
+		BeforeEach(func() {
+			// given
+			meshes := map[string]string{
+				"no-metrics": `{}`,
+				"metrics": `
+                metrics:
+                  enabledBackend: prometheus-1
+                  backends:
+                  - name: prometheus-1
+                    type: prometheus`,
+				"metrics-locality-aware": `
+                metrics:
+                  enabledBackend: prometheus-1
+                  backends:
+                  - name: prometheus-1
+                    type: prometheus
+                routing:
+                  localityAwareLoadBalancing: true`,
+			}
+			for meshName, spec := range meshes {
+				meshRes := mesh.NewMeshResource()
+				Expect(util_proto.FromYAML([]byte(spec), meshRes.Spec)).To(Succeed())
+				err := resManager.Create(context.Background(), meshRes, store.CreateByKey(meshName, model.NoMesh))
+				Expect(err).ToNot(HaveOccurred())
+
+				dataplane := mesh.NewDataplaneResource()
+				dataplane.Spec.Networking = &mesh_proto.Dataplane_Networking{
+					Address: "8.8.8.8",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port:        443,
+							ServicePort: 8443,
+							Tags: map[string]string{
+								"kuma.io/service": "backend",
+							},
+						},
+					},
+				}
+				err = resManager.Create(context.Background(), dataplane, store.CreateByKey("name.namespace", meshName))
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		generate := func(meshName string, enabled bool) *envoy_bootstrap_v3.Bootstrap {
+			cfg := bootstrap_config.DefaultBootstrapServerConfig()
+			cfg.Params.XdsHost = "localhost"
+			cfg.Params.XdsPort = 5678
+			cfg.StatsPruning.Enabled = enabled
+			generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, true, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			bootstrapConfig, err := generator.Generate(context.Background(), types.BootstrapRequest{
+				Mesh:      meshName,
+				Name:      "name.namespace",
+				AdminPort: 1234,
+				Version:   defaultVersion,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return bootstrapConfig.(*envoy_bootstrap_v3.Bootstrap)
+		}
+
+		It("should keep only essential stats when metrics are disabled", func() {
+			// when
+			bootstrapConfig := generate("no-metrics", true)
+
+			// then
+			actual, err := util_proto.ToYAML(bootstrapConfig.StatsConfig.StatsMatcher)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(`
+inclusionList:
+  patterns:
+  - prefix: server.
+  - prefix: runtime.
+  - prefix: overload.
+  - prefix: control_plane.
+  - prefix: cluster_manager.
+  - prefix: listener_manager.
+  - prefix: cluster.ads_cluster.
+  - prefix: cluster.access_log_sink.
+`))
+			// and
+			Expect(bootstrapConfig.StatsConfig.StatsTags).ToNot(BeEmpty())
+		})
+
+		It("should exclude stats of zone aware load balancing when locality aware load balancing is disabled", func() {
+			// when
+			bootstrapConfig := generate("metrics", true)
+
+			// then
+			actual, err := util_proto.ToYAML(bootstrapConfig.StatsConfig.StatsMatcher)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(`
+exclusionList:
+  patterns:
+  - safeRegex:
+      googleRe2: {}
+      regex: ^cluster\.[^.]+\.lb_(zone_|local_cluster_not_ok|recalculate_zone_structures)
+`))
+		})
+
+		It("should not prune stats when all features are used", func() {
+			// when
+			bootstrapConfig := generate("metrics-locality-aware", true)
+
+			// then
+			Expect(bootstrapConfig.StatsConfig.StatsMatcher).To(BeNil())
+		})
+
+		It("should not prune stats when pruning is disabled", func() {
+			// when
+			bootstrapConfig := generate("no-metrics", false)
+
+			// then
+			Expect(bootstrapConfig.StatsConfig.StatsMatcher).To(BeNil())
+		})
+	})
+
+	Context("connection limits", func() {
+
+		BeforeEach(func() {
+			// given
+			err := resManager.Create(context.Background(), mesh.NewMeshResource(), store.CreateByKey("gateways", model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+
+			dataplane := mesh.NewDataplaneResource()
+			dataplane.Spec.Networking = &mesh_proto.Dataplane_Networking{
+				Address: "8.8.8.8",
+				Gateway: &mesh_proto.Dataplane_Networking_Gateway{
+					Type: mesh_proto.Dataplane_Networking_Gateway_BUILTIN,
+					Tags: map[string]string{
+						"kuma.io/service": "edge-gateway",
+					},
+				},
+			}
+			err = resManager.Create(context.Background(), dataplane, store.CreateByKey("name.namespace", "gateways"))
+			Expect(err).ToNot(HaveOccurred())
+
+			gateway := mesh.NewGatewayResource()
+			Expect(util_proto.FromYAML([]byte(`
+selectors:
+- match:
+    kuma.io/service: edge-gateway
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      name: http
+    limits:
+      max_connections: 1000
+  - port: 9090
+    protocol: TCP
+    tags:
+      name: tcp
+  - port: 9443
+    protocol: TLS
+    hostname: foo.example.com
+    tls:
+      mode: PASSTHROUGH
+    tags:
+      name: foo
+    limits:
+      max_connections: 100
+  - port: 9443
+    protocol: TLS
+    hostname: bar.example.com
+    tls:
+      mode: PASSTHROUGH
+    tags:
+      name: bar
+    limits:
+      max_connections: 100
+`), gateway.Spec)).To(Succeed())
+			err = resManager.Create(context.Background(), gateway, store.CreateByKey("edge-gateway", "gateways"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should limit the connections of each gateway listener", func() {
+			// given
+			cfg := bootstrap_config.DefaultBootstrapServerConfig()
+			cfg.Params.XdsHost = "localhost"
+			cfg.Params.XdsPort = 5678
+			generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, true, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			bootstrapConfig, err := generator.Generate(context.Background(), types.BootstrapRequest{
+				Mesh:      "gateways",
+				Name:      "name.namespace",
+				AdminPort: 1234,
+				Version:   defaultVersion,
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			staticLayer := bootstrapConfig.(*envoy_bootstrap_v3.Bootstrap).LayeredRuntime.Layers[0].GetStaticLayer()
+			Expect(staticLayer.Fields["envoy.resource_limits.listener.edge-gateway:HTTP:8080.connection_limit"].GetNumberValue()).To(Equal(float64(1000)))
+			Expect(staticLayer.Fields["envoy.resource_limits.listener.edge-gateway:TLS:9443.connection_limit"].GetNumberValue()).To(Equal(float64(100)))
+			Expect(staticLayer.Fields).ToNot(HaveKey("envoy.resource_limits.listener.edge-gateway:TCP:9090.connection_limit"))
+		})
+	})
+
+	DescribeTable("should reject invalid bootstrap fragments",
+		func(fragment string, expected string) {
+			// given
+			cfg := bootstrap_config.DefaultBootstrapServerConfig()
+			cfg.Fragments = []*bootstrap_config.BootstrapFragmentConfig{
+				{
+					Name:   "invalid",
+					Config: fragment,
+				},
+			}
+
+			// when
+			_, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, true, "")
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expected))
+		},
+		Entry("unknown field", `
+stats_sink: []
+`, `could not load bootstrap fragment "invalid": could not parse Envoy bootstrap config`),
+		Entry("invalid field value", `
+stats_flush_interval: 600s
+`, `could not load bootstrap fragment "invalid": Envoy bootstrap config is not valid`),
+		Entry("node", `
+node:
+  id: other
+`, `could not load bootstrap fragment "invalid": node cannot be overridden, it is managed by Kuma`),
+		Entry("dynamic resources", `
+dynamic_resources:
+  lds_config:
+    ads: {}
+`, `could not load bootstrap fragment "invalid": dynamic_resources cannot be overridden, they are managed by Kuma`),
 	)
 
+	It("should fail bootstrap configuration when a fragment redefines a cluster", func() {
+		// given
+		cfg := bootstrap_config.DefaultBootstrapServerConfig()
+		cfg.Params.XdsHost = "localhost"
+		cfg.Fragments = []*bootstrap_config.BootstrapFragmentConfig{
+			{
+				Name: "ads",
+				Config: `
+static_resources:
+  clusters:
+  - name: ads_cluster
+    connect_timeout: 1s
+`,
+			},
+		}
+		generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, true, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = generator.Generate(context.Background(), types.BootstrapRequest{
+			Mesh:      "mesh",
+			Name:      "name.namespace",
+			AdminPort: 1234,
+			Version:   defaultVersion,
+		})
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal(`bootstrap fragment "ads" defines cluster "ads_cluster" that already exists`))
+	})
+
 	It("should fail bootstrap configuration due to conflicting port in inbound", func() {
 		// setup
 		dataplane := mesh.DataplaneResource{
@@ -289,7 +773,7 @@ var _ = Describe("bootstrapGenerator", func() {
 		cfg.Params.XdsHost = "localhost"
 		cfg.Params.XdsPort = 5678
 
-		generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, true)
+		generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, true, "")
 		Expect(err).ToNot(HaveOccurred())
 		request := types.BootstrapRequest{
 			Mesh:      "mesh",
@@ -354,7 +838,7 @@ var _ = Describe("bootstrapGenerator", func() {
 		cfg.Params.XdsHost = "localhost"
 		cfg.Params.XdsPort = 5678
 
-		generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, true)
+		generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, true, "")
 		Expect(err).ToNot(HaveOccurred())
 		request := types.BootstrapRequest{
 			Mesh:      "mesh",
@@ -379,7 +863,7 @@ var _ = Describe("bootstrapGenerator", func() {
 			// given
 			cfg := bootstrap_config.DefaultBootstrapServerConfig()
 
-			generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, true)
+			generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, true, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			// when
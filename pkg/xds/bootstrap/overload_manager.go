@@ -0,0 +1,135 @@
+package bootstrap
+
+import (
+	"time"
+
+	envoy_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoy_overload_v3 "github.com/envoyproxy/go-control-plane/envoy/config/overload/v3"
+	envoy_fixed_heap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/resource_monitors/fixed_heap/v3"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	bootstrap_config "github.com/kumahq/kuma/pkg/config/xds/bootstrap"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const (
+	fixedHeapResourceMonitor            = "envoy.resource_monitors.fixed_heap"
+	shrinkHeapOverloadAction            = "envoy.overload_actions.shrink_heap"
+	stopAcceptingRequestsOverloadAction = "envoy.overload_actions.stop_accepting_requests"
+	// globalDownstreamMaxConnectionsKey is the runtime key that limits active downstream connections across all listeners
+	globalDownstreamMaxConnectionsKey = "overload.global_downstream_max_connections"
+	overloadManagerRefreshInterval    = 250 * time.Millisecond
+)
+
+// overloadManagerParams is the effective configuration of Envoy overload manager of a proxy.
+type overloadManagerParams struct {
+	// MaxHeapSizeBytes is 0 when the memory limit of the proxy is unknown
+	MaxHeapSizeBytes               uint64
+	ShrinkHeapThreshold            float64
+	StopAcceptingRequestsThreshold float64
+	MaxActiveDownstreamConnections uint32
+}
+
+// overloadManagerParamsFor merges the defaults of the control plane with the overrides of the mesh.
+// It returns nil when the overload manager is disabled. Mesh is nil for Zone Ingress.
+func overloadManagerParamsFor(
+	cfg *bootstrap_config.OverloadManagerConfig,
+	mesh *mesh_proto.Mesh,
+	memoryLimit uint64,
+) *overloadManagerParams {
+	if cfg == nil {
+		return nil
+	}
+	overrides := mesh.GetOverloadManager()
+	enabled := cfg.Enabled
+	if overrides.GetEnabled() != nil {
+		enabled = overrides.GetEnabled().GetValue()
+	}
+	if !enabled {
+		return nil
+	}
+	params := &overloadManagerParams{
+		MaxHeapSizeBytes:               memoryLimit,
+		ShrinkHeapThreshold:            cfg.ShrinkHeapThreshold,
+		StopAcceptingRequestsThreshold: cfg.StopAcceptingRequestsThreshold,
+		MaxActiveDownstreamConnections: cfg.MaxActiveDownstreamConnections,
+	}
+	if overrides.GetMaxHeapSizeBytes() != nil {
+		params.MaxHeapSizeBytes = overrides.GetMaxHeapSizeBytes().GetValue()
+	}
+	if overrides.GetShrinkHeapThreshold() != nil {
+		params.ShrinkHeapThreshold = overrides.GetShrinkHeapThreshold().GetValue()
+	}
+	if overrides.GetStopAcceptingRequestsThreshold() != nil {
+		params.StopAcceptingRequestsThreshold = overrides.GetStopAcceptingRequestsThreshold().GetValue()
+	}
+	if overrides.GetMaxActiveDownstreamConnections() != nil {
+		params.MaxActiveDownstreamConnections = overrides.GetMaxActiveDownstreamConnections().GetValue()
+	}
+	return params
+}
+
+func applyOverloadManager(config *envoy_bootstrap_v3.Bootstrap, params *overloadManagerParams) error {
+	if params == nil {
+		return nil
+	}
+	if params.MaxActiveDownstreamConnections > 0 {
+		layer := kumaRuntimeLayer(config)
+		if layer == nil {
+			return errors.New("static runtime layer is not defined")
+		}
+		layer.Fields[globalDownstreamMaxConnectionsKey] = structpb.NewNumberValue(float64(params.MaxActiveDownstreamConnections))
+	}
+	if params.MaxHeapSizeBytes == 0 {
+		return nil
+	}
+	fixedHeap, err := util_proto.MarshalAnyDeterministic(&envoy_fixed_heap_v3.FixedHeapConfig{
+		MaxHeapSizeBytes: params.MaxHeapSizeBytes,
+	})
+	if err != nil {
+		return err
+	}
+	config.OverloadManager = &envoy_overload_v3.OverloadManager{
+		RefreshInterval: util_proto.Duration(overloadManagerRefreshInterval),
+		ResourceMonitors: []*envoy_overload_v3.ResourceMonitor{
+			{
+				Name: fixedHeapResourceMonitor,
+				ConfigType: &envoy_overload_v3.ResourceMonitor_TypedConfig{
+					TypedConfig: fixedHeap,
+				},
+			},
+		},
+		Actions: []*envoy_overload_v3.OverloadAction{
+			heapOverloadAction(shrinkHeapOverloadAction, params.ShrinkHeapThreshold),
+			heapOverloadAction(stopAcceptingRequestsOverloadAction, params.StopAcceptingRequestsThreshold),
+		},
+	}
+	return nil
+}
+
+func kumaRuntimeLayer(config *envoy_bootstrap_v3.Bootstrap) *structpb.Struct {
+	for _, layer := range config.GetLayeredRuntime().GetLayers() {
+		if layer.GetName() == "kuma" && layer.GetStaticLayer() != nil {
+			return layer.GetStaticLayer()
+		}
+	}
+	return nil
+}
+
+func heapOverloadAction(name string, threshold float64) *envoy_overload_v3.OverloadAction {
+	return &envoy_overload_v3.OverloadAction{
+		Name: name,
+		Triggers: []*envoy_overload_v3.Trigger{
+			{
+				Name: fixedHeapResourceMonitor,
+				TriggerOneof: &envoy_overload_v3.Trigger_Threshold{
+					Threshold: &envoy_overload_v3.ThresholdTrigger{
+						Value: threshold,
+					},
+				},
+			},
+		},
+	}
+}
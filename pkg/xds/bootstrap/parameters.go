@@ -28,4 +28,5 @@ type configParameters struct {
 	DNSPort            uint32
 	EmptyDNSPort       uint32
 	ProxyType          string
+	SecretsDir         string
 }
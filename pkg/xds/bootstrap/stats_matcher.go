@@ -0,0 +1,91 @@
+package bootstrap
+
+import (
+	envoy_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoy_metrics_v3 "github.com/envoyproxy/go-control-plane/envoy/config/metrics/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	bootstrap_config "github.com/kumahq/kuma/pkg/config/xds/bootstrap"
+)
+
+// essentialStatsPrefixes are the stats that are kept when metrics are not scraped from a proxy.
+// They describe the state of the proxy itself and its connection to the control plane.
+var essentialStatsPrefixes = []string{
+	"server.",
+	"runtime.",
+	"overload.",
+	"control_plane.",
+	"cluster_manager.",
+	"listener_manager.",
+	"cluster.ads_cluster.",
+	"cluster.access_log_sink.",
+}
+
+// zoneAwareLoadBalancingStatsRegex matches stats of zone aware load balancing that is configured for every cluster
+// but is used only when locality aware load balancing is enabled in the mesh.
+// Cluster names in stats are sanitized and don't contain dots.
+const zoneAwareLoadBalancingStatsRegex = `^cluster\.[^.]+\.lb_(zone_|local_cluster_not_ok|recalculate_zone_structures)`
+
+// statsMatcherFor returns the matcher of stats that are instantiated by a proxy based on the features of the mesh.
+// It returns nil when all stats are instantiated. Mesh and dataplane are nil for Zone Ingress.
+//
+// Most of the memory of a proxy in a large mesh is taken by the stats of clusters, so when metrics are not scraped
+// only the essential stats are instantiated. Otherwise, only stats of the features that are disabled are pruned.
+func statsMatcherFor(
+	cfg *bootstrap_config.StatsPruningConfig,
+	mesh *mesh_proto.Mesh,
+	dataplane *mesh_proto.Dataplane,
+) *envoy_metrics_v3.StatsMatcher {
+	if cfg == nil || !cfg.Enabled || mesh == nil {
+		return nil
+	}
+	if mesh.GetMetrics().GetEnabledBackend() == "" && dataplane.GetMetrics() == nil {
+		var patterns []*envoy_type_matcher.StringMatcher
+		for _, prefix := range essentialStatsPrefixes {
+			patterns = append(patterns, &envoy_type_matcher.StringMatcher{
+				MatchPattern: &envoy_type_matcher.StringMatcher_Prefix{
+					Prefix: prefix,
+				},
+			})
+		}
+		return &envoy_metrics_v3.StatsMatcher{
+			StatsMatcher: &envoy_metrics_v3.StatsMatcher_InclusionList{
+				InclusionList: &envoy_type_matcher.ListStringMatcher{
+					Patterns: patterns,
+				},
+			},
+		}
+	}
+	if mesh.GetRouting().GetLocalityAwareLoadBalancing() {
+		return nil
+	}
+	return &envoy_metrics_v3.StatsMatcher{
+		StatsMatcher: &envoy_metrics_v3.StatsMatcher_ExclusionList{
+			ExclusionList: &envoy_type_matcher.ListStringMatcher{
+				Patterns: []*envoy_type_matcher.StringMatcher{
+					{
+						MatchPattern: &envoy_type_matcher.StringMatcher_SafeRegex{
+							SafeRegex: &envoy_type_matcher.RegexMatcher{
+								EngineType: &envoy_type_matcher.RegexMatcher_GoogleRe2{
+									GoogleRe2: &envoy_type_matcher.RegexMatcher_GoogleRE2{},
+								},
+								Regex: zoneAwareLoadBalancingStatsRegex,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func applyStatsMatcher(config *envoy_bootstrap_v3.Bootstrap, matcher *envoy_metrics_v3.StatsMatcher) {
+	if matcher == nil {
+		return
+	}
+	if config.StatsConfig == nil {
+		config.StatsConfig = &envoy_metrics_v3.StatsConfig{}
+	}
+	config.StatsConfig.StatsMatcher = matcher
+}
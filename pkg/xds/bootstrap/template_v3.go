@@ -22,6 +22,9 @@ node:
 {{ end }}
 {{if .ProxyType }}
     dataplane.proxyType: "{{ .ProxyType }}"
+{{ end }}
+{{if .SecretsDir }}
+    dataplane.secrets.dir: "{{ .SecretsDir }}"
 {{ end }}
     version:
       kumaDp:
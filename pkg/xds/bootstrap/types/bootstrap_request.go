@@ -29,6 +29,11 @@ type BootstrapRequest struct {
 	BootstrapVersion BootstrapVersion `json:"bootstrapVersion"`
 	DNSPort          uint32           `json:"dnsPort,omitempty"`
 	EmptyDNSPort     uint32           `json:"emptyDnsPort,omitempty"`
+	// MemoryLimit is the memory limit of the data plane proxy in bytes, 0 when the limit is unknown
+	MemoryLimit uint64 `json:"memoryLimit,omitempty"`
+	// SecretsDir is a directory in which kuma-dp delivers the secrets of the data plane proxy as files,
+	// empty when the secrets are delivered over the xDS stream
+	SecretsDir string `json:"secretsDir,omitempty"`
 }
 
 type Version struct {
@@ -13,6 +13,9 @@ type Context struct {
 	ControlPlane     *ControlPlaneContext
 	Mesh             MeshContext
 	EnvoyAdminClient admin.EnvoyAdminClient
+	// SecretsDir is a directory in which kuma-dp delivers the secrets of the data plane proxy as files.
+	// If empty, the secrets are delivered over ADS.
+	SecretsDir string
 }
 
 type ConnectionInfo struct {
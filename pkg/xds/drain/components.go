@@ -0,0 +1,17 @@
+package drain
+
+import (
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+)
+
+func RegisterDrain(rt core_runtime.Runtime, authenticator auth.Authenticator) {
+	handler := DrainHandler{
+		ResManager:       rt.ResourceManager(),
+		Authenticator:    authenticator,
+		UpsertCfg:        rt.Config().Store.Upsert,
+		PropagationDelay: rt.Config().XdsServer.DataplaneDrainPropagationDelay,
+	}
+	log.Info("registering Drain in Dataplane Server")
+	rt.DpServer().HTTPMux().HandleFunc("/drain", handler.Handle)
+}
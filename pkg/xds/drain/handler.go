@@ -0,0 +1,85 @@
+package drain
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	store_config "github.com/kumahq/kuma/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+	"github.com/kumahq/kuma/pkg/xds/drain/types"
+)
+
+var log = core.Log.WithName("xds").WithName("drain")
+
+// DrainHandler marks all inbounds of a Dataplane that is shutting down as not ready,
+// so its endpoints are removed from the configuration of other Dataplanes before it drains its listeners.
+type DrainHandler struct {
+	ResManager    core_manager.ResourceManager
+	Authenticator auth.Authenticator
+	UpsertCfg     store_config.UpsertConfig
+	// PropagationDelay is a time that Kuma DP waits after the inbounds are marked as not ready.
+	PropagationDelay time.Duration
+}
+
+func (h *DrainHandler) Handle(resp http.ResponseWriter, req *http.Request) {
+	bytes, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		log.Error(err, "Could not read a request")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reqParams := types.DrainRequest{}
+	if err := json.Unmarshal(bytes, &reqParams); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	logger := log.WithValues("mesh", reqParams.Mesh, "name", reqParams.Name)
+
+	if err := h.drain(req.Context(), reqParams); err != nil {
+		auth.HandleDpRequestError(resp, err, logger, "Could not drain the dataplane")
+		return
+	}
+	logger.Info("Dataplane is draining, its inbounds are marked as not ready", "propagationDelay", h.PropagationDelay)
+
+	respBytes, err := json.Marshal(types.DrainResponse{
+		PropagationDelay: h.PropagationDelay.String(),
+	})
+	if err != nil {
+		logger.Error(err, "Could not marshal the response")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("content-type", "application/json")
+	if _, err := resp.Write(respBytes); err != nil {
+		logger.Error(err, "Error while writing the response")
+	}
+}
+
+func (h *DrainHandler) drain(ctx context.Context, request types.DrainRequest) error {
+	dataplane := core_mesh.NewDataplaneResource()
+	if err := h.ResManager.Get(ctx, dataplane, core_store.GetByKey(request.Name, request.Mesh)); err != nil {
+		return err
+	}
+	if err := h.Authenticator.Authenticate(ctx, dataplane, request.DataplaneToken); err != nil {
+		return auth.NewAuthenticationError(err)
+	}
+
+	key := core_model.MetaToResourceKey(dataplane.GetMeta())
+	return core_manager.Upsert(h.ResManager, key, core_mesh.NewDataplaneResource(), func(resource core_model.Resource) error {
+		dp := resource.(*core_mesh.DataplaneResource)
+		for _, inbound := range dp.Spec.GetNetworking().GetInbound() {
+			inbound.Health = &mesh_proto.Dataplane_Networking_Inbound_Health{Ready: false}
+		}
+		return nil
+	}, core_manager.WithConflictRetry(h.UpsertCfg.ConflictRetryBaseBackoff, h.UpsertCfg.ConflictRetryMaxTimes))
+}
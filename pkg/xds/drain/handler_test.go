@@ -0,0 +1,148 @@
+package drain_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	store_config "github.com/kumahq/kuma/pkg/config/core/resources/store"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+	"github.com/kumahq/kuma/pkg/xds/drain"
+	"github.com/kumahq/kuma/pkg/xds/drain/types"
+)
+
+type staticTokenAuthenticator struct {
+	token string
+}
+
+func (s *staticTokenAuthenticator) Authenticate(_ context.Context, _ model.Resource, credential auth.Credential) error {
+	if credential != s.token {
+		return errors.New("invalid token")
+	}
+	return nil
+}
+
+var _ = Describe("DrainHandler", func() {
+
+	var resManager manager.ResourceManager
+	var handler *drain.DrainHandler
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		handler = &drain.DrainHandler{
+			ResManager:    resManager,
+			Authenticator: &staticTokenAuthenticator{token: "token"},
+			UpsertCfg: store_config.UpsertConfig{
+				ConflictRetryBaseBackoff: time.Millisecond,
+				ConflictRetryMaxTimes:    3,
+			},
+			PropagationDelay: 7 * time.Second,
+		}
+
+		err := resManager.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("default", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		dataplane := &core_mesh.DataplaneResource{
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port: 8080,
+							Tags: map[string]string{
+								mesh_proto.ServiceTag: "backend",
+							},
+						},
+						{
+							Port: 8081,
+							Tags: map[string]string{
+								mesh_proto.ServiceTag: "backend-admin",
+							},
+							Health: &mesh_proto.Dataplane_Networking_Inbound_Health{
+								Ready: true,
+							},
+						},
+					},
+				},
+			},
+		}
+		err = resManager.Create(context.Background(), dataplane, store.CreateByKey("dp-1", "default"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	request := func(drainRequest types.DrainRequest) *httptest.ResponseRecorder {
+		body, err := json.Marshal(drainRequest)
+		Expect(err).ToNot(HaveOccurred())
+		req := httptest.NewRequest(http.MethodPost, "/drain", bytes.NewReader(body))
+		resp := httptest.NewRecorder()
+		handler.Handle(resp, req)
+		return resp
+	}
+
+	It("should mark all inbounds as not ready and return the propagation delay", func() {
+		// when
+		resp := request(types.DrainRequest{
+			Mesh:           "default",
+			Name:           "dp-1",
+			DataplaneToken: "token",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		drainResp := types.DrainResponse{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), &drainResp)).To(Succeed())
+		Expect(drainResp.PropagationDelay).To(Equal("7s"))
+
+		// and
+		dataplane := core_mesh.NewDataplaneResource()
+		Expect(resManager.Get(context.Background(), dataplane, store.GetByKey("dp-1", "default"))).To(Succeed())
+		Expect(dataplane.Spec.Networking.Inbound).To(HaveLen(2))
+		for _, inbound := range dataplane.Spec.Networking.Inbound {
+			Expect(inbound.Health).ToNot(BeNil())
+			Expect(inbound.Health.Ready).To(BeFalse())
+		}
+		Expect(dataplane.Spec.GetNetworking().GetHealthyInbounds()).To(BeEmpty())
+	})
+
+	It("should reject the request with invalid token", func() {
+		// when
+		resp := request(types.DrainRequest{
+			Mesh:           "default",
+			Name:           "dp-1",
+			DataplaneToken: "other-token",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+		Expect(resp.Body.String()).To(Equal("authentication failed: invalid token"))
+
+		// and
+		dataplane := core_mesh.NewDataplaneResource()
+		Expect(resManager.Get(context.Background(), dataplane, store.GetByKey("dp-1", "default"))).To(Succeed())
+		Expect(dataplane.Spec.GetNetworking().GetHealthyInbounds()).To(HaveLen(2))
+	})
+
+	It("should return not found when the dataplane does not exist", func() {
+		// when
+		resp := request(types.DrainRequest{
+			Mesh:           "default",
+			Name:           "dp-2",
+			DataplaneToken: "token",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+	})
+})
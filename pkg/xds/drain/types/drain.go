@@ -0,0 +1,15 @@
+package types
+
+// DrainRequest is sent by Kuma DP when it is shutting down, before it drains the listeners of Envoy.
+type DrainRequest struct {
+	Mesh           string `json:"mesh"`
+	Name           string `json:"name"`
+	DataplaneToken string `json:"dataplaneToken,omitempty"`
+}
+
+// DrainResponse tells Kuma DP how long it has to wait before draining the listeners of Envoy.
+type DrainResponse struct {
+	// PropagationDelay is a time it takes for the Control Plane to remove the endpoints
+	// of the Dataplane from the configuration of other Dataplanes.
+	PropagationDelay string `json:"propagationDelay"`
+}
@@ -22,6 +22,36 @@ func CircuitBreaker(circuitBreaker *core_mesh.CircuitBreakerResource) ClusterBui
 	})
 }
 
+// ConnectionPool applies the ConnectionPool policy. It has to be
+// configured after the protocol of the cluster.
+func ConnectionPool(protocol core_mesh.Protocol, connectionPool *core_mesh.ConnectionPoolResource) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.ConnectionPoolConfigurer{
+			Protocol:       protocol,
+			ConnectionPool: connectionPool,
+		})
+	})
+}
+
+// TcpKeepalive sets the default TCP keepalive of the mesh. It has to be
+// configured before the ConnectionPool policy, which overrides it.
+func TcpKeepalive(keepalive *mesh_proto.Networking_Outbound_TcpKeepalive) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.TcpKeepaliveConfigurer{TcpKeepalive: keepalive})
+	})
+}
+
+// DnsLookupFamily sets the IP family of STRICT_DNS clusters. It has to
+// be configured after StrictDNSCluster.
+func DnsLookupFamily(defaultFamily mesh_proto.Networking_Outbound_DnsLookupFamily, connectionPool *core_mesh.ConnectionPoolResource) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.DnsLookupFamilyConfigurer{
+			Default:        defaultFamily,
+			ConnectionPool: connectionPool,
+		})
+	})
+}
+
 func ClientSideMTLS(ctx xds_context.Context, upstreamService string, upstreamTLSReady bool, tags []envoy.Tags) ClusterBuilderOpt {
 	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
 		config.AddV3(&v3.ClientSideMTLSConfigurer{
@@ -0,0 +1,81 @@
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_upstream_http "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// ConnectionPoolConfigurer applies the ConnectionPool policy to the
+// cluster. It has to run after the protocol of the cluster is configured,
+// so that HTTP/2 keepalives are only set on HTTP/2 clusters.
+type ConnectionPoolConfigurer struct {
+	Protocol       core_mesh.Protocol
+	ConnectionPool *core_mesh.ConnectionPoolResource
+}
+
+var _ ClusterConfigurer = &ConnectionPoolConfigurer{}
+
+func (c *ConnectionPoolConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if c.ConnectionPool == nil {
+		return nil
+	}
+	conf := c.ConnectionPool.Spec.GetConf()
+
+	if keepalive := conf.GetTcpKeepalive(); keepalive != nil {
+		setTcpKeepalive(cluster, keepalive.GetProbes(), keepalive.GetTime(), keepalive.GetInterval())
+	}
+
+	switch c.Protocol {
+	case core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2, core_mesh.ProtocolGRPC:
+	default:
+		return nil
+	}
+
+	if conf.GetMaxRequestsPerConnection() != nil {
+		cluster.MaxRequestsPerConnection = util_proto.UInt32(conf.GetMaxRequestsPerConnection().GetValue())
+	}
+
+	if conf.GetIdleTimeout() == nil && conf.GetHttp2() == nil {
+		return nil
+	}
+
+	return UpdateCommonHttpProtocolOptions(cluster, func(options *envoy_upstream_http.HttpProtocolOptions) {
+		if idleTimeout := conf.GetIdleTimeout(); idleTimeout != nil {
+			if options.CommonHttpProtocolOptions == nil {
+				options.CommonHttpProtocolOptions = &envoy_core.HttpProtocolOptions{}
+			}
+			options.CommonHttpProtocolOptions.IdleTimeout = util_proto.Duration(idleTimeout.AsDuration())
+		}
+
+		if http2 := conf.GetHttp2(); http2 != nil {
+			explicit := options.GetExplicitHttpConfig().GetHttp2ProtocolOptions()
+			if explicit == nil {
+				return
+			}
+			explicit.ConnectionKeepalive = &envoy_core.KeepaliveSettings{
+				Interval: util_proto.Duration(http2.GetKeepaliveInterval().AsDuration()),
+				Timeout:  util_proto.Duration(http2.GetKeepaliveTimeout().AsDuration()),
+			}
+		}
+	})
+}
+
+// keepaliveSeconds converts the duration to the whole number of seconds that
+// Envoy expects for TCP keepalive settings. Durations under a second
+// are rounded up, since 0 would disable the setting.
+func keepaliveSeconds(d *durationpb.Duration) *wrapperspb.UInt32Value {
+	if d == nil {
+		return nil
+	}
+	s := d.GetSeconds()
+	if d.GetNanos() > 0 {
+		s++
+	}
+	return util_proto.UInt32(uint32(s))
+}
@@ -0,0 +1,126 @@
+package clusters_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+)
+
+var _ = Describe("ConnectionPoolConfigurer", func() {
+
+	type testCase struct {
+		protocol       core_mesh.Protocol
+		connectionPool *core_mesh.ConnectionPoolResource
+		expected       string
+	}
+
+	connectionPool := &core_mesh.ConnectionPoolResource{
+		Spec: &mesh_proto.ConnectionPool{
+			Conf: &mesh_proto.ConnectionPool_Conf{
+				MaxRequestsPerConnection: util_proto.UInt32(100),
+				IdleTimeout:              util_proto.Duration(30 * time.Second),
+				Http2: &mesh_proto.ConnectionPool_Conf_Http2{
+					KeepaliveInterval: util_proto.Duration(10 * time.Second),
+					KeepaliveTimeout:  util_proto.Duration(5 * time.Second),
+				},
+				TcpKeepalive: &mesh_proto.ConnectionPool_Conf_TcpKeepalive{
+					Probes:   util_proto.UInt32(3),
+					Time:     util_proto.Duration(60 * time.Second),
+					Interval: util_proto.Duration(500 * time.Millisecond),
+				},
+			},
+		},
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+				Configure(clusters.EdsCluster("backend")).
+				Configure(clusters.Http2()).
+				Configure(clusters.ConnectionPool(given.protocol, given.connectionPool)).
+				Build()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			actual, err := util_proto.ToYAML(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without ConnectionPool", testCase{
+			protocol: core_mesh.ProtocolHTTP2,
+			expected: `
+        altStatName: backend
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS
+        typedExtensionProtocolOptions:
+          envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
+            '@type': type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions
+            explicitHttpConfig:
+              http2ProtocolOptions: {}`,
+		}),
+		Entry("HTTP/2 service", testCase{
+			protocol:       core_mesh.ProtocolHTTP2,
+			connectionPool: connectionPool,
+			expected: `
+        altStatName: backend
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        maxRequestsPerConnection: 100
+        name: backend
+        type: EDS
+        typedExtensionProtocolOptions:
+          envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
+            '@type': type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions
+            commonHttpProtocolOptions:
+              idleTimeout: 30s
+            explicitHttpConfig:
+              http2ProtocolOptions:
+                connectionKeepalive:
+                  interval: 10s
+                  timeout: 5s
+        upstreamConnectionOptions:
+          tcpKeepalive:
+            keepaliveInterval: 1
+            keepaliveProbes: 3
+            keepaliveTime: 60`,
+		}),
+		Entry("TCP service", testCase{
+			protocol:       core_mesh.ProtocolTCP,
+			connectionPool: connectionPool,
+			expected: `
+        altStatName: backend
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS
+        typedExtensionProtocolOptions:
+          envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
+            '@type': type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions
+            explicitHttpConfig:
+              http2ProtocolOptions: {}
+        upstreamConnectionOptions:
+          tcpKeepalive:
+            keepaliveInterval: 1
+            keepaliveProbes: 3
+            keepaliveTime: 60`,
+		}),
+	)
+})
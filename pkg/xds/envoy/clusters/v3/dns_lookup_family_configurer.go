@@ -0,0 +1,44 @@
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+)
+
+// DnsLookupFamilyConfigurer sets the IP family used to resolve the
+// addresses of STRICT_DNS clusters. The family of the ConnectionPool
+// policy takes precedence over the default of the mesh. When neither
+// is set, the family chosen by the StrictDNSClusterConfigurer is kept,
+// so it has to run after it.
+type DnsLookupFamilyConfigurer struct {
+	Default        mesh_proto.Networking_Outbound_DnsLookupFamily
+	ConnectionPool *core_mesh.ConnectionPoolResource
+}
+
+var _ ClusterConfigurer = &DnsLookupFamilyConfigurer{}
+
+func (c *DnsLookupFamilyConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if cluster.GetType() != envoy_cluster.Cluster_STRICT_DNS {
+		return nil
+	}
+
+	family := c.Default
+	if c.ConnectionPool != nil {
+		if f := c.ConnectionPool.Spec.GetConf().GetDnsLookupFamily(); f != mesh_proto.Networking_Outbound_UNSPECIFIED {
+			family = f
+		}
+	}
+
+	switch family {
+	case mesh_proto.Networking_Outbound_AUTO:
+		cluster.DnsLookupFamily = envoy_cluster.Cluster_AUTO
+	case mesh_proto.Networking_Outbound_V4_ONLY:
+		cluster.DnsLookupFamily = envoy_cluster.Cluster_V4_ONLY
+	case mesh_proto.Networking_Outbound_V6_ONLY:
+		cluster.DnsLookupFamily = envoy_cluster.Cluster_V6_ONLY
+	}
+
+	return nil
+}
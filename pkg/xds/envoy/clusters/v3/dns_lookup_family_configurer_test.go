@@ -0,0 +1,126 @@
+package clusters_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+)
+
+var _ = Describe("DnsLookupFamilyConfigurer", func() {
+
+	type testCase struct {
+		hasIPv6        bool
+		defaultFamily  mesh_proto.Networking_Outbound_DnsLookupFamily
+		connectionPool *core_mesh.ConnectionPoolResource
+		expected       string
+	}
+
+	DescribeTable("should set the DNS lookup family of STRICT_DNS clusters",
+		func(given testCase) {
+			// when
+			cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+				Configure(clusters.StrictDNSCluster("external", []xds.Endpoint{{
+					Target: "example.com",
+					Port:   443,
+					Weight: 1,
+				}}, given.hasIPv6)).
+				Configure(clusters.DnsLookupFamily(given.defaultFamily, given.connectionPool)).
+				Build()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			actual, err := util_proto.ToYAML(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without a family", testCase{
+			expected: `
+        altStatName: external
+        dnsLookupFamily: V4_ONLY
+        loadAssignment:
+          clusterName: external
+          endpoints:
+          - lbEndpoints:
+            - endpoint:
+                address:
+                  socketAddress:
+                    address: example.com
+                    portValue: 443
+              loadBalancingWeight: 1
+        name: external
+        type: STRICT_DNS`,
+		}),
+		Entry("mesh default", testCase{
+			defaultFamily: mesh_proto.Networking_Outbound_V6_ONLY,
+			expected: `
+        altStatName: external
+        dnsLookupFamily: V6_ONLY
+        loadAssignment:
+          clusterName: external
+          endpoints:
+          - lbEndpoints:
+            - endpoint:
+                address:
+                  socketAddress:
+                    address: example.com
+                    portValue: 443
+              loadBalancingWeight: 1
+        name: external
+        type: STRICT_DNS`,
+		}),
+		Entry("ConnectionPool overrides the mesh default", testCase{
+			defaultFamily: mesh_proto.Networking_Outbound_V6_ONLY,
+			connectionPool: &core_mesh.ConnectionPoolResource{
+				Spec: &mesh_proto.ConnectionPool{
+					Conf: &mesh_proto.ConnectionPool_Conf{
+						DnsLookupFamily: mesh_proto.Networking_Outbound_AUTO,
+					},
+				},
+			},
+			expected: `
+        altStatName: external
+        loadAssignment:
+          clusterName: external
+          endpoints:
+          - lbEndpoints:
+            - endpoint:
+                address:
+                  socketAddress:
+                    address: example.com
+                    portValue: 443
+              loadBalancingWeight: 1
+        name: external
+        type: STRICT_DNS`,
+		}),
+	)
+
+	It("should not change EDS clusters", func() {
+		// when
+		cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+			Configure(clusters.EdsCluster("backend")).
+			Configure(clusters.DnsLookupFamily(mesh_proto.Networking_Outbound_V6_ONLY, nil)).
+			Build()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		actual, err := util_proto.ToYAML(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(`
+        altStatName: backend
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS`))
+	})
+})
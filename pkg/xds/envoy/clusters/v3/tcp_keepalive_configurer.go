@@ -0,0 +1,39 @@
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// TcpKeepaliveConfigurer sets the TCP keepalive of the upstream
+// connections to the default of the mesh. The ConnectionPool policy of
+// the destination takes precedence, so it has to run before the
+// ConnectionPoolConfigurer.
+type TcpKeepaliveConfigurer struct {
+	TcpKeepalive *mesh_proto.Networking_Outbound_TcpKeepalive
+}
+
+var _ ClusterConfigurer = &TcpKeepaliveConfigurer{}
+
+func (c *TcpKeepaliveConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if c.TcpKeepalive == nil {
+		return nil
+	}
+	setTcpKeepalive(cluster, c.TcpKeepalive.GetProbes(), c.TcpKeepalive.GetTime(), c.TcpKeepalive.GetInterval())
+	return nil
+}
+
+func setTcpKeepalive(cluster *envoy_cluster.Cluster, probes *wrapperspb.UInt32Value, time *durationpb.Duration, interval *durationpb.Duration) {
+	if cluster.UpstreamConnectionOptions == nil {
+		cluster.UpstreamConnectionOptions = &envoy_cluster.UpstreamConnectionOptions{}
+	}
+	cluster.UpstreamConnectionOptions.TcpKeepalive = &envoy_core.TcpKeepalive{
+		KeepaliveProbes:   probes,
+		KeepaliveTime:     keepaliveSeconds(time),
+		KeepaliveInterval: keepaliveSeconds(interval),
+	}
+}
@@ -0,0 +1,88 @@
+package clusters_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+)
+
+var _ = Describe("TcpKeepaliveConfigurer", func() {
+
+	keepalive := &mesh_proto.Networking_Outbound_TcpKeepalive{
+		Probes:   util_proto.UInt32(5),
+		Time:     util_proto.Duration(2 * time.Minute),
+		Interval: util_proto.Duration(30 * time.Second),
+	}
+
+	It("should set the default TCP keepalive of the mesh", func() {
+		// when
+		cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+			Configure(clusters.EdsCluster("backend")).
+			Configure(clusters.TcpKeepalive(keepalive)).
+			Configure(clusters.ConnectionPool(core_mesh.ProtocolTCP, nil)).
+			Build()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		actual, err := util_proto.ToYAML(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(`
+        altStatName: backend
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS
+        upstreamConnectionOptions:
+          tcpKeepalive:
+            keepaliveInterval: 30
+            keepaliveProbes: 5
+            keepaliveTime: 120`))
+	})
+
+	It("should be overridden by the ConnectionPool policy", func() {
+		// given
+		connectionPool := &core_mesh.ConnectionPoolResource{
+			Spec: &mesh_proto.ConnectionPool{
+				Conf: &mesh_proto.ConnectionPool_Conf{
+					TcpKeepalive: &mesh_proto.ConnectionPool_Conf_TcpKeepalive{
+						Time: util_proto.Duration(10 * time.Second),
+					},
+				},
+			},
+		}
+
+		// when
+		cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+			Configure(clusters.EdsCluster("backend")).
+			Configure(clusters.TcpKeepalive(keepalive)).
+			Configure(clusters.ConnectionPool(core_mesh.ProtocolTCP, connectionPool)).
+			Build()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		actual, err := util_proto.ToYAML(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(`
+        altStatName: backend
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS
+        upstreamConnectionOptions:
+          tcpKeepalive:
+            keepaliveTime: 10`))
+	})
+})
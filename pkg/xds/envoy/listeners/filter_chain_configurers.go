@@ -31,6 +31,25 @@ func Tracing(backend *mesh_proto.TracingBackend, service string) FilterChainBuil
 	})
 }
 
+// TracingSampling overrides the sampling of the tracing backend with the
+// sampling of the TrafficTrace. Service is the destination service of the
+// traffic or empty if the traffic is routed to many services.
+func TracingSampling(backend *mesh_proto.TracingBackend, sampling *mesh_proto.TrafficTrace_Conf_Sampling, service string) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.TracingSamplingConfigurer{
+		Backend:  backend,
+		Sampling: sampling,
+		Service:  service,
+	})
+}
+
+// HeaderPropagation adds the headers propagated in the mesh as tags to the
+// spans of traced requests.
+func HeaderPropagation(propagation *mesh_proto.HeaderPropagation) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.HeaderPropagationConfigurer{
+		Propagation: propagation,
+	})
+}
+
 func StaticEndpoints(virtualHostName string, paths []*envoy_common.StaticEndpointPath) FilterChainBuilderOpt {
 	return AddFilterChainConfigurer(&v3.StaticEndpointsConfigurer{
 		VirtualHostName: virtualHostName,
@@ -52,6 +71,39 @@ func ServerSideMTLS(ctx xds_context.Context) FilterChainBuilderOpt {
 	})
 }
 
+func ServerSideCrossMeshMTLS(ctx xds_context.Context, meshes []string) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.ServerSideCrossMeshMTLSConfigurer{
+		Ctx:    ctx,
+		Meshes: meshes,
+	})
+}
+
+func ServerSideStaticTLS(keyPair *tls.KeyPair) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.ServerSideStaticTLSConfigurer{
+		KeyPair: keyPair,
+	})
+}
+
+func ServerSideStaticMTLS(keyPair *tls.KeyPair, clientCertificateAuthority []byte) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.ServerSideStaticTLSConfigurer{
+		KeyPair:                    keyPair,
+		ClientCertificateAuthority: clientCertificateAuthority,
+	})
+}
+
+func ServerSideSdsTLS(secretName string) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.ServerSideSdsTLSConfigurer{
+		SecretName: secretName,
+	})
+}
+
+func ServerSideSdsMTLS(secretName string, clientCertificateAuthority []byte) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.ServerSideSdsTLSConfigurer{
+		SecretName:                 secretName,
+		ClientCertificateAuthority: clientCertificateAuthority,
+	})
+}
+
 func HttpConnectionManager(statsName string, forwardClientCertDetails bool) FilterChainBuilderOpt {
 	return AddFilterChainConfigurer(&v3.HttpConnectionManagerConfigurer{
 		StatsName:                statsName,
@@ -92,6 +144,33 @@ func FaultInjection(faultInjections ...*mesh_proto.FaultInjection) FilterChainBu
 	})
 }
 
+// HttpTap adds a tap filter that records requests when the recording is
+// enabled in the mesh.
+func HttpTap(recording *mesh_proto.Recording) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.HttpTapConfigurer{
+		Recording: recording,
+	})
+}
+
+// RequestId configures generation and propagation of request IDs. Edge
+// should be true for listeners that accept requests from outside of the mesh.
+func RequestId(requestId *mesh_proto.RequestId, edge bool) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.RequestIdConfigurer{
+		RequestId: requestId,
+		Edge:      edge,
+	})
+}
+
+// ForwardedHeaders configures the handling of the x-forwarded-for and
+// x-forwarded-proto headers by sidecars. Outbound should be true for
+// listeners that accept requests from the application.
+func ForwardedHeaders(forwardedHeaders *mesh_proto.ForwardedHeaders, outbound bool) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.ForwardedHeadersConfigurer{
+		ForwardedHeaders: forwardedHeaders,
+		Outbound:         outbound,
+	})
+}
+
 func RateLimit(rateLimits []*mesh_proto.RateLimit) FilterChainBuilderOpt {
 	return AddFilterChainConfigurer(&v3.RateLimitConfigurer{
 		RateLimits: rateLimits,
@@ -103,6 +182,7 @@ func NetworkAccessLog(
 	trafficDirection envoy_common.TrafficDirection,
 	sourceService string,
 	destinationService string,
+	routeName string,
 	backend *mesh_proto.LoggingBackend,
 	proxy *core_xds.Proxy,
 ) FilterChainBuilderOpt {
@@ -116,6 +196,7 @@ func NetworkAccessLog(
 			TrafficDirection:   trafficDirection,
 			SourceService:      sourceService,
 			DestinationService: destinationService,
+			RouteName:          routeName,
 			Backend:            backend,
 			Proxy:              proxy,
 		},
@@ -126,6 +207,7 @@ func HttpAccessLog(
 	mesh string,
 	trafficDirection envoy_common.TrafficDirection,
 	sourceService string, destinationService string,
+	routeName string,
 	backend *mesh_proto.LoggingBackend,
 	proxy *core_xds.Proxy,
 ) FilterChainBuilderOpt {
@@ -139,6 +221,7 @@ func HttpAccessLog(
 			TrafficDirection:   trafficDirection,
 			SourceService:      sourceService,
 			DestinationService: destinationService,
+			RouteName:          routeName,
 			Backend:            backend,
 			Proxy:              proxy,
 		},
@@ -1,6 +1,8 @@
 package listeners
 
 import (
+	"net"
+
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 
@@ -69,3 +71,69 @@ func MatchSourceAddress(address string) FilterChainBuilderOpt {
 		}),
 	)
 }
+
+// MatchSourcePrefixRanges appends filter chain matches for the given source
+// CIDR blocks. Invalid blocks are skipped.
+func MatchSourcePrefixRanges(cidrs ...string) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(
+		v3.FilterChainMustConfigureFunc(func(chain *envoy_listener.FilterChain) {
+			if chain.FilterChainMatch == nil {
+				chain.FilterChainMatch = &envoy_listener.FilterChainMatch{}
+			}
+
+			for _, cidr := range cidrs {
+				_, ipNet, err := net.ParseCIDR(cidr)
+				if err != nil {
+					continue
+				}
+
+				ones, _ := ipNet.Mask.Size()
+				chain.FilterChainMatch.SourcePrefixRanges = append(
+					chain.FilterChainMatch.SourcePrefixRanges,
+					&envoy_core.CidrRange{
+						AddressPrefix: ipNet.IP.String(),
+						PrefixLen:     util_proto.UInt32(uint32(ones)),
+					},
+				)
+			}
+		}),
+	)
+}
+
+// MatchDestinationPort sets the destination port match for the filter chain.
+func MatchDestinationPort(port uint32) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(
+		v3.FilterChainMustConfigureFunc(func(chain *envoy_listener.FilterChain) {
+			if chain.FilterChainMatch == nil {
+				chain.FilterChainMatch = &envoy_listener.FilterChainMatch{}
+			}
+
+			chain.FilterChainMatch.DestinationPort = util_proto.UInt32(port)
+		}),
+	)
+}
+
+// MatchDestinationAddresses appends exact filter chain matches for the given destination IP addresses.
+func MatchDestinationAddresses(addresses ...string) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(
+		v3.FilterChainMustConfigureFunc(func(chain *envoy_listener.FilterChain) {
+			if chain.FilterChainMatch == nil {
+				chain.FilterChainMatch = &envoy_listener.FilterChainMatch{}
+			}
+
+			for _, address := range addresses {
+				prefixLen := uint32(32)
+				if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+					prefixLen = 128
+				}
+				chain.FilterChainMatch.PrefixRanges = append(
+					chain.FilterChainMatch.PrefixRanges,
+					&envoy_core.CidrRange{
+						AddressPrefix: address,
+						PrefixLen:     util_proto.UInt32(prefixLen),
+					},
+				)
+			}
+		}),
+	)
+}
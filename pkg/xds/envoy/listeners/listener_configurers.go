@@ -61,6 +61,20 @@ func FilterChain(builder *FilterChainBuilder) ListenerBuilderOpt {
 	)
 }
 
+// DefaultFilterChain sets the filter chain that is used when none of the filter chains of the listener matches a connection.
+func DefaultFilterChain(builder *FilterChainBuilder) ListenerBuilderOpt {
+	return AddListenerConfigurer(
+		v3.ListenerConfigureFunc(func(listener *envoy_listener.Listener) error {
+			filterChain, err := builder.Build()
+			if err != nil {
+				return err
+			}
+			listener.DefaultFilterChain = filterChain.(*envoy_listener.FilterChain)
+			return nil
+		}),
+	)
+}
+
 func DNS(vips map[string][]string, emptyDnsPort uint32) ListenerBuilderOpt {
 	return AddListenerConfigurer(&v3.DNSConfigurer{
 		VIPs:         vips,
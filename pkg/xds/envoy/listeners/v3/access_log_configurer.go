@@ -8,6 +8,7 @@ import (
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	access_loggers_file "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
 	access_loggers_grpc "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	proto_v1 "github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/structpb"
 
@@ -16,6 +17,7 @@ import (
 	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
 	"github.com/kumahq/kuma/pkg/util/proto"
 	"github.com/kumahq/kuma/pkg/xds/envoy"
+	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
 )
 
 const accessLogSink = "access_log_sink"
@@ -25,11 +27,22 @@ type AccessLogConfigurer struct {
 	TrafficDirection   envoy.TrafficDirection
 	SourceService      string
 	DestinationService string
-	Backend            *mesh_proto.LoggingBackend
-	Proxy              *core_xds.Proxy
+	// RouteName is the name of the TrafficRoute policy that selects
+	// the destination for the logged traffic, if there is one.
+	RouteName string
+	Backend   *mesh_proto.LoggingBackend
+	Proxy     *core_xds.Proxy
 }
 
-func convertLoggingBackend(mesh string, trafficDirection envoy.TrafficDirection, sourceService string, destinationService string, backend *mesh_proto.LoggingBackend, proxy *core_xds.Proxy, defaultFormat string) (*envoy_accesslog.AccessLog, error) {
+// accessLogTraffic is the kind of traffic an access log is configured for.
+type accessLogTraffic int
+
+const (
+	httpTraffic accessLogTraffic = iota
+	tcpTraffic
+)
+
+func convertLoggingBackend(mesh string, trafficDirection envoy.TrafficDirection, sourceService string, destinationService string, routeName string, backend *mesh_proto.LoggingBackend, proxy *core_xds.Proxy, traffic accessLogTraffic, defaultFormat string) (*envoy_accesslog.AccessLog, error) {
 	if backend == nil {
 		return nil, nil
 	}
@@ -37,6 +50,9 @@ func convertLoggingBackend(mesh string, trafficDirection envoy.TrafficDirection,
 	if backend.Format != "" {
 		formatString = backend.Format
 	}
+	if traffic == tcpTraffic && backend.TcpFormat != "" {
+		formatString = backend.TcpFormat
+	}
 	format, err := accesslog.ParseFormat(formatString + "\n")
 
 	if err != nil {
@@ -50,6 +66,7 @@ func convertLoggingBackend(mesh string, trafficDirection envoy.TrafficDirection,
 		accesslog.CMD_KUMA_DESTINATION_SERVICE:         destinationService,
 		accesslog.CMD_KUMA_MESH:                        mesh,
 		accesslog.CMD_KUMA_TRAFFIC_DIRECTION:           string(trafficDirection),
+		accesslog.CMD_KUMA_ROUTE_NAME:                  routeName,
 	}
 
 	format, err = format.Interpolate(variables)
@@ -61,40 +78,96 @@ func convertLoggingBackend(mesh string, trafficDirection envoy.TrafficDirection,
 	case mesh_proto.LoggingFileType:
 		return fileAccessLog(format, backend.Conf)
 	case mesh_proto.LoggingTcpType:
-		return tcpAccessLog(format, backend.Conf)
+		return tcpAccessLog(format, traffic, backend.Conf)
+	case mesh_proto.LoggingSplunkType:
+		return sinkAccessLog(format, traffic, backend.Type, backend.Conf, &mesh_proto.SplunkLoggingBackendConfig{})
+	case mesh_proto.LoggingGrpcType:
+		return collectorAccessLog(format, traffic, backend.Name, backend.Conf)
 	default: // should be caught by validator
 		return nil, errors.Errorf("could not convert LoggingBackend of type %T to AccessLog", backend.GetType())
 	}
 }
 
-func tcpAccessLog(format *accesslog.AccessLogFormat, cfgStr *structpb.Struct) (*envoy_accesslog.AccessLog, error) {
+func tcpAccessLog(format *accesslog.AccessLogFormat, traffic accessLogTraffic, cfgStr *structpb.Struct) (*envoy_accesslog.AccessLog, error) {
 	cfg := mesh_proto.TcpLoggingBackendConfig{}
 	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
 		return nil, errors.Wrap(err, "could not parse backend config")
 	}
+	return grpcAccessLog(format, traffic, fmt.Sprintf("%s;%s", cfg.Address, format.String()), accessLogSink)
+}
 
-	httpGrpcAccessLog := &access_loggers_grpc.HttpGrpcAccessLogConfig{
-		CommonConfig: &access_loggers_grpc.CommonGrpcAccessLogConfig{
-			LogName:             fmt.Sprintf("%s;%s", cfg.Address, format.String()),
-			TransportApiVersion: envoy_core.ApiVersion_V3,
-			GrpcService: &envoy_core.GrpcService{
-				TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
-					EnvoyGrpc: &envoy_core.GrpcService_EnvoyGrpc{
-						ClusterName: accessLogSink,
-					},
+// sinkAccessLog streams access logs to kuma-dp, which forwards them to a logging backend
+// that cannot be reached over plain TCP, e.g. Splunk HEC.
+func sinkAccessLog(format *accesslog.AccessLogFormat, traffic accessLogTraffic, backendType string, cfgStr *structpb.Struct, cfg proto_v1.Message) (*envoy_accesslog.AccessLog, error) {
+	if err := proto.ToTyped(cfgStr, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not parse backend config")
+	}
+	sink, err := accesslog.EncodeSink(backendType, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return grpcAccessLog(format, traffic, fmt.Sprintf("%s;%s", sink, format.String()), accessLogSink)
+}
+
+// collectorAccessLog streams access logs directly to a gRPC Access Log Service collector,
+// bypassing kuma-dp. The collector receives structured log entries, so the format string
+// only selects the additional headers that are logged.
+func collectorAccessLog(format *accesslog.AccessLogFormat, traffic accessLogTraffic, backendName string, cfgStr *structpb.Struct) (*envoy_accesslog.AccessLog, error) {
+	cfg := mesh_proto.GrpcLoggingBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		return nil, errors.Wrap(err, "could not parse backend config")
+	}
+	logName := cfg.LogName
+	if logName == "" {
+		logName = backendName
+	}
+	return grpcAccessLog(format, traffic, logName, envoy_names.GetAccessLogClusterName(backendName))
+}
+
+// grpcAccessLog configures Envoy to stream access logs to a gRPC Access Log Service
+// reachable through the given cluster. For kuma-dp, the log name tells it where to
+// forward the access logs.
+//
+// TCP connections are logged with `envoy.access_loggers.tcp_grpc`, which, unlike
+// `envoy.access_loggers.http_grpc`, reports connection properties such as bytes sent and received.
+func grpcAccessLog(format *accesslog.AccessLogFormat, traffic accessLogTraffic, logName string, clusterName string) (*envoy_accesslog.AccessLog, error) {
+	commonConfig := &access_loggers_grpc.CommonGrpcAccessLogConfig{
+		LogName:             logName,
+		TransportApiVersion: envoy_core.ApiVersion_V3,
+		GrpcService: &envoy_core.GrpcService{
+			TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoy_core.GrpcService_EnvoyGrpc{
+					ClusterName: clusterName,
 				},
 			},
 		},
 	}
-	if err := format.ConfigureHttpLog(httpGrpcAccessLog); err != nil {
-		return nil, errors.Wrapf(err, "failed to configure %T according to the format string: %s", httpGrpcAccessLog, format)
+	var name string
+	var config proto_v1.Message
+	switch traffic {
+	case tcpTraffic:
+		tcpGrpcAccessLog := &access_loggers_grpc.TcpGrpcAccessLogConfig{
+			CommonConfig: commonConfig,
+		}
+		if err := format.ConfigureTcpLog(tcpGrpcAccessLog); err != nil {
+			return nil, errors.Wrapf(err, "failed to configure %T according to the format string: %s", tcpGrpcAccessLog, format)
+		}
+		name, config = "envoy.access_loggers.tcp_grpc", tcpGrpcAccessLog
+	default:
+		httpGrpcAccessLog := &access_loggers_grpc.HttpGrpcAccessLogConfig{
+			CommonConfig: commonConfig,
+		}
+		if err := format.ConfigureHttpLog(httpGrpcAccessLog); err != nil {
+			return nil, errors.Wrapf(err, "failed to configure %T according to the format string: %s", httpGrpcAccessLog, format)
+		}
+		name, config = "envoy.access_loggers.http_grpc", httpGrpcAccessLog
 	}
-	marshaled, err := proto.MarshalAnyDeterministic(httpGrpcAccessLog)
+	marshaled, err := proto.MarshalAnyDeterministic(config)
 	if err != nil {
-		return nil, errors.Wrapf(err, "could not marshall %T", httpGrpcAccessLog)
+		return nil, errors.Wrapf(err, "could not marshall %T", config)
 	}
 	return &envoy_accesslog.AccessLog{
-		Name: "envoy.access_loggers.http_grpc",
+		Name: name,
 		ConfigType: &envoy_accesslog.AccessLog_TypedConfig{
 			TypedConfig: marshaled,
 		},
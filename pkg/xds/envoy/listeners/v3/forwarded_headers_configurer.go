@@ -0,0 +1,47 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var forwardedHeaders = []string{"x-forwarded-for", "x-forwarded-proto"}
+
+// ForwardedHeadersConfigurer configures how the HttpConnectionManager of a
+// sidecar handles the x-forwarded-for and x-forwarded-proto headers. It has
+// to be applied after the routes of the HttpConnectionManager, so the headers
+// are removed by inline routes.
+type ForwardedHeadersConfigurer struct {
+	ForwardedHeaders *mesh_proto.ForwardedHeaders
+	// Outbound is true for listeners that accept requests from the application.
+	Outbound bool
+}
+
+var _ FilterChainConfigurer = &ForwardedHeadersConfigurer{}
+
+func (c *ForwardedHeadersConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.ForwardedHeaders == nil {
+		return nil
+	}
+	return UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+		if c.Outbound {
+			if !c.ForwardedHeaders.GetSanitizeOnSidecars() {
+				return nil
+			}
+			if routeConfig := hcm.GetRouteConfig(); routeConfig != nil {
+				routeConfig.RequestHeadersToRemove = append(routeConfig.RequestHeadersToRemove, forwardedHeaders...)
+			}
+			return nil
+		}
+		// Requests from applications come from the loopback address, so
+		// appending only makes sense for requests from other sidecars.
+		if c.ForwardedHeaders.GetAppendOnSidecars() {
+			hcm.UseRemoteAddress = util_proto.Bool(true)
+			hcm.SkipXffAppend = false
+		}
+		return nil
+	})
+}
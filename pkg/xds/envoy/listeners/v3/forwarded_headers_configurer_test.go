@@ -0,0 +1,154 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("ForwardedHeadersConfigurer", func() {
+	type testCase struct {
+		forwardedHeaders *mesh_proto.ForwardedHeaders
+		outbound         bool
+		expected         string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("stats", false)).
+				Configure(HttpInboundRoutes("backend", envoy_common.Routes{
+					envoy_common.NewRouteFromCluster(envoy_common.NewCluster(
+						envoy_common.WithService("localhost:8080"),
+					)),
+				})).
+				Configure(ForwardedHeaders(given.forwardedHeaders, given.outbound)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("forwarded headers not configured", testCase{
+			forwardedHeaders: nil,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                routeConfig:
+                  name: inbound:backend
+                  validateClusters: false
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  virtualHosts:
+                  - domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                statPrefix: stats`,
+		}),
+		Entry("append on inbound listeners", testCase{
+			forwardedHeaders: &mesh_proto.ForwardedHeaders{
+				AppendOnSidecars:   true,
+				SanitizeOnSidecars: true,
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                routeConfig:
+                  name: inbound:backend
+                  validateClusters: false
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  virtualHosts:
+                  - domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                statPrefix: stats
+                useRemoteAddress: true`,
+		}),
+		Entry("sanitize on outbound listeners", testCase{
+			forwardedHeaders: &mesh_proto.ForwardedHeaders{
+				AppendOnSidecars:   true,
+				SanitizeOnSidecars: true,
+			},
+			outbound: true,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                routeConfig:
+                  name: inbound:backend
+                  validateClusters: false
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  - x-forwarded-for
+                  - x-forwarded-proto
+                  virtualHosts:
+                  - domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                statPrefix: stats`,
+		}),
+		Entry("keep headers on outbound listeners", testCase{
+			forwardedHeaders: &mesh_proto.ForwardedHeaders{
+				AppendOnSidecars: true,
+			},
+			outbound: true,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                routeConfig:
+                  name: inbound:backend
+                  validateClusters: false
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  virtualHosts:
+                  - domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                statPrefix: stats`,
+		}),
+	)
+})
@@ -0,0 +1,40 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tracing "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// HeaderPropagationConfigurer adds the propagated headers as tags to the
+// spans of traced requests. It has to be applied after the TracingConfigurer.
+type HeaderPropagationConfigurer struct {
+	Propagation *mesh_proto.HeaderPropagation
+}
+
+var _ FilterChainConfigurer = &HeaderPropagationConfigurer{}
+
+func (c *HeaderPropagationConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if len(c.Propagation.GetHeaders()) == 0 {
+		return nil
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+		if hcm.Tracing == nil {
+			return nil
+		}
+		for _, header := range c.Propagation.GetHeaders() {
+			hcm.Tracing.CustomTags = append(hcm.Tracing.CustomTags, &envoy_tracing.CustomTag{
+				Tag: header.GetName(),
+				Type: &envoy_tracing.CustomTag_RequestHeader{
+					RequestHeader: &envoy_tracing.CustomTag_Header{
+						Name: header.GetName(),
+					},
+				},
+			})
+		}
+		return nil
+	})
+}
@@ -0,0 +1,90 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("HeaderPropagationConfigurer", func() {
+
+	propagation := &mesh_proto.HeaderPropagation{
+		Headers: []*mesh_proto.HeaderPropagation_Header{
+			{Name: "baggage"},
+			{Name: "x-tenant-id", StripAtGateways: true},
+		},
+	}
+
+	type testCase struct {
+		backend  *mesh_proto.TracingBackend
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy.APIV3).
+				Configure(HttpConnectionManager("localhost:8080", false)).
+				Configure(Tracing(given.backend, "web")).
+				Configure(HeaderPropagation(propagation)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("tracing enabled", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "zipkin",
+				Type: mesh_proto.TracingZipkinType,
+				Conf: util_proto.MustToStruct(&mesh_proto.ZipkinTracingBackendConfig{
+					Url: "http://zipkin.us:9090/v2/spans",
+				}),
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                statPrefix: localhost_8080
+                tracing:
+                  customTags:
+                  - requestHeader:
+                      name: baggage
+                    tag: baggage
+                  - requestHeader:
+                      name: x-tenant-id
+                    tag: x-tenant-id
+                  provider:
+                    name: envoy.zipkin
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v3.ZipkinConfig
+                      collectorCluster: tracing:zipkin
+                      collectorEndpoint: /v2/spans
+                      collectorEndpointVersion: HTTP_JSON
+                      collectorHostname: zipkin.us:9090`,
+		}),
+		Entry("tracing disabled", testCase{
+			backend: nil,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                statPrefix: localhost_8080`,
+		}),
+	)
+})
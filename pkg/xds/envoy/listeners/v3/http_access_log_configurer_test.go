@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
@@ -59,7 +60,7 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
 				Configure(OutboundListener(given.listenerName, given.listenerAddress, given.listenerPort, given.listenerProtocol)).
 				Configure(FilterChain(NewFilterChainBuilder(envoy.APIV3).
 					Configure(HttpConnectionManager(given.statsName, false)).
-					Configure(HttpAccessLog(mesh, envoy.TrafficDirectionOutbound, sourceService, destinationService, given.backend, proxy)))).
+					Configure(HttpAccessLog(mesh, envoy.TrafficDirectionOutbound, sourceService, destinationService, given.routeName, given.backend, proxy)))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -130,6 +131,45 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
                   - name: envoy.filters.http.router
                   statPrefix: backend
             name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with file access log and Kuma placeholders", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "route-to-backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name:   "file",
+				Format: `%KUMA_MESH% %KUMA_TRAFFIC_DIRECTION% %KUMA_SOURCE_SERVICE% %KUMA_DESTINATION_SERVICE% %KUMA_ROUTE_NAME%`,
+				Type:   mesh_proto.LoggingFileType,
+				Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+					Path: "/tmp/log",
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.file
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                      logFormat:
+                        textFormatSource:
+                          inlineString: |
+                            demo OUTBOUND web backend route-to-backend
+                      path: /tmp/log
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
             trafficDirection: OUTBOUND`,
 		}),
 		Entry("basic http_connection_manager with tcp access log", testCase{
@@ -182,6 +222,92 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
                   - name: envoy.filters.http.router
                   statPrefix: backend
             name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with splunk access log", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name:   "splunk",
+				Format: `"%KUMA_SOURCE_SERVICE%" "%KUMA_DESTINATION_SERVICE%" %RESPONSE_CODE%`,
+				Type:   mesh_proto.LoggingSplunkType,
+				Conf: util_proto.MustToStruct(&mesh_proto.SplunkLoggingBackendConfig{
+					Url: "https://splunk.example.com:8088",
+					Token: &system_proto.DataSource{
+						Type: &system_proto.DataSource_Secret{Secret: "splunk-token"},
+					},
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.http_grpc
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.HttpGrpcAccessLogConfig
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: |
+                          splunk://eyJ1cmwiOiJodHRwczovL3NwbHVuay5leGFtcGxlLmNvbTo4MDg4IiwidG9rZW4iOnsic2VjcmV0Ijoic3BsdW5rLXRva2VuIn19;"web" "backend" %RESPONSE_CODE%
+                        transportApiVersion: V3
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with grpc access log", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name:   "als",
+				Format: `"%REQ(ORIGIN)%" %RESPONSE_CODE%`,
+				Type:   mesh_proto.LoggingGrpcType,
+				Conf: util_proto.MustToStruct(&mesh_proto.GrpcLoggingBackendConfig{
+					Address: "als-collector:9001",
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.http_grpc
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.HttpGrpcAccessLogConfig
+                      additionalRequestHeadersToLog:
+                      - origin
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log:als
+                        logName: als
+                        transportApiVersion: V3
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
             trafficDirection: OUTBOUND`,
 		}),
 	)
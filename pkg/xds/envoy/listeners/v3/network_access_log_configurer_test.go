@@ -22,6 +22,7 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
 		listenerProtocol core_xds.SocketAddressProtocol
 		statsName        string
 		clusters         []envoy_common.Cluster
+		routeName        string
 		backend          *mesh_proto.LoggingBackend
 		expected         string
 	}
@@ -59,7 +60,7 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
 				Configure(OutboundListener(given.listenerName, given.listenerAddress, given.listenerPort, given.listenerProtocol)).
 				Configure(FilterChain(NewFilterChainBuilder(envoy_common.APIV3).
 					Configure(TcpProxy(given.statsName, given.clusters...)).
-					Configure(NetworkAccessLog(meshName, envoy_common.TrafficDirectionUnspecified, sourceService, destinationService, given.backend, proxy)))).
+					Configure(NetworkAccessLog(meshName, envoy_common.TrafficDirectionUnspecified, sourceService, destinationService, given.routeName, given.backend, proxy)))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -129,7 +130,7 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
                       logFormat:
                         textFormatSource:
                           inlineString: |+
-                            [%START_TIME%] %RESPONSE_FLAGS% demo 192.168.0.1(backend)->%UPSTREAM_HOST%(db) took %DURATION%ms, sent %BYTES_SENT% bytes, received: %BYTES_RECEIVED% bytes
+                            [%START_TIME%] %RESPONSE_FLAGS% demo 192.168.0.1(backend)->%UPSTREAM_HOST%(db) took %DURATION%ms, sent %BYTES_SENT% bytes, received: %BYTES_RECEIVED% bytes, sni: %REQUESTED_SERVER_NAME%
                       path: /tmp/log
                   cluster: db
                   statPrefix: db
@@ -166,16 +167,9 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
                 typedConfig:
                   '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
                   accessLog:
-                  - name: envoy.access_loggers.http_grpc
+                  - name: envoy.access_loggers.tcp_grpc
                     typedConfig:
-                      '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.HttpGrpcAccessLogConfig
-                      additionalRequestHeadersToLog:
-                      - origin
-                      - content-type
-                      additionalResponseHeadersToLog:
-                      - server
-                      additionalResponseTrailersToLog:
-                      - grpc-message
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.TcpGrpcAccessLogConfig
                       commonConfig:
                         grpcService:
                           envoyGrpc:
@@ -188,6 +182,52 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
                   cluster: db
                   statPrefix: db
             name: outbound:127.0.0.1:5432
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic tcp_proxy with tcp access log and a dedicated TCP format", testCase{
+			listenerName:    "outbound:127.0.0.1:5432",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    5432,
+			statsName:       "db",
+			clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+				envoy_common.WithService("db"),
+				envoy_common.WithWeight(200),
+			)},
+			backend: &mesh_proto.LoggingBackend{
+				Name:      "tcp",
+				Format:    `"%REQ(:AUTHORITY)%" %RESPONSE_CODE%`,
+				TcpFormat: `%KUMA_DESTINATION_SERVICE% %REQUESTED_SERVER_NAME% %BYTES_SENT% %FILTER_STATE(filter.state.key)%`,
+				Type:      mesh_proto.LoggingTcpType,
+				Conf: util_proto.MustToStruct(&mesh_proto.TcpLoggingBackendConfig{
+					Address: "127.0.0.1:1234",
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  accessLog:
+                  - name: envoy.access_loggers.tcp_grpc
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.TcpGrpcAccessLogConfig
+                      commonConfig:
+                        filterStateObjectsToLog:
+                        - filter.state.key
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: |
+                          127.0.0.1:1234;db %REQUESTED_SERVER_NAME% %BYTES_SENT% %FILTER_STATE(filter.state.key)%
+                        transportApiVersion: V3
+                  cluster: db
+                  statPrefix: db
+            name: outbound:127.0.0.1:5432
             trafficDirection: OUTBOUND`,
 		}),
 	)
@@ -82,14 +82,25 @@ func createPolicy(permission *core_mesh.TrafficPermissionResource) *rbac_config.
 }
 
 func principalFromSelector(selector *mesh_proto.Selector, mesh string) *rbac_config.Principal {
-	principals := kumaPrincipals(selector)
-
 	service := selector.Match[mesh_proto.ServiceTag]
+	instance := selector.Match[mesh_proto.InstanceTag]
+
+	// A selector of a specific instance of a specific service is matched
+	// by the instance-scoped SPIFFE ID instead of the instance tag.
+	instanceMatched := service != "" && service != mesh_proto.MatchAllTag &&
+		instance != "" && instance != mesh_proto.MatchAllTag
+
+	principals := kumaPrincipals(selector, instanceMatched)
+
 	if service != "" && service != mesh_proto.MatchAllTag {
+		matcher := tls.ServiceSpiffeIDMatcher(mesh, service)
+		if instanceMatched {
+			matcher = tls.ServiceInstanceSpiffeIDMatcher(mesh, service, instance)
+		}
 		spiffePrincipal := &rbac_config.Principal{
 			Identifier: &rbac_config.Principal_Authenticated_{
 				Authenticated: &rbac_config.Principal_Authenticated{
-					PrincipalName: tls.ServiceSpiffeIDMatcher(mesh, service),
+					PrincipalName: matcher,
 				},
 			},
 		}
@@ -117,12 +128,15 @@ func principalFromSelector(selector *mesh_proto.Selector, mesh string) *rbac_con
 }
 
 // kumaPrincipals can match any other tag than kuma.io/service tag
-func kumaPrincipals(selector *mesh_proto.Selector) []*rbac_config.Principal {
+func kumaPrincipals(selector *mesh_proto.Selector, instanceMatched bool) []*rbac_config.Principal {
 	principals := []*rbac_config.Principal{}
 	for tag, value := range selector.Match {
 		if tag == mesh_proto.ServiceTag {
 			continue // service tag is matched by spiffe principal
 		}
+		if tag == mesh_proto.InstanceTag && instanceMatched {
+			continue // instance tag is matched by spiffe principal
+		}
 		if value == mesh_proto.MatchAllTag {
 			continue // '*' can match anything so no need to build principal for it
 		}
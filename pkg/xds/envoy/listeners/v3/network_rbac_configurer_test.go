@@ -112,6 +112,68 @@ var _ = Describe("NetworkRbacConfigurer", func() {
                   statPrefix: localhost_8080
             name: inbound:192.168.0.1:8080
             trafficDirection: INBOUND
+`,
+		}),
+		Entry("network RBAC for a specific instance of a service", testCase{
+			listenerName:    "inbound:192.168.0.1:8080",
+			listenerAddress: "192.168.0.1",
+			listenerPort:    8080,
+			statsName:       "localhost:8080",
+			clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+				envoy_common.WithService("localhost:8080"),
+				envoy_common.WithWeight(200),
+			)},
+			rbacEnabled: true,
+			permission: &core_mesh.TrafficPermissionResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "tp-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.TrafficPermission{
+					Sources: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service":  "web1",
+								"kuma.io/instance": "web1-0",
+							},
+						},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "db",
+							},
+						},
+					},
+				},
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.rbac
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.rbac.v3.RBAC
+                  rules:
+                    policies:
+                      tp-1:
+                        permissions:
+                        - any: true
+                        principals:
+                        - authenticated:
+                            principalName:
+                              exact: spiffe://default/web1/instance/web1-0
+                  statPrefix: inbound_192_168_0_1_8080.
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  cluster: localhost:8080
+                  statPrefix: localhost_8080
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
 `,
 		}),
 		Entry("basic tcp_proxy with network RBAC disabled", testCase{
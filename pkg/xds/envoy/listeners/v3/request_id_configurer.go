@@ -0,0 +1,54 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_uuid "github.com/envoyproxy/go-control-plane/envoy/extensions/request_id/uuid/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
+)
+
+// RequestIdConfigurer configures how the HttpConnectionManager generates and
+// propagates request IDs. It has to be applied after the routes of the
+// HttpConnectionManager, so the request ID header is added to inline routes.
+type RequestIdConfigurer struct {
+	RequestId *mesh_proto.RequestId
+	// Edge is true for listeners that accept requests from outside of the mesh.
+	Edge bool
+}
+
+var _ FilterChainConfigurer = &RequestIdConfigurer{}
+
+func (c *RequestIdConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.RequestId == nil {
+		return nil
+	}
+	traceContext := c.RequestId.GetGenerator() == mesh_proto.RequestIdGeneratorTraceContext
+	extension, err := util_proto.MarshalAnyDeterministic(&envoy_uuid.UuidRequestIdConfig{
+		PackTraceReason: util_proto.Bool(traceContext),
+	})
+	if err != nil {
+		return err
+	}
+	return UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+		hcm.GenerateRequestId = util_proto.Bool(true)
+		hcm.RequestIdExtension = &envoy_hcm.RequestIDExtension{
+			TypedConfig: extension,
+		}
+		if c.Edge {
+			overwrite := c.RequestId.GetOverwriteOnGateways()
+			hcm.PreserveExternalRequestId = overwrite != nil && !overwrite.GetValue()
+		}
+		if routeConfig := hcm.GetRouteConfig(); routeConfig != nil {
+			headerConfigurer := &envoy_routes.RequestIdHeaderConfigurer{
+				Header: c.RequestId.GetHeader(),
+			}
+			if err := headerConfigurer.Configure(routeConfig); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,139 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("RequestIdConfigurer", func() {
+	type testCase struct {
+		requestId *mesh_proto.RequestId
+		edge      bool
+		expected  string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("stats", false)).
+				Configure(HttpInboundRoutes("backend", envoy_common.Routes{
+					envoy_common.NewRouteFromCluster(envoy_common.NewCluster(
+						envoy_common.WithService("localhost:8080"),
+					)),
+				})).
+				Configure(RequestId(given.requestId, given.edge)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("request id not configured", testCase{
+			requestId: nil,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                routeConfig:
+                  name: inbound:backend
+                  validateClusters: false
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  virtualHosts:
+                  - domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                statPrefix: stats`,
+		}),
+		Entry("uuid generator with a custom header", testCase{
+			requestId: &mesh_proto.RequestId{
+				Generator: mesh_proto.RequestIdGeneratorUuid,
+				Header:    "x-correlation-id",
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                generateRequestId: true
+                requestIdExtension:
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.request_id.uuid.v3.UuidRequestIdConfig
+                    packTraceReason: false
+                httpFilters:
+                - name: envoy.filters.http.router
+                routeConfig:
+                  name: inbound:backend
+                  validateClusters: false
+                  requestHeadersToAdd:
+                  - append: false
+                    header:
+                      key: x-correlation-id
+                      value: '%REQ(x-request-id)%'
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  virtualHosts:
+                  - domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                statPrefix: stats`,
+		}),
+		Entry("trace context generator on an edge listener that keeps incoming ids", testCase{
+			requestId: &mesh_proto.RequestId{
+				Generator:           mesh_proto.RequestIdGeneratorTraceContext,
+				OverwriteOnGateways: util_proto.Bool(false),
+			},
+			edge: true,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                generateRequestId: true
+                preserveExternalRequestId: true
+                requestIdExtension:
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.request_id.uuid.v3.UuidRequestIdConfig
+                    packTraceReason: true
+                httpFilters:
+                - name: envoy.filters.http.router
+                routeConfig:
+                  name: inbound:backend
+                  validateClusters: false
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  virtualHosts:
+                  - domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                statPrefix: stats`,
+		}),
+	)
+})
@@ -96,6 +96,23 @@ func genHttpRetryPolicy(
 	return &policy
 }
 
+// RetryPolicy converts the Retry policy to the Envoy retry policy for
+// the given protocol. It returns nil if the protocol is not retried.
+func RetryPolicy(retry *core_mesh.RetryResource, protocol core_mesh.Protocol) *envoy_route.RetryPolicy {
+	if retry == nil {
+		return nil
+	}
+
+	switch protocol {
+	case "http":
+		return genHttpRetryPolicy(retry.Spec.Conf.GetHttp())
+	case "grpc":
+		return genGrpcRetryPolicy(retry.Spec.Conf.GetGrpc())
+	default:
+		return nil
+	}
+}
+
 func (c *RetryConfigurer) Configure(
 	filterChain *envoy_listener.FilterChain,
 ) error {
@@ -104,14 +121,8 @@ func (c *RetryConfigurer) Configure(
 	}
 
 	updateFunc := func(manager *envoy_hcm.HttpConnectionManager) error {
-		var policy *envoy_route.RetryPolicy
-
-		switch c.Protocol {
-		case "http":
-			policy = genHttpRetryPolicy(c.Retry.Spec.Conf.GetHttp())
-		case "grpc":
-			policy = genGrpcRetryPolicy(c.Retry.Spec.Conf.GetGrpc())
-		default:
+		policy := RetryPolicy(c.Retry, c.Protocol)
+		if policy == nil {
 			return nil
 		}
 
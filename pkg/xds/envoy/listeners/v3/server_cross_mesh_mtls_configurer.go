@@ -0,0 +1,38 @@
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+
+	"github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	tls "github.com/kumahq/kuma/pkg/xds/envoy/tls/v3"
+)
+
+// ServerSideCrossMeshMTLSConfigurer secures the filter chain with the
+// mTLS of the mesh of the proxy, and accepts clients from any of the
+// given meshes.
+type ServerSideCrossMeshMTLSConfigurer struct {
+	Ctx    xds_context.Context
+	Meshes []string
+}
+
+var _ FilterChainConfigurer = &ServerSideCrossMeshMTLSConfigurer{}
+
+func (c *ServerSideCrossMeshMTLSConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	tlsContext, err := tls.CreateCrossMeshDownstreamTlsContext(c.Ctx, c.Meshes)
+	if err != nil {
+		return err
+	}
+	pbst, err := proto.MarshalAnyDeterministic(tlsContext)
+	if err != nil {
+		return err
+	}
+	filterChain.TransportSocket = &envoy_core.TransportSocket{
+		Name: "envoy.transport_sockets.tls",
+		ConfigType: &envoy_core.TransportSocket_TypedConfig{
+			TypedConfig: pbst,
+		},
+	}
+	return nil
+}
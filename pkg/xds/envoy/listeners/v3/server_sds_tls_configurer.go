@@ -0,0 +1,23 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+
+	xds_tls "github.com/kumahq/kuma/pkg/xds/envoy/tls/v3"
+)
+
+// ServerSideSdsTLSConfigurer terminates TLS on the filter chain using the
+// certificate of a secret that is received from the ADS, so the certificate
+// can be rotated without changing the listener. When ClientCertificateAuthority
+// is set, clients are required to present a certificate signed by one of the
+// PEM encoded CA certificates.
+type ServerSideSdsTLSConfigurer struct {
+	SecretName                 string
+	ClientCertificateAuthority []byte
+}
+
+var _ FilterChainConfigurer = &ServerSideSdsTLSConfigurer{}
+
+func (c *ServerSideSdsTLSConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	return configureDownstreamTLS(filterChain, xds_tls.SdsDownstreamTlsContext(c.SecretName), c.ClientCertificateAuthority)
+}
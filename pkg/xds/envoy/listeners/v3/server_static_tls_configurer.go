@@ -0,0 +1,59 @@
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+
+	"github.com/kumahq/kuma/pkg/tls"
+	"github.com/kumahq/kuma/pkg/util/proto"
+	xds_tls "github.com/kumahq/kuma/pkg/xds/envoy/tls/v3"
+)
+
+// ServerSideStaticTLSConfigurer terminates TLS on the filter chain using a static certificate.
+// When ClientCertificateAuthority is set, clients are required to present a certificate
+// signed by one of the PEM encoded CA certificates.
+type ServerSideStaticTLSConfigurer struct {
+	KeyPair                    *tls.KeyPair
+	ClientCertificateAuthority []byte
+}
+
+var _ FilterChainConfigurer = &ServerSideStaticTLSConfigurer{}
+
+func (c *ServerSideStaticTLSConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	return configureDownstreamTLS(filterChain, xds_tls.StaticDownstreamTlsContext(c.KeyPair), c.ClientCertificateAuthority)
+}
+
+// configureDownstreamTLS sets the TLS transport socket of the filter chain.
+// When clientCertificateAuthority is not empty, clients are required to
+// present a certificate signed by one of its CA certificates.
+func configureDownstreamTLS(
+	filterChain *envoy_listener.FilterChain,
+	tlsContext *envoy_tls.DownstreamTlsContext,
+	clientCertificateAuthority []byte,
+) error {
+	if len(clientCertificateAuthority) > 0 {
+		tlsContext.RequireClientCertificate = proto.Bool(true)
+		tlsContext.CommonTlsContext.ValidationContextType = &envoy_tls.CommonTlsContext_ValidationContext{
+			ValidationContext: &envoy_tls.CertificateValidationContext{
+				TrustedCa: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{
+						InlineBytes: clientCertificateAuthority,
+					},
+				},
+			},
+		}
+	}
+
+	pbst, err := proto.MarshalAnyDeterministic(tlsContext)
+	if err != nil {
+		return err
+	}
+	filterChain.TransportSocket = &envoy_core.TransportSocket{
+		Name: "envoy.transport_sockets.tls",
+		ConfigType: &envoy_core.TransportSocket_TypedConfig{
+			TypedConfig: pbst,
+		},
+	}
+	return nil
+}
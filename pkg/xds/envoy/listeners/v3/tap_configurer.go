@@ -0,0 +1,55 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_common_tap "github.com/envoyproxy/go-control-plane/envoy/extensions/common/tap/v3"
+	envoy_http_tap "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/tap/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// RecordingTapConfigID is the ID of the tap that the Control Plane configures
+// through the Envoy admin API to record requests.
+const RecordingTapConfigID = "kuma-recording"
+
+// HttpTapConfigurer adds a tap filter that is configured through the admin
+// API, so requests are recorded only when the Control Plane asks for them.
+type HttpTapConfigurer struct {
+	Recording *mesh_proto.Recording
+}
+
+var _ FilterChainConfigurer = &HttpTapConfigurer{}
+
+func (t *HttpTapConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if !t.Recording.GetEnabled() {
+		return nil
+	}
+	config := &envoy_http_tap.Tap{
+		CommonConfig: &envoy_common_tap.CommonExtensionConfig{
+			ConfigType: &envoy_common_tap.CommonExtensionConfig_AdminConfig{
+				AdminConfig: &envoy_common_tap.AdminConfig{
+					ConfigId: RecordingTapConfigID,
+				},
+			},
+		},
+	}
+	pbst, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return err
+	}
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		// the tap goes first, so it records requests before other filters
+		// modify or reject them
+		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{
+			{
+				Name: "envoy.filters.http.tap",
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: pbst,
+				},
+			},
+		}, manager.HttpFilters...)
+		return nil
+	})
+}
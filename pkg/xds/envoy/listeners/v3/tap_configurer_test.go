@@ -0,0 +1,65 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("HttpTapConfigurer", func() {
+	type testCase struct {
+		recording *mesh_proto.Recording
+		expected  string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy.APIV3).
+				Configure(HttpConnectionManager("stats", false)).
+				Configure(HttpTap(given.recording)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("recording enabled", testCase{
+			recording: &mesh_proto.Recording{
+				Enabled: true,
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.tap
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.tap.v3.Tap
+                    commonConfig:
+                      adminConfig:
+                        configId: kuma-recording
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("recording disabled", testCase{
+			recording: nil,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+	)
+})
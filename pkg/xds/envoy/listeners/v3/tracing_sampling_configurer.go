@@ -0,0 +1,55 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// TracingSamplingConfigurer overrides the sampling of the tracing backend
+// with the sampling of the TrafficTrace. It has to be applied after the
+// TracingConfigurer.
+//
+// The sampling of the service is applied as random sampling, so routes can
+// override it, and the maximum sampling as overall sampling, which caps
+// the sampling of the service and routes.
+type TracingSamplingConfigurer struct {
+	Backend  *mesh_proto.TracingBackend
+	Sampling *mesh_proto.TrafficTrace_Conf_Sampling
+
+	// Destination service of the traffic of the listener. It is empty if
+	// the listener routes traffic to many services.
+	Service string
+}
+
+var _ FilterChainConfigurer = &TracingSamplingConfigurer{}
+
+func (c *TracingSamplingConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.Backend == nil || c.Sampling == nil {
+		return nil
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+		if hcm.Tracing == nil {
+			return nil
+		}
+		random := c.Backend.Sampling
+		if percentage := c.Sampling.ServicePercentage(c.Service); c.Service != "" && percentage != nil {
+			random = percentage
+		}
+		if random != nil {
+			hcm.Tracing.RandomSampling = &envoy_type.Percent{
+				Value: random.GetValue(),
+			}
+		}
+		hcm.Tracing.OverallSampling = nil
+		if max := c.Sampling.GetMaxPercentage(); max != nil {
+			hcm.Tracing.OverallSampling = &envoy_type.Percent{
+				Value: max.GetValue(),
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,132 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("TracingSamplingConfigurer", func() {
+
+	backend := &mesh_proto.TracingBackend{
+		Name:     "zipkin",
+		Sampling: util_proto.Double(1),
+		Type:     mesh_proto.TracingZipkinType,
+		Conf: util_proto.MustToStruct(&mesh_proto.ZipkinTracingBackendConfig{
+			Url: "http://zipkin.us:9090/v2/spans",
+		}),
+	}
+
+	sampling := &mesh_proto.TrafficTrace_Conf_Sampling{
+		Services: []*mesh_proto.TrafficTrace_Conf_Sampling_Service{
+			{
+				Service:    "backend",
+				Percentage: util_proto.Double(0.1),
+			},
+		},
+		MaxPercentage: util_proto.Double(50),
+	}
+
+	type testCase struct {
+		sampling *mesh_proto.TrafficTrace_Conf_Sampling
+		service  string
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy.APIV3).
+				Configure(HttpConnectionManager("localhost:8080", false)).
+				Configure(Tracing(backend, "web")).
+				Configure(TracingSampling(backend, given.sampling, given.service)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("sampling of the service", testCase{
+			sampling: sampling,
+			service:  "backend",
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                statPrefix: localhost_8080
+                tracing:
+                  overallSampling:
+                    value: 50
+                  randomSampling:
+                    value: 0.1
+                  provider:
+                    name: envoy.zipkin
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v3.ZipkinConfig
+                      collectorCluster: tracing:zipkin
+                      collectorEndpoint: /v2/spans
+                      collectorEndpointVersion: HTTP_JSON
+                      collectorHostname: zipkin.us:9090`,
+		}),
+		Entry("service without sampling falls back to the backend", testCase{
+			sampling: sampling,
+			service:  "web",
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                statPrefix: localhost_8080
+                tracing:
+                  overallSampling:
+                    value: 50
+                  randomSampling:
+                    value: 1
+                  provider:
+                    name: envoy.zipkin
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v3.ZipkinConfig
+                      collectorCluster: tracing:zipkin
+                      collectorEndpoint: /v2/spans
+                      collectorEndpointVersion: HTTP_JSON
+                      collectorHostname: zipkin.us:9090`,
+		}),
+		Entry("no sampling in the TrafficTrace", testCase{
+			sampling: nil,
+			service:  "backend",
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                statPrefix: localhost_8080
+                tracing:
+                  overallSampling:
+                    value: 1
+                  provider:
+                    name: envoy.zipkin
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v3.ZipkinConfig
+                      collectorCluster: tracing:zipkin
+                      collectorEndpoint: /v2/spans
+                      collectorEndpointVersion: HTTP_JSON
+                      collectorHostname: zipkin.us:9090`,
+		}),
+	)
+})
@@ -39,6 +39,14 @@ func GetOutboundListenerName(address string, port uint32) string {
 		net.JoinHostPort(address, strconv.FormatUint(uint64(port), 10)))
 }
 
+func GetInboundPortRangeName(address string, from uint32, to uint32) string {
+	return fmt.Sprintf("inbound:port-range:%s:%d-%d", address, from, to)
+}
+
+func GetOutboundPortRangeName(service string, from uint32, to uint32) string {
+	return fmt.Sprintf("outbound:port-range:%s:%d-%d", service, from, to)
+}
+
 func GetInboundRouteName(service string) string {
 	return fmt.Sprintf("inbound:%s", service)
 }
@@ -67,6 +75,10 @@ func GetTracingClusterName(backendName string) string {
 	return fmt.Sprintf("tracing:%s", backendName)
 }
 
+func GetAccessLogClusterName(backendName string) string {
+	return fmt.Sprintf("access_log:%s", backendName)
+}
+
 func GetDNSListenerName() string {
 	return "kuma:dns"
 }
@@ -74,3 +86,34 @@ func GetDNSListenerName() string {
 func GetGatewayListenerName(gatewayName string, protoName string, port uint32) string {
 	return strings.Join([]string{gatewayName, protoName, strconv.Itoa(int(port))}, ":")
 }
+
+// GetGatewayClientRouteName returns the name of the route configuration
+// of the given class of clients of a gateway listener.
+func GetGatewayClientRouteName(listener string, class int) string {
+	return strings.Join([]string{listener, "clients", strconv.Itoa(class)}, ":")
+}
+
+// GetGatewayCertificateSecretName returns the name of the SDS secret that
+// delivers the certificate of a gateway listener from the given source.
+func GetGatewayCertificateSecretName(source string, name string) string {
+	return strings.Join([]string{"gateway_certificate", source, name}, ":")
+}
+
+// GetGatewayJwksClusterName returns the name of the cluster that a gateway
+// fetches the JSON Web Key Sets of the given host from.
+func GetGatewayJwksClusterName(host string, port uint32) string {
+	return strings.Join([]string{"gateway_jwks", host, strconv.Itoa(int(port))}, ":")
+}
+
+// GetGatewayOidcClusterName returns the name of the cluster that a gateway
+// reaches the OpenID Connect token endpoint of the given host through.
+func GetGatewayOidcClusterName(host string, port uint32) string {
+	return strings.Join([]string{"gateway_oidc", host, strconv.Itoa(int(port))}, ":")
+}
+
+// GetGatewayOidcSecretName returns the name of the SDS secret that
+// delivers a secret of the OpenID Connect authentication of a gateway
+// listener.
+func GetGatewayOidcSecretName(listener string, name string) string {
+	return strings.Join([]string{"gateway_oidc", listener, name}, ":")
+}
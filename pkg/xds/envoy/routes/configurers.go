@@ -61,6 +61,23 @@ func ResetTagsHeader() RouteConfigurationBuilderOpt {
 	return AddRouteConfigurationConfigurer(&v3.ResetTagsHeaderConfigurer{})
 }
 
+// RemoveRequestHeaders removes the headers from requests.
+func RemoveRequestHeaders(names ...string) RouteConfigurationBuilderOpt {
+	return AddRouteConfigurationConfigurer(
+		v3.RouteConfigurationMustConfigureFunc(func(rc *envoy_route.RouteConfiguration) {
+			rc.RequestHeadersToRemove = append(rc.RequestHeadersToRemove, names...)
+		}),
+	)
+}
+
+// RequestIdHeader copies the request ID to the header configured in the mesh.
+func RequestIdHeader(requestId *mesh_proto.RequestId) RouteConfigurationBuilderOpt {
+	return AddRouteConfigurationConfigurer(
+		&v3.RequestIdHeaderConfigurer{
+			Header: requestId.GetHeader(),
+		})
+}
+
 func TagsHeader(tags mesh_proto.MultiValueTagSet) RouteConfigurationBuilderOpt {
 	return AddRouteConfigurationConfigurer(
 		&v3.TagsHeaderConfigurer{
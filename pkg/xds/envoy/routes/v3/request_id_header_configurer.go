@@ -0,0 +1,29 @@
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// RequestIdHeaderConfigurer copies the request ID that Envoy keeps in
+// x-request-id to the header configured in the mesh.
+type RequestIdHeaderConfigurer struct {
+	Header string
+}
+
+func (r *RequestIdHeaderConfigurer) Configure(rc *envoy_route.RouteConfiguration) error {
+	if r.Header == "" || r.Header == mesh_proto.DefaultRequestIdHeader {
+		return nil
+	}
+	rc.RequestHeadersToAdd = append(rc.RequestHeadersToAdd, &envoy_core.HeaderValueOption{
+		Header: &envoy_core.HeaderValue{
+			Key:   r.Header,
+			Value: "%REQ(" + mesh_proto.DefaultRequestIdHeader + ")%",
+		},
+		Append: util_proto.Bool(false),
+	})
+	return nil
+}
@@ -0,0 +1,55 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/routes"
+)
+
+var _ = Describe("RequestIdHeaderConfigurer", func() {
+
+	It("should copy the request id to the custom header", func() {
+		// when
+		routeConfiguration, err := routes.NewRouteConfigurationBuilder(envoy.APIV3).
+			Configure(routes.RequestIdHeader(&mesh_proto.RequestId{
+				Header: "x-correlation-id",
+			})).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(routeConfiguration)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            requestHeadersToAdd:
+            - append: false
+              header:
+                key: x-correlation-id
+                value: '%REQ(x-request-id)%'`))
+	})
+
+	It("should not add the default header", func() {
+		// when
+		routeConfiguration, err := routes.NewRouteConfigurationBuilder(envoy.APIV3).
+			Configure(routes.RequestIdHeader(&mesh_proto.RequestId{
+				Header: "x-request-id",
+			})).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(routeConfiguration)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`{}`))
+	})
+})
@@ -11,8 +11,18 @@ import (
 )
 
 func CreateCaSecret(secret *core_xds.CaSecret) *envoy_auth.Secret {
+	return createCaSecret(tls.MeshCaResource, secret)
+}
+
+// CreateCrossMeshCaSecret creates the secret with the CAs of the meshes
+// whose data plane proxies can connect to cross-mesh gateway listeners.
+func CreateCrossMeshCaSecret(secret *core_xds.CaSecret) *envoy_auth.Secret {
+	return createCaSecret(tls.CrossMeshCaResource, secret)
+}
+
+func createCaSecret(name string, secret *core_xds.CaSecret) *envoy_auth.Secret {
 	return &envoy_auth.Secret{
-		Name: tls.MeshCaResource,
+		Name: name,
 		Type: &envoy_auth.Secret_ValidationContext{
 			ValidationContext: &envoy_auth.CertificateValidationContext{
 				TrustedCa: &envoy_core.DataSource{
@@ -0,0 +1,55 @@
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+
+	"github.com/kumahq/kuma/pkg/tls"
+)
+
+// CreateCertificateSecret creates a secret with the given certificate and
+// private key.
+func CreateCertificateSecret(name string, keyPair *tls.KeyPair) *envoy_auth.Secret {
+	return &envoy_auth.Secret{
+		Name: name,
+		Type: &envoy_auth.Secret_TlsCertificate{
+			TlsCertificate: &envoy_auth.TlsCertificate{
+				CertificateChain: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{
+						InlineBytes: keyPair.CertPEM,
+					},
+				},
+				PrivateKey: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{
+						InlineBytes: keyPair.KeyPEM,
+					},
+				},
+			},
+		},
+	}
+}
+
+// CreateFileCertificateSecret creates a secret with the certificate and
+// private key of the PEM file at the given path on the host of the data
+// plane proxy. Envoy watches the file and reloads the secret when the file
+// changes. The file holds both the certificate and the key, Envoy skips
+// the PEM blocks of the other type when it reads each of them.
+func CreateFileCertificateSecret(name string, path string) *envoy_auth.Secret {
+	return &envoy_auth.Secret{
+		Name: name,
+		Type: &envoy_auth.Secret_TlsCertificate{
+			TlsCertificate: &envoy_auth.TlsCertificate{
+				CertificateChain: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_Filename{
+						Filename: path,
+					},
+				},
+				PrivateKey: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_Filename{
+						Filename: path,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,23 @@
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+)
+
+// CreateGenericSecret creates a secret with the given opaque value, for
+// the filters that read their credentials from SDS.
+func CreateGenericSecret(name string, value []byte) *envoy_auth.Secret {
+	return &envoy_auth.Secret{
+		Name: name,
+		Type: &envoy_auth.Secret_GenericSecret{
+			GenericSecret: &envoy_auth.GenericSecret{
+				Secret: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{
+						InlineBytes: value,
+					},
+				},
+			},
+		},
+	}
+}
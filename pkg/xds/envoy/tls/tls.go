@@ -2,11 +2,15 @@ package tls
 
 import (
 	"fmt"
+	"path/filepath"
 )
 
 const (
 	MeshCaResource       = "mesh_ca"
 	IdentityCertResource = "identity_cert"
+	// CrossMeshCaResource holds the CAs of all the meshes whose data plane
+	// proxies can connect to cross-mesh gateway listeners.
+	CrossMeshCaResource = "cross_mesh_ca"
 )
 
 // KumaALPNProtocols are set for UpstreamTlsContext to show that mTLS is created by mesh.
@@ -14,6 +18,24 @@ const (
 // support PERMISSIVE mode
 var KumaALPNProtocols = []string{"kuma"}
 
+// FIPSCipherSuites are the TLS 1.2 cipher suites approved by FIPS 140-2
+// that are supported by the FIPS build of Envoy.
+var FIPSCipherSuites = []string{
+	"ECDHE-ECDSA-AES128-GCM-SHA256",
+	"ECDHE-RSA-AES128-GCM-SHA256",
+	"ECDHE-ECDSA-AES256-GCM-SHA384",
+	"ECDHE-RSA-AES256-GCM-SHA384",
+}
+
+// FIPSEcdhCurves are the ECDH curves approved by FIPS 140-2.
+var FIPSEcdhCurves = []string{"P-256"}
+
+// SecretFilePath is a path of the file in which kuma-dp delivers the secret to Envoy.
+// The file contains DiscoveryResponse with the secret, so Envoy can watch it as a path based config source.
+func SecretFilePath(secretsDir string, name string) string {
+	return filepath.Join(secretsDir, name+".yaml")
+}
+
 func MeshSpiffeIDPrefix(mesh string) string {
 	return fmt.Sprintf("spiffe://%s/", mesh)
 }
@@ -22,6 +44,12 @@ func ServiceSpiffeID(mesh string, service string) string {
 	return fmt.Sprintf("spiffe://%s/%s", mesh, service)
 }
 
+// ServiceInstanceSpiffeID is the SPIFFE ID of a single instance of the service,
+// e.g. a pod of a StatefulSet.
+func ServiceInstanceSpiffeID(mesh string, service string, instance string) string {
+	return fmt.Sprintf("spiffe://%s/%s/instance/%s", mesh, service, instance)
+}
+
 func KumaID(tagName, tagValue string) string {
 	return fmt.Sprintf("kuma://%s/%s", tagName, tagValue)
 }
@@ -4,6 +4,7 @@ import (
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/tls"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
@@ -19,7 +20,7 @@ func CreateDownstreamTlsContext(ctx xds_context.Context) (*envoy_tls.DownstreamT
 		return nil, nil
 	}
 	validationSANMatcher := MeshSpiffeIDPrefixMatcher(ctx.Mesh.Resource.Meta.GetName())
-	commonTlsContext, err := createCommonTlsContext(validationSANMatcher)
+	commonTlsContext, err := createCommonTlsContext(validationSANMatcher, ctx.Mesh.Resource.FIPSEnabled(), ctx.SecretsDir)
 	if err != nil {
 		return nil, err
 	}
@@ -29,6 +30,32 @@ func CreateDownstreamTlsContext(ctx xds_context.Context) (*envoy_tls.DownstreamT
 	}, nil
 }
 
+// CreateCrossMeshDownstreamTlsContext creates DownstreamTlsContext for incoming connections from the data plane proxies
+// of the given meshes. It verifies that incoming connection has TLS certificate signed by one of the CAs of the "cross_mesh_ca"
+// secret, with URI SAN of prefix spiffe://{mesh_name}/ of one of the meshes.
+// The listener is secured with the "identity_cert" of the proxy, like inbound listeners. The "cross_mesh_ca" secret is always
+// received from the ADS, because kuma-dp only delivers the secrets of the mesh of the proxy as files.
+func CreateCrossMeshDownstreamTlsContext(ctx xds_context.Context, meshes []string) (*envoy_tls.DownstreamTlsContext, error) {
+	if !ctx.Mesh.Resource.MTLSEnabled() {
+		return nil, errors.Errorf("mTLS has to be enabled on mesh %q to accept connections from other meshes", ctx.Mesh.Resource.Meta.GetName())
+	}
+	commonTlsContext, err := createCommonTlsContext(nil, ctx.Mesh.Resource.FIPSEnabled(), ctx.SecretsDir)
+	if err != nil {
+		return nil, err
+	}
+	var validationSANMatchers []*envoy_type_matcher.StringMatcher
+	for _, mesh := range meshes {
+		validationSANMatchers = append(validationSANMatchers, MeshSpiffeIDPrefixMatcher(mesh))
+	}
+	validationContext := commonTlsContext.GetCombinedValidationContext()
+	validationContext.DefaultValidationContext.MatchSubjectAltNames = validationSANMatchers
+	validationContext.ValidationContextSdsSecretConfig = sdsSecretConfig(xds_tls.CrossMeshCaResource, "")
+	return &envoy_tls.DownstreamTlsContext{
+		CommonTlsContext:         commonTlsContext,
+		RequireClientCertificate: util_proto.Bool(true),
+	}, nil
+}
+
 // CreateUpstreamTlsContext creates UpstreamTlsContext for outgoing connections
 // It verifies that the upstream server has TLS certificate signed by Mesh CA with URI SAN of spiffe://{mesh_name}/{upstream_service}
 // The downstream client exposes for the upstream server cert with multiple URI SANs, which means that if DP has inbound with services "web" and "web-api" and communicates with "backend"
@@ -46,7 +73,7 @@ func CreateUpstreamTlsContext(ctx xds_context.Context, upstreamService string, s
 	} else {
 		validationSANMatcher = ServiceSpiffeIDMatcher(ctx.Mesh.Resource.Meta.GetName(), upstreamService)
 	}
-	commonTlsContext, err := createCommonTlsContext(validationSANMatcher)
+	commonTlsContext, err := createCommonTlsContext(validationSANMatcher, ctx.Mesh.Resource.FIPSEnabled(), ctx.SecretsDir)
 	if err != nil {
 		return nil, err
 	}
@@ -57,10 +84,19 @@ func CreateUpstreamTlsContext(ctx xds_context.Context, upstreamService string, s
 	}, nil
 }
 
-func createCommonTlsContext(validationSANMatcher *envoy_type_matcher.StringMatcher) (*envoy_tls.CommonTlsContext, error) {
-	meshCaSecret := sdsSecretConfig(xds_tls.MeshCaResource)
-	identitySecret := sdsSecretConfig(xds_tls.IdentityCertResource)
+// createCommonTlsContext trusts only root certificates of the Mesh CA ("mesh_ca" secret).
+// When workload certificates are issued by an intermediate CA, the "identity_cert" secret carries
+// the whole chain, so the peers can build the path from the workload certificate to the trusted root.
+// If secretsDir is set, the secrets are watched in the files written by kuma-dp instead of being received over ADS.
+func createCommonTlsContext(validationSANMatcher *envoy_type_matcher.StringMatcher, fips bool, secretsDir string) (*envoy_tls.CommonTlsContext, error) {
+	meshCaSecret := sdsSecretConfig(xds_tls.MeshCaResource, secretsDir)
+	identitySecret := sdsSecretConfig(xds_tls.IdentityCertResource, secretsDir)
+	var tlsParams *envoy_tls.TlsParameters
+	if fips {
+		tlsParams = fipsTlsParameters()
+	}
 	return &envoy_tls.CommonTlsContext{
+		TlsParams: tlsParams,
 		ValidationContextType: &envoy_tls.CommonTlsContext_CombinedValidationContext{
 			CombinedValidationContext: &envoy_tls.CommonTlsContext_CombinedCertificateValidationContext{
 				DefaultValidationContext: &envoy_tls.CertificateValidationContext{
@@ -75,7 +111,29 @@ func createCommonTlsContext(validationSANMatcher *envoy_type_matcher.StringMatch
 	}, nil
 }
 
-func sdsSecretConfig(name string) *envoy_tls.SdsSecretConfig {
+// fipsTlsParameters restricts TLS to version 1.2 with FIPS approved cipher suites and curves.
+// TLS 1.3 is not allowed because BoringSSL in FIPS mode does not let us configure its cipher suites.
+func fipsTlsParameters() *envoy_tls.TlsParameters {
+	return &envoy_tls.TlsParameters{
+		TlsMinimumProtocolVersion: envoy_tls.TlsParameters_TLSv1_2,
+		TlsMaximumProtocolVersion: envoy_tls.TlsParameters_TLSv1_2,
+		CipherSuites:              xds_tls.FIPSCipherSuites,
+		EcdhCurves:                xds_tls.FIPSEcdhCurves,
+	}
+}
+
+func sdsSecretConfig(name string, secretsDir string) *envoy_tls.SdsSecretConfig {
+	if secretsDir != "" {
+		return &envoy_tls.SdsSecretConfig{
+			Name: name,
+			SdsConfig: &envoy_core.ConfigSource{
+				ResourceApiVersion: envoy_core.ApiVersion_V3,
+				ConfigSourceSpecifier: &envoy_core.ConfigSource_Path{
+					Path: xds_tls.SecretFilePath(secretsDir, name),
+				},
+			},
+		}
+	}
 	return &envoy_tls.SdsSecretConfig{
 		Name: name,
 		SdsConfig: &envoy_core.ConfigSource{
@@ -146,6 +204,14 @@ func ServiceSpiffeIDMatcher(mesh string, service string) *envoy_type_matcher.Str
 	}
 }
 
+func ServiceInstanceSpiffeIDMatcher(mesh string, service string, instance string) *envoy_type_matcher.StringMatcher {
+	return &envoy_type_matcher.StringMatcher{
+		MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+			Exact: xds_tls.ServiceInstanceSpiffeID(mesh, service, instance),
+		},
+	}
+}
+
 func KumaIDMatcher(tagName, tagValue string) *envoy_type_matcher.StringMatcher {
 	return &envoy_type_matcher.StringMatcher{
 		MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
@@ -174,3 +240,17 @@ func StaticDownstreamTlsContext(keyPair *tls.KeyPair) *envoy_tls.DownstreamTlsCo
 		},
 	}
 }
+
+// SdsDownstreamTlsContext creates DownstreamTlsContext that secures the
+// connections with the certificate of the named secret, which is received
+// from the ADS. Envoy updates the certificate when the secret changes,
+// without draining the listener.
+func SdsDownstreamTlsContext(secretName string) *envoy_tls.DownstreamTlsContext {
+	return &envoy_tls.DownstreamTlsContext{
+		CommonTlsContext: &envoy_tls.CommonTlsContext{
+			TlsCertificateSdsSecretConfigs: []*envoy_tls.SdsSecretConfig{
+				sdsSecretConfig(secretName, ""),
+			},
+		},
+	}
+}
@@ -37,7 +37,9 @@ var _ = Describe("CreateDownstreamTlsContext()", func() {
 	Context("when mTLS is enabled on a given Mesh", func() {
 
 		type testCase struct {
-			expected string
+			fips       bool
+			secretsDir string
+			expected   string
 		}
 
 		DescribeTable("should generate proper Envoy config",
@@ -58,10 +60,12 @@ var _ = Describe("CreateDownstreamTlsContext()", func() {
 											Type: "builtin",
 										},
 									},
+									Fips: given.fips,
 								},
 							},
 						},
 					},
+					SecretsDir: given.secretsDir,
 				}
 
 				// when
@@ -92,6 +96,56 @@ var _ = Describe("CreateDownstreamTlsContext()", func() {
                     sdsConfig:
                       ads: {}
                       resourceApiVersion: V3
+                requireClientCertificate: true`,
+			}),
+			Entry("FIPS mode is enabled", testCase{
+				fips: true,
+				expected: `
+                commonTlsContext:
+                  combinedValidationContext:
+                    defaultValidationContext:
+                      matchSubjectAltNames:
+                      - prefix: spiffe://default/
+                    validationContextSdsSecretConfig:
+                      name: mesh_ca
+                      sdsConfig:
+                        ads: {}
+                        resourceApiVersion: V3
+                  tlsCertificateSdsSecretConfigs:
+                  - name: identity_cert
+                    sdsConfig:
+                      ads: {}
+                      resourceApiVersion: V3
+                  tlsParams:
+                    cipherSuites:
+                    - ECDHE-ECDSA-AES128-GCM-SHA256
+                    - ECDHE-RSA-AES128-GCM-SHA256
+                    - ECDHE-ECDSA-AES256-GCM-SHA384
+                    - ECDHE-RSA-AES256-GCM-SHA384
+                    ecdhCurves:
+                    - P-256
+                    tlsMaximumProtocolVersion: TLSv1_2
+                    tlsMinimumProtocolVersion: TLSv1_2
+                requireClientCertificate: true`,
+			}),
+			Entry("secrets are delivered as files", testCase{
+				secretsDir: "/var/run/kuma-dp/secrets",
+				expected: `
+                commonTlsContext:
+                  combinedValidationContext:
+                    defaultValidationContext:
+                      matchSubjectAltNames:
+                      - prefix: spiffe://default/
+                    validationContextSdsSecretConfig:
+                      name: mesh_ca
+                      sdsConfig:
+                        path: /var/run/kuma-dp/secrets/mesh_ca.yaml
+                        resourceApiVersion: V3
+                  tlsCertificateSdsSecretConfigs:
+                  - name: identity_cert
+                    sdsConfig:
+                      path: /var/run/kuma-dp/secrets/identity_cert.yaml
+                      resourceApiVersion: V3
                 requireClientCertificate: true`,
 			}),
 		)
@@ -185,3 +239,79 @@ var _ = Describe("CreateUpstreamTlsContext()", func() {
 		)
 	})
 })
+
+var _ = Describe("CreateCrossMeshDownstreamTlsContext()", func() {
+
+	It("should fail when mTLS is disabled on the Mesh of the proxy", func() {
+		// given
+		ctx := xds_context.Context{
+			Mesh: xds_context.MeshContext{
+				Resource: &core_mesh.MeshResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "default",
+					},
+					Spec: &mesh_proto.Mesh{},
+				},
+			},
+		}
+
+		// when
+		_, err := v3.CreateCrossMeshDownstreamTlsContext(ctx, []string{"default", "demo"})
+
+		// then
+		Expect(err).To(MatchError(`mTLS has to be enabled on mesh "default" to accept connections from other meshes`))
+	})
+
+	It("should accept the clients of all the given meshes", func() {
+		// given
+		ctx := xds_context.Context{
+			Mesh: xds_context.MeshContext{
+				Resource: &core_mesh.MeshResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "default",
+					},
+					Spec: &mesh_proto.Mesh{
+						Mtls: &mesh_proto.Mesh_Mtls{
+							EnabledBackend: "builtin",
+							Backends: []*mesh_proto.CertificateAuthorityBackend{
+								{
+									Name: "builtin",
+									Type: "builtin",
+								},
+							},
+						},
+					},
+				},
+			},
+			SecretsDir: "/var/run/kuma-dp/secrets",
+		}
+
+		// when
+		snippet, err := v3.CreateCrossMeshDownstreamTlsContext(ctx, []string{"default", "demo"})
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(snippet)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+                commonTlsContext:
+                  combinedValidationContext:
+                    defaultValidationContext:
+                      matchSubjectAltNames:
+                      - prefix: spiffe://default/
+                      - prefix: spiffe://demo/
+                    validationContextSdsSecretConfig:
+                      name: cross_mesh_ca
+                      sdsConfig:
+                        ads: {}
+                        resourceApiVersion: V3
+                  tlsCertificateSdsSecretConfigs:
+                  - name: identity_cert
+                    sdsConfig:
+                      path: /var/run/kuma-dp/secrets/identity_cert.yaml
+                      resourceApiVersion: V3
+                requireClientCertificate: true`))
+	})
+})
@@ -0,0 +1,125 @@
+package generator_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	. "github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+)
+
+var _ = Describe("AccessLogProxyGenerator", func() {
+
+	type testCase struct {
+		ctx      xds_context.Context
+		proxy    *core_xds.Proxy
+		expected string
+	}
+
+	dataplane := &core_mesh.DataplaneResource{
+		Meta: &test_model.ResourceMeta{
+			Name: "backend-01",
+			Mesh: "demo",
+		},
+		Spec: &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+			},
+		},
+	}
+
+	tcpBackend := &mesh_proto.LoggingBackend{
+		Name: "logstash",
+		Type: mesh_proto.LoggingTcpType,
+		Conf: util_proto.MustToStruct(&mesh_proto.TcpLoggingBackendConfig{
+			Address: "logstash:1234",
+		}),
+	}
+
+	grpcBackend := &mesh_proto.LoggingBackend{
+		Name: "als",
+		Type: mesh_proto.LoggingGrpcType,
+		Conf: util_proto.MustToStruct(&mesh_proto.GrpcLoggingBackendConfig{
+			Address: "als-collector.kuma-logging:9001",
+		}),
+	}
+
+	DescribeTable("should not generate Envoy xDS resources unless a gRPC logging backend is used",
+		func(given testCase) {
+			// setup
+			gen := &generator.AccessLogProxyGenerator{}
+
+			// when
+			rs, err := gen.Generate(given.ctx, given.proxy)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(rs).To(BeNil())
+		},
+		Entry("Dataplane has no TrafficLog", testCase{
+			proxy: &core_xds.Proxy{
+				Id:         *core_xds.BuildProxyId("", "demo.backend-01"),
+				Dataplane:  dataplane,
+				APIVersion: envoy_common.APIV3,
+			},
+		}),
+		Entry("Dataplane logs to a TCP backend", testCase{
+			proxy: &core_xds.Proxy{
+				Id:         *core_xds.BuildProxyId("", "demo.backend-01"),
+				Dataplane:  dataplane,
+				APIVersion: envoy_common.APIV3,
+				Policies: core_xds.MatchedPolicies{
+					Logs: core_xds.LogMap{
+						"web": tcpBackend,
+					},
+				},
+			},
+		}),
+	)
+
+	DescribeTable("should generate Envoy xDS resources if a gRPC logging backend is used",
+		func(given testCase) {
+			// given
+			gen := &generator.AccessLogProxyGenerator{}
+
+			// when
+			rs, err := gen.Generate(given.ctx, given.proxy)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			resp, err := rs.List().ToDeltaDiscoveryResponse()
+			Expect(err).ToNot(HaveOccurred())
+			actual, err := util_proto.ToYAML(resp)
+			Expect(err).ToNot(HaveOccurred())
+
+			// and output matches golden files
+			Expect(actual).To(MatchGoldenYAML(filepath.Join("testdata", "access-log", given.expected)))
+		},
+		Entry("should create a single cluster for a collector used by many services", testCase{
+			proxy: &core_xds.Proxy{
+				Id:         *core_xds.BuildProxyId("", "demo.backend-01"),
+				Dataplane:  dataplane,
+				APIVersion: envoy_common.APIV3,
+				Policies: core_xds.MatchedPolicies{
+					Logs: core_xds.LogMap{
+						"web":     grpcBackend,
+						"api":     grpcBackend,
+						"backend": tcpBackend,
+					},
+				},
+			},
+			expected: "grpc.envoy-config.golden.yaml",
+		}),
+	)
+})
@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
+)
+
+// OriginAccessLog is a marker to indicate by which ProxyGenerator resources were generated.
+const OriginAccessLog = "access-log"
+
+// AccessLogProxyGenerator generates the clusters of the gRPC Access Log
+// Service collectors that the access logs of a proxy are streamed to.
+// Other logging backends don't need clusters, because their logs are
+// written to a file or forwarded by kuma-dp.
+type AccessLogProxyGenerator struct {
+}
+
+var _ ResourceGenerator = AccessLogProxyGenerator{}
+
+func (a AccessLogProxyGenerator) Generate(_ xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
+	backends := map[string]*mesh_proto.LoggingBackend{}
+	for _, backend := range proxy.Policies.Logs {
+		if backend.GetType() == mesh_proto.LoggingGrpcType {
+			backends[backend.Name] = backend
+		}
+	}
+	if len(backends) == 0 {
+		return nil, nil
+	}
+
+	var backendNames []string
+	for name := range backends {
+		backendNames = append(backendNames, name)
+	}
+	sort.Strings(backendNames)
+
+	resources := core_xds.NewResourceSet()
+	for _, name := range backendNames {
+		res, err := AccessLogCollectorCluster(backends[name], proxy.APIVersion)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate access log collector cluster for backend %q", name)
+		}
+		resources.Add(res)
+	}
+
+	return resources, nil
+}
+
+// AccessLogCollectorCluster generates the cluster of the gRPC Access Log
+// Service collector of the given logging backend.
+func AccessLogCollectorCluster(backend *mesh_proto.LoggingBackend, apiVersion envoy.APIVersion) (*core_xds.Resource, error) {
+	cfg := mesh_proto.GrpcLoggingBackendConfig{}
+	if err := proto.ToTyped(backend.Conf, &cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend")
+	}
+	host, portStr, err := net.SplitHostPort(cfg.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid address of the collector")
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid port of the collector")
+	}
+
+	clusterName := names.GetAccessLogClusterName(backend.Name)
+	cluster, err := clusters.NewClusterBuilder(apiVersion).
+		Configure(clusters.DNSCluster(clusterName, host, uint32(port))).
+		Configure(clusters.Http2()).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &core_xds.Resource{
+		Name:     clusterName,
+		Origin:   OriginAccessLog,
+		Resource: cluster,
+	}, nil
+}
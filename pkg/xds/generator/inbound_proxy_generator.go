@@ -76,7 +76,12 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Pr
 					Configure(envoy_listeners.FaultInjection(proxy.Policies.FaultInjections[endpoint]...)).
 					Configure(envoy_listeners.RateLimit(proxy.Policies.RateLimits.Inbound[endpoint])).
 					Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend, service)).
-					Configure(envoy_listeners.HttpInboundRoutes(service, routes))
+					Configure(envoy_listeners.TracingSampling(proxy.Policies.TracingBackend, proxy.Policies.TracingSampling(), service)).
+					Configure(envoy_listeners.HeaderPropagation(ctx.Mesh.Resource.Spec.GetHeaderPropagation())).
+					Configure(envoy_listeners.HttpTap(ctx.Mesh.Resource.Spec.GetRecording())).
+					Configure(envoy_listeners.HttpInboundRoutes(service, routes)).
+					Configure(envoy_listeners.RequestId(ctx.Mesh.Resource.Spec.GetRequestId(), false)).
+					Configure(envoy_listeners.ForwardedHeaders(ctx.Mesh.Resource.Spec.GetForwardedHeaders(), false))
 			case core_mesh.ProtocolGRPC:
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(localClusterName, true)).
@@ -84,7 +89,12 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Pr
 					Configure(envoy_listeners.FaultInjection(proxy.Policies.FaultInjections[endpoint]...)).
 					Configure(envoy_listeners.RateLimit(proxy.Policies.RateLimits.Inbound[endpoint])).
 					Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend, service)).
-					Configure(envoy_listeners.HttpInboundRoutes(service, routes))
+					Configure(envoy_listeners.TracingSampling(proxy.Policies.TracingBackend, proxy.Policies.TracingSampling(), service)).
+					Configure(envoy_listeners.HeaderPropagation(ctx.Mesh.Resource.Spec.GetHeaderPropagation())).
+					Configure(envoy_listeners.HttpTap(ctx.Mesh.Resource.Spec.GetRecording())).
+					Configure(envoy_listeners.HttpInboundRoutes(service, routes)).
+					Configure(envoy_listeners.RequestId(ctx.Mesh.Resource.Spec.GetRequestId(), false)).
+					Configure(envoy_listeners.ForwardedHeaders(ctx.Mesh.Resource.Spec.GetForwardedHeaders(), false))
 			case core_mesh.ProtocolKafka:
 				filterChainBuilder.
 					Configure(envoy_listeners.Kafka(localClusterName)).
@@ -46,6 +46,7 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 
 	servicesAcc := envoy_common.NewServicesAccumulator(proxy.ServiceTLSReadiness)
 	splitCounter := &splitCounter{}
+	unhealthyEndpoints := unhealthyEndpointsOf(ctx.Mesh)
 
 	for _, outbound := range outbounds {
 		// Determine the list of destination subsets
@@ -57,10 +58,10 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 		clusters := routes.Clusters()
 		servicesAcc.Add(clusters...)
 
-		protocol := g.inferProtocol(proxy, clusters)
+		protocol := g.inferProtocol(proxy, unhealthyEndpoints, clusters)
 
 		// Generate listener
-		listener, err := g.generateLDS(proxy, routes, outbound, protocol)
+		listener, err := g.generateLDS(ctx, proxy, routes, outbound, protocol)
 		if err != nil {
 			return nil, err
 		}
@@ -74,7 +75,7 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 	services := servicesAcc.Services()
 
 	// Generate clusters. It cannot be generated on the fly with outbound loop because we need to know all subsets of the cluster for every service.
-	cdsResources, err := g.generateCDS(ctx, services, proxy)
+	cdsResources, err := g.generateCDS(ctx, services, proxy, unhealthyEndpoints)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +90,7 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 	return resources, nil
 }
 
-func (_ OutboundProxyGenerator) generateLDS(proxy *model.Proxy, routes envoy_common.Routes, outbound *mesh_proto.Dataplane_Networking_Outbound, protocol core_mesh.Protocol) (envoy_common.NamedResource, error) {
+func (_ OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.Proxy, routes envoy_common.Routes, outbound *mesh_proto.Dataplane_Networking_Outbound, protocol core_mesh.Protocol) (envoy_common.NamedResource, error) {
 	oface := proxy.Dataplane.Spec.Networking.ToOutboundInterface(outbound)
 	rateLimits := []*mesh_proto.RateLimit{}
 	if rateLimit, exists := proxy.Policies.RateLimits.Outbound[oface]; exists {
@@ -100,6 +101,10 @@ func (_ OutboundProxyGenerator) generateLDS(proxy *model.Proxy, routes envoy_com
 	serviceName := outbound.GetTagsIncludingLegacy()[mesh_proto.ServiceTag]
 	outboundListenerName := envoy_names.GetOutboundListenerName(oface.DataplaneIP, oface.DataplanePort)
 	retryPolicy := proxy.Policies.Retries[serviceName]
+	var routeName string
+	if route := proxy.Routing.TrafficRoutes[oface]; route != nil {
+		routeName = route.Meta.GetName()
+	}
 	var timeoutPolicyConf *mesh_proto.Timeout_Conf
 	if timeoutPolicy := proxy.Policies.Timeouts[oface]; timeoutPolicy != nil {
 		timeoutPolicyConf = timeoutPolicy.Spec.GetConf()
@@ -111,26 +116,35 @@ func (_ OutboundProxyGenerator) generateLDS(proxy *model.Proxy, routes envoy_com
 			filterChainBuilder.
 				Configure(envoy_listeners.HttpConnectionManager(serviceName, false)).
 				Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend, sourceService)).
-				Configure(envoy_listeners.HttpAccessLog(meshName, envoy_common.TrafficDirectionOutbound, sourceService, serviceName, proxy.Policies.Logs[serviceName], proxy)).
+				Configure(envoy_listeners.TracingSampling(proxy.Policies.TracingBackend, proxy.Policies.TracingSampling(), serviceName)).
+				Configure(envoy_listeners.HeaderPropagation(ctx.Mesh.Resource.Spec.GetHeaderPropagation())).
+				Configure(envoy_listeners.HttpAccessLog(meshName, envoy_common.TrafficDirectionOutbound, sourceService, serviceName, routeName, proxy.Policies.Logs[serviceName], proxy)).
 				Configure(envoy_listeners.HttpOutboundRoute(serviceName, routes, proxy.Dataplane.Spec.TagSet())).
 				Configure(envoy_listeners.RateLimit(rateLimits)).
 				Configure(envoy_listeners.Retry(retryPolicy, protocol)).
-				Configure(envoy_listeners.GrpcStats())
+				Configure(envoy_listeners.GrpcStats()).
+				Configure(envoy_listeners.RequestId(ctx.Mesh.Resource.Spec.GetRequestId(), false)).
+				Configure(envoy_listeners.ForwardedHeaders(ctx.Mesh.Resource.Spec.GetForwardedHeaders(), true))
 		case core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2:
 			filterChainBuilder.
 				Configure(envoy_listeners.HttpConnectionManager(serviceName, false)).
 				Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend, sourceService)).
+				Configure(envoy_listeners.TracingSampling(proxy.Policies.TracingBackend, proxy.Policies.TracingSampling(), serviceName)).
+				Configure(envoy_listeners.HeaderPropagation(ctx.Mesh.Resource.Spec.GetHeaderPropagation())).
 				Configure(envoy_listeners.RateLimit(rateLimits)).
 				Configure(envoy_listeners.HttpAccessLog(
 					meshName,
 					envoy_common.TrafficDirectionOutbound,
 					sourceService,
 					serviceName,
+					routeName,
 					proxy.Policies.Logs[serviceName],
 					proxy,
 				)).
 				Configure(envoy_listeners.HttpOutboundRoute(serviceName, routes, proxy.Dataplane.Spec.TagSet())).
-				Configure(envoy_listeners.Retry(retryPolicy, protocol))
+				Configure(envoy_listeners.Retry(retryPolicy, protocol)).
+				Configure(envoy_listeners.RequestId(ctx.Mesh.Resource.Spec.GetRequestId(), false)).
+				Configure(envoy_listeners.ForwardedHeaders(ctx.Mesh.Resource.Spec.GetForwardedHeaders(), true))
 		case core_mesh.ProtocolKafka:
 			filterChainBuilder.
 				Configure(envoy_listeners.Kafka(serviceName)).
@@ -140,6 +154,7 @@ func (_ OutboundProxyGenerator) generateLDS(proxy *model.Proxy, routes envoy_com
 					envoy_common.TrafficDirectionOutbound,
 					sourceService,
 					serviceName,
+					routeName,
 					proxy.Policies.Logs[serviceName],
 					proxy,
 				)).
@@ -156,6 +171,7 @@ func (_ OutboundProxyGenerator) generateLDS(proxy *model.Proxy, routes envoy_com
 					envoy_common.TrafficDirectionOutbound,
 					sourceService,
 					serviceName,
+					routeName,
 					proxy.Policies.Logs[serviceName],
 					proxy,
 				)).
@@ -177,13 +193,15 @@ func (_ OutboundProxyGenerator) generateLDS(proxy *model.Proxy, routes envoy_com
 	return listener, nil
 }
 
-func (o OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services envoy_common.Services, proxy *model.Proxy) (*model.ResourceSet, error) {
+func (o OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services envoy_common.Services, proxy *model.Proxy, unhealthyEndpoints model.EndpointMap) (*model.ResourceSet, error) {
 	resources := model.NewResourceSet()
+	outboundDefaults := ctx.Mesh.Resource.Spec.GetNetworking().GetOutbound()
 	for _, serviceName := range services.Sorted() {
 		service := services[serviceName]
 		healthCheck := proxy.Policies.HealthChecks[serviceName]
 		circuitBreaker := proxy.Policies.CircuitBreakers[serviceName]
-		protocol := o.inferProtocol(proxy, service.Clusters())
+		connectionPool := proxy.Policies.ConnectionPools[serviceName]
+		protocol := o.inferProtocol(proxy, unhealthyEndpoints, service.Clusters())
 		tlsReady := service.TLSReady()
 
 		for _, cluster := range service.Clusters() {
@@ -197,6 +215,7 @@ func (o OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 				edsClusterBuilder.
 					Configure(envoy_clusters.StrictDNSCluster(cluster.Name(), proxy.Routing.OutboundTargets[serviceName],
 						proxy.Dataplane.IsIPv6())).
+					Configure(envoy_clusters.DnsLookupFamily(outboundDefaults.GetDnsLookupFamily(), connectionPool)).
 					Configure(envoy_clusters.ClientSideTLS(proxy.Routing.OutboundTargets[serviceName]))
 				switch protocol {
 				case core_mesh.ProtocolHTTP:
@@ -212,6 +231,10 @@ func (o OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 					Configure(envoy_clusters.ClientSideMTLS(ctx, serviceName, tlsReady, []envoy_common.Tags{cluster.Tags()})).
 					Configure(envoy_clusters.Http2())
 			}
+			edsClusterBuilder.
+				Configure(envoy_clusters.TcpKeepalive(outboundDefaults.GetTcpKeepalive())).
+				Configure(envoy_clusters.ConnectionPool(protocol, connectionPool))
+
 			edsCluster, err := edsClusterBuilder.Build()
 			if err != nil {
 				return nil, errors.Wrapf(err, "build CDS for cluster %s failed", cluster.Name())
@@ -249,16 +272,41 @@ func (_ OutboundProxyGenerator) generateEDS(ctx xds_context.Context, services en
 }
 
 // inferProtocol infers protocol for the destination listener. It will only return HTTP when all endpoints are tagged with HTTP.
-func (_ OutboundProxyGenerator) inferProtocol(proxy *model.Proxy, clusters []envoy_common.Cluster) core_mesh.Protocol {
+// Unhealthy endpoints are taken into account as well, so that a change of the health of endpoints
+// does not switch the listener and the clusters of the service between TCP and HTTP.
+func (_ OutboundProxyGenerator) inferProtocol(proxy *model.Proxy, unhealthyEndpoints model.EndpointMap, clusters []envoy_common.Cluster) core_mesh.Protocol {
 	var allEndpoints []model.Endpoint
 	for _, cluster := range clusters {
 		serviceName := cluster.Tags()[mesh_proto.ServiceTag]
 		endpoints := model.EndpointList(proxy.Routing.OutboundTargets[serviceName])
 		allEndpoints = append(allEndpoints, endpoints...)
+		allEndpoints = append(allEndpoints, unhealthyEndpoints[serviceName]...)
 	}
 	return InferServiceProtocol(allEndpoints)
 }
 
+// unhealthyEndpointsOf returns endpoints of unhealthy inbounds of the dataplanes in the mesh, which are not included in outbound targets.
+func unhealthyEndpointsOf(meshCtx xds_context.MeshContext) model.EndpointMap {
+	endpoints := model.EndpointMap{}
+	if meshCtx.Dataplanes == nil {
+		return endpoints
+	}
+	for _, dataplane := range meshCtx.Dataplanes.Items {
+		if dataplane.Spec.IsIngress() {
+			continue
+		}
+		for _, inbound := range dataplane.Spec.GetNetworking().GetInbound() {
+			if inbound.GetHealth() == nil || inbound.GetHealth().GetReady() {
+				continue
+			}
+			endpoints[inbound.GetService()] = append(endpoints[inbound.GetService()], model.Endpoint{
+				Tags: inbound.GetTags(),
+			})
+		}
+	}
+	return endpoints
+}
+
 func (_ OutboundProxyGenerator) determineRoutes(proxy *model.Proxy, outbound *mesh_proto.Dataplane_Networking_Outbound, splitCounter *splitCounter) (envoy_common.Routes, error) {
 	var routes envoy_common.Routes
 	oface := proxy.Dataplane.Spec.Networking.ToOutboundInterface(outbound)
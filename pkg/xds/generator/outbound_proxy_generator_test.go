@@ -556,4 +556,77 @@ var _ = Describe("OutboundProxyGenerator", func() {
 		// and output matches golden files
 		Expect(actual).To(MatchGoldenYAML(filepath.Join("testdata", "outbound-proxy", "cluster-dots.envoy.golden.yaml")))
 	})
+
+	It("should keep the protocol of the listener when all endpoints of the service are unhealthy", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dataplane := &mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(`
+        networking:
+          outbound:
+          - port: 18080
+            tags:
+              kuma.io/service: backend`), dataplane)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: *model.BuildProxyId("default", "side-car"),
+			Dataplane: &core_mesh.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			APIVersion: envoy_common.APIV3,
+			Routing: model.Routing{
+				TrafficRoutes: model.RouteMap{
+					mesh_proto.OutboundInterface{
+						DataplaneIP:   "127.0.0.1",
+						DataplanePort: 18080,
+					}: &core_mesh.TrafficRouteResource{
+						Spec: &mesh_proto.TrafficRoute{
+							Conf: &mesh_proto.TrafficRoute_Conf{
+								Destination: mesh_proto.MatchService("backend"),
+							},
+						},
+					},
+				},
+				OutboundTargets: model.EndpointMap{}, // the only endpoint of backend is unhealthy
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		backend := &mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(`
+        networking:
+          address: 192.168.0.1
+          inbound:
+          - port: 8080
+            health:
+              ready: false
+            tags:
+              kuma.io/service: backend
+              kuma.io/protocol: http`), backend)).To(Succeed())
+		ctx := xds_context.Context{
+			ControlPlane: &xds_context.ControlPlaneContext{
+				CLACache: &dummyCLACache{outboundTargets: model.EndpointMap{}},
+			},
+			Mesh: xds_context.MeshContext{
+				Resource: plainCtx.Mesh.Resource,
+				Dataplanes: &core_mesh.DataplaneResourceList{
+					Items: []*core_mesh.DataplaneResource{{Meta: &test_model.ResourceMeta{Name: "backend-01"}, Spec: backend}},
+				},
+			},
+		}
+
+		// when
+		rs, err := gen.Generate(ctx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := rs.List().ToDeltaDiscoveryResponse()
+		Expect(err).ToNot(HaveOccurred())
+		actual, err := util_proto.ToYAML(resp)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(actual)).To(ContainSubstring("envoy.filters.network.http_connection_manager"))
+	})
 })
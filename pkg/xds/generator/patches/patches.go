@@ -0,0 +1,212 @@
+package patches
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var resourceTypes = map[string]string{
+	mesh_proto.PatchTypeListener:              envoy_resource.ListenerType,
+	mesh_proto.PatchTypeCluster:               envoy_resource.ClusterType,
+	mesh_proto.PatchTypeRouteConfiguration:    envoy_resource.RouteType,
+	mesh_proto.PatchTypeClusterLoadAssignment: envoy_resource.EndpointType,
+}
+
+// AppliedPatch is a patch of a ProxyPatch that was applied to a generated resource.
+type AppliedPatch struct {
+	Policy   string `json:"policy"`
+	Index    int    `json:"index"`
+	Type     string `json:"type"`
+	Resource string `json:"resource"`
+}
+
+// Conflict is a patch of a ProxyPatch that was skipped, because it modifies a path of a generated resource
+// that was already modified by a more specific ProxyPatch.
+type Conflict struct {
+	Policy        string `json:"policy"`
+	Index         int    `json:"index"`
+	Type          string `json:"type"`
+	Resource      string `json:"resource"`
+	Path          string `json:"path"`
+	ConflictsWith string `json:"conflictsWith"`
+}
+
+type Result struct {
+	Applied   []AppliedPatch `json:"applied"`
+	Conflicts []Conflict     `json:"conflicts"`
+}
+
+// Apply applies patches of the given ProxyPatches to the generated resources.
+// Policies are expected to be ordered from the most specific one. When patches of two different policies modify
+// the same path of a resource, the patch of the more specific policy is applied and the other one is reported as a conflict.
+func Apply(resources *core_xds.ResourceSet, policies []*core_mesh.ProxyPatchResource) (Result, error) {
+	result := Result{}
+	owners := map[string]map[string]string{} // type/name of a resource -> modified path -> policy
+	for _, policy := range policies {
+		for i, patch := range policy.Spec.GetConf().GetPatches() {
+			typ, ok := resourceTypes[patch.GetMatch().GetType()]
+			if !ok {
+				return Result{}, errors.Errorf("could not apply %d patch of %s: invalid type %q", i, policy.GetMeta().GetName(), patch.GetMatch().GetType())
+			}
+			paths := modifiedPaths(patch)
+			for _, resource := range matchingResources(resources, typ, patch.GetMatch()) {
+				key := typ + "/" + resource.Name
+				if path, owner, conflict := findConflict(owners[key], paths, policy.GetMeta().GetName()); conflict {
+					result.Conflicts = append(result.Conflicts, Conflict{
+						Policy:        policy.GetMeta().GetName(),
+						Index:         i,
+						Type:          patch.GetMatch().GetType(),
+						Resource:      resource.Name,
+						Path:          path,
+						ConflictsWith: owner,
+					})
+					continue
+				}
+				if err := applyPatch(resource, patch); err != nil {
+					return Result{}, errors.Wrapf(err, "could not apply %d patch of %s to %s", i, policy.GetMeta().GetName(), resource.Name)
+				}
+				if owners[key] == nil {
+					owners[key] = map[string]string{}
+				}
+				for _, path := range paths {
+					owners[key][path] = policy.GetMeta().GetName()
+				}
+				result.Applied = append(result.Applied, AppliedPatch{
+					Policy:   policy.GetMeta().GetName(),
+					Index:    i,
+					Type:     patch.GetMatch().GetType(),
+					Resource: resource.Name,
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+func matchingResources(resources *core_xds.ResourceSet, typ string, match *mesh_proto.ProxyPatch_Patch_Match) []*core_xds.Resource {
+	var matching core_xds.ResourceList
+	for _, resource := range resources.Resources(typ) {
+		if match.GetName() != "" && match.GetName() != resource.Name {
+			continue
+		}
+		if match.GetOrigin() != "" && match.GetOrigin() != resource.Origin {
+			continue
+		}
+		matching = append(matching, resource)
+	}
+	sort.Stable(matching)
+	return matching
+}
+
+func findConflict(owners map[string]string, paths []string, policy string) (string, string, bool) {
+	ownedPaths := make([]string, 0, len(owners))
+	for ownedPath := range owners {
+		ownedPaths = append(ownedPaths, ownedPath)
+	}
+	sort.Strings(ownedPaths)
+	for _, path := range paths {
+		for _, ownedPath := range ownedPaths {
+			if owner := owners[ownedPath]; owner != policy && overlaps(path, ownedPath) {
+				return path, owner, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// overlaps returns true if one of the JSON Pointers points to the same value as the other one or to a value nested in it.
+func overlaps(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+func modifiedPaths(patch *mesh_proto.ProxyPatch_Patch) []string {
+	var paths []string
+	for _, operation := range patch.GetJsonPatch() {
+		switch operation.GetOp() {
+		case mesh_proto.JsonPatchOpTest:
+		case mesh_proto.JsonPatchOpMove:
+			paths = append(paths, operation.GetFrom(), operation.GetPath())
+		default:
+			paths = append(paths, operation.GetPath())
+		}
+	}
+	if patch.GetMergePatch() != nil {
+		paths = append(paths, mergePatchPaths("", patch.GetMergePatch().AsMap())...)
+	}
+	return paths
+}
+
+func mergePatchPaths(prefix string, patch map[string]interface{}) []string {
+	var paths []string
+	for key, value := range patch {
+		path := prefix + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+		if nested, ok := value.(map[string]interface{}); ok && len(nested) > 0 {
+			paths = append(paths, mergePatchPaths(path, nested)...)
+		} else {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func applyPatch(resource *core_xds.Resource, patch *mesh_proto.ProxyPatch_Patch) error {
+	doc, err := util_proto.ToJSON(resource.Resource)
+	if err != nil {
+		return err
+	}
+	if len(patch.GetJsonPatch()) > 0 {
+		var operations []map[string]interface{}
+		for _, operation := range patch.GetJsonPatch() {
+			op := map[string]interface{}{
+				"op":   operation.GetOp(),
+				"path": operation.GetPath(),
+			}
+			if operation.GetFrom() != "" {
+				op["from"] = operation.GetFrom()
+			}
+			if operation.GetValue() != nil {
+				op["value"] = operation.GetValue().AsInterface()
+			}
+			operations = append(operations, op)
+		}
+		operationsJSON, err := json.Marshal(operations)
+		if err != nil {
+			return err
+		}
+		jsonPatch, err := jsonpatch.DecodePatch(operationsJSON)
+		if err != nil {
+			return err
+		}
+		if doc, err = jsonPatch.Apply(doc); err != nil {
+			return err
+		}
+	}
+	if patch.GetMergePatch() != nil {
+		mergePatchJSON, err := json.Marshal(patch.GetMergePatch().AsMap())
+		if err != nil {
+			return err
+		}
+		if doc, err = jsonpatch.MergePatch(doc, mergePatchJSON); err != nil {
+			return err
+		}
+	}
+	patched := proto.Clone(resource.Resource)
+	patched.Reset()
+	if err := util_proto.FromJSON(doc, patched); err != nil {
+		return err
+	}
+	resource.Resource = patched
+	return nil
+}
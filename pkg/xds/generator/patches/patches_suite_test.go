@@ -0,0 +1,12 @@
+package patches_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+	_ "github.com/kumahq/kuma/pkg/xds/envoy"
+)
+
+func TestPatches(t *testing.T) {
+	test.RunSpecs(t, "Patches Suite")
+}
@@ -0,0 +1,270 @@
+package patches_test
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+	"github.com/kumahq/kuma/pkg/xds/generator/patches"
+)
+
+var _ = Describe("Apply()", func() {
+
+	type testCase struct {
+		clusters       []string
+		policies       []string
+		expected       string
+		expectedResult patches.Result
+	}
+
+	clustersToSet := func(clusters []string) *core_xds.ResourceSet {
+		set := core_xds.NewResourceSet()
+		for _, clusterYAML := range clusters {
+			cluster := &envoy_cluster.Cluster{}
+			err := util_proto.FromYAML([]byte(clusterYAML), cluster)
+			Expect(err).ToNot(HaveOccurred())
+			set.Add(&core_xds.Resource{
+				Name:     cluster.Name,
+				Origin:   generator.OriginOutbound,
+				Resource: cluster,
+			})
+		}
+		return set
+	}
+
+	policiesFromYAML := func(policies []string) []*core_mesh.ProxyPatchResource {
+		var resources []*core_mesh.ProxyPatchResource
+		for i, policyYAML := range policies {
+			policy := core_mesh.NewProxyPatchResource()
+			err := util_proto.FromYAML([]byte(policyYAML), policy.Spec)
+			Expect(err).ToNot(HaveOccurred())
+			policy.SetMeta(&test_model.ResourceMeta{
+				Mesh: "default",
+				Name: []string{"first", "second", "third"}[i],
+			})
+			resources = append(resources, policy)
+		}
+		return resources
+	}
+
+	DescribeTable("should apply patches",
+		func(given testCase) {
+			// given
+			set := clustersToSet(given.clusters)
+
+			// when
+			result, err := patches.Apply(set, policiesFromYAML(given.policies))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(given.expectedResult))
+			resp, err := set.List().ToDeltaDiscoveryResponse()
+			Expect(err).ToNot(HaveOccurred())
+			actual, err := util_proto.ToYAML(resp)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("should apply json patch to a matching cluster", testCase{
+			clusters: []string{`
+                name: backend
+                connectTimeout: 5s
+                altStatName: backend`, `
+                name: web
+                connectTimeout: 5s`,
+			},
+			policies: []string{`
+                conf:
+                  patches:
+                  - match:
+                      type: Cluster
+                      name: backend
+                    jsonPatch:
+                    - op: replace
+                      path: /connectTimeout
+                      value: 10s
+                    - op: remove
+                      path: /altStatName`,
+			},
+			expected: `
+            resources:
+            - name: backend
+              resource:
+                '@type': type.googleapis.com/envoy.config.cluster.v3.Cluster
+                connectTimeout: 10s
+                name: backend
+            - name: web
+              resource:
+                '@type': type.googleapis.com/envoy.config.cluster.v3.Cluster
+                connectTimeout: 5s
+                name: web`,
+			expectedResult: patches.Result{
+				Applied: []patches.AppliedPatch{
+					{Policy: "first", Index: 0, Type: "Cluster", Resource: "backend"},
+				},
+			},
+		}),
+		Entry("should apply merge patch to all clusters of the origin", testCase{
+			clusters: []string{`
+                name: backend
+                connectTimeout: 5s`, `
+                name: web
+                connectTimeout: 5s`,
+			},
+			policies: []string{`
+                conf:
+                  patches:
+                  - match:
+                      type: Cluster
+                      origin: outbound
+                    mergePatch:
+                      perConnectionBufferLimitBytes: 32768
+                      commonHttpProtocolOptions:
+                        idleTimeout: 60s`,
+			},
+			expected: `
+            resources:
+            - name: backend
+              resource:
+                '@type': type.googleapis.com/envoy.config.cluster.v3.Cluster
+                commonHttpProtocolOptions:
+                  idleTimeout: 60s
+                connectTimeout: 5s
+                name: backend
+                perConnectionBufferLimitBytes: 32768
+            - name: web
+              resource:
+                '@type': type.googleapis.com/envoy.config.cluster.v3.Cluster
+                commonHttpProtocolOptions:
+                  idleTimeout: 60s
+                connectTimeout: 5s
+                name: web
+                perConnectionBufferLimitBytes: 32768`,
+			expectedResult: patches.Result{
+				Applied: []patches.AppliedPatch{
+					{Policy: "first", Index: 0, Type: "Cluster", Resource: "backend"},
+					{Policy: "first", Index: 0, Type: "Cluster", Resource: "web"},
+				},
+			},
+		}),
+		Entry("should skip conflicting patch of less specific policy", testCase{
+			clusters: []string{`
+                name: backend
+                connectTimeout: 5s`,
+			},
+			policies: []string{`
+                conf:
+                  patches:
+                  - match:
+                      type: Cluster
+                    mergePatch:
+                      commonHttpProtocolOptions:
+                        idleTimeout: 60s`, `
+                conf:
+                  patches:
+                  - match:
+                      type: Cluster
+                    jsonPatch:
+                    - op: add
+                      path: /commonHttpProtocolOptions
+                      value:
+                        idleTimeout: 30s
+                  - match:
+                      type: Cluster
+                    jsonPatch:
+                    - op: replace
+                      path: /connectTimeout
+                      value: 10s`,
+			},
+			expected: `
+            resources:
+            - name: backend
+              resource:
+                '@type': type.googleapis.com/envoy.config.cluster.v3.Cluster
+                commonHttpProtocolOptions:
+                  idleTimeout: 60s
+                connectTimeout: 10s
+                name: backend`,
+			expectedResult: patches.Result{
+				Applied: []patches.AppliedPatch{
+					{Policy: "first", Index: 0, Type: "Cluster", Resource: "backend"},
+					{Policy: "second", Index: 1, Type: "Cluster", Resource: "backend"},
+				},
+				Conflicts: []patches.Conflict{
+					{
+						Policy:        "second",
+						Index:         0,
+						Type:          "Cluster",
+						Resource:      "backend",
+						Path:          "/commonHttpProtocolOptions",
+						ConflictsWith: "first",
+					},
+				},
+			},
+		}),
+		Entry("should not report patches of the same policy as conflicts", testCase{
+			clusters: []string{`
+                name: backend
+                connectTimeout: 5s`,
+			},
+			policies: []string{`
+                conf:
+                  patches:
+                  - match:
+                      type: Cluster
+                    jsonPatch:
+                    - op: replace
+                      path: /connectTimeout
+                      value: 10s
+                  - match:
+                      type: Cluster
+                    jsonPatch:
+                    - op: replace
+                      path: /connectTimeout
+                      value: 15s`,
+			},
+			expected: `
+            resources:
+            - name: backend
+              resource:
+                '@type': type.googleapis.com/envoy.config.cluster.v3.Cluster
+                connectTimeout: 15s
+                name: backend`,
+			expectedResult: patches.Result{
+				Applied: []patches.AppliedPatch{
+					{Policy: "first", Index: 0, Type: "Cluster", Resource: "backend"},
+					{Policy: "first", Index: 1, Type: "Cluster", Resource: "backend"},
+				},
+			},
+		}),
+	)
+
+	It("should return an error when json patch cannot be applied", func() {
+		// given
+		set := clustersToSet([]string{`
+            name: backend
+            connectTimeout: 5s`,
+		})
+		policies := policiesFromYAML([]string{`
+            conf:
+              patches:
+              - match:
+                  type: Cluster
+                jsonPatch:
+                - op: remove
+                  path: /circuitBreakers`,
+		})
+
+		// when
+		_, err := patches.Apply(set, policies)
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("could not apply 0 patch of first to backend"))
+	})
+})
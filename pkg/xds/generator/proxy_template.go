@@ -86,6 +86,7 @@ func NewDefaultProxyProfile() ResourceGenerator {
 		OutboundProxyGenerator{},
 		DirectAccessProxyGenerator{},
 		TracingProxyGenerator{},
+		AccessLogProxyGenerator{},
 		ProbeProxyGenerator{},
 		DNSGenerator{},
 	}
@@ -22,6 +22,10 @@ func (s SecretsProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 	if err != nil {
 		return nil, err
 	}
+	if ctx.SecretsDir != "" {
+		// kuma-dp delivers the secrets to Envoy as files, we only need to make sure they are generated
+		return nil, nil
+	}
 
 	resources := core_xds.NewResourceSet()
 	identitySecret := envoy_secrets.CreateIdentitySecret(identity)
@@ -51,6 +51,39 @@ var _ = Describe("SecretsGenerator", func() {
 				APIVersion: envoy_common.APIV3,
 			},
 		}),
+		Entry("secrets are delivered as files by kuma-dp", testCase{
+			ctx: xds_context.Context{
+				ControlPlane: &xds_context.ControlPlaneContext{
+					Secrets: &xds.TestSecrets{},
+				},
+				Mesh: xds_context.MeshContext{
+					Resource: &core_mesh.MeshResource{
+						Spec: &mesh_proto.Mesh{
+							Mtls: &mesh_proto.Mesh_Mtls{
+								EnabledBackend: "ca-1",
+								Backends: []*mesh_proto.CertificateAuthorityBackend{
+									{
+										Name: "ca-1",
+										Type: "builtin",
+									},
+								},
+							},
+						},
+					},
+				},
+				SecretsDir: "/var/run/kuma-dp/secrets",
+			},
+			proxy: &core_xds.Proxy{
+				Id: *core_xds.BuildProxyId("", "demo.backend-01"),
+				Dataplane: &core_mesh.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: "demo",
+					},
+				},
+				APIVersion: envoy_common.APIV3,
+			},
+		}),
 	)
 
 	DescribeTable("should generate Envoy xDS resources if secret backend is present",
@@ -74,11 +74,19 @@ func (_ TransparentProxyGenerator) generate(ctx xds_context.Context, proxy *mode
 		}
 	}
 
+	outboundPortRanges, outboundPortRangeClusters, err := outboundPortRangeFilterChains(ctx, proxy, allIP == allIPv6)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate outbound port ranges")
+	}
+	resources.AddSet(outboundPortRangeClusters)
+
 	outboundListener, err = envoy_listeners.NewListenerBuilder(proxy.APIVersion).
 		Configure(envoy_listeners.OutboundListener(outboundName, allIP, redirectPortOutbound, model.SocketAddressProtocolTCP)).
-		Configure(envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
+		Configure(passThroughFilterChain(envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
 			Configure(envoy_listeners.TcpProxy(outboundName, envoy_common.NewCluster(envoy_common.WithService(outboundName)))).
-			Configure(envoy_listeners.NetworkAccessLog(meshName, envoy_common.TrafficDirectionUnspecified, sourceService, "external", proxy.Policies.Logs[core_mesh.PassThroughService], proxy)))).
+			Configure(envoy_listeners.NetworkAccessLog(meshName, envoy_common.TrafficDirectionUnspecified, sourceService, "external", "", proxy.Policies.Logs[core_mesh.PassThroughService], proxy)),
+			outboundPortRanges)).
+		Configure(outboundPortRanges...).
 		Configure(envoy_listeners.OriginalDstForwarder()).
 		Build()
 	if err != nil {
@@ -93,10 +101,17 @@ func (_ TransparentProxyGenerator) generate(ctx xds_context.Context, proxy *mode
 		return nil, errors.Wrapf(err, "could not generate cluster: %s", inboundName)
 	}
 
+	inboundPortRanges, err := inboundPortRangeFilterChains(ctx, proxy, inboundName, allIP == allIPv6)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate inbound port ranges")
+	}
+
 	inboundListener, err := envoy_listeners.NewListenerBuilder(proxy.APIVersion).
 		Configure(envoy_listeners.InboundListener(inboundName, allIP, redirectPortInbound, model.SocketAddressProtocolTCP)).
-		Configure(envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
-			Configure(envoy_listeners.TcpProxy(inboundName, envoy_common.NewCluster(envoy_common.WithService(inboundName)))))).
+		Configure(passThroughFilterChain(envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
+			Configure(envoy_listeners.TcpProxy(inboundName, envoy_common.NewCluster(envoy_common.WithService(inboundName)))),
+			inboundPortRanges)).
+		Configure(inboundPortRanges...).
 		Configure(envoy_listeners.OriginalDstForwarder()).
 		Build()
 	if err != nil {
@@ -21,8 +21,9 @@ import (
 var _ = Describe("TransparentProxyGenerator", func() {
 
 	type testCase struct {
-		proxy    *model.Proxy
-		expected string
+		proxy      *model.Proxy
+		dataplanes []*core_mesh.DataplaneResource
+		expected   string
 	}
 
 	DescribeTable("Generate Envoy xDS resources",
@@ -37,6 +38,9 @@ var _ = Describe("TransparentProxyGenerator", func() {
 						},
 						Spec: &mesh_proto.Mesh{},
 					},
+					Dataplanes: &core_mesh.DataplaneResourceList{
+						Items: given.dataplanes,
+					},
 				},
 			}
 
@@ -160,5 +164,65 @@ var _ = Describe("TransparentProxyGenerator", func() {
 			},
 			expected: "04.envoy.golden.yaml",
 		}),
+		Entry("transparent_proxying=true with port ranges", testCase{
+			proxy: &model.Proxy{
+				Id: *model.BuildProxyId("default", "side-car"),
+				Dataplane: &core_mesh.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Mesh:    "default",
+						Name:    "side-car",
+						Version: "v1",
+					},
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "192.168.0.1",
+							Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+								{Port: 21, Tags: map[string]string{mesh_proto.ServiceTag: "ftp"}},
+							},
+							InboundPortRanges: []*mesh_proto.Dataplane_Networking_InboundPortRange{
+								{Ports: &mesh_proto.Dataplane_Networking_PortRange{From: 30000, To: 30001}},
+							},
+							OutboundPortRanges: []*mesh_proto.Dataplane_Networking_OutboundPortRange{
+								{
+									Ports: &mesh_proto.Dataplane_Networking_PortRange{From: 10000, To: 10001},
+									Tags:  map[string]string{mesh_proto.ServiceTag: "media"},
+								},
+							},
+							TransparentProxying: &mesh_proto.Dataplane_Networking_TransparentProxying{
+								RedirectPortOutbound: 15001,
+								RedirectPortInbound:  15006,
+							},
+						},
+					},
+				},
+				APIVersion: envoy_common.APIV3,
+			},
+			dataplanes: []*core_mesh.DataplaneResource{
+				portRangeDataplane("media-1", "192.168.0.2", "media", 10001, 10002),
+				portRangeDataplane("media-2", "192.168.0.3", "media", 9000, 20000),
+				portRangeDataplane("web-1", "192.168.0.4", "web", 10000, 10001),
+			},
+			expected: "05.envoy.golden.yaml",
+		}),
 	)
 })
+
+func portRangeDataplane(name string, address string, service string, from uint32, to uint32) *core_mesh.DataplaneResource {
+	return &core_mesh.DataplaneResource{
+		Meta: &test_model.ResourceMeta{
+			Mesh: "default",
+			Name: name,
+		},
+		Spec: &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: address,
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+					{Port: 5060, Tags: map[string]string{mesh_proto.ServiceTag: service}},
+				},
+				InboundPortRanges: []*mesh_proto.Dataplane_Networking_InboundPortRange{
+					{Ports: &mesh_proto.Dataplane_Networking_PortRange{From: from, To: to}},
+				},
+			},
+		},
+	}
+}
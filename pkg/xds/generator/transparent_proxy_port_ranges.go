@@ -0,0 +1,192 @@
+package generator
+
+import (
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	model "github.com/kumahq/kuma/pkg/core/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	envoy_clusters "github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
+	xds_tls "github.com/kumahq/kuma/pkg/xds/envoy/tls"
+)
+
+// Port ranges are served by the passthrough listeners, to which all the traffic is redirected by transparent proxying.
+// Instead of a listener per port, every port of a range is matched by a filter chain of the passthrough listener,
+// which is a cheap hash lookup in Envoy.
+
+func isIPv6(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.To4() == nil
+}
+
+func portRangeKey(address string, port uint32) string {
+	return net.JoinHostPort(address, strconv.FormatUint(uint64(port), 10))
+}
+
+// passThroughFilterChain configures the passthrough filter chain of the listener. When the listener has filter chains of port ranges,
+// it is the default filter chain, so connections to ports of the ranges on other addresses are still passed through.
+func passThroughFilterChain(builder *envoy_listeners.FilterChainBuilder, portRanges []envoy_listeners.ListenerBuilderOpt) envoy_listeners.ListenerBuilderOpt {
+	if len(portRanges) > 0 {
+		return envoy_listeners.DefaultFilterChain(builder)
+	}
+	return envoy_listeners.FilterChain(builder)
+}
+
+// inboundPortRangeFilterChains generates filter chains of the inbound passthrough listener for every port of the inbound port ranges.
+// Connections are forwarded to the same port of the application through the inbound passthrough cluster.
+func inboundPortRangeFilterChains(ctx xds_context.Context, proxy *model.Proxy, inboundPassThroughCluster string, ipv6 bool) ([]envoy_listeners.ListenerBuilderOpt, error) {
+	networking := proxy.Dataplane.Spec.GetNetworking()
+	if len(networking.GetInboundPortRanges()) == 0 {
+		return nil, nil
+	}
+
+	// port ranges are not services, so the TrafficPermissions of the service of the dataplane are applied
+	var permission *core_mesh.TrafficPermissionResource
+	ifaces, err := networking.GetInboundInterfaces()
+	if err != nil {
+		return nil, err
+	}
+	if len(ifaces) > 0 {
+		permission = proxy.Policies.TrafficPermissions[ifaces[0]]
+	}
+
+	var opts []envoy_listeners.ListenerBuilderOpt
+	mode := ctx.Mesh.Resource.GetEnabledCertificateAuthorityBackend().GetMode()
+	if mode == mesh_proto.CertificateAuthorityBackend_PERMISSIVE {
+		opts = append(opts, envoy_listeners.TLSInspector())
+	}
+	matched := map[string]bool{}
+	for _, portRange := range networking.GetInboundPortRanges() {
+		address := networking.InboundPortRangeAddress(portRange)
+		if isIPv6(address) != ipv6 {
+			continue
+		}
+		from, to := portRange.GetPorts().GetFrom(), portRange.GetPorts().GetTo()
+		name := envoy_names.GetInboundPortRangeName(address, from, to)
+		for port := from; port <= to; port++ {
+			key := portRangeKey(address, port)
+			if matched[key] {
+				continue
+			}
+			matched[key] = true
+
+			filterChainBuilder := func(serverSideMTLS bool) *envoy_listeners.FilterChainBuilder {
+				filterChainBuilder := envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
+					Configure(envoy_listeners.MatchDestinationAddresses(address)).
+					Configure(envoy_listeners.MatchDestinationPort(port)).
+					Configure(envoy_listeners.TcpProxy(name, envoy_common.NewCluster(envoy_common.WithService(inboundPassThroughCluster))))
+				if serverSideMTLS {
+					filterChainBuilder.Configure(envoy_listeners.ServerSideMTLS(ctx))
+				}
+				return filterChainBuilder.
+					Configure(envoy_listeners.NetworkRBAC(name, ctx.Mesh.Resource.MTLSEnabled(), permission))
+			}
+
+			switch mode {
+			case mesh_proto.CertificateAuthorityBackend_STRICT:
+				opts = append(opts, envoy_listeners.FilterChain(filterChainBuilder(true)))
+			case mesh_proto.CertificateAuthorityBackend_PERMISSIVE:
+				opts = append(opts,
+					envoy_listeners.FilterChain(filterChainBuilder(false).Configure(
+						envoy_listeners.MatchTransportProtocol("raw_buffer"))),
+					envoy_listeners.FilterChain(filterChainBuilder(false).Configure(
+						envoy_listeners.MatchTransportProtocol("tls"))),
+					envoy_listeners.FilterChain(filterChainBuilder(true).Configure(
+						envoy_listeners.MatchTransportProtocol("tls"),
+						envoy_listeners.MatchApplicationProtocols(xds_tls.KumaALPNProtocols...))),
+				)
+			default:
+				return nil, errors.New("unknown mode for CA backend")
+			}
+		}
+	}
+	return opts, nil
+}
+
+// outboundPortRangeFilterChains generates filter chains of the outbound passthrough listener for every port of the outbound port ranges
+// that is exposed by an inbound port range of a dataplane of the service. Connections are forwarded to the same address and port
+// through an original destination cluster secured by mTLS.
+func outboundPortRangeFilterChains(ctx xds_context.Context, proxy *model.Proxy, ipv6 bool) ([]envoy_listeners.ListenerBuilderOpt, *model.ResourceSet, error) {
+	resources := model.NewResourceSet()
+	networking := proxy.Dataplane.Spec.GetNetworking()
+	if len(networking.GetOutboundPortRanges()) == 0 || ctx.Mesh.Dataplanes == nil {
+		return nil, resources, nil
+	}
+
+	var opts []envoy_listeners.ListenerBuilderOpt
+	matched := map[string]bool{}
+	for _, portRange := range networking.GetOutboundPortRanges() {
+		from, to := portRange.GetPorts().GetFrom(), portRange.GetPorts().GetTo()
+		addressesByPort := map[uint32][]string{}
+		for _, dataplane := range ctx.Mesh.Dataplanes.Items {
+			if core_model.MetaToResourceKey(dataplane.GetMeta()) == core_model.MetaToResourceKey(proxy.Dataplane.GetMeta()) ||
+				!dataplane.Spec.Matches(portRange.GetTags()) {
+				continue
+			}
+			for _, inboundRange := range dataplane.Spec.GetNetworking().GetInboundPortRanges() {
+				address := dataplane.Spec.GetNetworking().InboundPortRangeAddress(inboundRange)
+				if isIPv6(address) != ipv6 {
+					continue
+				}
+				// only ports that are in both ranges are reachable
+				first, last := inboundRange.GetPorts().GetFrom(), inboundRange.GetPorts().GetTo()
+				if first < from {
+					first = from
+				}
+				if last > to {
+					last = to
+				}
+				for port := first; port <= last; port++ {
+					key := portRangeKey(address, port)
+					if matched[key] {
+						continue
+					}
+					matched[key] = true
+					addressesByPort[port] = append(addressesByPort[port], address)
+				}
+			}
+		}
+		if len(addressesByPort) == 0 {
+			continue
+		}
+
+		service := portRange.GetTags()[mesh_proto.ServiceTag]
+		name := envoy_names.GetOutboundPortRangeName(service, from, to)
+		cluster, err := envoy_clusters.NewClusterBuilder(proxy.APIVersion).
+			Configure(envoy_clusters.PassThroughCluster(name)).
+			Configure(envoy_clusters.ClientSideMTLS(ctx, service, true, []envoy_common.Tags{portRange.GetTags()})).
+			Build()
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not generate cluster: %s", name)
+		}
+		resources.Add(&model.Resource{
+			Name:     name,
+			Origin:   OriginTransparent,
+			Resource: cluster,
+		})
+
+		var ports []uint32
+		for port := range addressesByPort {
+			ports = append(ports, port)
+		}
+		sort.Slice(ports, func(i, j int) bool {
+			return ports[i] < ports[j]
+		})
+		for _, port := range ports {
+			opts = append(opts, envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
+				Configure(envoy_listeners.MatchDestinationAddresses(addressesByPort[port]...)).
+				Configure(envoy_listeners.MatchDestinationPort(port)).
+				Configure(envoy_listeners.TcpProxy(name, envoy_common.NewCluster(envoy_common.WithService(name))))))
+		}
+	}
+	return opts, resources, nil
+}
@@ -3,6 +3,7 @@ package ingress
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/golang/protobuf/proto"
@@ -90,8 +91,10 @@ func UpdateAvailableServicesCompat(ctx context.Context, rm manager.ResourceManag
 	return nil
 }
 
-func UpdateAvailableServices(ctx context.Context, rm manager.ResourceManager, ingress *core_mesh.ZoneIngressResource, others []*core_mesh.DataplaneResource) error {
-	availableServices := GetIngressAvailableServices(others)
+// UpdateAvailableServices updates available services of the ingress. drainRemaining is the fraction of instances
+// that the ingress advertises when its zone is draining, 1 means that the zone is not draining.
+func UpdateAvailableServices(ctx context.Context, rm manager.ResourceManager, ingress *core_mesh.ZoneIngressResource, others []*core_mesh.DataplaneResource, drainRemaining float64) error {
+	availableServices := DrainAvailableServices(GetIngressAvailableServices(others), drainRemaining)
 	if availableServicesEqual(availableServices, ingress.Spec.GetAvailableServices()) {
 		return nil
 	}
@@ -102,6 +105,31 @@ func UpdateAvailableServices(ctx context.Context, rm manager.ResourceManager, in
 	return nil
 }
 
+// DrainAvailableServices scales down the number of instances of every service to the remaining fraction,
+// so other zones send proportionally less traffic to the ingress. Every service keeps at least one instance
+// until the zone is drained, then no service is advertised.
+func DrainAvailableServices(services []*mesh_proto.ZoneIngress_AvailableService, remaining float64) []*mesh_proto.ZoneIngress_AvailableService {
+	if remaining >= 1 {
+		return services
+	}
+	if remaining <= 0 {
+		return nil
+	}
+	var result []*mesh_proto.ZoneIngress_AvailableService
+	for _, service := range services {
+		instances := uint32(math.Ceil(float64(service.Instances) * remaining))
+		if instances == 0 {
+			instances = 1
+		}
+		result = append(result, &mesh_proto.ZoneIngress_AvailableService{
+			Tags:      service.Tags,
+			Instances: instances,
+			Mesh:      service.Mesh,
+		})
+	}
+	return result
+}
+
 func availableServicesEqualCompat(services []*mesh_proto.Dataplane_Networking_Ingress_AvailableService, other []*mesh_proto.Dataplane_Networking_Ingress_AvailableService) bool {
 	if len(services) != len(other) {
 		return false
@@ -245,7 +245,7 @@ var _ = Describe("Ingress Dataplane", func() {
 				},
 			},
 		}
-		err = ingress.UpdateAvailableServices(ctx, mgr, ing, others)
+		err = ingress.UpdateAvailableServices(ctx, mgr, ing, others, 1)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(mgr.updCounter).To(Equal(0))
 	})
@@ -394,4 +394,58 @@ var _ = Describe("Ingress Dataplane", func() {
 		actual := ingress.GetIngressAvailableServices(dataplanes)
 		Expect(actual).To(Equal(expectedAvailableServices))
 	})
+
+	Describe("DrainAvailableServices", func() {
+		services := []*mesh_proto.ZoneIngress_AvailableService{
+			{
+				Instances: 10,
+				Tags: map[string]string{
+					"service": "backend",
+				},
+				Mesh: "mesh1",
+			},
+			{
+				Instances: 1,
+				Tags: map[string]string{
+					"service": "web",
+				},
+				Mesh: "mesh1",
+			},
+		}
+
+		type testCase struct {
+			remaining float64
+			expected  []uint32
+		}
+
+		DescribeTable("should scale down instances of services",
+			func(given testCase) {
+				// when
+				actual := ingress.DrainAvailableServices(services, given.remaining)
+
+				// then
+				var instances []uint32
+				for _, service := range actual {
+					instances = append(instances, service.Instances)
+				}
+				Expect(instances).To(Equal(given.expected))
+			},
+			Entry("zone is not draining", testCase{
+				remaining: 1,
+				expected:  []uint32{10, 1},
+			}),
+			Entry("zone is draining", testCase{
+				remaining: 0.42,
+				expected:  []uint32{5, 1},
+			}),
+			Entry("zone is almost drained", testCase{
+				remaining: 0.01,
+				expected:  []uint32{1, 1},
+			}),
+			Entry("zone is drained", testCase{
+				remaining: 0,
+				expected:  nil,
+			}),
+		)
+	})
 })
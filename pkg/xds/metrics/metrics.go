@@ -9,6 +9,9 @@ import (
 type Metrics struct {
 	XdsGenerations       prometheus.Summary
 	XdsGenerationsErrors prometheus.Counter
+	XdsListenerDrains    *prometheus.CounterVec
+	XdsMeshGenerations   *prometheus.SummaryVec
+	MeshLabels           *core_metrics.MeshLabels
 }
 
 func NewMetrics(metrics core_metrics.Metrics) (*Metrics, error) {
@@ -27,9 +30,28 @@ func NewMetrics(metrics core_metrics.Metrics) (*Metrics, error) {
 	if err := metrics.Register(xdsGenerationsErrors); err != nil {
 		return nil, err
 	}
+	xdsListenerDrains := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "xds_listener_drains",
+		Help: "Counter of listener updates that make Envoy drain connections, by whether only the changed filter chains or the whole listener are drained",
+	}, []string{"drain"})
+	if err := metrics.Register(xdsListenerDrains); err != nil {
+		return nil, err
+	}
+
+	xdsMeshGenerations := prometheus.NewSummaryVec(prometheus.SummaryOpts{
+		Name:       "mesh_xds_generation",
+		Help:       "Summary of XDS Snapshot generation by mesh, only the top meshes by data plane proxies have their own mesh label",
+		Objectives: core_metrics.DefaultObjectives,
+	}, []string{"mesh"})
+	if err := metrics.Register(xdsMeshGenerations); err != nil {
+		return nil, err
+	}
 
 	return &Metrics{
 		XdsGenerations:       xdsGenerations,
 		XdsGenerationsErrors: xdsGenerationsErrors,
+		XdsListenerDrains:    xdsListenerDrains,
+		XdsMeshGenerations:   xdsMeshGenerations,
+		MeshLabels:           metrics.MeshLabels(),
 	}, nil
 }
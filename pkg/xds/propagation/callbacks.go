@@ -0,0 +1,61 @@
+package propagation
+
+import (
+	"sync"
+
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+)
+
+// callbacks notify the Tracker when a proxy acknowledges the configuration.
+type callbacks struct {
+	util_xds.NoopCallbacks
+	tracker *Tracker
+
+	sync.Mutex
+	// nodeIDs of the streams, only the first request on a stream is guaranteed to carry the node identifier
+	nodeIDs map[int64]string
+	// streams is a number of open streams of a node
+	streams map[string]int
+}
+
+var _ util_xds.Callbacks = &callbacks{}
+
+func NewCallbacks(tracker *Tracker) util_xds.Callbacks {
+	return &callbacks{
+		tracker: tracker,
+		nodeIDs: map[int64]string{},
+		streams: map[string]int{},
+	}
+}
+
+func (c *callbacks) OnStreamClosed(streamID int64) {
+	c.Lock()
+	defer c.Unlock()
+	nodeID, ok := c.nodeIDs[streamID]
+	if !ok {
+		return
+	}
+	delete(c.nodeIDs, streamID)
+	c.streams[nodeID]--
+	if c.streams[nodeID] == 0 {
+		delete(c.streams, nodeID)
+		c.tracker.ForgetProxy(nodeID)
+	}
+}
+
+func (c *callbacks) OnStreamRequest(streamID int64, request util_xds.DiscoveryRequest) error {
+	c.Lock()
+	nodeID, ok := c.nodeIDs[streamID]
+	if !ok && request.NodeId() != "" {
+		nodeID = request.NodeId()
+		c.nodeIDs[streamID] = nodeID
+		c.streams[nodeID]++
+	}
+	c.Unlock()
+
+	if nodeID == "" || request.GetResponseNonce() == "" || request.HasErrors() {
+		return nil
+	}
+	c.tracker.OnAck(nodeID, request.GetTypeUrl(), request.VersionInfo())
+	return nil
+}
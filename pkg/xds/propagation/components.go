@@ -0,0 +1,32 @@
+package propagation
+
+import (
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+)
+
+// Setup creates the Tracker of the propagation of changes and exposes its reports in the API server.
+// Each instance of the Control Plane tracks only the data plane proxies connected to it.
+func Setup(rt core_runtime.Runtime) (*Tracker, error) {
+	// the configuration is generated from the cached resources and regenerated periodically,
+	// so a change is surely included in the configuration once the caches expire and the configuration is refreshed
+	settleTime := 2*rt.Config().Store.Cache.ExpirationTime + rt.Config().XdsServer.DataplaneConfigurationRefreshInterval
+	tracker, err := NewTracker(rt.Metrics(), settleTime)
+	if err != nil {
+		return nil, err
+	}
+	listener := &changeListener{
+		tracker:      tracker,
+		eventFactory: rt.EventReaderFactory(),
+		resManager:   rt.ReadOnlyResourceManager(),
+		registry:     registry.Global(),
+	}
+	if err := rt.Add(listener); err != nil {
+		return nil, err
+	}
+	endpoints := &changesEndpoints{
+		tracker: tracker,
+	}
+	rt.APIManager().Add(endpoints.webService())
+	return tracker, nil
+}
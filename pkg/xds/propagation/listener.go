@@ -0,0 +1,95 @@
+package propagation
+
+import (
+	"context"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/events"
+)
+
+const (
+	createOperation = "Create"
+	updateOperation = "Update"
+	deleteOperation = "Delete"
+)
+
+var operations = map[events.Op]string{
+	events.Create: createOperation,
+	events.Update: updateOperation,
+	events.Delete: deleteOperation,
+}
+
+// changeListener records the changes of policies in the Tracker.
+type changeListener struct {
+	tracker      *Tracker
+	eventFactory events.ListenerFactory
+	resManager   core_manager.ReadOnlyResourceManager
+	registry     registry.TypeRegistry
+}
+
+var _ component.Component = &changeListener{}
+
+func (l *changeListener) Start(stop <-chan struct{}) error {
+	eventReader := l.eventFactory.New()
+	for {
+		event, err := eventReader.Recv(stop)
+		if err == events.ListenerStoppedErr {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		resourceChanged, ok := event.(events.ResourceChangedEvent)
+		if !ok {
+			continue
+		}
+		if !l.isPolicy(resourceChanged.Type) {
+			continue
+		}
+		key := resourceChanged.Key
+		var version string
+		if resourceChanged.Operation != events.Delete {
+			desc, err := l.registry.DescriptorFor(resourceChanged.Type)
+			if err != nil {
+				continue
+			}
+			res := desc.NewObject()
+			if err := l.resManager.Get(context.Background(), res, store.GetBy(key)); err != nil {
+				if !store.IsResourceNotFound(err) {
+					log.Error(err, "could not get the changed resource", "type", resourceChanged.Type, "key", key)
+				}
+				continue
+			}
+			version = res.GetMeta().GetVersion()
+		}
+		if resourceChanged.Type == core_mesh.MeshType {
+			key.Mesh = key.Name
+		}
+		l.tracker.RecordChange(resourceChanged.Type, key, operations[resourceChanged.Operation], version)
+	}
+}
+
+func (l *changeListener) NeedLeaderElection() bool {
+	return false
+}
+
+// isPolicy returns true for the resources that are created by users and affect the configuration of data plane proxies.
+// Dataplanes are excluded, because their changes are propagated all the time as endpoints come and go.
+func (l *changeListener) isPolicy(typ core_model.ResourceType) bool {
+	switch typ {
+	case core_mesh.MeshType:
+		return true
+	case core_mesh.DataplaneType:
+		return false
+	}
+	desc, err := l.registry.DescriptorFor(typ)
+	if err != nil {
+		return false
+	}
+	return desc.Scope == core_model.ScopeMesh && !desc.ReadOnly
+}
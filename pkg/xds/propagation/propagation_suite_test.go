@@ -0,0 +1,11 @@
+package propagation_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestPropagation(t *testing.T) {
+	test.RunSpecs(t, "Propagation Suite")
+}
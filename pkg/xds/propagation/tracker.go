@@ -0,0 +1,296 @@
+package propagation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+)
+
+var log = core.Log.WithName("xds").WithName("propagation")
+
+// MaxChanges is the number of the most recent changes for which the reports are kept.
+const MaxChanges = 100
+
+type proxyPropagation struct {
+	generated    time.Time
+	acknowledged *time.Time
+}
+
+type change struct {
+	seq       uint64
+	id        string
+	key       core_model.ResourceKey
+	typ       core_model.ResourceType
+	operation string
+	time      time.Time
+	proxies   map[string]*proxyPropagation
+}
+
+// pending is a configuration of a proxy that was derived from the changes and is not yet acknowledged.
+type pending struct {
+	changes []*change
+	// versions of the resource types, indexed by type URL, that the proxy has to acknowledge
+	versions map[string]string
+}
+
+type proxyState struct {
+	// lastSeq is the sequence number of the most recent change that was already considered for the proxy
+	lastSeq uint64
+	pending []*pending
+}
+
+// Tracker tracks the propagation of the changes of policies to the data plane proxies.
+//
+// Every change is stamped with a change ID when the Control Plane observes it. A proxy is affected
+// by the change when the first configuration generated for the proxy after the change differs from
+// the previous one. The change is propagated to the proxy once it acknowledges that configuration.
+type Tracker struct {
+	sync.Mutex
+	// settleTime is the time after which a change is surely included in the generated configuration
+	settleTime  time.Duration
+	latency     *prometheus.HistogramVec
+	seq         uint64
+	changes     []*change
+	changesByID map[string]*change
+	versions    map[core_model.ResourceKey]string
+	proxies     map[string]*proxyState
+}
+
+func NewTracker(metrics core_metrics.Metrics, settleTime time.Duration) (*Tracker, error) {
+	latency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "xds_config_propagation",
+		Help:    "Time in seconds between a change of a policy and the acknowledgement of the derived configuration by a data plane proxy",
+		Buckets: []float64{0.5, 1, 2, 5, 10, 20, 30, 60, 120, 300},
+	}, []string{"resource_type"})
+	if err := metrics.Register(latency); err != nil {
+		return nil, err
+	}
+	return &Tracker{
+		settleTime:  settleTime,
+		latency:     latency,
+		changesByID: map[string]*change{},
+		versions:    map[core_model.ResourceKey]string{},
+		proxies:     map[string]*proxyState{},
+	}, nil
+}
+
+// RecordChange stamps the change of the resource with a change ID. The ID is derived from the version
+// of the resource, so every instance of the Control Plane assigns the same ID to the same change.
+func (t *Tracker) RecordChange(typ core_model.ResourceType, key core_model.ResourceKey, operation string, version string) string {
+	t.Lock()
+	defer t.Unlock()
+
+	now := core.Now()
+	if version == "" {
+		// the version of a deleted resource is the last version that we know of
+		version = t.versions[key]
+		if version == "" {
+			version = fmt.Sprintf("%d", now.UnixNano())
+		}
+	}
+	if operation == deleteOperation {
+		delete(t.versions, key)
+	} else {
+		t.versions[key] = version
+	}
+
+	id := changeID(typ, key, operation, version)
+	if _, ok := t.changesByID[id]; ok {
+		return id
+	}
+	t.seq++
+	c := &change{
+		seq:       t.seq,
+		id:        id,
+		key:       key,
+		typ:       typ,
+		operation: operation,
+		time:      now,
+		proxies:   map[string]*proxyPropagation{},
+	}
+	t.changes = append(t.changes, c)
+	t.changesByID[id] = c
+	if len(t.changes) > MaxChanges {
+		delete(t.changesByID, t.changes[0].id)
+		t.changes = t.changes[1:]
+	}
+	log.V(1).Info("recorded a change", "changeId", id, "type", typ, "mesh", key.Mesh, "name", key.Name, "operation", operation)
+	return id
+}
+
+func changeID(typ core_model.ResourceType, key core_model.ResourceKey, operation string, version string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%s/%s", typ, key.Mesh, key.Name, operation, version)))
+	return hex.EncodeToString(sum[:6])
+}
+
+// OnSnapshot is called when a configuration is generated for the proxy. Versions contain the new versions
+// of the resource types that changed compared to the previous configuration. Initial is true when it's
+// the first configuration of the proxy. It returns the IDs of the changes that the configuration includes,
+// so the generation of the configuration can be correlated with the changes that triggered it.
+func (t *Tracker) OnSnapshot(proxyID *core_xds.ProxyId, versions map[string]string, initial bool) []string {
+	t.Lock()
+	defer t.Unlock()
+
+	now := core.Now()
+	id := proxyID.String()
+	state, ok := t.proxies[id]
+	if !ok || initial {
+		// a new proxy receives the configuration that already includes all the changes
+		t.proxies[id] = &proxyState{lastSeq: t.seq}
+		return nil
+	}
+
+	if len(versions) == 0 {
+		// the configuration is unchanged, so the changes that surely have been already taken into account didn't affect the proxy
+		for _, c := range t.changesAfter(state.lastSeq) {
+			if now.Sub(c.time) < t.settleTime {
+				break
+			}
+			state.lastSeq = c.seq
+		}
+		return nil
+	}
+
+	// the newer configuration includes the changes of the configurations that are still waiting for the acknowledgement
+	for _, p := range state.pending {
+		for typeURL := range p.versions {
+			if version, ok := versions[typeURL]; ok {
+				p.versions[typeURL] = version
+			}
+		}
+	}
+
+	var affecting []*change
+	var ids []string
+	for _, c := range t.changesAfter(state.lastSeq) {
+		if c.key.Mesh != proxyID.ToResourceKey().Mesh {
+			continue
+		}
+		c.proxies[id] = &proxyPropagation{generated: now}
+		affecting = append(affecting, c)
+		ids = append(ids, c.id)
+	}
+	state.lastSeq = t.seq
+	if len(affecting) == 0 {
+		return nil
+	}
+	p := &pending{
+		changes:  affecting,
+		versions: map[string]string{},
+	}
+	for typeURL, version := range versions {
+		p.versions[typeURL] = version
+	}
+	state.pending = append(state.pending, p)
+	return ids
+}
+
+func (t *Tracker) changesAfter(seq uint64) []*change {
+	idx := sort.Search(len(t.changes), func(i int) bool {
+		return t.changes[i].seq > seq
+	})
+	return t.changes[idx:]
+}
+
+// OnAck is called when the proxy acknowledges the version of the resource type.
+func (t *Tracker) OnAck(proxyID string, typeURL string, version string) {
+	t.Lock()
+	defer t.Unlock()
+
+	state, ok := t.proxies[proxyID]
+	if !ok {
+		return
+	}
+	now := core.Now()
+	var stillPending []*pending
+	for _, p := range state.pending {
+		if p.versions[typeURL] == version {
+			delete(p.versions, typeURL)
+		}
+		if len(p.versions) > 0 {
+			stillPending = append(stillPending, p)
+			continue
+		}
+		for _, c := range p.changes {
+			t.latency.WithLabelValues(string(c.typ)).Observe(now.Sub(c.time).Seconds())
+			if propagation, ok := c.proxies[proxyID]; ok {
+				acknowledged := now
+				propagation.acknowledged = &acknowledged
+			}
+		}
+	}
+	state.pending = stillPending
+}
+
+// ForgetProxy is called when the proxy disconnects. The changes that were not acknowledged by the proxy remain unacknowledged.
+func (t *Tracker) ForgetProxy(proxyID string) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.proxies, proxyID)
+}
+
+// Get returns the report of the change or false if the change is unknown.
+func (t *Tracker) Get(id string) (types.ConfigChange, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	c, ok := t.changesByID[id]
+	if !ok {
+		return types.ConfigChange{}, false
+	}
+	report := c.summary()
+	for name, propagation := range c.proxies {
+		report.Proxies = append(report.Proxies, types.ConfigChangeProxy{
+			Name:         name,
+			Generated:    propagation.generated,
+			Acknowledged: propagation.acknowledged,
+		})
+	}
+	sort.Slice(report.Proxies, func(i, j int) bool {
+		return report.Proxies[i].Name < report.Proxies[j].Name
+	})
+	return report, true
+}
+
+// List returns the summaries of the recent changes, starting with the most recent one.
+func (t *Tracker) List() types.ConfigChangeList {
+	t.Lock()
+	defer t.Unlock()
+
+	list := types.ConfigChangeList{
+		Items: []types.ConfigChange{},
+	}
+	for i := len(t.changes) - 1; i >= 0; i-- {
+		list.Items = append(list.Items, t.changes[i].summary())
+	}
+	return list
+}
+
+func (c *change) summary() types.ConfigChange {
+	summary := types.ConfigChange{
+		ID:        c.id,
+		Type:      string(c.typ),
+		Mesh:      c.key.Mesh,
+		Name:      c.key.Name,
+		Operation: c.operation,
+		Time:      c.time,
+		Affected:  len(c.proxies),
+	}
+	for _, propagation := range c.proxies {
+		if propagation.acknowledged != nil {
+			summary.Acknowledged++
+		}
+	}
+	return summary
+}
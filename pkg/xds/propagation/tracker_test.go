@@ -0,0 +1,183 @@
+package propagation_test
+
+import (
+	"time"
+
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/xds/propagation"
+)
+
+var _ = Describe("Tracker", func() {
+
+	var tracker *propagation.Tracker
+	var now time.Time
+	var proxy1, proxy2 *core_xds.ProxyId
+
+	trafficPermission := core_model.ResourceKey{Mesh: "default", Name: "allow-all"}
+
+	BeforeEach(func() {
+		now = time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+		core.Now = func() time.Time {
+			return now
+		}
+		metrics, err := core_metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		tracker, err = propagation.NewTracker(metrics, 3*time.Second)
+		Expect(err).ToNot(HaveOccurred())
+
+		proxy1 = core_xds.BuildProxyId("default", "dp-1")
+		proxy2 = core_xds.BuildProxyId("default", "dp-2")
+		tracker.OnSnapshot(proxy1, map[string]string{envoy_resource.ListenerType: "1"}, true)
+		tracker.OnSnapshot(proxy2, map[string]string{envoy_resource.ListenerType: "1"}, true)
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	It("should track the propagation of a change to the affected proxies", func() {
+		// given
+		id := tracker.RecordChange(core_mesh.TrafficPermissionType, trafficPermission, "Update", "2")
+
+		// when the configuration of dp-1 is changed and dp-2 is not
+		now = now.Add(time.Second)
+		changeIDs := tracker.OnSnapshot(proxy1, map[string]string{
+			envoy_resource.ListenerType: "2",
+			envoy_resource.ClusterType:  "2",
+		}, false)
+		Expect(changeIDs).To(Equal([]string{id}))
+		Expect(tracker.OnSnapshot(proxy2, map[string]string{}, false)).To(BeEmpty())
+
+		// and dp-1 acknowledges only the listeners
+		now = now.Add(time.Second)
+		tracker.OnAck(proxy1.String(), envoy_resource.ListenerType, "2")
+
+		// then the change is not yet propagated
+		change, ok := tracker.Get(id)
+		Expect(ok).To(BeTrue())
+		Expect(change.Affected).To(Equal(1))
+		Expect(change.Acknowledged).To(Equal(0))
+
+		// when dp-1 acknowledges the clusters
+		now = now.Add(time.Second)
+		tracker.OnAck(proxy1.String(), envoy_resource.ClusterType, "2")
+
+		// then
+		change, ok = tracker.Get(id)
+		Expect(ok).To(BeTrue())
+		acknowledged := time.Date(2021, 10, 1, 12, 0, 3, 0, time.UTC)
+		Expect(change).To(Equal(types.ConfigChange{
+			ID:           id,
+			Type:         "TrafficPermission",
+			Mesh:         "default",
+			Name:         "allow-all",
+			Operation:    "Update",
+			Time:         time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC),
+			Affected:     1,
+			Acknowledged: 1,
+			Proxies: []types.ConfigChangeProxy{
+				{
+					Name:         "default.dp-1",
+					Generated:    time.Date(2021, 10, 1, 12, 0, 1, 0, time.UTC),
+					Acknowledged: &acknowledged,
+				},
+			},
+		}))
+	})
+
+	It("should attribute a change to a proxy whose configuration changed after the settle time", func() {
+		// given
+		id := tracker.RecordChange(core_mesh.TrafficPermissionType, trafficPermission, "Update", "2")
+
+		// when the configuration is unchanged before the change settles
+		now = now.Add(time.Second)
+		tracker.OnSnapshot(proxy1, map[string]string{}, false)
+		// and changed later
+		now = now.Add(time.Second)
+		tracker.OnSnapshot(proxy1, map[string]string{envoy_resource.ListenerType: "2"}, false)
+
+		// then
+		change, _ := tracker.Get(id)
+		Expect(change.Affected).To(Equal(1))
+	})
+
+	It("should not attribute a settled change to a proxy whose configuration did not change", func() {
+		// given
+		id := tracker.RecordChange(core_mesh.TrafficPermissionType, trafficPermission, "Update", "2")
+
+		// when the configuration is unchanged after the change settles
+		now = now.Add(5 * time.Second)
+		tracker.OnSnapshot(proxy1, map[string]string{}, false)
+		// and changed later for other reasons
+		now = now.Add(time.Second)
+		tracker.OnSnapshot(proxy1, map[string]string{envoy_resource.EndpointType: "2"}, false)
+
+		// then
+		change, _ := tracker.Get(id)
+		Expect(change.Affected).To(Equal(0))
+	})
+
+	It("should complete the propagation when the proxy acknowledges a newer version", func() {
+		// given
+		id := tracker.RecordChange(core_mesh.TrafficPermissionType, trafficPermission, "Update", "2")
+		tracker.OnSnapshot(proxy1, map[string]string{envoy_resource.ListenerType: "2"}, false)
+		tracker.OnSnapshot(proxy1, map[string]string{envoy_resource.ListenerType: "3"}, false)
+
+		// when
+		tracker.OnAck(proxy1.String(), envoy_resource.ListenerType, "3")
+
+		// then
+		change, _ := tracker.Get(id)
+		Expect(change.Acknowledged).To(Equal(1))
+	})
+
+	It("should not attribute changes of other meshes", func() {
+		// given
+		id := tracker.RecordChange(core_mesh.TrafficPermissionType, core_model.ResourceKey{Mesh: "demo", Name: "allow-all"}, "Create", "1")
+
+		// when
+		tracker.OnSnapshot(proxy1, map[string]string{envoy_resource.ListenerType: "2"}, false)
+
+		// then
+		change, _ := tracker.Get(id)
+		Expect(change.Affected).To(Equal(0))
+	})
+
+	It("should assign the same ID to the same change", func() {
+		// when
+		id1 := tracker.RecordChange(core_mesh.TrafficPermissionType, trafficPermission, "Update", "2")
+		id2 := tracker.RecordChange(core_mesh.TrafficPermissionType, trafficPermission, "Update", "2")
+		id3 := tracker.RecordChange(core_mesh.TrafficPermissionType, trafficPermission, "Delete", "")
+
+		// then
+		Expect(id1).To(Equal(id2))
+		Expect(id3).ToNot(Equal(id1))
+		Expect(tracker.List().Items).To(HaveLen(2))
+		Expect(tracker.List().Items[0].ID).To(Equal(id3))
+	})
+
+	It("should keep only the most recent changes", func() {
+		// when
+		var first string
+		for i := 0; i < propagation.MaxChanges+1; i++ {
+			id := tracker.RecordChange(core_mesh.TrafficPermissionType, trafficPermission, "Update", time.Duration(i).String())
+			if i == 0 {
+				first = id
+			}
+		}
+
+		// then
+		Expect(tracker.List().Items).To(HaveLen(propagation.MaxChanges))
+		_, ok := tracker.Get(first)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,49 @@
+package propagation
+
+import (
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+)
+
+// configChangeType is used only to report that a change is not found.
+const configChangeType = "ConfigChange"
+
+type changesEndpoints struct {
+	tracker *Tracker
+}
+
+func (e *changesEndpoints) webService() *restful.WebService {
+	ws := new(restful.WebService).
+		Path("/changes").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.GET("").To(e.list).
+		Doc("List recent changes of policies observed by this instance of the Control Plane").
+		Returns(200, "OK", nil))
+	ws.Route(ws.GET("/{id}").To(e.get).
+		Doc("Get the propagation of the change of a policy to data plane proxies").
+		Param(ws.PathParameter("id", "ID of a change").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+	return ws
+}
+
+func (e *changesEndpoints) list(_ *restful.Request, response *restful.Response) {
+	if err := response.WriteAsJson(e.tracker.List()); err != nil {
+		rest_errors.HandleError(response, err, "Could not list changes")
+	}
+}
+
+func (e *changesEndpoints) get(request *restful.Request, response *restful.Response) {
+	id := request.PathParameter("id")
+	change, ok := e.tracker.Get(id)
+	if !ok {
+		rest_errors.HandleError(response, store.ErrorResourceNotFound(configChangeType, id, ""), "Could not get the change")
+		return
+	}
+	if err := response.WriteAsJson(change); err != nil {
+		rest_errors.HandleError(response, err, "Could not get the change")
+	}
+}
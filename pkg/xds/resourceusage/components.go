@@ -0,0 +1,16 @@
+package resourceusage
+
+import (
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+)
+
+func RegisterResourceUsage(rt core_runtime.Runtime, authenticator auth.Authenticator) {
+	handler := ResourceUsageHandler{
+		ResManager:    rt.ResourceManager(),
+		Authenticator: authenticator,
+		UpsertCfg:     rt.Config().Store.Upsert,
+	}
+	log.Info("registering Resource Usage in Dataplane Server")
+	rt.DpServer().HTTPMux().HandleFunc("/resource-usage", handler.Handle)
+}
@@ -0,0 +1,81 @@
+package resourceusage
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	store_config "github.com/kumahq/kuma/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+	"github.com/kumahq/kuma/pkg/xds/resourceusage/types"
+)
+
+var log = core.Log.WithName("xds").WithName("resource-usage")
+
+// ResourceUsageHandler stores the resource usage reported by Kuma DP in the DataplaneInsight.
+type ResourceUsageHandler struct {
+	ResManager    core_manager.ResourceManager
+	Authenticator auth.Authenticator
+	UpsertCfg     store_config.UpsertConfig
+}
+
+func (h *ResourceUsageHandler) Handle(resp http.ResponseWriter, req *http.Request) {
+	bytes, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		log.Error(err, "Could not read a request")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reqParams := types.ResourceUsageRequest{}
+	if err := json.Unmarshal(bytes, &reqParams); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	logger := log.WithValues("mesh", reqParams.Mesh, "name", reqParams.Name)
+
+	if err := h.store(req.Context(), reqParams); err != nil {
+		auth.HandleDpRequestError(resp, err, logger, "Could not store the resource usage")
+		return
+	}
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ResourceUsageHandler) store(ctx context.Context, request types.ResourceUsageRequest) error {
+	dataplane := core_mesh.NewDataplaneResource()
+	if err := h.ResManager.Get(ctx, dataplane, core_store.GetByKey(request.Name, request.Mesh)); err != nil {
+		return err
+	}
+	if err := h.Authenticator.Authenticate(ctx, dataplane, request.DataplaneToken); err != nil {
+		return auth.NewAuthenticationError(err)
+	}
+
+	usage := &mesh_proto.ResourceUsage{
+		Time:              util_proto.MustTimestampProto(core.Now()),
+		KumaDp:            processResourceUsage(request.KumaDp),
+		Envoy:             processResourceUsage(request.Envoy),
+		ActiveConnections: request.ActiveConnections,
+	}
+	key := core_model.MetaToResourceKey(dataplane.GetMeta())
+	return core_manager.Upsert(h.ResManager, key, core_mesh.NewDataplaneInsightResource(), func(resource core_model.Resource) error {
+		insight := resource.(*core_mesh.DataplaneInsightResource)
+		insight.Spec.AddResourceUsage(usage)
+		return nil
+	}, core_manager.WithConflictRetry(h.UpsertCfg.ConflictRetryBaseBackoff, h.UpsertCfg.ConflictRetryMaxTimes))
+}
+
+func processResourceUsage(usage types.ProcessResourceUsage) *mesh_proto.ProcessResourceUsage {
+	return &mesh_proto.ProcessResourceUsage{
+		CpuMillicores: usage.CpuMillicores,
+		RssBytes:      usage.RssBytes,
+		OpenFds:       usage.OpenFds,
+	}
+}
@@ -0,0 +1,171 @@
+package resourceusage_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	store_config "github.com/kumahq/kuma/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+	"github.com/kumahq/kuma/pkg/xds/resourceusage"
+	"github.com/kumahq/kuma/pkg/xds/resourceusage/types"
+)
+
+type staticTokenAuthenticator struct {
+	token string
+}
+
+func (s *staticTokenAuthenticator) Authenticate(_ context.Context, _ model.Resource, credential auth.Credential) error {
+	if credential != s.token {
+		return errors.New("invalid token")
+	}
+	return nil
+}
+
+var _ = Describe("ResourceUsageHandler", func() {
+
+	var resManager manager.ResourceManager
+	var handler *resourceusage.ResourceUsageHandler
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		core.Now = func() time.Time {
+			return now
+		}
+		resManager = manager.NewResourceManager(memory.NewStore())
+		handler = &resourceusage.ResourceUsageHandler{
+			ResManager:    resManager,
+			Authenticator: &staticTokenAuthenticator{token: "token"},
+			UpsertCfg: store_config.UpsertConfig{
+				ConflictRetryBaseBackoff: time.Millisecond,
+				ConflictRetryMaxTimes:    3,
+			},
+		}
+
+		err := resManager.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("default", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		dataplane := &core_mesh.DataplaneResource{
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port: 8080,
+							Tags: map[string]string{
+								mesh_proto.ServiceTag: "backend",
+							},
+						},
+					},
+				},
+			},
+		}
+		err = resManager.Create(context.Background(), dataplane, store.CreateByKey("dp-1", "default"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	request := func(usageRequest types.ResourceUsageRequest) *httptest.ResponseRecorder {
+		body, err := json.Marshal(usageRequest)
+		Expect(err).ToNot(HaveOccurred())
+		req := httptest.NewRequest(http.MethodPost, "/resource-usage", bytes.NewReader(body))
+		resp := httptest.NewRecorder()
+		handler.Handle(resp, req)
+		return resp
+	}
+
+	It("should store the resource usage in the dataplane insight", func() {
+		// when
+		resp := request(types.ResourceUsageRequest{
+			Mesh:           "default",
+			Name:           "dp-1",
+			DataplaneToken: "token",
+			KumaDp: types.ProcessResourceUsage{
+				CpuMillicores: 5,
+				RssBytes:      1024,
+				OpenFds:       10,
+			},
+			Envoy: types.ProcessResourceUsage{
+				CpuMillicores: 50,
+				RssBytes:      4096,
+				OpenFds:       40,
+			},
+			ActiveConnections: 7,
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusNoContent))
+		insight := core_mesh.NewDataplaneInsightResource()
+		Expect(resManager.Get(context.Background(), insight, store.GetByKey("dp-1", "default"))).To(Succeed())
+		Expect(insight.Spec.ResourceUsage).To(HaveLen(1))
+		usage := insight.Spec.GetLatestResourceUsage()
+		Expect(usage.Time.AsTime()).To(Equal(now))
+		Expect(usage.KumaDp.CpuMillicores).To(Equal(uint64(5)))
+		Expect(usage.KumaDp.RssBytes).To(Equal(uint64(1024)))
+		Expect(usage.KumaDp.OpenFds).To(Equal(uint32(10)))
+		Expect(usage.Envoy.CpuMillicores).To(Equal(uint64(50)))
+		Expect(usage.Envoy.RssBytes).To(Equal(uint64(4096)))
+		Expect(usage.Envoy.OpenFds).To(Equal(uint32(40)))
+		Expect(usage.ActiveConnections).To(Equal(uint64(7)))
+	})
+
+	It("should append to the existing samples", func() {
+		// given
+		for i := 0; i < 2; i++ {
+			resp := request(types.ResourceUsageRequest{
+				Mesh:              "default",
+				Name:              "dp-1",
+				DataplaneToken:    "token",
+				ActiveConnections: uint64(i),
+			})
+			Expect(resp.Code).To(Equal(http.StatusNoContent))
+		}
+
+		// then
+		insight := core_mesh.NewDataplaneInsightResource()
+		Expect(resManager.Get(context.Background(), insight, store.GetByKey("dp-1", "default"))).To(Succeed())
+		Expect(insight.Spec.ResourceUsage).To(HaveLen(2))
+		Expect(insight.Spec.GetLatestResourceUsage().ActiveConnections).To(Equal(uint64(1)))
+	})
+
+	It("should reject the request with invalid token", func() {
+		// when
+		resp := request(types.ResourceUsageRequest{
+			Mesh:           "default",
+			Name:           "dp-1",
+			DataplaneToken: "other-token",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+		Expect(resp.Body.String()).To(Equal("authentication failed: invalid token"))
+	})
+
+	It("should return not found when the dataplane does not exist", func() {
+		// when
+		resp := request(types.ResourceUsageRequest{
+			Mesh:           "default",
+			Name:           "dp-2",
+			DataplaneToken: "token",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,11 @@
+package resourceusage_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestResourceUsage(t *testing.T) {
+	test.RunSpecs(t, "Resource Usage Suite")
+}
@@ -0,0 +1,20 @@
+package types
+
+// ResourceUsageRequest is sent periodically by Kuma DP to report the resource usage of itself and Envoy.
+type ResourceUsageRequest struct {
+	Mesh           string               `json:"mesh"`
+	Name           string               `json:"name"`
+	DataplaneToken string               `json:"dataplaneToken,omitempty"`
+	KumaDp         ProcessResourceUsage `json:"kumaDp"`
+	Envoy          ProcessResourceUsage `json:"envoy"`
+	// ActiveConnections is a number of downstream connections currently handled by Envoy listeners
+	ActiveConnections uint64 `json:"activeConnections"`
+}
+
+// ProcessResourceUsage is a snapshot of the resources used by a single process.
+type ProcessResourceUsage struct {
+	// CpuMillicores is an average CPU usage since the previous report
+	CpuMillicores uint64 `json:"cpuMillicores"`
+	RssBytes      uint64 `json:"rssBytes"`
+	OpenFds       uint32 `json:"openFds"`
+}
@@ -0,0 +1,17 @@
+package files
+
+import (
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+	"github.com/kumahq/kuma/pkg/xds/secrets"
+)
+
+func RegisterSecrets(rt core_runtime.Runtime, authenticator auth.Authenticator, secrets secrets.Secrets) {
+	secretsHandler := SecretsHandler{
+		ResManager:    rt.ReadOnlyResourceManager(),
+		Authenticator: authenticator,
+		Secrets:       secrets,
+	}
+	log.Info("registering Secrets in Dataplane Server")
+	rt.DpServer().HTTPMux().HandleFunc("/secrets", secretsHandler.Handle)
+}
@@ -0,0 +1,11 @@
+package files_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestFiles(t *testing.T) {
+	test.RunSpecs(t, "Secrets Files Suite")
+}
@@ -0,0 +1,123 @@
+package files
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+	"github.com/kumahq/kuma/pkg/xds/secrets"
+	"github.com/kumahq/kuma/pkg/xds/secrets/files/types"
+)
+
+var log = core.Log.WithName("xds").WithName("secrets-files")
+
+// SecretsHandler serves the secrets of a data plane proxy to Kuma DP, so it can deliver them to Envoy as files.
+type SecretsHandler struct {
+	ResManager    core_manager.ReadOnlyResourceManager
+	Authenticator auth.Authenticator
+	Secrets       secrets.Secrets
+}
+
+func (s *SecretsHandler) Handle(resp http.ResponseWriter, req *http.Request) {
+	bytes, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		log.Error(err, "Could not read a request")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reqParams := types.SecretsRequest{}
+	if err := json.Unmarshal(bytes, &reqParams); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	logger := log.WithValues("mesh", reqParams.Mesh, "name", reqParams.Name)
+
+	secretsResp, err := s.secrets(req.Context(), reqParams)
+	if err != nil {
+		auth.HandleDpRequestError(resp, err, logger, "Could not get the secrets")
+		return
+	}
+	if secretsResp == nil { // mTLS is disabled, there are no secrets to deliver
+		resp.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	bytes, err = json.Marshal(secretsResp)
+	if err != nil {
+		logger.Error(err, "Could not convert to json")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("content-type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err := resp.Write(bytes); err != nil {
+		logger.Error(err, "Error while writing the response")
+	}
+}
+
+func (s *SecretsHandler) secrets(ctx context.Context, request types.SecretsRequest) (*types.SecretsResponse, error) {
+	dataplane, err := s.dataplane(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Authenticator.Authenticate(ctx, dataplane, request.DataplaneToken); err != nil {
+		return nil, auth.NewAuthenticationError(err)
+	}
+
+	mesh := core_mesh.NewMeshResource()
+	if err := s.ResManager.Get(ctx, mesh, core_store.GetByKey(dataplane.GetMeta().GetMesh(), core_model.NoMesh)); err != nil {
+		return nil, err
+	}
+	if !mesh.MTLSEnabled() {
+		return nil, nil
+	}
+
+	identity, ca, err := s.Secrets.Get(dataplane, mesh)
+	if err != nil {
+		return nil, err
+	}
+	response := &types.SecretsResponse{
+		IdentityKey: string(identity.PemKey),
+	}
+	for _, cert := range identity.PemCerts {
+		response.IdentityCerts = append(response.IdentityCerts, string(cert))
+	}
+	for _, cert := range ca.PemCerts {
+		response.CaCerts = append(response.CaCerts, string(cert))
+	}
+	return response, nil
+}
+
+// dataplane returns the Dataplane from the request or from the store.
+// On Universal, the Dataplane passed to Kuma DP is created in the store only once Envoy connects to the xDS server,
+// but Kuma DP needs the secrets before Envoy is started.
+func (s *SecretsHandler) dataplane(ctx context.Context, request types.SecretsRequest) (*core_mesh.DataplaneResource, error) {
+	if request.DataplaneResource != "" {
+		res, err := rest.UnmarshallToCore([]byte(request.DataplaneResource))
+		if err != nil {
+			return nil, err
+		}
+		dataplane, ok := res.(*core_mesh.DataplaneResource)
+		if !ok {
+			var verr validators.ValidationError
+			verr.AddViolation("dataplaneResource", "only Dataplane resources can receive the secrets as files")
+			return nil, verr.OrNil()
+		}
+		return dataplane, nil
+	}
+	dataplane := core_mesh.NewDataplaneResource()
+	if err := s.ResManager.Get(ctx, dataplane, core_store.GetByKey(request.Name, request.Mesh)); err != nil {
+		return nil, err
+	}
+	return dataplane, nil
+}
@@ -0,0 +1,180 @@
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/xds"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+	"github.com/kumahq/kuma/pkg/xds/secrets/files"
+	"github.com/kumahq/kuma/pkg/xds/secrets/files/types"
+)
+
+type staticTokenAuthenticator struct {
+	token string
+}
+
+func (s *staticTokenAuthenticator) Authenticate(_ context.Context, _ model.Resource, credential auth.Credential) error {
+	if credential != s.token {
+		return errors.New("invalid token")
+	}
+	return nil
+}
+
+var _ = Describe("SecretsHandler", func() {
+
+	var resManager manager.ResourceManager
+	var handler *files.SecretsHandler
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		handler = &files.SecretsHandler{
+			ResManager:    resManager,
+			Authenticator: &staticTokenAuthenticator{token: "token"},
+			Secrets:       &xds.TestSecrets{},
+		}
+
+		dataplane := &core_mesh.DataplaneResource{
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port: 8080,
+							Tags: map[string]string{
+								mesh_proto.ServiceTag: "backend",
+							},
+						},
+					},
+				},
+			},
+		}
+		err := resManager.Create(context.Background(), dataplane, store.CreateByKey("dp-1", "default"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	createMesh := func(mtls bool) {
+		mesh := core_mesh.NewMeshResource()
+		if mtls {
+			mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+				EnabledBackend: "ca-1",
+				Backends: []*mesh_proto.CertificateAuthorityBackend{
+					{
+						Name: "ca-1",
+						Type: "builtin",
+					},
+				},
+			}
+		}
+		err := resManager.Create(context.Background(), mesh, store.CreateByKey("default", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	request := func(secretsRequest types.SecretsRequest) *httptest.ResponseRecorder {
+		body, err := json.Marshal(secretsRequest)
+		Expect(err).ToNot(HaveOccurred())
+		req := httptest.NewRequest(http.MethodPost, "/secrets", bytes.NewReader(body))
+		resp := httptest.NewRecorder()
+		handler.Handle(resp, req)
+		return resp
+	}
+
+	It("should return the secrets of the dataplane", func() {
+		// given
+		createMesh(true)
+
+		// when
+		resp := request(types.SecretsRequest{
+			Mesh:           "default",
+			Name:           "dp-1",
+			DataplaneToken: "token",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		secretsResp := types.SecretsResponse{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), &secretsResp)).To(Succeed())
+		Expect(secretsResp).To(Equal(types.SecretsResponse{
+			IdentityCerts: []string{"CERT"},
+			IdentityKey:   "KEY",
+			CaCerts:       []string{"CA"},
+		}))
+	})
+
+	It("should return no content when mTLS is disabled", func() {
+		// given
+		createMesh(false)
+
+		// when
+		resp := request(types.SecretsRequest{
+			Mesh:           "default",
+			Name:           "dp-1",
+			DataplaneToken: "token",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusNoContent))
+	})
+
+	It("should use the dataplane from the request", func() {
+		// given
+		createMesh(true)
+
+		// when
+		resp := request(types.SecretsRequest{
+			Mesh:           "default",
+			Name:           "dp-2",
+			DataplaneToken: "token",
+			DataplaneResource: `{"type": "Dataplane", "mesh": "default", "name": "dp-2",
+			  "networking": {"address": "192.168.0.2", "inbound": [{"port": 8080, "tags": {"kuma.io/service": "web"}}]}}`,
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusOK))
+	})
+
+	It("should reject the request with invalid token", func() {
+		// given
+		createMesh(true)
+
+		// when
+		resp := request(types.SecretsRequest{
+			Mesh:           "default",
+			Name:           "dp-1",
+			DataplaneToken: "other-token",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+		Expect(resp.Body.String()).To(Equal("authentication failed: invalid token"))
+	})
+
+	It("should return not found when the dataplane does not exist", func() {
+		// given
+		createMesh(true)
+
+		// when
+		resp := request(types.SecretsRequest{
+			Mesh:           "default",
+			Name:           "dp-3",
+			DataplaneToken: "token",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+		Expect(resp.Body.String()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,18 @@
+package types
+
+// SecretsRequest is sent by a client (Kuma DP) that delivers the secrets to Envoy as files.
+type SecretsRequest struct {
+	Mesh              string `json:"mesh"`
+	Name              string `json:"name"`
+	DataplaneToken    string `json:"dataplaneToken,omitempty"`
+	DataplaneResource string `json:"dataplaneResource,omitempty"`
+}
+
+// SecretsResponse contains PEM encoded identity and CA of the data plane proxy.
+type SecretsResponse struct {
+	// IdentityCerts is a chain of the identity certificate, starting with the certificate of the data plane proxy
+	IdentityCerts []string `json:"identityCerts"`
+	IdentityKey   string   `json:"identityKey"`
+	// CaCerts are the certificates of CAs that the data plane proxy trusts
+	CaCerts []string `json:"caCerts"`
+}
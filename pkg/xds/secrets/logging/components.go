@@ -0,0 +1,16 @@
+package logging
+
+import (
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+)
+
+func RegisterLoggingSecrets(rt core_runtime.Runtime, authenticator auth.Authenticator) {
+	handler := LoggingSecretsHandler{
+		ResManager:       rt.ReadOnlyResourceManager(),
+		Authenticator:    authenticator,
+		DataSourceLoader: rt.DataSourceLoader(),
+	}
+	log.Info("registering Logging Secrets in Dataplane Server")
+	rt.DpServer().HTTPMux().HandleFunc("/logging-secrets", handler.Handle)
+}
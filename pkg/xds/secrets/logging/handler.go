@@ -0,0 +1,107 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+	"github.com/kumahq/kuma/pkg/xds/secrets/logging/types"
+)
+
+var log = core.Log.WithName("xds").WithName("logging-secrets")
+
+// LoggingSecretsHandler serves Kuma DP the secrets that logging backends of the mesh reference as credentials.
+// Kuma DP forwards access logs to these backends, so the credentials are never put in the Envoy configuration.
+//
+// Only secrets referenced by a logging backend of the mesh of the Dataplane are served.
+type LoggingSecretsHandler struct {
+	ResManager       core_manager.ReadOnlyResourceManager
+	Authenticator    auth.Authenticator
+	DataSourceLoader datasource.Loader
+}
+
+func (h *LoggingSecretsHandler) Handle(resp http.ResponseWriter, req *http.Request) {
+	bytes, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		log.Error(err, "Could not read a request")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reqParams := types.LoggingSecretRequest{}
+	if err := json.Unmarshal(bytes, &reqParams); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	logger := log.WithValues("mesh", reqParams.Mesh, "name", reqParams.Name, "secret", reqParams.Secret)
+
+	value, err := h.secret(req.Context(), reqParams)
+	if err != nil {
+		auth.HandleDpRequestError(resp, err, logger, "Could not load the secret")
+		return
+	}
+
+	respBytes, err := json.Marshal(types.LoggingSecretResponse{
+		Value: value,
+	})
+	if err != nil {
+		logger.Error(err, "Could not marshal the response")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("content-type", "application/json")
+	if _, err := resp.Write(respBytes); err != nil {
+		logger.Error(err, "Error while writing the response")
+	}
+}
+
+func (h *LoggingSecretsHandler) secret(ctx context.Context, request types.LoggingSecretRequest) ([]byte, error) {
+	dataplane := core_mesh.NewDataplaneResource()
+	if err := h.ResManager.Get(ctx, dataplane, core_store.GetByKey(request.Name, request.Mesh)); err != nil {
+		return nil, err
+	}
+	if err := h.Authenticator.Authenticate(ctx, dataplane, request.DataplaneToken); err != nil {
+		return nil, auth.NewAuthenticationError(err)
+	}
+
+	mesh := core_mesh.NewMeshResource()
+	if err := h.ResManager.Get(ctx, mesh, core_store.GetByKey(dataplane.GetMeta().GetMesh(), core_model.NoMesh)); err != nil {
+		return nil, err
+	}
+	source := referencedSecret(mesh, request.Secret)
+	if source == nil {
+		var verr validators.ValidationError
+		verr.AddViolation("secret", "is not referenced by any logging backend of the mesh")
+		return nil, verr.OrNil()
+	}
+	return h.DataSourceLoader.Load(ctx, mesh.GetMeta().GetName(), source)
+}
+
+// referencedSecret returns the credential of a logging backend of the mesh that references the secret.
+func referencedSecret(mesh *core_mesh.MeshResource, secret string) *system_proto.DataSource {
+	for _, backend := range mesh.Spec.GetLogging().GetBackends() {
+		if backend.GetType() != mesh_proto.LoggingSplunkType {
+			continue
+		}
+		cfg := &mesh_proto.SplunkLoggingBackendConfig{}
+		if err := util_proto.ToTyped(backend.GetConf(), cfg); err != nil {
+			continue // should be caught by validator
+		}
+		if secret != "" && cfg.GetToken().GetSecret() == secret {
+			return cfg.GetToken()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+	"github.com/kumahq/kuma/pkg/xds/secrets/logging"
+	"github.com/kumahq/kuma/pkg/xds/secrets/logging/types"
+)
+
+type staticTokenAuthenticator struct {
+	token string
+}
+
+func (s *staticTokenAuthenticator) Authenticate(_ context.Context, _ model.Resource, credential auth.Credential) error {
+	if credential != s.token {
+		return errors.New("invalid token")
+	}
+	return nil
+}
+
+var _ = Describe("LoggingSecretsHandler", func() {
+
+	var handler *logging.LoggingSecretsHandler
+
+	BeforeEach(func() {
+		memoryStore := memory.NewStore()
+		resManager := manager.NewResourceManager(memoryStore)
+		secretManager := secret_manager.NewSecretManager(secret_store.NewSecretStore(memoryStore), cipher.None(), nil)
+		handler = &logging.LoggingSecretsHandler{
+			ResManager:       resManager,
+			Authenticator:    &staticTokenAuthenticator{token: "token"},
+			DataSourceLoader: datasource.NewDataSourceLoader(secretManager, nil),
+		}
+
+		mesh := &core_mesh.MeshResource{
+			Spec: &mesh_proto.Mesh{
+				Logging: &mesh_proto.Logging{
+					Backends: []*mesh_proto.LoggingBackend{
+						{
+							Name: "splunk",
+							Type: mesh_proto.LoggingSplunkType,
+							Conf: util_proto.MustToStruct(&mesh_proto.SplunkLoggingBackendConfig{
+								Url: "https://splunk.example.com:8088",
+								Token: &system_proto.DataSource{
+									Type: &system_proto.DataSource_Secret{Secret: "splunk-token"},
+								},
+							}),
+						},
+					},
+				},
+			},
+		}
+		Expect(resManager.Create(context.Background(), mesh, store.CreateByKey("default", model.NoMesh))).To(Succeed())
+
+		dataplane := &core_mesh.DataplaneResource{
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port: 8080,
+							Tags: map[string]string{
+								mesh_proto.ServiceTag: "backend",
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(resManager.Create(context.Background(), dataplane, store.CreateByKey("dp-1", "default"))).To(Succeed())
+
+		for name, value := range map[string]string{"splunk-token": "s3cr3t", "other": "other-s3cr3t"} {
+			secret := &system.SecretResource{
+				Spec: &system_proto.Secret{
+					Data: util_proto.Bytes([]byte(value)),
+				},
+			}
+			Expect(secretManager.Create(context.Background(), secret, store.CreateByKey(name, "default"))).To(Succeed())
+		}
+	})
+
+	request := func(secretRequest types.LoggingSecretRequest) *httptest.ResponseRecorder {
+		body, err := json.Marshal(secretRequest)
+		Expect(err).ToNot(HaveOccurred())
+		req := httptest.NewRequest(http.MethodPost, "/logging-secrets", bytes.NewReader(body))
+		resp := httptest.NewRecorder()
+		handler.Handle(resp, req)
+		return resp
+	}
+
+	It("should return a secret referenced by a logging backend", func() {
+		// when
+		resp := request(types.LoggingSecretRequest{
+			Mesh:           "default",
+			Name:           "dp-1",
+			DataplaneToken: "token",
+			Secret:         "splunk-token",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		secretResp := types.LoggingSecretResponse{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), &secretResp)).To(Succeed())
+		Expect(string(secretResp.Value)).To(Equal("s3cr3t"))
+	})
+
+	It("should reject a secret that is not referenced by any logging backend", func() {
+		// when
+		resp := request(types.LoggingSecretRequest{
+			Mesh:           "default",
+			Name:           "dp-1",
+			DataplaneToken: "token",
+			Secret:         "other",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusUnprocessableEntity))
+		Expect(resp.Body.String()).To(ContainSubstring("is not referenced by any logging backend of the mesh"))
+	})
+
+	It("should reject the request with invalid token", func() {
+		// when
+		resp := request(types.LoggingSecretRequest{
+			Mesh:           "default",
+			Name:           "dp-1",
+			DataplaneToken: "invalid",
+			Secret:         "splunk-token",
+		})
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+	})
+})
@@ -0,0 +1,11 @@
+package logging_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestLoggingSecrets(t *testing.T) {
+	test.RunSpecs(t, "Logging Secrets Suite")
+}
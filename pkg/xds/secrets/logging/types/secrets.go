@@ -0,0 +1,15 @@
+package types
+
+// LoggingSecretRequest is sent by Kuma DP to load a credential of a logging backend that references a secret of the mesh.
+type LoggingSecretRequest struct {
+	Mesh           string `json:"mesh"`
+	Name           string `json:"name"`
+	DataplaneToken string `json:"dataplaneToken,omitempty"`
+	// Secret is the name of the secret
+	Secret string `json:"secret"`
+}
+
+// LoggingSecretResponse contains the value of the secret.
+type LoggingSecretResponse struct {
+	Value []byte `json:"value"`
+}
@@ -22,6 +22,11 @@ import (
 
 var log = core.Log.WithName("xds").WithName("secrets")
 
+// caRefreshInterval defines how often the CA (trust bundle) of the Mesh is refreshed for a DP
+// that does not need new certificates. The trust bundle may change without changing the Mesh,
+// e.g. when a new zone joins the Mesh that uses per zone CAs.
+const caRefreshInterval = 1 * time.Minute
+
 type Secrets interface {
 	Get(dataplane *core_mesh.DataplaneResource, mesh *core_mesh.MeshResource) (*core_xds.IdentitySecret, *core_xds.CaSecret, error)
 	Info(dpKey model.ResourceKey) *Info
@@ -84,9 +89,10 @@ func (c *secrets) Info(dpKey model.ResourceKey) *Info {
 }
 
 type certs struct {
-	identity *core_xds.IdentitySecret
-	ca       *core_xds.CaSecret
-	info     *Info
+	identity    *core_xds.IdentitySecret
+	ca          *core_xds.CaSecret
+	caRefreshed time.Time
+	info        *Info
 }
 
 func (c *certs) Info() *Info {
@@ -126,6 +132,19 @@ func (c *secrets) Get(dataplane *core_mesh.DataplaneResource, mesh *core_mesh.Me
 	if certs == nil { // previous "if" should guarantee that the certs are always there
 		return nil, nil, errors.New("certificates were not generated")
 	}
+	if core.Now().After(certs.caRefreshed.Add(caRefreshInterval)) {
+		ca, _, err := c.caProvider.Get(context.Background(), mesh)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not refresh mesh CA cert")
+		}
+		refreshed := *certs
+		refreshed.ca = ca
+		refreshed.caRefreshed = core.Now()
+		c.Lock()
+		c.cachedCerts[dpKey] = &refreshed
+		c.Unlock()
+		certs = &refreshed
+	}
 	return certs.identity, certs.ca, nil
 }
 
@@ -177,9 +196,10 @@ func (c *secrets) generateCerts(dataplane *core_mesh.DataplaneResource, mesh *co
 	}
 
 	return &certs{
-		identity: identity,
-		ca:       ca,
-		info:     info,
+		identity:    identity,
+		ca:          ca,
+		caRefreshed: core.Now(),
+		info:        info,
 	}, nil
 }
 
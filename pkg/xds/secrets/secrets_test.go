@@ -10,8 +10,11 @@ import (
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/core/datasource"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_system "github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
 	secrets_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	secrets_store "github.com/kumahq/kuma/pkg/core/secrets/store"
@@ -28,6 +31,8 @@ var _ = Describe("Secrets", func() {
 	var secrets Secrets
 	var metrics core_metrics.Metrics
 	var now time.Time
+	var secretStore secrets_store.SecretStore
+	var caManager core_ca.Manager
 
 	newMesh := func() *core_mesh.MeshResource {
 		return &core_mesh.MeshResource{
@@ -87,12 +92,13 @@ var _ = Describe("Secrets", func() {
 
 	BeforeEach(func() {
 		resStore := memory.NewStore()
-		secretManager := secrets_manager.NewSecretManager(secrets_store.NewSecretStore(resStore), cipher.None(), nil)
-		builtinCaManager := ca_builtin.NewBuiltinCaManager(secretManager)
+		secretStore = secrets_store.NewSecretStore(resStore)
+		secretManager := secrets_manager.NewSecretManager(secretStore, cipher.None(), nil)
+		caManager = ca_builtin.NewBuiltinCaManager(secretManager, datasource.NewDataSourceLoader(secretManager, nil), "")
 		caManagers := core_ca.Managers{
-			"builtin": builtinCaManager,
+			"builtin": caManager,
 		}
-		err := builtinCaManager.EnsureBackends(context.Background(), "default", newMesh().Spec.Mtls.Backends)
+		err := caManager.EnsureBackends(context.Background(), "default", newMesh().Spec.Mtls.Backends)
 		Expect(err).ToNot(HaveOccurred())
 
 		caProvider := NewCaProvider(caManagers)
@@ -151,6 +157,30 @@ var _ = Describe("Secrets", func() {
 		Expect(test_metrics.FindMetric(metrics, "cert_generation").GetCounter().GetValue()).To(Equal(1.0))
 	})
 
+	It("should refresh CA without regenerating certs", func() {
+		// given
+		identity, ca, err := secrets.Get(newDataplane(), newMesh())
+		Expect(err).ToNot(HaveOccurred())
+
+		// and CA was changed
+		for _, name := range []string{"default.ca-builtin-cert-ca-1", "default.ca-builtin-key-ca-1"} {
+			err := secretStore.Delete(context.Background(), core_system.NewSecretResource(), core_store.DeleteByKey(name, "default"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		err = caManager.EnsureBackends(context.Background(), "default", newMesh().Spec.Mtls.Backends)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when CA refresh interval passed
+		now = now.Add(61 * time.Second)
+		newIdentity, newCa, err := secrets.Get(newDataplane(), newMesh())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(newIdentity).To(Equal(identity))
+		Expect(newCa).ToNot(Equal(ca))
+		Expect(test_metrics.FindMetric(metrics, "cert_generation").GetCounter().GetValue()).To(Equal(1.0))
+	})
+
 	Context("should regenerate certificate", func() {
 		BeforeEach(func() {
 			_, _, err := secrets.Get(newDataplane(), newMesh())
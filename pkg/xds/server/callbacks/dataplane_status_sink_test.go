@@ -194,6 +194,9 @@ var _ = Describe("DataplaneInsightSink", func() {
 			}, "1s", "1ms").Should(BeTrue())
 			// and
 			Expect(util_proto.ToYAML(dataplaneInsight.GetSpec())).To(MatchYAML(`
+            subscriptionSummary:
+              connectCount: 1
+              firstConnectTime: "2019-07-01T00:00:00Z"
             subscriptions:
             - connectTime: "2019-07-01T00:00:00Z"
               controlPlaneInstanceId: control-plane-01
@@ -228,6 +231,9 @@ var _ = Describe("DataplaneInsightSink", func() {
 			}, "1s", "1ms").Should(BeTrue())
 			// and
 			Expect(util_proto.ToYAML(dataplaneInsight.GetSpec())).To(MatchYAML(`
+            subscriptionSummary:
+              connectCount: 1
+              firstConnectTime: "2019-07-01T00:00:00Z"
             subscriptions:
             - connectTime: "2019-07-01T00:00:00Z"
               controlPlaneInstanceId: control-plane-01
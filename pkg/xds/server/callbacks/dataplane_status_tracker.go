@@ -96,6 +96,7 @@ func (c *dataplaneStatusTracker) OnStreamClosed(streamID int64) {
 	state.mu.Lock() // write access to the per Dataplane info
 	subscription := state.subscription
 	subscription.DisconnectTime = util_proto.MustTimestampProto(core.Now())
+	subscription.DisconnectReason = mesh_proto.DisconnectReasonStreamClosed
 	state.mu.Unlock()
 
 	// trigger final flush
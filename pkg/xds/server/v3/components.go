@@ -8,19 +8,26 @@ import (
 	envoy_server "github.com/envoyproxy/go-control-plane/pkg/server/v3"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/types"
 	"github.com/kumahq/kuma/pkg/core"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	util_xds "github.com/kumahq/kuma/pkg/util/xds"
 	util_xds_v3 "github.com/kumahq/kuma/pkg/util/xds/v3"
 	"github.com/kumahq/kuma/pkg/xds/auth"
 	auth_components "github.com/kumahq/kuma/pkg/xds/auth/components"
 	"github.com/kumahq/kuma/pkg/xds/cache/mesh"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/drain"
 	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
 	"github.com/kumahq/kuma/pkg/xds/generator"
 	xds_metrics "github.com/kumahq/kuma/pkg/xds/metrics"
+	"github.com/kumahq/kuma/pkg/xds/propagation"
+	"github.com/kumahq/kuma/pkg/xds/resourceusage"
 	"github.com/kumahq/kuma/pkg/xds/secrets"
+	secrets_files "github.com/kumahq/kuma/pkg/xds/secrets/files"
+	secrets_logging "github.com/kumahq/kuma/pkg/xds/secrets/logging"
 	xds_callbacks "github.com/kumahq/kuma/pkg/xds/server/callbacks"
 	xds_sync "github.com/kumahq/kuma/pkg/xds/sync"
 	xds_template "github.com/kumahq/kuma/pkg/xds/template"
@@ -35,6 +42,10 @@ func RegisterXDS(
 	envoyCpCtx *xds_context.ControlPlaneContext,
 	rt core_runtime.Runtime,
 ) error {
+	if rt.Config().XdsServer.Shadow.Enabled {
+		return registerShadowReconciler(meshSnapshotCache, envoyCpCtx, rt)
+	}
+
 	xdsContext := NewXdsContext()
 
 	authenticator, err := auth_components.DefaultAuthenticator(rt)
@@ -43,8 +54,13 @@ func RegisterXDS(
 	}
 	authCallbacks := auth.NewCallbacks(rt.ReadOnlyResourceManager(), authenticator, auth.DPNotFoundRetry{}) // no need to retry on DP Not Found because we are creating DP in DataplaneLifecycle callback
 
+	propagationTracker, err := propagation.Setup(rt)
+	if err != nil {
+		return err
+	}
+
 	metadataTracker := xds_callbacks.NewDataplaneMetadataTracker()
-	reconciler := DefaultReconciler(rt, xdsContext)
+	reconciler := DefaultReconciler(rt, xdsContext, propagationTracker, xdsMetrics)
 	ingressReconciler := DefaultIngressReconciler(rt, xdsContext)
 	watchdogFactory, err := xds_sync.DefaultDataplaneWatchdogFactory(rt, metadataTracker, reconciler, ingressReconciler, xdsMetrics, meshSnapshotCache, envoyCpCtx, envoy_common.APIV3)
 	if err != nil {
@@ -60,6 +76,7 @@ func RegisterXDS(
 		util_xds_v3.AdaptCallbacks(xds_callbacks.DataplaneCallbacksToXdsCallbacks(xds_callbacks.NewDataplaneLifecycle(rt.AppContext(), rt.ResourceManager()))),
 		util_xds_v3.AdaptCallbacks(DefaultDataplaneStatusTracker(rt, envoyCpCtx.Secrets)),
 		util_xds_v3.AdaptCallbacks(xds_callbacks.NewNackBackoff(rt.Config().XdsServer.NACKBackoff)),
+		util_xds_v3.AdaptCallbacks(propagation.NewCallbacks(propagationTracker)),
 		newResourceWarmingForcer(xdsContext.Cache(), xdsContext.Hasher()),
 	}
 
@@ -67,10 +84,68 @@ func RegisterXDS(
 
 	xdsServerLog.Info("registering Aggregated Discovery Service V3 in Dataplane Server")
 	envoy_service_discovery.RegisterAggregatedDiscoveryServiceServer(rt.DpServer().GrpcServer(), srv)
+
+	secrets_files.RegisterSecrets(rt, authenticator, envoyCpCtx.Secrets)
+	secrets_logging.RegisterLoggingSecrets(rt, authenticator)
+	resourceusage.RegisterResourceUsage(rt, authenticator)
+	drain.RegisterDrain(rt, authenticator)
+
+	previewEndpoints := &proxyTemplatePreviewEndpoints{
+		proxyBuilder:     xds_sync.DefaultOnDemandDataplaneProxyBuilder(rt, metadataTracker, meshSnapshotCache, envoyCpCtx, envoy_common.APIV3),
+		resourceSetHooks: rt.XDSHooks().ResourceSetHooks(),
+		resourceAccess:   rt.Access().ResourceAccess,
+	}
+	rt.APIManager().Add(previewEndpoints.webService())
+
+	snapshotEndpoints := &xdsSnapshotEndpoints{
+		snapshots:       &simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()},
+		metadataTracker: metadataTracker,
+		resourceAccess:  rt.Access().ResourceAccess,
+	}
+	rt.APIManager().Add(snapshotEndpoints.webService())
 	return nil
 }
 
-func DefaultReconciler(rt core_runtime.Runtime, xdsContext XdsContext) xds_sync.SnapshotReconciler {
+// registerShadowReconciler registers the shadow mode instead of the XDS server, so Dataplanes cannot connect
+// to the Control Plane and their configuration is only compared with the active Control Plane.
+func registerShadowReconciler(meshSnapshotCache *mesh.Cache, envoyCpCtx *xds_context.ControlPlaneContext, rt core_runtime.Runtime) error {
+	cfg := rt.Config().XdsServer.Shadow
+	xdsServerLog.Info("shadow mode is enabled, XDS is not served", "activeControlPlaneUrl", cfg.ActiveControlPlaneURL)
+
+	fetcher, err := newHTTPSnapshotFetcher(cfg.ActiveControlPlaneURL, cfg.ActiveControlPlaneAuthToken)
+	if err != nil {
+		return err
+	}
+	divergences, failures, err := newShadowMetrics(rt.Metrics())
+	if err != nil {
+		return err
+	}
+	metadataTracker := &shadowMetadataTracker{
+		metadataForDp: map[core_model.ResourceKey]*core_xds.DataplaneMetadata{},
+	}
+	shadow := &shadowReconciler{
+		resManager:      rt.ReadOnlyResourceManager(),
+		active:          fetcher,
+		activeURL:       cfg.ActiveControlPlaneURL,
+		metadataTracker: metadataTracker,
+		proxyBuilder:    xds_sync.DefaultOnDemandDataplaneProxyBuilder(rt, metadataTracker, meshSnapshotCache, envoyCpCtx, envoy_common.APIV3),
+		templateResolver: xds_template.SequentialResolver(
+			&xds_template.SimpleProxyTemplateResolver{
+				ReadOnlyResourceManager: rt.ReadOnlyResourceManager(),
+			},
+			generator.DefaultTemplateResolver,
+		),
+		resourceSetHooks: rt.XDSHooks().ResourceSetHooks(),
+		interval:         cfg.Interval,
+		divergences:      divergences,
+		failures:         failures,
+		reports:          map[core_model.ResourceKey]types.ShadowDataplaneReport{},
+	}
+	rt.APIManager().Add(shadow.webService())
+	return rt.Add(shadow)
+}
+
+func DefaultReconciler(rt core_runtime.Runtime, xdsContext XdsContext, tracker *propagation.Tracker, xdsMetrics *xds_metrics.Metrics) xds_sync.SnapshotReconciler {
 	resolver := xds_template.SequentialResolver(
 		&xds_template.SimpleProxyTemplateResolver{
 			ReadOnlyResourceManager: rt.ReadOnlyResourceManager(),
@@ -78,12 +153,26 @@ func DefaultReconciler(rt core_runtime.Runtime, xdsContext XdsContext) xds_sync.
 		generator.DefaultTemplateResolver,
 	)
 
+	var cacher snapshotCacher = &simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()}
+	if xdsMetrics != nil {
+		cacher = &drainCountingSnapshotCacher{
+			snapshotCacher: cacher,
+			drains:         xdsMetrics.XdsListenerDrains,
+		}
+	}
+	if tracker != nil {
+		cacher = &trackingSnapshotCacher{
+			snapshotCacher: cacher,
+			tracker:        tracker,
+		}
+	}
+
 	return &reconciler{
 		&templateSnapshotGenerator{
 			ResourceSetHooks:      rt.XDSHooks().ResourceSetHooks(),
 			ProxyTemplateResolver: resolver,
 		},
-		&simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()},
+		cacher,
 	}
 }
 
@@ -0,0 +1,144 @@
+package v3
+
+import (
+	"github.com/emicklei/go-restful"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/resources/access"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	model "github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/generator/patches"
+	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
+)
+
+type onDemandProxyBuilder interface {
+	Build(key core_model.ResourceKey) (*xds_context.Context, *model.Proxy, error)
+}
+
+// proxyTemplatePreviewEndpoints renders the Envoy resources that a ProxyTemplate would produce for a data plane proxy
+// without applying the ProxyTemplate, so potentially breaking modifications can be verified upfront.
+type proxyTemplatePreviewEndpoints struct {
+	proxyBuilder     onDemandProxyBuilder
+	resourceSetHooks []xds_hooks.ResourceSetHook
+	resourceAccess   access.ResourceAccess
+}
+
+func (e *proxyTemplatePreviewEndpoints) webService() *restful.WebService {
+	ws := new(restful.WebService).
+		Path("/meshes/{mesh}/dataplanes/{name}/xds-preview").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.POST("").To(e.preview).
+		Doc("Render Envoy resources of a dataplane with the given proxy template").
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(400, "Invalid proxy template", nil).
+		Returns(404, "Not found", nil))
+	return ws
+}
+
+func (e *proxyTemplatePreviewEndpoints) preview(request *restful.Request, response *restful.Response) {
+	key := core_model.ResourceKey{
+		Mesh: request.PathParameter("mesh"),
+		Name: request.PathParameter("name"),
+	}
+
+	template := core_mesh.NewProxyTemplateResource()
+	res := rest.Resource{Spec: template.Spec}
+	if err := request.ReadEntity(&res); err != nil {
+		rest_errors.HandleError(response, err, "Could not process a proxy template")
+		return
+	}
+	if err := template.Validate(); err != nil {
+		rest_errors.HandleError(response, err, "Invalid proxy template")
+		return
+	}
+
+	currentUser := user.FromCtx(request.Request.Context())
+	if err := e.resourceAccess.ValidateGet(key, core_mesh.NewDataplaneResource().Descriptor(), currentUser); err != nil {
+		rest_errors.HandleError(response, err, "Access Denied")
+		return
+	}
+	if err := e.resourceAccess.ValidateCreate(core_model.ResourceKey{Mesh: key.Mesh, Name: res.Meta.Name}, template.Spec, template.Descriptor(), currentUser); err != nil {
+		rest_errors.HandleError(response, err, "Access Denied")
+		return
+	}
+
+	ctx, proxy, err := e.proxyBuilder.Build(key)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not build a dataplane proxy")
+		return
+	}
+	rs, patchResult, err := generateResources(*ctx, proxy, template.Spec, e.resourceSetHooks)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not render a proxy template")
+		return
+	}
+
+	preview, err := previewResources(rs)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not render a proxy template")
+		return
+	}
+	previewPatches(preview, patchResult)
+	if err := response.WriteAsJson(preview); err != nil {
+		rest_errors.HandleError(response, err, "Could not render a proxy template")
+	}
+}
+
+func previewResources(rs *model.ResourceSet) (*types.ProxyTemplatePreviewResponse, error) {
+	preview := &types.ProxyTemplatePreviewResponse{
+		Resources: []types.ProxyTemplatePreviewResource{},
+	}
+	for _, resource := range rs.List() {
+		item := types.ProxyTemplatePreviewResource{
+			Name:   resource.Name,
+			Type:   "type.googleapis.com/" + proto.MessageName(resource.Resource),
+			Origin: resource.Origin,
+		}
+		if validator, ok := resource.Resource.(interface{ Validate() error }); ok {
+			if err := validator.Validate(); err != nil {
+				item.Errors = append(item.Errors, err.Error())
+			}
+		}
+		if item.Type != envoy_resource.SecretType { // never expose private keys, the name is enough to verify a reference
+			json, err := util_proto.ToJSON(resource.Resource)
+			if err != nil {
+				return nil, err
+			}
+			item.Resource = json
+		}
+		preview.Resources = append(preview.Resources, item)
+	}
+	return preview, nil
+}
+
+func previewPatches(preview *types.ProxyTemplatePreviewResponse, result patches.Result) {
+	for _, applied := range result.Applied {
+		preview.AppliedPatches = append(preview.AppliedPatches, types.ProxyPatchPreview{
+			Policy:   applied.Policy,
+			Index:    applied.Index,
+			Type:     applied.Type,
+			Resource: applied.Resource,
+		})
+	}
+	for _, conflict := range result.Conflicts {
+		preview.ConflictingPatches = append(preview.ConflictingPatches, types.ProxyPatchPreview{
+			Policy:        conflict.Policy,
+			Index:         conflict.Index,
+			Type:          conflict.Type,
+			Resource:      conflict.Resource,
+			Path:          conflict.Path,
+			ConflictsWith: conflict.ConflictsWith,
+		})
+	}
+}
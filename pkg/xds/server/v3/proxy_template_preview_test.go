@@ -0,0 +1,176 @@
+package v3
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	config_access "github.com/kumahq/kuma/pkg/config/access"
+	"github.com/kumahq/kuma/pkg/core/resources/access"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	model "github.com/kumahq/kuma/pkg/core/xds"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	"github.com/kumahq/kuma/pkg/test/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+)
+
+type staticProxyBuilder struct {
+	ctx   *xds_context.Context
+	proxy *model.Proxy
+}
+
+func (s *staticProxyBuilder) Build(key core_model.ResourceKey) (*xds_context.Context, *model.Proxy, error) {
+	return s.ctx, s.proxy, nil
+}
+
+var _ = Describe("ProxyTemplate preview", func() {
+
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		ctx := &xds_context.Context{
+			ControlPlane: &xds_context.ControlPlaneContext{
+				Secrets: &xds.TestSecrets{},
+			},
+			Mesh: xds_context.MeshContext{
+				Resource: &core_mesh.MeshResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "demo",
+					},
+					Spec: &mesh_proto.Mesh{
+						Mtls: &mesh_proto.Mesh_Mtls{
+							EnabledBackend: "builtin",
+							Backends: []*mesh_proto.CertificateAuthorityBackend{
+								{
+									Name: "builtin",
+									Type: "builtin",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		proxy := &model.Proxy{
+			Id:         *model.BuildProxyId("demo", "web1"),
+			APIVersion: envoy_common.APIV3,
+			Dataplane: &core_mesh.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Name:    "web1",
+					Mesh:    "demo",
+					Version: "1",
+				},
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Port:        80,
+								ServicePort: 8080,
+								Tags: map[string]string{
+									mesh_proto.ServiceTag: "web1",
+								},
+							},
+						},
+					},
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		endpoints := &proxyTemplatePreviewEndpoints{
+			proxyBuilder:   &staticProxyBuilder{ctx: ctx, proxy: proxy},
+			resourceAccess: access.NewAdminResourceAccess(config_access.AdminResourcesStaticAccessConfig{}),
+		}
+		container := restful.NewContainer()
+		container.Add(endpoints.webService())
+		server = httptest.NewServer(container)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	preview := func(template string) *http.Response {
+		resp, err := http.Post(server.URL+"/meshes/demo/dataplanes/web1/xds-preview", "application/json", strings.NewReader(template))
+		Expect(err).ToNot(HaveOccurred())
+		return resp
+	}
+
+	It("should render resources and report the ones rejected by the Envoy API", func() {
+		// when
+		resp := preview(`{
+			"type": "ProxyTemplate",
+			"mesh": "demo",
+			"name": "pt-1",
+			"selectors": [{"match": {"kuma.io/service": "*"}}],
+			"conf": {
+				"imports": ["default-proxy"],
+				"modifications": [{
+					"cluster": {
+						"operation": "add",
+						"value": "name: broken\nconnectTimeout: -1s\ntype: STATIC"
+					}
+				}]
+			}
+		}`)
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		result := types.ProxyTemplatePreviewResponse{}
+		Expect(json.Unmarshal(body, &result)).To(Succeed())
+
+		resources := map[string]types.ProxyTemplatePreviewResource{}
+		var secrets []types.ProxyTemplatePreviewResource
+		for _, resource := range result.Resources {
+			resources[resource.Name] = resource
+			if resource.Type == envoy_resource.SecretType {
+				secrets = append(secrets, resource)
+			}
+		}
+
+		// and the added cluster is rendered with validation errors
+		Expect(resources).To(HaveKey("broken"))
+		Expect(resources["broken"].Type).To(Equal(envoy_resource.ClusterType))
+		Expect(resources["broken"].Errors).To(ConsistOf(ContainSubstring("ConnectTimeout")))
+
+		// and resources generated by Kuma are valid
+		Expect(resources).To(HaveKey("localhost:8080"))
+		Expect(resources["localhost:8080"].Errors).To(BeEmpty())
+		Expect(resources["localhost:8080"].Resource).ToNot(BeEmpty())
+
+		// and secrets are not exposed
+		Expect(secrets).ToNot(BeEmpty())
+		for _, secret := range secrets {
+			Expect(secret.Resource).To(BeEmpty())
+		}
+	})
+
+	It("should reject an invalid proxy template", func() {
+		// when
+		resp := preview(`{
+			"type": "ProxyTemplate",
+			"mesh": "demo",
+			"name": "pt-1",
+			"conf": {
+				"imports": ["default-proxy"]
+			}
+		}`)
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -2,17 +2,22 @@ package v3
 
 import (
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	model "github.com/kumahq/kuma/pkg/core/xds"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	"github.com/kumahq/kuma/pkg/xds/generator"
+	"github.com/kumahq/kuma/pkg/xds/generator/patches"
 	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
+	"github.com/kumahq/kuma/pkg/xds/propagation"
 	xds_sync "github.com/kumahq/kuma/pkg/xds/sync"
 	xds_template "github.com/kumahq/kuma/pkg/xds/template"
 )
@@ -98,17 +103,13 @@ type templateSnapshotGenerator struct {
 func (s *templateSnapshotGenerator) GenerateSnapshot(ctx xds_context.Context, proxy *model.Proxy) (envoy_cache.Snapshot, error) {
 	template := s.ProxyTemplateResolver.GetTemplate(proxy)
 
-	gen := generator.ProxyTemplateGenerator{ProxyTemplate: template}
-
-	rs, err := gen.Generate(ctx, proxy)
+	rs, patchResult, err := generateResources(ctx, proxy, template, s.ResourceSetHooks)
 	if err != nil {
 		reconcileLog.Error(err, "failed to generate a snapshot", "proxy", proxy, "template", template)
 		return envoy_cache.Snapshot{}, err
 	}
-	for _, hook := range s.ResourceSetHooks {
-		if err := hook.Modify(rs, ctx, proxy); err != nil {
-			return envoy_cache.Snapshot{}, errors.Wrapf(err, "could not apply hook %T", hook)
-		}
+	if len(patchResult.Conflicts) > 0 {
+		reconcileLog.V(1).Info("skipped conflicting proxy patches", "proxy", proxy.Id, "conflicts", patchResult.Conflicts)
 	}
 
 	version := "" // empty value is a sign to other components to generate the version automatically
@@ -125,6 +126,27 @@ func (s *templateSnapshotGenerator) GenerateSnapshot(ctx xds_context.Context, pr
 	return out, nil
 }
 
+// generateResources renders the resources of a proxy from the template, applies the matched ProxyPatches
+// and then the hooks on top of them.
+func generateResources(ctx xds_context.Context, proxy *model.Proxy, template *mesh_proto.ProxyTemplate, hooks []xds_hooks.ResourceSetHook) (*model.ResourceSet, patches.Result, error) {
+	gen := generator.ProxyTemplateGenerator{ProxyTemplate: template}
+
+	rs, err := gen.Generate(ctx, proxy)
+	if err != nil {
+		return nil, patches.Result{}, err
+	}
+	patchResult, err := patches.Apply(rs, proxy.Policies.ProxyPatches)
+	if err != nil {
+		return nil, patches.Result{}, errors.Wrap(err, "could not apply proxy patches")
+	}
+	for _, hook := range hooks {
+		if err := hook.Modify(rs, ctx, proxy); err != nil {
+			return nil, patches.Result{}, errors.Wrapf(err, "could not apply hook %T", hook)
+		}
+	}
+	return rs, patchResult, nil
+}
+
 type snapshotCacher interface {
 	Get(*envoy_core.Node) (envoy_cache.Snapshot, error)
 	Cache(*envoy_core.Node, envoy_cache.Snapshot) error
@@ -147,3 +169,96 @@ func (s *simpleSnapshotCacher) Cache(node *envoy_core.Node, snapshot envoy_cache
 func (s *simpleSnapshotCacher) Clear(node *envoy_core.Node) {
 	s.store.ClearSnapshot(s.hasher.ID(node))
 }
+
+var typeURLs = map[envoy_types.ResponseType]string{
+	envoy_types.Listener: envoy_resource.ListenerType,
+	envoy_types.Route:    envoy_resource.RouteType,
+	envoy_types.Cluster:  envoy_resource.ClusterType,
+	envoy_types.Endpoint: envoy_resource.EndpointType,
+	envoy_types.Secret:   envoy_resource.SecretType,
+}
+
+// trackingSnapshotCacher notifies the propagation Tracker about the versions of the snapshot before it's cached,
+// so the proxy cannot acknowledge the versions before the Tracker knows about them.
+type trackingSnapshotCacher struct {
+	snapshotCacher
+	tracker *propagation.Tracker
+}
+
+func (t *trackingSnapshotCacher) Cache(node *envoy_core.Node, snapshot envoy_cache.Snapshot) error {
+	if proxyID, err := model.ParseProxyIdFromString(node.Id); err == nil {
+		previous, err := t.snapshotCacher.Get(node)
+		initial := err != nil
+		versions := map[string]string{}
+		for typ, typeURL := range typeURLs {
+			if snapshot.Resources[typ].Version != previous.Resources[typ].Version {
+				versions[typeURL] = snapshot.Resources[typ].Version
+			}
+		}
+		if changeIDs := t.tracker.OnSnapshot(proxyID, versions, initial); len(changeIDs) > 0 {
+			reconcileLog.V(1).Info("configuration includes changes of policies", "proxy", proxyID.String(), "changeIds", changeIDs)
+		}
+	}
+	return t.snapshotCacher.Cache(node, snapshot)
+}
+
+// Listener drain kinds, see drainCountingSnapshotCacher.
+const (
+	drainFilterChains = "filter_chains"
+	drainListener     = "listener"
+)
+
+// drainCountingSnapshotCacher counts the listeners that Envoy drains when the snapshot replaces the previous one.
+// When only the filter chains of a listener change, Envoy updates it in place and only drains the connections of
+// the filter chains that changed. Any other change, or the removal of the listener, drains all its connections.
+type drainCountingSnapshotCacher struct {
+	snapshotCacher
+	drains *prometheus.CounterVec
+}
+
+func (d *drainCountingSnapshotCacher) Cache(node *envoy_core.Node, snapshot envoy_cache.Snapshot) error {
+	if previous, err := d.snapshotCacher.Get(node); err == nil {
+		for drain, count := range listenerDrains(previous.Resources[envoy_types.Listener].Items, snapshot.Resources[envoy_types.Listener].Items) {
+			d.drains.WithLabelValues(drain).Add(float64(count))
+		}
+	}
+	return d.snapshotCacher.Cache(node, snapshot)
+}
+
+// listenerDrains returns the number of listeners that are drained, by drain kind, when the new listeners replace
+// the old ones.
+func listenerDrains(old, new map[string]envoy_types.ResourceWithTtl) map[string]int {
+	drains := map[string]int{}
+	for name, oldValue := range old {
+		newValue, ok := new[name]
+		if !ok {
+			drains[drainListener]++
+			continue
+		}
+		if proto.Equal(oldValue.Resource, newValue.Resource) {
+			continue
+		}
+		if equalWithoutFilterChains(oldValue.Resource, newValue.Resource) {
+			drains[drainFilterChains]++
+		} else {
+			drains[drainListener]++
+		}
+	}
+	return drains
+}
+
+func equalWithoutFilterChains(old, new envoy_types.Resource) bool {
+	oldListener, ok := old.(*envoy_listener.Listener)
+	if !ok {
+		return false
+	}
+	newListener, ok := new.(*envoy_listener.Listener)
+	if !ok {
+		return false
+	}
+	oldListener = proto.Clone(oldListener).(*envoy_listener.Listener)
+	newListener = proto.Clone(newListener).(*envoy_listener.Listener)
+	oldListener.FilterChains, oldListener.DefaultFilterChain = nil, nil
+	newListener.FilterChains, newListener.DefaultFilterChain = nil, nil
+	return proto.Equal(oldListener, newListener)
+}
@@ -15,6 +15,7 @@ import (
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	xds_model "github.com/kumahq/kuma/pkg/core/xds"
 	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 )
 
@@ -194,6 +195,44 @@ var _ = Describe("Reconcile", func() {
 			Expect(snapshot.Resources[envoy_types.Secret].Version).To(BeEmpty())
 		})
 	})
+
+	Describe("listenerDrains", func() {
+		listener := func(name string, bufferLimit uint32, filterChains ...string) envoy_types.ResourceWithTtl {
+			l := &envoy_listener.Listener{
+				Name:                          name,
+				PerConnectionBufferLimitBytes: util_proto.UInt32(bufferLimit),
+			}
+			for _, chain := range filterChains {
+				l.FilterChains = append(l.FilterChains, &envoy_listener.FilterChain{Name: chain})
+			}
+			return envoy_types.ResourceWithTtl{Resource: l}
+		}
+
+		It("should count the drained listeners by drain kind", func() {
+			// given
+			old := map[string]envoy_types.ResourceWithTtl{
+				"unchanged":     listener("unchanged", 1024, "a"),
+				"filter-chains": listener("filter-chains", 1024, "a", "b"),
+				"listener":      listener("listener", 1024, "a"),
+				"removed":       listener("removed", 1024, "a"),
+			}
+			new := map[string]envoy_types.ResourceWithTtl{
+				"unchanged":     listener("unchanged", 1024, "a"),
+				"filter-chains": listener("filter-chains", 1024, "a", "c"),
+				"listener":      listener("listener", 2048, "a"),
+				"added":         listener("added", 1024, "a"),
+			}
+
+			// when
+			drains := listenerDrains(old, new)
+
+			// then
+			Expect(drains).To(Equal(map[string]int{
+				drainFilterChains: 1,
+				drainListener:     2,
+			}))
+		})
+	})
 })
 
 type snapshotGeneratorFunc func(ctx xds_context.Context, proxy *xds_model.Proxy) (envoy_cache.Snapshot, error)
@@ -0,0 +1,325 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	model "github.com/kumahq/kuma/pkg/core/xds"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
+	xds_template "github.com/kumahq/kuma/pkg/xds/template"
+)
+
+var shadowLog = xdsServerLog.WithName("shadow")
+
+// errNotServedByInstance means that the data plane proxy is not connected to the instance of the active Control Plane
+// that handled the request, it will be compared once a request reaches the right instance.
+var errNotServedByInstance = errors.New("dataplane is not connected to the instance of the active Control Plane")
+
+type activeSnapshotFetcher interface {
+	Snapshot(ctx context.Context, key core_model.ResourceKey) (*types.DataplaneXdsSnapshot, error)
+}
+
+type httpSnapshotFetcher struct {
+	client util_http.Client
+}
+
+func newHTTPSnapshotFetcher(activeURL string, authToken string) (*httpSnapshotFetcher, error) {
+	baseURL, err := url.Parse(activeURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid URL of the active Control Plane %q", activeURL)
+	}
+	headers := map[string]string{}
+	if authToken != "" {
+		headers["Authorization"] = "Bearer " + authToken
+	}
+	return &httpSnapshotFetcher{
+		client: util_http.ClientWithBaseURL(&http.Client{Timeout: 10 * time.Second}, baseURL, headers),
+	}, nil
+}
+
+func (f *httpSnapshotFetcher) Snapshot(ctx context.Context, key core_model.ResourceKey) (*types.DataplaneXdsSnapshot, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/meshes/%s/dataplanes/%s/xds", key.Mesh, key.Name), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errNotServedByInstance
+	default:
+		return nil, errors.Errorf("active Control Plane responded with status code %d: %s", resp.StatusCode, string(body))
+	}
+	snapshot := &types.DataplaneXdsSnapshot{}
+	if err := json.Unmarshal(body, snapshot); err != nil {
+		return nil, errors.Wrap(err, "could not parse configuration of the active Control Plane")
+	}
+	return snapshot, nil
+}
+
+// shadowMetadataTracker provides metadata of Dataplanes as they are reported by the active Control Plane,
+// because Dataplanes are not connected to a Control Plane in the shadow mode.
+type shadowMetadataTracker struct {
+	sync.RWMutex
+	metadataForDp map[core_model.ResourceKey]*model.DataplaneMetadata
+}
+
+func (t *shadowMetadataTracker) Metadata(dpKey core_model.ResourceKey) *model.DataplaneMetadata {
+	t.RLock()
+	defer t.RUnlock()
+	return t.metadataForDp[dpKey]
+}
+
+func (t *shadowMetadataTracker) set(dpKey core_model.ResourceKey, metadata *model.DataplaneMetadata) {
+	t.Lock()
+	defer t.Unlock()
+	t.metadataForDp[dpKey] = metadata
+}
+
+func (t *shadowMetadataTracker) delete(dpKey core_model.ResourceKey) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.metadataForDp, dpKey)
+}
+
+// shadowReconciler generates configuration for online Dataplanes the same way as the reconciler does,
+// but instead of serving it, it compares it with the configuration served by the active Control Plane
+// and reports divergences. It is used to verify a new version of the Control Plane before it replaces the active one.
+type shadowReconciler struct {
+	resManager       manager.ReadOnlyResourceManager
+	active           activeSnapshotFetcher
+	activeURL        string
+	metadataTracker  *shadowMetadataTracker
+	proxyBuilder     onDemandProxyBuilder
+	templateResolver xds_template.ProxyTemplateResolver
+	resourceSetHooks []xds_hooks.ResourceSetHook
+	interval         time.Duration
+	divergences      prometheus.Gauge
+	failures         prometheus.Counter
+
+	sync.RWMutex
+	reports map[core_model.ResourceKey]types.ShadowDataplaneReport
+}
+
+var _ component.Component = &shadowReconciler{}
+
+func newShadowMetrics(metrics core_metrics.Metrics) (prometheus.Gauge, prometheus.Counter, error) {
+	divergences := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "xds_shadow_divergences",
+		Help: "Number of XDS resources generated in the shadow mode that diverge from the active Control Plane",
+	})
+	if err := metrics.Register(divergences); err != nil {
+		return nil, nil, err
+	}
+	errs := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "xds_shadow_errors",
+		Help: "Counter of errors during comparison of XDS configuration with the active Control Plane",
+	})
+	if err := metrics.Register(errs); err != nil {
+		return nil, nil, err
+	}
+	return divergences, errs, nil
+}
+
+func (s *shadowReconciler) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	shadowLog.Info("started", "activeControlPlaneUrl", s.activeURL)
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.compareAll(context.Background()); err != nil {
+				shadowLog.Error(err, "unable to compare configuration with the active Control Plane")
+			}
+		case <-stop:
+			shadowLog.Info("stopped")
+			return nil
+		}
+	}
+}
+
+func (s *shadowReconciler) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *shadowReconciler) compareAll(ctx context.Context) error {
+	insights := &core_mesh.DataplaneInsightResourceList{}
+	if err := s.resManager.List(ctx, insights); err != nil {
+		return err
+	}
+	online := map[core_model.ResourceKey]bool{}
+	for _, insight := range insights.Items {
+		if !insight.Spec.IsOnline() {
+			continue
+		}
+		key := core_model.MetaToResourceKey(insight.GetMeta())
+		online[key] = true
+		report := types.ShadowDataplaneReport{
+			Mesh:        key.Mesh,
+			Name:        key.Name,
+			ComparedAt:  core.Now(),
+			Divergences: []types.XdsDivergence{},
+		}
+		divergences, err := s.compare(ctx, key)
+		switch {
+		case err == errNotServedByInstance:
+			continue
+		case err != nil:
+			s.failures.Inc()
+			shadowLog.Error(err, "unable to compare configuration with the active Control Plane", "dataplane", key)
+			report.Error = err.Error()
+		case len(divergences) > 0:
+			shadowLog.Info("configuration diverges from the active Control Plane", "dataplane", key, "divergences", divergences)
+			report.Divergences = divergences
+		}
+		s.Lock()
+		s.reports[key] = report
+		s.Unlock()
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	total := 0
+	for key, report := range s.reports {
+		if !online[key] {
+			delete(s.reports, key)
+			continue
+		}
+		total += len(report.Divergences)
+	}
+	s.divergences.Set(float64(total))
+	return nil
+}
+
+func (s *shadowReconciler) compare(ctx context.Context, key core_model.ResourceKey) ([]types.XdsDivergence, error) {
+	active, err := s.active.Snapshot(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := dataplaneMetadata(active.Metadata)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid metadata of the dataplane")
+	}
+	s.metadataTracker.set(key, metadata)
+	defer s.metadataTracker.delete(key)
+
+	xdsCtx, proxy, err := s.proxyBuilder.Build(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not build a dataplane proxy")
+	}
+	rs, _, err := generateResources(*xdsCtx, proxy, s.templateResolver.GetTemplate(proxy), s.resourceSetHooks)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate configuration")
+	}
+	generated, err := generatedSnapshotResources(rs)
+	if err != nil {
+		return nil, err
+	}
+	return diffSnapshots(active.Resources, generated), nil
+}
+
+// diffSnapshots compares resources by their type and name. Secrets are compared only by name,
+// because their content is never exposed.
+func diffSnapshots(active []types.XdsSnapshotResource, generated []types.XdsSnapshotResource) []types.XdsDivergence {
+	type resourceKey struct {
+		typ  string
+		name string
+	}
+	activeResources := map[resourceKey]types.XdsSnapshotResource{}
+	for _, res := range active {
+		activeResources[resourceKey{res.Type, res.Name}] = res
+	}
+
+	divergences := []types.XdsDivergence{}
+	for _, res := range generated {
+		key := resourceKey{res.Type, res.Name}
+		activeRes, ok := activeResources[key]
+		delete(activeResources, key)
+		switch {
+		case !ok:
+			divergences = append(divergences, types.XdsDivergence{Kind: types.XdsDivergenceUnexpected, Type: res.Type, Name: res.Name})
+		case !equalJSON(activeRes.Resource, res.Resource):
+			divergences = append(divergences, types.XdsDivergence{Kind: types.XdsDivergenceDifferent, Type: res.Type, Name: res.Name})
+		}
+	}
+	for key := range activeResources {
+		divergences = append(divergences, types.XdsDivergence{Kind: types.XdsDivergenceMissing, Type: key.typ, Name: key.name})
+	}
+	sort.Slice(divergences, func(i, j int) bool {
+		if divergences[i].Type != divergences[j].Type {
+			return divergences[i].Type < divergences[j].Type
+		}
+		return divergences[i].Name < divergences[j].Name
+	})
+	return divergences
+}
+
+// equalJSON compares JSON documents regardless of the formatting and the order of fields.
+func equalJSON(a, b json.RawMessage) bool {
+	var aValue, bValue interface{}
+	if json.Unmarshal(a, &aValue) != nil || json.Unmarshal(b, &bValue) != nil {
+		return bytes.Equal(a, b)
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+func (s *shadowReconciler) webService() *restful.WebService {
+	ws := new(restful.WebService).
+		Path("/shadow-report").
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.GET("").To(s.report).
+		Doc("Report divergences between configuration generated in the shadow mode and the active Control Plane").
+		Returns(200, "OK", nil))
+	return ws
+}
+
+func (s *shadowReconciler) report(_ *restful.Request, response *restful.Response) {
+	s.RLock()
+	report := types.ShadowReport{
+		ActiveControlPlaneURL: s.activeURL,
+		Dataplanes:            []types.ShadowDataplaneReport{},
+	}
+	for _, dataplane := range s.reports {
+		report.Dataplanes = append(report.Dataplanes, dataplane)
+	}
+	s.RUnlock()
+	sort.Slice(report.Dataplanes, func(i, j int) bool {
+		if report.Dataplanes[i].Mesh != report.Dataplanes[j].Mesh {
+			return report.Dataplanes[i].Mesh < report.Dataplanes[j].Mesh
+		}
+		return report.Dataplanes[i].Name < report.Dataplanes[j].Name
+	})
+	if err := response.WriteAsJson(report); err != nil {
+		rest_errors.HandleError(response, err, "Could not write a shadow report")
+	}
+}
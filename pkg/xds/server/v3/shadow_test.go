@@ -0,0 +1,297 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	config_access "github.com/kumahq/kuma/pkg/config/access"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/access"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	model "github.com/kumahq/kuma/pkg/core/xds"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	"github.com/kumahq/kuma/pkg/test/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	xds_callbacks "github.com/kumahq/kuma/pkg/xds/server/callbacks"
+	xds_template "github.com/kumahq/kuma/pkg/xds/template"
+)
+
+var _ = Describe("Shadow mode", func() {
+
+	Describe("diffSnapshots()", func() {
+		resource := func(typ, name, json string) types.XdsSnapshotResource {
+			return types.XdsSnapshotResource{Name: name, Type: typ, Resource: []byte(json)}
+		}
+
+		DescribeTable("should report divergences",
+			func(active, generated []types.XdsSnapshotResource, expected []types.XdsDivergence) {
+				Expect(diffSnapshots(active, generated)).To(Equal(expected))
+			},
+			Entry("same resources regardless of formatting",
+				[]types.XdsSnapshotResource{resource(envoy_resource.ClusterType, "backend", `{"name": "backend", "connectTimeout": "5s"}`)},
+				[]types.XdsSnapshotResource{resource(envoy_resource.ClusterType, "backend", `{"connectTimeout":"5s","name":"backend"}`)},
+				[]types.XdsDivergence{},
+			),
+			Entry("secrets are compared only by name",
+				[]types.XdsSnapshotResource{resource(envoy_resource.SecretType, "identity_cert", "")},
+				[]types.XdsSnapshotResource{resource(envoy_resource.SecretType, "identity_cert", "")},
+				[]types.XdsDivergence{},
+			),
+			Entry("missing, unexpected and different resources",
+				[]types.XdsSnapshotResource{
+					resource(envoy_resource.ClusterType, "backend", `{"name": "backend", "connectTimeout": "5s"}`),
+					resource(envoy_resource.ClusterType, "web", `{"name": "web"}`),
+					resource(envoy_resource.ListenerType, "inbound", `{"name": "inbound"}`),
+				},
+				[]types.XdsSnapshotResource{
+					resource(envoy_resource.ClusterType, "backend", `{"name": "backend", "connectTimeout": "10s"}`),
+					resource(envoy_resource.ListenerType, "inbound", `{"name": "inbound"}`),
+					resource(envoy_resource.ListenerType, "outbound", `{"name": "outbound"}`),
+				},
+				[]types.XdsDivergence{
+					{Kind: types.XdsDivergenceDifferent, Type: envoy_resource.ClusterType, Name: "backend"},
+					{Kind: types.XdsDivergenceMissing, Type: envoy_resource.ClusterType, Name: "web"},
+					{Kind: types.XdsDivergenceUnexpected, Type: envoy_resource.ListenerType, Name: "outbound"},
+				},
+			),
+		)
+	})
+
+	Describe("shadowReconciler", func() {
+		var activeServer *httptest.Server
+		var activeSnapshots *simpleSnapshotCacher
+		var shadow *shadowReconciler
+		var proxy *model.Proxy
+		var xdsCtx *xds_context.Context
+		var resolver xds_template.ProxyTemplateResolver
+		key := core_model.ResourceKey{Mesh: "demo", Name: "web1"}
+
+		BeforeEach(func() {
+			xdsCtx = &xds_context.Context{
+				ControlPlane: &xds_context.ControlPlaneContext{
+					Secrets: &xds.TestSecrets{},
+				},
+				Mesh: xds_context.MeshContext{
+					Resource: &core_mesh.MeshResource{
+						Meta: &test_model.ResourceMeta{
+							Name: "demo",
+						},
+						Spec: &mesh_proto.Mesh{},
+					},
+				},
+			}
+			proxy = &model.Proxy{
+				Id:         *model.BuildProxyId("demo", "web1"),
+				APIVersion: envoy_common.APIV3,
+				Dataplane: &core_mesh.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name:    "web1",
+						Mesh:    "demo",
+						Version: "1",
+					},
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "192.168.0.1",
+							Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+								{
+									Port:        80,
+									ServicePort: 8080,
+									Tags: map[string]string{
+										mesh_proto.ServiceTag: "web1",
+									},
+								},
+							},
+						},
+					},
+				},
+				Metadata: &model.DataplaneMetadata{},
+			}
+			resolver = &xds_template.StaticProxyTemplateResolver{
+				Template: &mesh_proto.ProxyTemplate{
+					Conf: &mesh_proto.ProxyTemplate_Conf{
+						Imports: []string{core_mesh.ProfileDefaultProxy},
+					},
+				},
+			}
+
+			// active Control Plane
+			xdsContext := NewXdsContext()
+			activeSnapshots = &simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()}
+			metadataTracker := xds_callbacks.NewDataplaneMetadataTracker()
+			Expect(metadataTracker.OnProxyConnected(1, key, context.Background(), model.DataplaneMetadata{
+				DataplaneToken: "token",
+				AdminPort:      9901,
+			})).To(Succeed())
+			endpoints := &xdsSnapshotEndpoints{
+				snapshots:       activeSnapshots,
+				metadataTracker: metadataTracker,
+				resourceAccess:  access.NewAdminResourceAccess(config_access.AdminResourcesStaticAccessConfig{}),
+			}
+			container := restful.NewContainer()
+			container.Add(endpoints.webService())
+			activeServer = httptest.NewServer(container)
+
+			// Control Plane in the shadow mode
+			resManager := manager.NewResourceManager(memory.NewStore())
+			Expect(resManager.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("demo", core_model.NoMesh))).To(Succeed())
+			insight := core_mesh.NewDataplaneInsightResource()
+			insight.Spec.Subscriptions = []*mesh_proto.DiscoverySubscription{{
+				Id:          "1",
+				ConnectTime: timestamppb.New(core.Now()),
+			}}
+			Expect(resManager.Create(context.Background(), insight, store.CreateBy(key))).To(Succeed())
+
+			fetcher, err := newHTTPSnapshotFetcher(activeServer.URL, "")
+			Expect(err).ToNot(HaveOccurred())
+			metrics, err := core_metrics.NewMetrics("")
+			Expect(err).ToNot(HaveOccurred())
+			divergences, failures, err := newShadowMetrics(metrics)
+			Expect(err).ToNot(HaveOccurred())
+			shadow = &shadowReconciler{
+				resManager:       resManager,
+				active:           fetcher,
+				activeURL:        activeServer.URL,
+				metadataTracker:  &shadowMetadataTracker{metadataForDp: map[core_model.ResourceKey]*model.DataplaneMetadata{}},
+				proxyBuilder:     &staticProxyBuilder{ctx: xdsCtx, proxy: proxy},
+				templateResolver: resolver,
+				divergences:      divergences,
+				failures:         failures,
+				reports:          map[core_model.ResourceKey]types.ShadowDataplaneReport{},
+			}
+		})
+
+		AfterEach(func() {
+			activeServer.Close()
+		})
+
+		serveActiveSnapshot := func(modify func(snapshot envoy_cache.Snapshot)) {
+			generator := &templateSnapshotGenerator{ProxyTemplateResolver: resolver}
+			snapshot, err := generator.GenerateSnapshot(*xdsCtx, proxy)
+			Expect(err).ToNot(HaveOccurred())
+			modify(snapshot)
+			Expect(activeSnapshots.Cache(&envoy_core.Node{Id: proxy.Id.String()}, snapshot)).To(Succeed())
+		}
+
+		It("should not report divergences when configuration is the same", func() {
+			// given
+			serveActiveSnapshot(func(envoy_cache.Snapshot) {})
+
+			// when
+			Expect(shadow.compareAll(context.Background())).To(Succeed())
+
+			// then
+			Expect(shadow.reports).To(HaveKey(key))
+			Expect(shadow.reports[key].Error).To(BeEmpty())
+			Expect(shadow.reports[key].Divergences).To(BeEmpty())
+		})
+
+		It("should report resources that are not generated in the shadow mode", func() {
+			// given
+			var listener string
+			serveActiveSnapshot(func(snapshot envoy_cache.Snapshot) {
+				listeners := snapshot.Resources[envoy_types.Listener].Items
+				for name, resource := range listeners {
+					listener = name
+					listeners["copy-of-"+name] = resource
+					break
+				}
+			})
+
+			// when
+			Expect(shadow.compareAll(context.Background())).To(Succeed())
+
+			// then
+			Expect(shadow.reports[key].Divergences).To(Equal([]types.XdsDivergence{
+				{Kind: types.XdsDivergenceMissing, Type: envoy_resource.ListenerType, Name: "copy-of-" + listener},
+			}))
+		})
+
+		It("should skip dataplanes that are not connected to the instance of the active Control Plane", func() {
+			// when
+			Expect(shadow.compareAll(context.Background())).To(Succeed())
+
+			// then
+			Expect(shadow.reports).To(BeEmpty())
+		})
+
+		It("should expose the report", func() {
+			// given
+			serveActiveSnapshot(func(envoy_cache.Snapshot) {})
+			Expect(shadow.compareAll(context.Background())).To(Succeed())
+			container := restful.NewContainer()
+			container.Add(shadow.webService())
+			server := httptest.NewServer(container)
+			defer server.Close()
+
+			// when
+			resp, err := server.Client().Get(server.URL + "/shadow-report")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			defer resp.Body.Close()
+			report := types.ShadowReport{}
+			Expect(json.NewDecoder(resp.Body).Decode(&report)).To(Succeed())
+			Expect(report.ActiveControlPlaneURL).To(Equal(activeServer.URL))
+			Expect(report.Dataplanes).To(HaveLen(1))
+			Expect(report.Dataplanes[0].Mesh).To(Equal("demo"))
+			Expect(report.Dataplanes[0].Name).To(Equal("web1"))
+			Expect(report.Dataplanes[0].Divergences).To(BeEmpty())
+		})
+	})
+
+	Describe("xdsSnapshotMetadata()", func() {
+		It("should not expose the token and should be reversible", func() {
+			// given
+			metadata := &model.DataplaneMetadata{
+				DataplaneToken:  "token",
+				AdminPort:       9901,
+				DNSPort:         15053,
+				EmptyDNSPort:    15054,
+				ProxyType:       mesh_proto.DataplaneProxyType,
+				SecretsDir:      "/tmp/secrets",
+				DynamicMetadata: map[string]string{"version.dependencies": "1.0"},
+				Version: &mesh_proto.Version{
+					KumaDp: &mesh_proto.KumaDpVersion{Version: "1.3.0"},
+				},
+			}
+
+			// when
+			exposed, err := xdsSnapshotMetadata(metadata)
+			Expect(err).ToNot(HaveOccurred())
+			bytes, err := json.Marshal(exposed)
+			Expect(err).ToNot(HaveOccurred())
+			restored, err := dataplaneMetadata(exposed)
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(string(bytes)).ToNot(ContainSubstring("token"))
+			Expect(restored.DataplaneToken).To(BeEmpty())
+			Expect(restored.AdminPort).To(Equal(uint32(9901)))
+			Expect(restored.DNSPort).To(Equal(uint32(15053)))
+			Expect(restored.EmptyDNSPort).To(Equal(uint32(15054)))
+			Expect(restored.ProxyType).To(Equal(mesh_proto.DataplaneProxyType))
+			Expect(restored.SecretsDir).To(Equal("/tmp/secrets"))
+			Expect(restored.DynamicMetadata).To(Equal(metadata.DynamicMetadata))
+			Expect(restored.GetVersion().GetKumaDp().GetVersion()).To(Equal("1.3.0"))
+		})
+	})
+})
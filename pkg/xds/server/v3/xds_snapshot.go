@@ -0,0 +1,173 @@
+package v3
+
+import (
+	"sort"
+
+	"github.com/emicklei/go-restful"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/golang/protobuf/proto"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/resources/access"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	model "github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_sync "github.com/kumahq/kuma/pkg/xds/sync"
+)
+
+// snapshotTypes are the types of resources of a snapshot in the order in which they are exposed.
+var snapshotTypes = []struct {
+	typ     envoy_types.ResponseType
+	typeURL string
+}{
+	{envoy_types.Listener, envoy_resource.ListenerType},
+	{envoy_types.Route, envoy_resource.RouteType},
+	{envoy_types.Cluster, envoy_resource.ClusterType},
+	{envoy_types.Endpoint, envoy_resource.EndpointType},
+	{envoy_types.Secret, envoy_resource.SecretType},
+}
+
+// xdsSnapshotEndpoints exposes the configuration that the Control Plane instance serves to a connected data plane proxy,
+// so a Control Plane in the shadow mode can compare it with the configuration it generates.
+type xdsSnapshotEndpoints struct {
+	snapshots       snapshotCacher
+	metadataTracker xds_sync.DataplaneMetadataTracker
+	resourceAccess  access.ResourceAccess
+}
+
+func (e *xdsSnapshotEndpoints) webService() *restful.WebService {
+	ws := new(restful.WebService).
+		Path("/meshes/{mesh}/dataplanes/{name}/xds").
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.GET("").To(e.snapshot).
+		Doc("Get Envoy resources served to a dataplane connected to this instance of the Control Plane").
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+	return ws
+}
+
+func (e *xdsSnapshotEndpoints) snapshot(request *restful.Request, response *restful.Response) {
+	key := core_model.ResourceKey{
+		Mesh: request.PathParameter("mesh"),
+		Name: request.PathParameter("name"),
+	}
+	if err := e.resourceAccess.ValidateGet(key, core_mesh.NewDataplaneResource().Descriptor(), user.FromCtx(request.Request.Context())); err != nil {
+		rest_errors.HandleError(response, err, "Access Denied")
+		return
+	}
+
+	// the proxy may be connected to a different instance of the Control Plane
+	metadata := e.metadataTracker.Metadata(key)
+	proxyId := model.FromResourceKey(key)
+	snapshot, err := e.snapshots.Get(&envoy_core.Node{Id: proxyId.String()})
+	if metadata == nil || err != nil {
+		rest_errors.HandleError(response, core_store.ErrorResourceNotFound(core_mesh.DataplaneType, key.Name, key.Mesh), "Could not retrieve XDS configuration")
+		return
+	}
+
+	result := types.DataplaneXdsSnapshot{
+		Resources: []types.XdsSnapshotResource{},
+	}
+	if result.Metadata, err = xdsSnapshotMetadata(metadata); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve XDS configuration")
+		return
+	}
+	for _, snapshotType := range snapshotTypes {
+		items := snapshot.Resources[snapshotType.typ].Items
+		names := make([]string, 0, len(items))
+		for name := range items {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			resource, err := xdsSnapshotResource(name, items[name].Resource)
+			if err != nil {
+				rest_errors.HandleError(response, err, "Could not retrieve XDS configuration")
+				return
+			}
+			result.Resources = append(result.Resources, resource)
+		}
+	}
+	if err := response.WriteAsJson(result); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve XDS configuration")
+	}
+}
+
+// generatedSnapshotResources returns the resources of the set that are a part of a snapshot in the same form
+// as they are exposed by xdsSnapshotEndpoints.
+func generatedSnapshotResources(rs *model.ResourceSet) ([]types.XdsSnapshotResource, error) {
+	result := []types.XdsSnapshotResource{}
+	for _, snapshotType := range snapshotTypes {
+		for _, res := range rs.ListOf(snapshotType.typeURL) {
+			resource, err := xdsSnapshotResource(res.Name, res.Resource)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, resource)
+		}
+	}
+	return result, nil
+}
+
+func xdsSnapshotResource(name string, resource envoy_types.Resource) (types.XdsSnapshotResource, error) {
+	item := types.XdsSnapshotResource{
+		Name: name,
+		Type: "type.googleapis.com/" + proto.MessageName(resource),
+	}
+	if item.Type != envoy_resource.SecretType { // never expose private keys
+		json, err := util_proto.ToJSON(resource)
+		if err != nil {
+			return types.XdsSnapshotResource{}, err
+		}
+		item.Resource = json
+	}
+	return item, nil
+}
+
+func xdsSnapshotMetadata(metadata *model.DataplaneMetadata) (types.DataplaneXdsMetadata, error) {
+	result := types.DataplaneXdsMetadata{
+		AdminPort:       metadata.GetAdminPort(),
+		DNSPort:         metadata.GetDNSPort(),
+		EmptyDNSPort:    metadata.GetEmptyDNSPort(),
+		ProxyType:       string(metadata.GetProxyType()),
+		SecretsDir:      metadata.GetSecretsDir(),
+		DynamicMetadata: metadata.DynamicMetadata,
+	}
+	if version := metadata.GetVersion(); version != nil {
+		json, err := util_proto.ToJSON(version)
+		if err != nil {
+			return types.DataplaneXdsMetadata{}, err
+		}
+		result.Version = json
+	}
+	return result, nil
+}
+
+// dataplaneMetadata is the reverse of xdsSnapshotMetadata.
+func dataplaneMetadata(metadata types.DataplaneXdsMetadata) (*model.DataplaneMetadata, error) {
+	result := &model.DataplaneMetadata{
+		AdminPort:       metadata.AdminPort,
+		DNSPort:         metadata.DNSPort,
+		EmptyDNSPort:    metadata.EmptyDNSPort,
+		ProxyType:       mesh_proto.ProxyType(metadata.ProxyType),
+		SecretsDir:      metadata.SecretsDir,
+		DynamicMetadata: metadata.DynamicMetadata,
+	}
+	if len(metadata.Version) > 0 {
+		version := &mesh_proto.Version{}
+		if err := util_proto.FromJSON(metadata.Version, version); err != nil {
+			return nil, err
+		}
+		result.Version = version
+	}
+	return result, nil
+}
@@ -31,7 +31,7 @@ func defaultDataplaneProxyBuilder(rt core_runtime.Runtime, metadataTracker Datap
 		Zone:                  rt.Config().Multizone.Zone.Name,
 		APIVersion:            apiVersion,
 		ConfigManager:         rt.ConfigManager(),
-		TopLevelDomain:        rt.Config().DNSServer.Domain,
+		TopLevelDomains:       rt.Config().DNSServer.Domains(),
 	}
 }
 
@@ -41,6 +41,7 @@ func defaultIngressProxyBuilder(rt core_runtime.Runtime, metadataTracker Datapla
 		ReadOnlyResManager: rt.ReadOnlyResourceManager(),
 		LookupIP:           rt.LookupIP(),
 		MetadataTracker:    metadataTracker,
+		Zone:               rt.Config().Multizone.Zone.Name,
 		apiVersion:         apiVersion,
 	}
 }
@@ -75,3 +76,18 @@ func DefaultDataplaneWatchdogFactory(
 		deps,
 	)
 }
+
+func DefaultOnDemandDataplaneProxyBuilder(
+	rt core_runtime.Runtime,
+	metadataTracker DataplaneMetadataTracker,
+	meshSnapshotCache *mesh.Cache,
+	envoyCpCtx *xds_context.ControlPlaneContext,
+	apiVersion envoy.APIVersion,
+) *OnDemandDataplaneProxyBuilder {
+	return &OnDemandDataplaneProxyBuilder{
+		dataplaneProxyBuilder: defaultDataplaneProxyBuilder(rt, metadataTracker, apiVersion),
+		xdsContextBuilder:     newXDSContextBuilder(envoyCpCtx, rt.ReadOnlyResourceManager(), rt.LookupIP(), rt.EnvoyAdminClient()),
+		meshCache:             meshSnapshotCache,
+		metadataTracker:       metadataTracker,
+	}
+}
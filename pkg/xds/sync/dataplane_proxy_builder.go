@@ -39,15 +39,21 @@ type DataplaneProxyBuilder struct {
 	FaultInjectionMatcher faultinjections.FaultInjectionMatcher
 	RateLimitMatcher      ratelimits.RateLimitMatcher
 
-	Zone           string
-	APIVersion     envoy.APIVersion
-	ConfigManager  config_manager.ConfigManager
-	TopLevelDomain string
+	Zone            string
+	APIVersion      envoy.APIVersion
+	ConfigManager   config_manager.ConfigManager
+	TopLevelDomains []string
 }
 
 func (p *DataplaneProxyBuilder) Build(key core_model.ResourceKey, envoyContext *xds_context.Context) (*xds.Proxy, error) {
 	ctx := context.Background()
 
+	metadata := p.MetadataTracker.Metadata(key)
+	if envoyContext.Mesh.Resource.FIPSEnabled() && !metadata.GetVersion().GetEnvoy().IsFIPS() {
+		return nil, errors.Errorf("mesh %q requires FIPS mode, but Envoy of the dataplane %q is not a FIPS build (build: %q)",
+			key.Mesh, key.Name, metadata.GetVersion().GetEnvoy().GetBuild())
+	}
+
 	dp, err := p.resolveDataplane(ctx, key)
 	if err != nil {
 		return nil, err
@@ -72,7 +78,7 @@ func (p *DataplaneProxyBuilder) Build(key core_model.ResourceKey, envoyContext *
 		Id:                  xds.FromResourceKey(key),
 		APIVersion:          p.APIVersion,
 		Dataplane:           dp,
-		Metadata:            p.MetadataTracker.Metadata(key),
+		Metadata:            metadata,
 		Routing:             *routing,
 		Policies:            *matchedPolicies,
 		ServiceTLSReadiness: tlsReady,
@@ -126,7 +132,7 @@ func (p *DataplaneProxyBuilder) resolveRouting(
 			return nil, nil, err
 		}
 		// resolve all the domains
-		domains, outbounds = xds_topology.VIPOutbounds(virtualOutboundView, p.TopLevelDomain)
+		domains, outbounds = xds_topology.VIPOutbounds(virtualOutboundView, p.TopLevelDomains)
 
 		// Update the outbound of the dataplane with the generatedVips
 		generatedVips := map[string]bool{}
@@ -173,6 +179,11 @@ func (p *DataplaneProxyBuilder) matchPolicies(ctx context.Context, meshContext *
 		return nil, err
 	}
 
+	connectionPools, err := xds_topology.GetConnectionPools(ctx, dataplane, outboundSelectors, p.CachingResManager)
+	if err != nil {
+		return nil, err
+	}
+
 	trafficTrace, err := xds_topology.GetTrafficTrace(ctx, dataplane, p.CachingResManager)
 	if err != nil {
 		return nil, err
@@ -212,17 +223,24 @@ func (p *DataplaneProxyBuilder) matchPolicies(ctx context.Context, meshContext *
 		return nil, err
 	}
 
+	proxyPatches, err := xds_topology.GetProxyPatches(ctx, dataplane, p.CachingResManager)
+	if err != nil {
+		return nil, err
+	}
+
 	matchedPolicies := &xds.MatchedPolicies{
 		TrafficPermissions: matchedPermissions,
 		Logs:               matchedLogs,
 		HealthChecks:       healthChecks,
 		CircuitBreakers:    circuitBreakers,
+		ConnectionPools:    connectionPools,
 		TrafficTrace:       trafficTrace,
 		TracingBackend:     tracingBackend,
 		FaultInjections:    faultInjection,
 		Retries:            retries,
 		Timeouts:           timeouts,
 		RateLimits:         ratelimits,
+		ProxyPatches:       proxyPatches,
 	}
 	return matchedPolicies, nil
 }
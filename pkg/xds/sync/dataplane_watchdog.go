@@ -70,7 +70,7 @@ func (d *DataplaneWatchdog) Sync() error {
 	}
 	switch d.dpType {
 	case mesh_proto.DataplaneProxyType:
-		return d.syncDataplane()
+		return d.syncDataplane(metadata)
 	case mesh_proto.IngressProxyType:
 		return d.syncIngress()
 	default:
@@ -94,7 +94,7 @@ func (d *DataplaneWatchdog) Cleanup() error {
 
 // syncDataplane syncs state of the Dataplane.
 // It uses Mesh Hash to decide if we need to regenerate configuration or not.
-func (d *DataplaneWatchdog) syncDataplane() error {
+func (d *DataplaneWatchdog) syncDataplane(metadata *core_xds.DataplaneMetadata) error {
 	snapshotHash, err := d.meshCache.GetHash(context.Background(), d.key.Mesh)
 	if err != nil {
 		return err
@@ -116,6 +116,7 @@ func (d *DataplaneWatchdog) syncDataplane() error {
 	if err != nil {
 		return err
 	}
+	envoyCtx.SecretsDir = metadata.GetSecretsDir()
 	proxy, err := d.dataplaneProxyBuilder.Build(d.key, envoyCtx)
 	if err != nil {
 		return err
@@ -38,7 +38,9 @@ func (d *dataplaneWatchdogFactory) New(dpKey model.ResourceKey) util_watchdog.Wa
 		OnTick: func() error {
 			start := core.Now()
 			defer func() {
-				d.xdsMetrics.XdsGenerations.Observe(float64(core.Now().Sub(start).Milliseconds()))
+				elapsed := float64(core.Now().Sub(start).Milliseconds())
+				d.xdsMetrics.XdsGenerations.Observe(elapsed)
+				d.xdsMetrics.XdsMeshGenerations.WithLabelValues(d.xdsMetrics.MeshLabels.Label(dpKey.Mesh)).Observe(elapsed)
 			}()
 			return dataplaneWatchdog.Sync()
 		},
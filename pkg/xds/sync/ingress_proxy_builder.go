@@ -3,8 +3,10 @@ package sync
 import (
 	"context"
 
+	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/dns/lookup"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
@@ -19,6 +21,8 @@ type IngressProxyBuilder struct {
 	ReadOnlyResManager manager.ReadOnlyResourceManager
 	LookupIP           lookup.LookupIPFunc
 	MetadataTracker    DataplaneMetadataTracker
+	// Zone is the name of the zone of this Control Plane, it's empty in standalone mode
+	Zone string
 
 	apiVersion envoy.APIVersion
 }
@@ -138,8 +142,29 @@ func (p *IngressProxyBuilder) updateIngress(zoneIngress *core_mesh.ZoneIngressRe
 	}
 	allMeshDataplanes.Items = xds_topology.ResolveAddresses(syncLog, p.LookupIP, allMeshDataplanes.Items)
 
+	drainRemaining, err := p.drainRemaining(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Update Ingress' Available Services
 	// This was placed as an operation of DataplaneWatchdog out of the convenience.
 	// Consider moving to the outside of this component (follow the pattern of updating VIP outbounds)
-	return ingress.UpdateAvailableServices(ctx, p.ResManager, zoneIngress, allMeshDataplanes.Items)
+	return ingress.UpdateAvailableServices(ctx, p.ResManager, zoneIngress, allMeshDataplanes.Items, drainRemaining)
+}
+
+// drainRemaining returns the fraction of instances that the ingress should advertise to other zones.
+// Zone is synced from the Global Control Plane and it's draining when it was disabled with draining.
+func (p *IngressProxyBuilder) drainRemaining(ctx context.Context) (float64, error) {
+	if p.Zone == "" {
+		return 1, nil
+	}
+	zone := system.NewZoneResource()
+	if err := p.ReadOnlyResManager.Get(ctx, zone, core_store.GetByKey(p.Zone, core_model.NoMesh)); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			return 1, nil
+		}
+		return 0, err
+	}
+	return zone.Spec.DrainRemaining(core.Now()), nil
 }
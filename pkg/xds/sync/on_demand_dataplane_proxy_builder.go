@@ -0,0 +1,36 @@
+package sync
+
+import (
+	"context"
+
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/xds/cache/mesh"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+)
+
+// OnDemandDataplaneProxyBuilder builds the context and the proxy of a Dataplane the same way as DataplaneWatchdog does,
+// but outside of the sync loop, i.e. to preview the configuration of the Dataplane.
+type OnDemandDataplaneProxyBuilder struct {
+	dataplaneProxyBuilder *DataplaneProxyBuilder
+	xdsContextBuilder     *xdsContextBuilder
+	meshCache             *mesh.Cache
+	metadataTracker       DataplaneMetadataTracker
+}
+
+func (b *OnDemandDataplaneProxyBuilder) Build(key core_model.ResourceKey) (*xds_context.Context, *core_xds.Proxy, error) {
+	meshHash, err := b.meshCache.GetHash(context.Background(), key.Mesh)
+	if err != nil {
+		return nil, nil, err
+	}
+	envoyCtx, err := b.xdsContextBuilder.buildMeshedContext(key, meshHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	envoyCtx.SecretsDir = b.metadataTracker.Metadata(key).GetSecretsDir()
+	proxy, err := b.dataplaneProxyBuilder.Build(key, envoyCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return envoyCtx, proxy, nil
+}
@@ -0,0 +1,42 @@
+package topology
+
+import (
+	"context"
+
+	"github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+)
+
+// GetConnectionPools resolves all ConnectionPools applicable to a given Dataplane.
+func GetConnectionPools(ctx context.Context, dataplane *core_mesh.DataplaneResource, destinations core_xds.DestinationMap, manager core_manager.ReadOnlyResourceManager) (core_xds.ConnectionPoolMap, error) {
+	if len(destinations) == 0 {
+		return nil, nil
+	}
+	connectionPools := &core_mesh.ConnectionPoolResourceList{}
+	if err := manager.List(ctx, connectionPools, core_store.ListByMesh(dataplane.Meta.GetMesh())); err != nil {
+		return nil, err
+	}
+	return BuildConnectionPoolMap(dataplane, destinations, connectionPools.Items), nil
+}
+
+// BuildConnectionPoolMap creates a map with connection pool settings per reachable service.
+func BuildConnectionPoolMap(dataplane *core_mesh.DataplaneResource, destinations core_xds.DestinationMap, connectionPools []*core_mesh.ConnectionPoolResource) core_xds.ConnectionPoolMap {
+	if len(destinations) == 0 || len(connectionPools) == 0 {
+		return nil
+	}
+	policies := make([]policy.ConnectionPolicy, len(connectionPools))
+	for i, connectionPool := range connectionPools {
+		policies[i] = connectionPool
+	}
+
+	policyMap := policy.SelectConnectionPolicies(dataplane, policy.ToServicesOf(destinations), policies)
+
+	connectionPoolMap := core_xds.ConnectionPoolMap{}
+	for service, policy := range policyMap {
+		connectionPoolMap[service] = policy.(*core_mesh.ConnectionPoolResource)
+	}
+	return connectionPoolMap
+}
@@ -14,7 +14,7 @@ const VIPListenPort = uint32(80)
 
 func VIPOutbounds(
 	virtualOutboundView *vips.VirtualOutboundMeshView,
-	tldomain string,
+	tldomains []string,
 ) ([]xds.VIPDomains, []*mesh_proto.Dataplane_Networking_Outbound) {
 	var vipDomains []xds.VIPDomains
 	var outbounds []*mesh_proto.Dataplane_Networking_Outbound
@@ -52,10 +52,12 @@ func VIPOutbounds(
 		case vips.Service:
 			ob := voutbound.Outbounds[0]
 			service := ob.TagSet[mesh_proto.ServiceTag]
-			domain.Domains = []string{service + "." + tldomain}
-			cleanedDomain := strings.ReplaceAll(service, "_", ".") + "." + tldomain
-			if cleanedDomain != domain.Domains[0] {
-				domain.Domains = append(domain.Domains, cleanedDomain)
+			for _, tldomain := range tldomains {
+				domain.Domains = append(domain.Domains, service+"."+tldomain)
+				cleanedDomain := strings.ReplaceAll(service, "_", ".") + "." + tldomain
+				if cleanedDomain != service+"."+tldomain {
+					domain.Domains = append(domain.Domains, cleanedDomain)
+				}
 			}
 			if ob.Port != 0 {
 				outbounds = append(outbounds, &mesh_proto.Dataplane_Networking_Outbound{
@@ -15,6 +15,7 @@ var _ = Describe("VIPOutbounds", func() {
 
 	type outboundTestCase struct {
 		whenOutbounds map[vips.HostnameEntry]vips.VirtualOutbound
+		whenDomains   []string
 		thenVips      []xds.VIPDomains
 		thenOutbounds []*mesh_proto.Dataplane_Networking_Outbound
 	}
@@ -23,7 +24,11 @@ var _ = Describe("VIPOutbounds", func() {
 			vobView, err := vips.NewVirtualOutboundView(tc.whenOutbounds)
 			Expect(err).ToNot(HaveOccurred())
 
-			vips, outbounds := topology.VIPOutbounds(vobView, "mesh")
+			domains := tc.whenDomains
+			if domains == nil {
+				domains = []string{"mesh"}
+			}
+			vips, outbounds := topology.VIPOutbounds(vobView, domains)
 
 			Expect(vips).To(Equal(tc.thenVips))
 			Expect(outbounds).To(Equal(tc.thenOutbounds))
@@ -125,6 +130,26 @@ var _ = Describe("VIPOutbounds", func() {
 				{Address: "240.0.0.1", Port: 80, Tags: map[string]string{mesh_proto.ServiceTag: "example_svc_80"}},
 			},
 		}),
+		Entry("service generates hostnames in additional domains", outboundTestCase{
+			whenOutbounds: map[vips.HostnameEntry]vips.VirtualOutbound{
+				vips.NewServiceEntry("example_svc_80"): {
+					Address: "240.0.0.1",
+					Outbounds: []vips.OutboundEntry{
+						{TagSet: map[string]string{mesh_proto.ServiceTag: "example_svc_80"}},
+					},
+				},
+			},
+			whenDomains: []string{"mesh", "internal.corp"},
+			thenVips: []xds.VIPDomains{
+				{Address: "240.0.0.1", Domains: []string{
+					"example_svc_80.mesh", "example.svc.80.mesh",
+					"example_svc_80.internal.corp", "example.svc.80.internal.corp",
+				}},
+			},
+			thenOutbounds: []*mesh_proto.Dataplane_Networking_Outbound{
+				{Address: "240.0.0.1", Port: 80, Tags: map[string]string{mesh_proto.ServiceTag: "example_svc_80"}},
+			},
+		}),
 		Entry("multi outbounds work", outboundTestCase{
 			whenOutbounds: map[vips.HostnameEntry]vips.VirtualOutbound{
 				vips.NewFqdnEntry("my-foo-service-generated.mesh"): {
@@ -55,7 +55,7 @@ var _ = Describe("TrafficRoute", func() {
 
 	BeforeEach(func() {
 		secretManager := secret_manager.NewSecretManager(secret_store.NewSecretStore(memory.NewStore()), cipher.None(), nil)
-		dataSourceLoader = datasource.NewDataSourceLoader(secretManager)
+		dataSourceLoader = datasource.NewDataSourceLoader(secretManager, nil)
 	})
 	Describe("GetOutboundTargets()", func() {
 		It("should pick proper dataplanes for each outbound destination", func() {
@@ -0,0 +1,31 @@
+package topology
+
+import (
+	"context"
+
+	core_policy "github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+// GetProxyPatches returns all ProxyPatches that select a given Dataplane, starting from the most specific one.
+func GetProxyPatches(ctx context.Context, dataplane *core_mesh.DataplaneResource, manager core_manager.ReadOnlyResourceManager) ([]*core_mesh.ProxyPatchResource, error) {
+	patches := core_mesh.ProxyPatchResourceList{}
+	if err := manager.List(ctx, &patches, store.ListByMesh(dataplane.GetMeta().GetMesh())); err != nil {
+		return nil, err
+	}
+	return SelectProxyPatches(dataplane, patches.Items), nil
+}
+
+func SelectProxyPatches(dataplane *core_mesh.DataplaneResource, patches []*core_mesh.ProxyPatchResource) []*core_mesh.ProxyPatchResource {
+	policies := make([]core_policy.DataplanePolicy, len(patches))
+	for i, patch := range patches {
+		policies[i] = patch
+	}
+	var selected []*core_mesh.ProxyPatchResource
+	for _, policy := range core_policy.SelectDataplanePolicies(dataplane, policies) {
+		selected = append(selected, policy.(*core_mesh.ProxyPatchResource))
+	}
+	return selected
+}
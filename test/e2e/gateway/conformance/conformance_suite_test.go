@@ -0,0 +1,21 @@
+package conformance_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+
+	"github.com/kumahq/kuma/pkg/test"
+	"github.com/kumahq/kuma/test/e2e/gateway/conformance"
+	"github.com/kumahq/kuma/test/framework"
+)
+
+var _ = Describe("Gateway Conformance on Universal", conformance.ConformanceOnUniversal)
+
+func TestE2EGatewayConformance(t *testing.T) {
+	if framework.IsK8sClustersStarted() {
+		test.RunSpecs(t, "E2E Gateway Conformance Suite")
+	} else {
+		t.SkipNow()
+	}
+}
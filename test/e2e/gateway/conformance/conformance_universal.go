@@ -0,0 +1,577 @@
+package conformance
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/gruntwork-io/terratest/modules/shell"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/test/resources/builders"
+	"github.com/kumahq/kuma/pkg/tls"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/test/e2e/trafficroute/testutil"
+	. "github.com/kumahq/kuma/test/framework"
+	"github.com/kumahq/kuma/test/server/types"
+)
+
+const (
+	gatewayHostname = "example.kuma.io"
+	httpPort        = 8080
+	httpsPort       = 8443
+)
+
+// request is a request that the client sends to the gateway.
+type request struct {
+	path string
+	opts []testutil.CollectResponsesOptsFn
+}
+
+func get(path string, opts ...testutil.CollectResponsesOptsFn) request {
+	return request{path: path, opts: opts}
+}
+
+func testServerUniversal(name string, service string, args ...string) InstallFunc {
+	return func(cluster Cluster) error {
+		token, err := cluster.GetKuma().GenerateDpToken("default", service)
+		if err != nil {
+			return err
+		}
+
+		return TestServerUniversal(
+			name,
+			"default",
+			token,
+			WithArgs(append([]string{"echo", "--instance", service}, args...)),
+			WithServiceName(service),
+		)(cluster)
+	}
+}
+
+func gatewayClientUniversal(name string) InstallFunc {
+	return func(cluster Cluster) error {
+		return cluster.DeployApp(WithName(name), WithoutDataplane(), WithVerbose())
+	}
+}
+
+func gatewayProxyUniversal(name string) InstallFunc {
+	return func(cluster Cluster) error {
+		token, err := cluster.GetKuma().GenerateDpToken("default", "edge-gateway")
+		if err != nil {
+			return err
+		}
+
+		dataplaneYaml := `
+type: Dataplane
+mesh: default
+name: {{ name }}
+networking:
+  address:  {{ address }}
+  gateway:
+    type: BUILTIN
+    tags:
+      kuma.io/service: edge-gateway
+`
+		return cluster.DeployApp(
+			WithKumactlFlow(),
+			WithName(name),
+			WithToken(token),
+			WithVerbose(),
+			WithYaml(dataplaneYaml),
+		)
+	}
+}
+
+// certificateSecretUniversal creates a secret with a self-signed
+// certificate of the gateway hostname.
+func certificateSecretUniversal(name string) InstallFunc {
+	return func(cluster Cluster) error {
+		keyPair, err := tls.NewSelfSignedCert(gatewayHostname, tls.ServerCertType, gatewayHostname)
+		if err != nil {
+			return err
+		}
+
+		data := bytes.Join([][]byte{keyPair.CertPEM, keyPair.KeyPEM}, []byte("\n"))
+
+		return YamlUniversal(fmt.Sprintf(`
+type: Secret
+mesh: default
+name: %s
+data: %s
+`, name, base64.StdEncoding.EncodeToString(data)))(cluster)
+	}
+}
+
+// ConformanceOnUniversal specifies how the GatewayRoutes of the builtin
+// gateway are proxied: each type of match, each filter that changes the
+// request and TLS termination. Cross-mesh backends and Kubernetes
+// clusters aren't covered.
+func ConformanceOnUniversal() {
+	var cluster *UniversalCluster
+	var supported bool
+
+	// mirrored receives the requests of the mirror service.
+	mirrored := gbytes.NewBuffer()
+
+	E2EBeforeSuite(func() {
+		cluster = NewUniversalCluster(NewTestingT(), Kuma1, Silent)
+		Expect(cluster).ToNot(BeNil())
+
+		// The gateway proxy is deployed first, so that the unsupported
+		// gateway type is detected before the rest of the setup.
+		err := NewClusterSetup().
+			Install(Kuma(config_core.Standalone, append(KumaUniversalDeployOpts, WithVerbose())...)).
+			Install(gatewayProxyUniversal("gateway-proxy")).
+			Install(Parallel(
+				gatewayClientUniversal("gateway-client"),
+				testServerUniversal("echo-server", "echo-service"),
+				testServerUniversal("fallback-server", "fallback-service"),
+				testServerUniversal("mirror-server", "mirror-service", "--log-requests"),
+			)).
+			Setup(cluster)
+
+		// Kuma is built without the builtin gateway unless
+		// BUILD_WITH_EXPERIMENTAL_GATEWAY is set, in which case
+		// the specs are skipped, unless conformance is required.
+		var shellErr *shell.ErrWithCmdOutput
+		if errors.As(err, &shellErr) && !IsGatewayConformance() {
+			if strings.Contains(shellErr.Output.Combined(), "unsupported gateway type") {
+				return
+			}
+		}
+		Expect(err).To(Succeed())
+		Expect(cluster.VerifyKuma()).To(Succeed())
+
+		cluster.GetApp("mirror-server").StreamLogs(mirrored)
+
+		Expect(certificateSecretUniversal("example-kuma-io-certificate")(cluster)).To(Succeed())
+		Expect(
+			ResourceUniversal(
+				builders.Gateway().
+					WithService("edge-gateway").
+					AddListener(builders.HTTPListener(httpPort).
+						WithHostname(gatewayHostname),
+					).
+					AddListener(builders.HTTPSListener(httpsPort, "example-kuma-io-certificate").
+						WithHostname(gatewayHostname),
+					).
+					MustBuild(),
+			)(cluster),
+		).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			dataplanes, err := cluster.GetKumactlOptions().KumactlList("dataplanes", "default")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(dataplanes).Should(ContainElements(
+				"gateway-proxy", "echo-server", "fallback-server", "mirror-server"))
+		}, "60s", "1s").Should(Succeed())
+
+		supported = true
+	})
+
+	E2EAfterSuite(func() {
+		Expect(cluster.DismissCluster()).To(Succeed())
+	})
+
+	BeforeEach(func() {
+		if !supported {
+			Skip("kuma-cp builtin Gateway support is not enabled")
+		}
+	})
+
+	E2EAfterEach(func() {
+		if !supported {
+			return
+		}
+
+		Expect(
+			cluster.GetKumactlOptions().KumactlDelete("gateway-route", "conformance", "default"),
+		).To(Succeed())
+	})
+
+	// routeRules routes the requests with the given rules, and the
+	// requests that no rule matches to the fallback service.
+	routeRules := func(rules ...*builders.HTTPRuleBuilder) {
+		route := builders.GatewayRoute().
+			WithName("conformance").
+			WithService("edge-gateway").
+			AddHTTPRule(builders.HTTPRule().
+				MatchPrefix("/").
+				AddBackends(builders.Backend("fallback-service")),
+			)
+
+		for _, rule := range rules {
+			route.AddHTTPRule(rule)
+		}
+
+		Expect(ResourceUniversal(route.MustBuild())(cluster)).To(Succeed())
+	}
+
+	gatewayAddress := func(port int) string {
+		return net.JoinHostPort(cluster.GetApp("gateway-proxy").GetIP(), fmt.Sprint(port))
+	}
+
+	send := func(r request) (types.EchoResponse, error) {
+		return testutil.CollectResponse(
+			cluster, "gateway-client", "http://"+gatewayAddress(httpPort)+r.path,
+			append([]testutil.CollectResponsesOptsFn{testutil.WithHeader("Host", gatewayHostname)}, r.opts...)...,
+		)
+	}
+
+	DescribeTable("should route the requests that match",
+		func(match *mesh_proto.GatewayRoute_HttpRoute_Match, matching request, other request) {
+			routeRules(builders.HTTPRule().
+				Match(match).
+				AddBackends(builders.Backend("echo-service")),
+			)
+
+			Eventually(func(g Gomega) {
+				response, err := send(matching)
+				g.Expect(err).To(Succeed())
+				g.Expect(response.Instance).To(Equal("echo-service"))
+
+				response, err = send(other)
+				g.Expect(err).To(Succeed())
+				g.Expect(response.Instance).To(Equal("fallback-service"))
+			}, "30s", "1s").Should(Succeed())
+		},
+		Entry("by exact path",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_EXACT,
+					Value: "/exact",
+				},
+			},
+			get("/exact"),
+			get("/exact/path"),
+		),
+		Entry("by path prefix",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+					Value: "/prefix",
+				},
+			},
+			get("/prefix/path"),
+			// Prefixes match complete path components.
+			get("/prefixpath"),
+		),
+		Entry("by path regex",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_REGEX,
+					Value: "/regex/[0-9]+",
+				},
+			},
+			get("/regex/42"),
+			get("/regex/path"),
+		),
+		Entry("by method",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+					Value: "/method",
+				},
+				Method: mesh_proto.GatewayRoute_HttpRoute_Match_POST,
+			},
+			get("/method", testutil.WithMethod("POST")),
+			get("/method"),
+		),
+		Entry("by exact header",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+					Value: "/header-exact",
+				},
+				Headers: []*mesh_proto.GatewayRoute_HttpRoute_Match_Header{{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Header_EXACT,
+					Name:  "x-conformance",
+					Value: "exact",
+				}},
+			},
+			get("/header-exact", testutil.WithHeader("x-conformance", "exact")),
+			get("/header-exact", testutil.WithHeader("x-conformance", "other")),
+		),
+		Entry("by header regex",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+					Value: "/header-regex",
+				},
+				Headers: []*mesh_proto.GatewayRoute_HttpRoute_Match_Header{{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Header_REGEX,
+					Name:  "x-conformance",
+					Value: "v[0-9]+",
+				}},
+			},
+			get("/header-regex", testutil.WithHeader("x-conformance", "v2")),
+			get("/header-regex", testutil.WithHeader("x-conformance", "vx")),
+		),
+		Entry("by header prefix",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+					Value: "/header-prefix",
+				},
+				Headers: []*mesh_proto.GatewayRoute_HttpRoute_Match_Header{{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Header_PREFIX,
+					Name:  "x-conformance",
+					Value: "pre",
+				}},
+			},
+			get("/header-prefix", testutil.WithHeader("x-conformance", "prefix")),
+			get("/header-prefix", testutil.WithHeader("x-conformance", "other")),
+		),
+		Entry("by header presence",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+					Value: "/header-present",
+				},
+				Headers: []*mesh_proto.GatewayRoute_HttpRoute_Match_Header{{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Header_PRESENT,
+					Name:  "x-conformance",
+				}},
+			},
+			get("/header-present", testutil.WithHeader("x-conformance", "any")),
+			get("/header-present"),
+		),
+		Entry("by header absence",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+					Value: "/header-absent",
+				},
+				Headers: []*mesh_proto.GatewayRoute_HttpRoute_Match_Header{{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Header_ABSENT,
+					Name:  "x-conformance",
+				}},
+			},
+			get("/header-absent"),
+			get("/header-absent", testutil.WithHeader("x-conformance", "any")),
+		),
+		Entry("by exact query parameter",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+					Value: "/query-exact",
+				},
+				QueryParameters: []*mesh_proto.GatewayRoute_HttpRoute_Match_Query{{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Query_EXACT,
+					Name:  "version",
+					Value: "2",
+				}},
+			},
+			get("/query-exact?version=2"),
+			get("/query-exact?version=1"),
+		),
+		Entry("by query parameter regex",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+					Value: "/query-regex",
+				},
+				QueryParameters: []*mesh_proto.GatewayRoute_HttpRoute_Match_Query{{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Query_REGEX,
+					Name:  "version",
+					Value: "[0-9]+",
+				}},
+			},
+			get("/query-regex?version=2"),
+			get("/query-regex?version=latest"),
+		),
+		Entry("by query parameter presence",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+					Value: "/query-present",
+				},
+				QueryParameters: []*mesh_proto.GatewayRoute_HttpRoute_Match_Query{{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Query_PRESENT,
+					Name:  "version",
+				}},
+			},
+			get("/query-present?version"),
+			get("/query-present"),
+		),
+		Entry("by query parameter absence",
+			&mesh_proto.GatewayRoute_HttpRoute_Match{
+				Path: &mesh_proto.GatewayRoute_HttpRoute_Match_Path{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Path_PREFIX,
+					Value: "/query-absent",
+				},
+				QueryParameters: []*mesh_proto.GatewayRoute_HttpRoute_Match_Query{{
+					Match: mesh_proto.GatewayRoute_HttpRoute_Match_Query_ABSENT,
+					Name:  "version",
+				}},
+			},
+			get("/query-absent"),
+			get("/query-absent?version=2"),
+		),
+	)
+
+	DescribeTable("should rewrite the requests",
+		func(rewrite *mesh_proto.GatewayRoute_HttpRoute_Filter_Rewrite, path string, expectedPath string, expectedHost string) {
+			routeRules(builders.HTTPRule().
+				MatchPrefix("/rewrite").
+				AddFilter(&mesh_proto.GatewayRoute_HttpRoute_Filter{
+					Filter: &mesh_proto.GatewayRoute_HttpRoute_Filter_Rewrite_{
+						Rewrite: rewrite,
+					},
+				}).
+				AddBackends(builders.Backend("echo-service")),
+			)
+
+			Eventually(func(g Gomega) {
+				response, err := send(get(path))
+				g.Expect(err).To(Succeed())
+				g.Expect(response.Instance).To(Equal("echo-service"))
+				g.Expect(response.Received.Path).To(Equal(expectedPath))
+				g.Expect(response.Received.Headers["Host"]).To(ContainElement(expectedHost))
+			}, "30s", "1s").Should(Succeed())
+		},
+		Entry("by replacing the matched prefix",
+			&mesh_proto.GatewayRoute_HttpRoute_Filter_Rewrite{
+				ReplacePrefixMatch: "/v2",
+			},
+			"/rewrite/users", "/v2/users", gatewayHostname,
+		),
+		Entry("by replacing the full path",
+			&mesh_proto.GatewayRoute_HttpRoute_Filter_Rewrite{
+				ReplaceFullPath: "/replaced",
+			},
+			"/rewrite/users", "/replaced", gatewayHostname,
+		),
+		Entry("by regex substitution",
+			&mesh_proto.GatewayRoute_HttpRoute_Filter_Rewrite{
+				ReplaceRegex: &mesh_proto.GatewayRoute_HttpRoute_Filter_Rewrite_RegexReplace{
+					Pattern:      "^/rewrite/([^/]+)/(.*)$",
+					Substitution: "/\\2/\\1",
+				},
+			},
+			"/rewrite/users/42", "/42/users", gatewayHostname,
+		),
+		Entry("by replacing the hostname",
+			&mesh_proto.GatewayRoute_HttpRoute_Filter_Rewrite{
+				Hostname: "rewritten.kuma.io",
+			},
+			"/rewrite/users", "/rewrite/users", "rewritten.kuma.io",
+		),
+	)
+
+	It("should set, add and remove request headers", func() {
+		routeRules(builders.HTTPRule().
+			MatchPrefix("/headers").
+			AddFilter(&mesh_proto.GatewayRoute_HttpRoute_Filter{
+				Filter: &mesh_proto.GatewayRoute_HttpRoute_Filter_RequestHeader_{
+					RequestHeader: &mesh_proto.GatewayRoute_HttpRoute_Filter_RequestHeader{
+						Set: []*mesh_proto.GatewayRoute_HttpRoute_Filter_RequestHeader_Header{
+							{Name: "x-set", Value: "set"},
+						},
+						Add: []*mesh_proto.GatewayRoute_HttpRoute_Filter_RequestHeader_Header{
+							{Name: "x-add", Value: "added"},
+						},
+						Remove: []string{"x-remove"},
+					},
+				},
+			}).
+			AddBackends(builders.Backend("echo-service")),
+		)
+
+		Eventually(func(g Gomega) {
+			response, err := send(get("/headers",
+				testutil.WithHeader("x-set", "original"),
+				testutil.WithHeader("x-add", "original"),
+				testutil.WithHeader("x-remove", "original"),
+			))
+			g.Expect(err).To(Succeed())
+			g.Expect(response.Instance).To(Equal("echo-service"))
+			g.Expect(response.Received.Headers["X-Set"]).To(ConsistOf("set"))
+			g.Expect(response.Received.Headers["X-Add"]).To(ConsistOf("original", "added"))
+			g.Expect(response.Received.Headers).ToNot(HaveKey("X-Remove"))
+		}, "30s", "1s").Should(Succeed())
+	})
+
+	It("should redirect requests", func() {
+		routeRules(builders.HTTPRule().
+			MatchPrefix("/redirect").
+			AddFilter(&mesh_proto.GatewayRoute_HttpRoute_Filter{
+				Filter: &mesh_proto.GatewayRoute_HttpRoute_Filter_Redirect_{
+					Redirect: &mesh_proto.GatewayRoute_HttpRoute_Filter_Redirect{
+						Scheme:     "https",
+						Hostname:   "redirected.kuma.io",
+						Port:       httpsPort,
+						StatusCode: 301,
+					},
+				},
+			}),
+		)
+
+		Eventually(func(g Gomega) {
+			response, err := testutil.CollectFailure(
+				cluster, "gateway-client", "http://"+gatewayAddress(httpPort)+"/redirect/path?query",
+				testutil.WithHeader("Host", gatewayHostname),
+			)
+			g.Expect(err).To(Succeed())
+			g.Expect(response.ResponseCode).To(Equal(301))
+			g.Expect(response.RedirectURL).To(Equal(
+				fmt.Sprintf("https://redirected.kuma.io:%d/redirect/path", httpsPort)))
+		}, "30s", "1s").Should(Succeed())
+	})
+
+	It("should mirror requests", func() {
+		routeRules(builders.HTTPRule().
+			MatchPrefix("/mirror").
+			AddFilter(&mesh_proto.GatewayRoute_HttpRoute_Filter{
+				Filter: &mesh_proto.GatewayRoute_HttpRoute_Filter_Mirror_{
+					Mirror: &mesh_proto.GatewayRoute_HttpRoute_Filter_Mirror{
+						Backend:    builders.Backend("mirror-service"),
+						Percentage: util_proto.Double(100),
+					},
+				},
+			}).
+			AddBackends(builders.Backend("echo-service")),
+		)
+
+		Eventually(func(g Gomega) {
+			response, err := send(get("/mirror/path"))
+			g.Expect(err).To(Succeed())
+			g.Expect(response.Instance).To(Equal("echo-service"))
+		}, "30s", "1s").Should(Succeed())
+
+		// The mirrored requests are sent to the hostname with
+		// the "-shadow" suffix, and their responses are dropped.
+		Eventually(mirrored, "30s", "1s").Should(gbytes.Say(
+			regexp.QuoteMeta(fmt.Sprintf("GET %s-shadow /mirror/path", gatewayHostname))))
+	})
+
+	It("should terminate TLS", func() {
+		routeRules(builders.HTTPRule().
+			MatchPrefix("/tls").
+			AddBackends(builders.Backend("echo-service")),
+		)
+
+		Eventually(func(g Gomega) {
+			// The certificate is self-signed, but the hostname
+			// has to be sent in the SNI to select it.
+			response, err := testutil.CollectResponse(
+				cluster, "gateway-client", fmt.Sprintf("https://%s:%d/tls", gatewayHostname, httpsPort),
+				testutil.WithInsecure(),
+				testutil.WithResolve(gatewayHostname, httpsPort, cluster.GetApp("gateway-proxy").GetIP()),
+			)
+			g.Expect(err).To(Succeed())
+			g.Expect(response.Instance).To(Equal("echo-service"))
+			g.Expect(response.Received.Headers["X-Forwarded-Proto"]).To(ContainElement("https"))
+		}, "30s", "1s").Should(Succeed())
+	})
+}
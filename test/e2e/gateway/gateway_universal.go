@@ -14,6 +14,7 @@ import (
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/test/resources/builders"
 	"github.com/kumahq/kuma/test/e2e/trafficroute/testutil"
 	. "github.com/kumahq/kuma/test/framework"
 )
@@ -117,9 +118,11 @@ networking:
 
 		SetupCluster(NewClusterSetup().
 			Install(Kuma(config_core.Standalone, opt...)).
-			Install(GatewayClientUniversal("gateway-client")).
-			Install(EchoServerUniversal("echo-server")).
-			Install(GatewayProxyUniversal("gateway-proxy")),
+			Install(Parallel(
+				GatewayClientUniversal("gateway-client"),
+				EchoServerUniversal("echo-server"),
+				GatewayProxyUniversal("gateway-proxy"),
+			)),
 		)
 	}
 
@@ -138,42 +141,27 @@ networking:
 	// Before each test, install the gateway and routes.
 	JustBeforeEach(func() {
 		Expect(
-			cluster.GetKumactlOptions().KumactlApplyFromString(`
-type: Gateway
-mesh: default
-name: edge-gateway
-selectors:
-- match:
-    kuma.io/service: edge-gateway
-conf:
-  listeners:
-  - port: 8080
-    protocol: HTTP
-    hostname: example.kuma.io
-    tags:
-      hostname: example.kuma.io
-`),
+			ResourceUniversal(
+				builders.Gateway().
+					WithService("edge-gateway").
+					AddListener(builders.HTTPListener(8080).
+						WithHostname("example.kuma.io").
+						WithTags(map[string]string{"hostname": "example.kuma.io"}),
+					).
+					MustBuild(),
+			)(cluster),
 		).To(Succeed())
 
 		Expect(
-			cluster.GetKumactlOptions().KumactlApplyFromString(`
-type: GatewayRoute
-mesh: default
-name: edge-gateway
-selectors:
-- match:
-    kuma.io/service: edge-gateway
-conf:
-  http:
-    rules:
-    - matches:
-      - path:
-          match: PREFIX
-          value: /
-      backends:
-      - destination:
-          kuma.io/service: echo-service
-`),
+			ResourceUniversal(
+				builders.GatewayRoute().
+					WithService("edge-gateway").
+					AddHTTPRule(builders.HTTPRule().
+						MatchPrefix("/").
+						AddBackends(builders.Backend("echo-service")),
+					).
+					MustBuild(),
+			)(cluster),
 		).To(Succeed())
 
 		Expect(
@@ -277,24 +265,16 @@ conf:
 			// The suite-level JustBeforeEach adds a default route to the mesh echo server.
 			// Add new route to the external echo server.
 			Expect(
-				cluster.GetKumactlOptions().KumactlApplyFromString(`
-type: GatewayRoute
-mesh: default
-name: external-routes
-selectors:
-- match:
-    kuma.io/service: edge-gateway
-conf:
-  http:
-    rules:
-    - matches:
-      - path:
-          match: PREFIX
-          value: /external
-      backends:
-      - destination:
-          kuma.io/service: external-echo
-`),
+				ResourceUniversal(
+					builders.GatewayRoute().
+						WithName("external-routes").
+						WithService("edge-gateway").
+						AddHTTPRule(builders.HTTPRule().
+							MatchPrefix("/external").
+							AddBackends(builders.Backend("external-echo")),
+						).
+						MustBuild(),
+				)(cluster),
 			).To(Succeed())
 
 			Expect(
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 
 	"github.com/kballard/go-shellquote"
@@ -17,6 +18,8 @@ type CollectResponsesOpts struct {
 	NumberOfRequests int
 	Method           string
 	Headers          map[string]string
+	Insecure         bool
+	Resolve          []string
 }
 
 func DefaultCollectResponsesOpts() CollectResponsesOpts {
@@ -47,6 +50,39 @@ func WithHeader(key, value string) CollectResponsesOptsFn {
 	}
 }
 
+// WithInsecure accepts any certificate of a HTTPS destination.
+func WithInsecure() CollectResponsesOptsFn {
+	return func(opts *CollectResponsesOpts) {
+		opts.Insecure = true
+	}
+}
+
+// WithResolve connects to the given address for the host and port
+// of the destination, so that the host is also sent in the SNI of a
+// HTTPS request.
+func WithResolve(host string, port int, address string) CollectResponsesOptsFn {
+	return func(opts *CollectResponsesOpts) {
+		if strings.Contains(address, ":") {
+			address = "[" + address + "]" // IPv6
+		}
+		opts.Resolve = append(opts.Resolve, fmt.Sprintf("%s:%d:%s", host, port, address))
+	}
+}
+
+func (opts CollectResponsesOpts) curlArgs() []string {
+	var args []string
+	if opts.Insecure {
+		args = append(args, "--insecure")
+	}
+	for _, r := range opts.Resolve {
+		args = append(args, "--resolve", shellquote.Join(r))
+	}
+	for key, value := range opts.Headers {
+		args = append(args, "--header", shellquote.Join(fmt.Sprintf("%s: %s", key, value)))
+	}
+	return args
+}
+
 func CollectResponse(cluster framework.Cluster, source, destination string, fn ...CollectResponsesOptsFn) (types.EchoResponse, error) {
 	opts := DefaultCollectResponsesOpts()
 	for _, f := range fn {
@@ -58,9 +94,7 @@ func CollectResponse(cluster framework.Cluster, source, destination string, fn .
 		"--request", opts.Method,
 		"--max-time", "3",
 	}
-	for key, value := range opts.Headers {
-		cmd = append(cmd, "--header", shellquote.Join(fmt.Sprintf("%s: %s", key, value)))
-	}
+	cmd = append(cmd, opts.curlArgs()...)
 	cmd = append(cmd, shellquote.Join(destination))
 	stdout, _, err := cluster.ExecWithRetries("", "", source, cmd...)
 	if err != nil {
@@ -87,6 +121,7 @@ type FailureResponse struct {
 	ContentType  string `json:"content_type"`
 	URL          string `json:"url"`
 	EffectiveURL string `json:"url_effective"`
+	RedirectURL  string `json:"redirect_url"`
 }
 
 // CollectFailure runs Curl to fetch a URL that is expected to fail. The
@@ -109,10 +144,7 @@ func CollectFailure(cluster framework.Cluster, source, destination string, fn ..
 		"--output", os.DevNull,
 	}
 
-	for key, value := range opts.Headers {
-		cmd = append(cmd, "--header", shellquote.Join(fmt.Sprintf("%s: %s", key, value)))
-	}
-
+	cmd = append(cmd, opts.curlArgs()...)
 	cmd = append(cmd, shellquote.Join(destination))
 	stdout, _, err := cluster.Exec("", "", source, cmd...)
 
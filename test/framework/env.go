@@ -115,6 +115,19 @@ func IsIPv6() bool {
 	return envBool(envIPv6)
 }
 
+// ShouldStreamAppLogs returns whether the output of all the universal
+// apps is tailed into the Ginkgo output.
+func ShouldStreamAppLogs() bool {
+	return envBool("KUMA_E2E_STREAM_APP_LOGS")
+}
+
+// IsGatewayConformance returns whether the builtin gateway is required, so
+// that the gateway specs fail rather than being skipped when Kuma is built
+// without it.
+func IsGatewayConformance() bool {
+	return envBool("KUMA_E2E_GATEWAY_CONFORMANCE")
+}
+
 // GetKumactlBin returns the path to the kumactl program.
 func GetKumactlBin() string {
 	if path := os.Getenv("KUMACTLBIN"); path != "" {
@@ -101,6 +101,7 @@ type appDeploymentOptions struct {
 	omitDataplane   bool
 	proxyOnly       bool
 	serviceProbe    bool
+	streamLogs      bool
 }
 
 func (d *appDeploymentOptions) apply(opts ...AppDeploymentOption) {
@@ -407,6 +408,15 @@ func WithConcurrency(concurrency int) AppDeploymentOption {
 	})
 }
 
+// WithLogStreaming tails the output of the app into the Ginkgo output
+// from the start. The output of all the apps is streamed when the
+// KUMA_E2E_STREAM_APP_LOGS environment variable is "true".
+func WithLogStreaming() AppDeploymentOption {
+	return AppOptionFunc(func(o *appDeploymentOptions) {
+		o.streamLogs = true
+	})
+}
+
 type Deployment interface {
 	Name() string
 	Deploy(cluster Cluster) error
@@ -3,10 +3,13 @@ package framework
 import (
 	"bytes"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/gruntwork-io/terratest/modules/k8s"
 	"github.com/gruntwork-io/terratest/modules/retry"
+	"github.com/onsi/ginkgo"
+	"go.uber.org/multierr"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,7 +17,9 @@ import (
 	k8sjson "k8s.io/apimachinery/pkg/runtime/serializer/json"
 
 	"github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
 	bootstrap_k8s "github.com/kumahq/kuma/pkg/plugins/bootstrap/k8s"
+	"github.com/kumahq/kuma/pkg/test/resources/builders"
 	"github.com/kumahq/kuma/pkg/tls"
 )
 
@@ -74,6 +79,18 @@ func YamlUniversal(yaml string) InstallFunc {
 	}
 }
 
+// ResourceUniversal applies the resource, e.g. one that is made with
+// the builders package, with kumactl.
+func ResourceUniversal(res model.Resource) InstallFunc {
+	return func(cluster Cluster) error {
+		yaml, err := builders.YAML(res)
+		if err != nil {
+			return err
+		}
+		return YamlUniversal(yaml)(cluster)
+	}
+}
+
 func YamlPathK8s(path string) InstallFunc {
 	return func(cluster Cluster) error {
 		_, err := retry.DoWithRetryE(cluster.GetTesting(), "install yaml resource by path", DefaultRetries, DefaultTimeout,
@@ -154,9 +171,9 @@ func IngressUniversal(token string) InstallFunc {
 		if err != nil {
 			return err
 		}
-		uniCluster.apps[AppIngress] = app
+		uniCluster.addApp(AppIngress, app)
 
-		publicAddress := uniCluster.apps[AppIngress].ip
+		publicAddress := app.ip
 		dpyaml := fmt.Sprintf(ZoneIngress, publicAddress, kdsPort, kdsPort)
 		return uniCluster.CreateZoneIngress(app, "ingress", app.ip, dpyaml, token, false)
 	}
@@ -363,6 +380,29 @@ func Combine(fs ...InstallFunc) InstallFunc {
 	}
 }
 
+// Parallel runs the install functions concurrently, and returns the
+// errors of all the functions that fail. The functions must not depend
+// on each other, e.g. apps that can start in any order. Universal
+// clusters support deploying apps concurrently.
+func Parallel(fs ...InstallFunc) InstallFunc {
+	return func(cluster Cluster) error {
+		var wg sync.WaitGroup
+		errs := make([]error, len(fs))
+
+		for i, f := range fs {
+			wg.Add(1)
+			go func(i int, f InstallFunc) {
+				defer ginkgo.GinkgoRecover()
+				defer wg.Done()
+				errs[i] = f(cluster)
+			}(i, f)
+		}
+
+		wg.Wait()
+		return multierr.Combine(errs...)
+	}
+}
+
 func Namespace(name string) InstallFunc {
 	return func(cluster Cluster) error {
 		return k8s.CreateNamespaceE(cluster.GetTesting(), cluster.GetKubectlOptions(), name)
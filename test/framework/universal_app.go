@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/gruntwork-io/terratest/modules/docker"
@@ -201,36 +202,24 @@ type UniversalApp struct {
 	container    string
 	ip           string
 	verbose      bool
+	logs         *appLogStream
 }
 
+// dockerRunMutex serializes picking the public ports of the apps and
+// publishing them, so that apps that are deployed concurrently don't
+// pick the same free ports.
+var dockerRunMutex sync.Mutex
+
 func NewUniversalApp(t testing.TestingT, clusterName, dpName string, mode AppMode, isipv6, verbose bool, caps []string) (*UniversalApp, error) {
 	app := &UniversalApp{
 		t:            t,
 		ports:        map[string]string{},
 		lastUsedPort: 10204,
 		verbose:      verbose,
+		logs:         newAppLogStream(clusterName + "/" + dpName),
 	}
 
-	app.allocatePublicPortsFor("22")
-
-	if mode == AppModeCP {
-		app.allocatePublicPortsFor("5678", "5680", "5681", "5682", "5685")
-	}
-
-	opts := defaultDockerOptions
-	opts.OtherOptions = append(opts.OtherOptions, "--name", clusterName+"_"+dpName)
-	for _, cap := range caps {
-		opts.OtherOptions = append(opts.OtherOptions, "--cap-add", cap)
-	}
-	opts.OtherOptions = append(opts.OtherOptions, "--network", "kind")
-	if !isipv6 {
-		// For now supporting mixed environments with IPv4 and IPv6 addresses is challenging, specifically with
-		// builtin DNS. This is due to our mix of CoreDNS and Envoy DNS architecture.
-		// Here we make sure the IPv6 address is not allocated to the container unless explicitly requested.
-		opts.OtherOptions = append(opts.OtherOptions, "--sysctl", "net.ipv6.conf.all.disable_ipv6=1")
-	}
-	opts.OtherOptions = append(opts.OtherOptions, app.publishPortsForDocker(isipv6)...)
-	container, err := docker.RunAndGetIDE(t, GetUniversalImage(), &opts)
+	container, err := app.runContainer(clusterName+"_"+dpName, mode, isipv6, caps)
 	if err != nil {
 		return nil, err
 	}
@@ -251,6 +240,36 @@ func NewUniversalApp(t testing.TestingT, clusterName, dpName string, mode AppMod
 	return app, nil
 }
 
+// runContainer runs the container of the app, and publishes its ports.
+// The free ports are picked and published under dockerRunMutex, since
+// they are only taken once the container runs.
+func (s *UniversalApp) runContainer(name string, mode AppMode, isipv6 bool, caps []string) (string, error) {
+	dockerRunMutex.Lock()
+	defer dockerRunMutex.Unlock()
+
+	s.allocatePublicPortsFor("22")
+
+	if mode == AppModeCP {
+		s.allocatePublicPortsFor("5678", "5680", "5681", "5682", "5685")
+	}
+
+	opts := defaultDockerOptions
+	opts.OtherOptions = append(opts.OtherOptions, "--name", name)
+	for _, cap := range caps {
+		opts.OtherOptions = append(opts.OtherOptions, "--cap-add", cap)
+	}
+	opts.OtherOptions = append(opts.OtherOptions, "--network", "kind")
+	if !isipv6 {
+		// For now supporting mixed environments with IPv4 and IPv6 addresses is challenging, specifically with
+		// builtin DNS. This is due to our mix of CoreDNS and Envoy DNS architecture.
+		// Here we make sure the IPv6 address is not allocated to the container unless explicitly requested.
+		opts.OtherOptions = append(opts.OtherOptions, "--sysctl", "net.ipv6.conf.all.disable_ipv6=1")
+	}
+	opts.OtherOptions = append(opts.OtherOptions, s.publishPortsForDocker(isipv6)...)
+
+	return docker.RunAndGetIDE(s.t, GetUniversalImage(), &opts)
+}
+
 func (s *UniversalApp) allocatePublicPortsFor(ports ...string) {
 	for _, port := range ports {
 		pubPortUInt32, err := util_net.PickTCPPort("", s.lastUsedPort+1, 11204)
@@ -284,6 +303,18 @@ func (s *UniversalApp) GetIP() string {
 	return s.ip
 }
 
+// StreamLogs copies the output of the main app and of the data plane
+// proxy to the writer as it is produced, until StopStreamingLogs is
+// called.
+func (s *UniversalApp) StreamLogs(w io.Writer) {
+	s.logs.Start(w)
+}
+
+// StopStreamingLogs stops copying the output of the app.
+func (s *UniversalApp) StopStreamingLogs() {
+	s.logs.Stop()
+}
+
 func (s *UniversalApp) Stop() error {
 	out, err := docker.StopE(s.t, []string{s.container}, &docker.StopOptions{Time: 1})
 	if err != nil {
@@ -322,6 +353,7 @@ func (s *UniversalApp) CreateMainApp(env []string, args []string) {
 	s.mainAppEnv = env
 	s.mainAppArgs = args
 	s.mainApp = NewSshApp(s.verbose, s.ports[sshPort], env, args)
+	s.mainApp.tee(s.logs.Writer("app stdout"), s.logs.Writer("app stderr"))
 }
 
 func (s *UniversalApp) OverrideDpVersion(version string) error {
@@ -419,6 +451,7 @@ func (s *UniversalApp) CreateDP(token, cpAddress, name, mesh, ip, dpyaml string,
 		args = append(args, "--proxy-type=ingress")
 	}
 	s.dpApp = NewSshApp(s.verbose, s.ports[sshPort], []string{}, args)
+	s.dpApp.tee(s.logs.Writer("dp stdout"), s.logs.Writer("dp stderr"))
 }
 
 // iptablesChainExists tests whether iptables believes the given chainName
@@ -515,6 +548,13 @@ func NewSshApp(verbose bool, port string, env []string, args []string) *SshApp {
 	return app
 }
 
+// tee also copies the output of the command to the given writers. It
+// has to be called before the command is started.
+func (s *SshApp) tee(stdout, stderr io.Writer) {
+	s.cmd.Stdout = io.MultiWriter(s.cmd.Stdout, stdout)
+	s.cmd.Stderr = io.MultiWriter(s.cmd.Stderr, stderr)
+}
+
 func (s *SshApp) Run() error {
 	Logf("Running %v", s.cmd)
 	return s.cmd.Run()
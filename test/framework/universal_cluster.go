@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gruntwork-io/terratest/modules/k8s"
 	"github.com/gruntwork-io/terratest/modules/retry"
 	"github.com/gruntwork-io/terratest/modules/testing"
+	"github.com/onsi/ginkgo"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
@@ -25,6 +27,7 @@ type UniversalCluster struct {
 	name           string
 	controlplane   *UniversalControlPlane
 	apps           map[string]*UniversalApp
+	appsMutex      sync.RWMutex
 	verbose        bool
 	deployments    map[string]Deployment
 	defaultTimeout time.Duration
@@ -62,6 +65,9 @@ func (c *UniversalCluster) Name() string {
 }
 
 func (c *UniversalCluster) DismissCluster() (errs error) {
+	c.appsMutex.RLock()
+	defer c.appsMutex.RUnlock()
+
 	for _, app := range c.apps {
 		err := app.Stop()
 		if err != nil {
@@ -125,6 +131,10 @@ func (c *UniversalCluster) DeployKuma(mode core.CpMode, opt ...KumaDeploymentOpt
 		return err
 	}
 
+	if ShouldStreamAppLogs() {
+		app.StreamLogs(ginkgo.GinkgoWriter)
+	}
+
 	app.CreateMainApp(env, cmd)
 
 	if opts.runPostgresMigration {
@@ -137,7 +147,7 @@ func (c *UniversalCluster) DeployKuma(mode core.CpMode, opt ...KumaDeploymentOpt
 		return err
 	}
 
-	c.apps[AppModeCP] = app
+	c.addApp(AppModeCP, app)
 
 	var token string
 	if opts.env["KUMA_API_SERVER_AUTHN_TYPE"] == "tokens" {
@@ -172,7 +182,7 @@ func (c *UniversalCluster) DeployKuma(mode core.CpMode, opt ...KumaDeploymentOpt
 
 func (c *UniversalCluster) retrieveAdminToken() (string, error) {
 	return retry.DoWithRetryE(c.t, "generating DP token", DefaultRetries, DefaultTimeout, func() (string, error) {
-		sshApp := NewSshApp(c.verbose, c.apps[AppModeCP].ports["22"], []string{}, []string{"curl",
+		sshApp := NewSshApp(c.verbose, c.GetApp(AppModeCP).ports["22"], []string{}, []string{"curl",
 			"--fail", "--show-error",
 			"http://localhost:5681/global-secrets/admin-user-token"})
 		if err := sshApp.Run(); err != nil {
@@ -203,8 +213,8 @@ func (c *UniversalCluster) VerifyKuma() error {
 }
 
 func (c *UniversalCluster) DeleteKuma(...KumaDeploymentOption) error {
-	err := c.apps[AppModeCP].Stop()
-	delete(c.apps, AppModeCP)
+	err := c.GetApp(AppModeCP).Stop()
+	c.deleteApp(AppModeCP)
 	c.controlplane = nil
 	return err
 }
@@ -231,14 +241,14 @@ func (c *UniversalCluster) DeleteNamespace(namespace string) error {
 }
 
 func (c *UniversalCluster) CreateDP(app *UniversalApp, name, mesh, ip, dpyaml, token string, builtindns bool, concurrency int) error {
-	cpIp := c.apps[AppModeCP].ip
+	cpIp := c.GetApp(AppModeCP).ip
 	cpAddress := "https://" + net.JoinHostPort(cpIp, "5678")
 	app.CreateDP(token, cpAddress, name, mesh, ip, dpyaml, builtindns, false, concurrency)
 	return app.dpApp.Start()
 }
 
 func (c *UniversalCluster) CreateZoneIngress(app *UniversalApp, name, ip, dpyaml, token string, builtindns bool) error {
-	cpIp := c.apps[AppModeCP].ip
+	cpIp := c.GetApp(AppModeCP).ip
 	cpAddress := "https://" + net.JoinHostPort(cpIp, "5678")
 	app.CreateDP(token, cpAddress, name, "", ip, dpyaml, builtindns, true, 0)
 	return app.dpApp.Start()
@@ -277,7 +287,11 @@ func (c *UniversalCluster) DeployApp(opt ...AppDeploymentOption) error {
 	// container that isn't fully configured, and we need it to be
 	// recorded so that DismissCluster can clean it up.
 	Logf("Started universal app %q in container %q", opts.name, app.container)
-	c.apps[opts.name] = app
+	c.addApp(opts.name, app)
+
+	if opts.streamLogs || ShouldStreamAppLogs() {
+		app.StreamLogs(ginkgo.GinkgoWriter)
+	}
 
 	if !opts.omitDataplane {
 		if opts.kumactlFlow {
@@ -301,7 +315,7 @@ func (c *UniversalCluster) DeployApp(opt ...AppDeploymentOption) error {
 
 		builtindns := opts.builtindns == nil || *opts.builtindns
 		if transparent {
-			app.setupTransparent(c.apps[AppModeCP].ip, builtindns)
+			app.setupTransparent(c.GetApp(AppModeCP).ip, builtindns)
 		}
 
 		ip := app.ip
@@ -348,20 +362,60 @@ func runPostgresMigration(kumaCP *UniversalApp, envVars []string) error {
 }
 
 func (c *UniversalCluster) GetApp(appName string) *UniversalApp {
+	c.appsMutex.RLock()
+	defer c.appsMutex.RUnlock()
+
 	return c.apps[appName]
 }
 
+// addApp records a deployed app. Apps can be deployed concurrently,
+// see Parallel.
+func (c *UniversalCluster) addApp(appName string, app *UniversalApp) {
+	c.appsMutex.Lock()
+	defer c.appsMutex.Unlock()
+
+	c.apps[appName] = app
+}
+
+func (c *UniversalCluster) deleteApp(appName string) {
+	c.appsMutex.Lock()
+	defer c.appsMutex.Unlock()
+
+	delete(c.apps, appName)
+}
+
+// StreamAppLogs tails the output of the app and of its data plane
+// proxy into the Ginkgo output, until StopStreamingAppLogs is called.
+func (c *UniversalCluster) StreamAppLogs(appName string) error {
+	app := c.GetApp(appName)
+	if app == nil {
+		return errors.Errorf("App %s not found", appName)
+	}
+	app.StreamLogs(ginkgo.GinkgoWriter)
+	return nil
+}
+
+// StopStreamingAppLogs stops tailing the output of the app.
+func (c *UniversalCluster) StopStreamingAppLogs(appName string) error {
+	app := c.GetApp(appName)
+	if app == nil {
+		return errors.Errorf("App %s not found", appName)
+	}
+	app.StopStreamingLogs()
+	return nil
+}
+
 func (c *UniversalCluster) DeleteApp(namespace, appname string) error {
-	app, ok := c.apps[appname]
-	if !ok {
+	app := c.GetApp(appname)
+	if app == nil {
 		return errors.Errorf("App %s not found for deletion", appname)
 	}
 	return app.Stop()
 }
 
 func (c *UniversalCluster) Exec(namespace, podName, appname string, cmd ...string) (string, string, error) {
-	app, ok := c.apps[appname]
-	if !ok {
+	app := c.GetApp(appname)
+	if app == nil {
 		return "", "", errors.Errorf("App %s not found", appname)
 	}
 	sshApp := NewSshApp(false, app.ports[sshPort], []string{}, cmd)
@@ -378,8 +432,8 @@ func (c *UniversalCluster) ExecWithRetries(namespace, podName, appname string, c
 		c.defaultRetries/3,
 		c.defaultTimeout,
 		func() (string, error) {
-			app, ok := c.apps[appname]
-			if !ok {
+			app := c.GetApp(appname)
+			if app == nil {
 				return "", errors.Errorf("App %s not found", appname)
 			}
 			sshApp := NewSshApp(false, app.ports[sshPort], []string{}, cmd)
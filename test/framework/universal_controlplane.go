@@ -46,7 +46,7 @@ func (c *UniversalControlPlane) GetKumaCPLogs() (string, error) {
 }
 
 func (c *UniversalControlPlane) GetKDSServerAddress() string {
-	return "grpcs://" + net.JoinHostPort(c.cluster.apps[AppModeCP].ip, "5685")
+	return "grpcs://" + net.JoinHostPort(c.cluster.GetApp(AppModeCP).ip, "5685")
 }
 
 func (c *UniversalControlPlane) GetGlobaStatusAPI() string {
@@ -54,12 +54,12 @@ func (c *UniversalControlPlane) GetGlobaStatusAPI() string {
 }
 
 func (c *UniversalControlPlane) GetAPIServerAddress() string {
-	return "http://localhost:" + c.cluster.apps[AppModeCP].ports["5681"]
+	return "http://localhost:" + c.cluster.GetApp(AppModeCP).ports["5681"]
 }
 
 func (c *UniversalControlPlane) GetMetrics() (string, error) {
 	return retry.DoWithRetryE(c.t, "fetching CP metrics", DefaultRetries, DefaultTimeout, func() (string, error) {
-		sshApp := NewSshApp(c.verbose, c.cluster.apps[AppModeCP].ports["22"], []string{}, []string{"curl",
+		sshApp := NewSshApp(c.verbose, c.cluster.GetApp(AppModeCP).ports["22"], []string{}, []string{"curl",
 			"--fail", "--show-error",
 			"http://localhost:5680/metrics"})
 		if err := sshApp.Run(); err != nil {
@@ -78,7 +78,7 @@ func (c *UniversalControlPlane) GenerateDpToken(mesh, service string) (string, e
 		dpType = "ingress"
 	}
 	return retry.DoWithRetryE(c.t, "generating DP token", DefaultRetries, DefaultTimeout, func() (string, error) {
-		sshApp := NewSshApp(c.verbose, c.cluster.apps[AppModeCP].ports["22"], []string{}, []string{"curl",
+		sshApp := NewSshApp(c.verbose, c.cluster.GetApp(AppModeCP).ports["22"], []string{}, []string{"curl",
 			"--fail", "--show-error",
 			"-H", "\"Content-Type: application/json\"",
 			"--data", fmt.Sprintf(`'{"mesh": "%s", "type": "%s", "tags": {"kuma.io/service":["%s"]}}'`, mesh, dpType, service),
@@ -95,7 +95,7 @@ func (c *UniversalControlPlane) GenerateDpToken(mesh, service string) (string, e
 
 func (c *UniversalControlPlane) GenerateZoneIngressToken(zone string) (string, error) {
 	return retry.DoWithRetryE(c.t, "generating DP token", DefaultRetries, DefaultTimeout, func() (string, error) {
-		sshApp := NewSshApp(c.verbose, c.cluster.apps[AppModeCP].ports["22"], []string{}, []string{"curl",
+		sshApp := NewSshApp(c.verbose, c.cluster.GetApp(AppModeCP).ports["22"], []string{}, []string{"curl",
 			"--fail", "--show-error",
 			"-H", "\"Content-Type: application/json\"",
 			"--data", fmt.Sprintf(`'{"zone": "%s"}'`, zone),
@@ -0,0 +1,84 @@
+package framework
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// appLogStream copies the output of the processes of a universal app
+// to a writer, one line at a time, prefixed with the name of the app
+// and of the process. Streaming can be started and stopped at any
+// time, the output that is produced while it is stopped is dropped.
+type appLogStream struct {
+	name string
+
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func newAppLogStream(name string) *appLogStream {
+	return &appLogStream{name: name}
+}
+
+// Start streams the output to the writer.
+func (s *appLogStream) Start(out io.Writer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.out = out
+}
+
+// Stop stops streaming the output.
+func (s *appLogStream) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.out = nil
+}
+
+// Writer returns the writer for an output of a process of the app,
+// e.g. "dp stderr".
+func (s *appLogStream) Writer(source string) io.Writer {
+	return &appLogWriter{
+		stream: s,
+		prefix: fmt.Sprintf("[%s %s] ", s.name, source),
+	}
+}
+
+func (s *appLogStream) writeLine(prefix string, line []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.out == nil {
+		return
+	}
+
+	// Streaming is best effort, and must not fail the process.
+	_, _ = fmt.Fprintf(s.out, "%s%s\n", prefix, bytes.TrimRight(line, "\r"))
+}
+
+// appLogWriter splits the output of a process into lines, so that the
+// lines of the processes of the app are not interleaved.
+type appLogWriter struct {
+	stream *appLogStream
+	prefix string
+	buf    []byte
+}
+
+func (w *appLogWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+
+		w.stream.writeLine(w.prefix, w.buf[:i])
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(p), nil
+}
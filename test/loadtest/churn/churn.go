@@ -0,0 +1,139 @@
+package churn
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/test/loadtest/simulator"
+)
+
+// Recorder records when the generations of the churned policies are
+// applied.
+type Recorder interface {
+	Applied(generation uint32, at time.Time)
+}
+
+// Churner changes policies through the API server at a fixed rate. Each
+// change is a new generation of a Timeout policy, whose connect timeout
+// carries the marker of the generation.
+type Churner struct {
+	APIServerURL string
+	Mesh         string
+	Name         string
+	Rate         float64
+	Marker       simulator.Marker
+
+	Client   *http.Client
+	Recorder Recorder
+	Log      logr.Logger
+}
+
+// Start applies the generations until the context is done. Failed
+// changes are logged and skipped, so that a busy control plane doesn't
+// stop the test.
+func (c *Churner) Start(ctx context.Context) error {
+	if c.Rate <= 0 {
+		<-ctx.Done()
+		return nil
+	}
+
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / c.Rate))
+	defer ticker.Stop()
+
+	generation := uint32(0)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			generation = generation%c.Marker.Generations + 1
+
+			c.Recorder.Applied(generation, time.Now())
+			if err := c.apply(ctx, generation); err != nil && ctx.Err() == nil {
+				c.Log.Error(err, "failed to apply a policy change", "generation", generation)
+			}
+		}
+	}
+}
+
+// Cleanup deletes the churned policy.
+func (c *Churner) Cleanup(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.itemURL(), nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req)
+}
+
+func (c *Churner) apply(ctx context.Context, generation uint32) error {
+	body, err := json.Marshal(c.timeout(generation))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.itemURL(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.do(req)
+}
+
+func (c *Churner) do(req *http.Request) error {
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return errors.Errorf("%s %s: unexpected status %d: %s", req.Method, req.URL, resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+func (c *Churner) itemURL() string {
+	return fmt.Sprintf("%s/meshes/%s/timeouts/%s", strings.TrimSuffix(c.APIServerURL, "/"), c.Mesh, c.Name)
+}
+
+// timeout returns the Timeout policy of the generation. It selects the
+// simulated dataplanes by their tag, so that it is more specific than
+// the default Timeout policy of the mesh.
+func (c *Churner) timeout(generation uint32) *rest.Resource {
+	return &rest.Resource{
+		Meta: rest.ResourceMeta{Type: "Timeout", Mesh: c.Mesh, Name: c.Name},
+		Spec: &mesh_proto.Timeout{
+			Sources: []*mesh_proto.Selector{{
+				Match: map[string]string{
+					mesh_proto.ServiceTag:  "*",
+					simulator.SimulatedTag: "true",
+				},
+			}},
+			Destinations: []*mesh_proto.Selector{{
+				Match: map[string]string{
+					mesh_proto.ServiceTag: "*",
+				},
+			}},
+			Conf: &mesh_proto.Timeout_Conf{
+				ConnectTimeout: util_proto.Duration(c.Marker.ConnectTimeout(generation)),
+			},
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core"
+	kuma_log "github.com/kumahq/kuma/pkg/log"
+)
+
+var loadTestLog = core.Log.WithName("kuma-cp-loadtest")
+
+func NewRootCmd() *cobra.Command {
+	args := struct {
+		logLevel string
+	}{}
+	cmd := &cobra.Command{
+		Use:   "kuma-cp-loadtest",
+		Short: "Load test of Kuma CP",
+		Long:  `Load test of Kuma CP with simulated dataplanes and policy churn.`,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			level, err := kuma_log.ParseLogLevel(args.logLevel)
+			if err != nil {
+				return err
+			}
+			core.SetLogger(core.NewLogger(level))
+			return nil
+		},
+	}
+	cmd.PersistentFlags().StringVar(&args.logLevel, "log-level", kuma_log.InfoLevel.String(), kuma_cmd.UsageOptions("log level", kuma_log.OffLevel, kuma_log.InfoLevel, kuma_log.DebugLevel))
+
+	cmd.AddCommand(newRunCmd())
+	return cmd
+}
+
+func DefaultRootCmd() *cobra.Command {
+	return NewRootCmd()
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the rootCmd.
+func Execute() {
+	if err := DefaultRootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/test/loadtest/churn"
+	"github.com/kumahq/kuma/test/loadtest/simulator"
+	"github.com/kumahq/kuma/test/loadtest/stats"
+)
+
+const churnedPolicyName = "kuma-cp-loadtest"
+
+type runArgs struct {
+	xdsServerAddress string
+	apiServerAddress string
+	metricsAddress   string
+	mesh             string
+	dataplaneToken   string
+
+	dataplanes     int
+	services       int
+	rampUpPeriod   time.Duration
+	duration       time.Duration
+	churnRate      float64
+	sampleInterval time.Duration
+
+	maxPropagationP99 time.Duration
+	maxMemoryMiB      int
+}
+
+func newRunCmd() *cobra.Command {
+	log := loadTestLog.WithName("run")
+	args := runArgs{
+		xdsServerAddress: "grpcs://localhost:5678",
+		apiServerAddress: "http://localhost:5681",
+		metricsAddress:   "http://localhost:5680/metrics",
+		mesh:             "default",
+		dataplanes:       100,
+		services:         10,
+		rampUpPeriod:     30 * time.Second,
+		duration:         5 * time.Minute,
+		churnRate:        1,
+		sampleInterval:   5 * time.Second,
+	}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Run a load test against Kuma CP",
+		Long: `Run a load test against Kuma CP.
+
+The load test connects simulated dataplanes to the xDS server over the
+ramp up period, then changes a Timeout policy at the churn rate for the
+duration of the test. It reports the propagation latency of the changes
+and the CPU and memory usage of the control plane, and fails when they
+exceed the given thresholds.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if args.dataplanes < 1 || args.services < 1 {
+				return errors.New("--dataplanes and --services must be positive")
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), args.rampUpPeriod+args.duration)
+			defer cancel()
+
+			httpClient := &http.Client{Timeout: 10 * time.Second}
+			latencies := stats.NewLatencies(time.Now())
+			cp := &stats.ControlPlane{
+				MetricsURL: args.metricsAddress,
+				Interval:   args.sampleInterval,
+				Client:     httpClient,
+				Log:        log.WithName("control-plane"),
+			}
+			churner := &churn.Churner{
+				APIServerURL: args.apiServerAddress,
+				Mesh:         args.mesh,
+				Name:         churnedPolicyName,
+				Rate:         args.churnRate,
+				Marker:       simulator.DefaultMarker,
+				Client:       httpClient,
+				Recorder:     latencies,
+				Log:          log.WithName("churn"),
+			}
+
+			var wg sync.WaitGroup
+			var failed int32
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = cp.Start(ctx)
+			}()
+
+			log.Info("starting simulated dataplanes", "total", args.dataplanes, "rampUpPeriod", args.rampUpPeriod)
+			for i := 0; i < args.dataplanes; i++ {
+				node := &simulator.Node{
+					Mesh:     args.mesh,
+					Name:     fmt.Sprintf("loadtest-dataplane-%d", i),
+					Index:    i,
+					Services: args.services,
+					Token:    args.dataplaneToken,
+				}
+
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := runNode(ctx, args, node, latencies); err != nil {
+						atomic.AddInt32(&failed, 1)
+						log.Error(err, "simulated dataplane failed", "ID", node.ID())
+					}
+				}()
+			}
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(args.rampUpPeriod):
+				log.Info("applying policy changes", "rate", args.churnRate, "duration", args.duration)
+				_ = churner.Start(ctx)
+			}
+
+			wg.Wait()
+
+			cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cleanupCancel()
+			if err := churner.Cleanup(cleanupCtx); err != nil {
+				log.Error(err, "failed to delete the churned policy")
+			}
+
+			latencySummary := latencies.Summary()
+			cpSummary := cp.Summary()
+			printReport(cmd.OutOrStdout(), args, int(atomic.LoadInt32(&failed)), latencySummary, cpSummary)
+
+			return checkThresholds(args, latencySummary, cpSummary)
+		},
+	}
+	// flags
+	cmd.PersistentFlags().StringVar(&args.xdsServerAddress, "xds-server-address", args.xdsServerAddress, "address of xDS server")
+	cmd.PersistentFlags().StringVar(&args.apiServerAddress, "api-server-address", args.apiServerAddress, "address of API server")
+	cmd.PersistentFlags().StringVar(&args.metricsAddress, "metrics-address", args.metricsAddress, "address of Prometheus metrics of Kuma CP")
+	cmd.PersistentFlags().StringVar(&args.mesh, "mesh", args.mesh, "mesh of the simulated dataplanes")
+	cmd.PersistentFlags().StringVar(&args.dataplaneToken, "dataplane-token", args.dataplaneToken, "dataplane token, if Kuma CP requires one")
+	cmd.PersistentFlags().IntVar(&args.dataplanes, "dataplanes", args.dataplanes, "number of dataplanes to simulate")
+	cmd.PersistentFlags().IntVar(&args.services, "services", args.services, "number of services of the simulated dataplanes")
+	cmd.PersistentFlags().DurationVar(&args.rampUpPeriod, "rampup-period", args.rampUpPeriod, "ramp up period")
+	cmd.PersistentFlags().DurationVar(&args.duration, "duration", args.duration, "duration of the policy churn after the ramp up period")
+	cmd.PersistentFlags().Float64Var(&args.churnRate, "churn-rate", args.churnRate, "number of policy changes per second")
+	cmd.PersistentFlags().DurationVar(&args.sampleInterval, "sample-interval", args.sampleInterval, "interval between samples of Kuma CP metrics")
+	cmd.PersistentFlags().DurationVar(&args.maxPropagationP99, "max-propagation-p99", args.maxPropagationP99, "fail when the 99th percentile of the propagation latency exceeds it (0 disables the check)")
+	cmd.PersistentFlags().IntVar(&args.maxMemoryMiB, "max-memory-mib", args.maxMemoryMiB, "fail when the memory of Kuma CP exceeds it (0 disables the check)")
+	return cmd
+}
+
+// runNode simulates the Envoy of the node after a random delay within the
+// ramp up period.
+func runNode(ctx context.Context, args runArgs, node *simulator.Node, observer simulator.Observer) error {
+	delay := time.Duration(int64(float64(args.rampUpPeriod.Nanoseconds()) * rand.Float64()))
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-time.After(delay):
+	}
+
+	conn, err := simulator.Dial(ctx, args.xdsServerAddress)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to xDS server")
+	}
+	defer conn.Close()
+
+	log := loadTestLog.WithName("envoy-simulator").WithValues("ID", node.ID())
+	return simulator.Run(ctx, log, conn, node, simulator.DefaultMarker, observer)
+}
+
+func printReport(
+	out io.Writer,
+	args runArgs,
+	failed int,
+	latencies stats.LatencySummary,
+	cp stats.ControlPlaneSummary,
+) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "DATAPLANES\t%d simulated, %d connected, %d failed\n", args.dataplanes, latencies.Connected, failed)
+	fmt.Fprintf(w, "POLICY CHANGES\t%d applied at %g/s\n", latencies.Generations, args.churnRate)
+
+	p := latencies.Propagations
+	fmt.Fprintf(w, "PROPAGATION LATENCY\t%d observed, p50 %v, p90 %v, p99 %v, max %v\n", p.Count, p.P50, p.P90, p.P99, p.Max)
+
+	fmt.Fprintf(w, "CP CPU\tavg %.2f cores, max %.2f cores (%d samples)\n", cp.AvgCPUCores, cp.MaxCPUCores, cp.Samples)
+	fmt.Fprintf(w, "CP MEMORY\tavg %.1f MiB, max %.1f MiB\n", cp.AvgMemoryBytes/(1<<20), cp.MaxMemoryBytes/(1<<20))
+}
+
+func checkThresholds(args runArgs, latencies stats.LatencySummary, cp stats.ControlPlaneSummary) error {
+	if args.maxPropagationP99 > 0 {
+		if latencies.Propagations.Count == 0 {
+			return errors.New("no policy change was observed by the simulated dataplanes")
+		}
+		if latencies.Propagations.P99 > args.maxPropagationP99 {
+			return errors.Errorf("99th percentile of the propagation latency %v exceeds %v", latencies.Propagations.P99, args.maxPropagationP99)
+		}
+	}
+
+	if args.maxMemoryMiB > 0 && cp.MaxMemoryBytes > float64(args.maxMemoryMiB)*(1<<20) {
+		return errors.Errorf("memory of Kuma CP %.1f MiB exceeds %d MiB", cp.MaxMemoryBytes/(1<<20), args.maxMemoryMiB)
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package main
+
+import "github.com/kumahq/kuma/test/loadtest/cmd"
+
+func main() {
+	cmd.Execute()
+}
@@ -0,0 +1,175 @@
+package simulator
+
+import (
+	"context"
+	"crypto/tls"
+	"net/url"
+	"time"
+
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// requestedTypes are the resource types that the simulated Envoys
+// subscribe to, in the order that Envoy requests them.
+var requestedTypes = []string{
+	envoy_resource.ClusterType,
+	envoy_resource.EndpointType,
+	envoy_resource.ListenerType,
+	envoy_resource.RouteType,
+	envoy_resource.SecretType,
+}
+
+// Observer is notified when a simulated Envoy receives a configuration.
+type Observer interface {
+	// Connected is called when the Envoy of the node receives its
+	// first configuration.
+	Connected(node *Node, at time.Time)
+
+	// Observed is called when the Envoy of the node receives clusters
+	// that carry the marker of a generation of the churned policies.
+	Observed(node *Node, generation uint32, at time.Time)
+}
+
+// Dial connects to the xDS server at the given grpc:// or grpcs:// URL.
+func Dial(ctx context.Context, serverURL string) (*grpc.ClientConn, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var dialOpts []grpc.DialOption
+	switch u.Scheme {
+	case "grpc":
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	case "grpcs":
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			InsecureSkipVerify: true, // it's acceptable since we don't pass any secrets to the server
+		})))
+	default:
+		return nil, errors.Errorf("unsupported scheme %q. Use one of %s", u.Scheme, []string{"grpc", "grpcs"})
+	}
+
+	return grpc.DialContext(ctx, u.Host, dialOpts...)
+}
+
+// Run simulates the Envoy of the node on an ADS stream of the given
+// connection. It ACKs every response, and returns when the context is
+// done or the stream fails.
+func Run(ctx context.Context, log logr.Logger, conn *grpc.ClientConn, node *Node, marker Marker, observer Observer) error {
+	md, err := node.Metadata()
+	if err != nil {
+		return errors.Wrap(err, "failed to build node metadata")
+	}
+
+	stream, err := envoy_discovery.NewAggregatedDiscoveryServiceClient(conn).StreamAggregatedResources(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to start an xDS stream")
+	}
+
+	envoyNode := &envoy_core.Node{
+		Id:       node.ID(),
+		Cluster:  node.Service(),
+		Metadata: md,
+	}
+
+	for _, typ := range requestedTypes {
+		if err := stream.Send(&envoy_discovery.DiscoveryRequest{
+			Node:    envoyNode,
+			TypeUrl: typ,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to request %q", typ)
+		}
+	}
+
+	connected := false
+	generation := uint32(0)
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrap(err, "failed to receive a discovery response")
+		}
+
+		now := time.Now()
+		log.V(1).Info("received xDS resources", "type", resp.TypeUrl, "version", resp.VersionInfo, "resources", len(resp.Resources))
+
+		if !connected {
+			connected = true
+			observer.Connected(node, now)
+		}
+
+		if resp.TypeUrl == envoy_resource.ClusterType {
+			if g := marker.generation(resp); g != 0 && g != generation {
+				generation = g
+				observer.Observed(node, g, now)
+			}
+		}
+
+		if err := stream.Send(&envoy_discovery.DiscoveryRequest{
+			VersionInfo:   resp.VersionInfo,
+			ResponseNonce: resp.Nonce,
+			TypeUrl:       resp.TypeUrl,
+		}); err != nil {
+			return errors.Wrap(err, "failed to ACK a discovery response")
+		}
+	}
+}
+
+// Marker encodes the generations of the churned policies in the connect
+// timeout of the outbound clusters, so that the simulated Envoys can
+// tell which generation their configuration is built from.
+type Marker struct {
+	// Base is the connect timeout of the first generation.
+	Base time.Duration
+	// Generations is the number of generations before they wrap
+	// around. Each generation adds a millisecond to the timeout.
+	Generations uint32
+}
+
+// DefaultMarker is the marker of the load tests. Its timeouts are far
+// from the default connect timeout.
+var DefaultMarker = Marker{
+	Base:        5 * time.Second,
+	Generations: 1000,
+}
+
+// ConnectTimeout returns the connect timeout of the generation. The
+// generations start at 1.
+func (m Marker) ConnectTimeout(generation uint32) time.Duration {
+	return m.Base + time.Duration((generation-1)%m.Generations)*time.Millisecond
+}
+
+// Generation returns the generation of a connect timeout, or 0 if the
+// connect timeout doesn't carry a marker. Generations that wrap around
+// are folded onto the first ones.
+func (m Marker) Generation(timeout time.Duration) uint32 {
+	if timeout < m.Base || timeout >= m.Base+time.Duration(m.Generations)*time.Millisecond {
+		return 0
+	}
+	return uint32((timeout-m.Base)/time.Millisecond) + 1
+}
+
+func (m Marker) generation(resp *envoy_discovery.DiscoveryResponse) uint32 {
+	for _, r := range resp.Resources {
+		cluster := &envoy_cluster.Cluster{}
+		if err := util_proto.UnmarshalAnyTo(r, cluster); err != nil {
+			continue
+		}
+		if g := m.Generation(cluster.GetConnectTimeout().AsDuration()); g != 0 {
+			return g
+		}
+	}
+	return 0
+}
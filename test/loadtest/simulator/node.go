@@ -0,0 +1,133 @@
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	kuma_version "github.com/kumahq/kuma/pkg/version"
+)
+
+// SimulatedTag is the tag of the inbounds of the simulated dataplanes.
+// The churned policies select it, so that they are more specific than
+// the default policies of the mesh.
+const SimulatedTag = "loadtest.kuma.io/simulated"
+
+// envoyVersion is the Envoy version that the simulated dataplanes report.
+const envoyVersion = "1.18.3"
+
+const (
+	firstInboundPort  = 8080
+	firstOutboundPort = 11000
+	adminPort         = 9901
+	dnsPort           = 15053
+	emptyDNSPort      = 15054
+)
+
+// Node is a simulated dataplane.
+type Node struct {
+	Mesh string
+	Name string
+
+	// Index is the index of the node, which places it in the
+	// services of the simulation.
+	Index int
+
+	// Services is the number of services in the simulation. Each node
+	// implements one service and consumes all of them.
+	Services int
+
+	// Token is the dataplane token, if the control plane requires one.
+	Token string
+}
+
+// ID returns the Envoy node ID of the dataplane.
+func (n *Node) ID() string {
+	return fmt.Sprintf("%s.%s", n.Mesh, n.Name)
+}
+
+// Service returns the service that the node implements.
+func (n *Node) Service() string {
+	return fmt.Sprintf("service-%d", n.Index%n.Services)
+}
+
+// Dataplane returns the Dataplane resource of the node.
+func (n *Node) Dataplane() *rest.Resource {
+	dp := &mesh_proto.Dataplane{
+		Networking: &mesh_proto.Dataplane_Networking{
+			Address: fmt.Sprintf("10.%d.%d.%d", (n.Index>>16)&0xff, (n.Index>>8)&0xff, n.Index&0xff),
+			Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+				Port: firstInboundPort,
+				Tags: map[string]string{
+					mesh_proto.ServiceTag:  n.Service(),
+					mesh_proto.ProtocolTag: "http",
+					SimulatedTag:           "true",
+				},
+			}},
+		},
+	}
+
+	for i := 0; i < n.Services; i++ {
+		dp.Networking.Outbound = append(dp.Networking.Outbound, &mesh_proto.Dataplane_Networking_Outbound{
+			Address: "127.0.0.1",
+			Port:    uint32(firstOutboundPort + i),
+			Tags: map[string]string{
+				mesh_proto.ServiceTag: fmt.Sprintf("service-%d", i),
+			},
+		})
+	}
+
+	return &rest.Resource{
+		Meta: rest.ResourceMeta{Type: "Dataplane", Mesh: n.Mesh, Name: n.Name},
+		Spec: dp,
+	}
+}
+
+// Metadata returns the Envoy node metadata of the dataplane. It has
+// the fields that kuma-dp bootstraps Envoy with, and that the control
+// plane reads with DataplaneMetadataFromXdsMetadata.
+func (n *Node) Metadata() (*structpb.Struct, error) {
+	dpJSON, err := json.Marshal(n.Dataplane())
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := util_proto.ToStruct(&mesh_proto.Version{
+		KumaDp: &mesh_proto.KumaDpVersion{
+			Version:   kuma_version.Build.Version,
+			GitTag:    kuma_version.Build.GitTag,
+			GitCommit: kuma_version.Build.GitCommit,
+			BuildDate: kuma_version.Build.BuildDate,
+		},
+		Envoy: &mesh_proto.EnvoyVersion{
+			Version: envoyVersion,
+			Build:   "loadtest/" + envoyVersion + "/Clean/RELEASE/BoringSSL",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	md := &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"dataplane.resource":       structpb.NewStringValue(string(dpJSON)),
+			"dataplane.proxyType":      structpb.NewStringValue(string(mesh_proto.DataplaneProxyType)),
+			"dataplane.admin.port":     structpb.NewStringValue(strconv.Itoa(adminPort)),
+			"dataplane.dns.port":       structpb.NewStringValue(strconv.Itoa(dnsPort)),
+			"dataplane.dns.empty.port": structpb.NewStringValue(strconv.Itoa(emptyDNSPort)),
+			"dynamicMetadata":          structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{}}),
+			"version":                  structpb.NewStructValue(version),
+		},
+	}
+
+	if n.Token != "" {
+		md.Fields["dataplane.token"] = structpb.NewStringValue(n.Token)
+	}
+
+	return md, nil
+}
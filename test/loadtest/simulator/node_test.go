@@ -0,0 +1,74 @@
+package simulator_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/test/loadtest/simulator"
+)
+
+var _ = Describe("Node", func() {
+	It("should have the metadata that the control plane reads", func() {
+		// given
+		node := &simulator.Node{
+			Mesh:     "default",
+			Name:     "loadtest-dataplane-3",
+			Index:    3,
+			Services: 2,
+			Token:    "token",
+		}
+
+		// when
+		md, err := node.Metadata()
+		Expect(err).ToNot(HaveOccurred())
+		metadata := core_xds.DataplaneMetadataFromXdsMetadata(md)
+
+		// then
+		Expect(metadata.GetDataplaneToken()).To(Equal("token"))
+		Expect(metadata.GetProxyType()).To(Equal(mesh_proto.DataplaneProxyType))
+		Expect(metadata.GetAdminPort()).To(Equal(uint32(9901)))
+		Expect(metadata.GetDNSPort()).To(Equal(uint32(15053)))
+		Expect(metadata.GetEmptyDNSPort()).To(Equal(uint32(15054)))
+		Expect(metadata.GetVersion().GetEnvoy().GetVersion()).To(Equal("1.18.3"))
+
+		// and
+		dp := metadata.GetDataplaneResource()
+		Expect(dp).ToNot(BeNil())
+		Expect(dp.GetMeta().GetMesh()).To(Equal("default"))
+		Expect(dp.GetMeta().GetName()).To(Equal("loadtest-dataplane-3"))
+		Expect(dp.Spec.GetNetworking().GetAddress()).To(Equal("10.0.0.3"))
+		Expect(dp.Spec.GetNetworking().GetInbound()).To(HaveLen(1))
+		Expect(dp.Spec.GetNetworking().GetInbound()[0].GetTags()).To(Equal(map[string]string{
+			mesh_proto.ServiceTag:  "service-1",
+			mesh_proto.ProtocolTag: "http",
+			simulator.SimulatedTag: "true",
+		}))
+		Expect(dp.Spec.GetNetworking().GetOutbound()).To(HaveLen(2))
+	})
+})
+
+var _ = Describe("Marker", func() {
+	marker := simulator.Marker{
+		Base:        5 * time.Second,
+		Generations: 10,
+	}
+
+	It("should decode the generation of its connect timeouts", func() {
+		for g := uint32(1); g <= 10; g++ {
+			Expect(marker.Generation(marker.ConnectTimeout(g))).To(Equal(g))
+		}
+	})
+
+	It("should fold generations that wrap around", func() {
+		Expect(marker.Generation(marker.ConnectTimeout(11))).To(Equal(uint32(1)))
+	})
+
+	It("should ignore connect timeouts without a marker", func() {
+		Expect(marker.Generation(10 * time.Second)).To(Equal(uint32(0)))
+		Expect(marker.Generation(5*time.Second - time.Millisecond)).To(Equal(uint32(0)))
+	})
+})
@@ -0,0 +1,11 @@
+package simulator_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestSimulator(t *testing.T) {
+	test.RunSpecs(t, "Simulator Suite")
+}
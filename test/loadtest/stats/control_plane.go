@@ -0,0 +1,169 @@
+package stats
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	cpuMetric    = "process_cpu_seconds_total"
+	memoryMetric = "process_resident_memory_bytes"
+)
+
+// ControlPlane samples the CPU and the memory usage of the control plane
+// from its Prometheus metrics.
+type ControlPlane struct {
+	MetricsURL string
+	Interval   time.Duration
+	Client     *http.Client
+	Log        logr.Logger
+
+	mu      sync.Mutex
+	samples []sample
+}
+
+type sample struct {
+	at         time.Time
+	cpuSeconds float64
+	memory     float64
+}
+
+// Start samples the metrics until the context is done. Failed samples
+// are logged and skipped.
+func (c *ControlPlane) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+
+	for {
+		s, err := c.sample(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				c.Log.Error(err, "failed to sample control plane metrics")
+			}
+		} else {
+			c.mu.Lock()
+			c.samples = append(c.samples, s)
+			c.mu.Unlock()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *ControlPlane) sample(ctx context.Context) (sample, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.MetricsURL, nil)
+	if err != nil {
+		return sample{}, err
+	}
+
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return sample{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return sample{}, errors.Errorf("GET %s: unexpected status %d", c.MetricsURL, resp.StatusCode)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return sample{}, errors.Wrap(err, "failed to parse metrics")
+	}
+
+	cpu, ok := metricValue(families[cpuMetric])
+	if !ok {
+		return sample{}, errors.Errorf("metric %q not found", cpuMetric)
+	}
+	memory, ok := metricValue(families[memoryMetric])
+	if !ok {
+		return sample{}, errors.Errorf("metric %q not found", memoryMetric)
+	}
+
+	return sample{
+		at:         time.Now(),
+		cpuSeconds: cpu,
+		memory:     memory,
+	}, nil
+}
+
+func metricValue(family *io_prometheus_client.MetricFamily) (float64, bool) {
+	if family == nil || len(family.GetMetric()) == 0 {
+		return 0, false
+	}
+
+	m := family.GetMetric()[0]
+	switch {
+	case m.GetCounter() != nil:
+		return m.GetCounter().GetValue(), true
+	case m.GetGauge() != nil:
+		return m.GetGauge().GetValue(), true
+	case m.GetUntyped() != nil:
+		return m.GetUntyped().GetValue(), true
+	default:
+		return 0, false
+	}
+}
+
+// Summary summarizes the samples. The CPU usage is measured in cores,
+// between consecutive samples.
+func (c *ControlPlane) Summary() ControlPlaneSummary {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	summary := ControlPlaneSummary{
+		Samples: len(c.samples),
+	}
+	if len(c.samples) == 0 {
+		return summary
+	}
+
+	var memoryTotal float64
+	for i, s := range c.samples {
+		memoryTotal += s.memory
+		if s.memory > summary.MaxMemoryBytes {
+			summary.MaxMemoryBytes = s.memory
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		prev := c.samples[i-1]
+		if elapsed := s.at.Sub(prev.at).Seconds(); elapsed > 0 {
+			if cores := (s.cpuSeconds - prev.cpuSeconds) / elapsed; cores > summary.MaxCPUCores {
+				summary.MaxCPUCores = cores
+			}
+		}
+	}
+	summary.AvgMemoryBytes = memoryTotal / float64(len(c.samples))
+
+	first, last := c.samples[0], c.samples[len(c.samples)-1]
+	if elapsed := last.at.Sub(first.at).Seconds(); elapsed > 0 {
+		summary.AvgCPUCores = (last.cpuSeconds - first.cpuSeconds) / elapsed
+	}
+
+	return summary
+}
+
+type ControlPlaneSummary struct {
+	Samples int
+
+	AvgCPUCores float64
+	MaxCPUCores float64
+
+	AvgMemoryBytes float64
+	MaxMemoryBytes float64
+}
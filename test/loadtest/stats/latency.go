@@ -0,0 +1,127 @@
+package stats
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kumahq/kuma/test/loadtest/simulator"
+)
+
+// Latencies records the connections of the simulated dataplanes and the
+// propagation latencies of the churned policies, which is the time from
+// the moment a generation is applied to the moment a dataplane receives
+// it.
+type Latencies struct {
+	mu sync.Mutex
+
+	start   time.Time
+	applied map[uint32]time.Time
+
+	connections  []time.Duration
+	propagations []time.Duration
+	generations  int
+}
+
+var _ simulator.Observer = &Latencies{}
+
+func NewLatencies(start time.Time) *Latencies {
+	return &Latencies{
+		start:   start,
+		applied: map[uint32]time.Time{},
+	}
+}
+
+func (l *Latencies) Applied(generation uint32, at time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.applied[generation] = at
+	l.generations++
+}
+
+func (l *Latencies) Connected(_ *simulator.Node, at time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.connections = append(l.connections, at.Sub(l.start))
+}
+
+// Observed records the propagation latency of the generation. The first
+// generation that a dataplane receives after it connects is recorded as
+// well, which overestimates the latency of dataplanes that connect while
+// the generation propagates.
+func (l *Latencies) Observed(_ *simulator.Node, generation uint32, at time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	applied, ok := l.applied[generation]
+	if !ok || at.Before(applied) {
+		return
+	}
+
+	l.propagations = append(l.propagations, at.Sub(applied))
+}
+
+// Summary summarizes the recorded latencies.
+func (l *Latencies) Summary() LatencySummary {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return LatencySummary{
+		Connected:    len(l.connections),
+		Generations:  l.generations,
+		Propagations: Summarize(l.propagations),
+	}
+}
+
+type LatencySummary struct {
+	// Connected is the number of dataplanes that received their
+	// configuration.
+	Connected int
+
+	// Generations is the number of policy changes that were applied.
+	Generations int
+
+	// Propagations are the propagation latencies.
+	Propagations Distribution
+}
+
+// Distribution is the distribution of a set of durations.
+type Distribution struct {
+	Count int
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// Summarize returns the distribution of the durations.
+func Summarize(durations []time.Duration) Distribution {
+	if len(durations) == 0 {
+		return Distribution{}
+	}
+
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	return Distribution{
+		Count: len(sorted),
+		P50:   percentile(sorted, 0.50),
+		P90:   percentile(sorted, 0.90),
+		P99:   percentile(sorted, 0.99),
+		Max:   sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
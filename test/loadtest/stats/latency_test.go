@@ -0,0 +1,44 @@
+package stats_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/test/loadtest/simulator"
+	"github.com/kumahq/kuma/test/loadtest/stats"
+)
+
+var _ = Describe("Latencies", func() {
+	It("should summarize the propagation latencies", func() {
+		// given
+		start := time.Now()
+		latencies := stats.NewLatencies(start)
+		node := &simulator.Node{Mesh: "default", Name: "dp-1"}
+
+		// when
+		latencies.Applied(1, start)
+		for i := 1; i <= 100; i++ {
+			latencies.Observed(node, 1, start.Add(time.Duration(i)*time.Millisecond))
+		}
+		// generations that were not applied are ignored
+		latencies.Observed(node, 2, start)
+
+		// then
+		Expect(latencies.Summary()).To(Equal(stats.LatencySummary{
+			Generations: 1,
+			Propagations: stats.Distribution{
+				Count: 100,
+				P50:   50 * time.Millisecond,
+				P90:   90 * time.Millisecond,
+				P99:   99 * time.Millisecond,
+				Max:   100 * time.Millisecond,
+			},
+		}))
+	})
+
+	It("should summarize no durations", func() {
+		Expect(stats.Summarize(nil)).To(Equal(stats.Distribution{}))
+	})
+})
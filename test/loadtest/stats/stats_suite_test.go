@@ -0,0 +1,11 @@
+package stats_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestStats(t *testing.T) {
+	test.RunSpecs(t, "Stats Suite")
+}
@@ -19,6 +19,7 @@ func newEchoHTTPCmd() *cobra.Command {
 		crtFile  string
 		keyFile  string
 		probes   bool
+		logging  bool
 	}{}
 	cmd := &cobra.Command{
 		Use:   "echo",
@@ -26,6 +27,9 @@ func newEchoHTTPCmd() *cobra.Command {
 		Long:  `Run Test Server with generic echo response.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			http.HandleFunc("/", func(writer http.ResponseWriter, request *http.Request) {
+				if args.logging {
+					fmt.Printf("%s %s %s\n", request.Method, request.Host, request.URL.Path)
+				}
 				headers := request.Header
 				headers.Add("host", request.Host)
 				resp := &types.EchoResponse{
@@ -93,5 +97,6 @@ func newEchoHTTPCmd() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&args.crtFile, "crt", "./test/server/certs/server.crt", "path to the server's TLS cert")
 	cmd.PersistentFlags().StringVar(&args.keyFile, "key", "./test/server/certs/server.key", "path to the server's TLS key")
 	cmd.PersistentFlags().BoolVar(&args.probes, "probes", false, "generate readiness and liveness endpoints")
+	cmd.PersistentFlags().BoolVar(&args.logging, "log-requests", false, "print the method, host and path of every request")
 	return cmd
 }